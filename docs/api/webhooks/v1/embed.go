@@ -0,0 +1,8 @@
+package openapi
+
+import (
+	"embed"
+)
+
+//go:embed *
+var Content embed.FS
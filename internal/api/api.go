@@ -16,16 +16,12 @@ type ErrorHandlerFunc func(err error) error
 
 func ErrorHandler(marshaler ErrorMarshaler) ErrorHandlerFunc {
 	return func(err error) error {
-		err, cause := ex.Expose(err)
+		details := MarshalError(marshaler, err)
+		err, _ = ex.Expose(err)
 
-		var text, reason string
+		var text string
 		if err != nil {
 			text = err.Error()
-			reason = text
-		}
-
-		if cause != nil {
-			reason = cause.Error()
 		}
 
 		code, exist := marshaler.Code(err)
@@ -38,18 +34,34 @@ func ErrorHandler(marshaler ErrorMarshaler) ErrorHandlerFunc {
 			text = "internal error"
 		}
 
-		errorCode, exist := marshaler.ErrorCode(err)
-		if !exist {
-			errorCode = typespb.ErrorCode_ERROR_CODE_UNKNOWN
-		}
-
-		st, err := status.New(code, text).WithDetails(&typespb.Error{
-			Code:   errorCode,
-			Reason: reason,
-		})
+		st, err := status.New(code, text).WithDetails(details)
 
 		ex.Skip(err)
 
 		return st.Err()
 	}
 }
+
+// MarshalError converts the error into the structured error, e.g. to report failed items of a batch.
+func MarshalError(marshaler ErrorMarshaler, err error) *typespb.Error {
+	err, cause := ex.Expose(err)
+
+	var reason string
+	if err != nil {
+		reason = err.Error()
+	}
+
+	if cause != nil {
+		reason = cause.Error()
+	}
+
+	errorCode, exist := marshaler.ErrorCode(err)
+	if !exist {
+		errorCode = typespb.ErrorCode_ERROR_CODE_UNKNOWN
+	}
+
+	return &typespb.Error{
+		Code:   errorCode,
+		Reason: reason,
+	}
+}
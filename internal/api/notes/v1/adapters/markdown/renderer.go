@@ -0,0 +1,85 @@
+package markdown
+
+import (
+	"bytes"
+	"html"
+	"regexp"
+	"strings"
+
+	"github.com/microcosm-cc/bluemonday"
+	"github.com/therenotomorrow/ex"
+	"github.com/therenotomorrow/gotes/internal/domain/entities"
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/extension"
+	rendering "github.com/yuin/goldmark/renderer/html"
+)
+
+const ErrUnknownFormat ex.Error = "unknown format"
+
+// Version identifies the output of the renderer, it changes with the rendering or the sanitizing policy, so the
+// renders cached before are not served anymore.
+const Version = 1
+
+// Renderer turns Markdown into HTML and keeps only the markup safe to embed into a page, so the raw HTML written
+// in notes is rendered too, but without scripts, handlers and dangerous links.
+type Renderer struct {
+	markdown goldmark.Markdown
+	policy   *bluemonday.Policy
+	strict   *bluemonday.Policy
+}
+
+func NewRenderer() *Renderer {
+	policy := bluemonday.UGCPolicy()
+	// the languages of fenced code blocks and the checkboxes of task lists
+	policy.AllowAttrs("class").Matching(regexp.MustCompile(`^language-[a-zA-Z0-9_+-]+$`)).OnElements("code")
+	policy.AllowAttrs("type").Matching(regexp.MustCompile(`^checkbox$`)).OnElements("input")
+	policy.AllowAttrs("checked", "disabled").Matching(regexp.MustCompile(`^$`)).OnElements("input")
+
+	return &Renderer{
+		markdown: goldmark.New(
+			goldmark.WithExtensions(extension.GFM),
+			goldmark.WithRendererOptions(rendering.WithUnsafe()),
+		),
+		policy: policy,
+		strict: bluemonday.StrictPolicy(),
+	}
+}
+
+func (r *Renderer) Render(content string, format entities.Format) (string, error) {
+	if format.IsRaw() {
+		return content, nil
+	}
+
+	var buf bytes.Buffer
+
+	err := r.markdown.Convert([]byte(content), &buf)
+	if err != nil {
+		return "", ex.Unexpected(err)
+	}
+
+	switch format {
+	case entities.FormatHTML:
+		return r.policy.Sanitize(buf.String()), nil
+	case entities.FormatText:
+		return plain(html.UnescapeString(r.strict.Sanitize(buf.String()))), nil
+	default:
+		return "", ErrUnknownFormat
+	}
+}
+
+// plain trims the lines left of the markup and keeps at most one empty line between the blocks.
+func plain(text string) string {
+	lines := strings.Split(text, "\n")
+	kept := make([]string, 0, len(lines))
+
+	for _, line := range lines {
+		line = strings.TrimSpace(line)
+		if line == "" && (len(kept) == 0 || kept[len(kept)-1] == "") {
+			continue
+		}
+
+		kept = append(kept, line)
+	}
+
+	return strings.TrimSpace(strings.Join(kept, "\n"))
+}
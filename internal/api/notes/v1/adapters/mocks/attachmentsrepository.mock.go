@@ -0,0 +1,541 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"context"
+	"time"
+
+	mock "github.com/stretchr/testify/mock"
+	"github.com/therenotomorrow/gotes/internal/domain/entities"
+	"github.com/therenotomorrow/gotes/internal/domain/types/id"
+)
+
+// NewMockAttachmentsRepository creates a new instance of MockAttachmentsRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockAttachmentsRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockAttachmentsRepository {
+	mock := &MockAttachmentsRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockAttachmentsRepository is an autogenerated mock type for the AttachmentsRepository type
+type MockAttachmentsRepository struct {
+	mock.Mock
+}
+
+type MockAttachmentsRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockAttachmentsRepository) EXPECT() *MockAttachmentsRepository_Expecter {
+	return &MockAttachmentsRepository_Expecter{mock: &_m.Mock}
+}
+
+// DeleteAttachment provides a mock function for the type MockAttachmentsRepository
+func (_mock *MockAttachmentsRepository) DeleteAttachment(ctx context.Context, attachment *entities.Attachment) error {
+	ret := _mock.Called(ctx, attachment)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteAttachment")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *entities.Attachment) error); ok {
+		r0 = returnFunc(ctx, attachment)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockAttachmentsRepository_DeleteAttachment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteAttachment'
+type MockAttachmentsRepository_DeleteAttachment_Call struct {
+	*mock.Call
+}
+
+// DeleteAttachment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - attachment *entities.Attachment
+func (_e *MockAttachmentsRepository_Expecter) DeleteAttachment(ctx interface{}, attachment interface{}) *MockAttachmentsRepository_DeleteAttachment_Call {
+	return &MockAttachmentsRepository_DeleteAttachment_Call{Call: _e.mock.On("DeleteAttachment", ctx, attachment)}
+}
+
+func (_c *MockAttachmentsRepository_DeleteAttachment_Call) Run(run func(ctx context.Context, attachment *entities.Attachment)) *MockAttachmentsRepository_DeleteAttachment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *entities.Attachment
+		if args[1] != nil {
+			arg1 = args[1].(*entities.Attachment)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAttachmentsRepository_DeleteAttachment_Call) Return(err error) *MockAttachmentsRepository_DeleteAttachment_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockAttachmentsRepository_DeleteAttachment_Call) RunAndReturn(run func(ctx context.Context, attachment *entities.Attachment) error) *MockAttachmentsRepository_DeleteAttachment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteAttachmentsByNote provides a mock function for the type MockAttachmentsRepository
+func (_mock *MockAttachmentsRepository) DeleteAttachmentsByNote(ctx context.Context, note *entities.Note) error {
+	ret := _mock.Called(ctx, note)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteAttachmentsByNote")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *entities.Note) error); ok {
+		r0 = returnFunc(ctx, note)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockAttachmentsRepository_DeleteAttachmentsByNote_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteAttachmentsByNote'
+type MockAttachmentsRepository_DeleteAttachmentsByNote_Call struct {
+	*mock.Call
+}
+
+// DeleteAttachmentsByNote is a helper method to define mock.On call
+//   - ctx context.Context
+//   - note *entities.Note
+func (_e *MockAttachmentsRepository_Expecter) DeleteAttachmentsByNote(ctx interface{}, note interface{}) *MockAttachmentsRepository_DeleteAttachmentsByNote_Call {
+	return &MockAttachmentsRepository_DeleteAttachmentsByNote_Call{Call: _e.mock.On("DeleteAttachmentsByNote", ctx, note)}
+}
+
+func (_c *MockAttachmentsRepository_DeleteAttachmentsByNote_Call) Run(run func(ctx context.Context, note *entities.Note)) *MockAttachmentsRepository_DeleteAttachmentsByNote_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *entities.Note
+		if args[1] != nil {
+			arg1 = args[1].(*entities.Note)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAttachmentsRepository_DeleteAttachmentsByNote_Call) Return(err error) *MockAttachmentsRepository_DeleteAttachmentsByNote_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockAttachmentsRepository_DeleteAttachmentsByNote_Call) RunAndReturn(run func(ctx context.Context, note *entities.Note) error) *MockAttachmentsRepository_DeleteAttachmentsByNote_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ForgetBuriedBlobs provides a mock function for the type MockAttachmentsRepository
+func (_mock *MockAttachmentsRepository) ForgetBuriedBlobs(ctx context.Context, keys []string) error {
+	ret := _mock.Called(ctx, keys)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ForgetBuriedBlobs")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []string) error); ok {
+		r0 = returnFunc(ctx, keys)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockAttachmentsRepository_ForgetBuriedBlobs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ForgetBuriedBlobs'
+type MockAttachmentsRepository_ForgetBuriedBlobs_Call struct {
+	*mock.Call
+}
+
+// ForgetBuriedBlobs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - keys []string
+func (_e *MockAttachmentsRepository_Expecter) ForgetBuriedBlobs(ctx interface{}, keys interface{}) *MockAttachmentsRepository_ForgetBuriedBlobs_Call {
+	return &MockAttachmentsRepository_ForgetBuriedBlobs_Call{Call: _e.mock.On("ForgetBuriedBlobs", ctx, keys)}
+}
+
+func (_c *MockAttachmentsRepository_ForgetBuriedBlobs_Call) Run(run func(ctx context.Context, keys []string)) *MockAttachmentsRepository_ForgetBuriedBlobs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 []string
+		if args[1] != nil {
+			arg1 = args[1].([]string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAttachmentsRepository_ForgetBuriedBlobs_Call) Return(err error) *MockAttachmentsRepository_ForgetBuriedBlobs_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockAttachmentsRepository_ForgetBuriedBlobs_Call) RunAndReturn(run func(ctx context.Context, keys []string) error) *MockAttachmentsRepository_ForgetBuriedBlobs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAttachment provides a mock function for the type MockAttachmentsRepository
+func (_mock *MockAttachmentsRepository) GetAttachment(ctx context.Context, id1 id.ID) (*entities.Attachment, error) {
+	ret := _mock.Called(ctx, id1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAttachment")
+	}
+
+	var r0 *entities.Attachment
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, id.ID) (*entities.Attachment, error)); ok {
+		return returnFunc(ctx, id1)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, id.ID) *entities.Attachment); ok {
+		r0 = returnFunc(ctx, id1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.Attachment)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, id.ID) error); ok {
+		r1 = returnFunc(ctx, id1)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockAttachmentsRepository_GetAttachment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAttachment'
+type MockAttachmentsRepository_GetAttachment_Call struct {
+	*mock.Call
+}
+
+// GetAttachment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id1 id.ID
+func (_e *MockAttachmentsRepository_Expecter) GetAttachment(ctx interface{}, id1 interface{}) *MockAttachmentsRepository_GetAttachment_Call {
+	return &MockAttachmentsRepository_GetAttachment_Call{Call: _e.mock.On("GetAttachment", ctx, id1)}
+}
+
+func (_c *MockAttachmentsRepository_GetAttachment_Call) Run(run func(ctx context.Context, id1 id.ID)) *MockAttachmentsRepository_GetAttachment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 id.ID
+		if args[1] != nil {
+			arg1 = args[1].(id.ID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAttachmentsRepository_GetAttachment_Call) Return(attachment *entities.Attachment, err error) *MockAttachmentsRepository_GetAttachment_Call {
+	_c.Call.Return(attachment, err)
+	return _c
+}
+
+func (_c *MockAttachmentsRepository_GetAttachment_Call) RunAndReturn(run func(ctx context.Context, id1 id.ID) (*entities.Attachment, error)) *MockAttachmentsRepository_GetAttachment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAttachmentsByNote provides a mock function for the type MockAttachmentsRepository
+func (_mock *MockAttachmentsRepository) GetAttachmentsByNote(ctx context.Context, note *entities.Note) ([]*entities.Attachment, error) {
+	ret := _mock.Called(ctx, note)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAttachmentsByNote")
+	}
+
+	var r0 []*entities.Attachment
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *entities.Note) ([]*entities.Attachment, error)); ok {
+		return returnFunc(ctx, note)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *entities.Note) []*entities.Attachment); ok {
+		r0 = returnFunc(ctx, note)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.Attachment)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *entities.Note) error); ok {
+		r1 = returnFunc(ctx, note)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockAttachmentsRepository_GetAttachmentsByNote_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAttachmentsByNote'
+type MockAttachmentsRepository_GetAttachmentsByNote_Call struct {
+	*mock.Call
+}
+
+// GetAttachmentsByNote is a helper method to define mock.On call
+//   - ctx context.Context
+//   - note *entities.Note
+func (_e *MockAttachmentsRepository_Expecter) GetAttachmentsByNote(ctx interface{}, note interface{}) *MockAttachmentsRepository_GetAttachmentsByNote_Call {
+	return &MockAttachmentsRepository_GetAttachmentsByNote_Call{Call: _e.mock.On("GetAttachmentsByNote", ctx, note)}
+}
+
+func (_c *MockAttachmentsRepository_GetAttachmentsByNote_Call) Run(run func(ctx context.Context, note *entities.Note)) *MockAttachmentsRepository_GetAttachmentsByNote_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *entities.Note
+		if args[1] != nil {
+			arg1 = args[1].(*entities.Note)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAttachmentsRepository_GetAttachmentsByNote_Call) Return(attachments []*entities.Attachment, err error) *MockAttachmentsRepository_GetAttachmentsByNote_Call {
+	_c.Call.Return(attachments, err)
+	return _c
+}
+
+func (_c *MockAttachmentsRepository_GetAttachmentsByNote_Call) RunAndReturn(run func(ctx context.Context, note *entities.Note) ([]*entities.Attachment, error)) *MockAttachmentsRepository_GetAttachmentsByNote_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LockBuriedBlobs provides a mock function for the type MockAttachmentsRepository
+func (_mock *MockAttachmentsRepository) LockBuriedBlobs(ctx context.Context, limit int) ([]string, error) {
+	ret := _mock.Called(ctx, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LockBuriedBlobs")
+	}
+
+	var r0 []string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int) ([]string, error)); ok {
+		return returnFunc(ctx, limit)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int) []string); ok {
+		r0 = returnFunc(ctx, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = returnFunc(ctx, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockAttachmentsRepository_LockBuriedBlobs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LockBuriedBlobs'
+type MockAttachmentsRepository_LockBuriedBlobs_Call struct {
+	*mock.Call
+}
+
+// LockBuriedBlobs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - limit int
+func (_e *MockAttachmentsRepository_Expecter) LockBuriedBlobs(ctx interface{}, limit interface{}) *MockAttachmentsRepository_LockBuriedBlobs_Call {
+	return &MockAttachmentsRepository_LockBuriedBlobs_Call{Call: _e.mock.On("LockBuriedBlobs", ctx, limit)}
+}
+
+func (_c *MockAttachmentsRepository_LockBuriedBlobs_Call) Run(run func(ctx context.Context, limit int)) *MockAttachmentsRepository_LockBuriedBlobs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int
+		if args[1] != nil {
+			arg1 = args[1].(int)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAttachmentsRepository_LockBuriedBlobs_Call) Return(strings []string, err error) *MockAttachmentsRepository_LockBuriedBlobs_Call {
+	_c.Call.Return(strings, err)
+	return _c
+}
+
+func (_c *MockAttachmentsRepository_LockBuriedBlobs_Call) RunAndReturn(run func(ctx context.Context, limit int) ([]string, error)) *MockAttachmentsRepository_LockBuriedBlobs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PurgeAttachments provides a mock function for the type MockAttachmentsRepository
+func (_mock *MockAttachmentsRepository) PurgeAttachments(ctx context.Context, before time.Time) error {
+	ret := _mock.Called(ctx, before)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PurgeAttachments")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Time) error); ok {
+		r0 = returnFunc(ctx, before)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockAttachmentsRepository_PurgeAttachments_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PurgeAttachments'
+type MockAttachmentsRepository_PurgeAttachments_Call struct {
+	*mock.Call
+}
+
+// PurgeAttachments is a helper method to define mock.On call
+//   - ctx context.Context
+//   - before time.Time
+func (_e *MockAttachmentsRepository_Expecter) PurgeAttachments(ctx interface{}, before interface{}) *MockAttachmentsRepository_PurgeAttachments_Call {
+	return &MockAttachmentsRepository_PurgeAttachments_Call{Call: _e.mock.On("PurgeAttachments", ctx, before)}
+}
+
+func (_c *MockAttachmentsRepository_PurgeAttachments_Call) Run(run func(ctx context.Context, before time.Time)) *MockAttachmentsRepository_PurgeAttachments_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 time.Time
+		if args[1] != nil {
+			arg1 = args[1].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAttachmentsRepository_PurgeAttachments_Call) Return(err error) *MockAttachmentsRepository_PurgeAttachments_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockAttachmentsRepository_PurgeAttachments_Call) RunAndReturn(run func(ctx context.Context, before time.Time) error) *MockAttachmentsRepository_PurgeAttachments_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SaveAttachment provides a mock function for the type MockAttachmentsRepository
+func (_mock *MockAttachmentsRepository) SaveAttachment(ctx context.Context, attachment *entities.Attachment) (*entities.Attachment, error) {
+	ret := _mock.Called(ctx, attachment)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveAttachment")
+	}
+
+	var r0 *entities.Attachment
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *entities.Attachment) (*entities.Attachment, error)); ok {
+		return returnFunc(ctx, attachment)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *entities.Attachment) *entities.Attachment); ok {
+		r0 = returnFunc(ctx, attachment)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.Attachment)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *entities.Attachment) error); ok {
+		r1 = returnFunc(ctx, attachment)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockAttachmentsRepository_SaveAttachment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveAttachment'
+type MockAttachmentsRepository_SaveAttachment_Call struct {
+	*mock.Call
+}
+
+// SaveAttachment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - attachment *entities.Attachment
+func (_e *MockAttachmentsRepository_Expecter) SaveAttachment(ctx interface{}, attachment interface{}) *MockAttachmentsRepository_SaveAttachment_Call {
+	return &MockAttachmentsRepository_SaveAttachment_Call{Call: _e.mock.On("SaveAttachment", ctx, attachment)}
+}
+
+func (_c *MockAttachmentsRepository_SaveAttachment_Call) Run(run func(ctx context.Context, attachment *entities.Attachment)) *MockAttachmentsRepository_SaveAttachment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *entities.Attachment
+		if args[1] != nil {
+			arg1 = args[1].(*entities.Attachment)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAttachmentsRepository_SaveAttachment_Call) Return(attachment1 *entities.Attachment, err error) *MockAttachmentsRepository_SaveAttachment_Call {
+	_c.Call.Return(attachment1, err)
+	return _c
+}
+
+func (_c *MockAttachmentsRepository_SaveAttachment_Call) RunAndReturn(run func(ctx context.Context, attachment *entities.Attachment) (*entities.Attachment, error)) *MockAttachmentsRepository_SaveAttachment_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -0,0 +1,227 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"context"
+	"io"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockBlobStorage creates a new instance of MockBlobStorage. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockBlobStorage(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockBlobStorage {
+	mock := &MockBlobStorage{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockBlobStorage is an autogenerated mock type for the BlobStorage type
+type MockBlobStorage struct {
+	mock.Mock
+}
+
+type MockBlobStorage_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockBlobStorage) EXPECT() *MockBlobStorage_Expecter {
+	return &MockBlobStorage_Expecter{mock: &_m.Mock}
+}
+
+// Delete provides a mock function for the type MockBlobStorage
+func (_mock *MockBlobStorage) Delete(ctx context.Context, key string) error {
+	ret := _mock.Called(ctx, key)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, key)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockBlobStorage_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockBlobStorage_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - key string
+func (_e *MockBlobStorage_Expecter) Delete(ctx interface{}, key interface{}) *MockBlobStorage_Delete_Call {
+	return &MockBlobStorage_Delete_Call{Call: _e.mock.On("Delete", ctx, key)}
+}
+
+func (_c *MockBlobStorage_Delete_Call) Run(run func(ctx context.Context, key string)) *MockBlobStorage_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockBlobStorage_Delete_Call) Return(err error) *MockBlobStorage_Delete_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockBlobStorage_Delete_Call) RunAndReturn(run func(ctx context.Context, key string) error) *MockBlobStorage_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Get provides a mock function for the type MockBlobStorage
+func (_mock *MockBlobStorage) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	ret := _mock.Called(ctx, key)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 io.ReadCloser
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (io.ReadCloser, error)); ok {
+		return returnFunc(ctx, key)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) io.ReadCloser); ok {
+		r0 = returnFunc(ctx, key)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(io.ReadCloser)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, key)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockBlobStorage_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type MockBlobStorage_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+//   - ctx context.Context
+//   - key string
+func (_e *MockBlobStorage_Expecter) Get(ctx interface{}, key interface{}) *MockBlobStorage_Get_Call {
+	return &MockBlobStorage_Get_Call{Call: _e.mock.On("Get", ctx, key)}
+}
+
+func (_c *MockBlobStorage_Get_Call) Run(run func(ctx context.Context, key string)) *MockBlobStorage_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockBlobStorage_Get_Call) Return(readCloser io.ReadCloser, err error) *MockBlobStorage_Get_Call {
+	_c.Call.Return(readCloser, err)
+	return _c
+}
+
+func (_c *MockBlobStorage_Get_Call) RunAndReturn(run func(ctx context.Context, key string) (io.ReadCloser, error)) *MockBlobStorage_Get_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Put provides a mock function for the type MockBlobStorage
+func (_mock *MockBlobStorage) Put(ctx context.Context, key string, content io.Reader) error {
+	ret := _mock.Called(ctx, key, content)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Put")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, io.Reader) error); ok {
+		r0 = returnFunc(ctx, key, content)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockBlobStorage_Put_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Put'
+type MockBlobStorage_Put_Call struct {
+	*mock.Call
+}
+
+// Put is a helper method to define mock.On call
+//   - ctx context.Context
+//   - key string
+//   - content io.Reader
+func (_e *MockBlobStorage_Expecter) Put(ctx interface{}, key interface{}, content interface{}) *MockBlobStorage_Put_Call {
+	return &MockBlobStorage_Put_Call{Call: _e.mock.On("Put", ctx, key, content)}
+}
+
+func (_c *MockBlobStorage_Put_Call) Run(run func(ctx context.Context, key string, content io.Reader)) *MockBlobStorage_Put_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 io.Reader
+		if args[2] != nil {
+			arg2 = args[2].(io.Reader)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockBlobStorage_Put_Call) Return(err error) *MockBlobStorage_Put_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockBlobStorage_Put_Call) RunAndReturn(run func(ctx context.Context, key string, content io.Reader) error) *MockBlobStorage_Put_Call {
+	_c.Call.Return(run)
+	return _c
+}
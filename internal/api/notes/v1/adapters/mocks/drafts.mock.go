@@ -0,0 +1,513 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"context"
+	"time"
+
+	mock "github.com/stretchr/testify/mock"
+	"github.com/therenotomorrow/gotes/internal/domain/entities"
+)
+
+// NewMockDrafts creates a new instance of MockDrafts. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockDrafts(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockDrafts {
+	mock := &MockDrafts{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockDrafts is an autogenerated mock type for the Drafts type
+type MockDrafts struct {
+	mock.Mock
+}
+
+type MockDrafts_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockDrafts) EXPECT() *MockDrafts_Expecter {
+	return &MockDrafts_Expecter{mock: &_m.Mock}
+}
+
+// GetDraft provides a mock function for the type MockDrafts
+func (_mock *MockDrafts) GetDraft(ctx context.Context, note *entities.Note) (*entities.Draft, error) {
+	ret := _mock.Called(ctx, note)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDraft")
+	}
+
+	var r0 *entities.Draft
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *entities.Note) (*entities.Draft, error)); ok {
+		return returnFunc(ctx, note)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *entities.Note) *entities.Draft); ok {
+		r0 = returnFunc(ctx, note)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.Draft)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *entities.Note) error); ok {
+		r1 = returnFunc(ctx, note)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockDrafts_GetDraft_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDraft'
+type MockDrafts_GetDraft_Call struct {
+	*mock.Call
+}
+
+// GetDraft is a helper method to define mock.On call
+//   - ctx context.Context
+//   - note *entities.Note
+func (_e *MockDrafts_Expecter) GetDraft(ctx interface{}, note interface{}) *MockDrafts_GetDraft_Call {
+	return &MockDrafts_GetDraft_Call{Call: _e.mock.On("GetDraft", ctx, note)}
+}
+
+func (_c *MockDrafts_GetDraft_Call) Run(run func(ctx context.Context, note *entities.Note)) *MockDrafts_GetDraft_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *entities.Note
+		if args[1] != nil {
+			arg1 = args[1].(*entities.Note)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockDrafts_GetDraft_Call) Return(draft *entities.Draft, err error) *MockDrafts_GetDraft_Call {
+	_c.Call.Return(draft, err)
+	return _c
+}
+
+func (_c *MockDrafts_GetDraft_Call) RunAndReturn(run func(ctx context.Context, note *entities.Note) (*entities.Draft, error)) *MockDrafts_GetDraft_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetEdits provides a mock function for the type MockDrafts
+func (_mock *MockDrafts) GetEdits(ctx context.Context, draft *entities.Draft, after int64) ([]*entities.Edit, error) {
+	ret := _mock.Called(ctx, draft, after)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetEdits")
+	}
+
+	var r0 []*entities.Edit
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *entities.Draft, int64) ([]*entities.Edit, error)); ok {
+		return returnFunc(ctx, draft, after)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *entities.Draft, int64) []*entities.Edit); ok {
+		r0 = returnFunc(ctx, draft, after)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.Edit)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *entities.Draft, int64) error); ok {
+		r1 = returnFunc(ctx, draft, after)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockDrafts_GetEdits_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetEdits'
+type MockDrafts_GetEdits_Call struct {
+	*mock.Call
+}
+
+// GetEdits is a helper method to define mock.On call
+//   - ctx context.Context
+//   - draft *entities.Draft
+//   - after int64
+func (_e *MockDrafts_Expecter) GetEdits(ctx interface{}, draft interface{}, after interface{}) *MockDrafts_GetEdits_Call {
+	return &MockDrafts_GetEdits_Call{Call: _e.mock.On("GetEdits", ctx, draft, after)}
+}
+
+func (_c *MockDrafts_GetEdits_Call) Run(run func(ctx context.Context, draft *entities.Draft, after int64)) *MockDrafts_GetEdits_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *entities.Draft
+		if args[1] != nil {
+			arg1 = args[1].(*entities.Draft)
+		}
+		var arg2 int64
+		if args[2] != nil {
+			arg2 = args[2].(int64)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockDrafts_GetEdits_Call) Return(edits []*entities.Edit, err error) *MockDrafts_GetEdits_Call {
+	_c.Call.Return(edits, err)
+	return _c
+}
+
+func (_c *MockDrafts_GetEdits_Call) RunAndReturn(run func(ctx context.Context, draft *entities.Draft, after int64) ([]*entities.Edit, error)) *MockDrafts_GetEdits_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MarkSaved provides a mock function for the type MockDrafts
+func (_mock *MockDrafts) MarkSaved(ctx context.Context, draft *entities.Draft) error {
+	ret := _mock.Called(ctx, draft)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkSaved")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *entities.Draft) error); ok {
+		r0 = returnFunc(ctx, draft)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockDrafts_MarkSaved_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkSaved'
+type MockDrafts_MarkSaved_Call struct {
+	*mock.Call
+}
+
+// MarkSaved is a helper method to define mock.On call
+//   - ctx context.Context
+//   - draft *entities.Draft
+func (_e *MockDrafts_Expecter) MarkSaved(ctx interface{}, draft interface{}) *MockDrafts_MarkSaved_Call {
+	return &MockDrafts_MarkSaved_Call{Call: _e.mock.On("MarkSaved", ctx, draft)}
+}
+
+func (_c *MockDrafts_MarkSaved_Call) Run(run func(ctx context.Context, draft *entities.Draft)) *MockDrafts_MarkSaved_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *entities.Draft
+		if args[1] != nil {
+			arg1 = args[1].(*entities.Draft)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockDrafts_MarkSaved_Call) Return(err error) *MockDrafts_MarkSaved_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockDrafts_MarkSaved_Call) RunAndReturn(run func(ctx context.Context, draft *entities.Draft) error) *MockDrafts_MarkSaved_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// OpenDraft provides a mock function for the type MockDrafts
+func (_mock *MockDrafts) OpenDraft(ctx context.Context, note *entities.Note) (*entities.Draft, error) {
+	ret := _mock.Called(ctx, note)
+
+	if len(ret) == 0 {
+		panic("no return value specified for OpenDraft")
+	}
+
+	var r0 *entities.Draft
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *entities.Note) (*entities.Draft, error)); ok {
+		return returnFunc(ctx, note)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *entities.Note) *entities.Draft); ok {
+		r0 = returnFunc(ctx, note)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.Draft)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *entities.Note) error); ok {
+		r1 = returnFunc(ctx, note)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockDrafts_OpenDraft_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'OpenDraft'
+type MockDrafts_OpenDraft_Call struct {
+	*mock.Call
+}
+
+// OpenDraft is a helper method to define mock.On call
+//   - ctx context.Context
+//   - note *entities.Note
+func (_e *MockDrafts_Expecter) OpenDraft(ctx interface{}, note interface{}) *MockDrafts_OpenDraft_Call {
+	return &MockDrafts_OpenDraft_Call{Call: _e.mock.On("OpenDraft", ctx, note)}
+}
+
+func (_c *MockDrafts_OpenDraft_Call) Run(run func(ctx context.Context, note *entities.Note)) *MockDrafts_OpenDraft_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *entities.Note
+		if args[1] != nil {
+			arg1 = args[1].(*entities.Note)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockDrafts_OpenDraft_Call) Return(draft *entities.Draft, err error) *MockDrafts_OpenDraft_Call {
+	_c.Call.Return(draft, err)
+	return _c
+}
+
+func (_c *MockDrafts_OpenDraft_Call) RunAndReturn(run func(ctx context.Context, note *entities.Note) (*entities.Draft, error)) *MockDrafts_OpenDraft_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SaveEdit provides a mock function for the type MockDrafts
+func (_mock *MockDrafts) SaveEdit(ctx context.Context, draft *entities.Draft, edit *entities.Edit) error {
+	ret := _mock.Called(ctx, draft, edit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveEdit")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *entities.Draft, *entities.Edit) error); ok {
+		r0 = returnFunc(ctx, draft, edit)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockDrafts_SaveEdit_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveEdit'
+type MockDrafts_SaveEdit_Call struct {
+	*mock.Call
+}
+
+// SaveEdit is a helper method to define mock.On call
+//   - ctx context.Context
+//   - draft *entities.Draft
+//   - edit *entities.Edit
+func (_e *MockDrafts_Expecter) SaveEdit(ctx interface{}, draft interface{}, edit interface{}) *MockDrafts_SaveEdit_Call {
+	return &MockDrafts_SaveEdit_Call{Call: _e.mock.On("SaveEdit", ctx, draft, edit)}
+}
+
+func (_c *MockDrafts_SaveEdit_Call) Run(run func(ctx context.Context, draft *entities.Draft, edit *entities.Edit)) *MockDrafts_SaveEdit_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *entities.Draft
+		if args[1] != nil {
+			arg1 = args[1].(*entities.Draft)
+		}
+		var arg2 *entities.Edit
+		if args[2] != nil {
+			arg2 = args[2].(*entities.Edit)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockDrafts_SaveEdit_Call) Return(err error) *MockDrafts_SaveEdit_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockDrafts_SaveEdit_Call) RunAndReturn(run func(ctx context.Context, draft *entities.Draft, edit *entities.Edit) error) *MockDrafts_SaveEdit_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SendCursor provides a mock function for the type MockDrafts
+func (_mock *MockDrafts) SendCursor(ctx context.Context, note *entities.Note, edit *entities.Edit) error {
+	ret := _mock.Called(ctx, note, edit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SendCursor")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *entities.Note, *entities.Edit) error); ok {
+		r0 = returnFunc(ctx, note, edit)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockDrafts_SendCursor_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SendCursor'
+type MockDrafts_SendCursor_Call struct {
+	*mock.Call
+}
+
+// SendCursor is a helper method to define mock.On call
+//   - ctx context.Context
+//   - note *entities.Note
+//   - edit *entities.Edit
+func (_e *MockDrafts_Expecter) SendCursor(ctx interface{}, note interface{}, edit interface{}) *MockDrafts_SendCursor_Call {
+	return &MockDrafts_SendCursor_Call{Call: _e.mock.On("SendCursor", ctx, note, edit)}
+}
+
+func (_c *MockDrafts_SendCursor_Call) Run(run func(ctx context.Context, note *entities.Note, edit *entities.Edit)) *MockDrafts_SendCursor_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *entities.Note
+		if args[1] != nil {
+			arg1 = args[1].(*entities.Note)
+		}
+		var arg2 *entities.Edit
+		if args[2] != nil {
+			arg2 = args[2].(*entities.Edit)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockDrafts_SendCursor_Call) Return(err error) *MockDrafts_SendCursor_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockDrafts_SendCursor_Call) RunAndReturn(run func(ctx context.Context, note *entities.Note, edit *entities.Edit) error) *MockDrafts_SendCursor_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// WaitEdits provides a mock function for the type MockDrafts
+func (_mock *MockDrafts) WaitEdits(ctx context.Context, note *entities.Note, position string, timeout time.Duration) ([]*entities.Edit, error) {
+	ret := _mock.Called(ctx, note, position, timeout)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WaitEdits")
+	}
+
+	var r0 []*entities.Edit
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *entities.Note, string, time.Duration) ([]*entities.Edit, error)); ok {
+		return returnFunc(ctx, note, position, timeout)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *entities.Note, string, time.Duration) []*entities.Edit); ok {
+		r0 = returnFunc(ctx, note, position, timeout)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.Edit)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *entities.Note, string, time.Duration) error); ok {
+		r1 = returnFunc(ctx, note, position, timeout)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockDrafts_WaitEdits_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WaitEdits'
+type MockDrafts_WaitEdits_Call struct {
+	*mock.Call
+}
+
+// WaitEdits is a helper method to define mock.On call
+//   - ctx context.Context
+//   - note *entities.Note
+//   - position string
+//   - timeout time.Duration
+func (_e *MockDrafts_Expecter) WaitEdits(ctx interface{}, note interface{}, position interface{}, timeout interface{}) *MockDrafts_WaitEdits_Call {
+	return &MockDrafts_WaitEdits_Call{Call: _e.mock.On("WaitEdits", ctx, note, position, timeout)}
+}
+
+func (_c *MockDrafts_WaitEdits_Call) Run(run func(ctx context.Context, note *entities.Note, position string, timeout time.Duration)) *MockDrafts_WaitEdits_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *entities.Note
+		if args[1] != nil {
+			arg1 = args[1].(*entities.Note)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 time.Duration
+		if args[3] != nil {
+			arg3 = args[3].(time.Duration)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockDrafts_WaitEdits_Call) Return(edits []*entities.Edit, err error) *MockDrafts_WaitEdits_Call {
+	_c.Call.Return(edits, err)
+	return _c
+}
+
+func (_c *MockDrafts_WaitEdits_Call) RunAndReturn(run func(ctx context.Context, note *entities.Note, position string, timeout time.Duration) ([]*entities.Edit, error)) *MockDrafts_WaitEdits_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -6,9 +6,12 @@ package mocks
 
 import (
 	"context"
+	"time"
 
 	mock "github.com/stretchr/testify/mock"
+	"github.com/therenotomorrow/gotes/internal/api/notes/v1/ports"
 	"github.com/therenotomorrow/gotes/internal/domain/entities"
+	"github.com/therenotomorrow/gotes/internal/domain/types/uuid"
 )
 
 // NewMockEventsRepository creates a new instance of MockEventsRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
@@ -38,45 +41,112 @@ func (_m *MockEventsRepository) EXPECT() *MockEventsRepository_Expecter {
 	return &MockEventsRepository_Expecter{mock: &_m.Mock}
 }
 
-// CountEvents provides a mock function for the type MockEventsRepository
-func (_mock *MockEventsRepository) CountEvents(ctx context.Context, user *entities.User) (int32, error) {
-	ret := _mock.Called(ctx, user)
+// Backlog provides a mock function for the type MockEventsRepository
+func (_mock *MockEventsRepository) Backlog(ctx context.Context) (int64, time.Time, error) {
+	ret := _mock.Called(ctx)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CountEvents")
+		panic("no return value specified for Backlog")
+	}
+
+	var r0 int64
+	var r1 time.Time
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) (int64, time.Time, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) int64); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) time.Time); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Get(1).(time.Time)
+	}
+	if returnFunc, ok := ret.Get(2).(func(context.Context) error); ok {
+		r2 = returnFunc(ctx)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// MockEventsRepository_Backlog_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Backlog'
+type MockEventsRepository_Backlog_Call struct {
+	*mock.Call
+}
+
+// Backlog is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockEventsRepository_Expecter) Backlog(ctx interface{}) *MockEventsRepository_Backlog_Call {
+	return &MockEventsRepository_Backlog_Call{Call: _e.mock.On("Backlog", ctx)}
+}
+
+func (_c *MockEventsRepository_Backlog_Call) Run(run func(ctx context.Context)) *MockEventsRepository_Backlog_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockEventsRepository_Backlog_Call) Return(n int64, time1 time.Time, err error) *MockEventsRepository_Backlog_Call {
+	_c.Call.Return(n, time1, err)
+	return _c
+}
+
+func (_c *MockEventsRepository_Backlog_Call) RunAndReturn(run func(ctx context.Context) (int64, time.Time, error)) *MockEventsRepository_Backlog_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CountUnreadEvents provides a mock function for the type MockEventsRepository
+func (_mock *MockEventsRepository) CountUnreadEvents(ctx context.Context, user *entities.User, filter *ports.EventsFilter) (int32, error) {
+	ret := _mock.Called(ctx, user, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountUnreadEvents")
 	}
 
 	var r0 int32
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *entities.User) (int32, error)); ok {
-		return returnFunc(ctx, user)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *entities.User, *ports.EventsFilter) (int32, error)); ok {
+		return returnFunc(ctx, user, filter)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *entities.User) int32); ok {
-		r0 = returnFunc(ctx, user)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *entities.User, *ports.EventsFilter) int32); ok {
+		r0 = returnFunc(ctx, user, filter)
 	} else {
 		r0 = ret.Get(0).(int32)
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, *entities.User) error); ok {
-		r1 = returnFunc(ctx, user)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *entities.User, *ports.EventsFilter) error); ok {
+		r1 = returnFunc(ctx, user, filter)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockEventsRepository_CountEvents_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountEvents'
-type MockEventsRepository_CountEvents_Call struct {
+// MockEventsRepository_CountUnreadEvents_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountUnreadEvents'
+type MockEventsRepository_CountUnreadEvents_Call struct {
 	*mock.Call
 }
 
-// CountEvents is a helper method to define mock.On call
+// CountUnreadEvents is a helper method to define mock.On call
 //   - ctx context.Context
 //   - user *entities.User
-func (_e *MockEventsRepository_Expecter) CountEvents(ctx interface{}, user interface{}) *MockEventsRepository_CountEvents_Call {
-	return &MockEventsRepository_CountEvents_Call{Call: _e.mock.On("CountEvents", ctx, user)}
+//   - filter *ports.EventsFilter
+func (_e *MockEventsRepository_Expecter) CountUnreadEvents(ctx interface{}, user interface{}, filter interface{}) *MockEventsRepository_CountUnreadEvents_Call {
+	return &MockEventsRepository_CountUnreadEvents_Call{Call: _e.mock.On("CountUnreadEvents", ctx, user, filter)}
 }
 
-func (_c *MockEventsRepository_CountEvents_Call) Run(run func(ctx context.Context, user *entities.User)) *MockEventsRepository_CountEvents_Call {
+func (_c *MockEventsRepository_CountUnreadEvents_Call) Run(run func(ctx context.Context, user *entities.User, filter *ports.EventsFilter)) *MockEventsRepository_CountUnreadEvents_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -86,65 +156,128 @@ func (_c *MockEventsRepository_CountEvents_Call) Run(run func(ctx context.Contex
 		if args[1] != nil {
 			arg1 = args[1].(*entities.User)
 		}
+		var arg2 *ports.EventsFilter
+		if args[2] != nil {
+			arg2 = args[2].(*ports.EventsFilter)
+		}
 		run(
 			arg0,
 			arg1,
+			arg2,
 		)
 	})
 	return _c
 }
 
-func (_c *MockEventsRepository_CountEvents_Call) Return(n int32, err error) *MockEventsRepository_CountEvents_Call {
+func (_c *MockEventsRepository_CountUnreadEvents_Call) Return(n int32, err error) *MockEventsRepository_CountUnreadEvents_Call {
 	_c.Call.Return(n, err)
 	return _c
 }
 
-func (_c *MockEventsRepository_CountEvents_Call) RunAndReturn(run func(ctx context.Context, user *entities.User) (int32, error)) *MockEventsRepository_CountEvents_Call {
+func (_c *MockEventsRepository_CountUnreadEvents_Call) RunAndReturn(run func(ctx context.Context, user *entities.User, filter *ports.EventsFilter) (int32, error)) *MockEventsRepository_CountUnreadEvents_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetEvent provides a mock function for the type MockEventsRepository
-func (_mock *MockEventsRepository) GetEvent(ctx context.Context, user *entities.User) (*entities.Event, error) {
-	ret := _mock.Called(ctx, user)
+// DeleteEvents provides a mock function for the type MockEventsRepository
+func (_mock *MockEventsRepository) DeleteEvents(ctx context.Context, events []*entities.Event) error {
+	ret := _mock.Called(ctx, events)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetEvent")
+		panic("no return value specified for DeleteEvents")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []*entities.Event) error); ok {
+		r0 = returnFunc(ctx, events)
+	} else {
+		r0 = ret.Error(0)
 	}
+	return r0
+}
+
+// MockEventsRepository_DeleteEvents_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteEvents'
+type MockEventsRepository_DeleteEvents_Call struct {
+	*mock.Call
+}
 
-	var r0 *entities.Event
+// DeleteEvents is a helper method to define mock.On call
+//   - ctx context.Context
+//   - events []*entities.Event
+func (_e *MockEventsRepository_Expecter) DeleteEvents(ctx interface{}, events interface{}) *MockEventsRepository_DeleteEvents_Call {
+	return &MockEventsRepository_DeleteEvents_Call{Call: _e.mock.On("DeleteEvents", ctx, events)}
+}
+
+func (_c *MockEventsRepository_DeleteEvents_Call) Run(run func(ctx context.Context, events []*entities.Event)) *MockEventsRepository_DeleteEvents_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 []*entities.Event
+		if args[1] != nil {
+			arg1 = args[1].([]*entities.Event)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockEventsRepository_DeleteEvents_Call) Return(err error) *MockEventsRepository_DeleteEvents_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockEventsRepository_DeleteEvents_Call) RunAndReturn(run func(ctx context.Context, events []*entities.Event) error) *MockEventsRepository_DeleteEvents_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetEventsByUser provides a mock function for the type MockEventsRepository
+func (_mock *MockEventsRepository) GetEventsByUser(ctx context.Context, user *entities.User, query *ports.EventsQuery) ([]*entities.Event, error) {
+	ret := _mock.Called(ctx, user, query)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetEventsByUser")
+	}
+
+	var r0 []*entities.Event
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *entities.User) (*entities.Event, error)); ok {
-		return returnFunc(ctx, user)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *entities.User, *ports.EventsQuery) ([]*entities.Event, error)); ok {
+		return returnFunc(ctx, user, query)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *entities.User) *entities.Event); ok {
-		r0 = returnFunc(ctx, user)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *entities.User, *ports.EventsQuery) []*entities.Event); ok {
+		r0 = returnFunc(ctx, user, query)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*entities.Event)
+			r0 = ret.Get(0).([]*entities.Event)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, *entities.User) error); ok {
-		r1 = returnFunc(ctx, user)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *entities.User, *ports.EventsQuery) error); ok {
+		r1 = returnFunc(ctx, user, query)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockEventsRepository_GetEvent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetEvent'
-type MockEventsRepository_GetEvent_Call struct {
+// MockEventsRepository_GetEventsByUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetEventsByUser'
+type MockEventsRepository_GetEventsByUser_Call struct {
 	*mock.Call
 }
 
-// GetEvent is a helper method to define mock.On call
+// GetEventsByUser is a helper method to define mock.On call
 //   - ctx context.Context
 //   - user *entities.User
-func (_e *MockEventsRepository_Expecter) GetEvent(ctx interface{}, user interface{}) *MockEventsRepository_GetEvent_Call {
-	return &MockEventsRepository_GetEvent_Call{Call: _e.mock.On("GetEvent", ctx, user)}
+//   - query *ports.EventsQuery
+func (_e *MockEventsRepository_Expecter) GetEventsByUser(ctx interface{}, user interface{}, query interface{}) *MockEventsRepository_GetEventsByUser_Call {
+	return &MockEventsRepository_GetEventsByUser_Call{Call: _e.mock.On("GetEventsByUser", ctx, user, query)}
 }
 
-func (_c *MockEventsRepository_GetEvent_Call) Run(run func(ctx context.Context, user *entities.User)) *MockEventsRepository_GetEvent_Call {
+func (_c *MockEventsRepository_GetEventsByUser_Call) Run(run func(ctx context.Context, user *entities.User, query *ports.EventsQuery)) *MockEventsRepository_GetEventsByUser_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -154,6 +287,79 @@ func (_c *MockEventsRepository_GetEvent_Call) Run(run func(ctx context.Context,
 		if args[1] != nil {
 			arg1 = args[1].(*entities.User)
 		}
+		var arg2 *ports.EventsQuery
+		if args[2] != nil {
+			arg2 = args[2].(*ports.EventsQuery)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockEventsRepository_GetEventsByUser_Call) Return(events []*entities.Event, err error) *MockEventsRepository_GetEventsByUser_Call {
+	_c.Call.Return(events, err)
+	return _c
+}
+
+func (_c *MockEventsRepository_GetEventsByUser_Call) RunAndReturn(run func(ctx context.Context, user *entities.User, query *ports.EventsQuery) ([]*entities.Event, error)) *MockEventsRepository_GetEventsByUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LockEvents provides a mock function for the type MockEventsRepository
+func (_mock *MockEventsRepository) LockEvents(ctx context.Context, limit int) ([]*entities.Event, error) {
+	ret := _mock.Called(ctx, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LockEvents")
+	}
+
+	var r0 []*entities.Event
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int) ([]*entities.Event, error)); ok {
+		return returnFunc(ctx, limit)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int) []*entities.Event); ok {
+		r0 = returnFunc(ctx, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.Event)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = returnFunc(ctx, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockEventsRepository_LockEvents_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LockEvents'
+type MockEventsRepository_LockEvents_Call struct {
+	*mock.Call
+}
+
+// LockEvents is a helper method to define mock.On call
+//   - ctx context.Context
+//   - limit int
+func (_e *MockEventsRepository_Expecter) LockEvents(ctx interface{}, limit interface{}) *MockEventsRepository_LockEvents_Call {
+	return &MockEventsRepository_LockEvents_Call{Call: _e.mock.On("LockEvents", ctx, limit)}
+}
+
+func (_c *MockEventsRepository_LockEvents_Call) Run(run func(ctx context.Context, limit int)) *MockEventsRepository_LockEvents_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int
+		if args[1] != nil {
+			arg1 = args[1].(int)
+		}
 		run(
 			arg0,
 			arg1,
@@ -162,12 +368,165 @@ func (_c *MockEventsRepository_GetEvent_Call) Run(run func(ctx context.Context,
 	return _c
 }
 
-func (_c *MockEventsRepository_GetEvent_Call) Return(event *entities.Event, err error) *MockEventsRepository_GetEvent_Call {
-	_c.Call.Return(event, err)
+func (_c *MockEventsRepository_LockEvents_Call) Return(events []*entities.Event, err error) *MockEventsRepository_LockEvents_Call {
+	_c.Call.Return(events, err)
 	return _c
 }
 
-func (_c *MockEventsRepository_GetEvent_Call) RunAndReturn(run func(ctx context.Context, user *entities.User) (*entities.Event, error)) *MockEventsRepository_GetEvent_Call {
+func (_c *MockEventsRepository_LockEvents_Call) RunAndReturn(run func(ctx context.Context, limit int) ([]*entities.Event, error)) *MockEventsRepository_LockEvents_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MarkEventsRead provides a mock function for the type MockEventsRepository
+func (_mock *MockEventsRepository) MarkEventsRead(ctx context.Context, user *entities.User, ids []uuid.UUID, upTo *time.Time) (int32, error) {
+	ret := _mock.Called(ctx, user, ids, upTo)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkEventsRead")
+	}
+
+	var r0 int32
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *entities.User, []uuid.UUID, *time.Time) (int32, error)); ok {
+		return returnFunc(ctx, user, ids, upTo)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *entities.User, []uuid.UUID, *time.Time) int32); ok {
+		r0 = returnFunc(ctx, user, ids, upTo)
+	} else {
+		r0 = ret.Get(0).(int32)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *entities.User, []uuid.UUID, *time.Time) error); ok {
+		r1 = returnFunc(ctx, user, ids, upTo)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockEventsRepository_MarkEventsRead_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkEventsRead'
+type MockEventsRepository_MarkEventsRead_Call struct {
+	*mock.Call
+}
+
+// MarkEventsRead is a helper method to define mock.On call
+//   - ctx context.Context
+//   - user *entities.User
+//   - ids []uuid.UUID
+//   - upTo *time.Time
+func (_e *MockEventsRepository_Expecter) MarkEventsRead(ctx interface{}, user interface{}, ids interface{}, upTo interface{}) *MockEventsRepository_MarkEventsRead_Call {
+	return &MockEventsRepository_MarkEventsRead_Call{Call: _e.mock.On("MarkEventsRead", ctx, user, ids, upTo)}
+}
+
+func (_c *MockEventsRepository_MarkEventsRead_Call) Run(run func(ctx context.Context, user *entities.User, ids []uuid.UUID, upTo *time.Time)) *MockEventsRepository_MarkEventsRead_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *entities.User
+		if args[1] != nil {
+			arg1 = args[1].(*entities.User)
+		}
+		var arg2 []uuid.UUID
+		if args[2] != nil {
+			arg2 = args[2].([]uuid.UUID)
+		}
+		var arg3 *time.Time
+		if args[3] != nil {
+			arg3 = args[3].(*time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockEventsRepository_MarkEventsRead_Call) Return(n int32, err error) *MockEventsRepository_MarkEventsRead_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockEventsRepository_MarkEventsRead_Call) RunAndReturn(run func(ctx context.Context, user *entities.User, ids []uuid.UUID, upTo *time.Time) (int32, error)) *MockEventsRepository_MarkEventsRead_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PostponeEvents provides a mock function for the type MockEventsRepository
+func (_mock *MockEventsRepository) PostponeEvents(ctx context.Context, events []*entities.Event, reason string, backoff time.Duration, maxBackoff time.Duration) error {
+	ret := _mock.Called(ctx, events, reason, backoff, maxBackoff)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PostponeEvents")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []*entities.Event, string, time.Duration, time.Duration) error); ok {
+		r0 = returnFunc(ctx, events, reason, backoff, maxBackoff)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockEventsRepository_PostponeEvents_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PostponeEvents'
+type MockEventsRepository_PostponeEvents_Call struct {
+	*mock.Call
+}
+
+// PostponeEvents is a helper method to define mock.On call
+//   - ctx context.Context
+//   - events []*entities.Event
+//   - reason string
+//   - backoff time.Duration
+//   - maxBackoff time.Duration
+func (_e *MockEventsRepository_Expecter) PostponeEvents(ctx interface{}, events interface{}, reason interface{}, backoff interface{}, maxBackoff interface{}) *MockEventsRepository_PostponeEvents_Call {
+	return &MockEventsRepository_PostponeEvents_Call{Call: _e.mock.On("PostponeEvents", ctx, events, reason, backoff, maxBackoff)}
+}
+
+func (_c *MockEventsRepository_PostponeEvents_Call) Run(run func(ctx context.Context, events []*entities.Event, reason string, backoff time.Duration, maxBackoff time.Duration)) *MockEventsRepository_PostponeEvents_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 []*entities.Event
+		if args[1] != nil {
+			arg1 = args[1].([]*entities.Event)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 time.Duration
+		if args[3] != nil {
+			arg3 = args[3].(time.Duration)
+		}
+		var arg4 time.Duration
+		if args[4] != nil {
+			arg4 = args[4].(time.Duration)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+			arg4,
+		)
+	})
+	return _c
+}
+
+func (_c *MockEventsRepository_PostponeEvents_Call) Return(err error) *MockEventsRepository_PostponeEvents_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockEventsRepository_PostponeEvents_Call) RunAndReturn(run func(ctx context.Context, events []*entities.Event, reason string, backoff time.Duration, maxBackoff time.Duration) error) *MockEventsRepository_PostponeEvents_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -228,3 +587,60 @@ func (_c *MockEventsRepository_SaveEvent_Call) RunAndReturn(run func(ctx context
 	_c.Call.Return(run)
 	return _c
 }
+
+// SaveEvents provides a mock function for the type MockEventsRepository
+func (_mock *MockEventsRepository) SaveEvents(ctx context.Context, events []*entities.Event) error {
+	ret := _mock.Called(ctx, events)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveEvents")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []*entities.Event) error); ok {
+		r0 = returnFunc(ctx, events)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockEventsRepository_SaveEvents_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveEvents'
+type MockEventsRepository_SaveEvents_Call struct {
+	*mock.Call
+}
+
+// SaveEvents is a helper method to define mock.On call
+//   - ctx context.Context
+//   - events []*entities.Event
+func (_e *MockEventsRepository_Expecter) SaveEvents(ctx interface{}, events interface{}) *MockEventsRepository_SaveEvents_Call {
+	return &MockEventsRepository_SaveEvents_Call{Call: _e.mock.On("SaveEvents", ctx, events)}
+}
+
+func (_c *MockEventsRepository_SaveEvents_Call) Run(run func(ctx context.Context, events []*entities.Event)) *MockEventsRepository_SaveEvents_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 []*entities.Event
+		if args[1] != nil {
+			arg1 = args[1].([]*entities.Event)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockEventsRepository_SaveEvents_Call) Return(err error) *MockEventsRepository_SaveEvents_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockEventsRepository_SaveEvents_Call) RunAndReturn(run func(ctx context.Context, events []*entities.Event) error) *MockEventsRepository_SaveEvents_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -0,0 +1,303 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"context"
+	"time"
+
+	mock "github.com/stretchr/testify/mock"
+	"github.com/therenotomorrow/gotes/internal/domain/entities"
+)
+
+// NewMockEventStream creates a new instance of MockEventStream. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockEventStream(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockEventStream {
+	mock := &MockEventStream{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockEventStream is an autogenerated mock type for the EventStream type
+type MockEventStream struct {
+	mock.Mock
+}
+
+type MockEventStream_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockEventStream) EXPECT() *MockEventStream_Expecter {
+	return &MockEventStream_Expecter{mock: &_m.Mock}
+}
+
+// AckEvent provides a mock function for the type MockEventStream
+func (_mock *MockEventStream) AckEvent(ctx context.Context, sub *entities.Subscription, event *entities.Event) error {
+	ret := _mock.Called(ctx, sub, event)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AckEvent")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *entities.Subscription, *entities.Event) error); ok {
+		r0 = returnFunc(ctx, sub, event)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockEventStream_AckEvent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AckEvent'
+type MockEventStream_AckEvent_Call struct {
+	*mock.Call
+}
+
+// AckEvent is a helper method to define mock.On call
+//   - ctx context.Context
+//   - sub *entities.Subscription
+//   - event *entities.Event
+func (_e *MockEventStream_Expecter) AckEvent(ctx interface{}, sub interface{}, event interface{}) *MockEventStream_AckEvent_Call {
+	return &MockEventStream_AckEvent_Call{Call: _e.mock.On("AckEvent", ctx, sub, event)}
+}
+
+func (_c *MockEventStream_AckEvent_Call) Run(run func(ctx context.Context, sub *entities.Subscription, event *entities.Event)) *MockEventStream_AckEvent_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *entities.Subscription
+		if args[1] != nil {
+			arg1 = args[1].(*entities.Subscription)
+		}
+		var arg2 *entities.Event
+		if args[2] != nil {
+			arg2 = args[2].(*entities.Event)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockEventStream_AckEvent_Call) Return(err error) *MockEventStream_AckEvent_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockEventStream_AckEvent_Call) RunAndReturn(run func(ctx context.Context, sub *entities.Subscription, event *entities.Event) error) *MockEventStream_AckEvent_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Publish provides a mock function for the type MockEventStream
+func (_mock *MockEventStream) Publish(ctx context.Context, events []*entities.Event) error {
+	ret := _mock.Called(ctx, events)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Publish")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []*entities.Event) error); ok {
+		r0 = returnFunc(ctx, events)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockEventStream_Publish_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Publish'
+type MockEventStream_Publish_Call struct {
+	*mock.Call
+}
+
+// Publish is a helper method to define mock.On call
+//   - ctx context.Context
+//   - events []*entities.Event
+func (_e *MockEventStream_Expecter) Publish(ctx interface{}, events interface{}) *MockEventStream_Publish_Call {
+	return &MockEventStream_Publish_Call{Call: _e.mock.On("Publish", ctx, events)}
+}
+
+func (_c *MockEventStream_Publish_Call) Run(run func(ctx context.Context, events []*entities.Event)) *MockEventStream_Publish_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 []*entities.Event
+		if args[1] != nil {
+			arg1 = args[1].([]*entities.Event)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockEventStream_Publish_Call) Return(err error) *MockEventStream_Publish_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockEventStream_Publish_Call) RunAndReturn(run func(ctx context.Context, events []*entities.Event) error) *MockEventStream_Publish_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Subscribe provides a mock function for the type MockEventStream
+func (_mock *MockEventStream) Subscribe(ctx context.Context, sub *entities.Subscription, position string) error {
+	ret := _mock.Called(ctx, sub, position)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Subscribe")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *entities.Subscription, string) error); ok {
+		r0 = returnFunc(ctx, sub, position)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockEventStream_Subscribe_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Subscribe'
+type MockEventStream_Subscribe_Call struct {
+	*mock.Call
+}
+
+// Subscribe is a helper method to define mock.On call
+//   - ctx context.Context
+//   - sub *entities.Subscription
+//   - position string
+func (_e *MockEventStream_Expecter) Subscribe(ctx interface{}, sub interface{}, position interface{}) *MockEventStream_Subscribe_Call {
+	return &MockEventStream_Subscribe_Call{Call: _e.mock.On("Subscribe", ctx, sub, position)}
+}
+
+func (_c *MockEventStream_Subscribe_Call) Run(run func(ctx context.Context, sub *entities.Subscription, position string)) *MockEventStream_Subscribe_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *entities.Subscription
+		if args[1] != nil {
+			arg1 = args[1].(*entities.Subscription)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockEventStream_Subscribe_Call) Return(err error) *MockEventStream_Subscribe_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockEventStream_Subscribe_Call) RunAndReturn(run func(ctx context.Context, sub *entities.Subscription, position string) error) *MockEventStream_Subscribe_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// WaitEvents provides a mock function for the type MockEventStream
+func (_mock *MockEventStream) WaitEvents(ctx context.Context, sub *entities.Subscription, limit int, timeout time.Duration) ([]*entities.Event, error) {
+	ret := _mock.Called(ctx, sub, limit, timeout)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WaitEvents")
+	}
+
+	var r0 []*entities.Event
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *entities.Subscription, int, time.Duration) ([]*entities.Event, error)); ok {
+		return returnFunc(ctx, sub, limit, timeout)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *entities.Subscription, int, time.Duration) []*entities.Event); ok {
+		r0 = returnFunc(ctx, sub, limit, timeout)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.Event)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *entities.Subscription, int, time.Duration) error); ok {
+		r1 = returnFunc(ctx, sub, limit, timeout)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockEventStream_WaitEvents_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WaitEvents'
+type MockEventStream_WaitEvents_Call struct {
+	*mock.Call
+}
+
+// WaitEvents is a helper method to define mock.On call
+//   - ctx context.Context
+//   - sub *entities.Subscription
+//   - limit int
+//   - timeout time.Duration
+func (_e *MockEventStream_Expecter) WaitEvents(ctx interface{}, sub interface{}, limit interface{}, timeout interface{}) *MockEventStream_WaitEvents_Call {
+	return &MockEventStream_WaitEvents_Call{Call: _e.mock.On("WaitEvents", ctx, sub, limit, timeout)}
+}
+
+func (_c *MockEventStream_WaitEvents_Call) Run(run func(ctx context.Context, sub *entities.Subscription, limit int, timeout time.Duration)) *MockEventStream_WaitEvents_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *entities.Subscription
+		if args[1] != nil {
+			arg1 = args[1].(*entities.Subscription)
+		}
+		var arg2 int
+		if args[2] != nil {
+			arg2 = args[2].(int)
+		}
+		var arg3 time.Duration
+		if args[3] != nil {
+			arg3 = args[3].(time.Duration)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockEventStream_WaitEvents_Call) Return(events []*entities.Event, err error) *MockEventStream_WaitEvents_Call {
+	_c.Call.Return(events, err)
+	return _c
+}
+
+func (_c *MockEventStream_WaitEvents_Call) RunAndReturn(run func(ctx context.Context, sub *entities.Subscription, limit int, timeout time.Duration) ([]*entities.Event, error)) *MockEventStream_WaitEvents_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -0,0 +1,357 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"context"
+
+	mock "github.com/stretchr/testify/mock"
+	"github.com/therenotomorrow/gotes/internal/domain/entities"
+)
+
+// NewMockLinksRepository creates a new instance of MockLinksRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockLinksRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockLinksRepository {
+	mock := &MockLinksRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockLinksRepository is an autogenerated mock type for the LinksRepository type
+type MockLinksRepository struct {
+	mock.Mock
+}
+
+type MockLinksRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockLinksRepository) EXPECT() *MockLinksRepository_Expecter {
+	return &MockLinksRepository_Expecter{mock: &_m.Mock}
+}
+
+// CountView provides a mock function for the type MockLinksRepository
+func (_mock *MockLinksRepository) CountView(ctx context.Context, link *entities.Link) error {
+	ret := _mock.Called(ctx, link)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountView")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *entities.Link) error); ok {
+		r0 = returnFunc(ctx, link)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockLinksRepository_CountView_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountView'
+type MockLinksRepository_CountView_Call struct {
+	*mock.Call
+}
+
+// CountView is a helper method to define mock.On call
+//   - ctx context.Context
+//   - link *entities.Link
+func (_e *MockLinksRepository_Expecter) CountView(ctx interface{}, link interface{}) *MockLinksRepository_CountView_Call {
+	return &MockLinksRepository_CountView_Call{Call: _e.mock.On("CountView", ctx, link)}
+}
+
+func (_c *MockLinksRepository_CountView_Call) Run(run func(ctx context.Context, link *entities.Link)) *MockLinksRepository_CountView_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *entities.Link
+		if args[1] != nil {
+			arg1 = args[1].(*entities.Link)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockLinksRepository_CountView_Call) Return(err error) *MockLinksRepository_CountView_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockLinksRepository_CountView_Call) RunAndReturn(run func(ctx context.Context, link *entities.Link) error) *MockLinksRepository_CountView_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteLink provides a mock function for the type MockLinksRepository
+func (_mock *MockLinksRepository) DeleteLink(ctx context.Context, link *entities.Link) error {
+	ret := _mock.Called(ctx, link)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteLink")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *entities.Link) error); ok {
+		r0 = returnFunc(ctx, link)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockLinksRepository_DeleteLink_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteLink'
+type MockLinksRepository_DeleteLink_Call struct {
+	*mock.Call
+}
+
+// DeleteLink is a helper method to define mock.On call
+//   - ctx context.Context
+//   - link *entities.Link
+func (_e *MockLinksRepository_Expecter) DeleteLink(ctx interface{}, link interface{}) *MockLinksRepository_DeleteLink_Call {
+	return &MockLinksRepository_DeleteLink_Call{Call: _e.mock.On("DeleteLink", ctx, link)}
+}
+
+func (_c *MockLinksRepository_DeleteLink_Call) Run(run func(ctx context.Context, link *entities.Link)) *MockLinksRepository_DeleteLink_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *entities.Link
+		if args[1] != nil {
+			arg1 = args[1].(*entities.Link)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockLinksRepository_DeleteLink_Call) Return(err error) *MockLinksRepository_DeleteLink_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockLinksRepository_DeleteLink_Call) RunAndReturn(run func(ctx context.Context, link *entities.Link) error) *MockLinksRepository_DeleteLink_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetLink provides a mock function for the type MockLinksRepository
+func (_mock *MockLinksRepository) GetLink(ctx context.Context, token string) (*entities.Link, error) {
+	ret := _mock.Called(ctx, token)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLink")
+	}
+
+	var r0 *entities.Link
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*entities.Link, error)); ok {
+		return returnFunc(ctx, token)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *entities.Link); ok {
+		r0 = returnFunc(ctx, token)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.Link)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, token)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockLinksRepository_GetLink_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLink'
+type MockLinksRepository_GetLink_Call struct {
+	*mock.Call
+}
+
+// GetLink is a helper method to define mock.On call
+//   - ctx context.Context
+//   - token string
+func (_e *MockLinksRepository_Expecter) GetLink(ctx interface{}, token interface{}) *MockLinksRepository_GetLink_Call {
+	return &MockLinksRepository_GetLink_Call{Call: _e.mock.On("GetLink", ctx, token)}
+}
+
+func (_c *MockLinksRepository_GetLink_Call) Run(run func(ctx context.Context, token string)) *MockLinksRepository_GetLink_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockLinksRepository_GetLink_Call) Return(link *entities.Link, err error) *MockLinksRepository_GetLink_Call {
+	_c.Call.Return(link, err)
+	return _c
+}
+
+func (_c *MockLinksRepository_GetLink_Call) RunAndReturn(run func(ctx context.Context, token string) (*entities.Link, error)) *MockLinksRepository_GetLink_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetLinksByNote provides a mock function for the type MockLinksRepository
+func (_mock *MockLinksRepository) GetLinksByNote(ctx context.Context, note *entities.Note) ([]*entities.Link, error) {
+	ret := _mock.Called(ctx, note)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLinksByNote")
+	}
+
+	var r0 []*entities.Link
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *entities.Note) ([]*entities.Link, error)); ok {
+		return returnFunc(ctx, note)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *entities.Note) []*entities.Link); ok {
+		r0 = returnFunc(ctx, note)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.Link)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *entities.Note) error); ok {
+		r1 = returnFunc(ctx, note)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockLinksRepository_GetLinksByNote_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLinksByNote'
+type MockLinksRepository_GetLinksByNote_Call struct {
+	*mock.Call
+}
+
+// GetLinksByNote is a helper method to define mock.On call
+//   - ctx context.Context
+//   - note *entities.Note
+func (_e *MockLinksRepository_Expecter) GetLinksByNote(ctx interface{}, note interface{}) *MockLinksRepository_GetLinksByNote_Call {
+	return &MockLinksRepository_GetLinksByNote_Call{Call: _e.mock.On("GetLinksByNote", ctx, note)}
+}
+
+func (_c *MockLinksRepository_GetLinksByNote_Call) Run(run func(ctx context.Context, note *entities.Note)) *MockLinksRepository_GetLinksByNote_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *entities.Note
+		if args[1] != nil {
+			arg1 = args[1].(*entities.Note)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockLinksRepository_GetLinksByNote_Call) Return(links []*entities.Link, err error) *MockLinksRepository_GetLinksByNote_Call {
+	_c.Call.Return(links, err)
+	return _c
+}
+
+func (_c *MockLinksRepository_GetLinksByNote_Call) RunAndReturn(run func(ctx context.Context, note *entities.Note) ([]*entities.Link, error)) *MockLinksRepository_GetLinksByNote_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SaveLink provides a mock function for the type MockLinksRepository
+func (_mock *MockLinksRepository) SaveLink(ctx context.Context, link *entities.Link) (*entities.Link, error) {
+	ret := _mock.Called(ctx, link)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveLink")
+	}
+
+	var r0 *entities.Link
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *entities.Link) (*entities.Link, error)); ok {
+		return returnFunc(ctx, link)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *entities.Link) *entities.Link); ok {
+		r0 = returnFunc(ctx, link)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.Link)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *entities.Link) error); ok {
+		r1 = returnFunc(ctx, link)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockLinksRepository_SaveLink_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveLink'
+type MockLinksRepository_SaveLink_Call struct {
+	*mock.Call
+}
+
+// SaveLink is a helper method to define mock.On call
+//   - ctx context.Context
+//   - link *entities.Link
+func (_e *MockLinksRepository_Expecter) SaveLink(ctx interface{}, link interface{}) *MockLinksRepository_SaveLink_Call {
+	return &MockLinksRepository_SaveLink_Call{Call: _e.mock.On("SaveLink", ctx, link)}
+}
+
+func (_c *MockLinksRepository_SaveLink_Call) Run(run func(ctx context.Context, link *entities.Link)) *MockLinksRepository_SaveLink_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *entities.Link
+		if args[1] != nil {
+			arg1 = args[1].(*entities.Link)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockLinksRepository_SaveLink_Call) Return(link1 *entities.Link, err error) *MockLinksRepository_SaveLink_Call {
+	_c.Call.Return(link1, err)
+	return _c
+}
+
+func (_c *MockLinksRepository_SaveLink_Call) RunAndReturn(run func(ctx context.Context, link *entities.Link) (*entities.Link, error)) *MockLinksRepository_SaveLink_Call {
+	_c.Call.Return(run)
+	return _c
+}
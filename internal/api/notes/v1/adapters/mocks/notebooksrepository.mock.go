@@ -0,0 +1,615 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"context"
+	"time"
+
+	mock "github.com/stretchr/testify/mock"
+	"github.com/therenotomorrow/gotes/internal/domain/entities"
+	"github.com/therenotomorrow/gotes/internal/domain/types/id"
+)
+
+// NewMockNotebooksRepository creates a new instance of MockNotebooksRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockNotebooksRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockNotebooksRepository {
+	mock := &MockNotebooksRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockNotebooksRepository is an autogenerated mock type for the NotebooksRepository type
+type MockNotebooksRepository struct {
+	mock.Mock
+}
+
+type MockNotebooksRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockNotebooksRepository) EXPECT() *MockNotebooksRepository_Expecter {
+	return &MockNotebooksRepository_Expecter{mock: &_m.Mock}
+}
+
+// DeleteNotebooks provides a mock function for the type MockNotebooksRepository
+func (_mock *MockNotebooksRepository) DeleteNotebooks(ctx context.Context, notebooks []*entities.Notebook) error {
+	ret := _mock.Called(ctx, notebooks)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteNotebooks")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []*entities.Notebook) error); ok {
+		r0 = returnFunc(ctx, notebooks)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockNotebooksRepository_DeleteNotebooks_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteNotebooks'
+type MockNotebooksRepository_DeleteNotebooks_Call struct {
+	*mock.Call
+}
+
+// DeleteNotebooks is a helper method to define mock.On call
+//   - ctx context.Context
+//   - notebooks []*entities.Notebook
+func (_e *MockNotebooksRepository_Expecter) DeleteNotebooks(ctx interface{}, notebooks interface{}) *MockNotebooksRepository_DeleteNotebooks_Call {
+	return &MockNotebooksRepository_DeleteNotebooks_Call{Call: _e.mock.On("DeleteNotebooks", ctx, notebooks)}
+}
+
+func (_c *MockNotebooksRepository_DeleteNotebooks_Call) Run(run func(ctx context.Context, notebooks []*entities.Notebook)) *MockNotebooksRepository_DeleteNotebooks_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 []*entities.Notebook
+		if args[1] != nil {
+			arg1 = args[1].([]*entities.Notebook)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockNotebooksRepository_DeleteNotebooks_Call) Return(err error) *MockNotebooksRepository_DeleteNotebooks_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockNotebooksRepository_DeleteNotebooks_Call) RunAndReturn(run func(ctx context.Context, notebooks []*entities.Notebook) error) *MockNotebooksRepository_DeleteNotebooks_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DetachNotebook provides a mock function for the type MockNotebooksRepository
+func (_mock *MockNotebooksRepository) DetachNotebook(ctx context.Context, notebook *entities.Notebook) error {
+	ret := _mock.Called(ctx, notebook)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DetachNotebook")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *entities.Notebook) error); ok {
+		r0 = returnFunc(ctx, notebook)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockNotebooksRepository_DetachNotebook_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DetachNotebook'
+type MockNotebooksRepository_DetachNotebook_Call struct {
+	*mock.Call
+}
+
+// DetachNotebook is a helper method to define mock.On call
+//   - ctx context.Context
+//   - notebook *entities.Notebook
+func (_e *MockNotebooksRepository_Expecter) DetachNotebook(ctx interface{}, notebook interface{}) *MockNotebooksRepository_DetachNotebook_Call {
+	return &MockNotebooksRepository_DetachNotebook_Call{Call: _e.mock.On("DetachNotebook", ctx, notebook)}
+}
+
+func (_c *MockNotebooksRepository_DetachNotebook_Call) Run(run func(ctx context.Context, notebook *entities.Notebook)) *MockNotebooksRepository_DetachNotebook_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *entities.Notebook
+		if args[1] != nil {
+			arg1 = args[1].(*entities.Notebook)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockNotebooksRepository_DetachNotebook_Call) Return(err error) *MockNotebooksRepository_DetachNotebook_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockNotebooksRepository_DetachNotebook_Call) RunAndReturn(run func(ctx context.Context, notebook *entities.Notebook) error) *MockNotebooksRepository_DetachNotebook_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDescendants provides a mock function for the type MockNotebooksRepository
+func (_mock *MockNotebooksRepository) GetDescendants(ctx context.Context, notebook *entities.Notebook) ([]*entities.Notebook, error) {
+	ret := _mock.Called(ctx, notebook)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDescendants")
+	}
+
+	var r0 []*entities.Notebook
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *entities.Notebook) ([]*entities.Notebook, error)); ok {
+		return returnFunc(ctx, notebook)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *entities.Notebook) []*entities.Notebook); ok {
+		r0 = returnFunc(ctx, notebook)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.Notebook)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *entities.Notebook) error); ok {
+		r1 = returnFunc(ctx, notebook)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockNotebooksRepository_GetDescendants_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDescendants'
+type MockNotebooksRepository_GetDescendants_Call struct {
+	*mock.Call
+}
+
+// GetDescendants is a helper method to define mock.On call
+//   - ctx context.Context
+//   - notebook *entities.Notebook
+func (_e *MockNotebooksRepository_Expecter) GetDescendants(ctx interface{}, notebook interface{}) *MockNotebooksRepository_GetDescendants_Call {
+	return &MockNotebooksRepository_GetDescendants_Call{Call: _e.mock.On("GetDescendants", ctx, notebook)}
+}
+
+func (_c *MockNotebooksRepository_GetDescendants_Call) Run(run func(ctx context.Context, notebook *entities.Notebook)) *MockNotebooksRepository_GetDescendants_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *entities.Notebook
+		if args[1] != nil {
+			arg1 = args[1].(*entities.Notebook)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockNotebooksRepository_GetDescendants_Call) Return(notebooks []*entities.Notebook, err error) *MockNotebooksRepository_GetDescendants_Call {
+	_c.Call.Return(notebooks, err)
+	return _c
+}
+
+func (_c *MockNotebooksRepository_GetDescendants_Call) RunAndReturn(run func(ctx context.Context, notebook *entities.Notebook) ([]*entities.Notebook, error)) *MockNotebooksRepository_GetDescendants_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetNotebook provides a mock function for the type MockNotebooksRepository
+func (_mock *MockNotebooksRepository) GetNotebook(ctx context.Context, id1 id.ID) (*entities.Notebook, error) {
+	ret := _mock.Called(ctx, id1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetNotebook")
+	}
+
+	var r0 *entities.Notebook
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, id.ID) (*entities.Notebook, error)); ok {
+		return returnFunc(ctx, id1)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, id.ID) *entities.Notebook); ok {
+		r0 = returnFunc(ctx, id1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.Notebook)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, id.ID) error); ok {
+		r1 = returnFunc(ctx, id1)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockNotebooksRepository_GetNotebook_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetNotebook'
+type MockNotebooksRepository_GetNotebook_Call struct {
+	*mock.Call
+}
+
+// GetNotebook is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id1 id.ID
+func (_e *MockNotebooksRepository_Expecter) GetNotebook(ctx interface{}, id1 interface{}) *MockNotebooksRepository_GetNotebook_Call {
+	return &MockNotebooksRepository_GetNotebook_Call{Call: _e.mock.On("GetNotebook", ctx, id1)}
+}
+
+func (_c *MockNotebooksRepository_GetNotebook_Call) Run(run func(ctx context.Context, id1 id.ID)) *MockNotebooksRepository_GetNotebook_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 id.ID
+		if args[1] != nil {
+			arg1 = args[1].(id.ID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockNotebooksRepository_GetNotebook_Call) Return(notebook *entities.Notebook, err error) *MockNotebooksRepository_GetNotebook_Call {
+	_c.Call.Return(notebook, err)
+	return _c
+}
+
+func (_c *MockNotebooksRepository_GetNotebook_Call) RunAndReturn(run func(ctx context.Context, id1 id.ID) (*entities.Notebook, error)) *MockNotebooksRepository_GetNotebook_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetNotebooksByUser provides a mock function for the type MockNotebooksRepository
+func (_mock *MockNotebooksRepository) GetNotebooksByUser(ctx context.Context, user *entities.User) ([]*entities.Notebook, error) {
+	ret := _mock.Called(ctx, user)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetNotebooksByUser")
+	}
+
+	var r0 []*entities.Notebook
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *entities.User) ([]*entities.Notebook, error)); ok {
+		return returnFunc(ctx, user)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *entities.User) []*entities.Notebook); ok {
+		r0 = returnFunc(ctx, user)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.Notebook)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *entities.User) error); ok {
+		r1 = returnFunc(ctx, user)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockNotebooksRepository_GetNotebooksByUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetNotebooksByUser'
+type MockNotebooksRepository_GetNotebooksByUser_Call struct {
+	*mock.Call
+}
+
+// GetNotebooksByUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - user *entities.User
+func (_e *MockNotebooksRepository_Expecter) GetNotebooksByUser(ctx interface{}, user interface{}) *MockNotebooksRepository_GetNotebooksByUser_Call {
+	return &MockNotebooksRepository_GetNotebooksByUser_Call{Call: _e.mock.On("GetNotebooksByUser", ctx, user)}
+}
+
+func (_c *MockNotebooksRepository_GetNotebooksByUser_Call) Run(run func(ctx context.Context, user *entities.User)) *MockNotebooksRepository_GetNotebooksByUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *entities.User
+		if args[1] != nil {
+			arg1 = args[1].(*entities.User)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockNotebooksRepository_GetNotebooksByUser_Call) Return(notebooks []*entities.Notebook, err error) *MockNotebooksRepository_GetNotebooksByUser_Call {
+	_c.Call.Return(notebooks, err)
+	return _c
+}
+
+func (_c *MockNotebooksRepository_GetNotebooksByUser_Call) RunAndReturn(run func(ctx context.Context, user *entities.User) ([]*entities.Notebook, error)) *MockNotebooksRepository_GetNotebooksByUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LockNotebooks provides a mock function for the type MockNotebooksRepository
+func (_mock *MockNotebooksRepository) LockNotebooks(ctx context.Context, user *entities.User) error {
+	ret := _mock.Called(ctx, user)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LockNotebooks")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *entities.User) error); ok {
+		r0 = returnFunc(ctx, user)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockNotebooksRepository_LockNotebooks_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LockNotebooks'
+type MockNotebooksRepository_LockNotebooks_Call struct {
+	*mock.Call
+}
+
+// LockNotebooks is a helper method to define mock.On call
+//   - ctx context.Context
+//   - user *entities.User
+func (_e *MockNotebooksRepository_Expecter) LockNotebooks(ctx interface{}, user interface{}) *MockNotebooksRepository_LockNotebooks_Call {
+	return &MockNotebooksRepository_LockNotebooks_Call{Call: _e.mock.On("LockNotebooks", ctx, user)}
+}
+
+func (_c *MockNotebooksRepository_LockNotebooks_Call) Run(run func(ctx context.Context, user *entities.User)) *MockNotebooksRepository_LockNotebooks_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *entities.User
+		if args[1] != nil {
+			arg1 = args[1].(*entities.User)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockNotebooksRepository_LockNotebooks_Call) Return(err error) *MockNotebooksRepository_LockNotebooks_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockNotebooksRepository_LockNotebooks_Call) RunAndReturn(run func(ctx context.Context, user *entities.User) error) *MockNotebooksRepository_LockNotebooks_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SaveNotebook provides a mock function for the type MockNotebooksRepository
+func (_mock *MockNotebooksRepository) SaveNotebook(ctx context.Context, notebook *entities.Notebook) (*entities.Notebook, error) {
+	ret := _mock.Called(ctx, notebook)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveNotebook")
+	}
+
+	var r0 *entities.Notebook
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *entities.Notebook) (*entities.Notebook, error)); ok {
+		return returnFunc(ctx, notebook)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *entities.Notebook) *entities.Notebook); ok {
+		r0 = returnFunc(ctx, notebook)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.Notebook)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *entities.Notebook) error); ok {
+		r1 = returnFunc(ctx, notebook)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockNotebooksRepository_SaveNotebook_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveNotebook'
+type MockNotebooksRepository_SaveNotebook_Call struct {
+	*mock.Call
+}
+
+// SaveNotebook is a helper method to define mock.On call
+//   - ctx context.Context
+//   - notebook *entities.Notebook
+func (_e *MockNotebooksRepository_Expecter) SaveNotebook(ctx interface{}, notebook interface{}) *MockNotebooksRepository_SaveNotebook_Call {
+	return &MockNotebooksRepository_SaveNotebook_Call{Call: _e.mock.On("SaveNotebook", ctx, notebook)}
+}
+
+func (_c *MockNotebooksRepository_SaveNotebook_Call) Run(run func(ctx context.Context, notebook *entities.Notebook)) *MockNotebooksRepository_SaveNotebook_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *entities.Notebook
+		if args[1] != nil {
+			arg1 = args[1].(*entities.Notebook)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockNotebooksRepository_SaveNotebook_Call) Return(notebook1 *entities.Notebook, err error) *MockNotebooksRepository_SaveNotebook_Call {
+	_c.Call.Return(notebook1, err)
+	return _c
+}
+
+func (_c *MockNotebooksRepository_SaveNotebook_Call) RunAndReturn(run func(ctx context.Context, notebook *entities.Notebook) (*entities.Notebook, error)) *MockNotebooksRepository_SaveNotebook_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// TrashNotes provides a mock function for the type MockNotebooksRepository
+func (_mock *MockNotebooksRepository) TrashNotes(ctx context.Context, notebooks []*entities.Notebook, at time.Time) ([]*entities.Note, error) {
+	ret := _mock.Called(ctx, notebooks, at)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TrashNotes")
+	}
+
+	var r0 []*entities.Note
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []*entities.Notebook, time.Time) ([]*entities.Note, error)); ok {
+		return returnFunc(ctx, notebooks, at)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []*entities.Notebook, time.Time) []*entities.Note); ok {
+		r0 = returnFunc(ctx, notebooks, at)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.Note)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, []*entities.Notebook, time.Time) error); ok {
+		r1 = returnFunc(ctx, notebooks, at)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockNotebooksRepository_TrashNotes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TrashNotes'
+type MockNotebooksRepository_TrashNotes_Call struct {
+	*mock.Call
+}
+
+// TrashNotes is a helper method to define mock.On call
+//   - ctx context.Context
+//   - notebooks []*entities.Notebook
+//   - at time.Time
+func (_e *MockNotebooksRepository_Expecter) TrashNotes(ctx interface{}, notebooks interface{}, at interface{}) *MockNotebooksRepository_TrashNotes_Call {
+	return &MockNotebooksRepository_TrashNotes_Call{Call: _e.mock.On("TrashNotes", ctx, notebooks, at)}
+}
+
+func (_c *MockNotebooksRepository_TrashNotes_Call) Run(run func(ctx context.Context, notebooks []*entities.Notebook, at time.Time)) *MockNotebooksRepository_TrashNotes_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 []*entities.Notebook
+		if args[1] != nil {
+			arg1 = args[1].([]*entities.Notebook)
+		}
+		var arg2 time.Time
+		if args[2] != nil {
+			arg2 = args[2].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockNotebooksRepository_TrashNotes_Call) Return(notes []*entities.Note, err error) *MockNotebooksRepository_TrashNotes_Call {
+	_c.Call.Return(notes, err)
+	return _c
+}
+
+func (_c *MockNotebooksRepository_TrashNotes_Call) RunAndReturn(run func(ctx context.Context, notebooks []*entities.Notebook, at time.Time) ([]*entities.Note, error)) *MockNotebooksRepository_TrashNotes_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateNotebook provides a mock function for the type MockNotebooksRepository
+func (_mock *MockNotebooksRepository) UpdateNotebook(ctx context.Context, notebook *entities.Notebook) error {
+	ret := _mock.Called(ctx, notebook)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateNotebook")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *entities.Notebook) error); ok {
+		r0 = returnFunc(ctx, notebook)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockNotebooksRepository_UpdateNotebook_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateNotebook'
+type MockNotebooksRepository_UpdateNotebook_Call struct {
+	*mock.Call
+}
+
+// UpdateNotebook is a helper method to define mock.On call
+//   - ctx context.Context
+//   - notebook *entities.Notebook
+func (_e *MockNotebooksRepository_Expecter) UpdateNotebook(ctx interface{}, notebook interface{}) *MockNotebooksRepository_UpdateNotebook_Call {
+	return &MockNotebooksRepository_UpdateNotebook_Call{Call: _e.mock.On("UpdateNotebook", ctx, notebook)}
+}
+
+func (_c *MockNotebooksRepository_UpdateNotebook_Call) Run(run func(ctx context.Context, notebook *entities.Notebook)) *MockNotebooksRepository_UpdateNotebook_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *entities.Notebook
+		if args[1] != nil {
+			arg1 = args[1].(*entities.Notebook)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockNotebooksRepository_UpdateNotebook_Call) Return(err error) *MockNotebooksRepository_UpdateNotebook_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockNotebooksRepository_UpdateNotebook_Call) RunAndReturn(run func(ctx context.Context, notebook *entities.Notebook) error) *MockNotebooksRepository_UpdateNotebook_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -6,8 +6,10 @@ package mocks
 
 import (
 	"context"
+	"time"
 
 	mock "github.com/stretchr/testify/mock"
+	"github.com/therenotomorrow/gotes/internal/api/notes/v1/ports"
 	"github.com/therenotomorrow/gotes/internal/domain/entities"
 	"github.com/therenotomorrow/gotes/internal/domain/types/id"
 )
@@ -39,6 +41,216 @@ func (_m *MockNotesRepository) EXPECT() *MockNotesRepository_Expecter {
 	return &MockNotesRepository_Expecter{mock: &_m.Mock}
 }
 
+// CountNotesByUser provides a mock function for the type MockNotesRepository
+func (_mock *MockNotesRepository) CountNotesByUser(ctx context.Context, user *entities.User, filter *ports.NotesFilter) (int32, error) {
+	ret := _mock.Called(ctx, user, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountNotesByUser")
+	}
+
+	var r0 int32
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *entities.User, *ports.NotesFilter) (int32, error)); ok {
+		return returnFunc(ctx, user, filter)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *entities.User, *ports.NotesFilter) int32); ok {
+		r0 = returnFunc(ctx, user, filter)
+	} else {
+		r0 = ret.Get(0).(int32)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *entities.User, *ports.NotesFilter) error); ok {
+		r1 = returnFunc(ctx, user, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockNotesRepository_CountNotesByUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountNotesByUser'
+type MockNotesRepository_CountNotesByUser_Call struct {
+	*mock.Call
+}
+
+// CountNotesByUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - user *entities.User
+//   - filter *ports.NotesFilter
+func (_e *MockNotesRepository_Expecter) CountNotesByUser(ctx interface{}, user interface{}, filter interface{}) *MockNotesRepository_CountNotesByUser_Call {
+	return &MockNotesRepository_CountNotesByUser_Call{Call: _e.mock.On("CountNotesByUser", ctx, user, filter)}
+}
+
+func (_c *MockNotesRepository_CountNotesByUser_Call) Run(run func(ctx context.Context, user *entities.User, filter *ports.NotesFilter)) *MockNotesRepository_CountNotesByUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *entities.User
+		if args[1] != nil {
+			arg1 = args[1].(*entities.User)
+		}
+		var arg2 *ports.NotesFilter
+		if args[2] != nil {
+			arg2 = args[2].(*ports.NotesFilter)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockNotesRepository_CountNotesByUser_Call) Return(n int32, err error) *MockNotesRepository_CountNotesByUser_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockNotesRepository_CountNotesByUser_Call) RunAndReturn(run func(ctx context.Context, user *entities.User, filter *ports.NotesFilter) (int32, error)) *MockNotesRepository_CountNotesByUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CountSearchNotesByUser provides a mock function for the type MockNotesRepository
+func (_mock *MockNotesRepository) CountSearchNotesByUser(ctx context.Context, user *entities.User, text string) (int32, error) {
+	ret := _mock.Called(ctx, user, text)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountSearchNotesByUser")
+	}
+
+	var r0 int32
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *entities.User, string) (int32, error)); ok {
+		return returnFunc(ctx, user, text)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *entities.User, string) int32); ok {
+		r0 = returnFunc(ctx, user, text)
+	} else {
+		r0 = ret.Get(0).(int32)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *entities.User, string) error); ok {
+		r1 = returnFunc(ctx, user, text)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockNotesRepository_CountSearchNotesByUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountSearchNotesByUser'
+type MockNotesRepository_CountSearchNotesByUser_Call struct {
+	*mock.Call
+}
+
+// CountSearchNotesByUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - user *entities.User
+//   - text string
+func (_e *MockNotesRepository_Expecter) CountSearchNotesByUser(ctx interface{}, user interface{}, text interface{}) *MockNotesRepository_CountSearchNotesByUser_Call {
+	return &MockNotesRepository_CountSearchNotesByUser_Call{Call: _e.mock.On("CountSearchNotesByUser", ctx, user, text)}
+}
+
+func (_c *MockNotesRepository_CountSearchNotesByUser_Call) Run(run func(ctx context.Context, user *entities.User, text string)) *MockNotesRepository_CountSearchNotesByUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *entities.User
+		if args[1] != nil {
+			arg1 = args[1].(*entities.User)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockNotesRepository_CountSearchNotesByUser_Call) Return(n int32, err error) *MockNotesRepository_CountSearchNotesByUser_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockNotesRepository_CountSearchNotesByUser_Call) RunAndReturn(run func(ctx context.Context, user *entities.User, text string) (int32, error)) *MockNotesRepository_CountSearchNotesByUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CountTrashedNotesByUser provides a mock function for the type MockNotesRepository
+func (_mock *MockNotesRepository) CountTrashedNotesByUser(ctx context.Context, user *entities.User) (int32, error) {
+	ret := _mock.Called(ctx, user)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountTrashedNotesByUser")
+	}
+
+	var r0 int32
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *entities.User) (int32, error)); ok {
+		return returnFunc(ctx, user)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *entities.User) int32); ok {
+		r0 = returnFunc(ctx, user)
+	} else {
+		r0 = ret.Get(0).(int32)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *entities.User) error); ok {
+		r1 = returnFunc(ctx, user)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockNotesRepository_CountTrashedNotesByUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountTrashedNotesByUser'
+type MockNotesRepository_CountTrashedNotesByUser_Call struct {
+	*mock.Call
+}
+
+// CountTrashedNotesByUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - user *entities.User
+func (_e *MockNotesRepository_Expecter) CountTrashedNotesByUser(ctx interface{}, user interface{}) *MockNotesRepository_CountTrashedNotesByUser_Call {
+	return &MockNotesRepository_CountTrashedNotesByUser_Call{Call: _e.mock.On("CountTrashedNotesByUser", ctx, user)}
+}
+
+func (_c *MockNotesRepository_CountTrashedNotesByUser_Call) Run(run func(ctx context.Context, user *entities.User)) *MockNotesRepository_CountTrashedNotesByUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *entities.User
+		if args[1] != nil {
+			arg1 = args[1].(*entities.User)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockNotesRepository_CountTrashedNotesByUser_Call) Return(n int32, err error) *MockNotesRepository_CountTrashedNotesByUser_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockNotesRepository_CountTrashedNotesByUser_Call) RunAndReturn(run func(ctx context.Context, user *entities.User) (int32, error)) *MockNotesRepository_CountTrashedNotesByUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DeleteNote provides a mock function for the type MockNotesRepository
 func (_mock *MockNotesRepository) DeleteNote(ctx context.Context, note *entities.Note) error {
 	ret := _mock.Called(ctx, note)
@@ -164,9 +376,89 @@ func (_c *MockNotesRepository_GetNote_Call) RunAndReturn(run func(ctx context.Co
 	return _c
 }
 
+// GetNoteByContent provides a mock function for the type MockNotesRepository
+func (_mock *MockNotesRepository) GetNoteByContent(ctx context.Context, user *entities.User, title string, content string) (*entities.Note, error) {
+	ret := _mock.Called(ctx, user, title, content)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetNoteByContent")
+	}
+
+	var r0 *entities.Note
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *entities.User, string, string) (*entities.Note, error)); ok {
+		return returnFunc(ctx, user, title, content)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *entities.User, string, string) *entities.Note); ok {
+		r0 = returnFunc(ctx, user, title, content)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.Note)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *entities.User, string, string) error); ok {
+		r1 = returnFunc(ctx, user, title, content)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockNotesRepository_GetNoteByContent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetNoteByContent'
+type MockNotesRepository_GetNoteByContent_Call struct {
+	*mock.Call
+}
+
+// GetNoteByContent is a helper method to define mock.On call
+//   - ctx context.Context
+//   - user *entities.User
+//   - title string
+//   - content string
+func (_e *MockNotesRepository_Expecter) GetNoteByContent(ctx interface{}, user interface{}, title interface{}, content interface{}) *MockNotesRepository_GetNoteByContent_Call {
+	return &MockNotesRepository_GetNoteByContent_Call{Call: _e.mock.On("GetNoteByContent", ctx, user, title, content)}
+}
+
+func (_c *MockNotesRepository_GetNoteByContent_Call) Run(run func(ctx context.Context, user *entities.User, title string, content string)) *MockNotesRepository_GetNoteByContent_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *entities.User
+		if args[1] != nil {
+			arg1 = args[1].(*entities.User)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockNotesRepository_GetNoteByContent_Call) Return(note *entities.Note, err error) *MockNotesRepository_GetNoteByContent_Call {
+	_c.Call.Return(note, err)
+	return _c
+}
+
+func (_c *MockNotesRepository_GetNoteByContent_Call) RunAndReturn(run func(ctx context.Context, user *entities.User, title string, content string) (*entities.Note, error)) *MockNotesRepository_GetNoteByContent_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetNotesByUser provides a mock function for the type MockNotesRepository
-func (_mock *MockNotesRepository) GetNotesByUser(ctx context.Context, user *entities.User) ([]*entities.Note, error) {
-	ret := _mock.Called(ctx, user)
+func (_mock *MockNotesRepository) GetNotesByUser(ctx context.Context, user *entities.User, query *ports.NotesQuery) ([]*entities.Note, error) {
+	ret := _mock.Called(ctx, user, query)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetNotesByUser")
@@ -174,18 +466,18 @@ func (_mock *MockNotesRepository) GetNotesByUser(ctx context.Context, user *enti
 
 	var r0 []*entities.Note
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *entities.User) ([]*entities.Note, error)); ok {
-		return returnFunc(ctx, user)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *entities.User, *ports.NotesQuery) ([]*entities.Note, error)); ok {
+		return returnFunc(ctx, user, query)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *entities.User) []*entities.Note); ok {
-		r0 = returnFunc(ctx, user)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *entities.User, *ports.NotesQuery) []*entities.Note); ok {
+		r0 = returnFunc(ctx, user, query)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*entities.Note)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, *entities.User) error); ok {
-		r1 = returnFunc(ctx, user)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *entities.User, *ports.NotesQuery) error); ok {
+		r1 = returnFunc(ctx, user, query)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -200,11 +492,12 @@ type MockNotesRepository_GetNotesByUser_Call struct {
 // GetNotesByUser is a helper method to define mock.On call
 //   - ctx context.Context
 //   - user *entities.User
-func (_e *MockNotesRepository_Expecter) GetNotesByUser(ctx interface{}, user interface{}) *MockNotesRepository_GetNotesByUser_Call {
-	return &MockNotesRepository_GetNotesByUser_Call{Call: _e.mock.On("GetNotesByUser", ctx, user)}
+//   - query *ports.NotesQuery
+func (_e *MockNotesRepository_Expecter) GetNotesByUser(ctx interface{}, user interface{}, query interface{}) *MockNotesRepository_GetNotesByUser_Call {
+	return &MockNotesRepository_GetNotesByUser_Call{Call: _e.mock.On("GetNotesByUser", ctx, user, query)}
 }
 
-func (_c *MockNotesRepository_GetNotesByUser_Call) Run(run func(ctx context.Context, user *entities.User)) *MockNotesRepository_GetNotesByUser_Call {
+func (_c *MockNotesRepository_GetNotesByUser_Call) Run(run func(ctx context.Context, user *entities.User, query *ports.NotesQuery)) *MockNotesRepository_GetNotesByUser_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -214,9 +507,14 @@ func (_c *MockNotesRepository_GetNotesByUser_Call) Run(run func(ctx context.Cont
 		if args[1] != nil {
 			arg1 = args[1].(*entities.User)
 		}
+		var arg2 *ports.NotesQuery
+		if args[2] != nil {
+			arg2 = args[2].(*ports.NotesQuery)
+		}
 		run(
 			arg0,
 			arg1,
+			arg2,
 		)
 	})
 	return _c
@@ -227,75 +525,633 @@ func (_c *MockNotesRepository_GetNotesByUser_Call) Return(notes []*entities.Note
 	return _c
 }
 
-func (_c *MockNotesRepository_GetNotesByUser_Call) RunAndReturn(run func(ctx context.Context, user *entities.User) ([]*entities.Note, error)) *MockNotesRepository_GetNotesByUser_Call {
+func (_c *MockNotesRepository_GetNotesByUser_Call) RunAndReturn(run func(ctx context.Context, user *entities.User, query *ports.NotesQuery) ([]*entities.Note, error)) *MockNotesRepository_GetNotesByUser_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// SaveNote provides a mock function for the type MockNotesRepository
-func (_mock *MockNotesRepository) SaveNote(ctx context.Context, note *entities.Note) (*entities.Note, error) {
-	ret := _mock.Called(ctx, note)
+// GetTrashedNotesByUser provides a mock function for the type MockNotesRepository
+func (_mock *MockNotesRepository) GetTrashedNotesByUser(ctx context.Context, user *entities.User, query *ports.TrashQuery) ([]*entities.Note, error) {
+	ret := _mock.Called(ctx, user, query)
 
 	if len(ret) == 0 {
-		panic("no return value specified for SaveNote")
+		panic("no return value specified for GetTrashedNotesByUser")
 	}
 
-	var r0 *entities.Note
+	var r0 []*entities.Note
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *entities.Note) (*entities.Note, error)); ok {
-		return returnFunc(ctx, note)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *entities.User, *ports.TrashQuery) ([]*entities.Note, error)); ok {
+		return returnFunc(ctx, user, query)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *entities.Note) *entities.Note); ok {
-		r0 = returnFunc(ctx, note)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *entities.User, *ports.TrashQuery) []*entities.Note); ok {
+		r0 = returnFunc(ctx, user, query)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*entities.Note)
+			r0 = ret.Get(0).([]*entities.Note)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, *entities.Note) error); ok {
-		r1 = returnFunc(ctx, note)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *entities.User, *ports.TrashQuery) error); ok {
+		r1 = returnFunc(ctx, user, query)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockNotesRepository_SaveNote_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveNote'
-type MockNotesRepository_SaveNote_Call struct {
+// MockNotesRepository_GetTrashedNotesByUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTrashedNotesByUser'
+type MockNotesRepository_GetTrashedNotesByUser_Call struct {
 	*mock.Call
 }
 
-// SaveNote is a helper method to define mock.On call
+// GetTrashedNotesByUser is a helper method to define mock.On call
 //   - ctx context.Context
-//   - note *entities.Note
-func (_e *MockNotesRepository_Expecter) SaveNote(ctx interface{}, note interface{}) *MockNotesRepository_SaveNote_Call {
-	return &MockNotesRepository_SaveNote_Call{Call: _e.mock.On("SaveNote", ctx, note)}
+//   - user *entities.User
+//   - query *ports.TrashQuery
+func (_e *MockNotesRepository_Expecter) GetTrashedNotesByUser(ctx interface{}, user interface{}, query interface{}) *MockNotesRepository_GetTrashedNotesByUser_Call {
+	return &MockNotesRepository_GetTrashedNotesByUser_Call{Call: _e.mock.On("GetTrashedNotesByUser", ctx, user, query)}
 }
 
-func (_c *MockNotesRepository_SaveNote_Call) Run(run func(ctx context.Context, note *entities.Note)) *MockNotesRepository_SaveNote_Call {
+func (_c *MockNotesRepository_GetTrashedNotesByUser_Call) Run(run func(ctx context.Context, user *entities.User, query *ports.TrashQuery)) *MockNotesRepository_GetTrashedNotesByUser_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *entities.Note
+		var arg1 *entities.User
 		if args[1] != nil {
-			arg1 = args[1].(*entities.Note)
+			arg1 = args[1].(*entities.User)
+		}
+		var arg2 *ports.TrashQuery
+		if args[2] != nil {
+			arg2 = args[2].(*ports.TrashQuery)
 		}
 		run(
 			arg0,
 			arg1,
+			arg2,
 		)
 	})
 	return _c
 }
 
-func (_c *MockNotesRepository_SaveNote_Call) Return(note1 *entities.Note, err error) *MockNotesRepository_SaveNote_Call {
-	_c.Call.Return(note1, err)
+func (_c *MockNotesRepository_GetTrashedNotesByUser_Call) Return(notes []*entities.Note, err error) *MockNotesRepository_GetTrashedNotesByUser_Call {
+	_c.Call.Return(notes, err)
 	return _c
 }
 
-func (_c *MockNotesRepository_SaveNote_Call) RunAndReturn(run func(ctx context.Context, note *entities.Note) (*entities.Note, error)) *MockNotesRepository_SaveNote_Call {
+func (_c *MockNotesRepository_GetTrashedNotesByUser_Call) RunAndReturn(run func(ctx context.Context, user *entities.User, query *ports.TrashQuery) ([]*entities.Note, error)) *MockNotesRepository_GetTrashedNotesByUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LockNote provides a mock function for the type MockNotesRepository
+func (_mock *MockNotesRepository) LockNote(ctx context.Context, note *entities.Note) error {
+	ret := _mock.Called(ctx, note)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LockNote")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *entities.Note) error); ok {
+		r0 = returnFunc(ctx, note)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockNotesRepository_LockNote_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LockNote'
+type MockNotesRepository_LockNote_Call struct {
+	*mock.Call
+}
+
+// LockNote is a helper method to define mock.On call
+//   - ctx context.Context
+//   - note *entities.Note
+func (_e *MockNotesRepository_Expecter) LockNote(ctx interface{}, note interface{}) *MockNotesRepository_LockNote_Call {
+	return &MockNotesRepository_LockNote_Call{Call: _e.mock.On("LockNote", ctx, note)}
+}
+
+func (_c *MockNotesRepository_LockNote_Call) Run(run func(ctx context.Context, note *entities.Note)) *MockNotesRepository_LockNote_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *entities.Note
+		if args[1] != nil {
+			arg1 = args[1].(*entities.Note)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockNotesRepository_LockNote_Call) Return(err error) *MockNotesRepository_LockNote_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockNotesRepository_LockNote_Call) RunAndReturn(run func(ctx context.Context, note *entities.Note) error) *MockNotesRepository_LockNote_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MoveNote provides a mock function for the type MockNotesRepository
+func (_mock *MockNotesRepository) MoveNote(ctx context.Context, note *entities.Note) error {
+	ret := _mock.Called(ctx, note)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MoveNote")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *entities.Note) error); ok {
+		r0 = returnFunc(ctx, note)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockNotesRepository_MoveNote_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MoveNote'
+type MockNotesRepository_MoveNote_Call struct {
+	*mock.Call
+}
+
+// MoveNote is a helper method to define mock.On call
+//   - ctx context.Context
+//   - note *entities.Note
+func (_e *MockNotesRepository_Expecter) MoveNote(ctx interface{}, note interface{}) *MockNotesRepository_MoveNote_Call {
+	return &MockNotesRepository_MoveNote_Call{Call: _e.mock.On("MoveNote", ctx, note)}
+}
+
+func (_c *MockNotesRepository_MoveNote_Call) Run(run func(ctx context.Context, note *entities.Note)) *MockNotesRepository_MoveNote_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *entities.Note
+		if args[1] != nil {
+			arg1 = args[1].(*entities.Note)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockNotesRepository_MoveNote_Call) Return(err error) *MockNotesRepository_MoveNote_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockNotesRepository_MoveNote_Call) RunAndReturn(run func(ctx context.Context, note *entities.Note) error) *MockNotesRepository_MoveNote_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PurgeNotes provides a mock function for the type MockNotesRepository
+func (_mock *MockNotesRepository) PurgeNotes(ctx context.Context, before time.Time) ([]*entities.Note, error) {
+	ret := _mock.Called(ctx, before)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PurgeNotes")
+	}
+
+	var r0 []*entities.Note
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Time) ([]*entities.Note, error)); ok {
+		return returnFunc(ctx, before)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Time) []*entities.Note); ok {
+		r0 = returnFunc(ctx, before)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.Note)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = returnFunc(ctx, before)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockNotesRepository_PurgeNotes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PurgeNotes'
+type MockNotesRepository_PurgeNotes_Call struct {
+	*mock.Call
+}
+
+// PurgeNotes is a helper method to define mock.On call
+//   - ctx context.Context
+//   - before time.Time
+func (_e *MockNotesRepository_Expecter) PurgeNotes(ctx interface{}, before interface{}) *MockNotesRepository_PurgeNotes_Call {
+	return &MockNotesRepository_PurgeNotes_Call{Call: _e.mock.On("PurgeNotes", ctx, before)}
+}
+
+func (_c *MockNotesRepository_PurgeNotes_Call) Run(run func(ctx context.Context, before time.Time)) *MockNotesRepository_PurgeNotes_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 time.Time
+		if args[1] != nil {
+			arg1 = args[1].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockNotesRepository_PurgeNotes_Call) Return(notes []*entities.Note, err error) *MockNotesRepository_PurgeNotes_Call {
+	_c.Call.Return(notes, err)
+	return _c
+}
+
+func (_c *MockNotesRepository_PurgeNotes_Call) RunAndReturn(run func(ctx context.Context, before time.Time) ([]*entities.Note, error)) *MockNotesRepository_PurgeNotes_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RestoreNote provides a mock function for the type MockNotesRepository
+func (_mock *MockNotesRepository) RestoreNote(ctx context.Context, note *entities.Note) error {
+	ret := _mock.Called(ctx, note)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RestoreNote")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *entities.Note) error); ok {
+		r0 = returnFunc(ctx, note)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockNotesRepository_RestoreNote_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RestoreNote'
+type MockNotesRepository_RestoreNote_Call struct {
+	*mock.Call
+}
+
+// RestoreNote is a helper method to define mock.On call
+//   - ctx context.Context
+//   - note *entities.Note
+func (_e *MockNotesRepository_Expecter) RestoreNote(ctx interface{}, note interface{}) *MockNotesRepository_RestoreNote_Call {
+	return &MockNotesRepository_RestoreNote_Call{Call: _e.mock.On("RestoreNote", ctx, note)}
+}
+
+func (_c *MockNotesRepository_RestoreNote_Call) Run(run func(ctx context.Context, note *entities.Note)) *MockNotesRepository_RestoreNote_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *entities.Note
+		if args[1] != nil {
+			arg1 = args[1].(*entities.Note)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockNotesRepository_RestoreNote_Call) Return(err error) *MockNotesRepository_RestoreNote_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockNotesRepository_RestoreNote_Call) RunAndReturn(run func(ctx context.Context, note *entities.Note) error) *MockNotesRepository_RestoreNote_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SaveNote provides a mock function for the type MockNotesRepository
+func (_mock *MockNotesRepository) SaveNote(ctx context.Context, note *entities.Note) (*entities.Note, error) {
+	ret := _mock.Called(ctx, note)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveNote")
+	}
+
+	var r0 *entities.Note
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *entities.Note) (*entities.Note, error)); ok {
+		return returnFunc(ctx, note)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *entities.Note) *entities.Note); ok {
+		r0 = returnFunc(ctx, note)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.Note)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *entities.Note) error); ok {
+		r1 = returnFunc(ctx, note)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockNotesRepository_SaveNote_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveNote'
+type MockNotesRepository_SaveNote_Call struct {
+	*mock.Call
+}
+
+// SaveNote is a helper method to define mock.On call
+//   - ctx context.Context
+//   - note *entities.Note
+func (_e *MockNotesRepository_Expecter) SaveNote(ctx interface{}, note interface{}) *MockNotesRepository_SaveNote_Call {
+	return &MockNotesRepository_SaveNote_Call{Call: _e.mock.On("SaveNote", ctx, note)}
+}
+
+func (_c *MockNotesRepository_SaveNote_Call) Run(run func(ctx context.Context, note *entities.Note)) *MockNotesRepository_SaveNote_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *entities.Note
+		if args[1] != nil {
+			arg1 = args[1].(*entities.Note)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockNotesRepository_SaveNote_Call) Return(note1 *entities.Note, err error) *MockNotesRepository_SaveNote_Call {
+	_c.Call.Return(note1, err)
+	return _c
+}
+
+func (_c *MockNotesRepository_SaveNote_Call) RunAndReturn(run func(ctx context.Context, note *entities.Note) (*entities.Note, error)) *MockNotesRepository_SaveNote_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SearchNotesByUser provides a mock function for the type MockNotesRepository
+func (_mock *MockNotesRepository) SearchNotesByUser(ctx context.Context, user *entities.User, query *ports.SearchQuery) ([]*ports.SearchHit, error) {
+	ret := _mock.Called(ctx, user, query)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SearchNotesByUser")
+	}
+
+	var r0 []*ports.SearchHit
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *entities.User, *ports.SearchQuery) ([]*ports.SearchHit, error)); ok {
+		return returnFunc(ctx, user, query)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *entities.User, *ports.SearchQuery) []*ports.SearchHit); ok {
+		r0 = returnFunc(ctx, user, query)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*ports.SearchHit)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *entities.User, *ports.SearchQuery) error); ok {
+		r1 = returnFunc(ctx, user, query)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockNotesRepository_SearchNotesByUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SearchNotesByUser'
+type MockNotesRepository_SearchNotesByUser_Call struct {
+	*mock.Call
+}
+
+// SearchNotesByUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - user *entities.User
+//   - query *ports.SearchQuery
+func (_e *MockNotesRepository_Expecter) SearchNotesByUser(ctx interface{}, user interface{}, query interface{}) *MockNotesRepository_SearchNotesByUser_Call {
+	return &MockNotesRepository_SearchNotesByUser_Call{Call: _e.mock.On("SearchNotesByUser", ctx, user, query)}
+}
+
+func (_c *MockNotesRepository_SearchNotesByUser_Call) Run(run func(ctx context.Context, user *entities.User, query *ports.SearchQuery)) *MockNotesRepository_SearchNotesByUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *entities.User
+		if args[1] != nil {
+			arg1 = args[1].(*entities.User)
+		}
+		var arg2 *ports.SearchQuery
+		if args[2] != nil {
+			arg2 = args[2].(*ports.SearchQuery)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockNotesRepository_SearchNotesByUser_Call) Return(searchHits []*ports.SearchHit, err error) *MockNotesRepository_SearchNotesByUser_Call {
+	_c.Call.Return(searchHits, err)
+	return _c
+}
+
+func (_c *MockNotesRepository_SearchNotesByUser_Call) RunAndReturn(run func(ctx context.Context, user *entities.User, query *ports.SearchQuery) ([]*ports.SearchHit, error)) *MockNotesRepository_SearchNotesByUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// TouchNote provides a mock function for the type MockNotesRepository
+func (_mock *MockNotesRepository) TouchNote(ctx context.Context, note *entities.Note) error {
+	ret := _mock.Called(ctx, note)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TouchNote")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *entities.Note) error); ok {
+		r0 = returnFunc(ctx, note)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockNotesRepository_TouchNote_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TouchNote'
+type MockNotesRepository_TouchNote_Call struct {
+	*mock.Call
+}
+
+// TouchNote is a helper method to define mock.On call
+//   - ctx context.Context
+//   - note *entities.Note
+func (_e *MockNotesRepository_Expecter) TouchNote(ctx interface{}, note interface{}) *MockNotesRepository_TouchNote_Call {
+	return &MockNotesRepository_TouchNote_Call{Call: _e.mock.On("TouchNote", ctx, note)}
+}
+
+func (_c *MockNotesRepository_TouchNote_Call) Run(run func(ctx context.Context, note *entities.Note)) *MockNotesRepository_TouchNote_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *entities.Note
+		if args[1] != nil {
+			arg1 = args[1].(*entities.Note)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockNotesRepository_TouchNote_Call) Return(err error) *MockNotesRepository_TouchNote_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockNotesRepository_TouchNote_Call) RunAndReturn(run func(ctx context.Context, note *entities.Note) error) *MockNotesRepository_TouchNote_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// TrashNote provides a mock function for the type MockNotesRepository
+func (_mock *MockNotesRepository) TrashNote(ctx context.Context, note *entities.Note) error {
+	ret := _mock.Called(ctx, note)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TrashNote")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *entities.Note) error); ok {
+		r0 = returnFunc(ctx, note)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockNotesRepository_TrashNote_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TrashNote'
+type MockNotesRepository_TrashNote_Call struct {
+	*mock.Call
+}
+
+// TrashNote is a helper method to define mock.On call
+//   - ctx context.Context
+//   - note *entities.Note
+func (_e *MockNotesRepository_Expecter) TrashNote(ctx interface{}, note interface{}) *MockNotesRepository_TrashNote_Call {
+	return &MockNotesRepository_TrashNote_Call{Call: _e.mock.On("TrashNote", ctx, note)}
+}
+
+func (_c *MockNotesRepository_TrashNote_Call) Run(run func(ctx context.Context, note *entities.Note)) *MockNotesRepository_TrashNote_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *entities.Note
+		if args[1] != nil {
+			arg1 = args[1].(*entities.Note)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockNotesRepository_TrashNote_Call) Return(err error) *MockNotesRepository_TrashNote_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockNotesRepository_TrashNote_Call) RunAndReturn(run func(ctx context.Context, note *entities.Note) error) *MockNotesRepository_TrashNote_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateNote provides a mock function for the type MockNotesRepository
+func (_mock *MockNotesRepository) UpdateNote(ctx context.Context, note *entities.Note) error {
+	ret := _mock.Called(ctx, note)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateNote")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *entities.Note) error); ok {
+		r0 = returnFunc(ctx, note)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockNotesRepository_UpdateNote_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateNote'
+type MockNotesRepository_UpdateNote_Call struct {
+	*mock.Call
+}
+
+// UpdateNote is a helper method to define mock.On call
+//   - ctx context.Context
+//   - note *entities.Note
+func (_e *MockNotesRepository_Expecter) UpdateNote(ctx interface{}, note interface{}) *MockNotesRepository_UpdateNote_Call {
+	return &MockNotesRepository_UpdateNote_Call{Call: _e.mock.On("UpdateNote", ctx, note)}
+}
+
+func (_c *MockNotesRepository_UpdateNote_Call) Run(run func(ctx context.Context, note *entities.Note)) *MockNotesRepository_UpdateNote_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *entities.Note
+		if args[1] != nil {
+			arg1 = args[1].(*entities.Note)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockNotesRepository_UpdateNote_Call) Return(err error) *MockNotesRepository_UpdateNote_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockNotesRepository_UpdateNote_Call) RunAndReturn(run func(ctx context.Context, note *entities.Note) error) *MockNotesRepository_UpdateNote_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -0,0 +1,306 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"context"
+
+	mock "github.com/stretchr/testify/mock"
+	"github.com/therenotomorrow/gotes/internal/domain/entities"
+)
+
+// NewMockReferencesRepository creates a new instance of MockReferencesRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockReferencesRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockReferencesRepository {
+	mock := &MockReferencesRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockReferencesRepository is an autogenerated mock type for the ReferencesRepository type
+type MockReferencesRepository struct {
+	mock.Mock
+}
+
+type MockReferencesRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockReferencesRepository) EXPECT() *MockReferencesRepository_Expecter {
+	return &MockReferencesRepository_Expecter{mock: &_m.Mock}
+}
+
+// GetBacklinks provides a mock function for the type MockReferencesRepository
+func (_mock *MockReferencesRepository) GetBacklinks(ctx context.Context, note *entities.Note) ([]*entities.Note, error) {
+	ret := _mock.Called(ctx, note)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBacklinks")
+	}
+
+	var r0 []*entities.Note
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *entities.Note) ([]*entities.Note, error)); ok {
+		return returnFunc(ctx, note)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *entities.Note) []*entities.Note); ok {
+		r0 = returnFunc(ctx, note)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.Note)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *entities.Note) error); ok {
+		r1 = returnFunc(ctx, note)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockReferencesRepository_GetBacklinks_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetBacklinks'
+type MockReferencesRepository_GetBacklinks_Call struct {
+	*mock.Call
+}
+
+// GetBacklinks is a helper method to define mock.On call
+//   - ctx context.Context
+//   - note *entities.Note
+func (_e *MockReferencesRepository_Expecter) GetBacklinks(ctx interface{}, note interface{}) *MockReferencesRepository_GetBacklinks_Call {
+	return &MockReferencesRepository_GetBacklinks_Call{Call: _e.mock.On("GetBacklinks", ctx, note)}
+}
+
+func (_c *MockReferencesRepository_GetBacklinks_Call) Run(run func(ctx context.Context, note *entities.Note)) *MockReferencesRepository_GetBacklinks_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *entities.Note
+		if args[1] != nil {
+			arg1 = args[1].(*entities.Note)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockReferencesRepository_GetBacklinks_Call) Return(notes []*entities.Note, err error) *MockReferencesRepository_GetBacklinks_Call {
+	_c.Call.Return(notes, err)
+	return _c
+}
+
+func (_c *MockReferencesRepository_GetBacklinks_Call) RunAndReturn(run func(ctx context.Context, note *entities.Note) ([]*entities.Note, error)) *MockReferencesRepository_GetBacklinks_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDanglingReferences provides a mock function for the type MockReferencesRepository
+func (_mock *MockReferencesRepository) GetDanglingReferences(ctx context.Context, user *entities.User) ([]*entities.Reference, error) {
+	ret := _mock.Called(ctx, user)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDanglingReferences")
+	}
+
+	var r0 []*entities.Reference
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *entities.User) ([]*entities.Reference, error)); ok {
+		return returnFunc(ctx, user)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *entities.User) []*entities.Reference); ok {
+		r0 = returnFunc(ctx, user)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.Reference)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *entities.User) error); ok {
+		r1 = returnFunc(ctx, user)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockReferencesRepository_GetDanglingReferences_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDanglingReferences'
+type MockReferencesRepository_GetDanglingReferences_Call struct {
+	*mock.Call
+}
+
+// GetDanglingReferences is a helper method to define mock.On call
+//   - ctx context.Context
+//   - user *entities.User
+func (_e *MockReferencesRepository_Expecter) GetDanglingReferences(ctx interface{}, user interface{}) *MockReferencesRepository_GetDanglingReferences_Call {
+	return &MockReferencesRepository_GetDanglingReferences_Call{Call: _e.mock.On("GetDanglingReferences", ctx, user)}
+}
+
+func (_c *MockReferencesRepository_GetDanglingReferences_Call) Run(run func(ctx context.Context, user *entities.User)) *MockReferencesRepository_GetDanglingReferences_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *entities.User
+		if args[1] != nil {
+			arg1 = args[1].(*entities.User)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockReferencesRepository_GetDanglingReferences_Call) Return(references []*entities.Reference, err error) *MockReferencesRepository_GetDanglingReferences_Call {
+	_c.Call.Return(references, err)
+	return _c
+}
+
+func (_c *MockReferencesRepository_GetDanglingReferences_Call) RunAndReturn(run func(ctx context.Context, user *entities.User) ([]*entities.Reference, error)) *MockReferencesRepository_GetDanglingReferences_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetReferences provides a mock function for the type MockReferencesRepository
+func (_mock *MockReferencesRepository) GetReferences(ctx context.Context, note *entities.Note) ([]*entities.Reference, error) {
+	ret := _mock.Called(ctx, note)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetReferences")
+	}
+
+	var r0 []*entities.Reference
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *entities.Note) ([]*entities.Reference, error)); ok {
+		return returnFunc(ctx, note)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *entities.Note) []*entities.Reference); ok {
+		r0 = returnFunc(ctx, note)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.Reference)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *entities.Note) error); ok {
+		r1 = returnFunc(ctx, note)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockReferencesRepository_GetReferences_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetReferences'
+type MockReferencesRepository_GetReferences_Call struct {
+	*mock.Call
+}
+
+// GetReferences is a helper method to define mock.On call
+//   - ctx context.Context
+//   - note *entities.Note
+func (_e *MockReferencesRepository_Expecter) GetReferences(ctx interface{}, note interface{}) *MockReferencesRepository_GetReferences_Call {
+	return &MockReferencesRepository_GetReferences_Call{Call: _e.mock.On("GetReferences", ctx, note)}
+}
+
+func (_c *MockReferencesRepository_GetReferences_Call) Run(run func(ctx context.Context, note *entities.Note)) *MockReferencesRepository_GetReferences_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *entities.Note
+		if args[1] != nil {
+			arg1 = args[1].(*entities.Note)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockReferencesRepository_GetReferences_Call) Return(references []*entities.Reference, err error) *MockReferencesRepository_GetReferences_Call {
+	_c.Call.Return(references, err)
+	return _c
+}
+
+func (_c *MockReferencesRepository_GetReferences_Call) RunAndReturn(run func(ctx context.Context, note *entities.Note) ([]*entities.Reference, error)) *MockReferencesRepository_GetReferences_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SaveReferences provides a mock function for the type MockReferencesRepository
+func (_mock *MockReferencesRepository) SaveReferences(ctx context.Context, note *entities.Note, references []*entities.Reference) error {
+	ret := _mock.Called(ctx, note, references)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveReferences")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *entities.Note, []*entities.Reference) error); ok {
+		r0 = returnFunc(ctx, note, references)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockReferencesRepository_SaveReferences_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveReferences'
+type MockReferencesRepository_SaveReferences_Call struct {
+	*mock.Call
+}
+
+// SaveReferences is a helper method to define mock.On call
+//   - ctx context.Context
+//   - note *entities.Note
+//   - references []*entities.Reference
+func (_e *MockReferencesRepository_Expecter) SaveReferences(ctx interface{}, note interface{}, references interface{}) *MockReferencesRepository_SaveReferences_Call {
+	return &MockReferencesRepository_SaveReferences_Call{Call: _e.mock.On("SaveReferences", ctx, note, references)}
+}
+
+func (_c *MockReferencesRepository_SaveReferences_Call) Run(run func(ctx context.Context, note *entities.Note, references []*entities.Reference)) *MockReferencesRepository_SaveReferences_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *entities.Note
+		if args[1] != nil {
+			arg1 = args[1].(*entities.Note)
+		}
+		var arg2 []*entities.Reference
+		if args[2] != nil {
+			arg2 = args[2].([]*entities.Reference)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockReferencesRepository_SaveReferences_Call) Return(err error) *MockReferencesRepository_SaveReferences_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockReferencesRepository_SaveReferences_Call) RunAndReturn(run func(ctx context.Context, note *entities.Note, references []*entities.Reference) error) *MockReferencesRepository_SaveReferences_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -0,0 +1,432 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"context"
+
+	mock "github.com/stretchr/testify/mock"
+	"github.com/therenotomorrow/gotes/internal/domain/entities"
+	"github.com/therenotomorrow/gotes/internal/domain/types/id"
+)
+
+// NewMockRemindersRepository creates a new instance of MockRemindersRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockRemindersRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockRemindersRepository {
+	mock := &MockRemindersRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockRemindersRepository is an autogenerated mock type for the RemindersRepository type
+type MockRemindersRepository struct {
+	mock.Mock
+}
+
+type MockRemindersRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockRemindersRepository) EXPECT() *MockRemindersRepository_Expecter {
+	return &MockRemindersRepository_Expecter{mock: &_m.Mock}
+}
+
+// DeleteReminder provides a mock function for the type MockRemindersRepository
+func (_mock *MockRemindersRepository) DeleteReminder(ctx context.Context, reminder *entities.Reminder) error {
+	ret := _mock.Called(ctx, reminder)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteReminder")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *entities.Reminder) error); ok {
+		r0 = returnFunc(ctx, reminder)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockRemindersRepository_DeleteReminder_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteReminder'
+type MockRemindersRepository_DeleteReminder_Call struct {
+	*mock.Call
+}
+
+// DeleteReminder is a helper method to define mock.On call
+//   - ctx context.Context
+//   - reminder *entities.Reminder
+func (_e *MockRemindersRepository_Expecter) DeleteReminder(ctx interface{}, reminder interface{}) *MockRemindersRepository_DeleteReminder_Call {
+	return &MockRemindersRepository_DeleteReminder_Call{Call: _e.mock.On("DeleteReminder", ctx, reminder)}
+}
+
+func (_c *MockRemindersRepository_DeleteReminder_Call) Run(run func(ctx context.Context, reminder *entities.Reminder)) *MockRemindersRepository_DeleteReminder_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *entities.Reminder
+		if args[1] != nil {
+			arg1 = args[1].(*entities.Reminder)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRemindersRepository_DeleteReminder_Call) Return(err error) *MockRemindersRepository_DeleteReminder_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockRemindersRepository_DeleteReminder_Call) RunAndReturn(run func(ctx context.Context, reminder *entities.Reminder) error) *MockRemindersRepository_DeleteReminder_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetReminder provides a mock function for the type MockRemindersRepository
+func (_mock *MockRemindersRepository) GetReminder(ctx context.Context, id1 id.ID) (*entities.Reminder, error) {
+	ret := _mock.Called(ctx, id1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetReminder")
+	}
+
+	var r0 *entities.Reminder
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, id.ID) (*entities.Reminder, error)); ok {
+		return returnFunc(ctx, id1)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, id.ID) *entities.Reminder); ok {
+		r0 = returnFunc(ctx, id1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.Reminder)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, id.ID) error); ok {
+		r1 = returnFunc(ctx, id1)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRemindersRepository_GetReminder_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetReminder'
+type MockRemindersRepository_GetReminder_Call struct {
+	*mock.Call
+}
+
+// GetReminder is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id1 id.ID
+func (_e *MockRemindersRepository_Expecter) GetReminder(ctx interface{}, id1 interface{}) *MockRemindersRepository_GetReminder_Call {
+	return &MockRemindersRepository_GetReminder_Call{Call: _e.mock.On("GetReminder", ctx, id1)}
+}
+
+func (_c *MockRemindersRepository_GetReminder_Call) Run(run func(ctx context.Context, id1 id.ID)) *MockRemindersRepository_GetReminder_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 id.ID
+		if args[1] != nil {
+			arg1 = args[1].(id.ID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRemindersRepository_GetReminder_Call) Return(reminder *entities.Reminder, err error) *MockRemindersRepository_GetReminder_Call {
+	_c.Call.Return(reminder, err)
+	return _c
+}
+
+func (_c *MockRemindersRepository_GetReminder_Call) RunAndReturn(run func(ctx context.Context, id1 id.ID) (*entities.Reminder, error)) *MockRemindersRepository_GetReminder_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRemindersByNote provides a mock function for the type MockRemindersRepository
+func (_mock *MockRemindersRepository) GetRemindersByNote(ctx context.Context, note *entities.Note, user *entities.User) ([]*entities.Reminder, error) {
+	ret := _mock.Called(ctx, note, user)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRemindersByNote")
+	}
+
+	var r0 []*entities.Reminder
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *entities.Note, *entities.User) ([]*entities.Reminder, error)); ok {
+		return returnFunc(ctx, note, user)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *entities.Note, *entities.User) []*entities.Reminder); ok {
+		r0 = returnFunc(ctx, note, user)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.Reminder)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *entities.Note, *entities.User) error); ok {
+		r1 = returnFunc(ctx, note, user)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRemindersRepository_GetRemindersByNote_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRemindersByNote'
+type MockRemindersRepository_GetRemindersByNote_Call struct {
+	*mock.Call
+}
+
+// GetRemindersByNote is a helper method to define mock.On call
+//   - ctx context.Context
+//   - note *entities.Note
+//   - user *entities.User
+func (_e *MockRemindersRepository_Expecter) GetRemindersByNote(ctx interface{}, note interface{}, user interface{}) *MockRemindersRepository_GetRemindersByNote_Call {
+	return &MockRemindersRepository_GetRemindersByNote_Call{Call: _e.mock.On("GetRemindersByNote", ctx, note, user)}
+}
+
+func (_c *MockRemindersRepository_GetRemindersByNote_Call) Run(run func(ctx context.Context, note *entities.Note, user *entities.User)) *MockRemindersRepository_GetRemindersByNote_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *entities.Note
+		if args[1] != nil {
+			arg1 = args[1].(*entities.Note)
+		}
+		var arg2 *entities.User
+		if args[2] != nil {
+			arg2 = args[2].(*entities.User)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRemindersRepository_GetRemindersByNote_Call) Return(reminders []*entities.Reminder, err error) *MockRemindersRepository_GetRemindersByNote_Call {
+	_c.Call.Return(reminders, err)
+	return _c
+}
+
+func (_c *MockRemindersRepository_GetRemindersByNote_Call) RunAndReturn(run func(ctx context.Context, note *entities.Note, user *entities.User) ([]*entities.Reminder, error)) *MockRemindersRepository_GetRemindersByNote_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LockDueReminders provides a mock function for the type MockRemindersRepository
+func (_mock *MockRemindersRepository) LockDueReminders(ctx context.Context, limit int) ([]*entities.Reminder, error) {
+	ret := _mock.Called(ctx, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LockDueReminders")
+	}
+
+	var r0 []*entities.Reminder
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int) ([]*entities.Reminder, error)); ok {
+		return returnFunc(ctx, limit)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int) []*entities.Reminder); ok {
+		r0 = returnFunc(ctx, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.Reminder)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = returnFunc(ctx, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRemindersRepository_LockDueReminders_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LockDueReminders'
+type MockRemindersRepository_LockDueReminders_Call struct {
+	*mock.Call
+}
+
+// LockDueReminders is a helper method to define mock.On call
+//   - ctx context.Context
+//   - limit int
+func (_e *MockRemindersRepository_Expecter) LockDueReminders(ctx interface{}, limit interface{}) *MockRemindersRepository_LockDueReminders_Call {
+	return &MockRemindersRepository_LockDueReminders_Call{Call: _e.mock.On("LockDueReminders", ctx, limit)}
+}
+
+func (_c *MockRemindersRepository_LockDueReminders_Call) Run(run func(ctx context.Context, limit int)) *MockRemindersRepository_LockDueReminders_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int
+		if args[1] != nil {
+			arg1 = args[1].(int)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRemindersRepository_LockDueReminders_Call) Return(reminders []*entities.Reminder, err error) *MockRemindersRepository_LockDueReminders_Call {
+	_c.Call.Return(reminders, err)
+	return _c
+}
+
+func (_c *MockRemindersRepository_LockDueReminders_Call) RunAndReturn(run func(ctx context.Context, limit int) ([]*entities.Reminder, error)) *MockRemindersRepository_LockDueReminders_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SaveReminder provides a mock function for the type MockRemindersRepository
+func (_mock *MockRemindersRepository) SaveReminder(ctx context.Context, reminder *entities.Reminder) (*entities.Reminder, error) {
+	ret := _mock.Called(ctx, reminder)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveReminder")
+	}
+
+	var r0 *entities.Reminder
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *entities.Reminder) (*entities.Reminder, error)); ok {
+		return returnFunc(ctx, reminder)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *entities.Reminder) *entities.Reminder); ok {
+		r0 = returnFunc(ctx, reminder)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.Reminder)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *entities.Reminder) error); ok {
+		r1 = returnFunc(ctx, reminder)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRemindersRepository_SaveReminder_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveReminder'
+type MockRemindersRepository_SaveReminder_Call struct {
+	*mock.Call
+}
+
+// SaveReminder is a helper method to define mock.On call
+//   - ctx context.Context
+//   - reminder *entities.Reminder
+func (_e *MockRemindersRepository_Expecter) SaveReminder(ctx interface{}, reminder interface{}) *MockRemindersRepository_SaveReminder_Call {
+	return &MockRemindersRepository_SaveReminder_Call{Call: _e.mock.On("SaveReminder", ctx, reminder)}
+}
+
+func (_c *MockRemindersRepository_SaveReminder_Call) Run(run func(ctx context.Context, reminder *entities.Reminder)) *MockRemindersRepository_SaveReminder_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *entities.Reminder
+		if args[1] != nil {
+			arg1 = args[1].(*entities.Reminder)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRemindersRepository_SaveReminder_Call) Return(reminder1 *entities.Reminder, err error) *MockRemindersRepository_SaveReminder_Call {
+	_c.Call.Return(reminder1, err)
+	return _c
+}
+
+func (_c *MockRemindersRepository_SaveReminder_Call) RunAndReturn(run func(ctx context.Context, reminder *entities.Reminder) (*entities.Reminder, error)) *MockRemindersRepository_SaveReminder_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateReminder provides a mock function for the type MockRemindersRepository
+func (_mock *MockRemindersRepository) UpdateReminder(ctx context.Context, reminder *entities.Reminder) error {
+	ret := _mock.Called(ctx, reminder)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateReminder")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *entities.Reminder) error); ok {
+		r0 = returnFunc(ctx, reminder)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockRemindersRepository_UpdateReminder_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateReminder'
+type MockRemindersRepository_UpdateReminder_Call struct {
+	*mock.Call
+}
+
+// UpdateReminder is a helper method to define mock.On call
+//   - ctx context.Context
+//   - reminder *entities.Reminder
+func (_e *MockRemindersRepository_Expecter) UpdateReminder(ctx interface{}, reminder interface{}) *MockRemindersRepository_UpdateReminder_Call {
+	return &MockRemindersRepository_UpdateReminder_Call{Call: _e.mock.On("UpdateReminder", ctx, reminder)}
+}
+
+func (_c *MockRemindersRepository_UpdateReminder_Call) Run(run func(ctx context.Context, reminder *entities.Reminder)) *MockRemindersRepository_UpdateReminder_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *entities.Reminder
+		if args[1] != nil {
+			arg1 = args[1].(*entities.Reminder)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRemindersRepository_UpdateReminder_Call) Return(err error) *MockRemindersRepository_UpdateReminder_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockRemindersRepository_UpdateReminder_Call) RunAndReturn(run func(ctx context.Context, reminder *entities.Reminder) error) *MockRemindersRepository_UpdateReminder_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -0,0 +1,103 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	mock "github.com/stretchr/testify/mock"
+	"github.com/therenotomorrow/gotes/internal/domain/entities"
+)
+
+// NewMockRenderer creates a new instance of MockRenderer. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockRenderer(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockRenderer {
+	mock := &MockRenderer{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockRenderer is an autogenerated mock type for the Renderer type
+type MockRenderer struct {
+	mock.Mock
+}
+
+type MockRenderer_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockRenderer) EXPECT() *MockRenderer_Expecter {
+	return &MockRenderer_Expecter{mock: &_m.Mock}
+}
+
+// Render provides a mock function for the type MockRenderer
+func (_mock *MockRenderer) Render(content string, format entities.Format) (string, error) {
+	ret := _mock.Called(content, format)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Render")
+	}
+
+	var r0 string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string, entities.Format) (string, error)); ok {
+		return returnFunc(content, format)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string, entities.Format) string); ok {
+		r0 = returnFunc(content, format)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	if returnFunc, ok := ret.Get(1).(func(string, entities.Format) error); ok {
+		r1 = returnFunc(content, format)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRenderer_Render_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Render'
+type MockRenderer_Render_Call struct {
+	*mock.Call
+}
+
+// Render is a helper method to define mock.On call
+//   - content string
+//   - format entities.Format
+func (_e *MockRenderer_Expecter) Render(content interface{}, format interface{}) *MockRenderer_Render_Call {
+	return &MockRenderer_Render_Call{Call: _e.mock.On("Render", content, format)}
+}
+
+func (_c *MockRenderer_Render_Call) Run(run func(content string, format entities.Format)) *MockRenderer_Render_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		var arg1 entities.Format
+		if args[1] != nil {
+			arg1 = args[1].(entities.Format)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRenderer_Render_Call) Return(s string, err error) *MockRenderer_Render_Call {
+	_c.Call.Return(s, err)
+	return _c
+}
+
+func (_c *MockRenderer_Render_Call) RunAndReturn(run func(content string, format entities.Format) (string, error)) *MockRenderer_Render_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -0,0 +1,170 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"context"
+
+	mock "github.com/stretchr/testify/mock"
+	"github.com/therenotomorrow/gotes/internal/domain/entities"
+)
+
+// NewMockRenders creates a new instance of MockRenders. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockRenders(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockRenders {
+	mock := &MockRenders{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockRenders is an autogenerated mock type for the Renders type
+type MockRenders struct {
+	mock.Mock
+}
+
+type MockRenders_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockRenders) EXPECT() *MockRenders_Expecter {
+	return &MockRenders_Expecter{mock: &_m.Mock}
+}
+
+// GetRenders provides a mock function for the type MockRenders
+func (_mock *MockRenders) GetRenders(ctx context.Context, notes []*entities.Note, format entities.Format) ([]*entities.Render, error) {
+	ret := _mock.Called(ctx, notes, format)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRenders")
+	}
+
+	var r0 []*entities.Render
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []*entities.Note, entities.Format) ([]*entities.Render, error)); ok {
+		return returnFunc(ctx, notes, format)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []*entities.Note, entities.Format) []*entities.Render); ok {
+		r0 = returnFunc(ctx, notes, format)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.Render)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, []*entities.Note, entities.Format) error); ok {
+		r1 = returnFunc(ctx, notes, format)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRenders_GetRenders_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRenders'
+type MockRenders_GetRenders_Call struct {
+	*mock.Call
+}
+
+// GetRenders is a helper method to define mock.On call
+//   - ctx context.Context
+//   - notes []*entities.Note
+//   - format entities.Format
+func (_e *MockRenders_Expecter) GetRenders(ctx interface{}, notes interface{}, format interface{}) *MockRenders_GetRenders_Call {
+	return &MockRenders_GetRenders_Call{Call: _e.mock.On("GetRenders", ctx, notes, format)}
+}
+
+func (_c *MockRenders_GetRenders_Call) Run(run func(ctx context.Context, notes []*entities.Note, format entities.Format)) *MockRenders_GetRenders_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 []*entities.Note
+		if args[1] != nil {
+			arg1 = args[1].([]*entities.Note)
+		}
+		var arg2 entities.Format
+		if args[2] != nil {
+			arg2 = args[2].(entities.Format)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRenders_GetRenders_Call) Return(renders []*entities.Render, err error) *MockRenders_GetRenders_Call {
+	_c.Call.Return(renders, err)
+	return _c
+}
+
+func (_c *MockRenders_GetRenders_Call) RunAndReturn(run func(ctx context.Context, notes []*entities.Note, format entities.Format) ([]*entities.Render, error)) *MockRenders_GetRenders_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SaveRenders provides a mock function for the type MockRenders
+func (_mock *MockRenders) SaveRenders(ctx context.Context, renders []*entities.Render) error {
+	ret := _mock.Called(ctx, renders)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveRenders")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []*entities.Render) error); ok {
+		r0 = returnFunc(ctx, renders)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockRenders_SaveRenders_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveRenders'
+type MockRenders_SaveRenders_Call struct {
+	*mock.Call
+}
+
+// SaveRenders is a helper method to define mock.On call
+//   - ctx context.Context
+//   - renders []*entities.Render
+func (_e *MockRenders_Expecter) SaveRenders(ctx interface{}, renders interface{}) *MockRenders_SaveRenders_Call {
+	return &MockRenders_SaveRenders_Call{Call: _e.mock.On("SaveRenders", ctx, renders)}
+}
+
+func (_c *MockRenders_SaveRenders_Call) Run(run func(ctx context.Context, renders []*entities.Render)) *MockRenders_SaveRenders_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 []*entities.Render
+		if args[1] != nil {
+			arg1 = args[1].([]*entities.Render)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRenders_SaveRenders_Call) Return(err error) *MockRenders_SaveRenders_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockRenders_SaveRenders_Call) RunAndReturn(run func(ctx context.Context, renders []*entities.Render) error) *MockRenders_SaveRenders_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -0,0 +1,256 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"context"
+
+	mock "github.com/stretchr/testify/mock"
+	"github.com/therenotomorrow/gotes/internal/api/notes/v1/ports"
+	"github.com/therenotomorrow/gotes/internal/domain/entities"
+)
+
+// NewMockRevisionsRepository creates a new instance of MockRevisionsRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockRevisionsRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockRevisionsRepository {
+	mock := &MockRevisionsRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockRevisionsRepository is an autogenerated mock type for the RevisionsRepository type
+type MockRevisionsRepository struct {
+	mock.Mock
+}
+
+type MockRevisionsRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockRevisionsRepository) EXPECT() *MockRevisionsRepository_Expecter {
+	return &MockRevisionsRepository_Expecter{mock: &_m.Mock}
+}
+
+// GetRevision provides a mock function for the type MockRevisionsRepository
+func (_mock *MockRevisionsRepository) GetRevision(ctx context.Context, note *entities.Note, number int32) (*entities.Revision, error) {
+	ret := _mock.Called(ctx, note, number)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRevision")
+	}
+
+	var r0 *entities.Revision
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *entities.Note, int32) (*entities.Revision, error)); ok {
+		return returnFunc(ctx, note, number)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *entities.Note, int32) *entities.Revision); ok {
+		r0 = returnFunc(ctx, note, number)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.Revision)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *entities.Note, int32) error); ok {
+		r1 = returnFunc(ctx, note, number)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRevisionsRepository_GetRevision_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRevision'
+type MockRevisionsRepository_GetRevision_Call struct {
+	*mock.Call
+}
+
+// GetRevision is a helper method to define mock.On call
+//   - ctx context.Context
+//   - note *entities.Note
+//   - number int32
+func (_e *MockRevisionsRepository_Expecter) GetRevision(ctx interface{}, note interface{}, number interface{}) *MockRevisionsRepository_GetRevision_Call {
+	return &MockRevisionsRepository_GetRevision_Call{Call: _e.mock.On("GetRevision", ctx, note, number)}
+}
+
+func (_c *MockRevisionsRepository_GetRevision_Call) Run(run func(ctx context.Context, note *entities.Note, number int32)) *MockRevisionsRepository_GetRevision_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *entities.Note
+		if args[1] != nil {
+			arg1 = args[1].(*entities.Note)
+		}
+		var arg2 int32
+		if args[2] != nil {
+			arg2 = args[2].(int32)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRevisionsRepository_GetRevision_Call) Return(revision *entities.Revision, err error) *MockRevisionsRepository_GetRevision_Call {
+	_c.Call.Return(revision, err)
+	return _c
+}
+
+func (_c *MockRevisionsRepository_GetRevision_Call) RunAndReturn(run func(ctx context.Context, note *entities.Note, number int32) (*entities.Revision, error)) *MockRevisionsRepository_GetRevision_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRevisionsByNote provides a mock function for the type MockRevisionsRepository
+func (_mock *MockRevisionsRepository) GetRevisionsByNote(ctx context.Context, note *entities.Note, query *ports.RevisionsQuery) ([]*entities.Revision, error) {
+	ret := _mock.Called(ctx, note, query)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRevisionsByNote")
+	}
+
+	var r0 []*entities.Revision
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *entities.Note, *ports.RevisionsQuery) ([]*entities.Revision, error)); ok {
+		return returnFunc(ctx, note, query)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *entities.Note, *ports.RevisionsQuery) []*entities.Revision); ok {
+		r0 = returnFunc(ctx, note, query)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.Revision)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *entities.Note, *ports.RevisionsQuery) error); ok {
+		r1 = returnFunc(ctx, note, query)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRevisionsRepository_GetRevisionsByNote_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRevisionsByNote'
+type MockRevisionsRepository_GetRevisionsByNote_Call struct {
+	*mock.Call
+}
+
+// GetRevisionsByNote is a helper method to define mock.On call
+//   - ctx context.Context
+//   - note *entities.Note
+//   - query *ports.RevisionsQuery
+func (_e *MockRevisionsRepository_Expecter) GetRevisionsByNote(ctx interface{}, note interface{}, query interface{}) *MockRevisionsRepository_GetRevisionsByNote_Call {
+	return &MockRevisionsRepository_GetRevisionsByNote_Call{Call: _e.mock.On("GetRevisionsByNote", ctx, note, query)}
+}
+
+func (_c *MockRevisionsRepository_GetRevisionsByNote_Call) Run(run func(ctx context.Context, note *entities.Note, query *ports.RevisionsQuery)) *MockRevisionsRepository_GetRevisionsByNote_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *entities.Note
+		if args[1] != nil {
+			arg1 = args[1].(*entities.Note)
+		}
+		var arg2 *ports.RevisionsQuery
+		if args[2] != nil {
+			arg2 = args[2].(*ports.RevisionsQuery)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRevisionsRepository_GetRevisionsByNote_Call) Return(revisions []*entities.Revision, err error) *MockRevisionsRepository_GetRevisionsByNote_Call {
+	_c.Call.Return(revisions, err)
+	return _c
+}
+
+func (_c *MockRevisionsRepository_GetRevisionsByNote_Call) RunAndReturn(run func(ctx context.Context, note *entities.Note, query *ports.RevisionsQuery) ([]*entities.Revision, error)) *MockRevisionsRepository_GetRevisionsByNote_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SaveRevision provides a mock function for the type MockRevisionsRepository
+func (_mock *MockRevisionsRepository) SaveRevision(ctx context.Context, revision *entities.Revision) (*entities.Revision, error) {
+	ret := _mock.Called(ctx, revision)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveRevision")
+	}
+
+	var r0 *entities.Revision
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *entities.Revision) (*entities.Revision, error)); ok {
+		return returnFunc(ctx, revision)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *entities.Revision) *entities.Revision); ok {
+		r0 = returnFunc(ctx, revision)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.Revision)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *entities.Revision) error); ok {
+		r1 = returnFunc(ctx, revision)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRevisionsRepository_SaveRevision_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveRevision'
+type MockRevisionsRepository_SaveRevision_Call struct {
+	*mock.Call
+}
+
+// SaveRevision is a helper method to define mock.On call
+//   - ctx context.Context
+//   - revision *entities.Revision
+func (_e *MockRevisionsRepository_Expecter) SaveRevision(ctx interface{}, revision interface{}) *MockRevisionsRepository_SaveRevision_Call {
+	return &MockRevisionsRepository_SaveRevision_Call{Call: _e.mock.On("SaveRevision", ctx, revision)}
+}
+
+func (_c *MockRevisionsRepository_SaveRevision_Call) Run(run func(ctx context.Context, revision *entities.Revision)) *MockRevisionsRepository_SaveRevision_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *entities.Revision
+		if args[1] != nil {
+			arg1 = args[1].(*entities.Revision)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRevisionsRepository_SaveRevision_Call) Return(revision1 *entities.Revision, err error) *MockRevisionsRepository_SaveRevision_Call {
+	_c.Call.Return(revision1, err)
+	return _c
+}
+
+func (_c *MockRevisionsRepository_SaveRevision_Call) RunAndReturn(run func(ctx context.Context, revision *entities.Revision) (*entities.Revision, error)) *MockRevisionsRepository_SaveRevision_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -0,0 +1,516 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"context"
+
+	mock "github.com/stretchr/testify/mock"
+	"github.com/therenotomorrow/gotes/internal/api/notes/v1/ports"
+	"github.com/therenotomorrow/gotes/internal/domain/entities"
+	"github.com/therenotomorrow/gotes/internal/domain/types/email"
+)
+
+// NewMockSharesRepository creates a new instance of MockSharesRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockSharesRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockSharesRepository {
+	mock := &MockSharesRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockSharesRepository is an autogenerated mock type for the SharesRepository type
+type MockSharesRepository struct {
+	mock.Mock
+}
+
+type MockSharesRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockSharesRepository) EXPECT() *MockSharesRepository_Expecter {
+	return &MockSharesRepository_Expecter{mock: &_m.Mock}
+}
+
+// CountSharesByUser provides a mock function for the type MockSharesRepository
+func (_mock *MockSharesRepository) CountSharesByUser(ctx context.Context, user *entities.User) (int32, error) {
+	ret := _mock.Called(ctx, user)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountSharesByUser")
+	}
+
+	var r0 int32
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *entities.User) (int32, error)); ok {
+		return returnFunc(ctx, user)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *entities.User) int32); ok {
+		r0 = returnFunc(ctx, user)
+	} else {
+		r0 = ret.Get(0).(int32)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *entities.User) error); ok {
+		r1 = returnFunc(ctx, user)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSharesRepository_CountSharesByUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountSharesByUser'
+type MockSharesRepository_CountSharesByUser_Call struct {
+	*mock.Call
+}
+
+// CountSharesByUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - user *entities.User
+func (_e *MockSharesRepository_Expecter) CountSharesByUser(ctx interface{}, user interface{}) *MockSharesRepository_CountSharesByUser_Call {
+	return &MockSharesRepository_CountSharesByUser_Call{Call: _e.mock.On("CountSharesByUser", ctx, user)}
+}
+
+func (_c *MockSharesRepository_CountSharesByUser_Call) Run(run func(ctx context.Context, user *entities.User)) *MockSharesRepository_CountSharesByUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *entities.User
+		if args[1] != nil {
+			arg1 = args[1].(*entities.User)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSharesRepository_CountSharesByUser_Call) Return(n int32, err error) *MockSharesRepository_CountSharesByUser_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockSharesRepository_CountSharesByUser_Call) RunAndReturn(run func(ctx context.Context, user *entities.User) (int32, error)) *MockSharesRepository_CountSharesByUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteShare provides a mock function for the type MockSharesRepository
+func (_mock *MockSharesRepository) DeleteShare(ctx context.Context, share *entities.Share) error {
+	ret := _mock.Called(ctx, share)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteShare")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *entities.Share) error); ok {
+		r0 = returnFunc(ctx, share)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockSharesRepository_DeleteShare_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteShare'
+type MockSharesRepository_DeleteShare_Call struct {
+	*mock.Call
+}
+
+// DeleteShare is a helper method to define mock.On call
+//   - ctx context.Context
+//   - share *entities.Share
+func (_e *MockSharesRepository_Expecter) DeleteShare(ctx interface{}, share interface{}) *MockSharesRepository_DeleteShare_Call {
+	return &MockSharesRepository_DeleteShare_Call{Call: _e.mock.On("DeleteShare", ctx, share)}
+}
+
+func (_c *MockSharesRepository_DeleteShare_Call) Run(run func(ctx context.Context, share *entities.Share)) *MockSharesRepository_DeleteShare_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *entities.Share
+		if args[1] != nil {
+			arg1 = args[1].(*entities.Share)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSharesRepository_DeleteShare_Call) Return(err error) *MockSharesRepository_DeleteShare_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockSharesRepository_DeleteShare_Call) RunAndReturn(run func(ctx context.Context, share *entities.Share) error) *MockSharesRepository_DeleteShare_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetCollaborator provides a mock function for the type MockSharesRepository
+func (_mock *MockSharesRepository) GetCollaborator(ctx context.Context, mail email.Email) (*entities.User, error) {
+	ret := _mock.Called(ctx, mail)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCollaborator")
+	}
+
+	var r0 *entities.User
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, email.Email) (*entities.User, error)); ok {
+		return returnFunc(ctx, mail)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, email.Email) *entities.User); ok {
+		r0 = returnFunc(ctx, mail)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.User)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, email.Email) error); ok {
+		r1 = returnFunc(ctx, mail)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSharesRepository_GetCollaborator_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCollaborator'
+type MockSharesRepository_GetCollaborator_Call struct {
+	*mock.Call
+}
+
+// GetCollaborator is a helper method to define mock.On call
+//   - ctx context.Context
+//   - mail email.Email
+func (_e *MockSharesRepository_Expecter) GetCollaborator(ctx interface{}, mail interface{}) *MockSharesRepository_GetCollaborator_Call {
+	return &MockSharesRepository_GetCollaborator_Call{Call: _e.mock.On("GetCollaborator", ctx, mail)}
+}
+
+func (_c *MockSharesRepository_GetCollaborator_Call) Run(run func(ctx context.Context, mail email.Email)) *MockSharesRepository_GetCollaborator_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 email.Email
+		if args[1] != nil {
+			arg1 = args[1].(email.Email)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSharesRepository_GetCollaborator_Call) Return(user *entities.User, err error) *MockSharesRepository_GetCollaborator_Call {
+	_c.Call.Return(user, err)
+	return _c
+}
+
+func (_c *MockSharesRepository_GetCollaborator_Call) RunAndReturn(run func(ctx context.Context, mail email.Email) (*entities.User, error)) *MockSharesRepository_GetCollaborator_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetShare provides a mock function for the type MockSharesRepository
+func (_mock *MockSharesRepository) GetShare(ctx context.Context, note *entities.Note, user *entities.User) (*entities.Share, error) {
+	ret := _mock.Called(ctx, note, user)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetShare")
+	}
+
+	var r0 *entities.Share
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *entities.Note, *entities.User) (*entities.Share, error)); ok {
+		return returnFunc(ctx, note, user)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *entities.Note, *entities.User) *entities.Share); ok {
+		r0 = returnFunc(ctx, note, user)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.Share)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *entities.Note, *entities.User) error); ok {
+		r1 = returnFunc(ctx, note, user)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSharesRepository_GetShare_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetShare'
+type MockSharesRepository_GetShare_Call struct {
+	*mock.Call
+}
+
+// GetShare is a helper method to define mock.On call
+//   - ctx context.Context
+//   - note *entities.Note
+//   - user *entities.User
+func (_e *MockSharesRepository_Expecter) GetShare(ctx interface{}, note interface{}, user interface{}) *MockSharesRepository_GetShare_Call {
+	return &MockSharesRepository_GetShare_Call{Call: _e.mock.On("GetShare", ctx, note, user)}
+}
+
+func (_c *MockSharesRepository_GetShare_Call) Run(run func(ctx context.Context, note *entities.Note, user *entities.User)) *MockSharesRepository_GetShare_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *entities.Note
+		if args[1] != nil {
+			arg1 = args[1].(*entities.Note)
+		}
+		var arg2 *entities.User
+		if args[2] != nil {
+			arg2 = args[2].(*entities.User)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSharesRepository_GetShare_Call) Return(share *entities.Share, err error) *MockSharesRepository_GetShare_Call {
+	_c.Call.Return(share, err)
+	return _c
+}
+
+func (_c *MockSharesRepository_GetShare_Call) RunAndReturn(run func(ctx context.Context, note *entities.Note, user *entities.User) (*entities.Share, error)) *MockSharesRepository_GetShare_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSharesByNote provides a mock function for the type MockSharesRepository
+func (_mock *MockSharesRepository) GetSharesByNote(ctx context.Context, note *entities.Note) ([]*entities.Share, error) {
+	ret := _mock.Called(ctx, note)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSharesByNote")
+	}
+
+	var r0 []*entities.Share
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *entities.Note) ([]*entities.Share, error)); ok {
+		return returnFunc(ctx, note)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *entities.Note) []*entities.Share); ok {
+		r0 = returnFunc(ctx, note)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.Share)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *entities.Note) error); ok {
+		r1 = returnFunc(ctx, note)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSharesRepository_GetSharesByNote_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSharesByNote'
+type MockSharesRepository_GetSharesByNote_Call struct {
+	*mock.Call
+}
+
+// GetSharesByNote is a helper method to define mock.On call
+//   - ctx context.Context
+//   - note *entities.Note
+func (_e *MockSharesRepository_Expecter) GetSharesByNote(ctx interface{}, note interface{}) *MockSharesRepository_GetSharesByNote_Call {
+	return &MockSharesRepository_GetSharesByNote_Call{Call: _e.mock.On("GetSharesByNote", ctx, note)}
+}
+
+func (_c *MockSharesRepository_GetSharesByNote_Call) Run(run func(ctx context.Context, note *entities.Note)) *MockSharesRepository_GetSharesByNote_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *entities.Note
+		if args[1] != nil {
+			arg1 = args[1].(*entities.Note)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSharesRepository_GetSharesByNote_Call) Return(shares []*entities.Share, err error) *MockSharesRepository_GetSharesByNote_Call {
+	_c.Call.Return(shares, err)
+	return _c
+}
+
+func (_c *MockSharesRepository_GetSharesByNote_Call) RunAndReturn(run func(ctx context.Context, note *entities.Note) ([]*entities.Share, error)) *MockSharesRepository_GetSharesByNote_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSharesByUser provides a mock function for the type MockSharesRepository
+func (_mock *MockSharesRepository) GetSharesByUser(ctx context.Context, user *entities.User, query *ports.SharesQuery) ([]*entities.Share, error) {
+	ret := _mock.Called(ctx, user, query)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSharesByUser")
+	}
+
+	var r0 []*entities.Share
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *entities.User, *ports.SharesQuery) ([]*entities.Share, error)); ok {
+		return returnFunc(ctx, user, query)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *entities.User, *ports.SharesQuery) []*entities.Share); ok {
+		r0 = returnFunc(ctx, user, query)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.Share)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *entities.User, *ports.SharesQuery) error); ok {
+		r1 = returnFunc(ctx, user, query)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSharesRepository_GetSharesByUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSharesByUser'
+type MockSharesRepository_GetSharesByUser_Call struct {
+	*mock.Call
+}
+
+// GetSharesByUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - user *entities.User
+//   - query *ports.SharesQuery
+func (_e *MockSharesRepository_Expecter) GetSharesByUser(ctx interface{}, user interface{}, query interface{}) *MockSharesRepository_GetSharesByUser_Call {
+	return &MockSharesRepository_GetSharesByUser_Call{Call: _e.mock.On("GetSharesByUser", ctx, user, query)}
+}
+
+func (_c *MockSharesRepository_GetSharesByUser_Call) Run(run func(ctx context.Context, user *entities.User, query *ports.SharesQuery)) *MockSharesRepository_GetSharesByUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *entities.User
+		if args[1] != nil {
+			arg1 = args[1].(*entities.User)
+		}
+		var arg2 *ports.SharesQuery
+		if args[2] != nil {
+			arg2 = args[2].(*ports.SharesQuery)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSharesRepository_GetSharesByUser_Call) Return(shares []*entities.Share, err error) *MockSharesRepository_GetSharesByUser_Call {
+	_c.Call.Return(shares, err)
+	return _c
+}
+
+func (_c *MockSharesRepository_GetSharesByUser_Call) RunAndReturn(run func(ctx context.Context, user *entities.User, query *ports.SharesQuery) ([]*entities.Share, error)) *MockSharesRepository_GetSharesByUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SaveShare provides a mock function for the type MockSharesRepository
+func (_mock *MockSharesRepository) SaveShare(ctx context.Context, share *entities.Share) (*entities.Share, error) {
+	ret := _mock.Called(ctx, share)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveShare")
+	}
+
+	var r0 *entities.Share
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *entities.Share) (*entities.Share, error)); ok {
+		return returnFunc(ctx, share)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *entities.Share) *entities.Share); ok {
+		r0 = returnFunc(ctx, share)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.Share)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *entities.Share) error); ok {
+		r1 = returnFunc(ctx, share)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSharesRepository_SaveShare_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveShare'
+type MockSharesRepository_SaveShare_Call struct {
+	*mock.Call
+}
+
+// SaveShare is a helper method to define mock.On call
+//   - ctx context.Context
+//   - share *entities.Share
+func (_e *MockSharesRepository_Expecter) SaveShare(ctx interface{}, share interface{}) *MockSharesRepository_SaveShare_Call {
+	return &MockSharesRepository_SaveShare_Call{Call: _e.mock.On("SaveShare", ctx, share)}
+}
+
+func (_c *MockSharesRepository_SaveShare_Call) Run(run func(ctx context.Context, share *entities.Share)) *MockSharesRepository_SaveShare_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *entities.Share
+		if args[1] != nil {
+			arg1 = args[1].(*entities.Share)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSharesRepository_SaveShare_Call) Return(share1 *entities.Share, err error) *MockSharesRepository_SaveShare_Call {
+	_c.Call.Return(share1, err)
+	return _c
+}
+
+func (_c *MockSharesRepository_SaveShare_Call) RunAndReturn(run func(ctx context.Context, share *entities.Share) (*entities.Share, error)) *MockSharesRepository_SaveShare_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -0,0 +1,485 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"context"
+
+	mock "github.com/stretchr/testify/mock"
+	"github.com/therenotomorrow/gotes/internal/api/notes/v1/ports"
+	"github.com/therenotomorrow/gotes/internal/domain/entities"
+)
+
+// NewMockTagsRepository creates a new instance of MockTagsRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockTagsRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockTagsRepository {
+	mock := &MockTagsRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockTagsRepository is an autogenerated mock type for the TagsRepository type
+type MockTagsRepository struct {
+	mock.Mock
+}
+
+type MockTagsRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockTagsRepository) EXPECT() *MockTagsRepository_Expecter {
+	return &MockTagsRepository_Expecter{mock: &_m.Mock}
+}
+
+// AddNoteTags provides a mock function for the type MockTagsRepository
+func (_mock *MockTagsRepository) AddNoteTags(ctx context.Context, note *entities.Note, tags []*entities.Tag) error {
+	ret := _mock.Called(ctx, note, tags)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddNoteTags")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *entities.Note, []*entities.Tag) error); ok {
+		r0 = returnFunc(ctx, note, tags)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockTagsRepository_AddNoteTags_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddNoteTags'
+type MockTagsRepository_AddNoteTags_Call struct {
+	*mock.Call
+}
+
+// AddNoteTags is a helper method to define mock.On call
+//   - ctx context.Context
+//   - note *entities.Note
+//   - tags []*entities.Tag
+func (_e *MockTagsRepository_Expecter) AddNoteTags(ctx interface{}, note interface{}, tags interface{}) *MockTagsRepository_AddNoteTags_Call {
+	return &MockTagsRepository_AddNoteTags_Call{Call: _e.mock.On("AddNoteTags", ctx, note, tags)}
+}
+
+func (_c *MockTagsRepository_AddNoteTags_Call) Run(run func(ctx context.Context, note *entities.Note, tags []*entities.Tag)) *MockTagsRepository_AddNoteTags_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *entities.Note
+		if args[1] != nil {
+			arg1 = args[1].(*entities.Note)
+		}
+		var arg2 []*entities.Tag
+		if args[2] != nil {
+			arg2 = args[2].([]*entities.Tag)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockTagsRepository_AddNoteTags_Call) Return(err error) *MockTagsRepository_AddNoteTags_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockTagsRepository_AddNoteTags_Call) RunAndReturn(run func(ctx context.Context, note *entities.Note, tags []*entities.Tag) error) *MockTagsRepository_AddNoteTags_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTag provides a mock function for the type MockTagsRepository
+func (_mock *MockTagsRepository) GetTag(ctx context.Context, user *entities.User, name string) (*entities.Tag, error) {
+	ret := _mock.Called(ctx, user, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTag")
+	}
+
+	var r0 *entities.Tag
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *entities.User, string) (*entities.Tag, error)); ok {
+		return returnFunc(ctx, user, name)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *entities.User, string) *entities.Tag); ok {
+		r0 = returnFunc(ctx, user, name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.Tag)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *entities.User, string) error); ok {
+		r1 = returnFunc(ctx, user, name)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockTagsRepository_GetTag_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTag'
+type MockTagsRepository_GetTag_Call struct {
+	*mock.Call
+}
+
+// GetTag is a helper method to define mock.On call
+//   - ctx context.Context
+//   - user *entities.User
+//   - name string
+func (_e *MockTagsRepository_Expecter) GetTag(ctx interface{}, user interface{}, name interface{}) *MockTagsRepository_GetTag_Call {
+	return &MockTagsRepository_GetTag_Call{Call: _e.mock.On("GetTag", ctx, user, name)}
+}
+
+func (_c *MockTagsRepository_GetTag_Call) Run(run func(ctx context.Context, user *entities.User, name string)) *MockTagsRepository_GetTag_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *entities.User
+		if args[1] != nil {
+			arg1 = args[1].(*entities.User)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockTagsRepository_GetTag_Call) Return(tag *entities.Tag, err error) *MockTagsRepository_GetTag_Call {
+	_c.Call.Return(tag, err)
+	return _c
+}
+
+func (_c *MockTagsRepository_GetTag_Call) RunAndReturn(run func(ctx context.Context, user *entities.User, name string) (*entities.Tag, error)) *MockTagsRepository_GetTag_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTagsByUser provides a mock function for the type MockTagsRepository
+func (_mock *MockTagsRepository) GetTagsByUser(ctx context.Context, user *entities.User) ([]*ports.TagCount, error) {
+	ret := _mock.Called(ctx, user)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTagsByUser")
+	}
+
+	var r0 []*ports.TagCount
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *entities.User) ([]*ports.TagCount, error)); ok {
+		return returnFunc(ctx, user)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *entities.User) []*ports.TagCount); ok {
+		r0 = returnFunc(ctx, user)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*ports.TagCount)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *entities.User) error); ok {
+		r1 = returnFunc(ctx, user)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockTagsRepository_GetTagsByUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTagsByUser'
+type MockTagsRepository_GetTagsByUser_Call struct {
+	*mock.Call
+}
+
+// GetTagsByUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - user *entities.User
+func (_e *MockTagsRepository_Expecter) GetTagsByUser(ctx interface{}, user interface{}) *MockTagsRepository_GetTagsByUser_Call {
+	return &MockTagsRepository_GetTagsByUser_Call{Call: _e.mock.On("GetTagsByUser", ctx, user)}
+}
+
+func (_c *MockTagsRepository_GetTagsByUser_Call) Run(run func(ctx context.Context, user *entities.User)) *MockTagsRepository_GetTagsByUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *entities.User
+		if args[1] != nil {
+			arg1 = args[1].(*entities.User)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockTagsRepository_GetTagsByUser_Call) Return(tagCounts []*ports.TagCount, err error) *MockTagsRepository_GetTagsByUser_Call {
+	_c.Call.Return(tagCounts, err)
+	return _c
+}
+
+func (_c *MockTagsRepository_GetTagsByUser_Call) RunAndReturn(run func(ctx context.Context, user *entities.User) ([]*ports.TagCount, error)) *MockTagsRepository_GetTagsByUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LoadNoteTags provides a mock function for the type MockTagsRepository
+func (_mock *MockTagsRepository) LoadNoteTags(ctx context.Context, notes []*entities.Note) error {
+	ret := _mock.Called(ctx, notes)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LoadNoteTags")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []*entities.Note) error); ok {
+		r0 = returnFunc(ctx, notes)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockTagsRepository_LoadNoteTags_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LoadNoteTags'
+type MockTagsRepository_LoadNoteTags_Call struct {
+	*mock.Call
+}
+
+// LoadNoteTags is a helper method to define mock.On call
+//   - ctx context.Context
+//   - notes []*entities.Note
+func (_e *MockTagsRepository_Expecter) LoadNoteTags(ctx interface{}, notes interface{}) *MockTagsRepository_LoadNoteTags_Call {
+	return &MockTagsRepository_LoadNoteTags_Call{Call: _e.mock.On("LoadNoteTags", ctx, notes)}
+}
+
+func (_c *MockTagsRepository_LoadNoteTags_Call) Run(run func(ctx context.Context, notes []*entities.Note)) *MockTagsRepository_LoadNoteTags_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 []*entities.Note
+		if args[1] != nil {
+			arg1 = args[1].([]*entities.Note)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockTagsRepository_LoadNoteTags_Call) Return(err error) *MockTagsRepository_LoadNoteTags_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockTagsRepository_LoadNoteTags_Call) RunAndReturn(run func(ctx context.Context, notes []*entities.Note) error) *MockTagsRepository_LoadNoteTags_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MergeTag provides a mock function for the type MockTagsRepository
+func (_mock *MockTagsRepository) MergeTag(ctx context.Context, from *entities.Tag, into *entities.Tag) error {
+	ret := _mock.Called(ctx, from, into)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MergeTag")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *entities.Tag, *entities.Tag) error); ok {
+		r0 = returnFunc(ctx, from, into)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockTagsRepository_MergeTag_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MergeTag'
+type MockTagsRepository_MergeTag_Call struct {
+	*mock.Call
+}
+
+// MergeTag is a helper method to define mock.On call
+//   - ctx context.Context
+//   - from *entities.Tag
+//   - into *entities.Tag
+func (_e *MockTagsRepository_Expecter) MergeTag(ctx interface{}, from interface{}, into interface{}) *MockTagsRepository_MergeTag_Call {
+	return &MockTagsRepository_MergeTag_Call{Call: _e.mock.On("MergeTag", ctx, from, into)}
+}
+
+func (_c *MockTagsRepository_MergeTag_Call) Run(run func(ctx context.Context, from *entities.Tag, into *entities.Tag)) *MockTagsRepository_MergeTag_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *entities.Tag
+		if args[1] != nil {
+			arg1 = args[1].(*entities.Tag)
+		}
+		var arg2 *entities.Tag
+		if args[2] != nil {
+			arg2 = args[2].(*entities.Tag)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockTagsRepository_MergeTag_Call) Return(err error) *MockTagsRepository_MergeTag_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockTagsRepository_MergeTag_Call) RunAndReturn(run func(ctx context.Context, from *entities.Tag, into *entities.Tag) error) *MockTagsRepository_MergeTag_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveNoteTags provides a mock function for the type MockTagsRepository
+func (_mock *MockTagsRepository) RemoveNoteTags(ctx context.Context, note *entities.Note, names []string) error {
+	ret := _mock.Called(ctx, note, names)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveNoteTags")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *entities.Note, []string) error); ok {
+		r0 = returnFunc(ctx, note, names)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockTagsRepository_RemoveNoteTags_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveNoteTags'
+type MockTagsRepository_RemoveNoteTags_Call struct {
+	*mock.Call
+}
+
+// RemoveNoteTags is a helper method to define mock.On call
+//   - ctx context.Context
+//   - note *entities.Note
+//   - names []string
+func (_e *MockTagsRepository_Expecter) RemoveNoteTags(ctx interface{}, note interface{}, names interface{}) *MockTagsRepository_RemoveNoteTags_Call {
+	return &MockTagsRepository_RemoveNoteTags_Call{Call: _e.mock.On("RemoveNoteTags", ctx, note, names)}
+}
+
+func (_c *MockTagsRepository_RemoveNoteTags_Call) Run(run func(ctx context.Context, note *entities.Note, names []string)) *MockTagsRepository_RemoveNoteTags_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *entities.Note
+		if args[1] != nil {
+			arg1 = args[1].(*entities.Note)
+		}
+		var arg2 []string
+		if args[2] != nil {
+			arg2 = args[2].([]string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockTagsRepository_RemoveNoteTags_Call) Return(err error) *MockTagsRepository_RemoveNoteTags_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockTagsRepository_RemoveNoteTags_Call) RunAndReturn(run func(ctx context.Context, note *entities.Note, names []string) error) *MockTagsRepository_RemoveNoteTags_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateTag provides a mock function for the type MockTagsRepository
+func (_mock *MockTagsRepository) UpdateTag(ctx context.Context, tag *entities.Tag) error {
+	ret := _mock.Called(ctx, tag)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateTag")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *entities.Tag) error); ok {
+		r0 = returnFunc(ctx, tag)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockTagsRepository_UpdateTag_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateTag'
+type MockTagsRepository_UpdateTag_Call struct {
+	*mock.Call
+}
+
+// UpdateTag is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tag *entities.Tag
+func (_e *MockTagsRepository_Expecter) UpdateTag(ctx interface{}, tag interface{}) *MockTagsRepository_UpdateTag_Call {
+	return &MockTagsRepository_UpdateTag_Call{Call: _e.mock.On("UpdateTag", ctx, tag)}
+}
+
+func (_c *MockTagsRepository_UpdateTag_Call) Run(run func(ctx context.Context, tag *entities.Tag)) *MockTagsRepository_UpdateTag_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *entities.Tag
+		if args[1] != nil {
+			arg1 = args[1].(*entities.Tag)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockTagsRepository_UpdateTag_Call) Return(err error) *MockTagsRepository_UpdateTag_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockTagsRepository_UpdateTag_Call) RunAndReturn(run func(ctx context.Context, tag *entities.Tag) error) *MockTagsRepository_UpdateTag_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -0,0 +1,358 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"context"
+
+	mock "github.com/stretchr/testify/mock"
+	"github.com/therenotomorrow/gotes/internal/domain/entities"
+	"github.com/therenotomorrow/gotes/internal/domain/types/id"
+)
+
+// NewMockTemplatesRepository creates a new instance of MockTemplatesRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockTemplatesRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockTemplatesRepository {
+	mock := &MockTemplatesRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockTemplatesRepository is an autogenerated mock type for the TemplatesRepository type
+type MockTemplatesRepository struct {
+	mock.Mock
+}
+
+type MockTemplatesRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockTemplatesRepository) EXPECT() *MockTemplatesRepository_Expecter {
+	return &MockTemplatesRepository_Expecter{mock: &_m.Mock}
+}
+
+// DeleteTemplate provides a mock function for the type MockTemplatesRepository
+func (_mock *MockTemplatesRepository) DeleteTemplate(ctx context.Context, template *entities.Template) error {
+	ret := _mock.Called(ctx, template)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteTemplate")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *entities.Template) error); ok {
+		r0 = returnFunc(ctx, template)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockTemplatesRepository_DeleteTemplate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteTemplate'
+type MockTemplatesRepository_DeleteTemplate_Call struct {
+	*mock.Call
+}
+
+// DeleteTemplate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - template *entities.Template
+func (_e *MockTemplatesRepository_Expecter) DeleteTemplate(ctx interface{}, template interface{}) *MockTemplatesRepository_DeleteTemplate_Call {
+	return &MockTemplatesRepository_DeleteTemplate_Call{Call: _e.mock.On("DeleteTemplate", ctx, template)}
+}
+
+func (_c *MockTemplatesRepository_DeleteTemplate_Call) Run(run func(ctx context.Context, template *entities.Template)) *MockTemplatesRepository_DeleteTemplate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *entities.Template
+		if args[1] != nil {
+			arg1 = args[1].(*entities.Template)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockTemplatesRepository_DeleteTemplate_Call) Return(err error) *MockTemplatesRepository_DeleteTemplate_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockTemplatesRepository_DeleteTemplate_Call) RunAndReturn(run func(ctx context.Context, template *entities.Template) error) *MockTemplatesRepository_DeleteTemplate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTemplate provides a mock function for the type MockTemplatesRepository
+func (_mock *MockTemplatesRepository) GetTemplate(ctx context.Context, id1 id.ID) (*entities.Template, error) {
+	ret := _mock.Called(ctx, id1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTemplate")
+	}
+
+	var r0 *entities.Template
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, id.ID) (*entities.Template, error)); ok {
+		return returnFunc(ctx, id1)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, id.ID) *entities.Template); ok {
+		r0 = returnFunc(ctx, id1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.Template)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, id.ID) error); ok {
+		r1 = returnFunc(ctx, id1)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockTemplatesRepository_GetTemplate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTemplate'
+type MockTemplatesRepository_GetTemplate_Call struct {
+	*mock.Call
+}
+
+// GetTemplate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id1 id.ID
+func (_e *MockTemplatesRepository_Expecter) GetTemplate(ctx interface{}, id1 interface{}) *MockTemplatesRepository_GetTemplate_Call {
+	return &MockTemplatesRepository_GetTemplate_Call{Call: _e.mock.On("GetTemplate", ctx, id1)}
+}
+
+func (_c *MockTemplatesRepository_GetTemplate_Call) Run(run func(ctx context.Context, id1 id.ID)) *MockTemplatesRepository_GetTemplate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 id.ID
+		if args[1] != nil {
+			arg1 = args[1].(id.ID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockTemplatesRepository_GetTemplate_Call) Return(template *entities.Template, err error) *MockTemplatesRepository_GetTemplate_Call {
+	_c.Call.Return(template, err)
+	return _c
+}
+
+func (_c *MockTemplatesRepository_GetTemplate_Call) RunAndReturn(run func(ctx context.Context, id1 id.ID) (*entities.Template, error)) *MockTemplatesRepository_GetTemplate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTemplatesByUser provides a mock function for the type MockTemplatesRepository
+func (_mock *MockTemplatesRepository) GetTemplatesByUser(ctx context.Context, user *entities.User) ([]*entities.Template, error) {
+	ret := _mock.Called(ctx, user)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTemplatesByUser")
+	}
+
+	var r0 []*entities.Template
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *entities.User) ([]*entities.Template, error)); ok {
+		return returnFunc(ctx, user)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *entities.User) []*entities.Template); ok {
+		r0 = returnFunc(ctx, user)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.Template)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *entities.User) error); ok {
+		r1 = returnFunc(ctx, user)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockTemplatesRepository_GetTemplatesByUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTemplatesByUser'
+type MockTemplatesRepository_GetTemplatesByUser_Call struct {
+	*mock.Call
+}
+
+// GetTemplatesByUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - user *entities.User
+func (_e *MockTemplatesRepository_Expecter) GetTemplatesByUser(ctx interface{}, user interface{}) *MockTemplatesRepository_GetTemplatesByUser_Call {
+	return &MockTemplatesRepository_GetTemplatesByUser_Call{Call: _e.mock.On("GetTemplatesByUser", ctx, user)}
+}
+
+func (_c *MockTemplatesRepository_GetTemplatesByUser_Call) Run(run func(ctx context.Context, user *entities.User)) *MockTemplatesRepository_GetTemplatesByUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *entities.User
+		if args[1] != nil {
+			arg1 = args[1].(*entities.User)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockTemplatesRepository_GetTemplatesByUser_Call) Return(templates []*entities.Template, err error) *MockTemplatesRepository_GetTemplatesByUser_Call {
+	_c.Call.Return(templates, err)
+	return _c
+}
+
+func (_c *MockTemplatesRepository_GetTemplatesByUser_Call) RunAndReturn(run func(ctx context.Context, user *entities.User) ([]*entities.Template, error)) *MockTemplatesRepository_GetTemplatesByUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SaveTemplate provides a mock function for the type MockTemplatesRepository
+func (_mock *MockTemplatesRepository) SaveTemplate(ctx context.Context, template *entities.Template) (*entities.Template, error) {
+	ret := _mock.Called(ctx, template)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveTemplate")
+	}
+
+	var r0 *entities.Template
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *entities.Template) (*entities.Template, error)); ok {
+		return returnFunc(ctx, template)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *entities.Template) *entities.Template); ok {
+		r0 = returnFunc(ctx, template)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.Template)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *entities.Template) error); ok {
+		r1 = returnFunc(ctx, template)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockTemplatesRepository_SaveTemplate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveTemplate'
+type MockTemplatesRepository_SaveTemplate_Call struct {
+	*mock.Call
+}
+
+// SaveTemplate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - template *entities.Template
+func (_e *MockTemplatesRepository_Expecter) SaveTemplate(ctx interface{}, template interface{}) *MockTemplatesRepository_SaveTemplate_Call {
+	return &MockTemplatesRepository_SaveTemplate_Call{Call: _e.mock.On("SaveTemplate", ctx, template)}
+}
+
+func (_c *MockTemplatesRepository_SaveTemplate_Call) Run(run func(ctx context.Context, template *entities.Template)) *MockTemplatesRepository_SaveTemplate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *entities.Template
+		if args[1] != nil {
+			arg1 = args[1].(*entities.Template)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockTemplatesRepository_SaveTemplate_Call) Return(template1 *entities.Template, err error) *MockTemplatesRepository_SaveTemplate_Call {
+	_c.Call.Return(template1, err)
+	return _c
+}
+
+func (_c *MockTemplatesRepository_SaveTemplate_Call) RunAndReturn(run func(ctx context.Context, template *entities.Template) (*entities.Template, error)) *MockTemplatesRepository_SaveTemplate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateTemplate provides a mock function for the type MockTemplatesRepository
+func (_mock *MockTemplatesRepository) UpdateTemplate(ctx context.Context, template *entities.Template) error {
+	ret := _mock.Called(ctx, template)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateTemplate")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *entities.Template) error); ok {
+		r0 = returnFunc(ctx, template)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockTemplatesRepository_UpdateTemplate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateTemplate'
+type MockTemplatesRepository_UpdateTemplate_Call struct {
+	*mock.Call
+}
+
+// UpdateTemplate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - template *entities.Template
+func (_e *MockTemplatesRepository_Expecter) UpdateTemplate(ctx interface{}, template interface{}) *MockTemplatesRepository_UpdateTemplate_Call {
+	return &MockTemplatesRepository_UpdateTemplate_Call{Call: _e.mock.On("UpdateTemplate", ctx, template)}
+}
+
+func (_c *MockTemplatesRepository_UpdateTemplate_Call) Run(run func(ctx context.Context, template *entities.Template)) *MockTemplatesRepository_UpdateTemplate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *entities.Template
+		if args[1] != nil {
+			arg1 = args[1].(*entities.Template)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockTemplatesRepository_UpdateTemplate_Call) Return(err error) *MockTemplatesRepository_UpdateTemplate_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockTemplatesRepository_UpdateTemplate_Call) RunAndReturn(run func(ctx context.Context, template *entities.Template) error) *MockTemplatesRepository_UpdateTemplate_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -0,0 +1,107 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/therenotomorrow/ex"
+	"github.com/therenotomorrow/gotes/internal/api/notes/v1/usecases"
+	"github.com/therenotomorrow/gotes/internal/domain/entities"
+	"github.com/therenotomorrow/gotes/internal/domain/types/id"
+	"github.com/therenotomorrow/gotes/internal/storages/postgres"
+	commands "github.com/therenotomorrow/gotes/internal/storages/postgres/commands/notes"
+	queries "github.com/therenotomorrow/gotes/internal/storages/postgres/queries/notes"
+)
+
+type AttachmentsRepository struct {
+	commands commands.Querier
+	queries  queries.Querier
+}
+
+func NewAttachmentsRepository(dbtx postgres.DBTX) *AttachmentsRepository {
+	return &AttachmentsRepository{commands: commands.New(dbtx), queries: queries.New(dbtx)}
+}
+
+func (r *AttachmentsRepository) SaveAttachment(
+	ctx context.Context,
+	attachment *entities.Attachment,
+) (*entities.Attachment, error) {
+	ident, err := r.commands.InsertNoteAttachment(ctx, commands.NewInsertNoteAttachmentParams(attachment))
+	if err != nil {
+		return nil, ex.Unexpected(err)
+	}
+
+	attachment.ID = id.New(ident)
+
+	return attachment, nil
+}
+
+func (r *AttachmentsRepository) GetAttachment(ctx context.Context, ident id.ID) (*entities.Attachment, error) {
+	attachment, err := r.queries.SelectNoteAttachment(ctx, ident.Value())
+
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		return nil, usecases.ErrAttachmentNotFound
+	case err != nil:
+		return nil, ex.Unexpected(err)
+	}
+
+	note := new(entities.Note)
+	note.ID = id.New(attachment.NoteID)
+
+	return attachment.ToEntity(note), nil
+}
+
+func (r *AttachmentsRepository) GetAttachmentsByNote(
+	ctx context.Context,
+	note *entities.Note,
+) ([]*entities.Attachment, error) {
+	attachments, err := r.queries.SelectNoteAttachments(ctx, note.ID.Value())
+	if err != nil {
+		return nil, ex.Unexpected(err)
+	}
+
+	return queries.NoteAttachments(attachments).ToEntities(note), nil
+}
+
+func (r *AttachmentsRepository) DeleteAttachment(ctx context.Context, attachment *entities.Attachment) error {
+	cnt, err := r.commands.DeleteNoteAttachment(ctx, attachment.ID.Value())
+
+	switch {
+	case err != nil:
+		return ex.Unexpected(err)
+	case cnt == 0:
+		return usecases.ErrAttachmentNotFound
+	}
+
+	return nil
+}
+
+func (r *AttachmentsRepository) DeleteAttachmentsByNote(ctx context.Context, note *entities.Note) error {
+	err := r.commands.DeleteNoteAttachments(ctx, note.ID.Value())
+
+	return ex.Unexpected(err)
+}
+
+func (r *AttachmentsRepository) PurgeAttachments(ctx context.Context, before time.Time) error {
+	err := r.commands.DeleteTrashedNotesAttachments(ctx, &before)
+
+	return ex.Unexpected(err)
+}
+
+func (r *AttachmentsRepository) LockBuriedBlobs(ctx context.Context, limit int) ([]string, error) {
+	keys, err := r.commands.LockBlobTombstones(ctx, int32(limit)) //nolint:gosec // allowed conversation
+	if err != nil {
+		return nil, ex.Unexpected(err)
+	}
+
+	return keys, nil
+}
+
+func (r *AttachmentsRepository) ForgetBuriedBlobs(ctx context.Context, keys []string) error {
+	err := r.commands.DeleteBlobTombstones(ctx, keys)
+
+	return ex.Unexpected(err)
+}
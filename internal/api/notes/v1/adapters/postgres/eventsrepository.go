@@ -0,0 +1,155 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/therenotomorrow/ex"
+	"github.com/therenotomorrow/gotes/internal/api/notes/v1/ports"
+	"github.com/therenotomorrow/gotes/internal/domain/entities"
+	domuuid "github.com/therenotomorrow/gotes/internal/domain/types/uuid"
+	"github.com/therenotomorrow/gotes/internal/storages/postgres"
+	commands "github.com/therenotomorrow/gotes/internal/storages/postgres/commands/notes"
+	queries "github.com/therenotomorrow/gotes/internal/storages/postgres/queries/notes"
+)
+
+type EventsRepository struct {
+	commands commands.Querier
+	queries  queries.Querier
+}
+
+func NewEventsRepository(dbtx postgres.DBTX) *EventsRepository {
+	return &EventsRepository{commands: commands.New(dbtx), queries: queries.New(dbtx)}
+}
+
+func (r *EventsRepository) SaveEvent(ctx context.Context, event *entities.Event) error {
+	return r.SaveEvents(ctx, []*entities.Event{event})
+}
+
+func (r *EventsRepository) SaveEvents(ctx context.Context, events []*entities.Event) error {
+	if len(events) == 0 {
+		return nil
+	}
+
+	err := r.commands.InsertEvents(ctx, commands.NewInsertEventsParams(events))
+
+	return ex.Unexpected(err)
+}
+
+func (r *EventsRepository) GetEventsByUser(
+	ctx context.Context,
+	user *entities.User,
+	query *ports.EventsQuery,
+) ([]*entities.Event, error) {
+	params := &queries.SelectEventsByUserParams{
+		RecipientID:    user.ID.Value(),
+		EventTypes:     eventTypes(query.Filter.Types),
+		NoteIds:        query.Filter.NoteIDs,
+		Tags:           query.Filter.Tags,
+		Unread:         query.Filter.Unread,
+		AfterID:        pgtype.UUID{Bytes: [16]byte{}, Valid: false},
+		AfterEventTime: nil,
+		PageLimit:      query.Limit,
+	}
+
+	if query.After != nil {
+		params.AfterID = pgtype.UUID{Bytes: uuid.MustParse(query.After.ID.Value()), Valid: true}
+		params.AfterEventTime = &query.After.EventTime
+	}
+
+	events, err := r.queries.SelectEventsByUser(ctx, params)
+	if err != nil {
+		return nil, ex.Unexpected(err)
+	}
+
+	return queries.NoteEvents(events).ToEntities(), nil
+}
+
+func (r *EventsRepository) CountUnreadEvents(
+	ctx context.Context,
+	user *entities.User,
+	filter *ports.EventsFilter,
+) (int32, error) {
+	cnt, err := r.queries.CountUnreadEventsByUser(ctx, &queries.CountUnreadEventsByUserParams{
+		RecipientID: user.ID.Value(),
+		EventTypes:  eventTypes(filter.Types),
+		NoteIds:     filter.NoteIDs,
+		Tags:        filter.Tags,
+	})
+	if err != nil {
+		return 0, ex.Unexpected(err)
+	}
+
+	return int32(cnt), nil //nolint:gosec // allowed conversation
+}
+
+func (r *EventsRepository) MarkEventsRead(
+	ctx context.Context,
+	user *entities.User,
+	ids []domuuid.UUID,
+	upTo *time.Time,
+) (int32, error) {
+	params := &commands.UpdateNoteEventsReadAtParams{
+		RecipientID: user.ID.Value(),
+		Ids:         make([]uuid.UUID, len(ids)),
+		UpTo:        upTo,
+	}
+
+	for i, ident := range ids {
+		params.Ids[i] = uuid.MustParse(ident.Value())
+	}
+
+	cnt, err := r.commands.UpdateNoteEventsReadAt(ctx, params)
+	if err != nil {
+		return 0, ex.Unexpected(err)
+	}
+
+	return int32(cnt), nil //nolint:gosec // allowed conversation
+}
+
+func (r *EventsRepository) LockEvents(ctx context.Context, limit int) ([]*entities.Event, error) {
+	events, err := r.commands.LockOutboxEvents(ctx, int32(limit)) //nolint:gosec // allowed conversation
+	if err != nil {
+		return nil, ex.Unexpected(err)
+	}
+
+	return commands.EventOutboxes(events).ToEntities(), nil
+}
+
+func (r *EventsRepository) DeleteEvents(ctx context.Context, events []*entities.Event) error {
+	err := r.commands.DeleteOutboxEvents(ctx, commands.EventIDs(events))
+
+	return ex.Unexpected(err)
+}
+
+func (r *EventsRepository) PostponeEvents(
+	ctx context.Context,
+	events []*entities.Event,
+	reason string,
+	backoff, maxBackoff time.Duration,
+) error {
+	params := commands.NewUpdateOutboxEventsAttemptsParams(events, reason, backoff, maxBackoff)
+	err := r.commands.UpdateOutboxEventsAttempts(ctx, params)
+
+	return ex.Unexpected(err)
+}
+
+func (r *EventsRepository) Backlog(ctx context.Context) (int64, time.Time, error) {
+	backlog, err := r.queries.SelectOutboxBacklog(ctx)
+	if err != nil {
+		return 0, time.Time{}, ex.Unexpected(err)
+	}
+
+	return backlog.Events, backlog.Oldest, nil
+}
+
+func eventTypes(types []entities.EventType) []int16 {
+	values := make([]int16, len(types))
+	for i, eventType := range types {
+		values[i] = int16(eventType) //nolint:gosec // allowed conversation
+	}
+
+	return values
+}
@@ -0,0 +1,87 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/therenotomorrow/ex"
+	"github.com/therenotomorrow/gotes/internal/api/notes/v1/usecases"
+	"github.com/therenotomorrow/gotes/internal/domain/entities"
+	"github.com/therenotomorrow/gotes/internal/domain/types/id"
+	"github.com/therenotomorrow/gotes/internal/storages/postgres"
+	commands "github.com/therenotomorrow/gotes/internal/storages/postgres/commands/notes"
+	queries "github.com/therenotomorrow/gotes/internal/storages/postgres/queries/notes"
+)
+
+type LinksRepository struct {
+	commands commands.Querier
+	queries  queries.Querier
+}
+
+func NewLinksRepository(dbtx postgres.DBTX) *LinksRepository {
+	return &LinksRepository{commands: commands.New(dbtx), queries: queries.New(dbtx)}
+}
+
+func (r *LinksRepository) SaveLink(ctx context.Context, link *entities.Link) (*entities.Link, error) {
+	ident, err := r.commands.InsertNoteLink(ctx, commands.NewInsertNoteLinkParams(link))
+	if err != nil {
+		return nil, ex.Unexpected(err)
+	}
+
+	link.ID = id.New(ident)
+
+	return link, nil
+}
+
+func (r *LinksRepository) DeleteLink(ctx context.Context, link *entities.Link) error {
+	cnt, err := r.commands.DeleteNoteLink(ctx, &commands.DeleteNoteLinkParams{
+		NoteID: link.Note.ID.Value(),
+		Token:  link.Token,
+	})
+
+	switch {
+	case err != nil:
+		return ex.Unexpected(err)
+	case cnt == 0:
+		return usecases.ErrLinkNotFound
+	}
+
+	return nil
+}
+
+func (r *LinksRepository) GetLink(ctx context.Context, token string) (*entities.Link, error) {
+	link, err := r.queries.SelectNoteLink(ctx, token)
+
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		return nil, usecases.ErrLinkNotFound
+	case err != nil:
+		return nil, ex.Unexpected(err)
+	}
+
+	note := new(entities.Note)
+	note.ID = id.New(link.NoteID)
+
+	return link.ToEntity(note), nil
+}
+
+func (r *LinksRepository) GetLinksByNote(ctx context.Context, note *entities.Note) ([]*entities.Link, error) {
+	links, err := r.queries.SelectNoteLinks(ctx, note.ID.Value())
+	if err != nil {
+		return nil, ex.Unexpected(err)
+	}
+
+	return queries.NoteLinks(links).ToEntities(note), nil
+}
+
+func (r *LinksRepository) CountView(ctx context.Context, link *entities.Link) error {
+	views, err := r.commands.UpdateNoteLinkViews(ctx, link.ID.Value())
+	if err != nil {
+		return ex.Unexpected(err)
+	}
+
+	link.Views = views
+
+	return nil
+}
@@ -0,0 +1,150 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/therenotomorrow/ex"
+	"github.com/therenotomorrow/gotes/internal/api/notes/v1/usecases"
+	"github.com/therenotomorrow/gotes/internal/domain/entities"
+	"github.com/therenotomorrow/gotes/internal/domain/types/id"
+	"github.com/therenotomorrow/gotes/internal/storages/postgres"
+	commands "github.com/therenotomorrow/gotes/internal/storages/postgres/commands/notes"
+	queries "github.com/therenotomorrow/gotes/internal/storages/postgres/queries/notes"
+)
+
+type NotebooksRepository struct {
+	commands commands.Querier
+	queries  queries.Querier
+}
+
+func NewNotebooksRepository(dbtx postgres.DBTX) *NotebooksRepository {
+	return &NotebooksRepository{commands: commands.New(dbtx), queries: queries.New(dbtx)}
+}
+
+func (r *NotebooksRepository) SaveNotebook(
+	ctx context.Context,
+	notebook *entities.Notebook,
+) (*entities.Notebook, error) {
+	ident, err := r.commands.InsertNotebook(ctx, commands.NewInsertNotebookParams(notebook))
+	if err != nil {
+		return nil, ex.Unexpected(err)
+	}
+
+	notebook.ID = id.New(ident)
+
+	return notebook, nil
+}
+
+func (r *NotebooksRepository) GetNotebook(ctx context.Context, ident id.ID) (*entities.Notebook, error) {
+	notebook, err := r.queries.SelectNotebook(ctx, ident.Value())
+
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		return nil, usecases.ErrNotebookNotFound
+	case err != nil:
+		return nil, ex.Unexpected(err)
+	}
+
+	return notebook.ToEntity(), nil
+}
+
+func (r *NotebooksRepository) GetNotebooksByUser(
+	ctx context.Context,
+	user *entities.User,
+) ([]*entities.Notebook, error) {
+	notebooks, err := r.queries.SelectNotebooksByUser(ctx, user.ID.Value())
+	if err != nil {
+		return nil, ex.Unexpected(err)
+	}
+
+	return queries.Notebooks(notebooks).ToEntities(), nil
+}
+
+func (r *NotebooksRepository) GetDescendants(
+	ctx context.Context,
+	notebook *entities.Notebook,
+) ([]*entities.Notebook, error) {
+	rows, err := r.queries.SelectNotebookDescendants(ctx, notebook.ID.ValuePtr())
+	if err != nil {
+		return nil, ex.Unexpected(err)
+	}
+
+	notebooks := make([]*entities.Notebook, len(rows))
+	for i, row := range rows {
+		notebooks[i] = row.Notebook.ToEntity()
+	}
+
+	return notebooks, nil
+}
+
+func (r *NotebooksRepository) UpdateNotebook(ctx context.Context, notebook *entities.Notebook) error {
+	err := r.commands.UpdateNotebook(ctx, commands.NewUpdateNotebookParams(notebook))
+
+	return ex.Unexpected(err)
+}
+
+func (r *NotebooksRepository) LockNotebooks(ctx context.Context, user *entities.User) error {
+	err := r.commands.LockNotebooksByUser(ctx, user.ID.Value())
+
+	return ex.Unexpected(err)
+}
+
+func (r *NotebooksRepository) DeleteNotebooks(ctx context.Context, notebooks []*entities.Notebook) error {
+	err := r.commands.DeleteNotebooks(ctx, notebookIDs(notebooks))
+
+	return ex.Unexpected(err)
+}
+
+func (r *NotebooksRepository) DetachNotebook(ctx context.Context, notebook *entities.Notebook) error {
+	err := r.commands.DetachNotebookChildren(ctx, &commands.DetachNotebookChildrenParams{
+		UpdatedAt: time.Now(),
+		ParentID:  notebook.ID.ValuePtr(),
+	})
+	if err != nil {
+		return ex.Unexpected(err)
+	}
+
+	err = r.commands.DetachNotebooksNotes(ctx, []int64{notebook.ID.Value()})
+
+	return ex.Unexpected(err)
+}
+
+func (r *NotebooksRepository) TrashNotes(
+	ctx context.Context,
+	notebooks []*entities.Notebook,
+	at time.Time,
+) ([]*entities.Note, error) {
+	idents := notebookIDs(notebooks)
+
+	rows, err := r.commands.TrashNotebooksNotes(ctx, &commands.TrashNotebooksNotesParams{
+		DeletedAt:   &at,
+		NotebookIds: idents,
+	})
+	if err != nil {
+		return nil, ex.Unexpected(err)
+	}
+
+	err = r.commands.DetachNotebooksNotes(ctx, idents)
+	if err != nil {
+		return nil, ex.Unexpected(err)
+	}
+
+	notes := make([]*entities.Note, len(rows))
+	for i, row := range rows {
+		notes[i] = row.ToEntity(at)
+	}
+
+	return notes, nil
+}
+
+func notebookIDs(notebooks []*entities.Notebook) []int64 {
+	idents := make([]int64, len(notebooks))
+	for i, notebook := range notebooks {
+		idents[i] = notebook.ID.Value()
+	}
+
+	return idents
+}
@@ -4,8 +4,12 @@ import (
 	"context"
 	"database/sql"
 	"errors"
+	"html"
+	"strings"
+	"time"
 
 	"github.com/therenotomorrow/ex"
+	"github.com/therenotomorrow/gotes/internal/api/notes/v1/ports"
 	"github.com/therenotomorrow/gotes/internal/api/notes/v1/usecases"
 	"github.com/therenotomorrow/gotes/internal/domain/entities"
 	"github.com/therenotomorrow/gotes/internal/domain/types/id"
@@ -44,20 +48,445 @@ func (r *NotesRepository) GetNote(ctx context.Context, ident id.ID) (*entities.N
 		return nil, ex.Unexpected(err)
 	}
 
-	return note.ToEntity(), nil
+	entity := note.ToEntity()
+
+	err = attachTags(ctx, r.queries, entity)
+	if err != nil {
+		return nil, err
+	}
+
+	return entity, nil
+}
+
+func (r *NotesRepository) GetNoteByContent(
+	ctx context.Context,
+	user *entities.User,
+	title, content string,
+) (*entities.Note, error) {
+	note, err := r.queries.SelectNoteByContent(ctx, &queries.SelectNoteByContentParams{
+		UserID:  user.ID.ValuePtr(),
+		Title:   title,
+		Content: content,
+	})
+
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		return nil, usecases.ErrNoteNotFound
+	case err != nil:
+		return nil, ex.Unexpected(err)
+	}
+
+	entity := note.ToEntity()
+
+	err = attachTags(ctx, r.queries, entity)
+	if err != nil {
+		return nil, err
+	}
+
+	return entity, nil
 }
 
-func (r *NotesRepository) GetNotesByUser(ctx context.Context, user *entities.User) ([]*entities.Note, error) {
-	notes, err := r.queries.SelectNotesByUser(ctx, user.ID.ValuePtr())
+func (r *NotesRepository) GetNotesByUser(
+	ctx context.Context,
+	user *entities.User,
+	query *ports.NotesQuery,
+) ([]*entities.Note, error) {
+	var (
+		notes []*queries.Note
+		err   error
+	)
+
+	switch query.Order.Field {
+	case ports.SortByUpdatedAt:
+		notes, err = r.selectNotesOrderByUpdatedAt(ctx, user, query)
+	case ports.SortByTitle:
+		notes, err = r.selectNotesOrderByTitle(ctx, user, query)
+	default:
+		notes, err = r.selectNotesOrderByCreatedAt(ctx, user, query)
+	}
+
+	if err != nil {
+		return nil, ex.Unexpected(err)
+	}
+
+	found := queries.Notes(notes).ToEntities()
+
+	err = attachTags(ctx, r.queries, found...)
+	if err != nil {
+		return nil, err
+	}
+
+	return found, nil
+}
+
+func (r *NotesRepository) CountNotesByUser(
+	ctx context.Context,
+	user *entities.User,
+	filter *ports.NotesFilter,
+) (int32, error) {
+	cnt, err := r.queries.CountNotesByUser(ctx, &queries.CountNotesByUserParams{
+		UserID:        user.ID.ValuePtr(),
+		CreatedAfter:  filter.CreatedAfter,
+		CreatedBefore: filter.CreatedBefore,
+		UpdatedAfter:  filter.UpdatedAfter,
+		UpdatedBefore: filter.UpdatedBefore,
+		TitlePrefix:   filter.TitlePrefix,
+		TagsAny:       filter.TagsAny,
+		TagsAll:       filter.TagsAll,
+		NotebookID:    filter.NotebookID,
+		Pinned:        filter.Pinned,
+		Archived:      archived(filter.Archived),
+		Starred:       filter.Starred,
+	})
+	if err != nil {
+		return 0, ex.Unexpected(err)
+	}
+
+	return int32(cnt), nil //nolint:gosec // allowed conversation
+}
+
+func (r *NotesRepository) SearchNotesByUser(
+	ctx context.Context,
+	user *entities.User,
+	query *ports.SearchQuery,
+) ([]*ports.SearchHit, error) {
+	params := &queries.SearchNotesByUserParams{
+		Query:     query.Text,
+		UserID:    user.ID.ValuePtr(),
+		AfterID:   nil,
+		AfterRank: nil,
+		PageLimit: query.Limit,
+	}
+
+	if after := query.After; after != nil {
+		params.AfterID = after.Note.ID.ValuePtr()
+		params.AfterRank = &after.Rank
+	}
+
+	rows, err := r.queries.SearchNotesByUser(ctx, params)
 	if err != nil {
 		return nil, ex.Unexpected(err)
 	}
 
-	return queries.Notes(notes).ToEntities(), nil
+	hits := make([]*ports.SearchHit, len(rows))
+	notes := make([]*entities.Note, len(rows))
+
+	for i, row := range rows {
+		notes[i] = row.Note.ToEntity()
+		hits[i] = &ports.SearchHit{
+			Note:           notes[i],
+			TitleSnippet:   highlight(row.TitleSnippet),
+			ContentSnippet: highlight(row.ContentSnippet),
+			Rank:           row.Rank,
+		}
+	}
+
+	err = attachTags(ctx, r.queries, notes...)
+	if err != nil {
+		return nil, err
+	}
+
+	return hits, nil
+}
+
+func (r *NotesRepository) CountSearchNotesByUser(ctx context.Context, user *entities.User, text string) (int32, error) {
+	cnt, err := r.queries.CountSearchNotesByUser(ctx, &queries.CountSearchNotesByUserParams{
+		UserID: user.ID.ValuePtr(),
+		Query:  text,
+	})
+	if err != nil {
+		return 0, ex.Unexpected(err)
+	}
+
+	return int32(cnt), nil //nolint:gosec // allowed conversation
+}
+
+func (r *NotesRepository) selectNotesOrderByCreatedAt(
+	ctx context.Context,
+	user *entities.User,
+	query *ports.NotesQuery,
+) ([]*queries.Note, error) {
+	params := &queries.SelectNotesByUserOrderByCreatedAtAscParams{
+		UserID:         user.ID.ValuePtr(),
+		CreatedAfter:   query.Filter.CreatedAfter,
+		CreatedBefore:  query.Filter.CreatedBefore,
+		UpdatedAfter:   query.Filter.UpdatedAfter,
+		UpdatedBefore:  query.Filter.UpdatedBefore,
+		TitlePrefix:    query.Filter.TitlePrefix,
+		TagsAny:        query.Filter.TagsAny,
+		TagsAll:        query.Filter.TagsAll,
+		NotebookID:     query.Filter.NotebookID,
+		Pinned:         query.Filter.Pinned,
+		Archived:       archived(query.Filter.Archived),
+		Starred:        query.Filter.Starred,
+		AfterPinned:    nil,
+		AfterID:        nil,
+		AfterCreatedAt: nil,
+		PageLimit:      query.Limit,
+	}
+
+	if after := query.After; after != nil {
+		params.AfterID = after.ID.ValuePtr()
+		params.AfterPinned = &after.Pinned
+		params.AfterCreatedAt = &after.CreatedAt
+	}
+
+	if query.Order.Descending {
+		desc := queries.SelectNotesByUserOrderByCreatedAtDescParams(*params)
+
+		return r.queries.SelectNotesByUserOrderByCreatedAtDesc(ctx, &desc)
+	}
+
+	return r.queries.SelectNotesByUserOrderByCreatedAtAsc(ctx, params)
+}
+
+func (r *NotesRepository) selectNotesOrderByUpdatedAt(
+	ctx context.Context,
+	user *entities.User,
+	query *ports.NotesQuery,
+) ([]*queries.Note, error) {
+	params := &queries.SelectNotesByUserOrderByUpdatedAtAscParams{
+		UserID:         user.ID.ValuePtr(),
+		CreatedAfter:   query.Filter.CreatedAfter,
+		CreatedBefore:  query.Filter.CreatedBefore,
+		UpdatedAfter:   query.Filter.UpdatedAfter,
+		UpdatedBefore:  query.Filter.UpdatedBefore,
+		TitlePrefix:    query.Filter.TitlePrefix,
+		TagsAny:        query.Filter.TagsAny,
+		TagsAll:        query.Filter.TagsAll,
+		NotebookID:     query.Filter.NotebookID,
+		Pinned:         query.Filter.Pinned,
+		Archived:       archived(query.Filter.Archived),
+		Starred:        query.Filter.Starred,
+		AfterPinned:    nil,
+		AfterID:        nil,
+		AfterUpdatedAt: nil,
+		PageLimit:      query.Limit,
+	}
+
+	if after := query.After; after != nil {
+		params.AfterID = after.ID.ValuePtr()
+		params.AfterPinned = &after.Pinned
+		params.AfterUpdatedAt = &after.UpdatedAt
+	}
+
+	if query.Order.Descending {
+		desc := queries.SelectNotesByUserOrderByUpdatedAtDescParams(*params)
+
+		return r.queries.SelectNotesByUserOrderByUpdatedAtDesc(ctx, &desc)
+	}
+
+	return r.queries.SelectNotesByUserOrderByUpdatedAtAsc(ctx, params)
+}
+
+func (r *NotesRepository) selectNotesOrderByTitle(
+	ctx context.Context,
+	user *entities.User,
+	query *ports.NotesQuery,
+) ([]*queries.Note, error) {
+	params := &queries.SelectNotesByUserOrderByTitleAscParams{
+		UserID:        user.ID.ValuePtr(),
+		CreatedAfter:  query.Filter.CreatedAfter,
+		CreatedBefore: query.Filter.CreatedBefore,
+		UpdatedAfter:  query.Filter.UpdatedAfter,
+		UpdatedBefore: query.Filter.UpdatedBefore,
+		TitlePrefix:   query.Filter.TitlePrefix,
+		TagsAny:       query.Filter.TagsAny,
+		TagsAll:       query.Filter.TagsAll,
+		NotebookID:    query.Filter.NotebookID,
+		Pinned:        query.Filter.Pinned,
+		Archived:      archived(query.Filter.Archived),
+		Starred:       query.Filter.Starred,
+		AfterPinned:   nil,
+		AfterID:       nil,
+		AfterTitle:    nil,
+		PageLimit:     query.Limit,
+	}
+
+	if after := query.After; after != nil {
+		params.AfterID = after.ID.ValuePtr()
+		params.AfterPinned = &after.Pinned
+		params.AfterTitle = &after.Title
+	}
+
+	if query.Order.Descending {
+		desc := queries.SelectNotesByUserOrderByTitleDescParams(*params)
+
+		return r.queries.SelectNotesByUserOrderByTitleDesc(ctx, &desc)
+	}
+
+	return r.queries.SelectNotesByUserOrderByTitleAsc(ctx, params)
+}
+
+func (r *NotesRepository) UpdateNote(ctx context.Context, note *entities.Note) error {
+	version, err := r.commands.UpdateNote(ctx, commands.NewUpdateNoteParams(note))
+
+	return bump(note, version, err)
+}
+
+func (r *NotesRepository) LockNote(ctx context.Context, note *entities.Note) error {
+	version, err := r.commands.LockNote(ctx, commands.NewLockNoteParams(note))
+
+	return bump(note, version, err)
 }
 
 func (r *NotesRepository) DeleteNote(ctx context.Context, note *entities.Note) error {
-	err := r.commands.DeleteNote(ctx, note.ID.Value())
+	cnt, err := r.commands.DeleteNote(ctx, commands.NewDeleteNoteParams(note))
+	if err != nil {
+		return ex.Unexpected(err)
+	}
+
+	if cnt == 0 {
+		return usecases.ErrVersionMismatch
+	}
+
+	return nil
+}
+
+func (r *NotesRepository) TrashNote(ctx context.Context, note *entities.Note) error {
+	version, err := r.commands.UpdateNoteDeletedAt(ctx, commands.NewUpdateNoteDeletedAtParams(note))
+
+	return bump(note, version, err)
+}
+
+func (r *NotesRepository) MoveNote(ctx context.Context, note *entities.Note) error {
+	version, err := r.commands.UpdateNoteNotebook(ctx, commands.NewUpdateNoteNotebookParams(note))
+
+	return bump(note, version, err)
+}
+
+func (r *NotesRepository) RestoreNote(ctx context.Context, note *entities.Note) error {
+	version, err := r.commands.UpdateNoteDeletedAt(ctx, commands.NewUpdateNoteDeletedAtParams(note))
+
+	return bump(note, version, err)
+}
+
+func (r *NotesRepository) TouchNote(ctx context.Context, note *entities.Note) error {
+	version, err := r.commands.UpdateNoteVersion(ctx, commands.NewUpdateNoteVersionParams(note))
+
+	return bump(note, version, err)
+}
+
+func (r *NotesRepository) PurgeNotes(ctx context.Context, before time.Time) ([]*entities.Note, error) {
+	rows, err := r.commands.DeleteTrashedNotes(ctx, &before)
+	if err != nil {
+		return nil, ex.Unexpected(err)
+	}
+
+	notes := make([]*entities.Note, len(rows))
+	for i, row := range rows {
+		notes[i] = row.ToEntity()
+	}
+
+	return notes, nil
+}
+
+func (r *NotesRepository) GetTrashedNotesByUser(
+	ctx context.Context,
+	user *entities.User,
+	query *ports.TrashQuery,
+) ([]*entities.Note, error) {
+	params := &queries.SelectTrashedNotesByUserParams{
+		UserID:         user.ID.ValuePtr(),
+		AfterID:        nil,
+		AfterDeletedAt: nil,
+		PageLimit:      query.Limit,
+	}
+
+	if after := query.After; after != nil {
+		params.AfterID = after.ID.ValuePtr()
+		params.AfterDeletedAt = after.DeletedAt
+	}
+
+	notes, err := r.queries.SelectTrashedNotesByUser(ctx, params)
+	if err != nil {
+		return nil, ex.Unexpected(err)
+	}
+
+	found := queries.Notes(notes).ToEntities()
+
+	err = attachTags(ctx, r.queries, found...)
+	if err != nil {
+		return nil, err
+	}
+
+	return found, nil
+}
+
+func (r *NotesRepository) CountTrashedNotesByUser(ctx context.Context, user *entities.User) (int32, error) {
+	cnt, err := r.queries.CountTrashedNotesByUser(ctx, user.ID.ValuePtr())
+	if err != nil {
+		return 0, ex.Unexpected(err)
+	}
+
+	return int32(cnt), nil //nolint:gosec // allowed conversation
+}
+
+// attachTags loads the tags of all the notes with a single query.
+func attachTags(ctx context.Context, querier queries.Querier, notes ...*entities.Note) error {
+	if len(notes) == 0 {
+		return nil
+	}
+
+	idents := make([]int64, len(notes))
+	index := make(map[int64]*entities.Note, len(notes))
+
+	for i, note := range notes {
+		idents[i] = note.ID.Value()
+		index[note.ID.Value()] = note
+	}
+
+	rows, err := querier.SelectTagsByNotes(ctx, idents)
+	if err != nil {
+		return ex.Unexpected(err)
+	}
+
+	for _, row := range rows {
+		note := index[row.NoteID]
+		note.Tags = append(note.Tags, row.Tag.ToEntity())
+	}
+
+	return nil
+}
+
+// bump moves the note to the version it got in the store, a missing row means the note was changed concurrently.
+func bump(note *entities.Note, version int64, err error) error {
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		return usecases.ErrVersionMismatch
+	case err != nil:
+		return ex.Unexpected(err)
+	}
+
+	note.Version = version
+
+	return nil
+}
+
+// archived returns the state of the notes to keep, nil keeps the notes in any state.
+func archived(filter ports.ArchivedFilter) *bool {
+	var state bool
+
+	switch filter {
+	case ports.ArchivedOnly:
+		state = true
+	case ports.ArchivedIncluded:
+		return nil
+	default:
+		state = false
+	}
+
+	return &state
+}
+
+// matchStart and matchStop wrap the matches in the snippets made by the database, the characters are of the private
+// use area and are removed from the notes before the snippets are made, so they mark nothing but the matches.
+const (
+	matchStart = "\ue000"
+	matchStop  = "\ue001"
+)
 
-	return ex.Unexpected(err)
+// highlight escapes the snippet and wraps its matches in `<mark>` tags, so the snippet is safe to embed into a page.
+func highlight(snippet string) string {
+	return strings.NewReplacer(matchStart, "<mark>", matchStop, "</mark>").Replace(html.EscapeString(snippet))
 }
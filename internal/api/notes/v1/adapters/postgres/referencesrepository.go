@@ -0,0 +1,85 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/therenotomorrow/ex"
+	"github.com/therenotomorrow/gotes/internal/domain/entities"
+	"github.com/therenotomorrow/gotes/internal/storages/postgres"
+	commands "github.com/therenotomorrow/gotes/internal/storages/postgres/commands/notes"
+	queries "github.com/therenotomorrow/gotes/internal/storages/postgres/queries/notes"
+)
+
+type ReferencesRepository struct {
+	commands commands.Querier
+	queries  queries.Querier
+}
+
+func NewReferencesRepository(dbtx postgres.DBTX) *ReferencesRepository {
+	return &ReferencesRepository{commands: commands.New(dbtx), queries: queries.New(dbtx)}
+}
+
+func (r *ReferencesRepository) SaveReferences(
+	ctx context.Context,
+	note *entities.Note,
+	references []*entities.Reference,
+) error {
+	err := r.commands.DeleteNoteReferences(ctx, note.ID.Value())
+	if err != nil {
+		return ex.Unexpected(err)
+	}
+
+	if len(references) == 0 {
+		return nil
+	}
+
+	err = r.commands.InsertNoteReferences(ctx, commands.NewInsertNoteReferencesParams(note, references))
+
+	return ex.Unexpected(err)
+}
+
+func (r *ReferencesRepository) GetBacklinks(ctx context.Context, note *entities.Note) ([]*entities.Note, error) {
+	owner := note.Owner.ID.Value()
+
+	notes, err := r.queries.SelectBacklinks(ctx, &queries.SelectBacklinksParams{
+		UserID: &owner,
+		NoteID: note.ID.Value(),
+		Title:  note.Title,
+	})
+	if err != nil {
+		return nil, ex.Unexpected(err)
+	}
+
+	return queries.Notes(notes).ToEntities(), nil
+}
+
+func (r *ReferencesRepository) GetReferences(
+	ctx context.Context,
+	note *entities.Note,
+) ([]*entities.Reference, error) {
+	owner := note.Owner.ID.Value()
+
+	references, err := r.queries.SelectOutgoingReferences(ctx, &queries.SelectOutgoingReferencesParams{
+		UserID:   &owner,
+		SourceID: note.ID.Value(),
+	})
+	if err != nil {
+		return nil, ex.Unexpected(err)
+	}
+
+	return queries.SelectOutgoingReferencesRows(references).ToEntities(note), nil
+}
+
+func (r *ReferencesRepository) GetDanglingReferences(
+	ctx context.Context,
+	user *entities.User,
+) ([]*entities.Reference, error) {
+	owner := user.ID.Value()
+
+	references, err := r.queries.SelectDanglingReferences(ctx, &owner)
+	if err != nil {
+		return nil, ex.Unexpected(err)
+	}
+
+	return queries.SelectDanglingReferencesRows(references).ToEntities(), nil
+}
@@ -0,0 +1,105 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/therenotomorrow/ex"
+	"github.com/therenotomorrow/gotes/internal/api/notes/v1/usecases"
+	"github.com/therenotomorrow/gotes/internal/domain/entities"
+	"github.com/therenotomorrow/gotes/internal/domain/types/id"
+	"github.com/therenotomorrow/gotes/internal/storages/postgres"
+	commands "github.com/therenotomorrow/gotes/internal/storages/postgres/commands/notes"
+	queries "github.com/therenotomorrow/gotes/internal/storages/postgres/queries/notes"
+)
+
+type RemindersRepository struct {
+	commands commands.Querier
+	queries  queries.Querier
+}
+
+func NewRemindersRepository(dbtx postgres.DBTX) *RemindersRepository {
+	return &RemindersRepository{commands: commands.New(dbtx), queries: queries.New(dbtx)}
+}
+
+func (r *RemindersRepository) SaveReminder(
+	ctx context.Context,
+	reminder *entities.Reminder,
+) (*entities.Reminder, error) {
+	ident, err := r.commands.InsertNoteReminder(ctx, commands.NewInsertNoteReminderParams(reminder))
+	if err != nil {
+		return nil, ex.Unexpected(err)
+	}
+
+	reminder.ID = id.New(ident)
+
+	return reminder, nil
+}
+
+func (r *RemindersRepository) GetReminder(ctx context.Context, ident id.ID) (*entities.Reminder, error) {
+	reminder, err := r.queries.SelectNoteReminder(ctx, ident.Value())
+
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		return nil, usecases.ErrReminderNotFound
+	case err != nil:
+		return nil, ex.Unexpected(err)
+	}
+
+	note := new(entities.Note)
+	note.ID = id.New(reminder.NoteID)
+
+	return reminder.ToEntity(note), nil
+}
+
+func (r *RemindersRepository) GetRemindersByNote(
+	ctx context.Context,
+	note *entities.Note,
+	user *entities.User,
+) ([]*entities.Reminder, error) {
+	reminders, err := r.queries.SelectNoteReminders(ctx, &queries.SelectNoteRemindersParams{
+		NoteID: note.ID.Value(),
+		UserID: user.ID.Value(),
+	})
+	if err != nil {
+		return nil, ex.Unexpected(err)
+	}
+
+	return queries.NoteReminders(reminders).ToEntities(note), nil
+}
+
+func (r *RemindersRepository) UpdateReminder(ctx context.Context, reminder *entities.Reminder) error {
+	cnt, err := r.commands.UpdateNoteReminder(ctx, commands.NewUpdateNoteReminderParams(reminder))
+
+	switch {
+	case err != nil:
+		return ex.Unexpected(err)
+	case cnt == 0:
+		return usecases.ErrReminderNotFound
+	}
+
+	return nil
+}
+
+func (r *RemindersRepository) DeleteReminder(ctx context.Context, reminder *entities.Reminder) error {
+	cnt, err := r.commands.DeleteNoteReminder(ctx, reminder.ID.Value())
+
+	switch {
+	case err != nil:
+		return ex.Unexpected(err)
+	case cnt == 0:
+		return usecases.ErrReminderNotFound
+	}
+
+	return nil
+}
+
+func (r *RemindersRepository) LockDueReminders(ctx context.Context, limit int) ([]*entities.Reminder, error) {
+	reminders, err := r.commands.LockDueNoteReminders(ctx, int32(limit)) //nolint:gosec // allowed conversation
+	if err != nil {
+		return nil, ex.Unexpected(err)
+	}
+
+	return commands.NoteReminders(reminders).ToEntities(), nil
+}
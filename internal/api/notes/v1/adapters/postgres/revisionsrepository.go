@@ -0,0 +1,92 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/therenotomorrow/ex"
+	"github.com/therenotomorrow/gotes/internal/api/notes/v1/ports"
+	"github.com/therenotomorrow/gotes/internal/api/notes/v1/usecases"
+	"github.com/therenotomorrow/gotes/internal/domain/entities"
+	"github.com/therenotomorrow/gotes/internal/domain/types/id"
+	"github.com/therenotomorrow/gotes/internal/storages/postgres"
+	commands "github.com/therenotomorrow/gotes/internal/storages/postgres/commands/notes"
+	queries "github.com/therenotomorrow/gotes/internal/storages/postgres/queries/notes"
+)
+
+type RevisionsRepository struct {
+	commands commands.Querier
+	queries  queries.Querier
+}
+
+func NewRevisionsRepository(dbtx postgres.DBTX) *RevisionsRepository {
+	return &RevisionsRepository{commands: commands.New(dbtx), queries: queries.New(dbtx)}
+}
+
+func (r *RevisionsRepository) SaveRevision(
+	ctx context.Context,
+	revision *entities.Revision,
+) (*entities.Revision, error) {
+	// the number follows the latest revision of the note, so the note stays locked until the revision is saved,
+	// otherwise the concurrent revisions would get the same number
+	version, err := r.commands.LockNote(ctx, commands.NewLockNoteParams(revision.Note))
+
+	err = bump(revision.Note, version, err)
+	if err != nil {
+		return nil, err
+	}
+
+	row, err := r.commands.InsertRevision(ctx, commands.NewInsertRevisionParams(revision))
+	if err != nil {
+		return nil, ex.Unexpected(err)
+	}
+
+	revision.ID = id.New(row.ID)
+	revision.Number = row.Revision
+
+	return revision, nil
+}
+
+func (r *RevisionsRepository) GetRevision(
+	ctx context.Context,
+	note *entities.Note,
+	number int32,
+) (*entities.Revision, error) {
+	revision, err := r.queries.SelectRevision(ctx, &queries.SelectRevisionParams{
+		NoteID:   note.ID.Value(),
+		Revision: number,
+	})
+
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		return nil, usecases.ErrRevisionNotFound
+	case err != nil:
+		return nil, ex.Unexpected(err)
+	}
+
+	return revision.ToEntity(note), nil
+}
+
+func (r *RevisionsRepository) GetRevisionsByNote(
+	ctx context.Context,
+	note *entities.Note,
+	query *ports.RevisionsQuery,
+) ([]*entities.Revision, error) {
+	params := &queries.SelectRevisionsByNoteParams{
+		NoteID:        note.ID.Value(),
+		AfterRevision: nil,
+		PageLimit:     query.Limit,
+	}
+
+	if after := query.After; after != nil {
+		params.AfterRevision = &after.Number
+	}
+
+	revisions, err := r.queries.SelectRevisionsByNote(ctx, params)
+	if err != nil {
+		return nil, ex.Unexpected(err)
+	}
+
+	return queries.NoteRevisions(revisions).ToEntities(note), nil
+}
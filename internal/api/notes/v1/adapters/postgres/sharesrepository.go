@@ -0,0 +1,146 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/therenotomorrow/ex"
+	"github.com/therenotomorrow/gotes/internal/api/notes/v1/ports"
+	"github.com/therenotomorrow/gotes/internal/api/notes/v1/usecases"
+	"github.com/therenotomorrow/gotes/internal/domain/entities"
+	"github.com/therenotomorrow/gotes/internal/domain/types/email"
+	"github.com/therenotomorrow/gotes/internal/storages/postgres"
+	commands "github.com/therenotomorrow/gotes/internal/storages/postgres/commands/notes"
+	queries "github.com/therenotomorrow/gotes/internal/storages/postgres/queries/notes"
+)
+
+type SharesRepository struct {
+	commands commands.Querier
+	queries  queries.Querier
+}
+
+func NewSharesRepository(dbtx postgres.DBTX) *SharesRepository {
+	return &SharesRepository{commands: commands.New(dbtx), queries: queries.New(dbtx)}
+}
+
+func (r *SharesRepository) GetCollaborator(ctx context.Context, mail email.Email) (*entities.User, error) {
+	row, err := r.queries.SelectCollaborator(ctx, mail.Value())
+
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		return nil, usecases.ErrCollaboratorNotFound
+	case err != nil:
+		return nil, ex.Unexpected(err)
+	}
+
+	return row.ToEntity(), nil
+}
+
+func (r *SharesRepository) SaveShare(ctx context.Context, share *entities.Share) (*entities.Share, error) {
+	createdAt, err := r.commands.UpsertNoteShare(ctx, commands.NewUpsertNoteShareParams(share))
+	if err != nil {
+		return nil, ex.Unexpected(err)
+	}
+
+	share.CreatedAt = createdAt
+
+	return share, nil
+}
+
+func (r *SharesRepository) DeleteShare(ctx context.Context, share *entities.Share) error {
+	cnt, err := r.commands.DeleteNoteShare(ctx, &commands.DeleteNoteShareParams{
+		NoteID: share.Note.ID.Value(),
+		UserID: share.User.ID.Value(),
+	})
+
+	switch {
+	case err != nil:
+		return ex.Unexpected(err)
+	case cnt == 0:
+		return usecases.ErrShareNotFound
+	}
+
+	return nil
+}
+
+func (r *SharesRepository) GetShare(
+	ctx context.Context,
+	note *entities.Note,
+	user *entities.User,
+) (*entities.Share, error) {
+	share, err := r.queries.SelectNoteShare(ctx, &queries.SelectNoteShareParams{
+		NoteID: note.ID.Value(),
+		UserID: user.ID.Value(),
+	})
+
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		return nil, usecases.ErrShareNotFound
+	case err != nil:
+		return nil, ex.Unexpected(err)
+	}
+
+	return share.ToEntity(note, user), nil
+}
+
+func (r *SharesRepository) GetSharesByNote(ctx context.Context, note *entities.Note) ([]*entities.Share, error) {
+	rows, err := r.queries.SelectNoteShares(ctx, note.ID.Value())
+	if err != nil {
+		return nil, ex.Unexpected(err)
+	}
+
+	shares := make([]*entities.Share, len(rows))
+	for i, row := range rows {
+		shares[i] = row.ToEntity(note)
+	}
+
+	return shares, nil
+}
+
+func (r *SharesRepository) GetSharesByUser(
+	ctx context.Context,
+	user *entities.User,
+	query *ports.SharesQuery,
+) ([]*entities.Share, error) {
+	params := &queries.SelectSharedNotesByUserParams{
+		UserID:        user.ID.Value(),
+		AfterID:       nil,
+		AfterSharedAt: nil,
+		PageLimit:     query.Limit,
+	}
+
+	if after := query.After; after != nil {
+		params.AfterID = after.Note.ID.ValuePtr()
+		params.AfterSharedAt = &after.CreatedAt
+	}
+
+	rows, err := r.queries.SelectSharedNotesByUser(ctx, params)
+	if err != nil {
+		return nil, ex.Unexpected(err)
+	}
+
+	shares := make([]*entities.Share, len(rows))
+	notes := make([]*entities.Note, len(rows))
+
+	for i, row := range rows {
+		shares[i] = row.ToEntity(user)
+		notes[i] = shares[i].Note
+	}
+
+	err = attachTags(ctx, r.queries, notes...)
+	if err != nil {
+		return nil, err
+	}
+
+	return shares, nil
+}
+
+func (r *SharesRepository) CountSharesByUser(ctx context.Context, user *entities.User) (int32, error) {
+	cnt, err := r.queries.CountSharedNotesByUser(ctx, user.ID.Value())
+	if err != nil {
+		return 0, ex.Unexpected(err)
+	}
+
+	return int32(cnt), nil //nolint:gosec // allowed conversation
+}
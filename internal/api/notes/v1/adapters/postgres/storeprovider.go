@@ -2,27 +2,51 @@ package postgres
 
 import (
 	"context"
+	"log/slog"
 
 	"github.com/redis/go-redis/v9"
+	"github.com/therenotomorrow/gotes/internal/api/notes/v1/adapters/markdown"
 	adapters "github.com/therenotomorrow/gotes/internal/api/notes/v1/adapters/redis"
 	"github.com/therenotomorrow/gotes/internal/api/notes/v1/ports"
 	"github.com/therenotomorrow/gotes/internal/storages/postgres"
 )
 
 type StoreProvider struct {
-	db  postgres.Database
-	rdb redis.UniversalClient
+	db       postgres.Database
+	rdb      redis.UniversalClient
+	blobs    ports.BlobStorage
+	renderer *markdown.Renderer
+	logger   *slog.Logger
 }
 
-func NewStoreProvider(db postgres.Database, rdb redis.UniversalClient) *StoreProvider {
-	return &StoreProvider{db: db, rdb: rdb}
+func NewStoreProvider(
+	db postgres.Database,
+	rdb redis.UniversalClient,
+	blobs ports.BlobStorage,
+	logger *slog.Logger,
+) *StoreProvider {
+	return &StoreProvider{db: db, rdb: rdb, blobs: blobs, renderer: markdown.NewRenderer(), logger: logger}
 }
 
 func (p *StoreProvider) Provide(ctx context.Context) ports.Store {
 	conn := p.db.Conn(ctx)
 
 	return ports.Store{
-		Notes:  NewNotesRepository(conn),
-		Events: adapters.NewEventsRepository(p.rdb),
+		Notes:       NewNotesRepository(conn),
+		Revisions:   NewRevisionsRepository(conn),
+		Tags:        NewTagsRepository(conn),
+		Notebooks:   NewNotebooksRepository(conn),
+		Templates:   NewTemplatesRepository(conn),
+		References:  NewReferencesRepository(conn),
+		Shares:      NewSharesRepository(conn),
+		Links:       NewLinksRepository(conn),
+		Attachments: NewAttachmentsRepository(conn),
+		Reminders:   NewRemindersRepository(conn),
+		Blobs:       p.blobs,
+		Events:      NewEventsRepository(conn),
+		Stream:      adapters.NewEventStream(p.rdb),
+		Drafts:      adapters.NewDrafts(p.rdb),
+		Renderer:    p.renderer,
+		Renders:     adapters.NewRenders(p.rdb, markdown.Version, p.logger),
 	}
 }
@@ -0,0 +1,113 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/therenotomorrow/ex"
+	"github.com/therenotomorrow/gotes/internal/api/notes/v1/ports"
+	"github.com/therenotomorrow/gotes/internal/api/notes/v1/usecases"
+	"github.com/therenotomorrow/gotes/internal/domain/entities"
+	"github.com/therenotomorrow/gotes/internal/domain/types/id"
+	"github.com/therenotomorrow/gotes/internal/storages/postgres"
+	commands "github.com/therenotomorrow/gotes/internal/storages/postgres/commands/notes"
+	queries "github.com/therenotomorrow/gotes/internal/storages/postgres/queries/notes"
+)
+
+type TagsRepository struct {
+	commands commands.Querier
+	queries  queries.Querier
+}
+
+func NewTagsRepository(dbtx postgres.DBTX) *TagsRepository {
+	return &TagsRepository{commands: commands.New(dbtx), queries: queries.New(dbtx)}
+}
+
+func (r *TagsRepository) AddNoteTags(ctx context.Context, note *entities.Note, tags []*entities.Tag) error {
+	for _, tag := range tags {
+		row, err := r.commands.UpsertTag(ctx, commands.NewUpsertTagParams(tag))
+		if err != nil {
+			return ex.Unexpected(err)
+		}
+
+		tag.ID = id.New(row.ID)
+		tag.CreatedAt = row.CreatedAt
+
+		err = r.commands.InsertNoteTag(ctx, &commands.InsertNoteTagParams{
+			NoteID: note.ID.Value(),
+			TagID:  tag.ID.Value(),
+		})
+		if err != nil {
+			return ex.Unexpected(err)
+		}
+	}
+
+	return nil
+}
+
+func (r *TagsRepository) RemoveNoteTags(ctx context.Context, note *entities.Note, names []string) error {
+	err := r.commands.DeleteNoteTags(ctx, &commands.DeleteNoteTagsParams{
+		NoteID: note.ID.Value(),
+		Names:  names,
+	})
+
+	return ex.Unexpected(err)
+}
+
+func (r *TagsRepository) GetTag(ctx context.Context, user *entities.User, name string) (*entities.Tag, error) {
+	tag, err := r.queries.SelectTag(ctx, &queries.SelectTagParams{
+		UserID: user.ID.Value(),
+		Name:   name,
+	})
+
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		return nil, usecases.ErrTagNotFound
+	case err != nil:
+		return nil, ex.Unexpected(err)
+	}
+
+	return tag.ToEntity(), nil
+}
+
+func (r *TagsRepository) GetTagsByUser(ctx context.Context, user *entities.User) ([]*ports.TagCount, error) {
+	rows, err := r.queries.SelectTagsByUser(ctx, user.ID.Value())
+	if err != nil {
+		return nil, ex.Unexpected(err)
+	}
+
+	counts := make([]*ports.TagCount, len(rows))
+	for i, row := range rows {
+		counts[i] = &ports.TagCount{Tag: row.Tag.ToEntity(), Notes: row.Notes}
+	}
+
+	return counts, nil
+}
+
+func (r *TagsRepository) LoadNoteTags(ctx context.Context, notes []*entities.Note) error {
+	return attachTags(ctx, r.queries, notes...)
+}
+
+func (r *TagsRepository) UpdateTag(ctx context.Context, tag *entities.Tag) error {
+	err := r.commands.UpdateTag(ctx, &commands.UpdateTagParams{
+		Name: tag.Name,
+		ID:   tag.ID.Value(),
+	})
+
+	return ex.Unexpected(err)
+}
+
+func (r *TagsRepository) MergeTag(ctx context.Context, from, into *entities.Tag) error {
+	err := r.commands.MergeNoteTags(ctx, &commands.MergeNoteTagsParams{
+		IntoID: into.ID.Value(),
+		FromID: from.ID.Value(),
+	})
+	if err != nil {
+		return ex.Unexpected(err)
+	}
+
+	err = r.commands.DeleteTag(ctx, from.ID.Value())
+
+	return ex.Unexpected(err)
+}
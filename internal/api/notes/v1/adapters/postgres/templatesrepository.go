@@ -0,0 +1,75 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/therenotomorrow/ex"
+	"github.com/therenotomorrow/gotes/internal/api/notes/v1/usecases"
+	"github.com/therenotomorrow/gotes/internal/domain/entities"
+	"github.com/therenotomorrow/gotes/internal/domain/types/id"
+	"github.com/therenotomorrow/gotes/internal/storages/postgres"
+	commands "github.com/therenotomorrow/gotes/internal/storages/postgres/commands/notes"
+	queries "github.com/therenotomorrow/gotes/internal/storages/postgres/queries/notes"
+)
+
+type TemplatesRepository struct {
+	commands commands.Querier
+	queries  queries.Querier
+}
+
+func NewTemplatesRepository(dbtx postgres.DBTX) *TemplatesRepository {
+	return &TemplatesRepository{commands: commands.New(dbtx), queries: queries.New(dbtx)}
+}
+
+func (r *TemplatesRepository) SaveTemplate(
+	ctx context.Context,
+	template *entities.Template,
+) (*entities.Template, error) {
+	ident, err := r.commands.InsertNoteTemplate(ctx, commands.NewInsertNoteTemplateParams(template))
+	if err != nil {
+		return nil, ex.Unexpected(err)
+	}
+
+	template.ID = id.New(ident)
+
+	return template, nil
+}
+
+func (r *TemplatesRepository) GetTemplate(ctx context.Context, ident id.ID) (*entities.Template, error) {
+	template, err := r.queries.SelectNoteTemplate(ctx, ident.Value())
+
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		return nil, usecases.ErrTemplateNotFound
+	case err != nil:
+		return nil, ex.Unexpected(err)
+	}
+
+	return template.ToEntity(), nil
+}
+
+func (r *TemplatesRepository) GetTemplatesByUser(
+	ctx context.Context,
+	user *entities.User,
+) ([]*entities.Template, error) {
+	templates, err := r.queries.SelectNoteTemplatesByUser(ctx, user.ID.Value())
+	if err != nil {
+		return nil, ex.Unexpected(err)
+	}
+
+	return queries.NoteTemplates(templates).ToEntities(), nil
+}
+
+func (r *TemplatesRepository) UpdateTemplate(ctx context.Context, template *entities.Template) error {
+	err := r.commands.UpdateNoteTemplate(ctx, commands.NewUpdateNoteTemplateParams(template))
+
+	return ex.Unexpected(err)
+}
+
+func (r *TemplatesRepository) DeleteTemplate(ctx context.Context, template *entities.Template) error {
+	err := r.commands.DeleteNoteTemplate(ctx, template.ID.Value())
+
+	return ex.Unexpected(err)
+}
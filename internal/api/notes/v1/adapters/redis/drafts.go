@@ -0,0 +1,432 @@
+package redis
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/therenotomorrow/ex"
+	"github.com/therenotomorrow/gotes/internal/api/notes/v1/usecases"
+	"github.com/therenotomorrow/gotes/internal/domain/entities"
+	"github.com/therenotomorrow/gotes/internal/domain/types/id"
+	"github.com/therenotomorrow/gotes/internal/domain/types/ot"
+)
+
+const (
+	ErrMarshalEdit   ex.Error = "marshal edit error"
+	ErrUnmarshalEdit ex.Error = "unmarshal edit error"
+
+	// DraftTTL is how long the draft is kept after the last edit.
+	DraftTTL = time.Hour
+	// DraftEdits is the number of recent edits kept to transform the edits based on older revisions.
+	DraftEdits = 1000
+
+	editField     = "edit"
+	contentField  = "content"
+	revisionField = "revision"
+	savedField    = "saved"
+	versionField  = "version"
+	// startPosition reads the stream of edits from the start, it is where the editors of a new draft begin.
+	startPosition = "0"
+	// waitCount is the maximum number of edits returned by a single wait.
+	waitCount = 100
+)
+
+// Drafts keeps every draft in a hash, the recent edits in another hash by their revisions and all edits together
+// with the moves of cursors in a stream read by the editors. The keys expire together, so a forgotten draft
+// never meets the edits of the previous one.
+type Drafts struct {
+	rdb redis.UniversalClient
+}
+
+type component struct {
+	Insert string `json:"insert,omitempty"`
+	Retain int    `json:"retain,omitempty"`
+	Delete int    `json:"delete,omitempty"`
+}
+
+type selection struct {
+	Anchor int `json:"anchor"`
+	Head   int `json:"head"`
+}
+
+type edit struct {
+	Selection *selection  `json:"selection,omitempty"`
+	Session   string      `json:"session"`
+	Operation []component `json:"operation,omitempty"`
+	UserID    id.ID       `json:"userId"`
+	Revision  int64       `json:"revision"`
+}
+
+func MarshalEdit(e *entities.Edit) ([]byte, error) {
+	raw := &edit{
+		Selection: nil,
+		Session:   e.Session,
+		Operation: make([]component, len(e.Operation)),
+		UserID:    e.User.ID,
+		Revision:  e.Revision,
+	}
+
+	for i, c := range e.Operation {
+		raw.Operation[i] = component{Insert: c.Insert, Retain: c.Retain, Delete: c.Delete}
+	}
+
+	if e.Selection != nil {
+		raw.Selection = &selection{Anchor: e.Selection.Anchor, Head: e.Selection.Head}
+	}
+
+	val, err := json.Marshal(raw)
+	if err != nil {
+		return nil, ErrMarshalEdit.Because(err)
+	}
+
+	return val, nil
+}
+
+func UnmarshalEdit(data []byte) (*entities.Edit, error) {
+	var raw edit
+
+	err := json.Unmarshal(data, &raw)
+	if err != nil {
+		return nil, ErrUnmarshalEdit.Because(err)
+	}
+
+	user := new(entities.User)
+	user.ID = raw.UserID
+
+	result := &entities.Edit{
+		User:      user,
+		Selection: nil,
+		Session:   raw.Session,
+		Position:  "",
+		Operation: nil,
+		Revision:  raw.Revision,
+	}
+
+	if raw.Selection != nil {
+		result.Selection = &entities.Selection{Anchor: raw.Selection.Anchor, Head: raw.Selection.Head}
+
+		return result, nil
+	}
+
+	result.Operation = make(ot.Operation, len(raw.Operation))
+	for i, c := range raw.Operation {
+		result.Operation[i] = ot.Component{Insert: c.Insert, Retain: c.Retain, Delete: c.Delete}
+	}
+
+	return result, nil
+}
+
+func NewDrafts(rdb redis.UniversalClient) *Drafts {
+	return &Drafts{rdb: rdb}
+}
+
+func (d *Drafts) OpenDraft(ctx context.Context, note *entities.Note) (*entities.Draft, error) {
+	key := draftKey(note)
+	pipe := d.rdb.TxPipeline()
+
+	pipe.HSetNX(ctx, key, contentField, note.Content)
+	pipe.HSetNX(ctx, key, revisionField, 0)
+	pipe.HSetNX(ctx, key, savedField, 0)
+	pipe.HSetNX(ctx, key, versionField, note.Version)
+	d.expire(ctx, pipe, note)
+
+	fields := pipe.HGetAll(ctx, key)
+	last := pipe.XRevRangeN(ctx, editsKey(note), "+", "-", 1)
+
+	_, err := pipe.Exec(ctx)
+	if err != nil {
+		return nil, ex.Unexpected(err)
+	}
+
+	draft, err := unmarshalDraft(note, fields.Val())
+	if err != nil {
+		return nil, err
+	}
+
+	draft.Position = startPosition
+	if messages := last.Val(); len(messages) > 0 {
+		draft.Position = messages[0].ID
+	}
+
+	return draft, nil
+}
+
+func (d *Drafts) GetDraft(ctx context.Context, note *entities.Note) (*entities.Draft, error) {
+	fields, err := d.rdb.HGetAll(ctx, draftKey(note)).Result()
+	if err != nil {
+		return nil, ex.Unexpected(err)
+	}
+
+	return unmarshalDraft(note, fields)
+}
+
+func (d *Drafts) GetEdits(ctx context.Context, draft *entities.Draft, after int64) ([]*entities.Edit, error) {
+	switch {
+	case after >= draft.Revision:
+		return make([]*entities.Edit, 0), nil
+	case draft.Revision-after > DraftEdits:
+		return nil, usecases.ErrRevisionTooOld
+	}
+
+	fields := make([]string, 0, draft.Revision-after)
+	for revision := after + 1; revision <= draft.Revision; revision++ {
+		fields = append(fields, strconv.FormatInt(revision, 10))
+	}
+
+	values, err := d.rdb.HMGet(ctx, opsKey(draft.Note), fields...).Result()
+	if err != nil {
+		return nil, ex.Unexpected(err)
+	}
+
+	edits := make([]*entities.Edit, len(values))
+
+	for i, value := range values {
+		raw, ok := value.(string)
+		if !ok {
+			return nil, usecases.ErrRevisionTooOld
+		}
+
+		edits[i], err = UnmarshalEdit([]byte(raw))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return edits, nil
+}
+
+func (d *Drafts) SaveEdit(ctx context.Context, draft *entities.Draft, edit *entities.Edit) error {
+	data, err := MarshalEdit(edit)
+	if err != nil {
+		return err
+	}
+
+	key := draftKey(draft.Note)
+
+	return d.rdb.Watch(ctx, func(tx *redis.Tx) error {
+		revision, err := tx.HGet(ctx, key, revisionField).Int64()
+
+		switch {
+		case errors.Is(err, redis.Nil):
+			return usecases.ErrDraftNotFound
+		case err != nil:
+			return ex.Unexpected(err)
+		case revision != draft.Revision-1:
+			return usecases.ErrDraftChanged
+		}
+
+		var added *redis.StringCmd
+
+		_, err = tx.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+			pipe.HSet(
+				ctx, key,
+				contentField, draft.Content,
+				revisionField, draft.Revision,
+				savedField, draft.Saved,
+				versionField, draft.Version,
+			)
+			pipe.HSet(ctx, opsKey(draft.Note), strconv.FormatInt(draft.Revision, 10), data)
+
+			if forgotten := draft.Revision - DraftEdits; forgotten > 0 {
+				pipe.HDel(ctx, opsKey(draft.Note), strconv.FormatInt(forgotten, 10))
+			}
+
+			added = pipe.XAdd(ctx, editArgs(draft.Note, data))
+			d.expire(ctx, pipe, draft.Note)
+
+			return nil
+		})
+
+		switch {
+		case errors.Is(err, redis.TxFailedErr):
+			return usecases.ErrDraftChanged
+		case err != nil:
+			return ex.Unexpected(err)
+		}
+
+		edit.Position = added.Val()
+
+		return nil
+	}, key)
+}
+
+func (d *Drafts) SendCursor(ctx context.Context, note *entities.Note, edit *entities.Edit) error {
+	data, err := MarshalEdit(edit)
+	if err != nil {
+		return err
+	}
+
+	pipe := d.rdb.TxPipeline()
+	added := pipe.XAdd(ctx, editArgs(note, data))
+	pipe.Expire(ctx, editsKey(note), DraftTTL)
+
+	_, err = pipe.Exec(ctx)
+	if err != nil {
+		return ex.Unexpected(err)
+	}
+
+	edit.Position = added.Val()
+
+	return nil
+}
+
+func (d *Drafts) WaitEdits(
+	ctx context.Context,
+	note *entities.Note,
+	position string,
+	timeout time.Duration,
+) ([]*entities.Edit, error) {
+	streams, err := d.rdb.XRead(ctx, &redis.XReadArgs{
+		Streams: []string{editsKey(note), position},
+		Count:   waitCount,
+		Block:   timeout,
+		ID:      "",
+	}).Result()
+
+	switch {
+	case errors.Is(err, redis.Nil):
+		return nil, usecases.ErrZeroEdits
+	case err != nil:
+		return nil, ex.Unexpected(err)
+	case len(streams) == 0 || len(streams[0].Messages) == 0:
+		return nil, usecases.ErrZeroEdits
+	}
+
+	edits := make([]*entities.Edit, 0, len(streams[0].Messages))
+
+	for _, message := range streams[0].Messages {
+		raw, found := message.Values[editField].(string)
+		if !found {
+			continue
+		}
+
+		edit, err := UnmarshalEdit([]byte(raw))
+		if err != nil {
+			return nil, err
+		}
+
+		edit.Position = message.ID
+		edits = append(edits, edit)
+	}
+
+	return edits, nil
+}
+
+func (d *Drafts) MarkSaved(ctx context.Context, draft *entities.Draft) error {
+	key := draftKey(draft.Note)
+
+	err := d.rdb.Watch(ctx, func(tx *redis.Tx) error {
+		values, err := tx.HMGet(ctx, key, savedField, versionField).Result()
+		if err != nil {
+			return err
+		}
+
+		saved, version, found := parseMark(values)
+
+		switch {
+		case !found:
+			// the draft is forgotten, a new one starts from the saved note anyway
+			return nil
+		case saved >= draft.Revision && version >= draft.Version:
+			return nil
+		}
+
+		_, err = tx.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+			pipe.HSet(ctx, key, savedField, max(saved, draft.Revision), versionField, max(version, draft.Version))
+
+			return nil
+		})
+
+		return err
+	}, key)
+
+	// another editor changed the draft meanwhile, the next save records the revision
+	if errors.Is(err, redis.TxFailedErr) {
+		return nil
+	}
+
+	return ex.Unexpected(err)
+}
+
+func (d *Drafts) expire(ctx context.Context, pipe redis.Pipeliner, note *entities.Note) {
+	pipe.Expire(ctx, draftKey(note), DraftTTL)
+	pipe.Expire(ctx, opsKey(note), DraftTTL)
+	pipe.Expire(ctx, editsKey(note), DraftTTL)
+}
+
+func unmarshalDraft(note *entities.Note, fields map[string]string) (*entities.Draft, error) {
+	content, found := fields[contentField]
+	if !found {
+		return nil, usecases.ErrDraftNotFound
+	}
+
+	revision, err := strconv.ParseInt(fields[revisionField], 10, 64)
+	if err != nil {
+		return nil, ex.Unexpected(err)
+	}
+
+	saved, err := strconv.ParseInt(fields[savedField], 10, 64)
+	if err != nil {
+		return nil, ex.Unexpected(err)
+	}
+
+	// the drafts made before their versions were kept are stale, so they start from the note
+	version, _ := strconv.ParseInt(fields[versionField], 10, 64)
+
+	draft := entities.NewDraft(note)
+	draft.Content = content
+	draft.Revision = revision
+	draft.Saved = saved
+	draft.Version = version
+
+	return draft, nil
+}
+
+// parseMark returns the saved revision and the version of the draft, the draft is not found without the revision.
+func parseMark(values []any) (int64, int64, bool) {
+	raw, ok := values[0].(string)
+	if !ok {
+		return 0, 0, false
+	}
+
+	saved, err := strconv.ParseInt(raw, 10, 64)
+	if err != nil {
+		return 0, 0, false
+	}
+
+	raw, _ = values[1].(string)
+	version, _ := strconv.ParseInt(raw, 10, 64)
+
+	return saved, version, true
+}
+
+func editArgs(note *entities.Note, data []byte) *redis.XAddArgs {
+	return &redis.XAddArgs{
+		Stream:     editsKey(note),
+		NoMkStream: false,
+		MaxLen:     DraftEdits,
+		MinID:      "",
+		Approx:     true,
+		Limit:      0,
+		ID:         "*",
+		Values:     []any{editField, data},
+	}
+}
+
+// the keys of a draft share the hash tag, so they live on the same node of a cluster and are watched together.
+func draftKey(note *entities.Note) string {
+	return fmt.Sprintf("{note:%d}:draft", note.ID.Value())
+}
+
+func opsKey(note *entities.Note) string {
+	return fmt.Sprintf("{note:%d}:draft:edits", note.ID.Value())
+}
+
+func editsKey(note *entities.Note) string {
+	return fmt.Sprintf("{note:%d}:draft:stream", note.ID.Value())
+}
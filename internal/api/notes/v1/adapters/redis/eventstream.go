@@ -0,0 +1,385 @@
+package redis
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+	"github.com/therenotomorrow/ex"
+	"github.com/therenotomorrow/gotes/internal/api/notes/v1/usecases"
+	"github.com/therenotomorrow/gotes/internal/domain/entities"
+	"github.com/therenotomorrow/gotes/internal/domain/types/id"
+	domuuid "github.com/therenotomorrow/gotes/internal/domain/types/uuid"
+)
+
+const (
+	ErrMarshalEvent   ex.Error = "marshal event error"
+	ErrUnmarshalEvent ex.Error = "unmarshal event error"
+
+	// DefaultMaxLength is the number of events kept in the stream of a user unless changed by SetRetention.
+	DefaultMaxLength = 10000
+	// DefaultMaxDevices is the number of devices a user may have unless changed by SetRetention.
+	DefaultMaxDevices = 32
+
+	eventField = "event"
+	busyGroup  = "BUSYGROUP"
+	// latestPosition starts a new device after the last event, pendingPosition reads the events given out
+	// to the device before and newPosition the events never given out.
+	latestPosition  = "$"
+	pendingPosition = "0"
+	newPosition     = ">"
+)
+
+// positionRegexp matches ids of stream entries, a bare timestamp in milliseconds is allowed as well.
+var positionRegexp = regexp.MustCompile(`^\d+(-\d+)?$`)
+
+// Retention limits how many events the stream of a user keeps, MaxAge takes precedence over MaxLength when set.
+// The streams are trimmed approximately on every save.
+//
+// Every device and every filter of a device has its own consumer group in the stream. When a subscription creates
+// a group, the groups idle longer than DeviceIdle are destroyed along with their pending events, and so are
+// the longest idle ones beyond MaxDevices. A forgotten device starts over with the new events. Zero values turn
+// the limits off.
+type Retention struct {
+	MaxLength  int64
+	MaxAge     time.Duration
+	MaxDevices int
+	DeviceIdle time.Duration
+}
+
+var retention = Retention{MaxLength: DefaultMaxLength, MaxAge: 0, MaxDevices: DefaultMaxDevices, DeviceIdle: 0}
+
+func SetRetention(r Retention) {
+	retention = r
+}
+
+func (r Retention) args(key string, data []byte) *redis.XAddArgs {
+	args := &redis.XAddArgs{
+		Stream:     key,
+		NoMkStream: false,
+		MaxLen:     r.MaxLength,
+		MinID:      "",
+		Approx:     true,
+		Limit:      0,
+		ID:         "*",
+		Values:     []any{eventField, data},
+	}
+
+	if r.MaxAge > 0 {
+		args.MaxLen = 0
+		args.MinID = strconv.FormatInt(time.Now().Add(-r.MaxAge).UnixMilli(), 10)
+	}
+
+	return args
+}
+
+type EventStream struct {
+	rdb redis.UniversalClient
+}
+
+type event struct {
+	EventTime time.Time          `json:"eventTime"`
+	EventType entities.EventType `json:"eventType"`
+	NoteID    id.ID              `json:"noteId"`
+	ID        uuid.UUID          `json:"id"`
+}
+
+func MarshalEvent(e *entities.Event) ([]byte, error) {
+	val, err := json.Marshal(&event{
+		ID:        uuid.MustParse(e.ID.Value()),
+		EventType: e.EventType,
+		NoteID:    e.Note.ID,
+		EventTime: e.EventTime,
+	})
+	if err != nil {
+		return nil, ErrMarshalEvent.Because(err)
+	}
+
+	return val, nil
+}
+
+func UnmarshalEvent(raw []byte) (*entities.Event, error) {
+	var event event
+
+	err := json.Unmarshal(raw, &event)
+	if err != nil {
+		return nil, ErrUnmarshalEvent.Because(err)
+	}
+
+	note := new(entities.Note)
+	note.ID = event.NoteID
+
+	return &entities.Event{
+		ID:        domuuid.Conv(event.ID.String()),
+		EventType: event.EventType,
+		Note:      note,
+		Recipient: nil,
+		Position:  "",
+		EventTime: event.EventTime,
+		ReadAt:    nil,
+	}, nil
+}
+
+func NewEventStream(rdb redis.UniversalClient) *EventStream {
+	return &EventStream{rdb: rdb}
+}
+
+func (e *EventStream) Publish(ctx context.Context, events []*entities.Event) error {
+	if len(events) == 0 {
+		return nil
+	}
+
+	pipe := e.rdb.Pipeline()
+
+	for _, event := range events {
+		data, err := MarshalEvent(event)
+		if err != nil {
+			return err
+		}
+
+		pipe.XAdd(ctx, retention.args(eventsKey(event.Recipient), data))
+	}
+
+	_, err := pipe.Exec(ctx)
+
+	return ex.Unexpected(err)
+}
+
+func (e *EventStream) Subscribe(ctx context.Context, sub *entities.Subscription, position string) error {
+	err := e.join(ctx, sub, position)
+	if err != nil {
+		return err
+	}
+
+	return e.drain(ctx, sub.User)
+}
+
+func (e *EventStream) join(ctx context.Context, sub *entities.Subscription, position string) error {
+	if position != "" && !positionRegexp.MatchString(position) {
+		return usecases.ErrInvalidPosition
+	}
+
+	start := position
+	if start == "" {
+		start = latestPosition
+	}
+
+	key, group := eventsKey(sub.User), sub.Group()
+	err := e.rdb.XGroupCreateMkStream(ctx, key, group, start).Err()
+
+	switch {
+	case err == nil:
+		return e.prune(ctx, key, group)
+	case !strings.HasPrefix(err.Error(), busyGroup):
+		return ex.Unexpected(err)
+	case position == "":
+		return nil
+	}
+
+	// the device resumes, so whatever it was given before the position is forgotten
+	pipe := e.rdb.TxPipeline()
+	pipe.XGroupDelConsumer(ctx, key, group, group)
+	pipe.XGroupSetID(ctx, key, group, position)
+
+	_, err = pipe.Exec(ctx)
+
+	return ex.Unexpected(err)
+}
+
+func (e *EventStream) WaitEvents(
+	ctx context.Context,
+	sub *entities.Subscription,
+	limit int,
+	timeout time.Duration,
+) ([]*entities.Event, error) {
+	for {
+		// the events that were given out but never acknowledged go first
+		messages, err := e.read(ctx, sub, pendingPosition, limit, -1)
+		if errors.Is(err, usecases.ErrZeroEvents) {
+			messages, err = e.read(ctx, sub, newPosition, limit, timeout)
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		events := make([]*entities.Event, 0, len(messages))
+
+		for _, message := range messages {
+			raw, found := message.Values[eventField].(string)
+			if !found {
+				// the event was trimmed away before it was acknowledged
+				err = e.ack(ctx, sub, message.ID)
+				if err != nil {
+					return nil, err
+				}
+
+				continue
+			}
+
+			event, err := UnmarshalEvent([]byte(raw))
+			if err != nil {
+				// a malformed event is acknowledged, otherwise it would be delivered forever
+				return nil, errors.Join(err, e.ack(ctx, sub, message.ID))
+			}
+
+			event.Position = message.ID
+			events = append(events, event)
+		}
+
+		if len(events) > 0 {
+			return events, nil
+		}
+	}
+}
+
+func (e *EventStream) AckEvent(ctx context.Context, sub *entities.Subscription, event *entities.Event) error {
+	return e.ack(ctx, sub, event.Position)
+}
+
+// prune destroys the groups of the stream that the retention no longer keeps, the group just created is kept.
+func (e *EventStream) prune(ctx context.Context, key, created string) error {
+	if retention.MaxDevices <= 0 && retention.DeviceIdle <= 0 {
+		return nil
+	}
+
+	groups, err := e.rdb.XInfoGroups(ctx, key).Result()
+	if err != nil {
+		return ex.Unexpected(err)
+	}
+
+	idle := make(map[string]time.Duration, len(groups))
+	names := make([]string, 0, len(groups))
+
+	for _, group := range groups {
+		if group.Name == created {
+			continue
+		}
+
+		consumers, err := e.rdb.XInfoConsumers(ctx, key, group.Name).Result()
+		if err != nil {
+			return ex.Unexpected(err)
+		}
+
+		idle[group.Name] = idleness(consumers)
+		names = append(names, group.Name)
+	}
+
+	// the longest idle groups go first
+	slices.SortFunc(names, func(a, b string) int { return cmp.Compare(idle[b], idle[a]) })
+
+	excess := len(names) + 1 - retention.MaxDevices
+	if retention.MaxDevices <= 0 {
+		excess = 0
+	}
+
+	pipe := e.rdb.Pipeline()
+
+	for i, name := range names {
+		if i >= excess && (retention.DeviceIdle <= 0 || idle[name] < retention.DeviceIdle) {
+			break
+		}
+
+		pipe.XGroupDestroy(ctx, key, name)
+	}
+
+	if pipe.Len() == 0 {
+		return nil
+	}
+
+	_, err = pipe.Exec(ctx)
+
+	return ex.Unexpected(err)
+}
+
+// idleness is how long the group was not read. A group without consumers is about to be read, it was just created
+// or reset by a resuming device, so only the limit of the devices destroys it.
+func idleness(consumers []redis.XInfoConsumer) time.Duration {
+	if len(consumers) == 0 {
+		return 0
+	}
+
+	least := consumers[0].Idle
+
+	for _, consumer := range consumers[1:] {
+		least = min(least, consumer.Idle)
+	}
+
+	return least
+}
+
+func (e *EventStream) read(
+	ctx context.Context,
+	sub *entities.Subscription,
+	position string,
+	limit int,
+	timeout time.Duration,
+) ([]redis.XMessage, error) {
+	streams, err := e.rdb.XReadGroup(ctx, &redis.XReadGroupArgs{
+		Group:    sub.Group(),
+		Consumer: sub.Group(),
+		Streams:  []string{eventsKey(sub.User), position},
+		Count:    int64(limit),
+		Block:    timeout,
+		NoAck:    false,
+		Claim:    0,
+	}).Result()
+
+	switch {
+	case errors.Is(err, redis.Nil):
+		return nil, usecases.ErrZeroEvents
+	case err != nil:
+		return nil, ex.Unexpected(err)
+	case len(streams) == 0 || len(streams[0].Messages) == 0:
+		return nil, usecases.ErrZeroEvents
+	}
+
+	return streams[0].Messages, nil
+}
+
+func (e *EventStream) ack(ctx context.Context, sub *entities.Subscription, position string) error {
+	err := e.rdb.XAck(ctx, eventsKey(sub.User), sub.Group(), position).Err()
+
+	return ex.Unexpected(err)
+}
+
+// drain moves the events left in the user's list by releases before the streams into the stream, so devices
+// receive them as new events.
+func (e *EventStream) drain(ctx context.Context, user *entities.User) error {
+	legacy, key := legacyEventsKey(user), eventsKey(user)
+
+	for {
+		raw, err := e.rdb.LPop(ctx, legacy).Result()
+
+		switch {
+		case errors.Is(err, redis.Nil):
+			return nil
+		case err != nil:
+			return ex.Unexpected(err)
+		}
+
+		err = e.rdb.XAdd(ctx, retention.args(key, []byte(raw))).Err()
+		if err != nil {
+			// the event goes back to the head of the list and the next subscription moves it
+			return errors.Join(ex.Unexpected(err), ex.Unexpected(e.rdb.LPush(ctx, legacy, raw).Err()))
+		}
+	}
+}
+
+// legacyEventsKey is the list the events were kept in before the streams, it is drained on subscription.
+func legacyEventsKey(user *entities.User) string {
+	return fmt.Sprintf("user:%d:events", user.ID.Value())
+}
+
+func eventsKey(user *entities.User) string {
+	return fmt.Sprintf("user:%d:stream", user.ID.Value())
+}
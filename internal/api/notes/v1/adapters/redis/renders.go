@@ -0,0 +1,82 @@
+package redis
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/therenotomorrow/gotes/internal/domain/entities"
+	"github.com/therenotomorrow/gotes/pkg/services/trace"
+)
+
+// RenderTTL is how long the render is kept after it is made, the renders of old versions are never read again.
+const RenderTTL = 24 * time.Hour
+
+// Renders keeps every render under the key of the version of its note, the keys of different notes may live on
+// different nodes of a cluster, so they are read by a pipeline instead of a single command. The cache is best-effort,
+// its failures are logged and the notes are rendered as if they were never cached.
+type Renders struct {
+	rdb      redis.UniversalClient
+	tracer   *trace.Tracer
+	renderer int
+}
+
+// NewRenders keeps the renders made by the version of the renderer apart from the renders of the other versions.
+func NewRenders(rdb redis.UniversalClient, renderer int, logger *slog.Logger) *Renders {
+	return &Renders{rdb: rdb, tracer: trace.Service("notes.v1.renders", logger), renderer: renderer}
+}
+
+func (r *Renders) GetRenders(
+	ctx context.Context,
+	notes []*entities.Note,
+	format entities.Format,
+) ([]*entities.Render, error) {
+	pipe := r.rdb.Pipeline()
+	cmds := make([]*redis.StringCmd, len(notes))
+
+	for i, note := range notes {
+		cmds[i] = pipe.Get(ctx, r.key(note.ID.Value(), note.Version, format))
+	}
+
+	_, err := pipe.Exec(ctx)
+	if err != nil && !errors.Is(err, redis.Nil) {
+		r.tracer.Warning(ctx, "get renders", "error", err)
+
+		return []*entities.Render{}, nil
+	}
+
+	renders := make([]*entities.Render, 0, len(notes))
+
+	for i, cmd := range cmds {
+		content, err := cmd.Result()
+		if err != nil {
+			continue
+		}
+
+		renders = append(renders, entities.NewRender(notes[i], format, content))
+	}
+
+	return renders, nil
+}
+
+func (r *Renders) SaveRenders(ctx context.Context, renders []*entities.Render) error {
+	pipe := r.rdb.Pipeline()
+
+	for _, render := range renders {
+		pipe.Set(ctx, r.key(render.Note.ID.Value(), render.Version, render.Format), render.Content, RenderTTL)
+	}
+
+	_, err := pipe.Exec(ctx)
+	if err != nil {
+		r.tracer.Warning(ctx, "save renders", "error", err)
+	}
+
+	return nil
+}
+
+func (r *Renders) key(noteID, version int64, format entities.Format) string {
+	return fmt.Sprintf("{note:%d}:render:v%d:%d:%s", noteID, r.renderer, version, format)
+}
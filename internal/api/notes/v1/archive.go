@@ -0,0 +1,306 @@
+package v1
+
+import (
+	"archive/zip"
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/therenotomorrow/ex"
+	"github.com/therenotomorrow/gotes/internal/api/notes/v1/usecases"
+	"github.com/therenotomorrow/gotes/internal/domain/entities"
+	pb "github.com/therenotomorrow/gotes/pkg/api/notes/v1"
+)
+
+const (
+	// ImportLimit is the maximum size of an archive accepted by ImportNotes.
+	ImportLimit = 32 << 20
+	slugLimit   = 48
+	frontMatter = "---"
+	markdownExt = ".md"
+
+	ErrInvalidArchive  ex.Error = "invalid archive"
+	ErrArchiveTooLarge ex.Error = "archive is too large"
+	ErrUnknownFormat   ex.Error = "unknown archive format"
+)
+
+// archivedNote is a single note in a JSON lines archive.
+type archivedNote struct {
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+	Title     string    `json:"title"`
+	Content   string    `json:"content"`
+	Tags      []string  `json:"tags,omitempty"`
+}
+
+// ArchivedNote is a note read from an archive, Source tells where the note was found.
+type ArchivedNote struct {
+	Input  *usecases.ImportNoteInput
+	Source string
+}
+
+// ArchiveWriter writes notes into an archive of the format, Close completes the archive.
+type ArchiveWriter struct {
+	lines *json.Encoder
+	files *zip.Writer
+	count int
+}
+
+func NewArchiveWriter(w io.Writer, format pb.ArchiveFormat) (*ArchiveWriter, error) {
+	archive := &ArchiveWriter{lines: nil, files: nil, count: 0}
+
+	switch format {
+	case pb.ArchiveFormat_ARCHIVE_FORMAT_JSON_LINES:
+		archive.lines = json.NewEncoder(w)
+		archive.lines.SetEscapeHTML(false)
+	case pb.ArchiveFormat_ARCHIVE_FORMAT_MARKDOWN_ZIP:
+		archive.files = zip.NewWriter(w)
+	default:
+		return nil, ErrUnknownFormat
+	}
+
+	return archive, nil
+}
+
+func (a *ArchiveWriter) Write(note *entities.Note) error {
+	a.count++
+
+	if a.lines != nil {
+		return ex.Unexpected(a.lines.Encode(&archivedNote{
+			CreatedAt: note.CreatedAt.UTC(),
+			UpdatedAt: note.UpdatedAt.UTC(),
+			Title:     note.Title,
+			Content:   note.Content,
+			Tags:      marshalTagNames(note.Tags),
+		}))
+	}
+
+	file, err := a.files.Create(fmt.Sprintf("%04d-%s%s", a.count, slug(note.Title), markdownExt))
+	if err != nil {
+		return ex.Unexpected(err)
+	}
+
+	_, err = io.WriteString(file, MarshalMarkdown(note))
+
+	return ex.Unexpected(err)
+}
+
+func (a *ArchiveWriter) Close() error {
+	if a.files != nil {
+		return ex.Unexpected(a.files.Close())
+	}
+
+	return nil
+}
+
+// ReadArchive parses all notes of the archive, the archive is rejected as a whole when it is malformed
+// or unpacks beyond the import limit.
+func ReadArchive(data []byte, format pb.ArchiveFormat) ([]*ArchivedNote, error) {
+	switch format {
+	case pb.ArchiveFormat_ARCHIVE_FORMAT_JSON_LINES:
+		return readLines(data)
+	case pb.ArchiveFormat_ARCHIVE_FORMAT_MARKDOWN_ZIP:
+		return readFiles(data)
+	default:
+		return nil, ErrInvalidArchive.Because(ErrUnknownFormat)
+	}
+}
+
+// MarshalMarkdown returns the note as a Markdown document with its title, tags and timestamps in the front matter.
+func MarshalMarkdown(note *entities.Note) string {
+	var doc strings.Builder
+
+	title, _ := json.Marshal(note.Title)
+	tags, _ := json.Marshal(append(make([]string, 0, len(note.Tags)), marshalTagNames(note.Tags)...))
+
+	doc.WriteString(frontMatter + "\n")
+	doc.WriteString("title: " + string(title) + "\n")
+	doc.WriteString("tags: " + string(tags) + "\n")
+	doc.WriteString("created_at: " + note.CreatedAt.UTC().Format(time.RFC3339Nano) + "\n")
+	doc.WriteString("updated_at: " + note.UpdatedAt.UTC().Format(time.RFC3339Nano) + "\n")
+	doc.WriteString(frontMatter + "\n")
+	doc.WriteString(note.Content)
+
+	return doc.String()
+}
+
+// UnmarshalMarkdown parses the document made by MarshalMarkdown, documents without
+// the front matter are taken as the content of the note titled by the name.
+func UnmarshalMarkdown(name, doc string) (*usecases.ImportNoteInput, error) {
+	input := &usecases.ImportNoteInput{
+		CreatedAt: time.Time{},
+		UpdatedAt: time.Time{},
+		Title:     strings.TrimSuffix(path.Base(name), path.Ext(name)),
+		Content:   doc,
+		Tags:      nil,
+	}
+
+	header, found := strings.CutPrefix(doc, frontMatter+"\n")
+	if !found {
+		return input, nil
+	}
+
+	header, content, found := strings.Cut(header, "\n"+frontMatter+"\n")
+	if !found {
+		return nil, ErrInvalidArchive.Because(fmt.Errorf("%s: unterminated front matter", name))
+	}
+
+	input.Content = content
+
+	for line := range strings.Lines(header) {
+		key, value, _ := strings.Cut(strings.TrimSpace(line), ":")
+		value = strings.TrimSpace(value)
+
+		var err error
+
+		switch key {
+		case "title":
+			err = json.Unmarshal([]byte(value), &input.Title)
+		case "tags":
+			err = json.Unmarshal([]byte(value), &input.Tags)
+		case "created_at":
+			input.CreatedAt, err = time.Parse(time.RFC3339Nano, value)
+		case "updated_at":
+			input.UpdatedAt, err = time.Parse(time.RFC3339Nano, value)
+		}
+
+		if err != nil {
+			return nil, ErrInvalidArchive.Because(fmt.Errorf("%s: invalid %s: %w", name, key, err))
+		}
+	}
+
+	return input, nil
+}
+
+func readLines(data []byte) ([]*ArchivedNote, error) {
+	notes := make([]*ArchivedNote, 0)
+
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	scanner.Buffer(nil, ImportLimit)
+
+	for number := 1; scanner.Scan(); number++ {
+		line := bytes.TrimSpace(scanner.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+
+		var note archivedNote
+
+		err := json.Unmarshal(line, &note)
+		if err != nil {
+			return nil, ErrInvalidArchive.Because(fmt.Errorf("line %d: %w", number, err))
+		}
+
+		notes = append(notes, &ArchivedNote{
+			Source: "line " + strconv.Itoa(number),
+			Input: &usecases.ImportNoteInput{
+				CreatedAt: note.CreatedAt,
+				UpdatedAt: note.UpdatedAt,
+				Title:     note.Title,
+				Content:   note.Content,
+				Tags:      note.Tags,
+			},
+		})
+	}
+
+	err := scanner.Err()
+	if err != nil {
+		return nil, ErrInvalidArchive.Because(err)
+	}
+
+	return notes, nil
+}
+
+func readFiles(data []byte) ([]*ArchivedNote, error) {
+	archive, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return nil, ErrInvalidArchive.Because(err)
+	}
+
+	notes := make([]*ArchivedNote, 0, len(archive.File))
+	total := 0
+
+	for _, file := range archive.File {
+		if file.FileInfo().IsDir() || !strings.EqualFold(path.Ext(file.Name), markdownExt) {
+			continue
+		}
+
+		doc, err := readFile(file)
+		if err != nil {
+			return nil, err
+		}
+
+		total += len(doc)
+		if total > ImportLimit {
+			return nil, ErrArchiveTooLarge
+		}
+
+		input, err := UnmarshalMarkdown(file.Name, doc)
+		if err != nil {
+			return nil, err
+		}
+
+		notes = append(notes, &ArchivedNote{Input: input, Source: file.Name})
+	}
+
+	return notes, nil
+}
+
+// readFile reads the file of the archive, refusing files that unpack beyond the import limit.
+func readFile(file *zip.File) (string, error) {
+	reader, err := file.Open()
+	if err != nil {
+		return "", ErrInvalidArchive.Because(err)
+	}
+
+	defer func() { _ = reader.Close() }()
+
+	doc, err := io.ReadAll(io.LimitReader(reader, ImportLimit+1))
+
+	switch {
+	case err != nil:
+		return "", ErrInvalidArchive.Because(err)
+	case len(doc) > ImportLimit:
+		return "", ErrArchiveTooLarge
+	}
+
+	return string(doc), nil
+}
+
+// slug makes a readable file name out of the title.
+func slug(title string) string {
+	var name strings.Builder
+
+	dash := false
+
+	for _, r := range strings.ToLower(title) {
+		switch {
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			name.WriteRune(r)
+
+			dash = false
+		case !dash && name.Len() > 0:
+			name.WriteByte('-')
+
+			dash = true
+		}
+
+		if name.Len() >= slugLimit {
+			break
+		}
+	}
+
+	result := strings.TrimSuffix(name.String(), "-")
+	if result == "" {
+		return "note"
+	}
+
+	return result
+}
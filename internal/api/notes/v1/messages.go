@@ -2,11 +2,23 @@ package v1
 
 import (
 	"context"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/therenotomorrow/ex"
+	"github.com/therenotomorrow/gotes/internal/api"
+	"github.com/therenotomorrow/gotes/internal/api/notes/v1/ports"
 	"github.com/therenotomorrow/gotes/internal/api/notes/v1/usecases"
 	"github.com/therenotomorrow/gotes/internal/domain/entities"
+	"github.com/therenotomorrow/gotes/internal/domain/types/diff"
+	"github.com/therenotomorrow/gotes/internal/domain/types/ot"
+	"github.com/therenotomorrow/gotes/internal/domain/types/placeholder"
+	"github.com/therenotomorrow/gotes/internal/domain/types/rrule"
 	"github.com/therenotomorrow/gotes/internal/services/secure"
+	"github.com/therenotomorrow/gotes/internal/storages/blobs"
 	pb "github.com/therenotomorrow/gotes/pkg/api/notes/v1"
 	typespb "github.com/therenotomorrow/gotes/pkg/api/types"
 	"google.golang.org/grpc/codes"
@@ -15,14 +27,345 @@ import (
 
 func MarshalNote(note *entities.Note) *pb.Note {
 	return &pb.Note{
-		Id:        &typespb.ID{Value: note.ID.Value()},
-		Title:     note.Title,
-		Content:   note.Content,
-		CreatedAt: timestamppb.New(note.CreatedAt),
-		UpdatedAt: timestamppb.New(note.UpdatedAt),
+		Id:         &typespb.ID{Value: note.ID.Value()},
+		Title:      note.Title,
+		Content:    note.Content,
+		CreatedAt:  timestamppb.New(note.CreatedAt),
+		UpdatedAt:  timestamppb.New(note.UpdatedAt),
+		DeletedAt:  marshalTime(note.DeletedAt),
+		Tags:       marshalTagNames(note.Tags),
+		NotebookId: marshalNotebookID(note.Notebook),
+		Version:    note.Version,
+		Pinned:     note.Pinned,
+		Archived:   note.Archived,
+		Starred:    note.Starred,
 	}
 }
 
+// MarshalETag returns the entity tag of the note version for REST clients, the contents rendered into another
+// format are tagged apart from the raw ones.
+func MarshalETag(version int64, format entities.Format) string {
+	tag := strconv.FormatInt(version, 10)
+	if !format.IsRaw() {
+		tag += "-" + string(format)
+	}
+
+	return strconv.Quote(tag)
+}
+
+// UnmarshalETag parses the entity tag made by MarshalETag, weak tags are accepted as well. The format of the tag
+// is ignored, the version alone tells whether the note is changed.
+func UnmarshalETag(tag string) (int64, bool) {
+	tag, err := strconv.Unquote(strings.TrimPrefix(strings.TrimSpace(tag), "W/"))
+	if err != nil {
+		return 0, false
+	}
+
+	tag, _, _ = strings.Cut(tag, "-")
+
+	version, err := strconv.ParseInt(tag, 10, 64)
+	if err != nil || version < 1 {
+		return 0, false
+	}
+
+	return version, true
+}
+
+func marshalTagNames(tags []*entities.Tag) []string {
+	if len(tags) == 0 {
+		return nil
+	}
+
+	names := make([]string, len(tags))
+	for i, tag := range tags {
+		names[i] = tag.Name
+	}
+
+	return names
+}
+
+func marshalNotebookID(notebook *entities.Notebook) *typespb.ID {
+	if notebook == nil {
+		return nil
+	}
+
+	return &typespb.ID{Value: notebook.ID.Value()}
+}
+
+func UnmarshalBatchCreateNotes(request *pb.BatchCreateNotesRequest) []*usecases.CreateNoteInput {
+	inputs := make([]*usecases.CreateNoteInput, len(request.GetNotes()))
+	for i, note := range request.GetNotes() {
+		inputs[i] = &usecases.CreateNoteInput{
+			Title:      note.GetTitle(),
+			Content:    note.GetContent(),
+			NotebookID: note.GetNotebookId().GetValue(),
+			TemplateID: note.GetTemplateId().GetValue(),
+			Variables:  note.GetVariables(),
+		}
+	}
+
+	return inputs
+}
+
+func UnmarshalIDs(idents []*typespb.ID) []int64 {
+	ids := make([]int64, len(idents))
+	for i, ident := range idents {
+		ids[i] = ident.GetValue()
+	}
+
+	return ids
+}
+
+// UnmarshalBatchDeleteNotes pairs the IDs of the notes with their versions, a note without a version has zero.
+func UnmarshalBatchDeleteNotes(ids []int64, versions map[int64]int64) []*usecases.DeleteNoteInput {
+	inputs := make([]*usecases.DeleteNoteInput, len(ids))
+	for i, ident := range ids {
+		inputs[i] = &usecases.DeleteNoteInput{ID: ident, Version: versions[ident]}
+	}
+
+	return inputs
+}
+
+// MarshalBatchResults marshals the results of a batch, ids identify failed items when known.
+func MarshalBatchResults(marshaler api.ErrorMarshaler, results []*usecases.BatchResult, ids []int64) []*pb.BatchNoteResult {
+	pbResults := make([]*pb.BatchNoteResult, len(results))
+	for i, result := range results {
+		pbResult := &pb.BatchNoteResult{Id: nil, Note: nil, Error: nil}
+
+		switch {
+		case result.Err != nil:
+			pbResult.Error = api.MarshalError(marshaler, result.Err)
+
+			if ids != nil {
+				pbResult.Id = &typespb.ID{Value: ids[i]}
+			}
+		default:
+			pbResult.Note = MarshalNote(result.Note)
+			pbResult.Id = pbResult.GetNote().GetId()
+		}
+
+		pbResults[i] = pbResult
+	}
+
+	return pbResults
+}
+
+func UnmarshalImportNotes(notes []*ArchivedNote) []*usecases.ImportNoteInput {
+	inputs := make([]*usecases.ImportNoteInput, len(notes))
+	for i, note := range notes {
+		inputs[i] = note.Input
+	}
+
+	return inputs
+}
+
+func MarshalImportResults(
+	marshaler api.ErrorMarshaler,
+	results []*usecases.ImportResult,
+	notes []*ArchivedNote,
+) *pb.ImportNotesResponse {
+	response := &pb.ImportNotesResponse{
+		Results:    make([]*pb.ImportNoteResult, len(results)),
+		Created:    0,
+		Duplicates: 0,
+		Failed:     0,
+	}
+
+	for i, result := range results {
+		pbResult := &pb.ImportNoteResult{
+			Source: notes[i].Source,
+			Status: pb.ImportStatus_IMPORT_STATUS_UNKNOWN,
+			Note:   nil,
+			Error:  nil,
+		}
+
+		switch {
+		case result.Err != nil:
+			pbResult.Status = pb.ImportStatus_IMPORT_STATUS_FAILED
+			pbResult.Error = api.MarshalError(marshaler, result.Err)
+			response.Failed++
+		case result.Duplicate:
+			pbResult.Status = pb.ImportStatus_IMPORT_STATUS_DUPLICATE
+			pbResult.Note = MarshalNote(result.Note)
+			response.Duplicates++
+		default:
+			pbResult.Status = pb.ImportStatus_IMPORT_STATUS_CREATED
+			pbResult.Note = MarshalNote(result.Note)
+			response.Created++
+		}
+
+		response.Results[i] = pbResult
+	}
+
+	return response
+}
+
+func UnmarshalUploadAttachment(header *pb.AttachmentHeader, content io.Reader) *usecases.UploadAttachmentInput {
+	return &usecases.UploadAttachmentInput{
+		Content:     content,
+		Name:        header.GetName(),
+		ContentType: header.GetContentType(),
+		Checksum:    header.GetChecksum(),
+		NoteID:      header.GetNoteId().GetValue(),
+	}
+}
+
+func MarshalAttachment(attachment *entities.Attachment) *pb.Attachment {
+	return &pb.Attachment{
+		Id:          &typespb.ID{Value: attachment.ID.Value()},
+		NoteId:      &typespb.ID{Value: attachment.Note.ID.Value()},
+		Name:        attachment.Name,
+		ContentType: attachment.ContentType,
+		Size:        attachment.Size,
+		Checksum:    attachment.Checksum,
+		CreatedAt:   timestamppb.New(attachment.CreatedAt),
+	}
+}
+
+func MarshalAttachments(attachments []*entities.Attachment) []*pb.Attachment {
+	pbAttachments := make([]*pb.Attachment, len(attachments))
+	for i, attachment := range attachments {
+		pbAttachments[i] = MarshalAttachment(attachment)
+	}
+
+	return pbAttachments
+}
+
+func MarshalNotebook(notebook *entities.Notebook) *pb.Notebook {
+	return &pb.Notebook{
+		Id:        &typespb.ID{Value: notebook.ID.Value()},
+		Name:      notebook.Name,
+		ParentId:  marshalNotebookID(notebook.Parent),
+		CreatedAt: timestamppb.New(notebook.CreatedAt),
+		UpdatedAt: timestamppb.New(notebook.UpdatedAt),
+	}
+}
+
+func MarshalNotebooks(notebooks []*entities.Notebook) []*pb.Notebook {
+	pbNotebooks := make([]*pb.Notebook, len(notebooks))
+	for i, notebook := range notebooks {
+		pbNotebooks[i] = MarshalNotebook(notebook)
+	}
+
+	return pbNotebooks
+}
+
+func UnmarshalUpdateNotebook(request *pb.UpdateNotebookRequest) *usecases.UpdateNotebookInput {
+	input := &usecases.UpdateNotebookInput{ID: request.GetId().GetValue(), Name: nil, ParentID: nil}
+
+	for _, path := range request.GetUpdateMask().GetPaths() {
+		switch path {
+		case "name":
+			name := request.GetName()
+			input.Name = &name
+		case "parent_id":
+			parentID := request.GetParentId().GetValue()
+			input.ParentID = &parentID
+		}
+	}
+
+	return input
+}
+
+func UnmarshalDeleteMode(mode pb.NotebookDeleteMode) usecases.DeleteMode {
+	if mode == pb.NotebookDeleteMode_NOTEBOOK_DELETE_MODE_CASCADE {
+		return usecases.DeleteModeCascade
+	}
+
+	return usecases.DeleteModeMoveToRoot
+}
+
+func MarshalTemplate(template *entities.Template) *pb.Template {
+	return &pb.Template{
+		Id:           &typespb.ID{Value: template.ID.Value()},
+		Name:         template.Name,
+		Title:        template.Title,
+		Content:      template.Content,
+		Placeholders: template.Placeholders(),
+		CreatedAt:    timestamppb.New(template.CreatedAt),
+		UpdatedAt:    timestamppb.New(template.UpdatedAt),
+	}
+}
+
+func MarshalTemplates(templates []*entities.Template) []*pb.Template {
+	pbTemplates := make([]*pb.Template, len(templates))
+	for i, template := range templates {
+		pbTemplates[i] = MarshalTemplate(template)
+	}
+
+	return pbTemplates
+}
+
+func UnmarshalUpdateTemplate(request *pb.UpdateTemplateRequest) *usecases.UpdateTemplateInput {
+	input := &usecases.UpdateTemplateInput{ID: request.GetId().GetValue(), Name: nil, Title: nil, Content: nil}
+
+	for _, path := range request.GetUpdateMask().GetPaths() {
+		switch path {
+		case "name":
+			name := request.GetName()
+			input.Name = &name
+		case "title":
+			title := request.GetTitle()
+			input.Title = &title
+		case "content":
+			content := request.GetContent()
+			input.Content = &content
+		}
+	}
+
+	return input
+}
+
+func MarshalReference(reference *entities.Reference) *pb.NoteReference {
+	pbReference := &pb.NoteReference{
+		SourceId:    &typespb.ID{Value: reference.Source.ID.Value()},
+		Target:      reference.Link.String(),
+		TargetId:    nil,
+		TargetTitle: "",
+		Dangling:    reference.IsDangling(),
+	}
+
+	if !reference.IsDangling() {
+		pbReference.TargetId = &typespb.ID{Value: reference.Target.ID.Value()}
+		pbReference.TargetTitle = reference.Target.Title
+	}
+
+	return pbReference
+}
+
+func MarshalReferences(references []*entities.Reference) []*pb.NoteReference {
+	pbReferences := make([]*pb.NoteReference, len(references))
+	for i, reference := range references {
+		pbReferences[i] = MarshalReference(reference)
+	}
+
+	return pbReferences
+}
+
+func MarshalTag(tag *entities.Tag) *pb.Tag {
+	return &pb.Tag{
+		Name:      tag.Name,
+		CreatedAt: timestamppb.New(tag.CreatedAt),
+	}
+}
+
+func MarshalTagCounts(counts []*ports.TagCount) []*pb.TagCount {
+	pbCounts := make([]*pb.TagCount, len(counts))
+	for i, count := range counts {
+		pbCounts[i] = &pb.TagCount{Tag: MarshalTag(count.Tag), Notes: count.Notes}
+	}
+
+	return pbCounts
+}
+
+func marshalTime(t *time.Time) *timestamppb.Timestamp {
+	if t == nil {
+		return nil
+	}
+
+	return timestamppb.New(*t)
+}
+
 func MarshalNotes(notes []*entities.Note) []*pb.Note {
 	pbNotes := make([]*pb.Note, len(notes))
 	for i, note := range notes {
@@ -32,31 +375,554 @@ func MarshalNotes(notes []*entities.Note) []*pb.Note {
 	return pbNotes
 }
 
+func UnmarshalListNotes(request *pb.ListNotesRequest) *usecases.ListNotesInput {
+	return &usecases.ListNotesInput{
+		Filter: ports.NotesFilter{
+			CreatedAfter:  unmarshalTime(request.GetCreatedAfter()),
+			CreatedBefore: unmarshalTime(request.GetCreatedBefore()),
+			UpdatedAfter:  unmarshalTime(request.GetUpdatedAfter()),
+			UpdatedBefore: unmarshalTime(request.GetUpdatedBefore()),
+			TitlePrefix:   unmarshalString(request.GetTitlePrefix()),
+			TagsAny:       unmarshalTags(request.GetTagsAny()),
+			TagsAll:       unmarshalTags(request.GetTagsAll()),
+			NotebookID:    unmarshalID(request.GetNotebookId()),
+			Pinned:        request.Pinned,
+			Archived:      unmarshalArchived(request.Archived),
+			Starred:       request.Starred,
+		},
+		PageToken: request.GetPageToken(),
+		Format:    UnmarshalFormat(request.GetFormat()),
+		Order:     unmarshalOrder(request.GetOrderBy()),
+		PageSize:  request.GetPageSize(),
+	}
+}
+
+// UnmarshalFormat returns the format of the content, the unknown format is left to the Accept header.
+func UnmarshalFormat(format pb.ContentFormat) entities.Format {
+	switch format {
+	case pb.ContentFormat_CONTENT_FORMAT_RAW:
+		return entities.FormatRaw
+	case pb.ContentFormat_CONTENT_FORMAT_HTML:
+		return entities.FormatHTML
+	case pb.ContentFormat_CONTENT_FORMAT_TEXT:
+		return entities.FormatText
+	default:
+		return ""
+	}
+}
+
+// UnmarshalAccept returns the format of the most preferred media type of the Accept header among `text/markdown`,
+// `text/html` and `text/plain`, it is empty when the header has none of them, e.g. for `application/json`.
+func UnmarshalAccept(accept string) entities.Format {
+	var (
+		format  entities.Format
+		quality float64
+	)
+
+	for mediaRange := range strings.SplitSeq(accept, ",") {
+		mediaType, params, _ := strings.Cut(mediaRange, ";")
+		weight := acceptQuality(params)
+
+		if weight <= quality {
+			continue
+		}
+
+		switch strings.ToLower(strings.TrimSpace(mediaType)) {
+		case "text/markdown":
+			format, quality = entities.FormatRaw, weight
+		case "text/html":
+			format, quality = entities.FormatHTML, weight
+		case "text/plain":
+			format, quality = entities.FormatText, weight
+		}
+	}
+
+	return format
+}
+
+func acceptQuality(params string) float64 {
+	for param := range strings.SplitSeq(params, ";") {
+		name, value, _ := strings.Cut(param, "=")
+		if strings.TrimSpace(name) != "q" {
+			continue
+		}
+
+		weight, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+		if err != nil {
+			return 0
+		}
+
+		return weight
+	}
+
+	return 1
+}
+
+func unmarshalOrder(orderBy string) ports.NotesOrder {
+	field, direction, _ := strings.Cut(orderBy, " ")
+	order := ports.NotesOrder{Field: ports.SortByCreatedAt, Descending: direction == "desc"}
+
+	switch field {
+	case "updated_at":
+		order.Field = ports.SortByUpdatedAt
+	case "title":
+		order.Field = ports.SortByTitle
+	}
+
+	return order
+}
+
+func unmarshalArchived(archived *bool) ports.ArchivedFilter {
+	if archived != nil && *archived {
+		return ports.ArchivedOnly
+	}
+
+	return ports.ArchivedHidden
+}
+
+func unmarshalTime(ts *timestamppb.Timestamp) *time.Time {
+	if ts == nil {
+		return nil
+	}
+
+	t := ts.AsTime()
+
+	return &t
+}
+
+func unmarshalID(ident *typespb.ID) *int64 {
+	if ident == nil {
+		return nil
+	}
+
+	value := ident.GetValue()
+
+	return &value
+}
+
+func unmarshalString(s string) *string {
+	if s == "" {
+		return nil
+	}
+
+	return &s
+}
+
+func unmarshalTags(names []string) []string {
+	if len(names) == 0 {
+		return nil
+	}
+
+	tags := make([]string, len(names))
+	for i, name := range names {
+		tags[i] = entities.NormalizeTag(name)
+	}
+
+	slices.Sort(tags)
+
+	return slices.Compact(tags)
+}
+
+func UnmarshalUpdateNote(request *pb.UpdateNoteRequest) *usecases.UpdateNoteInput {
+	input := &usecases.UpdateNoteInput{
+		ID:       request.GetId().GetValue(),
+		Title:    nil,
+		Content:  nil,
+		Pinned:   nil,
+		Archived: nil,
+		Starred:  nil,
+		Version:  request.GetVersion(),
+	}
+
+	for _, path := range request.GetUpdateMask().GetPaths() {
+		switch path {
+		case "title":
+			title := request.GetTitle()
+			input.Title = &title
+		case "content":
+			content := request.GetContent()
+			input.Content = &content
+		case "pinned":
+			pinned := request.GetPinned()
+			input.Pinned = &pinned
+		case "archived":
+			archived := request.GetArchived()
+			input.Archived = &archived
+		case "starred":
+			starred := request.GetStarred()
+			input.Starred = &starred
+		}
+	}
+
+	return input
+}
+
+func MarshalSearchHits(hits []*ports.SearchHit) []*pb.SearchHit {
+	pbHits := make([]*pb.SearchHit, len(hits))
+	for i, hit := range hits {
+		pbHits[i] = &pb.SearchHit{
+			Note:           MarshalNote(hit.Note),
+			Rank:           hit.Rank,
+			TitleSnippet:   hit.TitleSnippet,
+			ContentSnippet: hit.ContentSnippet,
+		}
+	}
+
+	return pbHits
+}
+
+func MarshalRevision(revision *entities.Revision) *pb.NoteRevision {
+	return &pb.NoteRevision{
+		Id:        &typespb.ID{Value: revision.ID.Value()},
+		NoteId:    &typespb.ID{Value: revision.Note.ID.Value()},
+		Revision:  revision.Number,
+		Title:     revision.Title,
+		Content:   revision.Content,
+		CreatedAt: timestamppb.New(revision.CreatedAt),
+	}
+}
+
+func MarshalRevisions(revisions []*entities.Revision) []*pb.NoteRevision {
+	pbRevisions := make([]*pb.NoteRevision, len(revisions))
+	for i, revision := range revisions {
+		pbRevisions[i] = MarshalRevision(revision)
+	}
+
+	return pbRevisions
+}
+
+func MarshalDiff(lines []diff.Line) []*pb.DiffLine {
+	pbLines := make([]*pb.DiffLine, len(lines))
+	for i, line := range lines {
+		var operation pb.DiffOperation
+
+		switch line.Operation {
+		case diff.OperationEqual:
+			operation = pb.DiffOperation_DIFF_OPERATION_EQUAL
+		case diff.OperationInsert:
+			operation = pb.DiffOperation_DIFF_OPERATION_INSERT
+		case diff.OperationDelete:
+			operation = pb.DiffOperation_DIFF_OPERATION_DELETE
+		default:
+			operation = pb.DiffOperation_DIFF_OPERATION_UNKNOWN
+		}
+
+		pbLines[i] = &pb.DiffLine{Operation: operation, Text: line.Text}
+	}
+
+	return pbLines
+}
+
+func MarshalRole(role entities.Role) pb.ShareRole {
+	switch role {
+	case entities.RoleViewer:
+		return pb.ShareRole_SHARE_ROLE_VIEWER
+	case entities.RoleEditor:
+		return pb.ShareRole_SHARE_ROLE_EDITOR
+	default:
+		return pb.ShareRole_SHARE_ROLE_UNKNOWN
+	}
+}
+
+func UnmarshalRole(role pb.ShareRole) entities.Role {
+	if role == pb.ShareRole_SHARE_ROLE_EDITOR {
+		return entities.RoleEditor
+	}
+
+	return entities.RoleViewer
+}
+
+func MarshalShare(share *entities.Share) *pb.NoteShare {
+	return &pb.NoteShare{
+		NoteId:    &typespb.ID{Value: share.Note.ID.Value()},
+		Email:     share.User.Email.Value(),
+		Name:      share.User.Name,
+		Role:      MarshalRole(share.Role),
+		CreatedAt: timestamppb.New(share.CreatedAt),
+	}
+}
+
+func MarshalShares(shares []*entities.Share) []*pb.NoteShare {
+	pbShares := make([]*pb.NoteShare, len(shares))
+	for i, share := range shares {
+		pbShares[i] = MarshalShare(share)
+	}
+
+	return pbShares
+}
+
+func MarshalSharedNotes(shares []*entities.Share) []*pb.SharedNote {
+	pbNotes := make([]*pb.SharedNote, len(shares))
+	for i, share := range shares {
+		pbNotes[i] = &pb.SharedNote{
+			Note:     MarshalNote(share.Note),
+			Role:     MarshalRole(share.Role),
+			SharedAt: timestamppb.New(share.CreatedAt),
+		}
+	}
+
+	return pbNotes
+}
+
+func MarshalLink(link *entities.Link) *pb.ShareLink {
+	return &pb.ShareLink{
+		Token:     link.Token,
+		NoteId:    &typespb.ID{Value: link.Note.ID.Value()},
+		Views:     link.Views,
+		ExpiresAt: marshalTime(link.ExpiresAt),
+		CreatedAt: timestamppb.New(link.CreatedAt),
+	}
+}
+
+func MarshalLinks(links []*entities.Link) []*pb.ShareLink {
+	pbLinks := make([]*pb.ShareLink, len(links))
+	for i, link := range links {
+		pbLinks[i] = MarshalLink(link)
+	}
+
+	return pbLinks
+}
+
+func MarshalReminder(reminder *entities.Reminder) *pb.Reminder {
+	recurrence := ""
+	if reminder.Recurrence != nil {
+		recurrence = reminder.Recurrence.String()
+	}
+
+	return &pb.Reminder{
+		Id:          &typespb.ID{Value: reminder.ID.Value()},
+		NoteId:      &typespb.ID{Value: reminder.Note.ID.Value()},
+		FireAt:      marshalTime(reminder.FireAt),
+		FiredAt:     marshalTime(reminder.FiredAt),
+		Recurrence:  recurrence,
+		TimeZone:    reminder.TimeZone,
+		Occurrences: reminder.Occurrences,
+		CreatedAt:   timestamppb.New(reminder.CreatedAt),
+		UpdatedAt:   timestamppb.New(reminder.UpdatedAt),
+	}
+}
+
+func MarshalReminders(reminders []*entities.Reminder) []*pb.Reminder {
+	pbReminders := make([]*pb.Reminder, len(reminders))
+	for i, reminder := range reminders {
+		pbReminders[i] = MarshalReminder(reminder)
+	}
+
+	return pbReminders
+}
+
+func UnmarshalUpdateReminder(request *pb.UpdateReminderRequest) *usecases.UpdateReminderInput {
+	input := &usecases.UpdateReminderInput{
+		FireAt:     nil,
+		Recurrence: nil,
+		TimeZone:   nil,
+		ID:         request.GetId().GetValue(),
+		NoteID:     request.GetNoteId().GetValue(),
+	}
+
+	for _, path := range request.GetUpdateMask().GetPaths() {
+		switch path {
+		case "fire_at":
+			fireAt := request.GetFireAt().AsTime()
+			input.FireAt = &fireAt
+		case "recurrence":
+			recurrence := request.GetRecurrence()
+			input.Recurrence = &recurrence
+		case "time_zone":
+			timeZone := request.GetTimeZone()
+			input.TimeZone = &timeZone
+		}
+	}
+
+	return input
+}
+
+func MarshalDraft(draft *entities.Draft, editor *entities.Editor) *pb.EditNoteResponse_Draft {
+	return &pb.EditNoteResponse_Draft{
+		Draft: &pb.Draft{
+			Content:  draft.Content,
+			Revision: draft.Revision,
+			Session:  editor.Session,
+			Writable: editor.CanWrite,
+		},
+	}
+}
+
+// MarshalEdit returns the edit as the editor sees it: own operations are acknowledged and own cursors are skipped.
+func MarshalEdit(edit *entities.Edit, editor *entities.Editor) *pb.EditNoteResponse {
+	own := editor.IsOwn(edit)
+
+	switch {
+	case own && edit.IsCursor():
+		return nil
+	case own:
+		return &pb.EditNoteResponse{
+			Payload: &pb.EditNoteResponse_Ack{Ack: &pb.EditAck{Revision: edit.Revision}},
+		}
+	case edit.IsCursor():
+		return &pb.EditNoteResponse{
+			Payload: &pb.EditNoteResponse_Cursor{Cursor: &pb.RemoteCursor{
+				UserId:  &typespb.ID{Value: edit.User.ID.Value()},
+				Session: edit.Session,
+				Cursor: &pb.EditCursor{
+					Revision: edit.Revision,
+					Anchor:   int32(edit.Selection.Anchor), //nolint:gosec // allowed conversation
+					Head:     int32(edit.Selection.Head),   //nolint:gosec // allowed conversation
+				},
+			}},
+		}
+	}
+
+	return &pb.EditNoteResponse{
+		Payload: &pb.EditNoteResponse_Edit{Edit: &pb.RemoteEdit{
+			UserId:    &typespb.ID{Value: edit.User.ID.Value()},
+			Session:   edit.Session,
+			Operation: MarshalOperation(edit.Operation, edit.Revision),
+		}},
+	}
+}
+
+func MarshalOperation(operation ot.Operation, revision int64) *pb.EditOperation {
+	components := make([]*pb.EditComponent, len(operation))
+
+	for i, component := range operation {
+		switch {
+		case component.Insert != "":
+			components[i] = &pb.EditComponent{Kind: &pb.EditComponent_Insert{Insert: component.Insert}}
+		case component.Retain > 0:
+			components[i] = &pb.EditComponent{Kind: &pb.EditComponent_Retain{
+				Retain: int32(component.Retain), //nolint:gosec // allowed conversation
+			}}
+		default:
+			components[i] = &pb.EditComponent{Kind: &pb.EditComponent_Delete{
+				Delete: int32(component.Delete), //nolint:gosec // allowed conversation
+			}}
+		}
+	}
+
+	return &pb.EditOperation{Revision: revision, Components: components}
+}
+
+func UnmarshalApplyEdit(operation *pb.EditOperation) *usecases.ApplyEditInput {
+	input := &usecases.ApplyEditInput{
+		Operation: make(ot.Operation, len(operation.GetComponents())),
+		Revision:  operation.GetRevision(),
+	}
+
+	for i, component := range operation.GetComponents() {
+		input.Operation[i] = ot.Component{
+			Insert: component.GetInsert(),
+			Retain: int(component.GetRetain()),
+			Delete: int(component.GetDelete()),
+		}
+	}
+
+	return input
+}
+
+func UnmarshalMoveCursor(cursor *pb.EditCursor) *usecases.MoveCursorInput {
+	return &usecases.MoveCursorInput{
+		Selection: entities.Selection{Anchor: int(cursor.GetAnchor()), Head: int(cursor.GetHead())},
+		Revision:  cursor.GetRevision(),
+	}
+}
+
 func MarshalUnread(unread int32) *pb.SubscribeToEventsResponse_Unread {
 	return &pb.SubscribeToEventsResponse_Unread{
 		Unread: &pb.Unread{Events: unread},
 	}
 }
 
-func MarshalEvent(event *entities.Event) *pb.SubscribeToEventsResponse_Event {
-	var eventType pb.EventType
+func MarshalHeartbeat(now time.Time) *pb.SubscribeToEventsResponse_Heartbeat {
+	return &pb.SubscribeToEventsResponse_Heartbeat{
+		Heartbeat: &pb.Heartbeat{Time: timestamppb.New(now)},
+	}
+}
+
+func MarshalStreamEvent(event *entities.Event) *pb.SubscribeToEventsResponse_Event {
+	return &pb.SubscribeToEventsResponse_Event{Event: MarshalEvent(event)}
+}
+
+func MarshalEvent(event *entities.Event) *pb.Event {
+	return &pb.Event{
+		Id:        event.ID.Value(),
+		Type:      MarshalEventType(event.EventType),
+		NoteId:    &typespb.ID{Value: event.Note.ID.Value()},
+		EventTime: timestamppb.New(event.EventTime),
+		Position:  event.Position,
+		ReadAt:    marshalTime(event.ReadAt),
+	}
+}
+
+func MarshalEvents(events []*entities.Event) []*pb.Event {
+	pbEvents := make([]*pb.Event, len(events))
+	for i, event := range events {
+		pbEvents[i] = MarshalEvent(event)
+	}
+
+	return pbEvents
+}
+
+func UnmarshalEventFilter(filter *pb.EventFilter, unread bool) ports.EventsFilter {
+	var types []entities.EventType
 
-	switch event.EventType {
+	for _, eventType := range filter.GetTypes() {
+		if known, ok := UnmarshalEventType(eventType); ok {
+			types = append(types, known)
+		}
+	}
+
+	return ports.EventsFilter{
+		Types:   types,
+		NoteIDs: UnmarshalIDs(filter.GetNoteIds()),
+		Tags:    unmarshalTags(filter.GetTags()),
+		Unread:  unread,
+	}
+}
+
+func MarshalEventType(eventType entities.EventType) pb.EventType {
+	switch eventType {
 	case entities.EventTypeCreated:
-		eventType = pb.EventType_EVENT_TYPE_CREATED
+		return pb.EventType_EVENT_TYPE_CREATED
 	case entities.EventTypeDeleted:
-		eventType = pb.EventType_EVENT_TYPE_DELETED
+		return pb.EventType_EVENT_TYPE_DELETED
+	case entities.EventTypeUpdated:
+		return pb.EventType_EVENT_TYPE_UPDATED
+	case entities.EventTypeRestored:
+		return pb.EventType_EVENT_TYPE_RESTORED
+	case entities.EventTypePurged:
+		return pb.EventType_EVENT_TYPE_PURGED
+	case entities.EventTypeShared:
+		return pb.EventType_EVENT_TYPE_SHARED
+	case entities.EventTypeUnshared:
+		return pb.EventType_EVENT_TYPE_UNSHARED
+	case entities.EventTypeReminder:
+		return pb.EventType_EVENT_TYPE_REMINDER
 	default:
-		eventType = pb.EventType_EVENT_TYPE_UNKNOWN
+		return pb.EventType_EVENT_TYPE_UNKNOWN
 	}
+}
 
-	return &pb.SubscribeToEventsResponse_Event{
-		Event: &pb.Event{
-			Id:        event.ID.Value(),
-			Type:      eventType,
-			NoteId:    &typespb.ID{Value: event.Note.ID.Value()},
-			EventTime: timestamppb.New(event.EventTime),
-		},
+func UnmarshalEventType(eventType pb.EventType) (entities.EventType, bool) {
+	switch eventType {
+	case pb.EventType_EVENT_TYPE_CREATED:
+		return entities.EventTypeCreated, true
+	case pb.EventType_EVENT_TYPE_DELETED:
+		return entities.EventTypeDeleted, true
+	case pb.EventType_EVENT_TYPE_UPDATED:
+		return entities.EventTypeUpdated, true
+	case pb.EventType_EVENT_TYPE_RESTORED:
+		return entities.EventTypeRestored, true
+	case pb.EventType_EVENT_TYPE_PURGED:
+		return entities.EventTypePurged, true
+	case pb.EventType_EVENT_TYPE_SHARED:
+		return entities.EventTypeShared, true
+	case pb.EventType_EVENT_TYPE_UNSHARED:
+		return entities.EventTypeUnshared, true
+	case pb.EventType_EVENT_TYPE_REMINDER:
+		return entities.EventTypeReminder, true
+	default:
+		return 0, false
 	}
 }
 
@@ -68,20 +934,120 @@ type ErrorMarshaler struct {
 func NewErrorMarshaler() *ErrorMarshaler {
 	return &ErrorMarshaler{
 		errorToCode: map[error]codes.Code{
-			usecases.ErrNoteNotFound:     codes.NotFound,
-			usecases.ErrPermissionDenied: codes.PermissionDenied,
-			context.Canceled:             codes.Canceled,
-			ex.ErrUnexpected:             codes.Internal,
-			secure.ErrUnauthorized:       codes.Unauthenticated,
-			ErrSend:                      codes.Unavailable,
+			usecases.ErrNoteNotFound:          codes.NotFound,
+			usecases.ErrPermissionDenied:      codes.PermissionDenied,
+			usecases.ErrNothingToUpdate:       codes.InvalidArgument,
+			usecases.ErrInvalidPageToken:      codes.InvalidArgument,
+			usecases.ErrRevisionNotFound:      codes.NotFound,
+			usecases.ErrNoteNotTrashed:        codes.FailedPrecondition,
+			usecases.ErrTagNotFound:           codes.NotFound,
+			usecases.ErrShareNotFound:         codes.NotFound,
+			usecases.ErrCollaboratorNotFound:  codes.NotFound,
+			usecases.ErrShareWithOwner:        codes.InvalidArgument,
+			usecases.ErrInvalidPosition:       codes.InvalidArgument,
+			usecases.ErrInvalidEventID:        codes.InvalidArgument,
+			usecases.ErrReminderNotFound:      codes.NotFound,
+			usecases.ErrReminderTimeRequired:  codes.InvalidArgument,
+			entities.ErrInvalidTimeZone:       codes.InvalidArgument,
+			entities.ErrReminderInPast:        codes.InvalidArgument,
+			rrule.ErrInvalidRule:              codes.InvalidArgument,
+			usecases.ErrDraftNotFound:         codes.NotFound,
+			usecases.ErrDraftChanged:          codes.Aborted,
+			usecases.ErrInvalidRevision:       codes.InvalidArgument,
+			usecases.ErrRevisionTooOld:        codes.Aborted,
+			ot.ErrInvalidOperation:            codes.InvalidArgument,
+			ot.ErrLengthMismatch:              codes.InvalidArgument,
+			usecases.ErrLinkNotFound:          codes.NotFound,
+			usecases.ErrNotebookNotFound:      codes.NotFound,
+			usecases.ErrNotebookCycle:         codes.InvalidArgument,
+			usecases.ErrBatchTooLarge:         codes.InvalidArgument,
+			usecases.ErrVersionRequired:       codes.FailedPrecondition,
+			usecases.ErrVersionMismatch:       codes.Aborted,
+			usecases.ErrAttachmentNotFound:    codes.NotFound,
+			usecases.ErrAttachmentTooLarge:    codes.InvalidArgument,
+			usecases.ErrChecksumMismatch:      codes.InvalidArgument,
+			usecases.ErrAttachmentCorrupted:   codes.DataLoss,
+			entities.ErrEmptyAttachmentName:   codes.InvalidArgument,
+			blobs.ErrBlobNotFound:             codes.DataLoss,
+			entities.ErrEmptyNotebookName:     codes.InvalidArgument,
+			usecases.ErrTemplateNotFound:      codes.NotFound,
+			entities.ErrEmptyTemplateName:     codes.InvalidArgument,
+			placeholder.ErrInvalidPlaceholder: codes.InvalidArgument,
+			placeholder.ErrMissingVariable:    codes.InvalidArgument,
+			entities.ErrEmptyTag:              codes.InvalidArgument,
+			entities.ErrEmptyTitle:            codes.InvalidArgument,
+			entities.ErrEmptyContent:          codes.InvalidArgument,
+			entities.ErrTitleLength:           codes.InvalidArgument,
+			entities.ErrContentLength:         codes.InvalidArgument,
+			context.Canceled:                  codes.Canceled,
+			ex.ErrUnexpected:                  codes.Internal,
+			secure.ErrUnauthorized:            codes.Unauthenticated,
+			ErrSend:                           codes.Unavailable,
+			ErrRecv:                           codes.Unavailable,
+			ErrInvalidArchive:                 codes.InvalidArgument,
+			ErrArchiveTooLarge:                codes.InvalidArgument,
+			ErrUnknownFormat:                  codes.InvalidArgument,
+			ErrMissingHeader:                  codes.InvalidArgument,
+			ErrMissingJoin:                    codes.InvalidArgument,
+			ErrJoinedAlready:                  codes.InvalidArgument,
 		},
 		errorToErrorCode: map[error]typespb.ErrorCode{
-			usecases.ErrNoteNotFound:     typespb.ErrorCode_ERROR_CODE_ENTITY_NOT_FOUND,
-			usecases.ErrPermissionDenied: typespb.ErrorCode_ERROR_CODE_PERMISSION_DENIED,
-			context.Canceled:             typespb.ErrorCode_ERROR_CODE_INTERNAL,
-			ex.ErrUnexpected:             typespb.ErrorCode_ERROR_CODE_INTERNAL,
-			secure.ErrUnauthorized:       typespb.ErrorCode_ERROR_CODE_PERMISSION_DENIED,
-			ErrSend:                      typespb.ErrorCode_ERROR_CODE_INTERNAL,
+			usecases.ErrNoteNotFound:          typespb.ErrorCode_ERROR_CODE_ENTITY_NOT_FOUND,
+			usecases.ErrPermissionDenied:      typespb.ErrorCode_ERROR_CODE_PERMISSION_DENIED,
+			usecases.ErrNothingToUpdate:       typespb.ErrorCode_ERROR_CODE_BUSINESS,
+			usecases.ErrInvalidPageToken:      typespb.ErrorCode_ERROR_CODE_INVALID_PAGE_TOKEN,
+			usecases.ErrRevisionNotFound:      typespb.ErrorCode_ERROR_CODE_ENTITY_NOT_FOUND,
+			usecases.ErrNoteNotTrashed:        typespb.ErrorCode_ERROR_CODE_BUSINESS,
+			usecases.ErrTagNotFound:           typespb.ErrorCode_ERROR_CODE_ENTITY_NOT_FOUND,
+			usecases.ErrShareNotFound:         typespb.ErrorCode_ERROR_CODE_ENTITY_NOT_FOUND,
+			usecases.ErrCollaboratorNotFound:  typespb.ErrorCode_ERROR_CODE_ENTITY_NOT_FOUND,
+			usecases.ErrShareWithOwner:        typespb.ErrorCode_ERROR_CODE_BUSINESS,
+			usecases.ErrInvalidPosition:       typespb.ErrorCode_ERROR_CODE_BUSINESS,
+			usecases.ErrInvalidEventID:        typespb.ErrorCode_ERROR_CODE_BUSINESS,
+			usecases.ErrReminderNotFound:      typespb.ErrorCode_ERROR_CODE_ENTITY_NOT_FOUND,
+			usecases.ErrReminderTimeRequired:  typespb.ErrorCode_ERROR_CODE_BUSINESS,
+			entities.ErrInvalidTimeZone:       typespb.ErrorCode_ERROR_CODE_BUSINESS,
+			entities.ErrReminderInPast:        typespb.ErrorCode_ERROR_CODE_BUSINESS,
+			rrule.ErrInvalidRule:              typespb.ErrorCode_ERROR_CODE_BUSINESS,
+			usecases.ErrDraftNotFound:         typespb.ErrorCode_ERROR_CODE_ENTITY_NOT_FOUND,
+			usecases.ErrDraftChanged:          typespb.ErrorCode_ERROR_CODE_BUSINESS,
+			usecases.ErrInvalidRevision:       typespb.ErrorCode_ERROR_CODE_BUSINESS,
+			usecases.ErrRevisionTooOld:        typespb.ErrorCode_ERROR_CODE_BUSINESS,
+			ot.ErrInvalidOperation:            typespb.ErrorCode_ERROR_CODE_BUSINESS,
+			ot.ErrLengthMismatch:              typespb.ErrorCode_ERROR_CODE_BUSINESS,
+			usecases.ErrLinkNotFound:          typespb.ErrorCode_ERROR_CODE_ENTITY_NOT_FOUND,
+			usecases.ErrNotebookNotFound:      typespb.ErrorCode_ERROR_CODE_ENTITY_NOT_FOUND,
+			usecases.ErrNotebookCycle:         typespb.ErrorCode_ERROR_CODE_BUSINESS,
+			usecases.ErrBatchTooLarge:         typespb.ErrorCode_ERROR_CODE_BUSINESS,
+			usecases.ErrVersionRequired:       typespb.ErrorCode_ERROR_CODE_VERSION_REQUIRED,
+			usecases.ErrVersionMismatch:       typespb.ErrorCode_ERROR_CODE_VERSION_MISMATCH,
+			usecases.ErrAttachmentNotFound:    typespb.ErrorCode_ERROR_CODE_ENTITY_NOT_FOUND,
+			usecases.ErrAttachmentTooLarge:    typespb.ErrorCode_ERROR_CODE_BUSINESS,
+			usecases.ErrChecksumMismatch:      typespb.ErrorCode_ERROR_CODE_BUSINESS,
+			usecases.ErrAttachmentCorrupted:   typespb.ErrorCode_ERROR_CODE_INTERNAL,
+			entities.ErrEmptyAttachmentName:   typespb.ErrorCode_ERROR_CODE_BUSINESS,
+			blobs.ErrBlobNotFound:             typespb.ErrorCode_ERROR_CODE_INTERNAL,
+			entities.ErrEmptyNotebookName:     typespb.ErrorCode_ERROR_CODE_BUSINESS,
+			usecases.ErrTemplateNotFound:      typespb.ErrorCode_ERROR_CODE_ENTITY_NOT_FOUND,
+			entities.ErrEmptyTemplateName:     typespb.ErrorCode_ERROR_CODE_BUSINESS,
+			placeholder.ErrInvalidPlaceholder: typespb.ErrorCode_ERROR_CODE_BUSINESS,
+			placeholder.ErrMissingVariable:    typespb.ErrorCode_ERROR_CODE_BUSINESS,
+			entities.ErrEmptyTag:              typespb.ErrorCode_ERROR_CODE_BUSINESS,
+			entities.ErrEmptyTitle:            typespb.ErrorCode_ERROR_CODE_INVALID_TITLE,
+			entities.ErrEmptyContent:          typespb.ErrorCode_ERROR_CODE_INVALID_CONTENT,
+			entities.ErrTitleLength:           typespb.ErrorCode_ERROR_CODE_INVALID_TITLE,
+			entities.ErrContentLength:         typespb.ErrorCode_ERROR_CODE_INVALID_CONTENT,
+			context.Canceled:                  typespb.ErrorCode_ERROR_CODE_INTERNAL,
+			ex.ErrUnexpected:                  typespb.ErrorCode_ERROR_CODE_INTERNAL,
+			secure.ErrUnauthorized:            typespb.ErrorCode_ERROR_CODE_PERMISSION_DENIED,
+			ErrSend:                           typespb.ErrorCode_ERROR_CODE_INTERNAL,
+			ErrRecv:                           typespb.ErrorCode_ERROR_CODE_INTERNAL,
+			ErrInvalidArchive:                 typespb.ErrorCode_ERROR_CODE_BUSINESS,
+			ErrArchiveTooLarge:                typespb.ErrorCode_ERROR_CODE_BUSINESS,
+			ErrUnknownFormat:                  typespb.ErrorCode_ERROR_CODE_BUSINESS,
+			ErrMissingHeader:                  typespb.ErrorCode_ERROR_CODE_BUSINESS,
+			ErrMissingJoin:                    typespb.ErrorCode_ERROR_CODE_BUSINESS,
+			ErrJoinedAlready:                  typespb.ErrorCode_ERROR_CODE_BUSINESS,
 		},
 	}
 }
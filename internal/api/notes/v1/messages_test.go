@@ -1,15 +1,22 @@
 package v1_test
 
 import (
+	"bytes"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	v1 "github.com/therenotomorrow/gotes/internal/api/notes/v1"
+	"github.com/therenotomorrow/gotes/internal/api/notes/v1/ports"
+	"github.com/therenotomorrow/gotes/internal/api/notes/v1/usecases"
 	"github.com/therenotomorrow/gotes/internal/domain/entities"
 	"github.com/therenotomorrow/gotes/internal/domain/types/id"
+	"github.com/therenotomorrow/gotes/internal/domain/types/ot"
+	"github.com/therenotomorrow/gotes/internal/domain/types/wikilink"
 	pb "github.com/therenotomorrow/gotes/pkg/api/notes/v1"
 	typespb "github.com/therenotomorrow/gotes/pkg/api/types"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -23,7 +30,9 @@ func TestMarshalNote(t *testing.T) {
 		Owner:     new(entities.User),
 		Title:     "title",
 		Content:   "content",
+		Tags:      []*entities.Tag{{Name: "gotes"}, {Name: "work"}},
 		ID:        id.New(42),
+		Version:   7,
 	}
 
 	got := v1.MarshalNote(note)
@@ -31,6 +40,8 @@ func TestMarshalNote(t *testing.T) {
 		Id:      &typespb.ID{Value: 42},
 		Title:   "title",
 		Content: "content",
+		Tags:    []string{"gotes", "work"},
+		Version: 7,
 		CreatedAt: &timestamppb.Timestamp{
 			Seconds: now.Add(-time.Hour).Unix(),
 			Nanos:   0,
@@ -63,3 +74,362 @@ func TestMarshalNotes(t *testing.T) {
 
 	assert.Equal(t, want, got)
 }
+
+func TestUnmarshalListNotes(t *testing.T) {
+	t.Parallel()
+
+	archived := true
+	request := &pb.ListNotesRequest{
+		OrderBy:  "title desc",
+		TagsAny:  []string{"Work", " gotes", "work"},
+		Archived: &archived,
+		Format:   pb.ContentFormat_CONTENT_FORMAT_TEXT,
+	}
+
+	got := v1.UnmarshalListNotes(request)
+
+	assert.Equal(t, []string{"gotes", "work"}, got.Filter.TagsAny)
+	assert.Nil(t, got.Filter.TagsAll)
+	assert.Nil(t, got.Filter.Pinned)
+	assert.Equal(t, ports.ArchivedOnly, got.Filter.Archived)
+	assert.Equal(t, ports.NotesOrder{Field: ports.SortByTitle, Descending: true}, got.Order)
+	assert.Equal(t, entities.FormatText, got.Format)
+}
+
+func TestUnmarshalUpdateNote(t *testing.T) {
+	t.Parallel()
+
+	request := &pb.UpdateNoteRequest{
+		Id:         &typespb.ID{Value: 42},
+		Title:      "title",
+		Content:    "content",
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"content"}},
+		Version:    3,
+	}
+
+	got := v1.UnmarshalUpdateNote(request)
+	content := "content"
+	want := &usecases.UpdateNoteInput{ID: 42, Title: nil, Content: &content, Version: 3}
+
+	assert.Equal(t, want, got)
+
+	request.UpdateMask.Paths = []string{"pinned", "archived"}
+	request.Pinned = true
+
+	got = v1.UnmarshalUpdateNote(request)
+	pinned, archived := true, false
+	want = &usecases.UpdateNoteInput{ID: 42, Pinned: &pinned, Archived: &archived, Version: 3}
+
+	assert.Equal(t, want, got)
+}
+
+func TestETag(t *testing.T) {
+	t.Parallel()
+
+	version, ok := v1.UnmarshalETag(v1.MarshalETag(42, entities.FormatRaw))
+	require.True(t, ok)
+	assert.Equal(t, int64(42), version)
+
+	assert.Equal(t, `"42"`, v1.MarshalETag(42, ""))
+	assert.Equal(t, `"42-html"`, v1.MarshalETag(42, entities.FormatHTML))
+	assert.NotEqual(t, v1.MarshalETag(42, entities.FormatHTML), v1.MarshalETag(42, entities.FormatText))
+
+	version, ok = v1.UnmarshalETag(v1.MarshalETag(42, entities.FormatText))
+	require.True(t, ok)
+	assert.Equal(t, int64(42), version)
+
+	version, ok = v1.UnmarshalETag(`W/"7"`)
+	require.True(t, ok)
+	assert.Equal(t, int64(7), version)
+
+	for _, tag := range []string{"", "*", `"0"`, `"-1"`, `"abc"`} {
+		_, ok = v1.UnmarshalETag(tag)
+		assert.False(t, ok, tag)
+	}
+}
+
+func TestUnmarshalFormat(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, entities.FormatRaw, v1.UnmarshalFormat(pb.ContentFormat_CONTENT_FORMAT_RAW))
+	assert.Equal(t, entities.FormatHTML, v1.UnmarshalFormat(pb.ContentFormat_CONTENT_FORMAT_HTML))
+	assert.Equal(t, entities.FormatText, v1.UnmarshalFormat(pb.ContentFormat_CONTENT_FORMAT_TEXT))
+	assert.Empty(t, v1.UnmarshalFormat(pb.ContentFormat_CONTENT_FORMAT_UNKNOWN))
+}
+
+func TestUnmarshalAccept(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]entities.Format{
+		"":                                  "",
+		"*/*":                               "",
+		"application/json":                  "",
+		"text/html":                         entities.FormatHTML,
+		"Text/Plain; charset=utf-8":         entities.FormatText,
+		"text/markdown, text/html":          entities.FormatRaw,
+		"text/html;q=0.5, text/plain":       entities.FormatText,
+		"application/json, text/html;q=0.9": entities.FormatHTML,
+		"text/html;q=0":                     "",
+	}
+
+	for accept, want := range tests {
+		assert.Equal(t, want, v1.UnmarshalAccept(accept), accept)
+	}
+}
+
+func TestUnmarshalUpdateNotebook(t *testing.T) {
+	t.Parallel()
+
+	request := &pb.UpdateNotebookRequest{
+		Id:         &typespb.ID{Value: 42},
+		Name:       "name",
+		ParentId:   nil,
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"parent_id"}},
+	}
+
+	got := v1.UnmarshalUpdateNotebook(request)
+	root := int64(0)
+	want := &usecases.UpdateNotebookInput{ID: 42, Name: nil, ParentID: &root}
+
+	assert.Equal(t, want, got)
+}
+
+func TestUnmarshalUpdateTemplate(t *testing.T) {
+	t.Parallel()
+
+	request := &pb.UpdateTemplateRequest{
+		Id:         &typespb.ID{Value: 3},
+		Name:       "name",
+		Title:      "",
+		Content:    "content",
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"title", "content"}},
+	}
+
+	got := v1.UnmarshalUpdateTemplate(request)
+	title, content := "", "content"
+	want := &usecases.UpdateTemplateInput{ID: 3, Name: nil, Title: &title, Content: &content}
+
+	assert.Equal(t, want, got)
+}
+
+func TestMarshalReferences(t *testing.T) {
+	t.Parallel()
+
+	source := &entities.Note{ID: id.New(1)}
+	references := []*entities.Reference{
+		{Source: source, Target: &entities.Note{Title: "Roadmap", ID: id.New(7)}, Link: wikilink.Target{ID: 7}},
+		{Source: source, Target: nil, Link: wikilink.Target{Title: "Missing"}, Position: 1},
+	}
+
+	got := v1.MarshalReferences(references)
+	want := []*pb.NoteReference{
+		{SourceId: &typespb.ID{Value: 1}, Target: "7", TargetId: &typespb.ID{Value: 7}, TargetTitle: "Roadmap"},
+		{SourceId: &typespb.ID{Value: 1}, Target: "Missing", Dangling: true},
+	}
+
+	assert.Equal(t, want, got)
+}
+
+func TestRenderNote(t *testing.T) {
+	t.Parallel()
+
+	note := &entities.Note{Title: "<script>alert(1)</script>", Content: "line 1\nline & 2"}
+
+	got, err := v1.RenderNote(note)
+	require.NoError(t, err)
+
+	page := string(got)
+
+	assert.Contains(t, page, "&lt;script&gt;alert(1)&lt;/script&gt;")
+	assert.Contains(t, page, "line 1\nline &amp; 2")
+	assert.NotContains(t, page, "<script>")
+}
+
+func TestMarshalBatchResults(t *testing.T) {
+	t.Parallel()
+
+	results := []*usecases.BatchResult{
+		{Note: &entities.Note{ID: id.New(42)}, Err: nil},
+		{Note: nil, Err: usecases.ErrNoteNotFound},
+	}
+
+	got := v1.MarshalBatchResults(v1.NewErrorMarshaler(), results, []int64{42, 43})
+	require.Len(t, got, 2)
+
+	assert.Equal(t, int64(42), got[0].GetId().GetValue())
+	assert.Equal(t, int64(42), got[0].GetNote().GetId().GetValue())
+	assert.Nil(t, got[0].GetError())
+
+	assert.Equal(t, int64(43), got[1].GetId().GetValue())
+	assert.Nil(t, got[1].GetNote())
+	assert.Equal(t, typespb.ErrorCode_ERROR_CODE_ENTITY_NOT_FOUND, got[1].GetError().GetCode())
+	assert.Equal(t, "note not found", got[1].GetError().GetReason())
+}
+
+func TestArchive(t *testing.T) {
+	t.Parallel()
+
+	createdAt := time.Date(2024, time.March, 1, 10, 0, 0, 0, time.UTC)
+	notes := []*entities.Note{
+		{
+			CreatedAt: createdAt,
+			UpdatedAt: createdAt.Add(time.Hour),
+			Title:     `"Quoted": title`,
+			Content:   "---\nline 1\nline 2\n",
+			Tags:      []*entities.Tag{{Name: "gotes"}, {Name: "work"}},
+		},
+		{CreatedAt: createdAt, UpdatedAt: createdAt, Title: "title", Content: "content"},
+	}
+
+	for _, format := range []pb.ArchiveFormat{
+		pb.ArchiveFormat_ARCHIVE_FORMAT_JSON_LINES,
+		pb.ArchiveFormat_ARCHIVE_FORMAT_MARKDOWN_ZIP,
+	} {
+		t.Run(format.String(), func(t *testing.T) {
+			t.Parallel()
+
+			var buf bytes.Buffer
+
+			archive, err := v1.NewArchiveWriter(&buf, format)
+			require.NoError(t, err)
+
+			for _, note := range notes {
+				require.NoError(t, archive.Write(note))
+			}
+
+			require.NoError(t, archive.Close())
+
+			got, err := v1.ReadArchive(buf.Bytes(), format)
+			require.NoError(t, err)
+			require.Len(t, got, 2)
+
+			assert.Equal(t, &usecases.ImportNoteInput{
+				CreatedAt: createdAt,
+				UpdatedAt: createdAt.Add(time.Hour),
+				Title:     `"Quoted": title`,
+				Content:   "---\nline 1\nline 2\n",
+				Tags:      []string{"gotes", "work"},
+			}, got[0].Input)
+			assert.Equal(t, "title", got[1].Input.Title)
+			assert.NotEmpty(t, got[1].Source)
+		})
+	}
+
+	t.Run("unknown format", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := v1.NewArchiveWriter(new(bytes.Buffer), pb.ArchiveFormat_ARCHIVE_FORMAT_UNKNOWN)
+		require.ErrorIs(t, err, v1.ErrUnknownFormat)
+	})
+
+	t.Run("malformed", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := v1.ReadArchive([]byte("{}\nnot json"), pb.ArchiveFormat_ARCHIVE_FORMAT_JSON_LINES)
+		require.ErrorIs(t, err, v1.ErrInvalidArchive)
+
+		_, err = v1.ReadArchive([]byte("not zip"), pb.ArchiveFormat_ARCHIVE_FORMAT_MARKDOWN_ZIP)
+		require.ErrorIs(t, err, v1.ErrInvalidArchive)
+	})
+}
+
+func TestUnmarshalMarkdown(t *testing.T) {
+	t.Parallel()
+
+	got, err := v1.UnmarshalMarkdown("notes/groceries.md", "# Groceries\n- milk\n")
+	require.NoError(t, err)
+	assert.Equal(t, "groceries", got.Title)
+	assert.Equal(t, "# Groceries\n- milk\n", got.Content)
+	assert.True(t, got.CreatedAt.IsZero())
+
+	_, err = v1.UnmarshalMarkdown("broken.md", "---\ntitle: \"broken\"\n")
+	require.ErrorIs(t, err, v1.ErrInvalidArchive)
+
+	_, err = v1.UnmarshalMarkdown("broken.md", "---\ncreated_at: yesterday\n---\ncontent")
+	require.ErrorIs(t, err, v1.ErrInvalidArchive)
+}
+
+func TestMarshalImportResults(t *testing.T) {
+	t.Parallel()
+
+	results := []*usecases.ImportResult{
+		{Note: &entities.Note{ID: id.New(42)}, Err: nil, Duplicate: false},
+		{Note: &entities.Note{ID: id.New(7)}, Err: nil, Duplicate: true},
+		{Note: nil, Err: entities.ErrEmptyTitle, Duplicate: false},
+	}
+	notes := []*v1.ArchivedNote{{Source: "line 1"}, {Source: "line 2"}, {Source: "line 3"}}
+
+	got := v1.MarshalImportResults(v1.NewErrorMarshaler(), results, notes)
+	require.Len(t, got.GetResults(), 3)
+
+	assert.Equal(t, int32(1), got.GetCreated())
+	assert.Equal(t, int32(1), got.GetDuplicates())
+	assert.Equal(t, int32(1), got.GetFailed())
+
+	assert.Equal(t, "line 1", got.GetResults()[0].GetSource())
+	assert.Equal(t, pb.ImportStatus_IMPORT_STATUS_CREATED, got.GetResults()[0].GetStatus())
+	assert.Equal(t, int64(42), got.GetResults()[0].GetNote().GetId().GetValue())
+	assert.Equal(t, pb.ImportStatus_IMPORT_STATUS_DUPLICATE, got.GetResults()[1].GetStatus())
+	assert.Equal(t, int64(7), got.GetResults()[1].GetNote().GetId().GetValue())
+	assert.Equal(t, pb.ImportStatus_IMPORT_STATUS_FAILED, got.GetResults()[2].GetStatus())
+	assert.Equal(t, typespb.ErrorCode_ERROR_CODE_INVALID_TITLE, got.GetResults()[2].GetError().GetCode())
+}
+
+func TestUnmarshalUpdateReminder(t *testing.T) {
+	t.Parallel()
+
+	fireAt := time.Date(2030, time.January, 7, 9, 0, 0, 0, time.UTC)
+	request := &pb.UpdateReminderRequest{
+		Id:         &typespb.ID{Value: 1},
+		NoteId:     &typespb.ID{Value: 42},
+		FireAt:     timestamppb.New(fireAt),
+		Recurrence: "",
+		TimeZone:   "Europe/Berlin",
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"fire_at", "recurrence"}},
+	}
+
+	got := v1.UnmarshalUpdateReminder(request)
+	recurrence := ""
+	want := &usecases.UpdateReminderInput{
+		FireAt: &fireAt, Recurrence: &recurrence, TimeZone: nil, ID: 1, NoteID: 42,
+	}
+
+	assert.Equal(t, want, got)
+}
+
+func TestMarshalEdit(t *testing.T) {
+	t.Parallel()
+
+	var (
+		user   = &entities.User{ID: id.New(20)}
+		editor = &entities.Editor{User: user, Note: nil, Session: "own", Position: "", CanWrite: true}
+		remote = &entities.Editor{User: user, Note: nil, Session: "remote", Position: "", CanWrite: true}
+		edit   = editor.Edit(ot.Operation{}.Retain(5).Insert(",").Delete(6), 3)
+		cursor = editor.Move(entities.Selection{Anchor: 1, Head: 5}, 3)
+	)
+
+	assert.Nil(t, v1.MarshalEdit(cursor, editor))
+	assert.Equal(t, &pb.EditNoteResponse{
+		Payload: &pb.EditNoteResponse_Ack{Ack: &pb.EditAck{Revision: 3}},
+	}, v1.MarshalEdit(edit, editor))
+
+	operation := &pb.EditOperation{Revision: 3, Components: []*pb.EditComponent{
+		{Kind: &pb.EditComponent_Retain{Retain: 5}},
+		{Kind: &pb.EditComponent_Insert{Insert: ","}},
+		{Kind: &pb.EditComponent_Delete{Delete: 6}},
+	}}
+
+	assert.Equal(t, &pb.EditNoteResponse{
+		Payload: &pb.EditNoteResponse_Edit{Edit: &pb.RemoteEdit{
+			UserId: &typespb.ID{Value: 20}, Session: "own", Operation: operation,
+		}},
+	}, v1.MarshalEdit(edit, remote))
+	assert.Equal(t, &pb.EditNoteResponse{
+		Payload: &pb.EditNoteResponse_Cursor{Cursor: &pb.RemoteCursor{
+			UserId: &typespb.ID{Value: 20}, Session: "own", Cursor: &pb.EditCursor{Revision: 3, Anchor: 1, Head: 5},
+		}},
+	}, v1.MarshalEdit(cursor, remote))
+
+	want := &usecases.ApplyEditInput{Operation: edit.Operation, Revision: 3}
+	assert.Equal(t, want, v1.UnmarshalApplyEdit(operation))
+}
@@ -2,27 +2,343 @@ package ports
 
 import (
 	"context"
+	"io"
+	"time"
 
 	"github.com/therenotomorrow/gotes/internal/domain/entities"
+	"github.com/therenotomorrow/gotes/internal/domain/types/email"
 	"github.com/therenotomorrow/gotes/internal/domain/types/id"
+	"github.com/therenotomorrow/gotes/internal/domain/types/uuid"
 )
 
+type SortField int
+
+const (
+	SortByCreatedAt SortField = iota
+	SortByUpdatedAt
+	SortByTitle
+)
+
+// ArchivedFilter keeps the notes by whether they are archived.
+type ArchivedFilter int
+
+const (
+	// ArchivedHidden keeps the notes not archived, the listings hide the archived notes unless asked for.
+	ArchivedHidden ArchivedFilter = iota
+	// ArchivedOnly keeps only the archived notes.
+	ArchivedOnly
+	// ArchivedIncluded keeps the notes whether they are archived or not, e.g. to export all of them.
+	ArchivedIncluded
+)
+
+type NotesOrder struct {
+	Field      SortField
+	Descending bool
+}
+
+type NotesFilter struct {
+	CreatedAfter  *time.Time
+	CreatedBefore *time.Time
+	UpdatedAfter  *time.Time
+	UpdatedBefore *time.Time
+	TitlePrefix   *string
+	// NotebookID keeps notes of the notebook, not including its nested notebooks.
+	NotebookID *int64
+	Pinned     *bool
+	Starred    *bool
+	// TagsAny keeps notes having at least one of the tags.
+	TagsAny []string
+	// TagsAll keeps notes having every one of the tags.
+	TagsAll  []string
+	Archived ArchivedFilter
+}
+
+type NotesQuery struct {
+	// After is the last note of the previous page, the listing continues right after it.
+	After  *entities.Note
+	Filter NotesFilter
+	Order  NotesOrder
+	Limit  int32
+}
+
+type SearchHit struct {
+	Note           *entities.Note
+	TitleSnippet   string
+	ContentSnippet string
+	Rank           float32
+}
+
+type SearchQuery struct {
+	// After is the last hit of the previous page, the search continues right after it.
+	After *SearchHit
+	Text  string
+	Limit int32
+}
+
+type TrashQuery struct {
+	// After is the last note of the previous page, the listing continues right after it.
+	After *entities.Note
+	Limit int32
+}
+
+// NotesRepository changes a note only when it is still at the version the note entity holds,
+// otherwise the change fails as a version mismatch.
 type NotesRepository interface {
 	SaveNote(ctx context.Context, note *entities.Note) (*entities.Note, error)
 	GetNote(ctx context.Context, id id.ID) (*entities.Note, error)
+	// GetNoteByContent finds a note of the user with exactly the same title and content, e.g. to skip duplicates.
+	GetNoteByContent(ctx context.Context, user *entities.User, title, content string) (*entities.Note, error)
+	UpdateNote(ctx context.Context, note *entities.Note) error
+	// LockNote keeps the note from being changed by others until the transaction ends.
+	LockNote(ctx context.Context, note *entities.Note) error
 	DeleteNote(ctx context.Context, note *entities.Note) error
-	GetNotesByUser(ctx context.Context, user *entities.User) ([]*entities.Note, error)
+	TrashNote(ctx context.Context, note *entities.Note) error
+	MoveNote(ctx context.Context, note *entities.Note) error
+	// TouchNote bumps the version of the note changed outside of the note itself, e.g. by tags.
+	TouchNote(ctx context.Context, note *entities.Note) error
+	RestoreNote(ctx context.Context, note *entities.Note) error
+	PurgeNotes(ctx context.Context, before time.Time) ([]*entities.Note, error)
+	GetNotesByUser(ctx context.Context, user *entities.User, query *NotesQuery) ([]*entities.Note, error)
+	CountNotesByUser(ctx context.Context, user *entities.User, filter *NotesFilter) (int32, error)
+	SearchNotesByUser(ctx context.Context, user *entities.User, query *SearchQuery) ([]*SearchHit, error)
+	CountSearchNotesByUser(ctx context.Context, user *entities.User, text string) (int32, error)
+	GetTrashedNotesByUser(ctx context.Context, user *entities.User, query *TrashQuery) ([]*entities.Note, error)
+	CountTrashedNotesByUser(ctx context.Context, user *entities.User) (int32, error)
+}
+
+type RevisionsQuery struct {
+	// After is the last revision of the previous page, the listing continues right after it.
+	After *entities.Revision
+	Limit int32
+}
+
+type RevisionsRepository interface {
+	// SaveRevision numbers the revision after the latest one of the note, the note is locked at its version until
+	// the transaction ends, so the concurrent revisions of the note never get the same number.
+	SaveRevision(ctx context.Context, revision *entities.Revision) (*entities.Revision, error)
+	GetRevision(ctx context.Context, note *entities.Note, number int32) (*entities.Revision, error)
+	GetRevisionsByNote(ctx context.Context, note *entities.Note, query *RevisionsQuery) ([]*entities.Revision, error)
+}
+
+type TagCount struct {
+	Tag   *entities.Tag
+	Notes int32
+}
+
+type TagsRepository interface {
+	AddNoteTags(ctx context.Context, note *entities.Note, tags []*entities.Tag) error
+	RemoveNoteTags(ctx context.Context, note *entities.Note, names []string) error
+	GetTag(ctx context.Context, user *entities.User, name string) (*entities.Tag, error)
+	GetTagsByUser(ctx context.Context, user *entities.User) ([]*TagCount, error)
+	// LoadNoteTags fills in the tags of all the notes at once.
+	LoadNoteTags(ctx context.Context, notes []*entities.Note) error
+	UpdateTag(ctx context.Context, tag *entities.Tag) error
+	MergeTag(ctx context.Context, from, into *entities.Tag) error
+}
+
+type NotebooksRepository interface {
+	SaveNotebook(ctx context.Context, notebook *entities.Notebook) (*entities.Notebook, error)
+	GetNotebook(ctx context.Context, id id.ID) (*entities.Notebook, error)
+	GetNotebooksByUser(ctx context.Context, user *entities.User) ([]*entities.Notebook, error)
+	// GetDescendants returns all notebooks nested into the notebook at any depth.
+	GetDescendants(ctx context.Context, notebook *entities.Notebook) ([]*entities.Notebook, error)
+	UpdateNotebook(ctx context.Context, notebook *entities.Notebook) error
+	// LockNotebooks keeps all notebooks of the user from being moved by others until the transaction ends.
+	LockNotebooks(ctx context.Context, user *entities.User) error
+	DeleteNotebooks(ctx context.Context, notebooks []*entities.Notebook) error
+	// DetachNotebook moves the nested notebooks and the notes of the notebook to the root.
+	DetachNotebook(ctx context.Context, notebook *entities.Notebook) error
+	// TrashNotes moves the notes of the notebooks to the trash and detaches all notes from the notebooks.
+	TrashNotes(ctx context.Context, notebooks []*entities.Notebook, at time.Time) ([]*entities.Note, error)
 }
 
+type TemplatesRepository interface {
+	SaveTemplate(ctx context.Context, template *entities.Template) (*entities.Template, error)
+	GetTemplate(ctx context.Context, id id.ID) (*entities.Template, error)
+	GetTemplatesByUser(ctx context.Context, user *entities.User) ([]*entities.Template, error)
+	UpdateTemplate(ctx context.Context, template *entities.Template) error
+	DeleteTemplate(ctx context.Context, template *entities.Template) error
+}
+
+type ReferencesRepository interface {
+	// SaveReferences replaces the references of the note by the given ones.
+	SaveReferences(ctx context.Context, note *entities.Note, references []*entities.Reference) error
+	// GetBacklinks returns the notes of the owner referring to the note by its id or title.
+	GetBacklinks(ctx context.Context, note *entities.Note) ([]*entities.Note, error)
+	GetReferences(ctx context.Context, note *entities.Note) ([]*entities.Reference, error)
+	GetDanglingReferences(ctx context.Context, user *entities.User) ([]*entities.Reference, error)
+}
+
+type SharesQuery struct {
+	// After is the last share of the previous page, the listing continues right after it.
+	After *entities.Share
+	Limit int32
+}
+
+type SharesRepository interface {
+	GetCollaborator(ctx context.Context, mail email.Email) (*entities.User, error)
+	SaveShare(ctx context.Context, share *entities.Share) (*entities.Share, error)
+	DeleteShare(ctx context.Context, share *entities.Share) error
+	GetShare(ctx context.Context, note *entities.Note, user *entities.User) (*entities.Share, error)
+	GetSharesByNote(ctx context.Context, note *entities.Note) ([]*entities.Share, error)
+	GetSharesByUser(ctx context.Context, user *entities.User, query *SharesQuery) ([]*entities.Share, error)
+	CountSharesByUser(ctx context.Context, user *entities.User) (int32, error)
+}
+
+type LinksRepository interface {
+	SaveLink(ctx context.Context, link *entities.Link) (*entities.Link, error)
+	DeleteLink(ctx context.Context, link *entities.Link) error
+	GetLink(ctx context.Context, token string) (*entities.Link, error)
+	GetLinksByNote(ctx context.Context, note *entities.Note) ([]*entities.Link, error)
+	CountView(ctx context.Context, link *entities.Link) error
+}
+
+type AttachmentsRepository interface {
+	SaveAttachment(ctx context.Context, attachment *entities.Attachment) (*entities.Attachment, error)
+	GetAttachment(ctx context.Context, id id.ID) (*entities.Attachment, error)
+	GetAttachmentsByNote(ctx context.Context, note *entities.Note) ([]*entities.Attachment, error)
+	// DeleteAttachment removes the metadata of the file and buries its blob.
+	DeleteAttachment(ctx context.Context, attachment *entities.Attachment) error
+	// DeleteAttachmentsByNote removes the metadata of all files of the note and buries their blobs, the buried blobs
+	// are deleted from the storage only after the transaction is committed.
+	DeleteAttachmentsByNote(ctx context.Context, note *entities.Note) error
+	// PurgeAttachments removes the metadata of all files of the notes trashed before the time and buries their blobs.
+	PurgeAttachments(ctx context.Context, before time.Time) error
+	// LockBuriedBlobs returns the keys of up to the limit of buried blobs, they stay locked until the transaction
+	// ends and other purgers skip them.
+	LockBuriedBlobs(ctx context.Context, limit int) ([]string, error)
+	// ForgetBuriedBlobs removes the keys of the blobs deleted from the storage.
+	ForgetBuriedBlobs(ctx context.Context, keys []string) error
+}
+
+type RemindersRepository interface {
+	SaveReminder(ctx context.Context, reminder *entities.Reminder) (*entities.Reminder, error)
+	GetReminder(ctx context.Context, id id.ID) (*entities.Reminder, error)
+	// GetRemindersByNote returns the reminders of the user about the note, the next to fire first.
+	GetRemindersByNote(ctx context.Context, note *entities.Note, user *entities.User) ([]*entities.Reminder, error)
+	UpdateReminder(ctx context.Context, reminder *entities.Reminder) error
+	DeleteReminder(ctx context.Context, reminder *entities.Reminder) error
+	// LockDueReminders returns up to the limit of reminders due to fire, they stay locked until the transaction ends
+	// and other schedulers skip them.
+	LockDueReminders(ctx context.Context, limit int) ([]*entities.Reminder, error)
+}
+
+// BlobStorage keeps the content of attachments, it is not a part of the transaction.
+type BlobStorage interface {
+	Put(ctx context.Context, key string, content io.Reader) error
+	Get(ctx context.Context, key string) (io.ReadCloser, error)
+	// Delete removes the blob, missing blobs are not an error.
+	Delete(ctx context.Context, key string) error
+}
+
+// EventsFilter keeps the events matching every given condition, an empty filter keeps all events.
+type EventsFilter struct {
+	Types   []entities.EventType
+	NoteIDs []int64
+	// Tags keeps events of notes having at least one of the tags.
+	Tags []string
+	// Unread keeps the events the recipient has not marked as read.
+	Unread bool
+}
+
+type EventsQuery struct {
+	// After is the last event of the previous page, the listing continues right after it.
+	After  *entities.Event
+	Filter EventsFilter
+	Limit  int32
+}
+
+// EventsRepository keeps the history of events and the outbox, the events are saved in the transaction
+// of the change and published to the streams by the relay afterwards.
 type EventsRepository interface {
 	SaveEvent(ctx context.Context, event *entities.Event) error
-	GetEvent(ctx context.Context, user *entities.User) (*entities.Event, error)
-	CountEvents(ctx context.Context, user *entities.User) (int32, error)
+	// SaveEvents saves all events in a single round trip.
+	SaveEvents(ctx context.Context, events []*entities.Event) error
+	// GetEventsByUser returns the history of events of the user, most recent first.
+	GetEventsByUser(ctx context.Context, user *entities.User, query *EventsQuery) ([]*entities.Event, error)
+	CountUnreadEvents(ctx context.Context, user *entities.User, filter *EventsFilter) (int32, error)
+	// MarkEventsRead marks the events with the ids and all events that occurred up to the time as read,
+	// it returns the number of events that were unread.
+	MarkEventsRead(ctx context.Context, user *entities.User, ids []uuid.UUID, upTo *time.Time) (int32, error)
+	// LockEvents returns up to the limit of events due for publishing, they stay locked until the transaction ends
+	// and other relays skip them.
+	LockEvents(ctx context.Context, limit int) ([]*entities.Event, error)
+	DeleteEvents(ctx context.Context, events []*entities.Event) error
+	// PostponeEvents records the failed attempt, the events are due again after the backoff doubled on every attempt.
+	PostponeEvents(ctx context.Context, events []*entities.Event, reason string, backoff, maxBackoff time.Duration) error
+	// Backlog returns the number of events in the outbox and when the oldest of them was saved.
+	Backlog(ctx context.Context) (int64, time.Time, error)
+}
+
+// EventStream delivers the published events to every device of their recipients.
+type EventStream interface {
+	// Publish appends the events to the streams of their recipients in a single round trip.
+	Publish(ctx context.Context, events []*entities.Event) error
+	// Subscribe prepares the stream of the device, it continues after the position when one is given,
+	// a new device gets only the events published after it subscribed.
+	Subscribe(ctx context.Context, sub *entities.Subscription, position string) error
+	// WaitEvents returns up to the limit of the next events of the device, blocking up to the timeout until one
+	// is published. The events are delivered again until they are acknowledged.
+	WaitEvents(
+		ctx context.Context,
+		sub *entities.Subscription,
+		limit int,
+		timeout time.Duration,
+	) ([]*entities.Event, error)
+	AckEvent(ctx context.Context, sub *entities.Subscription, event *entities.Event) error
+}
+
+// Drafts keeps the drafts of the notes being edited and the stream of their edits, shared by all instances.
+// A draft is forgotten once nobody edits it for a while, so it starts from the content of the note again.
+type Drafts interface {
+	// OpenDraft returns the draft of the note, a new draft starts from the content of the note.
+	OpenDraft(ctx context.Context, note *entities.Note) (*entities.Draft, error)
+	GetDraft(ctx context.Context, note *entities.Note) (*entities.Draft, error)
+	// GetEdits returns the edits applied to the draft after the revision, oldest first.
+	// Only the recent edits are kept, the older revisions fail as too old.
+	GetEdits(ctx context.Context, draft *entities.Draft, after int64) ([]*entities.Edit, error)
+	// SaveEdit saves the draft with the edit applied and sends the edit to the editors of the note.
+	// It fails as changed when another edit was applied to the draft first.
+	SaveEdit(ctx context.Context, draft *entities.Draft, edit *entities.Edit) error
+	// SendCursor sends the move of the cursor to the editors of the note, the draft stays as it is.
+	SendCursor(ctx context.Context, note *entities.Note, edit *entities.Edit) error
+	// WaitEdits returns the edits sent after the position, blocking up to the timeout until one is sent.
+	WaitEdits(ctx context.Context, note *entities.Note, position string, timeout time.Duration) ([]*entities.Edit, error)
+	// MarkSaved records that the draft is saved into the note up to its revision.
+	MarkSaved(ctx context.Context, draft *entities.Draft) error
+}
+
+// Renderer turns the Markdown content of notes into the other formats, the HTML is sanitized against XSS.
+type Renderer interface {
+	Render(content string, format entities.Format) (string, error)
+}
+
+// Renders caches the contents of notes rendered in the formats, shared by all instances. The renders are kept by
+// the versions of the notes, so a changed note is rendered again and the stale renders just expire.
+// The cache is optional, a cache that is not available misses every render and forgets the saved ones.
+type Renders interface {
+	// GetRenders returns the cached renders of the notes in the format, the notes rendered never before are missing.
+	GetRenders(ctx context.Context, notes []*entities.Note, format entities.Format) ([]*entities.Render, error)
+	SaveRenders(ctx context.Context, renders []*entities.Render) error
 }
 
 type Store struct {
-	Notes  NotesRepository
-	Events EventsRepository
+	Notes       NotesRepository
+	Revisions   RevisionsRepository
+	Tags        TagsRepository
+	Notebooks   NotebooksRepository
+	Templates   TemplatesRepository
+	References  ReferencesRepository
+	Shares      SharesRepository
+	Links       LinksRepository
+	Attachments AttachmentsRepository
+	Reminders   RemindersRepository
+	Blobs       BlobStorage
+	Events      EventsRepository
+	Stream      EventStream
+	Drafts      Drafts
+	Renderer    Renderer
+	Renders     Renders
 }
 
 type StoreProvider interface {
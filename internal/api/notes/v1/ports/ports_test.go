@@ -4,9 +4,12 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/therenotomorrow/gotes/internal/api/notes/v1/adapters/markdown"
 	"github.com/therenotomorrow/gotes/internal/api/notes/v1/adapters/mocks"
 	"github.com/therenotomorrow/gotes/internal/api/notes/v1/adapters/postgres"
+	"github.com/therenotomorrow/gotes/internal/api/notes/v1/adapters/redis"
 	"github.com/therenotomorrow/gotes/internal/api/notes/v1/ports"
+	"github.com/therenotomorrow/gotes/internal/storages/blobs"
 )
 
 func TestNotesRepository(t *testing.T) {
@@ -16,6 +19,112 @@ func TestNotesRepository(t *testing.T) {
 	assert.Implements(t, (*ports.NotesRepository)(nil), new(mocks.MockNotesRepository))
 }
 
+func TestRevisionsRepository(t *testing.T) {
+	t.Parallel()
+
+	assert.Implements(t, (*ports.RevisionsRepository)(nil), new(postgres.RevisionsRepository))
+	assert.Implements(t, (*ports.RevisionsRepository)(nil), new(mocks.MockRevisionsRepository))
+}
+
+func TestTagsRepository(t *testing.T) {
+	t.Parallel()
+
+	assert.Implements(t, (*ports.TagsRepository)(nil), new(postgres.TagsRepository))
+	assert.Implements(t, (*ports.TagsRepository)(nil), new(mocks.MockTagsRepository))
+}
+
+func TestNotebooksRepository(t *testing.T) {
+	t.Parallel()
+
+	assert.Implements(t, (*ports.NotebooksRepository)(nil), new(postgres.NotebooksRepository))
+	assert.Implements(t, (*ports.NotebooksRepository)(nil), new(mocks.MockNotebooksRepository))
+}
+
+func TestTemplatesRepository(t *testing.T) {
+	t.Parallel()
+
+	assert.Implements(t, (*ports.TemplatesRepository)(nil), new(postgres.TemplatesRepository))
+	assert.Implements(t, (*ports.TemplatesRepository)(nil), new(mocks.MockTemplatesRepository))
+}
+
+func TestReferencesRepository(t *testing.T) {
+	t.Parallel()
+
+	assert.Implements(t, (*ports.ReferencesRepository)(nil), new(postgres.ReferencesRepository))
+	assert.Implements(t, (*ports.ReferencesRepository)(nil), new(mocks.MockReferencesRepository))
+}
+
+func TestSharesRepository(t *testing.T) {
+	t.Parallel()
+
+	assert.Implements(t, (*ports.SharesRepository)(nil), new(postgres.SharesRepository))
+	assert.Implements(t, (*ports.SharesRepository)(nil), new(mocks.MockSharesRepository))
+}
+
+func TestLinksRepository(t *testing.T) {
+	t.Parallel()
+
+	assert.Implements(t, (*ports.LinksRepository)(nil), new(postgres.LinksRepository))
+	assert.Implements(t, (*ports.LinksRepository)(nil), new(mocks.MockLinksRepository))
+}
+
+func TestRemindersRepository(t *testing.T) {
+	t.Parallel()
+
+	assert.Implements(t, (*ports.RemindersRepository)(nil), new(postgres.RemindersRepository))
+	assert.Implements(t, (*ports.RemindersRepository)(nil), new(mocks.MockRemindersRepository))
+}
+
+func TestAttachmentsRepository(t *testing.T) {
+	t.Parallel()
+
+	assert.Implements(t, (*ports.AttachmentsRepository)(nil), new(postgres.AttachmentsRepository))
+	assert.Implements(t, (*ports.AttachmentsRepository)(nil), new(mocks.MockAttachmentsRepository))
+}
+
+func TestBlobStorage(t *testing.T) {
+	t.Parallel()
+
+	assert.Implements(t, (*ports.BlobStorage)(nil), new(blobs.Filesystem))
+	assert.Implements(t, (*ports.BlobStorage)(nil), new(blobs.S3))
+	assert.Implements(t, (*ports.BlobStorage)(nil), new(mocks.MockBlobStorage))
+}
+
+func TestEventsRepository(t *testing.T) {
+	t.Parallel()
+
+	assert.Implements(t, (*ports.EventsRepository)(nil), new(postgres.EventsRepository))
+	assert.Implements(t, (*ports.EventsRepository)(nil), new(mocks.MockEventsRepository))
+}
+
+func TestEventStream(t *testing.T) {
+	t.Parallel()
+
+	assert.Implements(t, (*ports.EventStream)(nil), new(redis.EventStream))
+	assert.Implements(t, (*ports.EventStream)(nil), new(mocks.MockEventStream))
+}
+
+func TestDrafts(t *testing.T) {
+	t.Parallel()
+
+	assert.Implements(t, (*ports.Drafts)(nil), new(redis.Drafts))
+	assert.Implements(t, (*ports.Drafts)(nil), new(mocks.MockDrafts))
+}
+
+func TestRenderer(t *testing.T) {
+	t.Parallel()
+
+	assert.Implements(t, (*ports.Renderer)(nil), new(markdown.Renderer))
+	assert.Implements(t, (*ports.Renderer)(nil), new(mocks.MockRenderer))
+}
+
+func TestRenders(t *testing.T) {
+	t.Parallel()
+
+	assert.Implements(t, (*ports.Renders)(nil), new(redis.Renders))
+	assert.Implements(t, (*ports.Renders)(nil), new(mocks.MockRenders))
+}
+
 func TestStoreProvider(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,95 @@
+package v1
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	adapters "github.com/therenotomorrow/gotes/internal/api/notes/v1/adapters/postgres"
+	"github.com/therenotomorrow/gotes/internal/api/notes/v1/ports"
+	"github.com/therenotomorrow/gotes/internal/api/notes/v1/usecases"
+	"github.com/therenotomorrow/gotes/internal/storages/postgres"
+	"github.com/therenotomorrow/gotes/pkg/services/trace"
+)
+
+// SweepBatchSize is the number of buried blobs the purger deletes at once.
+const SweepBatchSize = 100
+
+// Purger permanently removes notes that stayed in the trash longer than the retention and deletes the blobs
+// of the removed attachments.
+type Purger struct {
+	tracer    *trace.Tracer
+	cases     *usecases.UseCases
+	retention time.Duration
+	interval  time.Duration
+}
+
+func NewPurger(
+	db postgres.Database,
+	rdb redis.UniversalClient,
+	blobs ports.BlobStorage,
+	retention, interval time.Duration,
+	logger *slog.Logger,
+) *Purger {
+	provider := adapters.NewStoreProvider(db, rdb, blobs, logger)
+	uow := adapters.NewUnitOfWork(db, provider)
+
+	return &Purger{
+		tracer:    trace.Service("notes.v1.purger", logger),
+		cases:     usecases.NewCases(uow, provider.Provide(context.Background())),
+		retention: retention,
+		interval:  interval,
+	}
+}
+
+// Run purges the trash and sweeps the buried blobs every interval until the context is done.
+func (p *Purger) Run(ctx context.Context) {
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+
+		case <-ticker.C:
+			p.purge(ctx)
+			p.Sweep(ctx)
+		}
+	}
+}
+
+func (p *Purger) purge(ctx context.Context) {
+	purged, err := p.cases.PurgeTrash(ctx, p.retention)
+	if err != nil {
+		p.tracer.Error(ctx, "PurgeTrash", err)
+
+		return
+	}
+
+	if purged > 0 {
+		p.tracer.Info(ctx, "purge trash", "notes", purged)
+	}
+}
+
+// Sweep deletes batches of buried blobs while there are any, it stops at the first failure
+// and leaves the rest to the next run.
+func (p *Purger) Sweep(ctx context.Context) {
+	for {
+		swept, err := p.cases.SweepBlobs(ctx, SweepBatchSize)
+		if swept > 0 {
+			p.tracer.Info(ctx, "sweep blobs", "blobs", swept)
+		}
+
+		if err != nil {
+			p.tracer.Error(ctx, "SweepBlobs", err)
+
+			return
+		}
+
+		if swept < SweepBatchSize {
+			return
+		}
+	}
+}
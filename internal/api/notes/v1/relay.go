@@ -0,0 +1,117 @@
+package v1
+
+import (
+	"context"
+	"expvar"
+	"log/slog"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	adapters "github.com/therenotomorrow/gotes/internal/api/notes/v1/adapters/postgres"
+	"github.com/therenotomorrow/gotes/internal/api/notes/v1/ports"
+	"github.com/therenotomorrow/gotes/internal/api/notes/v1/usecases"
+	"github.com/therenotomorrow/gotes/internal/storages/postgres"
+	"github.com/therenotomorrow/gotes/pkg/services/trace"
+)
+
+const (
+	// RelayBatchSize is the number of events the relay publishes at once.
+	RelayBatchSize = 100
+	// lagWarning is how long the oldest event may wait in the outbox before the relay warns.
+	lagWarning = time.Minute
+)
+
+// relayMetrics are exposed with expvar: the published and failed events in total, the events waiting
+// in the outbox and how long the oldest of them waits.
+var relayMetrics = expvar.NewMap("notes.v1.relay")
+
+// Relay publishes the events saved in the outbox to the event streams, an event is published at least once.
+type Relay struct {
+	tracer   *trace.Tracer
+	cases    *usecases.UseCases
+	interval time.Duration
+}
+
+func NewRelay(
+	db postgres.Database,
+	rdb redis.UniversalClient,
+	blobs ports.BlobStorage,
+	interval time.Duration,
+	logger *slog.Logger,
+) *Relay {
+	provider := adapters.NewStoreProvider(db, rdb, blobs, logger)
+	uow := adapters.NewUnitOfWork(db, provider)
+
+	return NewRelayWithProvider(uow, provider, interval, logger)
+}
+
+func NewRelayWithProvider(
+	uow ports.UnitOfWork,
+	provider ports.StoreProvider,
+	interval time.Duration,
+	logger *slog.Logger,
+) *Relay {
+	return &Relay{
+		tracer:   trace.Service("notes.v1.relay", logger),
+		cases:    usecases.NewCases(uow, provider.Provide(context.Background())),
+		interval: interval,
+	}
+}
+
+// Run drains the outbox every interval until the context is done.
+func (r *Relay) Run(ctx context.Context) {
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+
+		case <-ticker.C:
+			r.Drain(ctx)
+			r.measure(ctx)
+		}
+	}
+}
+
+// Drain publishes batches of events while the outbox is full, it stops at the first failure
+// and leaves the rest to the next run.
+func (r *Relay) Drain(ctx context.Context) {
+	for {
+		published, err := r.cases.RelayEvents(ctx, RelayBatchSize)
+		if err != nil {
+			relayMetrics.Add("failures", 1)
+			r.tracer.Error(ctx, "RelayEvents", err)
+
+			return
+		}
+
+		relayMetrics.Add("published", int64(published))
+
+		if published < RelayBatchSize {
+			return
+		}
+	}
+}
+
+func (r *Relay) measure(ctx context.Context) {
+	events, lag, err := r.cases.EventsBacklog(ctx)
+	if err != nil {
+		r.tracer.Error(ctx, "EventsBacklog", err)
+
+		return
+	}
+
+	backlog := new(expvar.Int)
+	backlog.Set(events)
+	relayMetrics.Set("backlog", backlog)
+
+	seconds := new(expvar.Float)
+	seconds.Set(lag.Seconds())
+	relayMetrics.Set("lag_seconds", seconds)
+
+	if lag > lagWarning {
+		r.tracer.Warning(ctx, "relay lags behind", "events", events, "lag", lag)
+	}
+}
@@ -0,0 +1,40 @@
+package v1
+
+import (
+	"bytes"
+	"html/template"
+
+	"github.com/therenotomorrow/ex"
+	"github.com/therenotomorrow/gotes/internal/domain/entities"
+)
+
+const HTMLContentType = "text/html; charset=utf-8"
+
+var page = template.Must(template.New("note").Parse(`<!DOCTYPE html>
+<html lang="en">
+<head>
+  <meta charset="utf-8">
+  <meta name="robots" content="noindex">
+  <title>{{ .Title }}</title>
+</head>
+<body>
+  <article>
+    <h1>{{ .Title }}</h1>
+    <p><time datetime="{{ .UpdatedAt.Format "2006-01-02T15:04:05Z07:00" }}">{{ .UpdatedAt.Format "2 Jan 2006 15:04" }}</time></p>
+    <pre style="white-space: pre-wrap">{{ .Content }}</pre>
+  </article>
+</body>
+</html>
+`))
+
+// RenderNote renders the note as a standalone HTML page, the title and the content are escaped.
+func RenderNote(note *entities.Note) ([]byte, error) {
+	var buf bytes.Buffer
+
+	err := page.Execute(&buf, note)
+	if err != nil {
+		return nil, ex.Unexpected(err)
+	}
+
+	return buf.Bytes(), nil
+}
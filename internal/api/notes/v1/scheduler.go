@@ -0,0 +1,91 @@
+package v1
+
+import (
+	"context"
+	"expvar"
+	"log/slog"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	adapters "github.com/therenotomorrow/gotes/internal/api/notes/v1/adapters/postgres"
+	"github.com/therenotomorrow/gotes/internal/api/notes/v1/ports"
+	"github.com/therenotomorrow/gotes/internal/api/notes/v1/usecases"
+	"github.com/therenotomorrow/gotes/internal/storages/postgres"
+	"github.com/therenotomorrow/gotes/pkg/services/trace"
+)
+
+// ScheduleBatchSize is the number of reminders the scheduler fires at once.
+const ScheduleBatchSize = 100
+
+// scheduleMetrics are exposed with expvar: the fired reminders and the failures to fire them.
+var scheduleMetrics = expvar.NewMap("notes.v1.scheduler")
+
+// Scheduler fires the due reminders, the instances running side by side skip the reminders locked by each other,
+// so a reminder fires once.
+type Scheduler struct {
+	tracer   *trace.Tracer
+	cases    *usecases.UseCases
+	interval time.Duration
+}
+
+func NewScheduler(
+	db postgres.Database,
+	rdb redis.UniversalClient,
+	blobs ports.BlobStorage,
+	interval time.Duration,
+	logger *slog.Logger,
+) *Scheduler {
+	provider := adapters.NewStoreProvider(db, rdb, blobs, logger)
+	uow := adapters.NewUnitOfWork(db, provider)
+
+	return NewSchedulerWithProvider(uow, provider, interval, logger)
+}
+
+func NewSchedulerWithProvider(
+	uow ports.UnitOfWork,
+	provider ports.StoreProvider,
+	interval time.Duration,
+	logger *slog.Logger,
+) *Scheduler {
+	return &Scheduler{
+		tracer:   trace.Service("notes.v1.scheduler", logger),
+		cases:    usecases.NewCases(uow, provider.Provide(context.Background())),
+		interval: interval,
+	}
+}
+
+// Run fires the due reminders every interval until the context is done.
+func (s *Scheduler) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+
+		case <-ticker.C:
+			s.Drain(ctx)
+		}
+	}
+}
+
+// Drain fires batches of reminders while there are due ones, it stops at the first failure
+// and leaves the rest to the next run.
+func (s *Scheduler) Drain(ctx context.Context) {
+	for {
+		fired, err := s.cases.FireReminders(ctx, ScheduleBatchSize)
+		if err != nil {
+			scheduleMetrics.Add("failures", 1)
+			s.tracer.Error(ctx, "FireReminders", err)
+
+			return
+		}
+
+		scheduleMetrics.Add("fired", int64(fired))
+
+		if fired < ScheduleBatchSize {
+			return
+		}
+	}
+}
@@ -1,8 +1,11 @@
 package v1
 
 import (
+	"bufio"
+	"bytes"
 	"context"
 	"errors"
+	"io"
 	"log/slog"
 	"time"
 
@@ -10,6 +13,7 @@ import (
 	"github.com/therenotomorrow/ex"
 	"github.com/therenotomorrow/gotes/internal/api"
 	adapters "github.com/therenotomorrow/gotes/internal/api/notes/v1/adapters/postgres"
+	events "github.com/therenotomorrow/gotes/internal/api/notes/v1/adapters/redis"
 	"github.com/therenotomorrow/gotes/internal/api/notes/v1/ports"
 	"github.com/therenotomorrow/gotes/internal/api/notes/v1/usecases"
 	"github.com/therenotomorrow/gotes/internal/domain/entities"
@@ -17,26 +21,49 @@ import (
 	"github.com/therenotomorrow/gotes/internal/storages/postgres"
 	pb "github.com/therenotomorrow/gotes/pkg/api/notes/v1"
 	"github.com/therenotomorrow/gotes/pkg/services/trace"
+	"google.golang.org/genproto/googleapis/api/httpbody"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
 )
 
 const (
-	halfSecond = time.Second / 2
-	triesLimit = 10
+	// DefaultHeartbeatInterval is how long an event stream may stay silent unless changed by SetHeartbeatInterval.
+	DefaultHeartbeatInterval = 15 * time.Second
+	// DefaultCheckpointInterval is how often the edited notes are saved unless changed by SetCheckpointInterval.
+	DefaultCheckpointInterval = 5 * time.Second
 
-	ErrSend ex.Error = "send error"
+	// IfMatchKey is the metadata key the gateway forwards the If-Match header with.
+	IfMatchKey = "grpcgateway-if-match"
+	// AcceptKey is the metadata key the gateway forwards the Accept header with.
+	AcceptKey = "grpcgateway-accept"
+	// FormatKey is the header the format of the returned contents is told with, the gateway tags the note by it.
+	FormatKey = "content-format"
+
+	ErrSend          ex.Error = "send error"
+	ErrRecv          ex.Error = "recv error"
+	ErrMissingHeader ex.Error = "upload must start with the header"
+	ErrMissingJoin   ex.Error = "edit must start with the note to join"
+	ErrJoinedAlready ex.Error = "note is joined already"
 )
 
 type NotesService struct {
 	pb.UnimplementedNotesServiceServer
 
-	handle api.ErrorHandlerFunc
-	tracer *trace.Tracer
-	cases  *usecases.UseCases
+	handle     api.ErrorHandlerFunc
+	marshaler  api.ErrorMarshaler
+	tracer     *trace.Tracer
+	cases      *usecases.UseCases
+	heartbeat  time.Duration
+	checkpoint time.Duration
 }
 
-func NewService(db postgres.Database, rdb redis.UniversalClient, logger *slog.Logger) *NotesService {
-	provider := adapters.NewStoreProvider(db, rdb)
+func NewService(
+	db postgres.Database,
+	rdb redis.UniversalClient,
+	blobs ports.BlobStorage,
+	logger *slog.Logger,
+) *NotesService {
+	provider := adapters.NewStoreProvider(db, rdb, blobs, logger)
 	uow := adapters.NewUnitOfWork(db, provider)
 
 	return NewServiceWithProvider(uow, provider, logger)
@@ -44,15 +71,50 @@ func NewService(db postgres.Database, rdb redis.UniversalClient, logger *slog.Lo
 
 func NewServiceWithProvider(uow ports.UnitOfWork, provider ports.StoreProvider, logger *slog.Logger) *NotesService {
 	store := provider.Provide(context.Background())
+	marshaler := NewErrorMarshaler()
 
 	return &NotesService{
 		UnimplementedNotesServiceServer: pb.UnimplementedNotesServiceServer{},
-		handle:                          api.ErrorHandler(NewErrorMarshaler()),
+		handle:                          api.ErrorHandler(marshaler),
+		marshaler:                       marshaler,
 		tracer:                          trace.Service("notes.v1", logger),
 		cases:                           usecases.NewCases(uow, store),
+		heartbeat:                       DefaultHeartbeatInterval,
+		checkpoint:                      DefaultCheckpointInterval,
 	}
 }
 
+// SetBatchLimit changes the maximum number of notes in a single batch request.
+func (svc *NotesService) SetBatchLimit(limit int) {
+	svc.cases.SetBatchLimit(limit)
+}
+
+// SetAttachmentLimit changes the maximum size of an uploaded file in bytes.
+func (svc *NotesService) SetAttachmentLimit(limit int64) {
+	svc.cases.SetAttachmentLimit(limit)
+}
+
+// SetEventsRetention limits the number of events kept for every user, the max age takes precedence when set.
+// The devices of a user idle longer than the device idle or beyond the max devices are forgotten.
+func SetEventsRetention(maxLength int64, maxAge time.Duration, maxDevices int, deviceIdle time.Duration) {
+	events.SetRetention(events.Retention{
+		MaxLength:  maxLength,
+		MaxAge:     maxAge,
+		MaxDevices: maxDevices,
+		DeviceIdle: deviceIdle,
+	})
+}
+
+// SetHeartbeatInterval changes how often heartbeats are sent to event streams without events.
+func (svc *NotesService) SetHeartbeatInterval(interval time.Duration) {
+	svc.heartbeat = interval
+}
+
+// SetCheckpointInterval changes how often the drafts of the notes being edited are saved into the notes.
+func (svc *NotesService) SetCheckpointInterval(interval time.Duration) {
+	svc.checkpoint = interval
+}
+
 func (svc *NotesService) CreateNote(
 	ctx context.Context,
 	request *pb.CreateNoteRequest,
@@ -63,8 +125,11 @@ func (svc *NotesService) CreateNote(
 	}
 
 	note, err := svc.cases.CreateNote(ctx, user, &usecases.CreateNoteInput{
-		Title:   request.GetTitle(),
-		Content: request.GetContent(),
+		Title:      request.GetTitle(),
+		Content:    request.GetContent(),
+		NotebookID: request.GetNotebookId().GetValue(),
+		TemplateID: request.GetTemplateId().GetValue(),
+		Variables:  request.GetVariables(),
 	})
 	if err != nil {
 		svc.tracer.Error(ctx, "CreateNote", err, "user", user.ID)
@@ -75,6 +140,163 @@ func (svc *NotesService) CreateNote(
 	return &pb.CreateNoteResponse{Note: MarshalNote(note)}, nil
 }
 
+func (svc *NotesService) UpdateNote(
+	ctx context.Context,
+	request *pb.UpdateNoteRequest,
+) (*pb.UpdateNoteResponse, error) {
+	user, err := secure.User(ctx)
+	if err != nil {
+		return nil, svc.handle(err)
+	}
+
+	input := UnmarshalUpdateNote(request)
+	input.Version = precondition(ctx, input.Version)
+
+	note, err := svc.cases.UpdateNote(ctx, user, input)
+	if err != nil {
+		return nil, svc.handle(err)
+	}
+
+	return &pb.UpdateNoteResponse{Note: MarshalNote(note)}, nil
+}
+
+func (svc *NotesService) EditNote(stream grpc.BidiStreamingServer[pb.EditNoteRequest, pb.EditNoteResponse]) error {
+	ctx := stream.Context()
+
+	user, err := secure.User(ctx)
+	if err != nil {
+		return svc.handle(err)
+	}
+
+	req, err := stream.Recv()
+	if err != nil {
+		return svc.handle(ErrRecv.Because(err))
+	}
+
+	if req.GetNoteId() == nil {
+		return svc.handle(ErrMissingJoin)
+	}
+
+	output, err := svc.cases.JoinEdit(ctx, user, &usecases.JoinEditInput{NoteID: req.GetNoteId().GetValue()})
+	if err != nil {
+		return svc.handle(err)
+	}
+
+	editor := output.Editor
+
+	err = stream.Send(&pb.EditNoteResponse{Payload: MarshalDraft(output.Draft, editor)})
+	if err != nil {
+		return svc.handle(ErrSend.Because(err))
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	received := make(chan error, 1)
+
+	go func() {
+		// the editor leaving or failing to edit ends the edits sent to it as well
+		defer cancel()
+
+		received <- svc.receiveEdits(ctx, stream, editor)
+	}()
+
+	err = svc.sendEdits(ctx, stream, editor)
+	if errors.Is(err, context.Canceled) {
+		err = <-received
+	}
+
+	// the last changes are saved when the editor leaves
+	saved := svc.cases.SaveDraft(context.WithoutCancel(ctx), editor)
+	if saved != nil {
+		svc.tracer.Error(ctx, "EditNote", saved, "user", user.ID, "note", editor.Note.ID)
+	}
+
+	if err == nil {
+		err = saved
+	}
+
+	if err != nil {
+		return svc.handle(err)
+	}
+
+	return nil
+}
+
+// receiveEdits applies the operations and the moves of the cursor of the editor until it leaves.
+func (svc *NotesService) receiveEdits(
+	ctx context.Context,
+	stream grpc.BidiStreamingServer[pb.EditNoteRequest, pb.EditNoteResponse],
+	editor *entities.Editor,
+) error {
+	for {
+		req, err := stream.Recv()
+
+		switch {
+		case errors.Is(err, io.EOF):
+			return nil
+		case err != nil:
+			return ErrRecv.Because(err)
+		}
+
+		switch payload := req.GetPayload().(type) {
+		case *pb.EditNoteRequest_Operation:
+			_, err = svc.cases.ApplyEdit(ctx, editor, UnmarshalApplyEdit(payload.Operation))
+		case *pb.EditNoteRequest_Cursor:
+			err = svc.cases.MoveCursor(ctx, editor, UnmarshalMoveCursor(payload.Cursor))
+		case *pb.EditNoteRequest_NoteId:
+			err = ErrJoinedAlready
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// sendEdits sends the edits of all editors to the editor as they are made and saves the draft every checkpoint
+// interval, it stops once the context is done.
+func (svc *NotesService) sendEdits(
+	ctx context.Context,
+	stream grpc.BidiStreamingServer[pb.EditNoteRequest, pb.EditNoteResponse],
+	editor *entities.Editor,
+) error {
+	checkpoint := time.Now().Add(svc.checkpoint)
+
+	for {
+		edits, err := svc.cases.WaitEdits(ctx, editor, svc.checkpoint)
+
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		if err != nil && !errors.Is(err, usecases.ErrZeroEdits) {
+			return err
+		}
+
+		for _, edit := range edits {
+			response := MarshalEdit(edit, editor)
+			if response == nil {
+				continue
+			}
+
+			err = stream.Send(response)
+			if err != nil {
+				return ErrSend.Because(err)
+			}
+		}
+
+		if time.Now().After(checkpoint) {
+			err = svc.cases.SaveDraft(ctx, editor)
+			if err != nil {
+				return err
+			}
+
+			checkpoint = time.Now().Add(svc.checkpoint)
+		}
+	}
+}
+
 func (svc *NotesService) DeleteNote(
 	ctx context.Context,
 	request *pb.DeleteNoteRequest,
@@ -85,7 +307,8 @@ func (svc *NotesService) DeleteNote(
 	}
 
 	err = svc.cases.DeleteNote(ctx, user, &usecases.DeleteNoteInput{
-		ID: request.GetId().GetValue(),
+		ID:      request.GetId().GetValue(),
+		Version: precondition(ctx, request.GetVersion()),
 	})
 	if err != nil {
 		return nil, svc.handle(err)
@@ -94,6 +317,46 @@ func (svc *NotesService) DeleteNote(
 	return &pb.DeleteNoteResponse{}, nil
 }
 
+func (svc *NotesService) RestoreNote(
+	ctx context.Context,
+	request *pb.RestoreNoteRequest,
+) (*pb.RestoreNoteResponse, error) {
+	user, err := secure.User(ctx)
+	if err != nil {
+		return nil, svc.handle(err)
+	}
+
+	note, err := svc.cases.RestoreNote(ctx, user, &usecases.RestoreNoteInput{
+		ID:      request.GetId().GetValue(),
+		Version: precondition(ctx, request.GetVersion()),
+	})
+	if err != nil {
+		return nil, svc.handle(err)
+	}
+
+	return &pb.RestoreNoteResponse{Note: MarshalNote(note)}, nil
+}
+
+func (svc *NotesService) PurgeNote(
+	ctx context.Context,
+	request *pb.PurgeNoteRequest,
+) (*pb.PurgeNoteResponse, error) {
+	user, err := secure.User(ctx)
+	if err != nil {
+		return nil, svc.handle(err)
+	}
+
+	err = svc.cases.PurgeNote(ctx, user, &usecases.PurgeNoteInput{
+		ID:      request.GetId().GetValue(),
+		Version: precondition(ctx, request.GetVersion()),
+	})
+	if err != nil {
+		return nil, svc.handle(err)
+	}
+
+	return &pb.PurgeNoteResponse{}, nil
+}
+
 func (svc *NotesService) RetrieveNote(
 	ctx context.Context,
 	request *pb.RetrieveNoteRequest,
@@ -103,81 +366,1221 @@ func (svc *NotesService) RetrieveNote(
 		return nil, svc.handle(err)
 	}
 
+	format := negotiate(ctx, UnmarshalFormat(request.GetFormat()))
+
 	note, err := svc.cases.RetrieveNote(ctx, user, &usecases.RetrieveNoteInput{
-		ID: request.GetId().GetValue(),
+		Format: format,
+		ID:     request.GetId().GetValue(),
 	})
 	if err != nil {
 		return nil, svc.handle(err)
 	}
 
+	// there is no header to set outside of a call, e.g. in tests
+	ex.Skip(grpc.SetHeader(ctx, metadata.Pairs(FormatKey, string(format))))
+
 	return &pb.RetrieveNoteResponse{Note: MarshalNote(note)}, nil
 }
 
-func (svc *NotesService) ListNotes(ctx context.Context, _ *pb.ListNotesRequest) (*pb.ListNotesResponse, error) {
+func (svc *NotesService) ListNotes(ctx context.Context, request *pb.ListNotesRequest) (*pb.ListNotesResponse, error) {
 	user, err := secure.User(ctx)
 	if err != nil {
 		return nil, svc.handle(err)
 	}
 
-	notes, err := svc.cases.ListNotes(ctx, user)
+	input := UnmarshalListNotes(request)
+	input.Format = negotiate(ctx, input.Format)
+
+	page, err := svc.cases.ListNotes(ctx, user, input)
 	if err != nil {
 		return nil, svc.handle(err)
 	}
 
-	return &pb.ListNotesResponse{Notes: MarshalNotes(notes)}, nil
+	return &pb.ListNotesResponse{
+		Notes:         MarshalNotes(page.Notes),
+		NextPageToken: page.NextPageToken,
+		TotalSize:     page.TotalSize,
+	}, nil
 }
 
-func (svc *NotesService) SubscribeToEvents(
-	_ *pb.SubscribeToEventsRequest,
-	stream grpc.ServerStreamingServer[pb.SubscribeToEventsResponse],
-) error {
-	ctx := stream.Context()
+func (svc *NotesService) ListTrashedNotes(
+	ctx context.Context,
+	request *pb.ListTrashedNotesRequest,
+) (*pb.ListTrashedNotesResponse, error) {
+	user, err := secure.User(ctx)
+	if err != nil {
+		return nil, svc.handle(err)
+	}
+
+	page, err := svc.cases.ListTrashedNotes(ctx, user, &usecases.ListTrashedNotesInput{
+		PageToken: request.GetPageToken(),
+		PageSize:  request.GetPageSize(),
+	})
+	if err != nil {
+		return nil, svc.handle(err)
+	}
+
+	return &pb.ListTrashedNotesResponse{
+		Notes:         MarshalNotes(page.Notes),
+		NextPageToken: page.NextPageToken,
+		TotalSize:     page.TotalSize,
+	}, nil
+}
 
+func (svc *NotesService) SearchNotes(
+	ctx context.Context,
+	request *pb.SearchNotesRequest,
+) (*pb.SearchNotesResponse, error) {
 	user, err := secure.User(ctx)
 	if err != nil {
-		return svc.handle(err)
+		return nil, svc.handle(err)
 	}
 
-	unread, err := svc.cases.UnreadEvents(ctx, user)
+	page, err := svc.cases.SearchNotes(ctx, user, &usecases.SearchNotesInput{
+		Text:      request.GetQuery(),
+		PageToken: request.GetPageToken(),
+		PageSize:  request.GetPageSize(),
+	})
 	if err != nil {
-		return svc.handle(err)
+		return nil, svc.handle(err)
 	}
 
-	err = stream.Send(&pb.SubscribeToEventsResponse{Payload: MarshalUnread(unread)})
+	return &pb.SearchNotesResponse{
+		Hits:          MarshalSearchHits(page.Hits),
+		NextPageToken: page.NextPageToken,
+		TotalSize:     page.TotalSize,
+	}, nil
+}
+
+func (svc *NotesService) AddNoteTags(
+	ctx context.Context,
+	request *pb.AddNoteTagsRequest,
+) (*pb.AddNoteTagsResponse, error) {
+	user, err := secure.User(ctx)
 	if err != nil {
-		return svc.handle(ErrSend.Because(err))
+		return nil, svc.handle(err)
+	}
+
+	note, err := svc.cases.AddNoteTags(ctx, user, &usecases.NoteTagsInput{
+		Tags:    request.GetTags(),
+		NoteID:  request.GetNoteId().GetValue(),
+		Version: precondition(ctx, request.GetVersion()),
+	})
+	if err != nil {
+		return nil, svc.handle(err)
 	}
 
-	ticker := time.NewTicker(halfSecond)
-	defer ticker.Stop()
+	return &pb.AddNoteTagsResponse{Note: MarshalNote(note)}, nil
+}
+
+func (svc *NotesService) RemoveNoteTags(
+	ctx context.Context,
+	request *pb.RemoveNoteTagsRequest,
+) (*pb.RemoveNoteTagsResponse, error) {
+	user, err := secure.User(ctx)
+	if err != nil {
+		return nil, svc.handle(err)
+	}
 
-	for tries := 0; tries < triesLimit; {
-		var event *entities.Event
+	note, err := svc.cases.RemoveNoteTags(ctx, user, &usecases.NoteTagsInput{
+		Tags:    request.GetTags(),
+		NoteID:  request.GetNoteId().GetValue(),
+		Version: precondition(ctx, request.GetVersion()),
+	})
+	if err != nil {
+		return nil, svc.handle(err)
+	}
 
-		select {
-		case <-ctx.Done():
-			return svc.handle(ctx.Err())
+	return &pb.RemoveNoteTagsResponse{Note: MarshalNote(note)}, nil
+}
 
-		case <-ticker.C:
-			event, err = svc.cases.GetNextEvent(ctx, user)
+func (svc *NotesService) ListTags(ctx context.Context, _ *pb.ListTagsRequest) (*pb.ListTagsResponse, error) {
+	user, err := secure.User(ctx)
+	if err != nil {
+		return nil, svc.handle(err)
+	}
 
-			switch {
-			case errors.Is(err, usecases.ErrZeroEvents):
-				tries++
+	counts, err := svc.cases.ListTags(ctx, user)
+	if err != nil {
+		return nil, svc.handle(err)
+	}
 
-				continue
-			case err != nil:
-				return svc.handle(err)
-			}
+	return &pb.ListTagsResponse{Tags: MarshalTagCounts(counts)}, nil
+}
 
-			err = stream.Send(&pb.SubscribeToEventsResponse{Payload: MarshalEvent(event)})
-			if err != nil {
-				return svc.handle(ErrSend.Because(err))
-			}
+func (svc *NotesService) RenameTag(ctx context.Context, request *pb.RenameTagRequest) (*pb.RenameTagResponse, error) {
+	user, err := secure.User(ctx)
+	if err != nil {
+		return nil, svc.handle(err)
+	}
 
-			tries = 0
+	tag, err := svc.cases.RenameTag(ctx, user, &usecases.RenameTagInput{
+		Name:    request.GetName(),
+		NewName: request.GetNewName(),
+	})
+	if err != nil {
+		return nil, svc.handle(err)
+	}
+
+	return &pb.RenameTagResponse{Tag: MarshalTag(tag)}, nil
+}
+
+func (svc *NotesService) BatchCreateNotes(
+	ctx context.Context,
+	request *pb.BatchCreateNotesRequest,
+) (*pb.BatchCreateNotesResponse, error) {
+	user, err := secure.User(ctx)
+	if err != nil {
+		return nil, svc.handle(err)
+	}
+
+	results, err := svc.cases.BatchCreateNotes(ctx, user, UnmarshalBatchCreateNotes(request))
+	if err != nil {
+		svc.tracer.Error(ctx, "BatchCreateNotes", err, "user", user.ID)
+
+		return nil, svc.handle(err)
+	}
+
+	return &pb.BatchCreateNotesResponse{Results: MarshalBatchResults(svc.marshaler, results, nil)}, nil
+}
+
+func (svc *NotesService) BatchDeleteNotes(
+	ctx context.Context,
+	request *pb.BatchDeleteNotesRequest,
+) (*pb.BatchDeleteNotesResponse, error) {
+	user, err := secure.User(ctx)
+	if err != nil {
+		return nil, svc.handle(err)
+	}
+
+	ids := UnmarshalIDs(request.GetIds())
+
+	results, err := svc.cases.BatchDeleteNotes(ctx, user, UnmarshalBatchDeleteNotes(ids, request.GetVersions()))
+	if err != nil {
+		svc.tracer.Error(ctx, "BatchDeleteNotes", err, "user", user.ID)
+
+		return nil, svc.handle(err)
+	}
+
+	return &pb.BatchDeleteNotesResponse{Results: MarshalBatchResults(svc.marshaler, results, ids)}, nil
+}
+
+func (svc *NotesService) BatchGetNotes(
+	ctx context.Context,
+	request *pb.BatchGetNotesRequest,
+) (*pb.BatchGetNotesResponse, error) {
+	user, err := secure.User(ctx)
+	if err != nil {
+		return nil, svc.handle(err)
+	}
+
+	ids := UnmarshalIDs(request.GetIds())
+
+	results, err := svc.cases.BatchGetNotes(ctx, user, ids)
+	if err != nil {
+		return nil, svc.handle(err)
+	}
+
+	return &pb.BatchGetNotesResponse{Results: MarshalBatchResults(svc.marshaler, results, ids)}, nil
+}
+
+func (svc *NotesService) ExportNotes(
+	request *pb.ExportNotesRequest,
+	stream grpc.ServerStreamingServer[pb.ExportNotesResponse],
+) error {
+	ctx := stream.Context()
+
+	user, err := secure.User(ctx)
+	if err != nil {
+		return svc.handle(err)
+	}
+
+	chunks := bufio.NewWriterSize(&chunkWriter{send: func(chunk []byte) error {
+		err := stream.Send(&pb.ExportNotesResponse{Chunk: chunk})
+		if err != nil {
+			return ErrSend.Because(err)
 		}
+
+		return nil
+	}}, ChunkSize)
+
+	archive, err := NewArchiveWriter(chunks, request.GetFormat())
+	if err != nil {
+		return svc.handle(err)
 	}
 
-	return nil
+	err = svc.cases.ExportNotes(ctx, user, archive.Write)
+	if err == nil {
+		err = archive.Close()
+	}
+
+	if err == nil {
+		err = chunks.Flush()
+	}
+
+	if err != nil {
+		svc.tracer.Error(ctx, "ExportNotes", err, "user", user.ID)
+
+		return svc.handle(err)
+	}
+
+	return nil
+}
+
+func (svc *NotesService) ImportNotes(
+	stream grpc.ClientStreamingServer[pb.ImportNotesRequest, pb.ImportNotesResponse],
+) error {
+	ctx := stream.Context()
+
+	user, err := secure.User(ctx)
+	if err != nil {
+		return svc.handle(err)
+	}
+
+	var (
+		data   bytes.Buffer
+		format pb.ArchiveFormat
+	)
+
+	for {
+		req, err := stream.Recv()
+
+		switch {
+		case errors.Is(err, io.EOF):
+			return svc.importNotesSendAndClose(stream, user, format, data.Bytes())
+		case err != nil:
+			return svc.handle(ErrRecv.Because(err))
+		}
+
+		if format == pb.ArchiveFormat_ARCHIVE_FORMAT_UNKNOWN {
+			format = req.GetFormat()
+		}
+
+		if data.Len()+len(req.GetChunk()) > ImportLimit {
+			return svc.handle(ErrArchiveTooLarge)
+		}
+
+		data.Write(req.GetChunk())
+	}
+}
+
+func (svc *NotesService) importNotesSendAndClose(
+	stream grpc.ClientStreamingServer[pb.ImportNotesRequest, pb.ImportNotesResponse],
+	user *entities.User,
+	format pb.ArchiveFormat,
+	data []byte,
+) error {
+	ctx := stream.Context()
+
+	notes, err := ReadArchive(data, format)
+	if err != nil {
+		return svc.handle(err)
+	}
+
+	results, err := svc.cases.ImportNotes(ctx, user, UnmarshalImportNotes(notes))
+	if err != nil {
+		svc.tracer.Error(ctx, "ImportNotes", err, "user", user.ID)
+
+		return svc.handle(err)
+	}
+
+	err = stream.SendAndClose(MarshalImportResults(svc.marshaler, results, notes))
+	if err != nil {
+		return svc.handle(ErrSend.Because(err))
+	}
+
+	return nil
+}
+
+func (svc *NotesService) UploadAttachment(
+	stream grpc.ClientStreamingServer[pb.UploadAttachmentRequest, pb.UploadAttachmentResponse],
+) error {
+	ctx := stream.Context()
+
+	user, err := secure.User(ctx)
+	if err != nil {
+		return svc.handle(err)
+	}
+
+	req, err := stream.Recv()
+	if err != nil {
+		return svc.handle(ErrRecv.Because(err))
+	}
+
+	header := req.GetHeader()
+	if header == nil {
+		return svc.handle(ErrMissingHeader)
+	}
+
+	content := &chunkReader{chunk: nil, recv: func() ([]byte, error) {
+		req, err := stream.Recv()
+
+		switch {
+		case errors.Is(err, io.EOF):
+			return nil, io.EOF
+		case err != nil:
+			return nil, ErrRecv.Because(err)
+		}
+
+		return req.GetChunk(), nil
+	}}
+
+	attachment, err := svc.cases.UploadAttachment(ctx, user, UnmarshalUploadAttachment(header, content))
+	if err != nil {
+		svc.tracer.Error(ctx, "UploadAttachment", err, "user", user.ID)
+
+		return svc.handle(err)
+	}
+
+	err = stream.SendAndClose(&pb.UploadAttachmentResponse{Attachment: MarshalAttachment(attachment)})
+	if err != nil {
+		return svc.handle(ErrSend.Because(err))
+	}
+
+	return nil
+}
+
+func (svc *NotesService) DownloadAttachment(
+	request *pb.DownloadAttachmentRequest,
+	stream grpc.ServerStreamingServer[pb.DownloadAttachmentResponse],
+) error {
+	ctx := stream.Context()
+
+	user, err := secure.User(ctx)
+	if err != nil {
+		return svc.handle(err)
+	}
+
+	attachment, content, err := svc.cases.DownloadAttachment(ctx, user, &usecases.AttachmentInput{
+		ID: request.GetId().GetValue(),
+	})
+	if err != nil {
+		return svc.handle(err)
+	}
+
+	defer func() { _ = content.Close() }()
+
+	err = stream.Send(&pb.DownloadAttachmentResponse{
+		Payload: &pb.DownloadAttachmentResponse_Attachment{Attachment: MarshalAttachment(attachment)},
+	})
+	if err != nil {
+		return svc.handle(ErrSend.Because(err))
+	}
+
+	chunks := &chunkWriter{send: func(chunk []byte) error {
+		err := stream.Send(&pb.DownloadAttachmentResponse{
+			Payload: &pb.DownloadAttachmentResponse_Chunk{Chunk: chunk},
+		})
+		if err != nil {
+			return ErrSend.Because(err)
+		}
+
+		return nil
+	}}
+
+	_, err = io.CopyBuffer(chunks, content, make([]byte, ChunkSize))
+	if err != nil {
+		svc.tracer.Error(ctx, "DownloadAttachment", err, "user", user.ID)
+
+		return svc.handle(err)
+	}
+
+	return nil
+}
+
+func (svc *NotesService) ListAttachments(
+	ctx context.Context,
+	request *pb.ListAttachmentsRequest,
+) (*pb.ListAttachmentsResponse, error) {
+	user, err := secure.User(ctx)
+	if err != nil {
+		return nil, svc.handle(err)
+	}
+
+	attachments, err := svc.cases.ListAttachments(ctx, user, &usecases.ListAttachmentsInput{
+		NoteID: request.GetNoteId().GetValue(),
+	})
+	if err != nil {
+		return nil, svc.handle(err)
+	}
+
+	return &pb.ListAttachmentsResponse{Attachments: MarshalAttachments(attachments)}, nil
+}
+
+func (svc *NotesService) DeleteAttachment(
+	ctx context.Context,
+	request *pb.DeleteAttachmentRequest,
+) (*pb.DeleteAttachmentResponse, error) {
+	user, err := secure.User(ctx)
+	if err != nil {
+		return nil, svc.handle(err)
+	}
+
+	err = svc.cases.DeleteAttachment(ctx, user, &usecases.AttachmentInput{ID: request.GetId().GetValue()})
+	if err != nil {
+		return nil, svc.handle(err)
+	}
+
+	return &pb.DeleteAttachmentResponse{}, nil
+}
+
+func (svc *NotesService) MoveNote(ctx context.Context, request *pb.MoveNoteRequest) (*pb.MoveNoteResponse, error) {
+	user, err := secure.User(ctx)
+	if err != nil {
+		return nil, svc.handle(err)
+	}
+
+	note, err := svc.cases.MoveNote(ctx, user, &usecases.MoveNoteInput{
+		ID:         request.GetId().GetValue(),
+		NotebookID: request.GetNotebookId().GetValue(),
+		Version:    precondition(ctx, request.GetVersion()),
+	})
+	if err != nil {
+		return nil, svc.handle(err)
+	}
+
+	return &pb.MoveNoteResponse{Note: MarshalNote(note)}, nil
+}
+
+func (svc *NotesService) CreateNotebook(
+	ctx context.Context,
+	request *pb.CreateNotebookRequest,
+) (*pb.CreateNotebookResponse, error) {
+	user, err := secure.User(ctx)
+	if err != nil {
+		return nil, svc.handle(err)
+	}
+
+	notebook, err := svc.cases.CreateNotebook(ctx, user, &usecases.CreateNotebookInput{
+		Name:     request.GetName(),
+		ParentID: request.GetParentId().GetValue(),
+	})
+	if err != nil {
+		svc.tracer.Error(ctx, "CreateNotebook", err, "user", user.ID)
+
+		return nil, svc.handle(err)
+	}
+
+	return &pb.CreateNotebookResponse{Notebook: MarshalNotebook(notebook)}, nil
+}
+
+func (svc *NotesService) GetNotebook(
+	ctx context.Context,
+	request *pb.GetNotebookRequest,
+) (*pb.GetNotebookResponse, error) {
+	user, err := secure.User(ctx)
+	if err != nil {
+		return nil, svc.handle(err)
+	}
+
+	notebook, err := svc.cases.GetNotebook(ctx, user, &usecases.GetNotebookInput{
+		ID: request.GetId().GetValue(),
+	})
+	if err != nil {
+		return nil, svc.handle(err)
+	}
+
+	return &pb.GetNotebookResponse{Notebook: MarshalNotebook(notebook)}, nil
+}
+
+func (svc *NotesService) ListNotebooks(
+	ctx context.Context,
+	_ *pb.ListNotebooksRequest,
+) (*pb.ListNotebooksResponse, error) {
+	user, err := secure.User(ctx)
+	if err != nil {
+		return nil, svc.handle(err)
+	}
+
+	notebooks, err := svc.cases.ListNotebooks(ctx, user)
+	if err != nil {
+		return nil, svc.handle(err)
+	}
+
+	return &pb.ListNotebooksResponse{Notebooks: MarshalNotebooks(notebooks)}, nil
+}
+
+func (svc *NotesService) UpdateNotebook(
+	ctx context.Context,
+	request *pb.UpdateNotebookRequest,
+) (*pb.UpdateNotebookResponse, error) {
+	user, err := secure.User(ctx)
+	if err != nil {
+		return nil, svc.handle(err)
+	}
+
+	notebook, err := svc.cases.UpdateNotebook(ctx, user, UnmarshalUpdateNotebook(request))
+	if err != nil {
+		return nil, svc.handle(err)
+	}
+
+	return &pb.UpdateNotebookResponse{Notebook: MarshalNotebook(notebook)}, nil
+}
+
+func (svc *NotesService) DeleteNotebook(
+	ctx context.Context,
+	request *pb.DeleteNotebookRequest,
+) (*pb.DeleteNotebookResponse, error) {
+	user, err := secure.User(ctx)
+	if err != nil {
+		return nil, svc.handle(err)
+	}
+
+	err = svc.cases.DeleteNotebook(ctx, user, &usecases.DeleteNotebookInput{
+		ID:   request.GetId().GetValue(),
+		Mode: UnmarshalDeleteMode(request.GetMode()),
+	})
+	if err != nil {
+		return nil, svc.handle(err)
+	}
+
+	return &pb.DeleteNotebookResponse{}, nil
+}
+
+func (svc *NotesService) CreateTemplate(
+	ctx context.Context,
+	request *pb.CreateTemplateRequest,
+) (*pb.CreateTemplateResponse, error) {
+	user, err := secure.User(ctx)
+	if err != nil {
+		return nil, svc.handle(err)
+	}
+
+	template, err := svc.cases.CreateTemplate(ctx, user, &usecases.CreateTemplateInput{
+		Name:    request.GetName(),
+		Title:   request.GetTitle(),
+		Content: request.GetContent(),
+	})
+	if err != nil {
+		svc.tracer.Error(ctx, "CreateTemplate", err, "user", user.ID)
+
+		return nil, svc.handle(err)
+	}
+
+	return &pb.CreateTemplateResponse{Template: MarshalTemplate(template)}, nil
+}
+
+func (svc *NotesService) GetTemplate(
+	ctx context.Context,
+	request *pb.GetTemplateRequest,
+) (*pb.GetTemplateResponse, error) {
+	user, err := secure.User(ctx)
+	if err != nil {
+		return nil, svc.handle(err)
+	}
+
+	template, err := svc.cases.GetTemplate(ctx, user, &usecases.TemplateInput{
+		ID: request.GetId().GetValue(),
+	})
+	if err != nil {
+		return nil, svc.handle(err)
+	}
+
+	return &pb.GetTemplateResponse{Template: MarshalTemplate(template)}, nil
+}
+
+func (svc *NotesService) ListTemplates(
+	ctx context.Context,
+	_ *pb.ListTemplatesRequest,
+) (*pb.ListTemplatesResponse, error) {
+	user, err := secure.User(ctx)
+	if err != nil {
+		return nil, svc.handle(err)
+	}
+
+	templates, err := svc.cases.ListTemplates(ctx, user)
+	if err != nil {
+		return nil, svc.handle(err)
+	}
+
+	return &pb.ListTemplatesResponse{Templates: MarshalTemplates(templates)}, nil
+}
+
+func (svc *NotesService) UpdateTemplate(
+	ctx context.Context,
+	request *pb.UpdateTemplateRequest,
+) (*pb.UpdateTemplateResponse, error) {
+	user, err := secure.User(ctx)
+	if err != nil {
+		return nil, svc.handle(err)
+	}
+
+	template, err := svc.cases.UpdateTemplate(ctx, user, UnmarshalUpdateTemplate(request))
+	if err != nil {
+		return nil, svc.handle(err)
+	}
+
+	return &pb.UpdateTemplateResponse{Template: MarshalTemplate(template)}, nil
+}
+
+func (svc *NotesService) DeleteTemplate(
+	ctx context.Context,
+	request *pb.DeleteTemplateRequest,
+) (*pb.DeleteTemplateResponse, error) {
+	user, err := secure.User(ctx)
+	if err != nil {
+		return nil, svc.handle(err)
+	}
+
+	err = svc.cases.DeleteTemplate(ctx, user, &usecases.TemplateInput{
+		ID: request.GetId().GetValue(),
+	})
+	if err != nil {
+		return nil, svc.handle(err)
+	}
+
+	return &pb.DeleteTemplateResponse{}, nil
+}
+
+func (svc *NotesService) ListBacklinks(
+	ctx context.Context,
+	request *pb.ListBacklinksRequest,
+) (*pb.ListBacklinksResponse, error) {
+	user, err := secure.User(ctx)
+	if err != nil {
+		return nil, svc.handle(err)
+	}
+
+	notes, err := svc.cases.ListBacklinks(ctx, user, &usecases.ListLinksInput{
+		NoteID: request.GetNoteId().GetValue(),
+	})
+	if err != nil {
+		return nil, svc.handle(err)
+	}
+
+	return &pb.ListBacklinksResponse{Notes: MarshalNotes(notes)}, nil
+}
+
+func (svc *NotesService) ListOutgoingLinks(
+	ctx context.Context,
+	request *pb.ListOutgoingLinksRequest,
+) (*pb.ListOutgoingLinksResponse, error) {
+	user, err := secure.User(ctx)
+	if err != nil {
+		return nil, svc.handle(err)
+	}
+
+	references, err := svc.cases.ListOutgoingLinks(ctx, user, &usecases.ListLinksInput{
+		NoteID: request.GetNoteId().GetValue(),
+	})
+	if err != nil {
+		return nil, svc.handle(err)
+	}
+
+	return &pb.ListOutgoingLinksResponse{Links: MarshalReferences(references)}, nil
+}
+
+func (svc *NotesService) ListDanglingLinks(
+	ctx context.Context,
+	_ *pb.ListDanglingLinksRequest,
+) (*pb.ListDanglingLinksResponse, error) {
+	user, err := secure.User(ctx)
+	if err != nil {
+		return nil, svc.handle(err)
+	}
+
+	references, err := svc.cases.ListDanglingLinks(ctx, user)
+	if err != nil {
+		return nil, svc.handle(err)
+	}
+
+	return &pb.ListDanglingLinksResponse{Links: MarshalReferences(references)}, nil
+}
+
+func (svc *NotesService) ShareNote(ctx context.Context, request *pb.ShareNoteRequest) (*pb.ShareNoteResponse, error) {
+	user, err := secure.User(ctx)
+	if err != nil {
+		return nil, svc.handle(err)
+	}
+
+	share, err := svc.cases.ShareNote(ctx, user, &usecases.ShareNoteInput{
+		Email:  request.GetEmail(),
+		Role:   UnmarshalRole(request.GetRole()),
+		NoteID: request.GetNoteId().GetValue(),
+	})
+	if err != nil {
+		return nil, svc.handle(err)
+	}
+
+	return &pb.ShareNoteResponse{Share: MarshalShare(share)}, nil
+}
+
+func (svc *NotesService) UnshareNote(
+	ctx context.Context,
+	request *pb.UnshareNoteRequest,
+) (*pb.UnshareNoteResponse, error) {
+	user, err := secure.User(ctx)
+	if err != nil {
+		return nil, svc.handle(err)
+	}
+
+	err = svc.cases.UnshareNote(ctx, user, &usecases.UnshareNoteInput{
+		Email:  request.GetEmail(),
+		NoteID: request.GetNoteId().GetValue(),
+	})
+	if err != nil {
+		return nil, svc.handle(err)
+	}
+
+	return &pb.UnshareNoteResponse{}, nil
+}
+
+func (svc *NotesService) ListNoteShares(
+	ctx context.Context,
+	request *pb.ListNoteSharesRequest,
+) (*pb.ListNoteSharesResponse, error) {
+	user, err := secure.User(ctx)
+	if err != nil {
+		return nil, svc.handle(err)
+	}
+
+	shares, err := svc.cases.ListNoteShares(ctx, user, &usecases.ListNoteSharesInput{
+		NoteID: request.GetNoteId().GetValue(),
+	})
+	if err != nil {
+		return nil, svc.handle(err)
+	}
+
+	return &pb.ListNoteSharesResponse{Shares: MarshalShares(shares)}, nil
+}
+
+func (svc *NotesService) ListSharedNotes(
+	ctx context.Context,
+	request *pb.ListSharedNotesRequest,
+) (*pb.ListSharedNotesResponse, error) {
+	user, err := secure.User(ctx)
+	if err != nil {
+		return nil, svc.handle(err)
+	}
+
+	page, err := svc.cases.ListSharedNotes(ctx, user, &usecases.ListSharedNotesInput{
+		PageToken: request.GetPageToken(),
+		PageSize:  request.GetPageSize(),
+	})
+	if err != nil {
+		return nil, svc.handle(err)
+	}
+
+	return &pb.ListSharedNotesResponse{
+		Notes:         MarshalSharedNotes(page.Shares),
+		NextPageToken: page.NextPageToken,
+		TotalSize:     page.TotalSize,
+	}, nil
+}
+
+func (svc *NotesService) CreateShareLink(
+	ctx context.Context,
+	request *pb.CreateShareLinkRequest,
+) (*pb.CreateShareLinkResponse, error) {
+	user, err := secure.User(ctx)
+	if err != nil {
+		return nil, svc.handle(err)
+	}
+
+	link, err := svc.cases.CreateShareLink(ctx, user, &usecases.CreateShareLinkInput{
+		TTL:    request.GetTtl().AsDuration(),
+		NoteID: request.GetNoteId().GetValue(),
+	})
+	if err != nil {
+		return nil, svc.handle(err)
+	}
+
+	return &pb.CreateShareLinkResponse{Link: MarshalLink(link)}, nil
+}
+
+func (svc *NotesService) RevokeShareLink(
+	ctx context.Context,
+	request *pb.RevokeShareLinkRequest,
+) (*pb.RevokeShareLinkResponse, error) {
+	user, err := secure.User(ctx)
+	if err != nil {
+		return nil, svc.handle(err)
+	}
+
+	err = svc.cases.RevokeShareLink(ctx, user, &usecases.RevokeShareLinkInput{
+		Token:  request.GetToken(),
+		NoteID: request.GetNoteId().GetValue(),
+	})
+	if err != nil {
+		return nil, svc.handle(err)
+	}
+
+	return &pb.RevokeShareLinkResponse{}, nil
+}
+
+func (svc *NotesService) ListShareLinks(
+	ctx context.Context,
+	request *pb.ListShareLinksRequest,
+) (*pb.ListShareLinksResponse, error) {
+	user, err := secure.User(ctx)
+	if err != nil {
+		return nil, svc.handle(err)
+	}
+
+	links, err := svc.cases.ListShareLinks(ctx, user, &usecases.ListShareLinksInput{
+		NoteID: request.GetNoteId().GetValue(),
+	})
+	if err != nil {
+		return nil, svc.handle(err)
+	}
+
+	return &pb.ListShareLinksResponse{Links: MarshalLinks(links)}, nil
+}
+
+func (svc *NotesService) CreateReminder(
+	ctx context.Context,
+	request *pb.CreateReminderRequest,
+) (*pb.CreateReminderResponse, error) {
+	user, err := secure.User(ctx)
+	if err != nil {
+		return nil, svc.handle(err)
+	}
+
+	reminder, err := svc.cases.CreateReminder(ctx, user, &usecases.CreateReminderInput{
+		FireAt:     request.GetFireAt().AsTime(),
+		Recurrence: request.GetRecurrence(),
+		TimeZone:   request.GetTimeZone(),
+		NoteID:     request.GetNoteId().GetValue(),
+	})
+	if err != nil {
+		return nil, svc.handle(err)
+	}
+
+	return &pb.CreateReminderResponse{Reminder: MarshalReminder(reminder)}, nil
+}
+
+func (svc *NotesService) ListReminders(
+	ctx context.Context,
+	request *pb.ListRemindersRequest,
+) (*pb.ListRemindersResponse, error) {
+	user, err := secure.User(ctx)
+	if err != nil {
+		return nil, svc.handle(err)
+	}
+
+	reminders, err := svc.cases.ListReminders(ctx, user, &usecases.ListRemindersInput{
+		NoteID: request.GetNoteId().GetValue(),
+	})
+	if err != nil {
+		return nil, svc.handle(err)
+	}
+
+	return &pb.ListRemindersResponse{Reminders: MarshalReminders(reminders)}, nil
+}
+
+func (svc *NotesService) UpdateReminder(
+	ctx context.Context,
+	request *pb.UpdateReminderRequest,
+) (*pb.UpdateReminderResponse, error) {
+	user, err := secure.User(ctx)
+	if err != nil {
+		return nil, svc.handle(err)
+	}
+
+	reminder, err := svc.cases.UpdateReminder(ctx, user, UnmarshalUpdateReminder(request))
+	if err != nil {
+		return nil, svc.handle(err)
+	}
+
+	return &pb.UpdateReminderResponse{Reminder: MarshalReminder(reminder)}, nil
+}
+
+func (svc *NotesService) DeleteReminder(
+	ctx context.Context,
+	request *pb.DeleteReminderRequest,
+) (*pb.DeleteReminderResponse, error) {
+	user, err := secure.User(ctx)
+	if err != nil {
+		return nil, svc.handle(err)
+	}
+
+	err = svc.cases.DeleteReminder(ctx, user, &usecases.ReminderInput{
+		ID:     request.GetId().GetValue(),
+		NoteID: request.GetNoteId().GetValue(),
+	})
+	if err != nil {
+		return nil, svc.handle(err)
+	}
+
+	return &pb.DeleteReminderResponse{}, nil
+}
+
+func (svc *NotesService) GetPublicNote(
+	ctx context.Context,
+	request *pb.GetPublicNoteRequest,
+) (*pb.GetPublicNoteResponse, error) {
+	link, err := svc.cases.GetPublicNote(ctx, &usecases.GetPublicNoteInput{Token: request.GetToken()})
+	if err != nil {
+		return nil, svc.handle(err)
+	}
+
+	return &pb.GetPublicNoteResponse{Note: MarshalNote(link.Note), Views: link.Views}, nil
+}
+
+func (svc *NotesService) RenderPublicNote(
+	ctx context.Context,
+	request *pb.RenderPublicNoteRequest,
+) (*httpbody.HttpBody, error) {
+	link, err := svc.cases.GetPublicNote(ctx, &usecases.GetPublicNoteInput{Token: request.GetToken()})
+	if err != nil {
+		return nil, svc.handle(err)
+	}
+
+	data, err := RenderNote(link.Note)
+	if err != nil {
+		return nil, svc.handle(err)
+	}
+
+	return &httpbody.HttpBody{ContentType: HTMLContentType, Data: data, Extensions: nil}, nil
+}
+
+func (svc *NotesService) ListNoteRevisions(
+	ctx context.Context,
+	request *pb.ListNoteRevisionsRequest,
+) (*pb.ListNoteRevisionsResponse, error) {
+	user, err := secure.User(ctx)
+	if err != nil {
+		return nil, svc.handle(err)
+	}
+
+	page, err := svc.cases.ListNoteRevisions(ctx, user, &usecases.ListNoteRevisionsInput{
+		PageToken: request.GetPageToken(),
+		NoteID:    request.GetNoteId().GetValue(),
+		PageSize:  request.GetPageSize(),
+	})
+	if err != nil {
+		return nil, svc.handle(err)
+	}
+
+	return &pb.ListNoteRevisionsResponse{
+		Revisions:     MarshalRevisions(page.Revisions),
+		NextPageToken: page.NextPageToken,
+	}, nil
+}
+
+func (svc *NotesService) GetNoteRevision(
+	ctx context.Context,
+	request *pb.GetNoteRevisionRequest,
+) (*pb.GetNoteRevisionResponse, error) {
+	user, err := secure.User(ctx)
+	if err != nil {
+		return nil, svc.handle(err)
+	}
+
+	revision, err := svc.cases.GetNoteRevision(ctx, user, &usecases.GetNoteRevisionInput{
+		NoteID:   request.GetNoteId().GetValue(),
+		Revision: request.GetRevision(),
+	})
+	if err != nil {
+		return nil, svc.handle(err)
+	}
+
+	return &pb.GetNoteRevisionResponse{Revision: MarshalRevision(revision)}, nil
+}
+
+func (svc *NotesService) DiffNoteRevisions(
+	ctx context.Context,
+	request *pb.DiffNoteRevisionsRequest,
+) (*pb.DiffNoteRevisionsResponse, error) {
+	user, err := secure.User(ctx)
+	if err != nil {
+		return nil, svc.handle(err)
+	}
+
+	output, err := svc.cases.DiffNoteRevisions(ctx, user, &usecases.DiffNoteRevisionsInput{
+		NoteID:       request.GetNoteId().GetValue(),
+		FromRevision: request.GetFromRevision(),
+		ToRevision:   request.GetToRevision(),
+	})
+	if err != nil {
+		return nil, svc.handle(err)
+	}
+
+	return &pb.DiffNoteRevisionsResponse{
+		FromTitle: output.From.Title,
+		ToTitle:   output.To.Title,
+		Lines:     MarshalDiff(output.Lines),
+	}, nil
+}
+
+func (svc *NotesService) RestoreNoteRevision(
+	ctx context.Context,
+	request *pb.RestoreNoteRevisionRequest,
+) (*pb.RestoreNoteRevisionResponse, error) {
+	user, err := secure.User(ctx)
+	if err != nil {
+		return nil, svc.handle(err)
+	}
+
+	output, err := svc.cases.RestoreNoteRevision(ctx, user, &usecases.RestoreNoteRevisionInput{
+		NoteID:   request.GetNoteId().GetValue(),
+		Revision: request.GetRevision(),
+		Version:  precondition(ctx, request.GetVersion()),
+	})
+	if err != nil {
+		return nil, svc.handle(err)
+	}
+
+	return &pb.RestoreNoteRevisionResponse{
+		Note:     MarshalNote(output.Note),
+		Revision: MarshalRevision(output.Revision),
+	}, nil
+}
+
+func (svc *NotesService) SubscribeToEvents(
+	request *pb.SubscribeToEventsRequest,
+	stream grpc.ServerStreamingServer[pb.SubscribeToEventsResponse],
+) error {
+	ctx := stream.Context()
+
+	user, err := secure.User(ctx)
+	if err != nil {
+		return svc.handle(err)
+	}
+
+	filter := UnmarshalEventFilter(request.GetFilter(), false)
+
+	sub, err := svc.cases.Subscribe(ctx, user, &usecases.SubscribeInput{
+		Device:   request.GetDevice(),
+		Position: request.GetResumeFrom(),
+		Filter:   filter,
+	})
+	if err != nil {
+		return svc.handle(err)
+	}
+
+	unread, err := svc.cases.UnreadEvents(ctx, user, &filter)
+	if err != nil {
+		return svc.handle(err)
+	}
+
+	err = stream.Send(&pb.SubscribeToEventsResponse{Payload: MarshalUnread(unread)})
+	if err != nil {
+		return svc.handle(ErrSend.Because(err))
+	}
+
+	for {
+		var events []*entities.Event
+
+		events, err = svc.cases.WaitNextEvents(ctx, sub, &filter, svc.heartbeat)
+
+		if ctx.Err() != nil {
+			return svc.handle(ctx.Err())
+		}
+
+		switch {
+		case errors.Is(err, usecases.ErrZeroEvents):
+			err = stream.Send(&pb.SubscribeToEventsResponse{Payload: MarshalHeartbeat(time.Now())})
+			if err != nil {
+				err = ErrSend.Because(err)
+			}
+		case err != nil:
+			return svc.handle(err)
+		default:
+			err = svc.sendEvents(ctx, stream, sub, events)
+		}
+
+		if err != nil {
+			return svc.handle(err)
+		}
+	}
+}
+
+func (svc *NotesService) ListEvents(
+	ctx context.Context,
+	request *pb.ListEventsRequest,
+) (*pb.ListEventsResponse, error) {
+	user, err := secure.User(ctx)
+	if err != nil {
+		return nil, svc.handle(err)
+	}
+
+	output, err := svc.cases.ListEvents(ctx, user, &usecases.ListEventsInput{
+		PageToken: request.GetPageToken(),
+		Filter:    UnmarshalEventFilter(request.GetFilter(), request.GetUnreadOnly()),
+		PageSize:  request.GetPageSize(),
+	})
+	if err != nil {
+		return nil, svc.handle(err)
+	}
+
+	return &pb.ListEventsResponse{
+		Events:        MarshalEvents(output.Events),
+		NextPageToken: output.NextPageToken,
+		Unread:        output.Unread,
+	}, nil
+}
+
+func (svc *NotesService) MarkEventsRead(
+	ctx context.Context,
+	request *pb.MarkEventsReadRequest,
+) (*pb.MarkEventsReadResponse, error) {
+	user, err := secure.User(ctx)
+	if err != nil {
+		return nil, svc.handle(err)
+	}
+
+	output, err := svc.cases.MarkEventsRead(ctx, user, &usecases.MarkEventsReadInput{
+		UpTo: unmarshalTime(request.GetUpTo()),
+		IDs:  request.GetIds(),
+	})
+	if err != nil {
+		return nil, svc.handle(err)
+	}
+
+	return &pb.MarkEventsReadResponse{Marked: output.Marked, Unread: output.Unread}, nil
+}
+
+// sendEvents acknowledges every event only once it is sent, an event lost with a broken stream is delivered again.
+func (svc *NotesService) sendEvents(
+	ctx context.Context,
+	stream grpc.ServerStreamingServer[pb.SubscribeToEventsResponse],
+	sub *entities.Subscription,
+	events []*entities.Event,
+) error {
+	for _, event := range events {
+		err := stream.Send(&pb.SubscribeToEventsResponse{Payload: MarshalStreamEvent(event)})
+		if err != nil {
+			return ErrSend.Because(err)
+		}
+
+		err = svc.cases.AckEvent(ctx, sub, event)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// precondition returns the version of the note a change is based on, REST clients send it as the If-Match header.
+func precondition(ctx context.Context, version int64) int64 {
+	if version != 0 {
+		return version
+	}
+
+	for _, tag := range metadata.ValueFromIncomingContext(ctx, IfMatchKey) {
+		version, ok := UnmarshalETag(tag)
+		if ok {
+			return version
+		}
+	}
+
+	return 0
+}
+
+// negotiate returns the format of the request, REST clients may ask for the format by the Accept header instead.
+func negotiate(ctx context.Context, format entities.Format) entities.Format {
+	if format != "" {
+		return format
+	}
+
+	for _, accept := range metadata.ValueFromIncomingContext(ctx, AcceptKey) {
+		format = UnmarshalAccept(accept)
+		if format != "" {
+			return format
+		}
+	}
+
+	return entities.FormatRaw
 }
@@ -2,7 +2,9 @@ package v1_test
 
 import (
 	"context"
+	"io"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -14,6 +16,7 @@ import (
 	"github.com/therenotomorrow/gotes/internal/api/notes/v1/usecases"
 	"github.com/therenotomorrow/gotes/internal/domain/entities"
 	"github.com/therenotomorrow/gotes/internal/domain/types/id"
+	"github.com/therenotomorrow/gotes/internal/domain/types/ot"
 	"github.com/therenotomorrow/gotes/internal/domain/types/uuid"
 	"github.com/therenotomorrow/gotes/internal/services/secure"
 	pb "github.com/therenotomorrow/gotes/pkg/api/notes/v1"
@@ -21,6 +24,7 @@ import (
 	"github.com/therenotomorrow/gotes/pkg/services/generate"
 	"github.com/therenotomorrow/gotes/pkg/services/trace"
 	"github.com/therenotomorrow/gotes/pkg/testkit"
+	"google.golang.org/grpc"
 )
 
 type unitOfWork struct {
@@ -35,7 +39,7 @@ var (
 	log   = trace.Logger(trace.TEXT, true)
 	input = &pb.CreateNoteRequest{
 		Title:   "title",
-		Content: "content",
+		Content: "the content",
 	}
 )
 
@@ -105,8 +109,9 @@ func TestNotesServiceCreateNote(t *testing.T) {
 			ctx      = t.Context()
 			user     = new(entities.User)
 			notes    = mocks.NewMockNotesRepository(t)
+			revs     = mocks.NewMockRevisionsRepository(t)
 			events   = mocks.NewMockEventsRepository(t)
-			store    = ports.Store{Notes: notes, Events: events}
+			store    = ports.Store{Notes: notes, Revisions: revs, Events: events}
 			provider = mocks.NewMockStoreProvider(t)
 		)
 
@@ -122,6 +127,12 @@ func TestNotesServiceCreateNote(t *testing.T) {
 
 				return note
 			}, nil)
+		revs.On("SaveRevision", ctx, mock.AnythingOfType("*entities.Revision")).
+			Return(func(_ context.Context, revision *entities.Revision) *entities.Revision {
+				assert.Equal(t, ident, revision.Note.ID)
+
+				return revision
+			}, nil)
 		events.On("SaveEvent", ctx, mock.AnythingOfType("*entities.Event")).
 			Return(func(ctx context.Context, event *entities.Event) error {
 				assert.Equal(t, entities.EventTypeCreated, event.EventType)
@@ -142,11 +153,207 @@ func TestNotesServiceCreateNote(t *testing.T) {
 		want := &pb.CreateNoteResponse{Note: &pb.Note{
 			Id:        &typespb.ID{Value: ident.Value()},
 			Title:     "title",
-			Content:   "content",
+			Content:   "the content",
 			CreatedAt: testkit.TimeAsTimestamp(now),
 			UpdatedAt: testkit.TimeAsTimestamp(now),
+			Version:   1,
 		}}
 
 		assert.Equal(t, want, resp)
 	})
 }
+
+type eventsStream struct {
+	grpc.ServerStream
+
+	ctx  context.Context //nolint:containedctx // the context of the fake stream
+	sent []*pb.SubscribeToEventsResponse
+}
+
+func (s *eventsStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *eventsStream) Send(resp *pb.SubscribeToEventsResponse) error {
+	s.sent = append(s.sent, resp)
+
+	return nil
+}
+
+func TestNotesServiceSubscribeToEvents(t *testing.T) {
+	t.Parallel()
+
+	uuid.SetGenerator(generate.NewUUID())
+
+	var (
+		user  = &entities.User{ID: id.New(10)}
+		event = &entities.Event{
+			Note:      &entities.Note{ID: id.New(42)},
+			ID:        uuid.New(),
+			EventType: entities.EventTypeUpdated,
+		}
+		stream   = mocks.NewMockEventStream(t)
+		history  = mocks.NewMockEventsRepository(t)
+		store    = ports.Store{Events: history, Stream: stream}
+		provider = mocks.NewMockStoreProvider(t)
+		filter   = ports.EventsFilter{Types: []entities.EventType{entities.EventTypeUpdated}, NoteIDs: []int64{42}}
+	)
+
+	ctx, cancel := context.WithCancel(secure.NewUserContext(t.Context(), user))
+	fake := &eventsStream{ctx: ctx}
+	// the filtered stream keeps its own position
+	sub := mock.MatchedBy(func(sub *entities.Subscription) bool {
+		return sub.Device == "phone" && sub.Group() != sub.Device
+	})
+	timeout := mock.AnythingOfType("time.Duration")
+	event.Position = "1700000000000-1"
+
+	provider.On("Provide", context.Background()).Return(store)
+	stream.On("Subscribe", ctx, sub, "1700000000000-0").
+		Return(nil).Once()
+	history.On("CountUnreadEvents", ctx, user, &filter).
+		Return(int32(2), nil).Once()
+	stream.On("WaitEvents", ctx, sub, 20, timeout).
+		Return(nil, usecases.ErrZeroEvents).Once()
+	stream.On("WaitEvents", ctx, sub, 20, timeout).
+		Return([]*entities.Event{event}, nil).Once()
+	stream.On("AckEvent", ctx, sub, event).
+		Return(nil).Once()
+	stream.On("WaitEvents", ctx, sub, 20, timeout).
+		Return(func(context.Context, *entities.Subscription, int, time.Duration) ([]*entities.Event, error) {
+			cancel()
+
+			return nil, context.Canceled
+		}).Once()
+
+	svc := v1.NewServiceWithProvider(unitOfWork{provider: provider}, provider, log)
+	svc.SetHeartbeatInterval(time.Minute)
+
+	err := svc.SubscribeToEvents(&pb.SubscribeToEventsRequest{
+		Device:     "phone",
+		ResumeFrom: "1700000000000-0",
+		Filter: &pb.EventFilter{
+			Types:   []pb.EventType{pb.EventType_EVENT_TYPE_UPDATED},
+			NoteIds: []*typespb.ID{{Value: 42}},
+		},
+	}, fake)
+	require.Error(t, err)
+
+	require.Len(t, fake.sent, 3)
+	assert.Equal(t, int32(2), fake.sent[0].GetUnread().GetEvents())
+	assert.NotNil(t, fake.sent[1].GetHeartbeat().GetTime())
+	assert.Equal(t, event.ID.Value(), fake.sent[2].GetEvent().GetId())
+	assert.Equal(t, event.Position, fake.sent[2].GetEvent().GetPosition())
+}
+
+type editStream struct {
+	grpc.ServerStream
+
+	ctx      context.Context //nolint:containedctx // the context of the fake stream
+	received []*pb.EditNoteRequest
+	sent     []*pb.EditNoteResponse
+}
+
+func (s *editStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *editStream) Recv() (*pb.EditNoteRequest, error) {
+	if len(s.received) == 0 {
+		return nil, io.EOF
+	}
+
+	req := s.received[0]
+	s.received = s.received[1:]
+
+	return req, nil
+}
+
+func (s *editStream) Send(resp *pb.EditNoteResponse) error {
+	s.sent = append(s.sent, resp)
+
+	return nil
+}
+
+func TestNotesServiceEditNote(t *testing.T) {
+	t.Parallel()
+
+	uuid.SetGenerator(generate.NewUUID())
+
+	var (
+		user = &entities.User{ID: id.New(10)}
+		note = &entities.Note{Owner: user, Content: "hello", ID: id.New(42)}
+		join = &pb.EditNoteRequest{Payload: &pb.EditNoteRequest_NoteId{NoteId: &typespb.ID{Value: 42}}}
+	)
+
+	t.Run("missing join", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			ctx      = secure.NewUserContext(t.Context(), user)
+			provider = mocks.NewMockStoreProvider(t)
+			fake     = &editStream{ctx: ctx, received: []*pb.EditNoteRequest{{
+				Payload: &pb.EditNoteRequest_Cursor{Cursor: &pb.EditCursor{Revision: 0, Anchor: 0, Head: 0}},
+			}}}
+		)
+
+		provider.On("Provide", context.Background()).Return(ports.Store{})
+
+		svc := v1.NewServiceWithProvider(unitOfWork{provider: provider}, provider, log)
+
+		err := svc.EditNote(fake)
+		require.Error(t, err)
+		assert.Empty(t, fake.sent)
+	})
+
+	t.Run("edit and leave", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			ctx      = secure.NewUserContext(t.Context(), user)
+			notes    = mocks.NewMockNotesRepository(t)
+			drafts   = mocks.NewMockDrafts(t)
+			store    = ports.Store{Notes: notes, Drafts: drafts}
+			provider = mocks.NewMockStoreProvider(t)
+			fake     = &editStream{ctx: ctx, received: []*pb.EditNoteRequest{join, {
+				Payload: &pb.EditNoteRequest_Operation{Operation: v1.MarshalOperation(
+					ot.Operation{}.Retain(5).Insert("!"), 0,
+				)},
+			}}}
+			edited = &entities.Draft{Note: note, Content: "hello!", Position: "", Revision: 1, Saved: 1}
+		)
+
+		provider.On("Provide", context.Background()).Return(store)
+		notes.On("GetNote", ctx, note.ID).
+			Return(note, nil).Once()
+		drafts.On("OpenDraft", ctx, note).
+			Return(entities.NewDraft(note), nil).Once()
+		drafts.On("GetDraft", mock.Anything, note).
+			Return(entities.NewDraft(note), nil).Once()
+		drafts.On("GetEdits", mock.Anything, mock.AnythingOfType("*entities.Draft"), int64(0)).
+			Return([]*entities.Edit{}, nil).Once()
+		drafts.On("SaveEdit", mock.Anything, mock.AnythingOfType("*entities.Draft"), mock.AnythingOfType("*entities.Edit")).
+			Return(nil).Once()
+		drafts.On("WaitEdits", mock.Anything, note, "", mock.AnythingOfType("time.Duration")).
+			Return(func(ctx context.Context, _ *entities.Note, _ string, _ time.Duration) ([]*entities.Edit, error) {
+				// the edits are sent until the editor leaves
+				<-ctx.Done()
+
+				return nil, ctx.Err()
+			})
+		// the draft is saved by another editor meanwhile, so there is nothing to save on leave
+		drafts.On("GetDraft", mock.Anything, note).
+			Return(edited, nil).Once()
+
+		svc := v1.NewServiceWithProvider(unitOfWork{provider: provider}, provider, log)
+		svc.SetCheckpointInterval(time.Minute)
+
+		err := svc.EditNote(fake)
+		require.NoError(t, err)
+
+		require.Len(t, fake.sent, 1)
+		assert.Equal(t, "hello", fake.sent[0].GetDraft().GetContent())
+		assert.True(t, fake.sent[0].GetDraft().GetWritable())
+		assert.NotEmpty(t, fake.sent[0].GetDraft().GetSession())
+	})
+}
@@ -0,0 +1,53 @@
+package v1
+
+import (
+	"bytes"
+)
+
+// ChunkSize is the maximum size of a chunk sent by the streaming methods, e.g. ExportNotes.
+const ChunkSize = 32 << 10
+
+// chunkWriter sends everything written to it as chunks of the stream.
+type chunkWriter struct {
+	send func(chunk []byte) error
+}
+
+func (w *chunkWriter) Write(data []byte) (int, error) {
+	written := 0
+
+	for len(data) > 0 {
+		size := min(len(data), ChunkSize)
+
+		err := w.send(bytes.Clone(data[:size]))
+		if err != nil {
+			return written, err
+		}
+
+		written += size
+		data = data[size:]
+	}
+
+	return written, nil
+}
+
+// chunkReader reads the chunks received from the stream as a single content, io.EOF ends the content.
+type chunkReader struct {
+	recv  func() ([]byte, error)
+	chunk []byte
+}
+
+func (r *chunkReader) Read(data []byte) (int, error) {
+	for len(r.chunk) == 0 {
+		chunk, err := r.recv()
+		if err != nil {
+			return 0, err
+		}
+
+		r.chunk = chunk
+	}
+
+	n := copy(data, r.chunk)
+	r.chunk = r.chunk[n:]
+
+	return n, nil
+}
@@ -0,0 +1,54 @@
+package usecases
+
+import (
+	"encoding/hex"
+	"errors"
+	"hash"
+	"io"
+)
+
+// digest hashes the content while it is read and stops reading once the content grows beyond the limit.
+type digest struct {
+	reader io.Reader
+	hash   hash.Hash
+	size   int64
+	limit  int64
+}
+
+func (d *digest) Read(p []byte) (int, error) {
+	n, err := d.reader.Read(p)
+
+	d.size += int64(n)
+	d.hash.Write(p[:n])
+
+	if d.size > d.limit {
+		return n, ErrAttachmentTooLarge
+	}
+
+	return n, err
+}
+
+func (d *digest) sum() string {
+	return hex.EncodeToString(d.hash.Sum(nil))
+}
+
+// verified checks the content against the checksum once it is read to the end.
+type verified struct {
+	io.ReadCloser
+
+	digest   digest
+	checksum string
+}
+
+func (v *verified) Read(p []byte) (int, error) {
+	n, err := v.digest.Read(p)
+
+	switch {
+	case errors.Is(err, ErrAttachmentTooLarge):
+		return n, ErrAttachmentCorrupted
+	case errors.Is(err, io.EOF) && v.digest.sum() != v.checksum:
+		return n, ErrAttachmentCorrupted
+	}
+
+	return n, err
+}
@@ -0,0 +1,154 @@
+package usecases
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"time"
+
+	"github.com/therenotomorrow/gotes/internal/api/notes/v1/ports"
+	"github.com/therenotomorrow/gotes/internal/domain/entities"
+	"github.com/therenotomorrow/gotes/internal/domain/types/cursor"
+	"github.com/therenotomorrow/gotes/internal/domain/types/id"
+	"github.com/therenotomorrow/gotes/internal/domain/types/uuid"
+)
+
+const (
+	DefaultPageSize = 50
+)
+
+// pageToken holds the sort key of the last item of a page and the fingerprint of the request that produced it.
+type pageToken struct {
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+	DeletedAt time.Time `json:"deletedAt"`
+	Title     string    `json:"title"`
+	Query     string    `json:"query"`
+	Event     string    `json:"event,omitempty"`
+	ID        int64     `json:"id"`
+	Rank      float32   `json:"rank"`
+	Revision  int32     `json:"revision"`
+	Pinned    bool      `json:"pinned,omitempty"`
+}
+
+func pageSize(size int32) int32 {
+	if size <= 0 {
+		return DefaultPageSize
+	}
+
+	return size
+}
+
+func fingerprint(user *entities.User, params ...any) string {
+	data, _ := json.Marshal(append([]any{user.ID.Value()}, params...))
+	sum := sha256.Sum256(data)
+
+	return hex.EncodeToString(sum[:])
+}
+
+func encodePageToken(page *pageToken) (string, error) {
+	return cursor.Encode(page)
+}
+
+func decodePageToken(token, query string) (*pageToken, error) {
+	if token == "" {
+		return nil, nil //nolint:nilnil // the first page has no previous item
+	}
+
+	page := new(pageToken)
+
+	err := cursor.Decode(token, page)
+	if err != nil || page.Query != query {
+		return nil, ErrInvalidPageToken
+	}
+
+	// events are keyed by their uuid, everything else by the id
+	if page.Event != "" {
+		_, err = uuid.Parse(page.Event)
+	} else {
+		_, err = id.Conv(page.ID)
+	}
+
+	if err != nil {
+		return nil, ErrInvalidPageToken
+	}
+
+	return page, nil
+}
+
+func (p *pageToken) note() *entities.Note {
+	if p == nil {
+		return nil
+	}
+
+	return &entities.Note{
+		CreatedAt: p.CreatedAt,
+		UpdatedAt: p.UpdatedAt,
+		Owner:     nil,
+		DeletedAt: nil,
+		Notebook:  nil,
+		Title:     p.Title,
+		Content:   "",
+		ID:        id.New(p.ID),
+		Pinned:    p.Pinned,
+	}
+}
+
+func (p *pageToken) hit() *ports.SearchHit {
+	if p == nil {
+		return nil
+	}
+
+	return &ports.SearchHit{Note: p.note(), TitleSnippet: "", ContentSnippet: "", Rank: p.Rank}
+}
+
+func (p *pageToken) trashed() *entities.Note {
+	if p == nil {
+		return nil
+	}
+
+	note := p.note()
+	note.DeletedAt = &p.DeletedAt
+
+	return note
+}
+
+func (p *pageToken) revision() *entities.Revision {
+	if p == nil {
+		return nil
+	}
+
+	return &entities.Revision{
+		CreatedAt: p.CreatedAt,
+		Note:      nil,
+		Author:    nil,
+		Title:     p.Title,
+		Content:   "",
+		ID:        id.New(p.ID),
+		Number:    p.Revision,
+	}
+}
+
+func (p *pageToken) share() *entities.Share {
+	if p == nil {
+		return nil
+	}
+
+	return &entities.Share{CreatedAt: p.CreatedAt, Note: p.note(), User: nil, Role: ""}
+}
+
+func (p *pageToken) event() *entities.Event {
+	if p == nil {
+		return nil
+	}
+
+	return &entities.Event{
+		EventTime: p.CreatedAt,
+		ReadAt:    nil,
+		Note:      nil,
+		Recipient: nil,
+		Position:  "",
+		ID:        uuid.Conv(p.Event),
+		EventType: 0,
+	}
+}
@@ -2,31 +2,128 @@ package usecases
 
 import (
 	"context"
+	"crypto/sha256"
+	"errors"
+	"io"
+	"maps"
+	"slices"
+	"strings"
+	"time"
 
 	"github.com/therenotomorrow/gotes/internal/api/notes/v1/ports"
 	"github.com/therenotomorrow/gotes/internal/domain"
 	"github.com/therenotomorrow/gotes/internal/domain/entities"
+	"github.com/therenotomorrow/gotes/internal/domain/types/diff"
+	"github.com/therenotomorrow/gotes/internal/domain/types/email"
 	"github.com/therenotomorrow/gotes/internal/domain/types/id"
+	"github.com/therenotomorrow/gotes/internal/domain/types/ot"
+	"github.com/therenotomorrow/gotes/internal/domain/types/uuid"
+	"github.com/therenotomorrow/gotes/internal/domain/types/wikilink"
 )
 
 const (
 	ErrNoteNotFound     domain.Error = "note not found"
 	ErrZeroEvents       domain.Error = "zero events"
 	ErrPermissionDenied domain.Error = "permission denied"
+	ErrNothingToUpdate  domain.Error = "nothing to update"
+	ErrInvalidPageToken domain.Error = "invalid page token"
+	ErrRevisionNotFound domain.Error = "revision not found"
+	ErrNoteNotTrashed   domain.Error = "note not trashed"
+	ErrTagNotFound      domain.Error = "tag not found"
+
+	ErrShareNotFound        domain.Error = "share not found"
+	ErrCollaboratorNotFound domain.Error = "collaborator not found"
+	ErrShareWithOwner       domain.Error = "cannot share note with its owner"
+	ErrLinkNotFound         domain.Error = "link not found"
+	ErrNotebookNotFound     domain.Error = "notebook not found"
+	ErrNotebookCycle        domain.Error = "notebook cannot be moved into itself"
+	ErrBatchTooLarge        domain.Error = "batch is too large"
+	ErrVersionRequired      domain.Error = "note version required"
+	ErrVersionMismatch      domain.Error = "note version mismatch"
+	ErrAttachmentNotFound   domain.Error = "attachment not found"
+	ErrAttachmentTooLarge   domain.Error = "attachment is too large"
+	ErrChecksumMismatch     domain.Error = "attachment checksum mismatch"
+	ErrAttachmentCorrupted  domain.Error = "attachment is corrupted"
+	ErrInvalidPosition      domain.Error = "invalid event position"
+	ErrInvalidEventID       domain.Error = "invalid event id"
+	ErrReminderNotFound     domain.Error = "reminder not found"
+	ErrReminderTimeRequired domain.Error = "reminder time required"
+	ErrDraftNotFound        domain.Error = "draft not found"
+	ErrDraftChanged         domain.Error = "draft changed"
+	ErrZeroEdits            domain.Error = "zero edits"
+	ErrInvalidRevision      domain.Error = "invalid draft revision"
+	ErrRevisionTooOld       domain.Error = "draft revision is too old"
+	ErrTemplateNotFound     domain.Error = "template not found"
+)
+
+// exportPageSize is the number of notes read from the store at once while exporting.
+const exportPageSize = 100
+
+// eventsBatchSize is the number of events a device takes from its stream at once.
+const eventsBatchSize = 20
+
+// editAttempts is how many times an edit is transformed again when other edits are applied to the draft first.
+const editAttempts = 10
+
+// relayBackoff is the delay before the events that failed to publish are due again, it doubles on every attempt
+// up to relayMaxBackoff.
+const (
+	relayBackoff    = time.Second
+	relayMaxBackoff = 5 * time.Minute
+)
+
+const (
+	// DefaultBatchLimit is the maximum number of items in a single batch operation unless changed by SetBatchLimit.
+	DefaultBatchLimit = 100
+	// DefaultAttachmentLimit is the maximum size of an attachment in bytes unless changed by SetAttachmentLimit.
+	DefaultAttachmentLimit = 10 << 20
+)
+
+// access is the level of access an operation needs on a note.
+type access int
+
+const (
+	accessRead access = iota
+	accessWrite
+	accessOwner
 )
 
 type UseCases struct {
-	uow   ports.UnitOfWork
-	store ports.Store
+	uow             ports.UnitOfWork
+	store           ports.Store
+	batchLimit      int
+	attachmentLimit int64
 }
 
 func NewCases(uow ports.UnitOfWork, store ports.Store) *UseCases {
-	return &UseCases{uow: uow, store: store}
+	return &UseCases{
+		uow:             uow,
+		store:           store,
+		batchLimit:      DefaultBatchLimit,
+		attachmentLimit: DefaultAttachmentLimit,
+	}
+}
+
+// SetBatchLimit changes the maximum number of items in a single batch operation.
+func (use *UseCases) SetBatchLimit(limit int) {
+	use.batchLimit = limit
+}
+
+// SetAttachmentLimit changes the maximum size of an attachment in bytes.
+func (use *UseCases) SetAttachmentLimit(limit int64) {
+	use.attachmentLimit = limit
 }
 
 type CreateNoteInput struct {
+	// Variables fill the placeholders of the template, they take precedence over the built-in ones.
+	Variables map[string]string
+	// Title and Content replace the rendered ones of the template when set.
 	Title   string
 	Content string
+	// NotebookID is zero for notes created in the root.
+	NotebookID int64
+	// TemplateID is zero for notes created without a template.
+	TemplateID int64
 }
 
 func (use *UseCases) CreateNote(
@@ -34,15 +131,13 @@ func (use *UseCases) CreateNote(
 	user *entities.User,
 	input *CreateNoteInput,
 ) (*entities.Note, error) {
-	note, err := entities.NewNote(input.Title, input.Content)
+	note, err := use.newNote(ctx, use.store, user, input)
 	if err != nil {
 		return nil, err
 	}
 
-	note.SetOwner(user)
-
 	err = use.uow.Do(ctx, func(store ports.Store) error {
-		note, err = store.Notes.SaveNote(ctx, note)
+		note, err = use.save(ctx, store, user, note, input.NotebookID)
 		if err != nil {
 			return err
 		}
@@ -55,8 +150,102 @@ func (use *UseCases) CreateNote(
 	return note, err
 }
 
+type UpdateNoteInput struct {
+	Title   *string
+	Content *string
+	// Pinned, Archived and Starred are changed by the owner only.
+	Pinned   *bool
+	Archived *bool
+	Starred  *bool
+	ID       int64
+	// Version is the version of the note the change is based on.
+	Version int64
+}
+
+func (use *UseCases) UpdateNote(
+	ctx context.Context,
+	user *entities.User,
+	input *UpdateNoteInput,
+) (*entities.Note, error) {
+	ident, err := id.Conv(input.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	edits := input.Title != nil || input.Content != nil
+	states := input.Pinned != nil || input.Archived != nil || input.Starred != nil
+
+	if !edits && !states {
+		return nil, ErrNothingToUpdate
+	}
+
+	level := accessWrite
+	if states {
+		level = accessOwner
+	}
+
+	var note *entities.Note
+
+	err = use.uow.Do(ctx, func(store ports.Store) error {
+		note, err = store.Notes.GetNote(ctx, ident)
+		if err != nil {
+			return err
+		}
+
+		err = use.permit(ctx, store, user, note, level)
+		if err != nil {
+			return err
+		}
+
+		err = use.alive(note)
+		if err != nil {
+			return err
+		}
+
+		err = use.match(note, input.Version)
+		if err != nil {
+			return err
+		}
+
+		previous := note.Content
+
+		err = use.update(note, input)
+		if err != nil {
+			return err
+		}
+
+		err = store.Notes.UpdateNote(ctx, note)
+		if err != nil {
+			return err
+		}
+
+		err = use.relink(ctx, store, note, previous)
+		if err != nil {
+			return err
+		}
+
+		// the states are not a part of the history of the note
+		if edits {
+			_, err = store.Revisions.SaveRevision(ctx, entities.NewRevision(note, user))
+			if err != nil {
+				return err
+			}
+		}
+
+		event := entities.NewEvent(entities.EventTypeUpdated, note)
+
+		return store.Events.SaveEvent(ctx, event)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return note, nil
+}
+
 type DeleteNoteInput struct {
-	ID int64
+	ID      int64
+	Version int64
 }
 
 func (use *UseCases) DeleteNote(ctx context.Context, user *entities.User, input *DeleteNoteInput) error {
@@ -71,12 +260,24 @@ func (use *UseCases) DeleteNote(ctx context.Context, user *entities.User, input
 			return err
 		}
 
-		err = use.permit(user, note)
+		err = use.permit(ctx, store, user, note, accessOwner)
 		if err != nil {
 			return err
 		}
 
-		err = store.Notes.DeleteNote(ctx, note)
+		err = use.alive(note)
+		if err != nil {
+			return err
+		}
+
+		err = use.match(note, input.Version)
+		if err != nil {
+			return err
+		}
+
+		note.Trash()
+
+		err = store.Notes.TrashNote(ctx, note)
 		if err != nil {
 			return err
 		}
@@ -88,7 +289,9 @@ func (use *UseCases) DeleteNote(ctx context.Context, user *entities.User, input
 }
 
 type RetrieveNoteInput struct {
-	ID int64
+	// Format is the format of the content, the content is given as it is written by default.
+	Format entities.Format
+	ID     int64
 }
 
 func (use *UseCases) RetrieveNote(
@@ -106,7 +309,257 @@ func (use *UseCases) RetrieveNote(
 		return nil, err
 	}
 
-	err = use.permit(user, note)
+	err = use.permit(ctx, use.store, user, note, accessRead)
+	if err != nil {
+		return nil, err
+	}
+
+	err = use.alive(note)
+	if err != nil {
+		return nil, err
+	}
+
+	err = use.render(ctx, []*entities.Note{note}, input.Format)
+	if err != nil {
+		return nil, err
+	}
+
+	return note, nil
+}
+
+type ListNotesInput struct {
+	PageToken string
+	// Format is the format of the contents, it does not change the notes listed, so the pages may switch it.
+	Format   entities.Format
+	Filter   ports.NotesFilter
+	Order    ports.NotesOrder
+	PageSize int32
+}
+
+type ListNotesOutput struct {
+	NextPageToken string
+	Notes         []*entities.Note
+	TotalSize     int32
+}
+
+func (use *UseCases) ListNotes(
+	ctx context.Context,
+	user *entities.User,
+	input *ListNotesInput,
+) (*ListNotesOutput, error) {
+	size := pageSize(input.PageSize)
+	query := fingerprint(user, input.Filter, input.Order)
+
+	page, err := decodePageToken(input.PageToken, query)
+	if err != nil {
+		return nil, err
+	}
+
+	notes, err := use.store.Notes.GetNotesByUser(ctx, user, &ports.NotesQuery{
+		After:  page.note(),
+		Filter: input.Filter,
+		Order:  input.Order,
+		Limit:  size + 1,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	total, err := use.store.Notes.CountNotesByUser(ctx, user, &input.Filter)
+	if err != nil {
+		return nil, err
+	}
+
+	output := &ListNotesOutput{Notes: notes, NextPageToken: "", TotalSize: total}
+
+	if len(notes) > int(size) {
+		output.Notes = notes[:size]
+		last := output.Notes[size-1]
+
+		output.NextPageToken, err = encodePageToken(&pageToken{
+			CreatedAt: last.CreatedAt,
+			UpdatedAt: last.UpdatedAt,
+			DeletedAt: time.Time{},
+			Title:     last.Title,
+			Query:     query,
+			ID:        last.ID.Value(),
+			Rank:      0,
+			Revision:  0,
+			Pinned:    last.Pinned,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = use.render(ctx, output.Notes, input.Format)
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+type SearchNotesInput struct {
+	Text      string
+	PageToken string
+	PageSize  int32
+}
+
+type SearchNotesOutput struct {
+	NextPageToken string
+	Hits          []*ports.SearchHit
+	TotalSize     int32
+}
+
+func (use *UseCases) SearchNotes(
+	ctx context.Context,
+	user *entities.User,
+	input *SearchNotesInput,
+) (*SearchNotesOutput, error) {
+	size := pageSize(input.PageSize)
+	query := fingerprint(user, input.Text)
+
+	page, err := decodePageToken(input.PageToken, query)
+	if err != nil {
+		return nil, err
+	}
+
+	hits, err := use.store.Notes.SearchNotesByUser(ctx, user, &ports.SearchQuery{
+		After: page.hit(),
+		Text:  input.Text,
+		Limit: size + 1,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	total, err := use.store.Notes.CountSearchNotesByUser(ctx, user, input.Text)
+	if err != nil {
+		return nil, err
+	}
+
+	output := &SearchNotesOutput{Hits: hits, NextPageToken: "", TotalSize: total}
+
+	if len(hits) > int(size) {
+		output.Hits = hits[:size]
+		last := output.Hits[size-1]
+
+		output.NextPageToken, err = encodePageToken(&pageToken{
+			CreatedAt: last.Note.CreatedAt,
+			UpdatedAt: last.Note.UpdatedAt,
+			DeletedAt: time.Time{},
+			Title:     last.Note.Title,
+			Query:     query,
+			ID:        last.Note.ID.Value(),
+			Rank:      last.Rank,
+			Revision:  0,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return output, nil
+}
+
+type ListTrashedNotesInput struct {
+	PageToken string
+	PageSize  int32
+}
+
+type ListTrashedNotesOutput struct {
+	NextPageToken string
+	Notes         []*entities.Note
+	TotalSize     int32
+}
+
+func (use *UseCases) ListTrashedNotes(
+	ctx context.Context,
+	user *entities.User,
+	input *ListTrashedNotesInput,
+) (*ListTrashedNotesOutput, error) {
+	size := pageSize(input.PageSize)
+	query := fingerprint(user, "trash")
+
+	page, err := decodePageToken(input.PageToken, query)
+	if err != nil {
+		return nil, err
+	}
+
+	notes, err := use.store.Notes.GetTrashedNotesByUser(ctx, user, &ports.TrashQuery{
+		After: page.trashed(),
+		Limit: size + 1,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	total, err := use.store.Notes.CountTrashedNotesByUser(ctx, user)
+	if err != nil {
+		return nil, err
+	}
+
+	output := &ListTrashedNotesOutput{Notes: notes, NextPageToken: "", TotalSize: total}
+
+	if len(notes) > int(size) {
+		output.Notes = notes[:size]
+		last := output.Notes[size-1]
+
+		output.NextPageToken, err = encodePageToken(&pageToken{
+			CreatedAt: last.CreatedAt,
+			UpdatedAt: last.UpdatedAt,
+			DeletedAt: *last.DeletedAt,
+			Title:     last.Title,
+			Query:     query,
+			ID:        last.ID.Value(),
+			Rank:      0,
+			Revision:  0,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return output, nil
+}
+
+type RestoreNoteInput struct {
+	ID      int64
+	Version int64
+}
+
+func (use *UseCases) RestoreNote(
+	ctx context.Context,
+	user *entities.User,
+	input *RestoreNoteInput,
+) (*entities.Note, error) {
+	var note *entities.Note
+
+	err := use.uow.Do(ctx, func(store ports.Store) error {
+		var err error
+
+		note, err = use.trashed(ctx, store, user, input.ID)
+		if err != nil {
+			return err
+		}
+
+		err = use.match(note, input.Version)
+		if err != nil {
+			return err
+		}
+
+		note.Restore()
+
+		err = store.Notes.RestoreNote(ctx, note)
+		if err != nil {
+			return err
+		}
+
+		event := entities.NewEvent(entities.EventTypeRestored, note)
+
+		return store.Events.SaveEvent(ctx, event)
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -114,20 +567,2712 @@ func (use *UseCases) RetrieveNote(
 	return note, nil
 }
 
-func (use *UseCases) ListNotes(ctx context.Context, user *entities.User) ([]*entities.Note, error) {
-	return use.store.Notes.GetNotesByUser(ctx, user)
+type PurgeNoteInput struct {
+	ID      int64
+	Version int64
 }
 
-func (use *UseCases) UnreadEvents(ctx context.Context, user *entities.User) (int32, error) {
-	return use.store.Events.CountEvents(ctx, user)
+func (use *UseCases) PurgeNote(ctx context.Context, user *entities.User, input *PurgeNoteInput) error {
+	return use.uow.Do(ctx, func(store ports.Store) error {
+		note, err := use.trashed(ctx, store, user, input.ID)
+		if err != nil {
+			return err
+		}
+
+		err = use.match(note, input.Version)
+		if err != nil {
+			return err
+		}
+
+		err = store.Attachments.DeleteAttachmentsByNote(ctx, note)
+		if err != nil {
+			return err
+		}
+
+		err = store.Notes.DeleteNote(ctx, note)
+		if err != nil {
+			return err
+		}
+
+		event := entities.NewEvent(entities.EventTypePurged, note)
+
+		return store.Events.SaveEvent(ctx, event)
+	})
 }
 
-func (use *UseCases) GetNextEvent(ctx context.Context, user *entities.User) (*entities.Event, error) {
-	return use.store.Events.GetEvent(ctx, user)
+// PurgeTrash permanently removes notes that have been in the trash longer than the retention.
+func (use *UseCases) PurgeTrash(ctx context.Context, retention time.Duration) (int, error) {
+	var purged int
+
+	err := use.uow.Do(ctx, func(store ports.Store) error {
+		before := time.Now().Add(-retention)
+
+		err := store.Attachments.PurgeAttachments(ctx, before)
+		if err != nil {
+			return err
+		}
+
+		notes, err := store.Notes.PurgeNotes(ctx, before)
+		if err != nil {
+			return err
+		}
+
+		for _, note := range notes {
+			if note.Owner == nil {
+				continue
+			}
+
+			err = store.Events.SaveEvent(ctx, entities.NewEvent(entities.EventTypePurged, note))
+			if err != nil {
+				return err
+			}
+		}
+
+		purged = len(notes)
+
+		return nil
+	})
+
+	return purged, err
 }
 
-func (use *UseCases) permit(user *entities.User, note *entities.Note) error {
-	if !note.IsOwner(user) {
+type NoteTagsInput struct {
+	Tags    []string
+	NoteID  int64
+	Version int64
+}
+
+func (use *UseCases) AddNoteTags(
+	ctx context.Context,
+	user *entities.User,
+	input *NoteTagsInput,
+) (*entities.Note, error) {
+	tags, err := newTags(user, input.Tags)
+	if err != nil {
+		return nil, err
+	}
+
+	return use.tag(ctx, user, input.NoteID, input.Version, func(store ports.Store, note *entities.Note) error {
+		return store.Tags.AddNoteTags(ctx, note, tags)
+	})
+}
+
+func (use *UseCases) RemoveNoteTags(
+	ctx context.Context,
+	user *entities.User,
+	input *NoteTagsInput,
+) (*entities.Note, error) {
+	names := make([]string, len(input.Tags))
+	for i, name := range input.Tags {
+		names[i] = entities.NormalizeTag(name)
+	}
+
+	return use.tag(ctx, user, input.NoteID, input.Version, func(store ports.Store, note *entities.Note) error {
+		return store.Tags.RemoveNoteTags(ctx, note, names)
+	})
+}
+
+func (use *UseCases) ListTags(ctx context.Context, user *entities.User) ([]*ports.TagCount, error) {
+	return use.store.Tags.GetTagsByUser(ctx, user)
+}
+
+type RenameTagInput struct {
+	Name    string
+	NewName string
+}
+
+// RenameTag renames the tag, renaming to an already existing tag merges both into the existing one.
+func (use *UseCases) RenameTag(
+	ctx context.Context,
+	user *entities.User,
+	input *RenameTagInput,
+) (*entities.Tag, error) {
+	var tag *entities.Tag
+
+	err := use.uow.Do(ctx, func(store ports.Store) error {
+		var err error
+
+		tag, err = store.Tags.GetTag(ctx, user, entities.NormalizeTag(input.Name))
+		if err != nil {
+			return err
+		}
+
+		name := entities.NormalizeTag(input.NewName)
+		if name == tag.Name {
+			return nil
+		}
+
+		into, err := store.Tags.GetTag(ctx, user, name)
+
+		switch {
+		case errors.Is(err, ErrTagNotFound):
+			err = tag.SetName(name)
+			if err != nil {
+				return err
+			}
+
+			return store.Tags.UpdateTag(ctx, tag)
+		case err != nil:
+			return err
+		}
+
+		err = store.Tags.MergeTag(ctx, tag, into)
+		if err != nil {
+			return err
+		}
+
+		tag = into
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return tag, nil
+}
+
+type ListNoteRevisionsInput struct {
+	PageToken string
+	NoteID    int64
+	PageSize  int32
+}
+
+type ListNoteRevisionsOutput struct {
+	NextPageToken string
+	Revisions     []*entities.Revision
+}
+
+func (use *UseCases) ListNoteRevisions(
+	ctx context.Context,
+	user *entities.User,
+	input *ListNoteRevisionsInput,
+) (*ListNoteRevisionsOutput, error) {
+	note, err := use.accessible(ctx, use.store, user, input.NoteID, accessRead)
+	if err != nil {
+		return nil, err
+	}
+
+	size := pageSize(input.PageSize)
+	query := fingerprint(user, note.ID.Value())
+
+	page, err := decodePageToken(input.PageToken, query)
+	if err != nil {
+		return nil, err
+	}
+
+	revisions, err := use.store.Revisions.GetRevisionsByNote(ctx, note, &ports.RevisionsQuery{
+		After: page.revision(),
+		Limit: size + 1,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	output := &ListNoteRevisionsOutput{Revisions: revisions, NextPageToken: ""}
+
+	if len(revisions) > int(size) {
+		output.Revisions = revisions[:size]
+		last := output.Revisions[size-1]
+
+		output.NextPageToken, err = encodePageToken(&pageToken{
+			CreatedAt: last.CreatedAt,
+			UpdatedAt: last.CreatedAt,
+			DeletedAt: time.Time{},
+			Title:     last.Title,
+			Query:     query,
+			ID:        last.ID.Value(),
+			Rank:      0,
+			Revision:  last.Number,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return output, nil
+}
+
+type GetNoteRevisionInput struct {
+	NoteID   int64
+	Revision int32
+}
+
+func (use *UseCases) GetNoteRevision(
+	ctx context.Context,
+	user *entities.User,
+	input *GetNoteRevisionInput,
+) (*entities.Revision, error) {
+	note, err := use.accessible(ctx, use.store, user, input.NoteID, accessRead)
+	if err != nil {
+		return nil, err
+	}
+
+	return use.revision(ctx, use.store, note, input.Revision)
+}
+
+type DiffNoteRevisionsInput struct {
+	NoteID       int64
+	FromRevision int32
+	ToRevision   int32
+}
+
+type DiffNoteRevisionsOutput struct {
+	From  *entities.Revision
+	To    *entities.Revision
+	Lines []diff.Line
+}
+
+func (use *UseCases) DiffNoteRevisions(
+	ctx context.Context,
+	user *entities.User,
+	input *DiffNoteRevisionsInput,
+) (*DiffNoteRevisionsOutput, error) {
+	note, err := use.accessible(ctx, use.store, user, input.NoteID, accessRead)
+	if err != nil {
+		return nil, err
+	}
+
+	from, err := use.revision(ctx, use.store, note, input.FromRevision)
+	if err != nil {
+		return nil, err
+	}
+
+	to, err := use.revision(ctx, use.store, note, input.ToRevision)
+	if err != nil {
+		return nil, err
+	}
+
+	return &DiffNoteRevisionsOutput{From: from, To: to, Lines: from.Diff(to)}, nil
+}
+
+type RestoreNoteRevisionInput struct {
+	NoteID   int64
+	Version  int64
+	Revision int32
+}
+
+type RestoreNoteRevisionOutput struct {
+	Note     *entities.Note
+	Revision *entities.Revision
+}
+
+// RestoreNoteRevision brings the note back to the given revision, the restore itself is recorded as a new revision.
+func (use *UseCases) RestoreNoteRevision(
+	ctx context.Context,
+	user *entities.User,
+	input *RestoreNoteRevisionInput,
+) (*RestoreNoteRevisionOutput, error) {
+	var output *RestoreNoteRevisionOutput
+
+	err := use.uow.Do(ctx, func(store ports.Store) error {
+		note, err := use.accessible(ctx, store, user, input.NoteID, accessWrite)
+		if err != nil {
+			return err
+		}
+
+		err = use.match(note, input.Version)
+		if err != nil {
+			return err
+		}
+
+		revision, err := use.revision(ctx, store, note, input.Revision)
+		if err != nil {
+			return err
+		}
+
+		previous := note.Content
+
+		err = use.update(note, &UpdateNoteInput{
+			Title:    &revision.Title,
+			Content:  &revision.Content,
+			Pinned:   nil,
+			Archived: nil,
+			Starred:  nil,
+			ID:       input.NoteID,
+			Version:  input.Version,
+		})
+		if err != nil {
+			return err
+		}
+
+		err = store.Notes.UpdateNote(ctx, note)
+		if err != nil {
+			return err
+		}
+
+		err = use.relink(ctx, store, note, previous)
+		if err != nil {
+			return err
+		}
+
+		revision, err = store.Revisions.SaveRevision(ctx, entities.NewRevision(note, user))
+		if err != nil {
+			return err
+		}
+
+		output = &RestoreNoteRevisionOutput{Note: note, Revision: revision}
+		event := entities.NewEvent(entities.EventTypeUpdated, note)
+
+		return store.Events.SaveEvent(ctx, event)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+type ShareNoteInput struct {
+	Email  string
+	Role   entities.Role
+	NoteID int64
+}
+
+// ShareNote grants the collaborator access to the note, sharing it again changes the role.
+func (use *UseCases) ShareNote(
+	ctx context.Context,
+	user *entities.User,
+	input *ShareNoteInput,
+) (*entities.Share, error) {
+	mail, err := email.Parse(input.Email)
+	if err != nil {
+		return nil, err
+	}
+
+	var share *entities.Share
+
+	err = use.uow.Do(ctx, func(store ports.Store) error {
+		note, err := use.accessible(ctx, store, user, input.NoteID, accessOwner)
+		if err != nil {
+			return err
+		}
+
+		collaborator, err := store.Shares.GetCollaborator(ctx, mail)
+		if err != nil {
+			return err
+		}
+
+		if note.IsOwner(collaborator) {
+			return ErrShareWithOwner
+		}
+
+		share, err = store.Shares.SaveShare(ctx, entities.NewShare(note, collaborator, input.Role))
+		if err != nil {
+			return err
+		}
+
+		event := entities.NewEvent(entities.EventTypeShared, note)
+		event.SetRecipient(collaborator)
+
+		return store.Events.SaveEvent(ctx, event)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return share, nil
+}
+
+type UnshareNoteInput struct {
+	Email  string
+	NoteID int64
+}
+
+func (use *UseCases) UnshareNote(ctx context.Context, user *entities.User, input *UnshareNoteInput) error {
+	mail, err := email.Parse(input.Email)
+	if err != nil {
+		return err
+	}
+
+	return use.uow.Do(ctx, func(store ports.Store) error {
+		note, err := use.accessible(ctx, store, user, input.NoteID, accessOwner)
+		if err != nil {
+			return err
+		}
+
+		collaborator, err := store.Shares.GetCollaborator(ctx, mail)
+		if err != nil {
+			return err
+		}
+
+		err = store.Shares.DeleteShare(ctx, entities.NewShare(note, collaborator, entities.RoleViewer))
+		if err != nil {
+			return err
+		}
+
+		event := entities.NewEvent(entities.EventTypeUnshared, note)
+		event.SetRecipient(collaborator)
+
+		return store.Events.SaveEvent(ctx, event)
+	})
+}
+
+type ListNoteSharesInput struct {
+	NoteID int64
+}
+
+// ListNoteShares returns the collaborators of the note, only its owner can see them.
+func (use *UseCases) ListNoteShares(
+	ctx context.Context,
+	user *entities.User,
+	input *ListNoteSharesInput,
+) ([]*entities.Share, error) {
+	note, err := use.accessible(ctx, use.store, user, input.NoteID, accessOwner)
+	if err != nil {
+		return nil, err
+	}
+
+	return use.store.Shares.GetSharesByNote(ctx, note)
+}
+
+type ListSharedNotesInput struct {
+	PageToken string
+	PageSize  int32
+}
+
+type ListSharedNotesOutput struct {
+	NextPageToken string
+	Shares        []*entities.Share
+	TotalSize     int32
+}
+
+// ListSharedNotes returns the notes other users shared with the user, most recently shared first.
+func (use *UseCases) ListSharedNotes(
+	ctx context.Context,
+	user *entities.User,
+	input *ListSharedNotesInput,
+) (*ListSharedNotesOutput, error) {
+	size := pageSize(input.PageSize)
+	query := fingerprint(user, "shared")
+
+	page, err := decodePageToken(input.PageToken, query)
+	if err != nil {
+		return nil, err
+	}
+
+	shares, err := use.store.Shares.GetSharesByUser(ctx, user, &ports.SharesQuery{
+		After: page.share(),
+		Limit: size + 1,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	total, err := use.store.Shares.CountSharesByUser(ctx, user)
+	if err != nil {
+		return nil, err
+	}
+
+	output := &ListSharedNotesOutput{Shares: shares, NextPageToken: "", TotalSize: total}
+
+	if len(shares) > int(size) {
+		output.Shares = shares[:size]
+		last := output.Shares[size-1]
+
+		output.NextPageToken, err = encodePageToken(&pageToken{
+			CreatedAt: last.CreatedAt,
+			UpdatedAt: last.Note.UpdatedAt,
+			DeletedAt: time.Time{},
+			Title:     last.Note.Title,
+			Query:     query,
+			ID:        last.Note.ID.Value(),
+			Rank:      0,
+			Revision:  0,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return output, nil
+}
+
+type CreateShareLinkInput struct {
+	// TTL is the lifetime of the link, zero makes the link never expire.
+	TTL    time.Duration
+	NoteID int64
+}
+
+func (use *UseCases) CreateShareLink(
+	ctx context.Context,
+	user *entities.User,
+	input *CreateShareLinkInput,
+) (*entities.Link, error) {
+	note, err := use.accessible(ctx, use.store, user, input.NoteID, accessOwner)
+	if err != nil {
+		return nil, err
+	}
+
+	return use.store.Links.SaveLink(ctx, entities.NewLink(note, input.TTL))
+}
+
+type RevokeShareLinkInput struct {
+	Token  string
+	NoteID int64
+}
+
+func (use *UseCases) RevokeShareLink(ctx context.Context, user *entities.User, input *RevokeShareLinkInput) error {
+	note, err := use.accessible(ctx, use.store, user, input.NoteID, accessOwner)
+	if err != nil {
+		return err
+	}
+
+	link := entities.NewLink(note, 0)
+	link.Token = input.Token
+
+	return use.store.Links.DeleteLink(ctx, link)
+}
+
+type ListShareLinksInput struct {
+	NoteID int64
+}
+
+func (use *UseCases) ListShareLinks(
+	ctx context.Context,
+	user *entities.User,
+	input *ListShareLinksInput,
+) ([]*entities.Link, error) {
+	note, err := use.accessible(ctx, use.store, user, input.NoteID, accessOwner)
+	if err != nil {
+		return nil, err
+	}
+
+	return use.store.Links.GetLinksByNote(ctx, note)
+}
+
+type GetPublicNoteInput struct {
+	Token string
+}
+
+// GetPublicNote returns the link together with its note to anyone knowing the token and counts the view.
+func (use *UseCases) GetPublicNote(ctx context.Context, input *GetPublicNoteInput) (*entities.Link, error) {
+	var link *entities.Link
+
+	err := use.uow.Do(ctx, func(store ports.Store) error {
+		var err error
+
+		link, err = store.Links.GetLink(ctx, input.Token)
+		if err != nil {
+			return err
+		}
+
+		if link.IsExpired() {
+			return ErrLinkNotFound
+		}
+
+		note, err := store.Notes.GetNote(ctx, link.Note.ID)
+		if err != nil {
+			return err
+		}
+
+		// a trashed note is hidden the same way as a revoked link, so the token does not leak anything
+		if note.IsTrashed() {
+			return ErrLinkNotFound
+		}
+
+		link.Note = note
+
+		return store.Links.CountView(ctx, link)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return link, nil
+}
+
+type CreateReminderInput struct {
+	FireAt time.Time
+	// Recurrence is the rule repeating the reminder, empty for reminders firing once.
+	Recurrence string
+	TimeZone   string
+	NoteID     int64
+}
+
+// CreateReminder reminds the user about the note, anyone able to read the note may set a reminder for themselves.
+func (use *UseCases) CreateReminder(
+	ctx context.Context,
+	user *entities.User,
+	input *CreateReminderInput,
+) (*entities.Reminder, error) {
+	note, err := use.accessible(ctx, use.store, user, input.NoteID, accessRead)
+	if err != nil {
+		return nil, err
+	}
+
+	reminder, err := entities.NewReminder(input.FireAt, input.Recurrence, input.TimeZone)
+	if err != nil {
+		return nil, err
+	}
+
+	reminder.SetNote(note)
+	reminder.SetUser(user)
+
+	return use.store.Reminders.SaveReminder(ctx, reminder)
+}
+
+type ListRemindersInput struct {
+	NoteID int64
+}
+
+func (use *UseCases) ListReminders(
+	ctx context.Context,
+	user *entities.User,
+	input *ListRemindersInput,
+) ([]*entities.Reminder, error) {
+	note, err := use.accessible(ctx, use.store, user, input.NoteID, accessRead)
+	if err != nil {
+		return nil, err
+	}
+
+	return use.store.Reminders.GetRemindersByNote(ctx, note, user)
+}
+
+type UpdateReminderInput struct {
+	FireAt     *time.Time
+	Recurrence *string
+	TimeZone   *string
+	ID         int64
+	NoteID     int64
+}
+
+// UpdateReminder reschedules the reminder, it starts over from the new or the current time of the reminder.
+func (use *UseCases) UpdateReminder(
+	ctx context.Context,
+	user *entities.User,
+	input *UpdateReminderInput,
+) (*entities.Reminder, error) {
+	if input.FireAt == nil && input.Recurrence == nil && input.TimeZone == nil {
+		return nil, ErrNothingToUpdate
+	}
+
+	reminder, err := use.reminder(ctx, user, input.NoteID, input.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	fireAt, recurrence, timeZone := reminder.FireAt, "", reminder.TimeZone
+
+	if reminder.Recurrence != nil {
+		recurrence = reminder.Recurrence.String()
+	}
+
+	if input.FireAt != nil {
+		fireAt = input.FireAt
+	}
+
+	if input.Recurrence != nil {
+		recurrence = *input.Recurrence
+	}
+
+	if input.TimeZone != nil {
+		timeZone = *input.TimeZone
+	}
+
+	// a finished reminder has no time to start over from
+	if fireAt == nil {
+		return nil, ErrReminderTimeRequired
+	}
+
+	err = reminder.Schedule(*fireAt, recurrence, timeZone)
+	if err != nil {
+		return nil, err
+	}
+
+	err = use.store.Reminders.UpdateReminder(ctx, reminder)
+	if err != nil {
+		return nil, err
+	}
+
+	return reminder, nil
+}
+
+type ReminderInput struct {
+	ID     int64
+	NoteID int64
+}
+
+func (use *UseCases) DeleteReminder(ctx context.Context, user *entities.User, input *ReminderInput) error {
+	reminder, err := use.reminder(ctx, user, input.NoteID, input.ID)
+	if err != nil {
+		return err
+	}
+
+	return use.store.Reminders.DeleteReminder(ctx, reminder)
+}
+
+type MoveNoteInput struct {
+	// NotebookID is zero to move the note to the root.
+	NotebookID int64
+	ID         int64
+	Version    int64
+}
+
+func (use *UseCases) MoveNote(ctx context.Context, user *entities.User, input *MoveNoteInput) (*entities.Note, error) {
+	var note *entities.Note
+
+	err := use.uow.Do(ctx, func(store ports.Store) error {
+		var (
+			notebook *entities.Notebook
+			err      error
+		)
+
+		note, err = use.accessible(ctx, store, user, input.ID, accessOwner)
+		if err != nil {
+			return err
+		}
+
+		err = use.match(note, input.Version)
+		if err != nil {
+			return err
+		}
+
+		if input.NotebookID != 0 {
+			notebook, err = use.notebook(ctx, store, user, input.NotebookID)
+			if err != nil {
+				return err
+			}
+		}
+
+		note.SetNotebook(notebook)
+
+		err = store.Notes.MoveNote(ctx, note)
+		if err != nil {
+			return err
+		}
+
+		event := entities.NewEvent(entities.EventTypeUpdated, note)
+
+		return store.Events.SaveEvent(ctx, event)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return note, nil
+}
+
+type CreateNotebookInput struct {
+	Name string
+	// ParentID is zero for notebooks created in the root.
+	ParentID int64
+}
+
+func (use *UseCases) CreateNotebook(
+	ctx context.Context,
+	user *entities.User,
+	input *CreateNotebookInput,
+) (*entities.Notebook, error) {
+	notebook, err := entities.NewNotebook(input.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	notebook.SetOwner(user)
+
+	err = use.uow.Do(ctx, func(store ports.Store) error {
+		if input.ParentID != 0 {
+			notebook.Parent, err = use.notebook(ctx, store, user, input.ParentID)
+			if err != nil {
+				return err
+			}
+		}
+
+		notebook, err = store.Notebooks.SaveNotebook(ctx, notebook)
+
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return notebook, nil
+}
+
+type GetNotebookInput struct {
+	ID int64
+}
+
+func (use *UseCases) GetNotebook(
+	ctx context.Context,
+	user *entities.User,
+	input *GetNotebookInput,
+) (*entities.Notebook, error) {
+	return use.notebook(ctx, use.store, user, input.ID)
+}
+
+func (use *UseCases) ListNotebooks(ctx context.Context, user *entities.User) ([]*entities.Notebook, error) {
+	return use.store.Notebooks.GetNotebooksByUser(ctx, user)
+}
+
+type UpdateNotebookInput struct {
+	Name *string
+	// ParentID moves the notebook, zero moves it to the root.
+	ParentID *int64
+	ID       int64
+}
+
+func (use *UseCases) UpdateNotebook(
+	ctx context.Context,
+	user *entities.User,
+	input *UpdateNotebookInput,
+) (*entities.Notebook, error) {
+	if input.Name == nil && input.ParentID == nil {
+		return nil, ErrNothingToUpdate
+	}
+
+	var notebook *entities.Notebook
+
+	err := use.uow.Do(ctx, func(store ports.Store) error {
+		var err error
+
+		notebook, err = use.notebook(ctx, store, user, input.ID)
+		if err != nil {
+			return err
+		}
+
+		if input.Name != nil {
+			err = notebook.SetName(*input.Name)
+			if err != nil {
+				return err
+			}
+		}
+
+		if input.ParentID != nil {
+			err = use.reparent(ctx, store, user, notebook, *input.ParentID)
+			if err != nil {
+				return err
+			}
+		}
+
+		return store.Notebooks.UpdateNotebook(ctx, notebook)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return notebook, nil
+}
+
+type DeleteMode int
+
+const (
+	// DeleteModeMoveToRoot keeps the content of the notebook, nested notebooks and notes are moved to the root.
+	DeleteModeMoveToRoot DeleteMode = iota
+	// DeleteModeCascade deletes nested notebooks at any depth and moves all their notes to the trash.
+	DeleteModeCascade
+)
+
+type DeleteNotebookInput struct {
+	ID   int64
+	Mode DeleteMode
+}
+
+func (use *UseCases) DeleteNotebook(ctx context.Context, user *entities.User, input *DeleteNotebookInput) error {
+	return use.uow.Do(ctx, func(store ports.Store) error {
+		notebook, err := use.notebook(ctx, store, user, input.ID)
+		if err != nil {
+			return err
+		}
+
+		if input.Mode == DeleteModeMoveToRoot {
+			err = store.Notebooks.DetachNotebook(ctx, notebook)
+			if err != nil {
+				return err
+			}
+
+			return store.Notebooks.DeleteNotebooks(ctx, []*entities.Notebook{notebook})
+		}
+
+		descendants, err := store.Notebooks.GetDescendants(ctx, notebook)
+		if err != nil {
+			return err
+		}
+
+		notebooks := append([]*entities.Notebook{notebook}, descendants...)
+
+		notes, err := store.Notebooks.TrashNotes(ctx, notebooks, time.Now())
+		if err != nil {
+			return err
+		}
+
+		for _, note := range notes {
+			if note.Owner == nil {
+				continue
+			}
+
+			err = store.Events.SaveEvent(ctx, entities.NewEvent(entities.EventTypeDeleted, note))
+			if err != nil {
+				return err
+			}
+		}
+
+		return store.Notebooks.DeleteNotebooks(ctx, notebooks)
+	})
+}
+
+type CreateTemplateInput struct {
+	Name    string
+	Title   string
+	Content string
+}
+
+func (use *UseCases) CreateTemplate(
+	ctx context.Context,
+	user *entities.User,
+	input *CreateTemplateInput,
+) (*entities.Template, error) {
+	template, err := entities.NewTemplate(input.Name, input.Title, input.Content)
+	if err != nil {
+		return nil, err
+	}
+
+	template.SetOwner(user)
+
+	return use.store.Templates.SaveTemplate(ctx, template)
+}
+
+type TemplateInput struct {
+	ID int64
+}
+
+func (use *UseCases) GetTemplate(
+	ctx context.Context,
+	user *entities.User,
+	input *TemplateInput,
+) (*entities.Template, error) {
+	return use.template(ctx, use.store, user, input.ID)
+}
+
+func (use *UseCases) ListTemplates(ctx context.Context, user *entities.User) ([]*entities.Template, error) {
+	return use.store.Templates.GetTemplatesByUser(ctx, user)
+}
+
+type UpdateTemplateInput struct {
+	Name    *string
+	Title   *string
+	Content *string
+	ID      int64
+}
+
+func (use *UseCases) UpdateTemplate(
+	ctx context.Context,
+	user *entities.User,
+	input *UpdateTemplateInput,
+) (*entities.Template, error) {
+	if input.Name == nil && input.Title == nil && input.Content == nil {
+		return nil, ErrNothingToUpdate
+	}
+
+	var template *entities.Template
+
+	err := use.uow.Do(ctx, func(store ports.Store) error {
+		var err error
+
+		template, err = use.template(ctx, store, user, input.ID)
+		if err != nil {
+			return err
+		}
+
+		if input.Name != nil {
+			err = template.SetName(*input.Name)
+			if err != nil {
+				return err
+			}
+		}
+
+		if input.Title != nil {
+			err = template.SetTitle(*input.Title)
+			if err != nil {
+				return err
+			}
+		}
+
+		if input.Content != nil {
+			err = template.SetContent(*input.Content)
+			if err != nil {
+				return err
+			}
+		}
+
+		return store.Templates.UpdateTemplate(ctx, template)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return template, nil
+}
+
+func (use *UseCases) DeleteTemplate(ctx context.Context, user *entities.User, input *TemplateInput) error {
+	return use.uow.Do(ctx, func(store ports.Store) error {
+		template, err := use.template(ctx, store, user, input.ID)
+		if err != nil {
+			return err
+		}
+
+		return store.Templates.DeleteTemplate(ctx, template)
+	})
+}
+
+type ListLinksInput struct {
+	NoteID int64
+}
+
+// ListBacklinks returns the notes referring to the note by its id or title, most recently updated first.
+func (use *UseCases) ListBacklinks(
+	ctx context.Context,
+	user *entities.User,
+	input *ListLinksInput,
+) ([]*entities.Note, error) {
+	note, err := use.accessible(ctx, use.store, user, input.NoteID, accessOwner)
+	if err != nil {
+		return nil, err
+	}
+
+	return use.store.References.GetBacklinks(ctx, note)
+}
+
+// ListOutgoingLinks returns the references of the note in the order they appear, dangling ones included.
+func (use *UseCases) ListOutgoingLinks(
+	ctx context.Context,
+	user *entities.User,
+	input *ListLinksInput,
+) ([]*entities.Reference, error) {
+	note, err := use.accessible(ctx, use.store, user, input.NoteID, accessOwner)
+	if err != nil {
+		return nil, err
+	}
+
+	return use.store.References.GetReferences(ctx, note)
+}
+
+// ListDanglingLinks returns the references of the notes of the user to the notes that do not exist or are in the trash.
+func (use *UseCases) ListDanglingLinks(ctx context.Context, user *entities.User) ([]*entities.Reference, error) {
+	return use.store.References.GetDanglingReferences(ctx, user)
+}
+
+// BatchResult is the outcome of a single item of a batch operation, Err is set when the item failed.
+type BatchResult struct {
+	Note *entities.Note
+	Err  error
+}
+
+// BatchCreateNotes creates all notes in one transaction, the notes that fail
+// domain rules are reported in their results without affecting the others.
+func (use *UseCases) BatchCreateNotes(
+	ctx context.Context,
+	user *entities.User,
+	inputs []*CreateNoteInput,
+) ([]*BatchResult, error) {
+	err := use.batch(len(inputs))
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]*BatchResult, len(inputs))
+
+	err = use.uow.Do(ctx, func(store ports.Store) error {
+		events := make([]*entities.Event, 0, len(inputs))
+
+		for i, input := range inputs {
+			note, err := use.newNote(ctx, store, user, input)
+			if err == nil {
+				note, err = use.save(ctx, store, user, note, input.NotebookID)
+			}
+
+			results[i], err = batchResult(note, err)
+			if err != nil {
+				return err
+			}
+
+			if results[i].Err == nil {
+				events = append(events, entities.NewEvent(entities.EventTypeCreated, note))
+			}
+		}
+
+		return store.Events.SaveEvents(ctx, events)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
+
+// BatchDeleteNotes moves all notes to the trash in one transaction, the notes that cannot be deleted are reported
+// in their results without affecting the others. Every note needs its version, the notes without one or of another
+// version are not deleted.
+func (use *UseCases) BatchDeleteNotes(
+	ctx context.Context,
+	user *entities.User,
+	inputs []*DeleteNoteInput,
+) ([]*BatchResult, error) {
+	err := use.batch(len(inputs))
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]*BatchResult, len(inputs))
+
+	err = use.uow.Do(ctx, func(store ports.Store) error {
+		events := make([]*entities.Event, 0, len(inputs))
+
+		for i, input := range inputs {
+			note, err := use.accessible(ctx, store, user, input.ID, accessOwner)
+			if err == nil {
+				err = use.match(note, input.Version)
+			}
+
+			if err == nil {
+				note.Trash()
+				err = store.Notes.TrashNote(ctx, note)
+			}
+
+			results[i], err = batchResult(note, err)
+			if err != nil {
+				return err
+			}
+
+			if results[i].Err == nil {
+				events = append(events, entities.NewEvent(entities.EventTypeDeleted, note))
+			}
+		}
+
+		return store.Events.SaveEvents(ctx, events)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
+
+// BatchGetNotes returns all notes read in one transaction, the notes that
+// cannot be read are reported in their results.
+func (use *UseCases) BatchGetNotes(ctx context.Context, user *entities.User, ids []int64) ([]*BatchResult, error) {
+	err := use.batch(len(ids))
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]*BatchResult, len(ids))
+
+	err = use.uow.Do(ctx, func(store ports.Store) error {
+		for i, noteID := range ids {
+			note, err := use.accessible(ctx, store, user, noteID, accessRead)
+
+			results[i], err = batchResult(note, err)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
+
+// ExportNotes passes every note of the user to the export, oldest first, reading the notes page by page.
+func (use *UseCases) ExportNotes(
+	ctx context.Context,
+	user *entities.User,
+	export func(note *entities.Note) error,
+) error {
+	// the archive keeps every note, the archived ones are hidden by the listings only
+	query := &ports.NotesQuery{
+		After:  nil,
+		Filter: ports.NotesFilter{Archived: ports.ArchivedIncluded},
+		Order:  ports.NotesOrder{Field: ports.SortByCreatedAt, Descending: false},
+		Limit:  exportPageSize,
+	}
+
+	for {
+		notes, err := use.store.Notes.GetNotesByUser(ctx, user, query)
+		if err != nil {
+			return err
+		}
+
+		for _, note := range notes {
+			err = export(note)
+			if err != nil {
+				return err
+			}
+		}
+
+		if len(notes) < int(query.Limit) {
+			return nil
+		}
+
+		query.After = notes[len(notes)-1]
+	}
+}
+
+type ImportNoteInput struct {
+	// CreatedAt and UpdatedAt come from the archive, zero values are replaced by the time of the import.
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	Title     string
+	Content   string
+	Tags      []string
+}
+
+// ImportResult is the outcome of a single note of an import, Err is set when the note failed.
+type ImportResult struct {
+	Note *entities.Note
+	Err  error
+	// Duplicate is set when the note was skipped, Note is then the existing note with the same title and content.
+	Duplicate bool
+}
+
+// ImportNotes creates the notes in transactions of the batch limit each, so a large import does not hold its locks
+// for long. The notes already present or repeated within the import are skipped, the notes that fail domain rules
+// are reported in their results. The chunks imported before a failure stay, importing again skips them.
+func (use *UseCases) ImportNotes(
+	ctx context.Context,
+	user *entities.User,
+	inputs []*ImportNoteInput,
+) ([]*ImportResult, error) {
+	results := make([]*ImportResult, 0, len(inputs))
+
+	for chunk := range slices.Chunk(inputs, use.batchLimit) {
+		err := use.uow.Do(ctx, func(store ports.Store) error {
+			imported := make([]*ImportResult, len(chunk))
+			events := make([]*entities.Event, 0, len(chunk))
+
+			for i, input := range chunk {
+				result, err := use.importNote(ctx, store, user, input)
+				if err != nil {
+					return err
+				}
+
+				imported[i] = result
+
+				if result.Err == nil && !result.Duplicate {
+					events = append(events, entities.NewEvent(entities.EventTypeCreated, result.Note))
+				}
+			}
+
+			err := store.Events.SaveEvents(ctx, events)
+			if err != nil {
+				return err
+			}
+
+			results = append(results, imported...)
+
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return results, nil
+}
+
+type UploadAttachmentInput struct {
+	Content     io.Reader
+	Name        string
+	ContentType string
+	// Checksum is the hex encoded SHA-256 the client expects, the content is not verified when it is empty.
+	Checksum string
+	NoteID   int64
+}
+
+// UploadAttachment stores the content in the blob storage and attaches it to the note,
+// the content is read until its end or until it grows beyond the attachment limit.
+func (use *UseCases) UploadAttachment(
+	ctx context.Context,
+	user *entities.User,
+	input *UploadAttachmentInput,
+) (*entities.Attachment, error) {
+	note, err := use.accessible(ctx, use.store, user, input.NoteID, accessWrite)
+	if err != nil {
+		return nil, err
+	}
+
+	attachment, err := entities.NewAttachment(note, input.Name, input.ContentType)
+	if err != nil {
+		return nil, err
+	}
+
+	attachment.SetOwner(user)
+
+	content := &digest{reader: input.Content, hash: sha256.New(), size: 0, limit: use.attachmentLimit}
+
+	err = use.store.Blobs.Put(ctx, attachment.Key, content)
+
+	switch {
+	case content.size > content.limit:
+		return nil, use.drop(ctx, attachment, ErrAttachmentTooLarge)
+	case err != nil:
+		return nil, use.drop(ctx, attachment, err)
+	}
+
+	attachment.Seal(content.size, content.sum())
+
+	if input.Checksum != "" && !strings.EqualFold(input.Checksum, attachment.Checksum) {
+		return nil, use.drop(ctx, attachment, ErrChecksumMismatch)
+	}
+
+	saved, err := use.store.Attachments.SaveAttachment(ctx, attachment)
+	if err != nil {
+		return nil, use.drop(ctx, attachment, err)
+	}
+
+	return saved, nil
+}
+
+type AttachmentInput struct {
+	ID int64
+}
+
+// DownloadAttachment returns the attachment with its content, reading the content
+// fails with ErrAttachmentCorrupted when it does not match the checksum.
+func (use *UseCases) DownloadAttachment(
+	ctx context.Context,
+	user *entities.User,
+	input *AttachmentInput,
+) (*entities.Attachment, io.ReadCloser, error) {
+	attachment, err := use.attachment(ctx, use.store, user, input.ID, accessRead)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	content, err := use.store.Blobs.Get(ctx, attachment.Key)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return attachment, &verified{
+		ReadCloser: content,
+		digest:     digest{reader: content, hash: sha256.New(), size: 0, limit: attachment.Size},
+		checksum:   attachment.Checksum,
+	}, nil
+}
+
+type ListAttachmentsInput struct {
+	NoteID int64
+}
+
+func (use *UseCases) ListAttachments(
+	ctx context.Context,
+	user *entities.User,
+	input *ListAttachmentsInput,
+) ([]*entities.Attachment, error) {
+	note, err := use.accessible(ctx, use.store, user, input.NoteID, accessRead)
+	if err != nil {
+		return nil, err
+	}
+
+	return use.store.Attachments.GetAttachmentsByNote(ctx, note)
+}
+
+func (use *UseCases) DeleteAttachment(ctx context.Context, user *entities.User, input *AttachmentInput) error {
+	return use.uow.Do(ctx, func(store ports.Store) error {
+		attachment, err := use.attachment(ctx, store, user, input.ID, accessWrite)
+		if err != nil {
+			return err
+		}
+
+		return store.Attachments.DeleteAttachment(ctx, attachment)
+	})
+}
+
+// SweepBlobs deletes a batch of up to the limit of blobs buried with their attachments, the blobs that failed
+// to delete stay buried until the next sweep. It returns the number of deleted blobs.
+func (use *UseCases) SweepBlobs(ctx context.Context, limit int) (int, error) {
+	var (
+		swept   int
+		failure error
+	)
+
+	err := use.uow.Do(ctx, func(store ports.Store) error {
+		keys, err := store.Attachments.LockBuriedBlobs(ctx, limit)
+		if err != nil || len(keys) == 0 {
+			return err
+		}
+
+		for _, key := range keys {
+			failure = store.Blobs.Delete(ctx, key)
+			if failure != nil {
+				break
+			}
+
+			swept++
+		}
+
+		if swept == 0 {
+			return nil
+		}
+
+		return store.Attachments.ForgetBuriedBlobs(ctx, keys[:swept])
+	})
+
+	switch {
+	case err != nil:
+		return 0, err
+	case failure != nil:
+		return swept, failure
+	}
+
+	return swept, nil
+}
+
+// RelayEvents publishes a batch of up to the limit of events from the outbox, the events that failed to publish
+// stay in the outbox and are postponed. It returns the number of published events.
+func (use *UseCases) RelayEvents(ctx context.Context, limit int) (int, error) {
+	var (
+		published int
+		failure   error
+	)
+
+	err := use.uow.Do(ctx, func(store ports.Store) error {
+		events, err := store.Events.LockEvents(ctx, limit)
+		if err != nil || len(events) == 0 {
+			return err
+		}
+
+		failure = store.Stream.Publish(ctx, events)
+		if failure != nil {
+			return store.Events.PostponeEvents(ctx, events, failure.Error(), relayBackoff, relayMaxBackoff)
+		}
+
+		published = len(events)
+
+		return store.Events.DeleteEvents(ctx, events)
+	})
+
+	switch {
+	case err != nil:
+		return 0, err
+	case failure != nil:
+		return 0, failure
+	}
+
+	return published, nil
+}
+
+// FireReminders fires a batch of up to the limit of due reminders, the reminders are saved as events
+// in the transaction that moves them to their next occurrence. Reminders of users who lost access to the note
+// are deleted and reminders of trashed notes pass silently. It returns the number of fired reminders.
+func (use *UseCases) FireReminders(ctx context.Context, limit int) (int, error) {
+	var fired int
+
+	err := use.uow.Do(ctx, func(store ports.Store) error {
+		reminders, err := store.Reminders.LockDueReminders(ctx, limit)
+		if err != nil || len(reminders) == 0 {
+			return err
+		}
+
+		now := time.Now()
+		events := make([]*entities.Event, 0, len(reminders))
+
+		for _, reminder := range reminders {
+			note, err := store.Notes.GetNote(ctx, reminder.Note.ID)
+			if err == nil {
+				err = use.permit(ctx, store, reminder.User, note, accessRead)
+			}
+
+			switch {
+			case errors.Is(err, ErrNoteNotFound) || errors.Is(err, ErrPermissionDenied):
+				err = store.Reminders.DeleteReminder(ctx, reminder)
+				if err != nil {
+					return err
+				}
+
+				continue
+			case err != nil:
+				return err
+			}
+
+			reminder.SetNote(note)
+
+			event := reminder.Fire(now)
+			if !note.IsTrashed() {
+				events = append(events, event)
+			}
+
+			err = store.Reminders.UpdateReminder(ctx, reminder)
+			if err != nil {
+				return err
+			}
+		}
+
+		fired = len(events)
+		if fired == 0 {
+			return nil
+		}
+
+		return store.Events.SaveEvents(ctx, events)
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return fired, nil
+}
+
+// EventsBacklog returns the number of events waiting in the outbox and for how long the oldest of them waits.
+func (use *UseCases) EventsBacklog(ctx context.Context) (int64, time.Duration, error) {
+	events, oldest, err := use.store.Events.Backlog(ctx)
+	if err != nil || events == 0 {
+		return 0, 0, err
+	}
+
+	return events, time.Since(oldest), nil
+}
+
+type SubscribeInput struct {
+	Device string
+	// Position is the last event the device has seen, the stream continues right after it.
+	Position string
+	Filter   ports.EventsFilter
+}
+
+func (use *UseCases) Subscribe(
+	ctx context.Context,
+	user *entities.User,
+	input *SubscribeInput,
+) (*entities.Subscription, error) {
+	sub := entities.NewSubscription(user, input.Device)
+
+	// the events skipped by the filter are acknowledged, so a filtered stream has its own position
+	if filter := input.Filter; len(filter.Types) > 0 || len(filter.NoteIDs) > 0 || len(filter.Tags) > 0 {
+		sub.Filter = fingerprint(user, filter)
+	}
+
+	err := use.store.Stream.Subscribe(ctx, sub, input.Position)
+	if err != nil {
+		return nil, err
+	}
+
+	return sub, nil
+}
+
+func (use *UseCases) UnreadEvents(
+	ctx context.Context,
+	user *entities.User,
+	filter *ports.EventsFilter,
+) (int32, error) {
+	return use.store.Events.CountUnreadEvents(ctx, user, filter)
+}
+
+// WaitNextEvents returns the next events of the device matching the filter as soon as they are published,
+// ErrZeroEvents tells that nothing happened during the timeout. The events that do not match the filter
+// are acknowledged, the subscription keeps its own position for the filter, so they are not lost for the device.
+func (use *UseCases) WaitNextEvents(
+	ctx context.Context,
+	sub *entities.Subscription,
+	filter *ports.EventsFilter,
+	timeout time.Duration,
+) ([]*entities.Event, error) {
+	deadline := time.Now().Add(timeout)
+
+	for {
+		// a stream waits forever without a timeout, so the rest of the time is never let go down to zero
+		left := time.Until(deadline)
+		if left < time.Millisecond {
+			return nil, ErrZeroEvents
+		}
+
+		events, err := use.store.Stream.WaitEvents(ctx, sub, eventsBatchSize, left)
+		if err != nil {
+			return nil, err
+		}
+
+		found, skipped, err := use.matching(ctx, filter, events)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, event := range skipped {
+			err = use.store.Stream.AckEvent(ctx, sub, event)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		if len(found) > 0 {
+			return found, nil
+		}
+	}
+}
+
+// AckEvent marks the event as delivered to the device, so it is not sent again.
+func (use *UseCases) AckEvent(ctx context.Context, sub *entities.Subscription, event *entities.Event) error {
+	return use.store.Stream.AckEvent(ctx, sub, event)
+}
+
+type ListEventsInput struct {
+	PageToken string
+	Filter    ports.EventsFilter
+	PageSize  int32
+}
+
+type ListEventsOutput struct {
+	NextPageToken string
+	Events        []*entities.Event
+	Unread        int32
+}
+
+func (use *UseCases) ListEvents(
+	ctx context.Context,
+	user *entities.User,
+	input *ListEventsInput,
+) (*ListEventsOutput, error) {
+	size := pageSize(input.PageSize)
+	query := fingerprint(user, input.Filter)
+
+	page, err := decodePageToken(input.PageToken, query)
+	if err != nil {
+		return nil, err
+	}
+
+	events, err := use.store.Events.GetEventsByUser(ctx, user, &ports.EventsQuery{
+		After:  page.event(),
+		Filter: input.Filter,
+		Limit:  size + 1,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	unread, err := use.store.Events.CountUnreadEvents(ctx, user, &input.Filter)
+	if err != nil {
+		return nil, err
+	}
+
+	output := &ListEventsOutput{Events: events, NextPageToken: "", Unread: unread}
+
+	if len(events) > int(size) {
+		output.Events = events[:size]
+		last := output.Events[size-1]
+
+		output.NextPageToken, err = encodePageToken(&pageToken{
+			CreatedAt: last.EventTime,
+			UpdatedAt: time.Time{},
+			DeletedAt: time.Time{},
+			Title:     "",
+			Query:     query,
+			Event:     last.ID.Value(),
+			ID:        0,
+			Rank:      0,
+			Revision:  0,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return output, nil
+}
+
+type MarkEventsReadInput struct {
+	// UpTo marks all events that occurred up to the time as read.
+	UpTo *time.Time
+	IDs  []string
+}
+
+type MarkEventsReadOutput struct {
+	Marked int32
+	Unread int32
+}
+
+func (use *UseCases) MarkEventsRead(
+	ctx context.Context,
+	user *entities.User,
+	input *MarkEventsReadInput,
+) (*MarkEventsReadOutput, error) {
+	if len(input.IDs) == 0 && input.UpTo == nil {
+		return nil, ErrNothingToUpdate
+	}
+
+	ids := make([]uuid.UUID, len(input.IDs))
+
+	for i, raw := range input.IDs {
+		ident, err := uuid.Parse(raw)
+		if err != nil {
+			return nil, ErrInvalidEventID
+		}
+
+		ids[i] = ident
+	}
+
+	marked, err := use.store.Events.MarkEventsRead(ctx, user, ids, input.UpTo)
+	if err != nil {
+		return nil, err
+	}
+
+	unread, err := use.store.Events.CountUnreadEvents(ctx, user, new(ports.EventsFilter))
+	if err != nil {
+		return nil, err
+	}
+
+	return &MarkEventsReadOutput{Marked: marked, Unread: unread}, nil
+}
+
+type JoinEditInput struct {
+	NoteID int64
+}
+
+type JoinEditOutput struct {
+	Editor *entities.Editor
+	Draft  *entities.Draft
+}
+
+// JoinEdit starts editing the note together with its other editors, the collaborators allowed only to read
+// the note join as well and follow the edits.
+func (use *UseCases) JoinEdit(ctx context.Context, user *entities.User, input *JoinEditInput) (*JoinEditOutput, error) {
+	note, err := use.accessible(ctx, use.store, user, input.NoteID, accessRead)
+	if err != nil {
+		return nil, err
+	}
+
+	canWrite := true
+	err = use.permit(ctx, use.store, user, note, accessWrite)
+
+	switch {
+	case errors.Is(err, ErrPermissionDenied):
+		canWrite = false
+	case err != nil:
+		return nil, err
+	}
+
+	editor := entities.NewEditor(user, note, canWrite)
+
+	for attempt := 1; ; attempt++ {
+		draft, err := use.open(ctx, editor)
+
+		switch {
+		case errors.Is(err, ErrDraftChanged) && attempt < editAttempts:
+			continue
+		case err != nil:
+			return nil, err
+		}
+
+		editor.Join(draft)
+
+		return &JoinEditOutput{Editor: editor, Draft: draft}, nil
+	}
+}
+
+type ApplyEditInput struct {
+	Operation ot.Operation
+	// Revision is the revision of the draft the operation is based on.
+	Revision int64
+}
+
+// ApplyEdit transforms the operation against the edits applied to the draft since its revision and applies it.
+// The edit is sent to all editors of the note, the author included, it acknowledges the edit in order with the rest.
+func (use *UseCases) ApplyEdit(
+	ctx context.Context,
+	editor *entities.Editor,
+	input *ApplyEditInput,
+) (*entities.Edit, error) {
+	if !editor.CanWrite {
+		return nil, ErrPermissionDenied
+	}
+
+	err := input.Operation.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	for attempt := 1; ; attempt++ {
+		edit, err := use.edit(ctx, editor, input)
+		if !errors.Is(err, ErrDraftChanged) || attempt == editAttempts {
+			return edit, err
+		}
+	}
+}
+
+type MoveCursorInput struct {
+	Selection entities.Selection
+	// Revision is the revision of the draft the selection is in.
+	Revision int64
+}
+
+// MoveCursor shows the cursor of the editor to the other editors, it is moved along the edits applied since its
+// revision, so it points to the same text in the latest revision.
+func (use *UseCases) MoveCursor(ctx context.Context, editor *entities.Editor, input *MoveCursorInput) error {
+	draft, err := use.store.Drafts.GetDraft(ctx, editor.Note)
+	if err != nil {
+		return err
+	}
+
+	err = use.revise(draft, input.Revision)
+	if err != nil {
+		return err
+	}
+
+	edits, err := use.store.Drafts.GetEdits(ctx, draft, input.Revision)
+	if err != nil {
+		return err
+	}
+
+	selection := input.Selection
+	for _, edit := range edits {
+		selection.Transform(edit.Operation)
+	}
+
+	return use.store.Drafts.SendCursor(ctx, editor.Note, editor.Move(selection, draft.Revision))
+}
+
+// WaitEdits returns the edits the editor has not seen yet as soon as they are made, ErrZeroEdits tells that nobody
+// edited the note during the timeout.
+func (use *UseCases) WaitEdits(
+	ctx context.Context,
+	editor *entities.Editor,
+	timeout time.Duration,
+) ([]*entities.Edit, error) {
+	edits, err := use.store.Drafts.WaitEdits(ctx, editor.Note, editor.Position, timeout)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(edits) > 0 {
+		editor.Follow(edits[len(edits)-1])
+	}
+
+	return edits, nil
+}
+
+// SaveDraft checkpoints the draft into the note as a new revision of it, the followers of the note are notified
+// as about any other update. Editors save the draft one after another, the draft already saved is skipped.
+// The draft of the note changed elsewhere is not saved, it is reset to the note instead.
+func (use *UseCases) SaveDraft(ctx context.Context, editor *entities.Editor) error {
+	if !editor.CanWrite {
+		return nil
+	}
+
+	draft, err := use.store.Drafts.GetDraft(ctx, editor.Note)
+	if err != nil {
+		return err
+	}
+
+	// notes never have empty content, the draft waits for some text to be saved
+	if draft.IsSaved() || draft.Content == "" {
+		return nil
+	}
+
+	var stale *entities.Draft
+
+	err = use.uow.Do(ctx, func(store ports.Store) error {
+		note, err := use.accessible(ctx, store, editor.User, editor.Note.ID.Value(), accessWrite)
+		if err != nil {
+			return err
+		}
+
+		// the draft is read again under the lock, so the saves of other editors are either not begun or marked
+		err = store.Notes.LockNote(ctx, note)
+		if err != nil {
+			return err
+		}
+
+		draft, err := store.Drafts.GetDraft(ctx, note)
+
+		switch {
+		case err != nil:
+			return err
+		case draft.IsStale():
+			stale = draft
+
+			return nil
+		case draft.IsSaved() || draft.Content == "":
+			return nil
+		case note.Content == draft.Content:
+			return store.Drafts.MarkSaved(ctx, draft)
+		}
+
+		previous := note.Content
+
+		err = note.SetContent(draft.Content)
+		if err != nil {
+			return err
+		}
+
+		err = store.Notes.UpdateNote(ctx, note)
+		if err != nil {
+			return err
+		}
+
+		err = use.relink(ctx, store, note, previous)
+		if err != nil {
+			return err
+		}
+
+		_, err = store.Revisions.SaveRevision(ctx, entities.NewRevision(note, editor.User))
+		if err != nil {
+			return err
+		}
+
+		event := entities.NewEvent(entities.EventTypeUpdated, note)
+
+		err = store.Events.SaveEvent(ctx, event)
+		if err != nil {
+			return err
+		}
+
+		draft.Version = note.Version
+
+		return store.Drafts.MarkSaved(ctx, draft)
+	})
+
+	switch {
+	case errors.Is(err, ErrVersionMismatch):
+		// the note changed right before the lock, the next save finds the draft stale
+		return nil
+	case err != nil:
+		return err
+	case stale == nil:
+		return nil
+	}
+
+	err = use.reset(ctx, editor, stale)
+	if errors.Is(err, ErrDraftChanged) {
+		// another editor reset the draft first
+		return nil
+	}
+
+	return err
+}
+
+// matching splits the events into the ones matching the filter and the rest, the tags of all the notes are loaded
+// at once.
+func (use *UseCases) matching(
+	ctx context.Context,
+	filter *ports.EventsFilter,
+	events []*entities.Event,
+) ([]*entities.Event, []*entities.Event, error) {
+	found := make([]*entities.Event, 0, len(events))
+	skipped := make([]*entities.Event, 0, len(events))
+
+	for _, event := range events {
+		switch {
+		case len(filter.Types) > 0 && !slices.Contains(filter.Types, event.EventType):
+			skipped = append(skipped, event)
+		case len(filter.NoteIDs) > 0 && !slices.Contains(filter.NoteIDs, event.Note.ID.Value()):
+			skipped = append(skipped, event)
+		default:
+			found = append(found, event)
+		}
+	}
+
+	if len(filter.Tags) == 0 || len(found) == 0 {
+		return found, skipped, nil
+	}
+
+	notes := make(map[int64]*entities.Note, len(found))
+	for _, event := range found {
+		notes[event.Note.ID.Value()] = &entities.Note{ID: event.Note.ID}
+	}
+
+	// a note that is gone has no tags, so its events are skipped
+	err := use.store.Tags.LoadNoteTags(ctx, slices.Collect(maps.Values(notes)))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	tagged := found[:0]
+
+	for _, event := range found {
+		if slices.ContainsFunc(notes[event.Note.ID.Value()].Tags, func(tag *entities.Tag) bool {
+			return slices.Contains(filter.Tags, tag.Name)
+		}) {
+			tagged = append(tagged, event)
+		} else {
+			skipped = append(skipped, event)
+		}
+	}
+
+	return tagged, skipped, nil
+}
+
+func (use *UseCases) update(note *entities.Note, input *UpdateNoteInput) error {
+	if input.Title != nil {
+		err := note.SetTitle(*input.Title)
+		if err != nil {
+			return err
+		}
+	}
+
+	if input.Content != nil {
+		err := note.SetContent(*input.Content)
+		if err != nil {
+			return err
+		}
+	}
+
+	if input.Pinned != nil {
+		note.SetPinned(*input.Pinned)
+	}
+
+	if input.Archived != nil {
+		note.SetArchived(*input.Archived)
+	}
+
+	if input.Starred != nil {
+		note.SetStarred(*input.Starred)
+	}
+
+	return nil
+}
+
+func (use *UseCases) accessible(
+	ctx context.Context,
+	store ports.Store,
+	user *entities.User,
+	noteID int64,
+	level access,
+) (*entities.Note, error) {
+	ident, err := id.Conv(noteID)
+	if err != nil {
+		return nil, err
+	}
+
+	note, err := store.Notes.GetNote(ctx, ident)
+	if err != nil {
+		return nil, err
+	}
+
+	err = use.permit(ctx, store, user, note, level)
+	if err != nil {
+		return nil, err
+	}
+
+	err = use.alive(note)
+	if err != nil {
+		return nil, err
+	}
+
+	return note, nil
+}
+
+// save places the new note into the notebook and stores it together with its first revision.
+func (use *UseCases) save(
+	ctx context.Context,
+	store ports.Store,
+	user *entities.User,
+	note *entities.Note,
+	notebookID int64,
+) (*entities.Note, error) {
+	var err error
+
+	if notebookID != 0 {
+		note.Notebook, err = use.notebook(ctx, store, user, notebookID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	note, err = store.Notes.SaveNote(ctx, note)
+	if err != nil {
+		return nil, err
+	}
+
+	err = use.relink(ctx, store, note, "")
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = store.Revisions.SaveRevision(ctx, entities.NewRevision(note, user))
+	if err != nil {
+		return nil, err
+	}
+
+	return note, nil
+}
+
+// relink replaces the references of the note when the wiki links of its content differ from the previous content.
+func (use *UseCases) relink(ctx context.Context, store ports.Store, note *entities.Note, previous string) error {
+	if slices.Equal(wikilink.Parse(previous), wikilink.Parse(note.Content)) {
+		return nil
+	}
+
+	return store.References.SaveReferences(ctx, note, entities.NewReferences(note))
+}
+
+// render replaces the contents of the notes with their renders in the format, only the notes missing in the cache
+// are rendered and cached for the next time.
+func (use *UseCases) render(ctx context.Context, notes []*entities.Note, format entities.Format) error {
+	if format.IsRaw() || len(notes) == 0 {
+		return nil
+	}
+
+	cached, err := use.store.Renders.GetRenders(ctx, notes, format)
+	if err != nil {
+		return err
+	}
+
+	contents := make(map[int64]string, len(cached))
+
+	for _, render := range cached {
+		contents[render.Note.ID.Value()] = render.Content
+	}
+
+	missing := make([]*entities.Render, 0, len(notes)-len(cached))
+
+	for _, note := range notes {
+		content, ok := contents[note.ID.Value()]
+		if !ok {
+			content, err = use.store.Renderer.Render(note.Content, format)
+			if err != nil {
+				return err
+			}
+
+			missing = append(missing, entities.NewRender(note, format, content))
+		}
+
+		note.Content = content
+	}
+
+	if len(missing) == 0 {
+		return nil
+	}
+
+	return use.store.Renders.SaveRenders(ctx, missing)
+}
+
+// importNote keeps domain errors in the result of the note, any other error aborts the whole import.
+func (use *UseCases) importNote(
+	ctx context.Context,
+	store ports.Store,
+	user *entities.User,
+	input *ImportNoteInput,
+) (*ImportResult, error) {
+	note, err := entities.NewNote(input.Title, input.Content)
+	if err != nil {
+		return importResult(nil, err)
+	}
+
+	tags, err := newTags(user, input.Tags)
+	if err != nil {
+		return importResult(nil, err)
+	}
+
+	existing, err := store.Notes.GetNoteByContent(ctx, user, note.Title, note.Content)
+
+	switch {
+	case err == nil:
+		return &ImportResult{Note: existing, Err: nil, Duplicate: true}, nil
+	case !errors.Is(err, ErrNoteNotFound):
+		return nil, err
+	}
+
+	note.SetOwner(user)
+	note.Backdate(input.CreatedAt, input.UpdatedAt)
+
+	note, err = use.save(ctx, store, user, note, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	err = store.Tags.AddNoteTags(ctx, note, tags)
+	if err != nil {
+		return nil, err
+	}
+
+	note.Tags = tags
+
+	return importResult(note, nil)
+}
+
+func importResult(note *entities.Note, err error) (*ImportResult, error) {
+	result, err := batchResult(note, err)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ImportResult{Note: result.Note, Err: result.Err, Duplicate: false}, nil
+}
+
+// newTags builds the tags of the user, repeated names are kept once.
+func newTags(user *entities.User, names []string) ([]*entities.Tag, error) {
+	tags := make([]*entities.Tag, 0, len(names))
+	seen := make(map[string]bool, len(names))
+
+	for _, name := range names {
+		tag, err := entities.NewTag(name)
+		if err != nil {
+			return nil, err
+		}
+
+		if seen[tag.Name] {
+			continue
+		}
+
+		seen[tag.Name] = true
+
+		tag.SetOwner(user)
+
+		tags = append(tags, tag)
+	}
+
+	return tags, nil
+}
+
+// attachment returns the attachment of a note the user has the level of access to.
+func (use *UseCases) attachment(
+	ctx context.Context,
+	store ports.Store,
+	user *entities.User,
+	attachmentID int64,
+	level access,
+) (*entities.Attachment, error) {
+	ident, err := id.Conv(attachmentID)
+	if err != nil {
+		return nil, err
+	}
+
+	attachment, err := store.Attachments.GetAttachment(ctx, ident)
+	if err != nil {
+		return nil, err
+	}
+
+	attachment.Note, err = use.accessible(ctx, store, user, attachment.Note.ID.Value(), level)
+	if err != nil {
+		return nil, err
+	}
+
+	return attachment, nil
+}
+
+// drop removes the blob of the attachment that failed to upload and returns the reason of the failure.
+func (use *UseCases) drop(ctx context.Context, attachment *entities.Attachment, reason error) error {
+	err := use.store.Blobs.Delete(ctx, attachment.Key)
+	if err != nil {
+		return errors.Join(reason, err)
+	}
+
+	return reason
+}
+
+func (use *UseCases) batch(size int) error {
+	if size > use.batchLimit {
+		return ErrBatchTooLarge
+	}
+
+	return nil
+}
+
+// batchResult keeps domain errors in the result of the item, any other error aborts the whole batch.
+func batchResult(note *entities.Note, err error) (*BatchResult, error) {
+	var domainErr domain.Error
+
+	switch {
+	case err == nil:
+		return &BatchResult{Note: note, Err: nil}, nil
+	case errors.As(err, &domainErr):
+		return &BatchResult{Note: nil, Err: err}, nil
+	default:
+		return nil, err
+	}
+}
+
+// notebook returns the notebook of the user, notebooks of other users are reported as not found.
+func (use *UseCases) notebook(
+	ctx context.Context,
+	store ports.Store,
+	user *entities.User,
+	notebookID int64,
+) (*entities.Notebook, error) {
+	ident, err := id.Conv(notebookID)
+	if err != nil {
+		return nil, err
+	}
+
+	notebook, err := store.Notebooks.GetNotebook(ctx, ident)
+	if err != nil {
+		return nil, err
+	}
+
+	if !notebook.IsOwner(user) {
+		return nil, ErrNotebookNotFound
+	}
+
+	return notebook, nil
+}
+
+// template returns the template of the user, templates of other users are reported as not found.
+func (use *UseCases) template(
+	ctx context.Context,
+	store ports.Store,
+	user *entities.User,
+	templateID int64,
+) (*entities.Template, error) {
+	ident, err := id.Conv(templateID)
+	if err != nil {
+		return nil, err
+	}
+
+	template, err := store.Templates.GetTemplate(ctx, ident)
+	if err != nil {
+		return nil, err
+	}
+
+	if !template.IsOwner(user) {
+		return nil, ErrTemplateNotFound
+	}
+
+	return template, nil
+}
+
+// newNote makes the note of the user, the template is rendered first and the title and the content
+// given explicitly replace the rendered ones.
+func (use *UseCases) newNote(
+	ctx context.Context,
+	store ports.Store,
+	user *entities.User,
+	input *CreateNoteInput,
+) (*entities.Note, error) {
+	title, content := input.Title, input.Content
+
+	if input.TemplateID != 0 {
+		template, err := use.template(ctx, store, user, input.TemplateID)
+		if err != nil {
+			return nil, err
+		}
+
+		renderedTitle, renderedContent, err := template.Render(user, input.Variables, time.Now())
+		if err != nil {
+			return nil, err
+		}
+
+		if title == "" {
+			title = renderedTitle
+		}
+
+		if content == "" {
+			content = renderedContent
+		}
+	}
+
+	note, err := entities.NewNote(title, content)
+	if err != nil {
+		return nil, err
+	}
+
+	note.SetOwner(user)
+
+	return note, nil
+}
+
+// reparent moves the notebook into the parent, refusing to move it into itself or any of its nested notebooks.
+// The notebooks of the user stay locked until the transaction ends, so concurrent moves cannot make a cycle together.
+func (use *UseCases) reparent(
+	ctx context.Context,
+	store ports.Store,
+	user *entities.User,
+	notebook *entities.Notebook,
+	parentID int64,
+) error {
+	if parentID == 0 {
+		notebook.SetParent(nil)
+
+		return nil
+	}
+
+	err := store.Notebooks.LockNotebooks(ctx, user)
+	if err != nil {
+		return err
+	}
+
+	parent, err := use.notebook(ctx, store, user, parentID)
+	if err != nil {
+		return err
+	}
+
+	descendants, err := store.Notebooks.GetDescendants(ctx, notebook)
+	if err != nil {
+		return err
+	}
+
+	for _, nested := range append(descendants, notebook) {
+		if nested.ID == parent.ID {
+			return ErrNotebookCycle
+		}
+	}
+
+	notebook.SetParent(parent)
+
+	return nil
+}
+
+func (use *UseCases) revision(
+	ctx context.Context,
+	store ports.Store,
+	note *entities.Note,
+	number int32,
+) (*entities.Revision, error) {
+	if number <= 0 {
+		return nil, ErrRevisionNotFound
+	}
+
+	return store.Revisions.GetRevision(ctx, note, number)
+}
+
+// tag applies the change to the tags of the note and returns the note with its fresh tags.
+func (use *UseCases) tag(
+	ctx context.Context,
+	user *entities.User,
+	noteID, version int64,
+	change func(store ports.Store, note *entities.Note) error,
+) (*entities.Note, error) {
+	var note *entities.Note
+
+	err := use.uow.Do(ctx, func(store ports.Store) error {
+		var err error
+
+		note, err = use.accessible(ctx, store, user, noteID, accessOwner)
+		if err != nil {
+			return err
+		}
+
+		err = use.match(note, version)
+		if err != nil {
+			return err
+		}
+
+		err = change(store, note)
+		if err != nil {
+			return err
+		}
+
+		err = store.Notes.TouchNote(ctx, note)
+		if err != nil {
+			return err
+		}
+
+		note, err = store.Notes.GetNote(ctx, note.ID)
+		if err != nil {
+			return err
+		}
+
+		event := entities.NewEvent(entities.EventTypeUpdated, note)
+
+		return store.Events.SaveEvent(ctx, event)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return note, nil
+}
+
+// match guards against lost updates, a change must be based on the current version of the note.
+func (use *UseCases) match(note *entities.Note, version int64) error {
+	switch {
+	case version == 0:
+		return ErrVersionRequired
+	case note.Version != version:
+		return ErrVersionMismatch
+	}
+
+	return nil
+}
+
+// reminder returns the reminder of the user about the note, reminders of others are not found.
+func (use *UseCases) reminder(
+	ctx context.Context,
+	user *entities.User,
+	noteID, reminderID int64,
+) (*entities.Reminder, error) {
+	note, err := use.accessible(ctx, use.store, user, noteID, accessRead)
+	if err != nil {
+		return nil, err
+	}
+
+	ident, err := id.Conv(reminderID)
+	if err != nil {
+		return nil, err
+	}
+
+	reminder, err := use.store.Reminders.GetReminder(ctx, ident)
+	if err != nil {
+		return nil, err
+	}
+
+	if reminder.Note.ID != note.ID || !reminder.IsUser(user) {
+		return nil, ErrReminderNotFound
+	}
+
+	reminder.SetNote(note)
+
+	return reminder, nil
+}
+
+// edit applies the operation to the latest revision of the draft, it fails as changed when another edit
+// is applied to the draft first.
+func (use *UseCases) edit(ctx context.Context, editor *entities.Editor, input *ApplyEditInput) (*entities.Edit, error) {
+	draft, err := use.store.Drafts.GetDraft(ctx, editor.Note)
+	if err != nil {
+		return nil, err
+	}
+
+	operation, err := use.rebase(ctx, draft, input.Revision, input.Operation)
+	if err != nil {
+		return nil, err
+	}
+
+	edit := editor.Edit(operation, draft.Revision)
+
+	err = draft.Apply(edit)
+	if err != nil {
+		return nil, err
+	}
+
+	err = use.store.Drafts.SaveEdit(ctx, draft, edit)
+	if err != nil {
+		return nil, err
+	}
+
+	return edit, nil
+}
+
+// open returns the draft of the note of the editor, the stale draft is reset to the note first.
+func (use *UseCases) open(ctx context.Context, editor *entities.Editor) (*entities.Draft, error) {
+	draft, err := use.store.Drafts.OpenDraft(ctx, editor.Note)
+	if err != nil {
+		return nil, err
+	}
+
+	if draft.IsStale() {
+		err = use.reset(ctx, editor, draft)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return draft, nil
+}
+
+// reset replaces the content of the stale draft with the content of its note by an edit, so the editors following
+// the draft continue from the note. The edits not saved yet are lost, the change of the note wins over them.
+func (use *UseCases) reset(ctx context.Context, editor *entities.Editor, draft *entities.Draft) error {
+	edit := editor.Reset(draft)
+
+	err := draft.Apply(edit)
+	if err != nil {
+		return err
+	}
+
+	draft.Version = draft.Note.Version
+	draft.Saved = draft.Revision
+
+	err = use.store.Drafts.SaveEdit(ctx, draft, edit)
+	if err != nil {
+		return err
+	}
+
+	draft.Position = edit.Position
+
+	return nil
+}
+
+// rebase transforms the operation based on the revision against the edits applied to the draft since then,
+// so it applies to the latest revision of the draft.
+func (use *UseCases) rebase(
+	ctx context.Context,
+	draft *entities.Draft,
+	revision int64,
+	operation ot.Operation,
+) (ot.Operation, error) {
+	err := use.revise(draft, revision)
+	if err != nil {
+		return nil, err
+	}
+
+	edits, err := use.store.Drafts.GetEdits(ctx, draft, revision)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, edit := range edits {
+		operation, _, err = ot.Transform(operation, edit.Operation)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return operation, nil
+}
+
+func (use *UseCases) revise(draft *entities.Draft, revision int64) error {
+	if revision < 0 || revision > draft.Revision {
+		return ErrInvalidRevision
+	}
+
+	return nil
+}
+
+func (use *UseCases) alive(note *entities.Note) error {
+	if note.IsTrashed() {
+		return ErrNoteNotFound
+	}
+
+	return nil
+}
+
+func (use *UseCases) trashed(
+	ctx context.Context,
+	store ports.Store,
+	user *entities.User,
+	noteID int64,
+) (*entities.Note, error) {
+	ident, err := id.Conv(noteID)
+	if err != nil {
+		return nil, err
+	}
+
+	note, err := store.Notes.GetNote(ctx, ident)
+	if err != nil {
+		return nil, err
+	}
+
+	err = use.permit(ctx, store, user, note, accessOwner)
+	if err != nil {
+		return nil, err
+	}
+
+	if !note.IsTrashed() {
+		return nil, ErrNoteNotTrashed
+	}
+
+	return note, nil
+}
+
+// permit lets the owner do anything with the note, collaborators are allowed to read or write it by their role.
+func (use *UseCases) permit(
+	ctx context.Context,
+	store ports.Store,
+	user *entities.User,
+	note *entities.Note,
+	level access,
+) error {
+	if note.IsOwner(user) {
+		return nil
+	}
+
+	if level == accessOwner {
+		return ErrPermissionDenied
+	}
+
+	share, err := store.Shares.GetShare(ctx, note, user)
+
+	switch {
+	case errors.Is(err, ErrShareNotFound):
+		return ErrPermissionDenied
+	case err != nil:
+		return err
+	}
+
+	if level == accessWrite && !share.CanWrite() {
 		return ErrPermissionDenied
 	}
 
@@ -2,7 +2,10 @@ package usecases_test
 
 import (
 	"context"
+	"io"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -13,9 +16,18 @@ import (
 	v1 "github.com/therenotomorrow/gotes/internal/api/notes/v1/usecases"
 	"github.com/therenotomorrow/gotes/internal/api/users/v1/usecases"
 	"github.com/therenotomorrow/gotes/internal/domain/entities"
+	"github.com/therenotomorrow/gotes/internal/domain/types/cursor"
+	"github.com/therenotomorrow/gotes/internal/domain/types/diff"
+	"github.com/therenotomorrow/gotes/internal/domain/types/email"
 	"github.com/therenotomorrow/gotes/internal/domain/types/id"
+	"github.com/therenotomorrow/gotes/internal/domain/types/ot"
+	"github.com/therenotomorrow/gotes/internal/domain/types/placeholder"
+	"github.com/therenotomorrow/gotes/internal/domain/types/rrule"
 	"github.com/therenotomorrow/gotes/internal/domain/types/uuid"
+	"github.com/therenotomorrow/gotes/internal/domain/types/wikilink"
 	"github.com/therenotomorrow/gotes/pkg/services/generate"
+	"github.com/therenotomorrow/gotes/pkg/services/validate"
+	"github.com/therenotomorrow/gotes/pkg/services/vault"
 	"github.com/therenotomorrow/gotes/pkg/testkit"
 )
 
@@ -42,7 +54,7 @@ func TestUseCasesCreateNote(t *testing.T) {
 
 		got, err := use.CreateNote(ctx, user, &v1.CreateNoteInput{
 			Title:   "",
-			Content: "content",
+			Content: "the content",
 		})
 		require.ErrorIs(t, err, entities.ErrEmptyTitle)
 		assert.Nil(t, got)
@@ -61,7 +73,7 @@ func TestUseCasesCreateNote(t *testing.T) {
 		var (
 			ctx   = t.Context()
 			user  = new(entities.User)
-			input = &v1.CreateNoteInput{Title: "title", Content: "content"}
+			input = &v1.CreateNoteInput{Title: "title", Content: "the content"}
 			notes = mocks.NewMockNotesRepository(t)
 			store = ports.Store{Notes: notes}
 			use   = v1.NewCases(unitOfWork(store), store)
@@ -81,10 +93,11 @@ func TestUseCasesCreateNote(t *testing.T) {
 		var (
 			ctx    = t.Context()
 			user   = new(entities.User)
-			input  = &v1.CreateNoteInput{Title: "title", Content: "content"}
+			input  = &v1.CreateNoteInput{Title: "title", Content: "the content"}
 			notes  = mocks.NewMockNotesRepository(t)
+			revs   = mocks.NewMockRevisionsRepository(t)
 			events = mocks.NewMockEventsRepository(t)
-			store  = ports.Store{Notes: notes, Events: events}
+			store  = ports.Store{Notes: notes, Revisions: revs, Events: events}
 			use    = v1.NewCases(unitOfWork(store), store)
 		)
 
@@ -96,6 +109,13 @@ func TestUseCasesCreateNote(t *testing.T) {
 
 				return note
 			}, nil)
+		revs.On("SaveRevision", ctx, mock.AnythingOfType("*entities.Revision")).
+			Return(func(_ context.Context, revision *entities.Revision) *entities.Revision {
+				assert.Equal(t, user, revision.Author)
+				assert.Equal(t, "the content", revision.Content)
+
+				return revision
+			}, nil)
 		events.On("SaveEvent", ctx, mock.AnythingOfType("*entities.Event")).
 			Return(nil)
 
@@ -111,8 +131,345 @@ func TestUseCasesCreateNote(t *testing.T) {
 			UpdatedAt: now,
 			Owner:     user,
 			Title:     "title",
-			Content:   "content",
+			Content:   "the content",
 			ID:        ident,
+			Version:   1,
+		}
+
+		assert.Equal(t, want, got)
+	})
+}
+
+func TestUseCasesCreateNoteFromTemplate(t *testing.T) {
+	t.Parallel()
+
+	var (
+		owner    = &entities.User{ID: id.New(10), Name: "Alice", Email: email.New("alice@gotes.dev")}
+		template = &entities.Template{
+			Owner:   owner,
+			Name:    "Meeting",
+			Title:   "Meeting {{date}}",
+			Content: "# {{ topic }}\n\nHost: {{user.name}} <{{user.email}}>",
+			ID:      id.New(3),
+		}
+	)
+
+	t.Run("template errors", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			ctx       = t.Context()
+			templates = mocks.NewMockTemplatesRepository(t)
+			store     = ports.Store{Templates: templates}
+			use       = v1.NewCases(nil, store)
+		)
+
+		templates.On("GetTemplate", ctx, template.ID).
+			Return(template, nil)
+
+		got, err := use.CreateNote(ctx, &entities.User{ID: id.New(20)}, &v1.CreateNoteInput{TemplateID: 3})
+		require.ErrorIs(t, err, v1.ErrTemplateNotFound)
+		assert.Nil(t, got)
+
+		got, err = use.CreateNote(ctx, owner, &v1.CreateNoteInput{TemplateID: 3})
+		require.ErrorIs(t, err, placeholder.ErrMissingVariable)
+		assert.Nil(t, got)
+
+		// the filled template follows the same lengths as the notes created by hand
+		got, err = use.CreateNote(ctx, owner, &v1.CreateNoteInput{
+			Variables:  map[string]string{"topic": "Roadmap", "date": strings.Repeat("x", 250)},
+			TemplateID: 3,
+		})
+		require.ErrorIs(t, err, entities.ErrTitleLength)
+		assert.Nil(t, got)
+	})
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			ctx       = t.Context()
+			templates = mocks.NewMockTemplatesRepository(t)
+			notes     = mocks.NewMockNotesRepository(t)
+			revs      = mocks.NewMockRevisionsRepository(t)
+			events    = mocks.NewMockEventsRepository(t)
+			store     = ports.Store{Notes: notes, Revisions: revs, Events: events, Templates: templates}
+			use       = v1.NewCases(unitOfWork(store), store)
+		)
+
+		templates.On("GetTemplate", ctx, template.ID).
+			Return(template, nil)
+		notes.On("SaveNote", ctx, mock.AnythingOfType("*entities.Note")).
+			Return(func(_ context.Context, note *entities.Note) *entities.Note { return note }, nil).Twice()
+		revs.On("SaveRevision", ctx, mock.AnythingOfType("*entities.Revision")).
+			Return(func(_ context.Context, revision *entities.Revision) *entities.Revision { return revision }, nil)
+		events.On("SaveEvent", ctx, mock.AnythingOfType("*entities.Event")).
+			Return(nil)
+
+		got, err := use.CreateNote(ctx, owner, &v1.CreateNoteInput{
+			Variables:  map[string]string{"topic": "Roadmap", "date": "2030-01-07"},
+			TemplateID: 3,
+		})
+		require.NoError(t, err)
+
+		assert.Equal(t, "Meeting 2030-01-07", got.Title)
+		assert.Equal(t, "# Roadmap\n\nHost: Alice <alice@gotes.dev>", got.Content)
+		assert.Equal(t, owner, got.Owner)
+
+		// the title given explicitly replaces the one of the template
+		got, err = use.CreateNote(ctx, owner, &v1.CreateNoteInput{
+			Variables:  map[string]string{"topic": "Roadmap"},
+			Title:      "Weekly sync",
+			TemplateID: 3,
+		})
+		require.NoError(t, err)
+
+		assert.Equal(t, "Weekly sync", got.Title)
+	})
+}
+
+func TestUseCasesUpdateNote(t *testing.T) {
+	t.Parallel()
+
+	uuid.SetGenerator(generate.NewUUID())
+
+	title := "new title"
+	empty := ""
+
+	t.Run("validation errors", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			ctx   = t.Context()
+			user  = new(entities.User)
+			store = ports.Store{}
+			use   = v1.NewCases(nil, store)
+		)
+
+		got, err := use.UpdateNote(ctx, user, &v1.UpdateNoteInput{
+			ID:    -42,
+			Title: &title,
+		})
+		require.ErrorIs(t, err, id.ErrInvalidID)
+		assert.Nil(t, got)
+
+		got, err = use.UpdateNote(ctx, user, &v1.UpdateNoteInput{
+			ID: 42,
+		})
+		require.ErrorIs(t, err, v1.ErrNothingToUpdate)
+		assert.Nil(t, got)
+	})
+
+	t.Run("not permit", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			ctx    = t.Context()
+			ident  = id.New(42)
+			input  = &v1.UpdateNoteInput{ID: ident.Value(), Title: &title}
+			notes  = mocks.NewMockNotesRepository(t)
+			shares = mocks.NewMockSharesRepository(t)
+			store  = ports.Store{Notes: notes, Shares: shares}
+			use    = v1.NewCases(unitOfWork(store), store)
+		)
+
+		user := &entities.User{ID: id.New(20)}
+		note := &entities.Note{Owner: &entities.User{ID: id.New(10)}}
+
+		notes.On("GetNote", ctx, ident).
+			Return(note, nil)
+		shares.On("GetShare", ctx, note, user).
+			Return(nil, v1.ErrShareNotFound)
+
+		got, err := use.UpdateNote(ctx, user, input)
+		require.ErrorIs(t, err, usecases.ErrPermissionDenied)
+		assert.Nil(t, got)
+	})
+
+	t.Run("version errors", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			ctx   = t.Context()
+			owner = &entities.User{ID: id.New(30)}
+			note  = &entities.Note{Owner: owner, Version: 3}
+			ident = id.New(42)
+			notes = mocks.NewMockNotesRepository(t)
+			store = ports.Store{Notes: notes}
+			use   = v1.NewCases(unitOfWork(store), store)
+		)
+
+		notes.On("GetNote", ctx, ident).
+			Return(note, nil)
+
+		got, err := use.UpdateNote(ctx, owner, &v1.UpdateNoteInput{ID: ident.Value(), Title: &title})
+		require.ErrorIs(t, err, v1.ErrVersionRequired)
+		assert.Nil(t, got)
+
+		got, err = use.UpdateNote(ctx, owner, &v1.UpdateNoteInput{ID: ident.Value(), Title: &title, Version: 2})
+		require.ErrorIs(t, err, v1.ErrVersionMismatch)
+		assert.Nil(t, got)
+	})
+
+	t.Run("store version mismatch", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			ctx   = t.Context()
+			owner = &entities.User{ID: id.New(30)}
+			note  = &entities.Note{Owner: owner, Version: 3}
+			ident = id.New(42)
+			input = &v1.UpdateNoteInput{ID: ident.Value(), Title: &title, Version: 3}
+			notes = mocks.NewMockNotesRepository(t)
+			store = ports.Store{Notes: notes}
+			use   = v1.NewCases(unitOfWork(store), store)
+		)
+
+		notes.On("GetNote", ctx, ident).
+			Return(note, nil)
+		notes.On("UpdateNote", ctx, note).
+			Return(v1.ErrVersionMismatch)
+
+		got, err := use.UpdateNote(ctx, owner, input)
+		require.ErrorIs(t, err, v1.ErrVersionMismatch)
+		assert.Nil(t, got)
+	})
+
+	t.Run("entity error", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			ctx   = t.Context()
+			owner = &entities.User{ID: id.New(30)}
+			note  = &entities.Note{Owner: owner, Title: "title", Version: 3}
+			ident = id.New(42)
+			input = &v1.UpdateNoteInput{ID: ident.Value(), Content: &empty, Version: 3}
+			notes = mocks.NewMockNotesRepository(t)
+			store = ports.Store{Notes: notes}
+			use   = v1.NewCases(unitOfWork(store), store)
+		)
+
+		notes.On("GetNote", ctx, ident).
+			Return(note, nil)
+
+		got, err := use.UpdateNote(ctx, owner, input)
+		require.ErrorIs(t, err, entities.ErrEmptyContent)
+		assert.Nil(t, got)
+	})
+
+	t.Run("store update note error", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			ctx   = t.Context()
+			owner = &entities.User{ID: id.New(30)}
+			note  = &entities.Note{Owner: owner, Version: 3}
+			ident = id.New(42)
+			input = &v1.UpdateNoteInput{ID: ident.Value(), Title: &title, Version: 3}
+			notes = mocks.NewMockNotesRepository(t)
+			store = ports.Store{Notes: notes}
+			use   = v1.NewCases(unitOfWork(store), store)
+		)
+
+		notes.On("GetNote", ctx, ident).
+			Return(note, nil)
+		notes.On("UpdateNote", ctx, note).
+			Return(ex.ErrUnknown)
+
+		got, err := use.UpdateNote(ctx, owner, input)
+		require.ErrorIs(t, err, ex.ErrUnknown)
+		assert.Nil(t, got)
+	})
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			ctx    = t.Context()
+			owner  = &entities.User{ID: id.New(40)}
+			note   = &entities.Note{Owner: owner, Title: "title", Content: "the content", ID: id.New(42), Version: 3}
+			input  = &v1.UpdateNoteInput{ID: note.ID.Value(), Title: &title, Version: 3}
+			notes  = mocks.NewMockNotesRepository(t)
+			revs   = mocks.NewMockRevisionsRepository(t)
+			events = mocks.NewMockEventsRepository(t)
+			store  = ports.Store{Notes: notes, Revisions: revs, Events: events}
+			use    = v1.NewCases(unitOfWork(store), store)
+		)
+
+		notes.On("GetNote", ctx, note.ID).
+			Return(note, nil)
+		notes.On("UpdateNote", ctx, note).
+			Return(nil)
+		revs.On("SaveRevision", ctx, mock.AnythingOfType("*entities.Revision")).
+			Return(func(_ context.Context, revision *entities.Revision) *entities.Revision {
+				assert.Equal(t, "new title", revision.Title)
+
+				return revision
+			}, nil)
+		events.On("SaveEvent", ctx, mock.AnythingOfType("*entities.Event")).
+			Return(func(_ context.Context, event *entities.Event) error {
+				assert.Equal(t, entities.EventTypeUpdated, event.EventType)
+
+				return nil
+			})
+
+		got, err := use.UpdateNote(ctx, owner, input)
+		require.NoError(t, err)
+
+		got.UpdatedAt = testkit.TimeByMinute(got.UpdatedAt)
+
+		want := &entities.Note{
+			UpdatedAt: testkit.NowByMinute(),
+			Owner:     owner,
+			Title:     "new title",
+			Content:   "the content",
+			ID:        note.ID,
+			Version:   3,
+		}
+
+		assert.Equal(t, want, got)
+	})
+
+	t.Run("states", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			ctx     = t.Context()
+			owner   = &entities.User{ID: id.New(40)}
+			updated = time.Now().Add(-time.Hour)
+			note    = &entities.Note{UpdatedAt: updated, Owner: owner, Title: "title", ID: id.New(42), Version: 3}
+			pinned  = true
+			input   = &v1.UpdateNoteInput{ID: note.ID.Value(), Pinned: &pinned, Starred: &pinned, Version: 3}
+			notes   = mocks.NewMockNotesRepository(t)
+			revs    = mocks.NewMockRevisionsRepository(t)
+			events  = mocks.NewMockEventsRepository(t)
+			store   = ports.Store{Notes: notes, Revisions: revs, Events: events}
+			use     = v1.NewCases(unitOfWork(store), store)
+		)
+
+		notes.On("GetNote", ctx, note.ID).
+			Return(note, nil)
+		notes.On("UpdateNote", ctx, note).
+			Return(nil).Once()
+		events.On("SaveEvent", ctx, mock.AnythingOfType("*entities.Event")).
+			Return(nil).Once()
+
+		// the collaborators change the title and the content only
+		got, err := use.UpdateNote(ctx, &entities.User{ID: id.New(50)}, input)
+		require.ErrorIs(t, err, usecases.ErrPermissionDenied)
+		assert.Nil(t, got)
+
+		got, err = use.UpdateNote(ctx, owner, input)
+		require.NoError(t, err)
+
+		want := &entities.Note{
+			UpdatedAt: updated,
+			Owner:     owner,
+			Title:     "title",
+			ID:        note.ID,
+			Version:   3,
+			Pinned:    true,
+			Starred:   true,
 		}
 
 		assert.Equal(t, want, got)
@@ -182,15 +539,37 @@ func TestUseCasesDeleteNote(t *testing.T) {
 		require.ErrorIs(t, err, usecases.ErrPermissionDenied)
 	})
 
-	t.Run("store delete note error", func(t *testing.T) {
+	t.Run("already trashed", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			ctx     = t.Context()
+			owner   = &entities.User{ID: id.New(30)}
+			trashed = testkit.NowByMinute()
+			note    = &entities.Note{Owner: owner, DeletedAt: &trashed}
+			ident   = id.New(42)
+			input   = &v1.DeleteNoteInput{ID: ident.Value()}
+			notes   = mocks.NewMockNotesRepository(t)
+			store   = ports.Store{Notes: notes}
+			use     = v1.NewCases(unitOfWork(store), store)
+		)
+
+		notes.On("GetNote", ctx, ident).
+			Return(note, nil)
+
+		err := use.DeleteNote(ctx, owner, input)
+		require.ErrorIs(t, err, v1.ErrNoteNotFound)
+	})
+
+	t.Run("store trash note error", func(t *testing.T) {
 		t.Parallel()
 
 		var (
 			ctx   = t.Context()
 			owner = &entities.User{ID: id.New(30)}
-			note  = &entities.Note{Owner: owner}
+			note  = &entities.Note{Owner: owner, Version: 3}
 			ident = id.New(42)
-			input = &v1.DeleteNoteInput{ID: ident.Value()}
+			input = &v1.DeleteNoteInput{ID: ident.Value(), Version: 3}
 			notes = mocks.NewMockNotesRepository(t)
 			store = ports.Store{Notes: notes}
 			use   = v1.NewCases(unitOfWork(store), store)
@@ -198,7 +577,7 @@ func TestUseCasesDeleteNote(t *testing.T) {
 
 		notes.On("GetNote", ctx, ident).
 			Return(note, nil)
-		notes.On("DeleteNote", ctx, note).
+		notes.On("TrashNote", ctx, note).
 			Return(ex.ErrUnknown)
 
 		err := use.DeleteNote(ctx, owner, input)
@@ -211,9 +590,9 @@ func TestUseCasesDeleteNote(t *testing.T) {
 		var (
 			ctx    = t.Context()
 			owner  = &entities.User{ID: id.New(40)}
-			note   = &entities.Note{Owner: owner}
+			note   = &entities.Note{Owner: owner, Version: 3}
 			ident  = id.New(42)
-			input  = &v1.DeleteNoteInput{ID: ident.Value()}
+			input  = &v1.DeleteNoteInput{ID: ident.Value(), Version: 3}
 			notes  = mocks.NewMockNotesRepository(t)
 			events = mocks.NewMockEventsRepository(t)
 			store  = ports.Store{Notes: notes, Events: events}
@@ -222,13 +601,18 @@ func TestUseCasesDeleteNote(t *testing.T) {
 
 		notes.On("GetNote", ctx, ident).
 			Return(note, nil)
-		notes.On("DeleteNote", ctx, note).
+		notes.On("TrashNote", ctx, note).
 			Return(nil)
 		events.On("SaveEvent", ctx, mock.AnythingOfType("*entities.Event")).
-			Return(nil)
+			Return(func(_ context.Context, event *entities.Event) error {
+				assert.Equal(t, entities.EventTypeDeleted, event.EventType)
+
+				return nil
+			})
 
 		err := use.DeleteNote(ctx, owner, input)
 		require.NoError(t, err)
+		assert.True(t, note.IsTrashed())
 	})
 }
 
@@ -277,12 +661,13 @@ func TestUseCasesRetrieveNote(t *testing.T) {
 		t.Parallel()
 
 		var (
-			ctx   = t.Context()
-			ident = id.New(42)
-			input = &v1.RetrieveNoteInput{ID: ident.Value()}
-			notes = mocks.NewMockNotesRepository(t)
-			store = ports.Store{Notes: notes}
-			use   = v1.NewCases(unitOfWork(store), store)
+			ctx    = t.Context()
+			ident  = id.New(42)
+			input  = &v1.RetrieveNoteInput{ID: ident.Value()}
+			notes  = mocks.NewMockNotesRepository(t)
+			shares = mocks.NewMockSharesRepository(t)
+			store  = ports.Store{Notes: notes, Shares: shares}
+			use    = v1.NewCases(unitOfWork(store), store)
 		)
 
 		user := &entities.User{ID: id.New(20)}
@@ -290,6 +675,8 @@ func TestUseCasesRetrieveNote(t *testing.T) {
 
 		notes.On("GetNote", ctx, ident).
 			Return(note, nil)
+		shares.On("GetShare", ctx, note, user).
+			Return(nil, v1.ErrShareNotFound)
 
 		got, err := use.RetrieveNote(ctx, user, input)
 		require.ErrorIs(t, err, usecases.ErrPermissionDenied)
@@ -320,49 +707,3943 @@ func TestUseCasesRetrieveNote(t *testing.T) {
 
 		assert.Equal(t, want, got)
 	})
-}
-
-func TestUseCasesListNotes(t *testing.T) {
-	t.Parallel()
 
-	t.Run("store error", func(t *testing.T) {
+	t.Run("render error", func(t *testing.T) {
 		t.Parallel()
 
 		var (
-			ctx   = t.Context()
-			user  = new(entities.User)
-			notes = mocks.NewMockNotesRepository(t)
-			store = ports.Store{Notes: notes}
-			use   = v1.NewCases(unitOfWork(store), store)
+			ctx      = t.Context()
+			owner    = &entities.User{ID: id.New(40)}
+			note     = &entities.Note{Owner: owner, Content: "# Plan"}
+			ident    = id.New(42)
+			input    = &v1.RetrieveNoteInput{ID: ident.Value(), Format: entities.FormatHTML}
+			notes    = mocks.NewMockNotesRepository(t)
+			renderer = mocks.NewMockRenderer(t)
+			renders  = mocks.NewMockRenders(t)
+			store    = ports.Store{Notes: notes, Renderer: renderer, Renders: renders}
+			use      = v1.NewCases(unitOfWork(store), store)
 		)
 
-		notes.On("GetNotesByUser", ctx, user).
-			Return(nil, ex.ErrUnknown)
+		notes.On("GetNote", ctx, ident).
+			Return(note, nil)
+		renders.On("GetRenders", ctx, []*entities.Note{note}, entities.FormatHTML).
+			Return([]*entities.Render{}, nil)
+		renderer.On("Render", "# Plan", entities.FormatHTML).
+			Return("", ex.ErrUnknown)
 
-		got, err := use.ListNotes(ctx, user)
+		got, err := use.RetrieveNote(ctx, owner, input)
 		require.ErrorIs(t, err, ex.ErrUnknown)
 		assert.Nil(t, got)
 	})
 
-	t.Run("success", func(t *testing.T) {
+	t.Run("render", func(t *testing.T) {
 		t.Parallel()
 
 		var (
-			ctx   = t.Context()
-			user  = new(entities.User)
-			notes = mocks.NewMockNotesRepository(t)
-			store = ports.Store{Notes: notes}
-			use   = v1.NewCases(unitOfWork(store), store)
+			ctx      = t.Context()
+			owner    = &entities.User{ID: id.New(40)}
+			note     = &entities.Note{Owner: owner, Content: "# Plan", Version: 3}
+			ident    = id.New(42)
+			input    = &v1.RetrieveNoteInput{ID: ident.Value(), Format: entities.FormatText}
+			notes    = mocks.NewMockNotesRepository(t)
+			renderer = mocks.NewMockRenderer(t)
+			renders  = mocks.NewMockRenders(t)
+			store    = ports.Store{Notes: notes, Renderer: renderer, Renders: renders}
+			use      = v1.NewCases(unitOfWork(store), store)
 		)
 
-		notes.On("GetNotesByUser", ctx, user).
-			Return([]*entities.Note{new(entities.Note), new(entities.Note)}, nil)
+		notes.On("GetNote", ctx, ident).
+			Return(note, nil)
+		renders.On("GetRenders", ctx, []*entities.Note{note}, entities.FormatText).
+			Return([]*entities.Render{}, nil)
+		renderer.On("Render", "# Plan", entities.FormatText).
+			Return("Plan", nil)
+		renders.On("SaveRenders", ctx, []*entities.Render{
+			{Note: note, Format: entities.FormatText, Content: "Plan", Version: 3},
+		}).
+			Return(nil)
 
-		got, err := use.ListNotes(ctx, user)
+		got, err := use.RetrieveNote(ctx, owner, input)
+		require.NoError(t, err)
+		assert.Equal(t, "Plan", got.Content)
+	})
+}
+
+func TestUseCasesListNotes(t *testing.T) {
+	t.Parallel()
+
+	cursor.SetSigner(vault.NewCursorSigner("secret"))
+
+	t.Run("store error", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			ctx   = t.Context()
+			user  = new(entities.User)
+			input = new(v1.ListNotesInput)
+			notes = mocks.NewMockNotesRepository(t)
+			store = ports.Store{Notes: notes}
+			use   = v1.NewCases(unitOfWork(store), store)
+		)
+
+		notes.On("GetNotesByUser", ctx, user, mock.AnythingOfType("*ports.NotesQuery")).
+			Return(nil, ex.ErrUnknown)
+
+		got, err := use.ListNotes(ctx, user, input)
+		require.ErrorIs(t, err, ex.ErrUnknown)
+		assert.Nil(t, got)
+	})
+
+	t.Run("invalid page token", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			ctx   = t.Context()
+			user  = new(entities.User)
+			input = &v1.ListNotesInput{PageToken: "tampered"}
+			store = ports.Store{}
+			use   = v1.NewCases(unitOfWork(store), store)
+		)
+
+		got, err := use.ListNotes(ctx, user, input)
+		require.ErrorIs(t, err, v1.ErrInvalidPageToken)
+		assert.Nil(t, got)
+	})
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			ctx   = t.Context()
+			user  = &entities.User{ID: id.New(10)}
+			input = &v1.ListNotesInput{PageSize: 2, Order: ports.NotesOrder{Field: ports.SortByTitle}}
+			notes = mocks.NewMockNotesRepository(t)
+			store = ports.Store{Notes: notes}
+			use   = v1.NewCases(unitOfWork(store), store)
+		)
+
+		page := []*entities.Note{
+			{Title: "a", ID: id.New(1)},
+			{Title: "b", ID: id.New(2)},
+			{Title: "c", ID: id.New(3)},
+		}
+
+		notes.On("GetNotesByUser", ctx, user, &ports.NotesQuery{Order: input.Order, Limit: 3}).
+			Return(page, nil).
+			Once()
+		notes.On("CountNotesByUser", ctx, user, &input.Filter).
+			Return(int32(3), nil)
+
+		got, err := use.ListNotes(ctx, user, input)
+		require.NoError(t, err)
+
+		assert.Equal(t, page[:2], got.Notes)
+		assert.Equal(t, int32(3), got.TotalSize)
+		assert.NotEmpty(t, got.NextPageToken)
+
+		input.PageToken = got.NextPageToken
+		after := &entities.Note{Title: "b", ID: id.New(2)}
+
+		notes.On("GetNotesByUser", ctx, user, &ports.NotesQuery{After: after, Order: input.Order, Limit: 3}).
+			Return(page[2:], nil).
+			Once()
+
+		got, err = use.ListNotes(ctx, user, input)
+		require.NoError(t, err)
+
+		want := &v1.ListNotesOutput{Notes: page[2:], TotalSize: 3}
+
+		assert.Equal(t, want, got)
+	})
+
+	t.Run("pinned first", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			ctx     = t.Context()
+			user    = &entities.User{ID: id.New(10)}
+			starred = true
+			input   = &v1.ListNotesInput{PageSize: 1, Filter: ports.NotesFilter{Starred: &starred}}
+			notes   = mocks.NewMockNotesRepository(t)
+			store   = ports.Store{Notes: notes}
+			use     = v1.NewCases(unitOfWork(store), store)
+		)
+
+		page := []*entities.Note{
+			{Title: "b", ID: id.New(2), Pinned: true, Starred: true},
+			{Title: "a", ID: id.New(1), Starred: true},
+		}
+
+		notes.On("GetNotesByUser", ctx, user, &ports.NotesQuery{Filter: input.Filter, Limit: 2}).
+			Return(page, nil).
+			Once()
+		notes.On("CountNotesByUser", ctx, user, &input.Filter).
+			Return(int32(2), nil)
+
+		got, err := use.ListNotes(ctx, user, input)
+		require.NoError(t, err)
+
+		input.PageToken = got.NextPageToken
+		after := &entities.Note{Title: "b", ID: id.New(2), Pinned: true}
+
+		notes.On("GetNotesByUser", ctx, user, &ports.NotesQuery{After: after, Filter: input.Filter, Limit: 2}).
+			Return(page[1:], nil).
+			Once()
+
+		got, err = use.ListNotes(ctx, user, input)
+		require.NoError(t, err)
+		assert.Equal(t, page[1:], got.Notes)
+	})
+
+	t.Run("page token of another query", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			ctx   = t.Context()
+			user  = &entities.User{ID: id.New(10)}
+			input = &v1.ListNotesInput{PageSize: 1}
+			notes = mocks.NewMockNotesRepository(t)
+			store = ports.Store{Notes: notes}
+			use   = v1.NewCases(unitOfWork(store), store)
+		)
+
+		notes.On("GetNotesByUser", ctx, user, mock.AnythingOfType("*ports.NotesQuery")).
+			Return([]*entities.Note{{ID: id.New(1)}, {ID: id.New(2)}}, nil)
+		notes.On("CountNotesByUser", ctx, user, &input.Filter).
+			Return(int32(2), nil)
+
+		got, err := use.ListNotes(ctx, user, input)
+		require.NoError(t, err)
+
+		input.PageToken = got.NextPageToken
+		input.Order.Descending = true
+
+		got, err = use.ListNotes(ctx, user, input)
+		require.ErrorIs(t, err, v1.ErrInvalidPageToken)
+		assert.Nil(t, got)
+	})
+
+	t.Run("render cached", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			ctx      = t.Context()
+			user     = &entities.User{ID: id.New(10)}
+			input    = &v1.ListNotesInput{PageSize: 2, Format: entities.FormatHTML}
+			notes    = mocks.NewMockNotesRepository(t)
+			renderer = mocks.NewMockRenderer(t)
+			renders  = mocks.NewMockRenders(t)
+			store    = ports.Store{Notes: notes, Renderer: renderer, Renders: renders}
+			use      = v1.NewCases(unitOfWork(store), store)
+		)
+
+		page := []*entities.Note{
+			{Content: "*a*", ID: id.New(1), Version: 1},
+			{Content: "*b*", ID: id.New(2), Version: 5},
+		}
+
+		notes.On("GetNotesByUser", ctx, user, &ports.NotesQuery{Limit: 3}).
+			Return(page, nil)
+		notes.On("CountNotesByUser", ctx, user, &input.Filter).
+			Return(int32(2), nil)
+		renders.On("GetRenders", ctx, page, entities.FormatHTML).
+			Return([]*entities.Render{entities.NewRender(page[1], entities.FormatHTML, "<p><em>b</em></p>")}, nil)
+		renderer.On("Render", "*a*", entities.FormatHTML).
+			Return("<p><em>a</em></p>", nil)
+		renders.On("SaveRenders", ctx, []*entities.Render{
+			{Note: page[0], Format: entities.FormatHTML, Content: "<p><em>a</em></p>", Version: 1},
+		}).
+			Return(nil)
+
+		got, err := use.ListNotes(ctx, user, input)
+		require.NoError(t, err)
+		assert.Equal(t, "<p><em>a</em></p>", got.Notes[0].Content)
+		assert.Equal(t, "<p><em>b</em></p>", got.Notes[1].Content)
+	})
+}
+
+func TestUseCasesSearchNotes(t *testing.T) {
+	t.Parallel()
+
+	cursor.SetSigner(vault.NewCursorSigner("secret"))
+
+	t.Run("store error", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			ctx   = t.Context()
+			user  = new(entities.User)
+			input = &v1.SearchNotesInput{Text: "gotes"}
+			notes = mocks.NewMockNotesRepository(t)
+			store = ports.Store{Notes: notes}
+			use   = v1.NewCases(unitOfWork(store), store)
+		)
+
+		notes.On("SearchNotesByUser", ctx, user, mock.AnythingOfType("*ports.SearchQuery")).
+			Return(nil, ex.ErrUnknown)
+
+		got, err := use.SearchNotes(ctx, user, input)
+		require.ErrorIs(t, err, ex.ErrUnknown)
+		assert.Nil(t, got)
+	})
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			ctx   = t.Context()
+			user  = &entities.User{ID: id.New(10)}
+			input = &v1.SearchNotesInput{Text: "gotes", PageSize: 1}
+			notes = mocks.NewMockNotesRepository(t)
+			store = ports.Store{Notes: notes}
+			use   = v1.NewCases(unitOfWork(store), store)
+		)
+
+		hits := []*ports.SearchHit{
+			{Note: &entities.Note{ID: id.New(7)}, Rank: 0.75},
+			{Note: &entities.Note{ID: id.New(3)}, Rank: 0.5},
+		}
+
+		notes.On("SearchNotesByUser", ctx, user, &ports.SearchQuery{Text: "gotes", Limit: 2}).
+			Return(hits, nil).
+			Once()
+		notes.On("CountSearchNotesByUser", ctx, user, "gotes").
+			Return(int32(2), nil)
+
+		got, err := use.SearchNotes(ctx, user, input)
+		require.NoError(t, err)
+
+		assert.Equal(t, hits[:1], got.Hits)
+		assert.NotEmpty(t, got.NextPageToken)
+
+		input.PageToken = got.NextPageToken
+		after := &ports.SearchHit{Note: &entities.Note{ID: id.New(7)}, Rank: 0.75}
+
+		notes.On("SearchNotesByUser", ctx, user, &ports.SearchQuery{After: after, Text: "gotes", Limit: 2}).
+			Return(hits[1:], nil).
+			Once()
+
+		got, err = use.SearchNotes(ctx, user, input)
+		require.NoError(t, err)
+
+		want := &v1.SearchNotesOutput{Hits: hits[1:], TotalSize: 2}
+
+		assert.Equal(t, want, got)
+	})
+}
+
+func TestUseCasesListNoteRevisions(t *testing.T) {
+	t.Parallel()
+
+	cursor.SetSigner(vault.NewCursorSigner("secret"))
+
+	t.Run("not permit", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			ctx    = t.Context()
+			user   = &entities.User{ID: id.New(20)}
+			note   = &entities.Note{Owner: &entities.User{ID: id.New(10)}, ID: id.New(42)}
+			notes  = mocks.NewMockNotesRepository(t)
+			shares = mocks.NewMockSharesRepository(t)
+			store  = ports.Store{Notes: notes, Shares: shares}
+			use    = v1.NewCases(unitOfWork(store), store)
+		)
+
+		notes.On("GetNote", ctx, note.ID).
+			Return(note, nil)
+		shares.On("GetShare", ctx, note, user).
+			Return(nil, v1.ErrShareNotFound)
+
+		got, err := use.ListNoteRevisions(ctx, user, &v1.ListNoteRevisionsInput{NoteID: note.ID.Value()})
+		require.ErrorIs(t, err, usecases.ErrPermissionDenied)
+		assert.Nil(t, got)
+	})
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			ctx   = t.Context()
+			owner = &entities.User{ID: id.New(10)}
+			note  = &entities.Note{Owner: owner, ID: id.New(42)}
+			input = &v1.ListNoteRevisionsInput{NoteID: note.ID.Value(), PageSize: 1}
+			notes = mocks.NewMockNotesRepository(t)
+			revs  = mocks.NewMockRevisionsRepository(t)
+			store = ports.Store{Notes: notes, Revisions: revs}
+			use   = v1.NewCases(unitOfWork(store), store)
+		)
+
+		revisions := []*entities.Revision{
+			{Note: note, ID: id.New(8), Number: 2},
+			{Note: note, ID: id.New(5), Number: 1},
+		}
+
+		notes.On("GetNote", ctx, note.ID).
+			Return(note, nil)
+		revs.On("GetRevisionsByNote", ctx, note, &ports.RevisionsQuery{Limit: 2}).
+			Return(revisions, nil).
+			Once()
+
+		got, err := use.ListNoteRevisions(ctx, owner, input)
+		require.NoError(t, err)
+
+		assert.Equal(t, revisions[:1], got.Revisions)
+		assert.NotEmpty(t, got.NextPageToken)
+
+		input.PageToken = got.NextPageToken
+		after := &entities.Revision{ID: id.New(8), Number: 2}
+
+		revs.On("GetRevisionsByNote", ctx, note, &ports.RevisionsQuery{After: after, Limit: 2}).
+			Return(revisions[1:], nil).
+			Once()
+
+		got, err = use.ListNoteRevisions(ctx, owner, input)
+		require.NoError(t, err)
+
+		want := &v1.ListNoteRevisionsOutput{Revisions: revisions[1:]}
+
+		assert.Equal(t, want, got)
+	})
+}
+
+func TestUseCasesGetNoteRevision(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid revision", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			ctx   = t.Context()
+			owner = &entities.User{ID: id.New(10)}
+			note  = &entities.Note{Owner: owner, ID: id.New(42)}
+			notes = mocks.NewMockNotesRepository(t)
+			store = ports.Store{Notes: notes}
+			use   = v1.NewCases(unitOfWork(store), store)
+		)
+
+		notes.On("GetNote", ctx, note.ID).
+			Return(note, nil)
+
+		got, err := use.GetNoteRevision(ctx, owner, &v1.GetNoteRevisionInput{NoteID: note.ID.Value()})
+		require.ErrorIs(t, err, v1.ErrRevisionNotFound)
+		assert.Nil(t, got)
+	})
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			ctx      = t.Context()
+			owner    = &entities.User{ID: id.New(10)}
+			note     = &entities.Note{Owner: owner, ID: id.New(42)}
+			revision = &entities.Revision{Note: note, Title: "title", ID: id.New(5), Number: 3}
+			notes    = mocks.NewMockNotesRepository(t)
+			revs     = mocks.NewMockRevisionsRepository(t)
+			store    = ports.Store{Notes: notes, Revisions: revs}
+			use      = v1.NewCases(unitOfWork(store), store)
+		)
+
+		notes.On("GetNote", ctx, note.ID).
+			Return(note, nil)
+		revs.On("GetRevision", ctx, note, int32(3)).
+			Return(revision, nil)
+
+		got, err := use.GetNoteRevision(ctx, owner, &v1.GetNoteRevisionInput{NoteID: note.ID.Value(), Revision: 3})
+		require.NoError(t, err)
+		assert.Equal(t, revision, got)
+	})
+}
+
+func TestUseCasesDiffNoteRevisions(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx   = t.Context()
+		owner = &entities.User{ID: id.New(10)}
+		note  = &entities.Note{Owner: owner, ID: id.New(42)}
+		from  = &entities.Revision{Note: note, Content: "first\nsecond\nthird", Number: 1}
+		to    = &entities.Revision{Note: note, Content: "first\nthird\nfourth", Number: 2}
+		notes = mocks.NewMockNotesRepository(t)
+		revs  = mocks.NewMockRevisionsRepository(t)
+		store = ports.Store{Notes: notes, Revisions: revs}
+		use   = v1.NewCases(unitOfWork(store), store)
+	)
+
+	notes.On("GetNote", ctx, note.ID).
+		Return(note, nil)
+	revs.On("GetRevision", ctx, note, int32(1)).
+		Return(from, nil)
+	revs.On("GetRevision", ctx, note, int32(2)).
+		Return(to, nil)
+
+	got, err := use.DiffNoteRevisions(ctx, owner, &v1.DiffNoteRevisionsInput{
+		NoteID:       note.ID.Value(),
+		FromRevision: 1,
+		ToRevision:   2,
+	})
+	require.NoError(t, err)
+
+	want := &v1.DiffNoteRevisionsOutput{
+		From: from,
+		To:   to,
+		Lines: []diff.Line{
+			{Text: "first", Operation: diff.OperationEqual},
+			{Text: "second", Operation: diff.OperationDelete},
+			{Text: "third", Operation: diff.OperationEqual},
+			{Text: "fourth", Operation: diff.OperationInsert},
+		},
+	}
+
+	assert.Equal(t, want, got)
+}
+
+func TestUseCasesRestoreNoteRevision(t *testing.T) {
+	t.Parallel()
+
+	uuid.SetGenerator(generate.NewUUID())
+
+	t.Run("revision not found", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			ctx   = t.Context()
+			owner = &entities.User{ID: id.New(10)}
+			note  = &entities.Note{Owner: owner, ID: id.New(42), Version: 2}
+			notes = mocks.NewMockNotesRepository(t)
+			revs  = mocks.NewMockRevisionsRepository(t)
+			store = ports.Store{Notes: notes, Revisions: revs}
+			use   = v1.NewCases(unitOfWork(store), store)
+		)
+
+		notes.On("GetNote", ctx, note.ID).
+			Return(note, nil)
+		revs.On("GetRevision", ctx, note, int32(7)).
+			Return(nil, v1.ErrRevisionNotFound)
+
+		got, err := use.RestoreNoteRevision(ctx, owner, &v1.RestoreNoteRevisionInput{
+			NoteID:   note.ID.Value(),
+			Revision: 7,
+			Version:  2,
+		})
+		require.ErrorIs(t, err, v1.ErrRevisionNotFound)
+		assert.Nil(t, got)
+	})
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			ctx    = t.Context()
+			owner  = &entities.User{ID: id.New(10)}
+			note   = &entities.Note{Owner: owner, Title: "new title", Content: "new content", ID: id.New(42), Version: 2}
+			old    = &entities.Revision{Note: note, Title: "old title", Content: "old content", Number: 1}
+			notes  = mocks.NewMockNotesRepository(t)
+			revs   = mocks.NewMockRevisionsRepository(t)
+			events = mocks.NewMockEventsRepository(t)
+			store  = ports.Store{Notes: notes, Revisions: revs, Events: events}
+			use    = v1.NewCases(unitOfWork(store), store)
+		)
+
+		notes.On("GetNote", ctx, note.ID).
+			Return(note, nil)
+		revs.On("GetRevision", ctx, note, int32(1)).
+			Return(old, nil)
+		notes.On("UpdateNote", ctx, note).
+			Return(nil)
+		revs.On("SaveRevision", ctx, mock.AnythingOfType("*entities.Revision")).
+			Return(func(_ context.Context, revision *entities.Revision) *entities.Revision {
+				revision.ID = id.New(9)
+				revision.Number = 3
+
+				return revision
+			}, nil)
+		events.On("SaveEvent", ctx, mock.AnythingOfType("*entities.Event")).
+			Return(func(_ context.Context, event *entities.Event) error {
+				assert.Equal(t, entities.EventTypeUpdated, event.EventType)
+
+				return nil
+			})
+
+		got, err := use.RestoreNoteRevision(ctx, owner, &v1.RestoreNoteRevisionInput{
+			NoteID:   note.ID.Value(),
+			Revision: 1,
+			Version:  2,
+		})
+		require.NoError(t, err)
+
+		assert.Equal(t, "old title", got.Note.Title)
+		assert.Equal(t, "old content", got.Note.Content)
+		assert.Equal(t, int32(3), got.Revision.Number)
+		assert.Equal(t, "old content", got.Revision.Content)
+		assert.Equal(t, owner, got.Revision.Author)
+		assert.Equal(t, int32(1), old.Number)
+	})
+}
+
+func TestUseCasesListTrashedNotes(t *testing.T) {
+	t.Parallel()
+
+	cursor.SetSigner(vault.NewCursorSigner("secret"))
+
+	var (
+		ctx   = t.Context()
+		user  = &entities.User{ID: id.New(10)}
+		input = &v1.ListTrashedNotesInput{PageSize: 1}
+		notes = mocks.NewMockNotesRepository(t)
+		store = ports.Store{Notes: notes}
+		use   = v1.NewCases(unitOfWork(store), store)
+	)
+
+	now := testkit.NowByMinute()
+	trashed := []*entities.Note{
+		{ID: id.New(7), DeletedAt: &now},
+		{ID: id.New(3), DeletedAt: &now},
+	}
+
+	notes.On("GetTrashedNotesByUser", ctx, user, &ports.TrashQuery{Limit: 2}).
+		Return(trashed, nil).
+		Once()
+	notes.On("CountTrashedNotesByUser", ctx, user).
+		Return(int32(2), nil)
+
+	got, err := use.ListTrashedNotes(ctx, user, input)
+	require.NoError(t, err)
+
+	assert.Equal(t, trashed[:1], got.Notes)
+	assert.NotEmpty(t, got.NextPageToken)
+
+	input.PageToken = got.NextPageToken
+
+	notes.On("GetTrashedNotesByUser", ctx, user, mock.MatchedBy(func(query *ports.TrashQuery) bool {
+		return query.After.ID == id.New(7) && query.After.DeletedAt.Equal(now)
+	})).
+		Return(trashed[1:], nil).
+		Once()
+
+	got, err = use.ListTrashedNotes(ctx, user, input)
+	require.NoError(t, err)
+
+	want := &v1.ListTrashedNotesOutput{Notes: trashed[1:], TotalSize: 2}
+
+	assert.Equal(t, want, got)
+}
+
+func TestUseCasesRestoreNote(t *testing.T) {
+	t.Parallel()
+
+	uuid.SetGenerator(generate.NewUUID())
+
+	t.Run("not trashed", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			ctx   = t.Context()
+			owner = &entities.User{ID: id.New(10)}
+			note  = &entities.Note{Owner: owner, ID: id.New(42)}
+			notes = mocks.NewMockNotesRepository(t)
+			store = ports.Store{Notes: notes}
+			use   = v1.NewCases(unitOfWork(store), store)
+		)
+
+		notes.On("GetNote", ctx, note.ID).
+			Return(note, nil)
+
+		got, err := use.RestoreNote(ctx, owner, &v1.RestoreNoteInput{ID: note.ID.Value()})
+		require.ErrorIs(t, err, v1.ErrNoteNotTrashed)
+		assert.Nil(t, got)
+	})
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			ctx     = t.Context()
+			owner   = &entities.User{ID: id.New(10)}
+			trashed = testkit.NowByMinute()
+			note    = &entities.Note{Owner: owner, DeletedAt: &trashed, ID: id.New(42), Version: 2}
+			notes   = mocks.NewMockNotesRepository(t)
+			events  = mocks.NewMockEventsRepository(t)
+			store   = ports.Store{Notes: notes, Events: events}
+			use     = v1.NewCases(unitOfWork(store), store)
+		)
+
+		notes.On("GetNote", ctx, note.ID).
+			Return(note, nil)
+		notes.On("RestoreNote", ctx, note).
+			Return(nil)
+		events.On("SaveEvent", ctx, mock.AnythingOfType("*entities.Event")).
+			Return(func(_ context.Context, event *entities.Event) error {
+				assert.Equal(t, entities.EventTypeRestored, event.EventType)
+
+				return nil
+			})
+
+		got, err := use.RestoreNote(ctx, owner, &v1.RestoreNoteInput{ID: note.ID.Value(), Version: 2})
+		require.NoError(t, err)
+		assert.False(t, got.IsTrashed())
+	})
+}
+
+func TestUseCasesPurgeNote(t *testing.T) {
+	t.Parallel()
+
+	uuid.SetGenerator(generate.NewUUID())
+
+	t.Run("not permit", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			ctx     = t.Context()
+			user    = &entities.User{ID: id.New(20)}
+			trashed = testkit.NowByMinute()
+			note    = &entities.Note{Owner: &entities.User{ID: id.New(10)}, DeletedAt: &trashed, ID: id.New(42)}
+			notes   = mocks.NewMockNotesRepository(t)
+			store   = ports.Store{Notes: notes}
+			use     = v1.NewCases(unitOfWork(store), store)
+		)
+
+		notes.On("GetNote", ctx, note.ID).
+			Return(note, nil)
+
+		err := use.PurgeNote(ctx, user, &v1.PurgeNoteInput{ID: note.ID.Value()})
+		require.ErrorIs(t, err, usecases.ErrPermissionDenied)
+	})
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			ctx     = t.Context()
+			owner   = &entities.User{ID: id.New(10)}
+			trashed = testkit.NowByMinute()
+			note    = &entities.Note{Owner: owner, DeletedAt: &trashed, ID: id.New(42), Version: 2}
+			notes   = mocks.NewMockNotesRepository(t)
+			files   = mocks.NewMockAttachmentsRepository(t)
+			events  = mocks.NewMockEventsRepository(t)
+			store   = ports.Store{Notes: notes, Attachments: files, Events: events}
+			use     = v1.NewCases(unitOfWork(store), store)
+		)
+
+		notes.On("GetNote", ctx, note.ID).
+			Return(note, nil)
+		files.On("DeleteAttachmentsByNote", ctx, note).
+			Return(nil)
+		notes.On("DeleteNote", ctx, note).
+			Return(nil)
+		events.On("SaveEvent", ctx, mock.AnythingOfType("*entities.Event")).
+			Return(func(_ context.Context, event *entities.Event) error {
+				assert.Equal(t, entities.EventTypePurged, event.EventType)
+
+				return nil
+			})
+
+		err := use.PurgeNote(ctx, owner, &v1.PurgeNoteInput{ID: note.ID.Value(), Version: 2})
+		require.NoError(t, err)
+	})
+}
+
+func TestUseCasesPurgeTrash(t *testing.T) {
+	t.Parallel()
+
+	uuid.SetGenerator(generate.NewUUID())
+
+	var (
+		ctx    = t.Context()
+		notes  = mocks.NewMockNotesRepository(t)
+		files  = mocks.NewMockAttachmentsRepository(t)
+		events = mocks.NewMockEventsRepository(t)
+		store  = ports.Store{Notes: notes, Attachments: files, Events: events}
+		use    = v1.NewCases(unitOfWork(store), store)
+	)
+
+	purged := []*entities.Note{
+		{Owner: &entities.User{ID: id.New(10)}, ID: id.New(7)},
+		{Owner: nil, ID: id.New(3)},
+	}
+	retained := mock.MatchedBy(func(before time.Time) bool {
+		return testkit.TimeByMinute(before).Equal(testkit.TimeByMinute(time.Now().Add(-time.Hour)))
+	})
+
+	files.On("PurgeAttachments", ctx, retained).
+		Return(nil)
+	notes.On("PurgeNotes", ctx, retained).
+		Return(purged, nil)
+	events.On("SaveEvent", ctx, mock.AnythingOfType("*entities.Event")).
+		Return(nil).
+		Once()
+
+	got, err := use.PurgeTrash(ctx, time.Hour)
+	require.NoError(t, err)
+	assert.Equal(t, 2, got)
+}
+
+func TestUseCasesAddNoteTags(t *testing.T) {
+	t.Parallel()
+
+	uuid.SetGenerator(generate.NewUUID())
+
+	t.Run("empty tag", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			ctx   = t.Context()
+			user  = new(entities.User)
+			store = ports.Store{}
+			use   = v1.NewCases(nil, store)
+		)
+
+		got, err := use.AddNoteTags(ctx, user, &v1.NoteTagsInput{Tags: []string{"work", "  "}, NoteID: 42})
+		require.ErrorIs(t, err, entities.ErrEmptyTag)
+		assert.Nil(t, got)
+	})
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			ctx    = t.Context()
+			owner  = &entities.User{ID: id.New(10)}
+			note   = &entities.Note{Owner: owner, ID: id.New(42), Version: 2}
+			tagged = &entities.Note{Owner: owner, Tags: []*entities.Tag{{Name: "work"}}, ID: id.New(42), Version: 3}
+			notes  = mocks.NewMockNotesRepository(t)
+			tags   = mocks.NewMockTagsRepository(t)
+			events = mocks.NewMockEventsRepository(t)
+			store  = ports.Store{Notes: notes, Tags: tags, Events: events}
+			use    = v1.NewCases(unitOfWork(store), store)
+		)
+
+		notes.On("GetNote", ctx, note.ID).
+			Return(note, nil).
+			Once()
+		tags.On("AddNoteTags", ctx, note, mock.AnythingOfType("[]*entities.Tag")).
+			Return(func(_ context.Context, _ *entities.Note, tags []*entities.Tag) error {
+				assert.Len(t, tags, 1)
+				assert.Equal(t, "work", tags[0].Name)
+				assert.Equal(t, owner, tags[0].Owner)
+
+				return nil
+			})
+		notes.On("TouchNote", ctx, note).
+			Return(nil)
+		notes.On("GetNote", ctx, note.ID).
+			Return(tagged, nil).
+			Once()
+		events.On("SaveEvent", ctx, mock.AnythingOfType("*entities.Event")).
+			Return(nil)
+
+		got, err := use.AddNoteTags(ctx, owner, &v1.NoteTagsInput{Tags: []string{" Work"}, NoteID: 42, Version: 2})
+		require.NoError(t, err)
+		assert.Equal(t, tagged, got)
+	})
+}
+
+func TestUseCasesRemoveNoteTags(t *testing.T) {
+	t.Parallel()
+
+	uuid.SetGenerator(generate.NewUUID())
+
+	var (
+		ctx    = t.Context()
+		owner  = &entities.User{ID: id.New(10)}
+		note   = &entities.Note{Owner: owner, ID: id.New(42), Version: 2}
+		notes  = mocks.NewMockNotesRepository(t)
+		tags   = mocks.NewMockTagsRepository(t)
+		events = mocks.NewMockEventsRepository(t)
+		store  = ports.Store{Notes: notes, Tags: tags, Events: events}
+		use    = v1.NewCases(unitOfWork(store), store)
+	)
+
+	notes.On("GetNote", ctx, note.ID).
+		Return(note, nil)
+	tags.On("RemoveNoteTags", ctx, note, []string{"work"}).
+		Return(nil)
+	notes.On("TouchNote", ctx, note).
+		Return(nil)
+	events.On("SaveEvent", ctx, mock.AnythingOfType("*entities.Event")).
+		Return(nil)
+
+	got, err := use.RemoveNoteTags(ctx, owner, &v1.NoteTagsInput{Tags: []string{"WORK"}, NoteID: 42, Version: 2})
+	require.NoError(t, err)
+	assert.Equal(t, note, got)
+}
+
+func TestUseCasesRenameTag(t *testing.T) {
+	t.Parallel()
+
+	t.Run("not found", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			ctx   = t.Context()
+			user  = &entities.User{ID: id.New(10)}
+			tags  = mocks.NewMockTagsRepository(t)
+			store = ports.Store{Tags: tags}
+			use   = v1.NewCases(unitOfWork(store), store)
+		)
+
+		tags.On("GetTag", ctx, user, "work").
+			Return(nil, v1.ErrTagNotFound)
+
+		got, err := use.RenameTag(ctx, user, &v1.RenameTagInput{Name: "Work", NewName: "job"})
+		require.ErrorIs(t, err, v1.ErrTagNotFound)
+		assert.Nil(t, got)
+	})
+
+	t.Run("rename", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			ctx   = t.Context()
+			user  = &entities.User{ID: id.New(10)}
+			tag   = &entities.Tag{Owner: user, Name: "work", ID: id.New(1)}
+			tags  = mocks.NewMockTagsRepository(t)
+			store = ports.Store{Tags: tags}
+			use   = v1.NewCases(unitOfWork(store), store)
+		)
+
+		tags.On("GetTag", ctx, user, "work").
+			Return(tag, nil)
+		tags.On("GetTag", ctx, user, "job").
+			Return(nil, v1.ErrTagNotFound)
+		tags.On("UpdateTag", ctx, tag).
+			Return(nil)
+
+		got, err := use.RenameTag(ctx, user, &v1.RenameTagInput{Name: "work", NewName: "Job"})
+		require.NoError(t, err)
+		assert.Equal(t, &entities.Tag{Owner: user, Name: "job", ID: id.New(1)}, got)
+	})
+
+	t.Run("merge", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			ctx   = t.Context()
+			user  = &entities.User{ID: id.New(10)}
+			tag   = &entities.Tag{Owner: user, Name: "work", ID: id.New(1)}
+			into  = &entities.Tag{Owner: user, Name: "job", ID: id.New(2)}
+			tags  = mocks.NewMockTagsRepository(t)
+			store = ports.Store{Tags: tags}
+			use   = v1.NewCases(unitOfWork(store), store)
+		)
+
+		tags.On("GetTag", ctx, user, "work").
+			Return(tag, nil)
+		tags.On("GetTag", ctx, user, "job").
+			Return(into, nil)
+		tags.On("MergeTag", ctx, tag, into).
+			Return(nil)
+
+		got, err := use.RenameTag(ctx, user, &v1.RenameTagInput{Name: "work", NewName: "job"})
+		require.NoError(t, err)
+		assert.Equal(t, into, got)
+	})
+}
+
+func TestUseCasesShareNote(t *testing.T) {
+	t.Parallel()
+
+	email.SetValidator(validate.NewEmail())
+	uuid.SetGenerator(generate.NewUUID())
+
+	t.Run("share with owner", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			ctx    = t.Context()
+			owner  = &entities.User{ID: id.New(10), Email: email.New("owner@gotes.dev")}
+			note   = &entities.Note{Owner: owner, ID: id.New(42)}
+			notes  = mocks.NewMockNotesRepository(t)
+			shares = mocks.NewMockSharesRepository(t)
+			store  = ports.Store{Notes: notes, Shares: shares}
+			use    = v1.NewCases(unitOfWork(store), store)
+		)
+
+		notes.On("GetNote", ctx, note.ID).
+			Return(note, nil)
+		shares.On("GetCollaborator", ctx, owner.Email).
+			Return(owner, nil)
+
+		got, err := use.ShareNote(ctx, owner, &v1.ShareNoteInput{Email: "owner@gotes.dev", Role: entities.RoleEditor, NoteID: 42})
+		require.ErrorIs(t, err, v1.ErrShareWithOwner)
+		assert.Nil(t, got)
+	})
+
+	t.Run("not owner", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			ctx   = t.Context()
+			user  = &entities.User{ID: id.New(20)}
+			note  = &entities.Note{Owner: &entities.User{ID: id.New(10)}, ID: id.New(42)}
+			notes = mocks.NewMockNotesRepository(t)
+			store = ports.Store{Notes: notes}
+			use   = v1.NewCases(unitOfWork(store), store)
+		)
+
+		notes.On("GetNote", ctx, note.ID).
+			Return(note, nil)
+
+		got, err := use.ShareNote(ctx, user, &v1.ShareNoteInput{Email: "mate@gotes.dev", Role: entities.RoleViewer, NoteID: 42})
+		require.ErrorIs(t, err, usecases.ErrPermissionDenied)
+		assert.Nil(t, got)
+	})
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			ctx    = t.Context()
+			owner  = &entities.User{ID: id.New(10)}
+			mate   = &entities.User{ID: id.New(20), Email: email.New("mate@gotes.dev")}
+			note   = &entities.Note{Owner: owner, ID: id.New(42)}
+			notes  = mocks.NewMockNotesRepository(t)
+			shares = mocks.NewMockSharesRepository(t)
+			events = mocks.NewMockEventsRepository(t)
+			store  = ports.Store{Notes: notes, Shares: shares, Events: events}
+			use    = v1.NewCases(unitOfWork(store), store)
+		)
+
+		notes.On("GetNote", ctx, note.ID).
+			Return(note, nil)
+		shares.On("GetCollaborator", ctx, mate.Email).
+			Return(mate, nil)
+		shares.On("SaveShare", ctx, mock.AnythingOfType("*entities.Share")).
+			Return(func(_ context.Context, share *entities.Share) (*entities.Share, error) {
+				return share, nil
+			})
+		events.On("SaveEvent", ctx, mock.MatchedBy(func(event *entities.Event) bool {
+			return event.EventType == entities.EventTypeShared && event.Recipient == mate && event.Note == note
+		})).
+			Return(nil)
+
+		got, err := use.ShareNote(ctx, owner, &v1.ShareNoteInput{Email: "mate@gotes.dev", Role: entities.RoleEditor, NoteID: 42})
+		require.NoError(t, err)
+		assert.Equal(t, note, got.Note)
+		assert.Equal(t, mate, got.User)
+		assert.Equal(t, entities.RoleEditor, got.Role)
+	})
+}
+
+func TestUseCasesUnshareNote(t *testing.T) {
+	t.Parallel()
+
+	email.SetValidator(validate.NewEmail())
+	uuid.SetGenerator(generate.NewUUID())
+
+	t.Run("not shared", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			ctx    = t.Context()
+			owner  = &entities.User{ID: id.New(10)}
+			mate   = &entities.User{ID: id.New(20), Email: email.New("mate@gotes.dev")}
+			note   = &entities.Note{Owner: owner, ID: id.New(42)}
+			notes  = mocks.NewMockNotesRepository(t)
+			shares = mocks.NewMockSharesRepository(t)
+			store  = ports.Store{Notes: notes, Shares: shares}
+			use    = v1.NewCases(unitOfWork(store), store)
+		)
+
+		notes.On("GetNote", ctx, note.ID).
+			Return(note, nil)
+		shares.On("GetCollaborator", ctx, mate.Email).
+			Return(mate, nil)
+		shares.On("DeleteShare", ctx, mock.AnythingOfType("*entities.Share")).
+			Return(v1.ErrShareNotFound)
+
+		err := use.UnshareNote(ctx, owner, &v1.UnshareNoteInput{Email: "mate@gotes.dev", NoteID: 42})
+		require.ErrorIs(t, err, v1.ErrShareNotFound)
+	})
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			ctx    = t.Context()
+			owner  = &entities.User{ID: id.New(10)}
+			mate   = &entities.User{ID: id.New(20), Email: email.New("mate@gotes.dev")}
+			note   = &entities.Note{Owner: owner, ID: id.New(42)}
+			notes  = mocks.NewMockNotesRepository(t)
+			shares = mocks.NewMockSharesRepository(t)
+			events = mocks.NewMockEventsRepository(t)
+			store  = ports.Store{Notes: notes, Shares: shares, Events: events}
+			use    = v1.NewCases(unitOfWork(store), store)
+		)
+
+		notes.On("GetNote", ctx, note.ID).
+			Return(note, nil)
+		shares.On("GetCollaborator", ctx, mate.Email).
+			Return(mate, nil)
+		shares.On("DeleteShare", ctx, mock.MatchedBy(func(share *entities.Share) bool {
+			return share.Note == note && share.User == mate
+		})).
+			Return(nil)
+		events.On("SaveEvent", ctx, mock.MatchedBy(func(event *entities.Event) bool {
+			return event.EventType == entities.EventTypeUnshared && event.Recipient == mate
+		})).
+			Return(nil)
+
+		err := use.UnshareNote(ctx, owner, &v1.UnshareNoteInput{Email: "mate@gotes.dev", NoteID: 42})
+		require.NoError(t, err)
+	})
+}
+
+func TestUseCasesListNoteShares(t *testing.T) {
+	t.Parallel()
+
+	t.Run("not owner", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			ctx   = t.Context()
+			user  = &entities.User{ID: id.New(20)}
+			note  = &entities.Note{Owner: &entities.User{ID: id.New(10)}, ID: id.New(42)}
+			notes = mocks.NewMockNotesRepository(t)
+			store = ports.Store{Notes: notes}
+			use   = v1.NewCases(unitOfWork(store), store)
+		)
+
+		notes.On("GetNote", ctx, note.ID).
+			Return(note, nil)
+
+		got, err := use.ListNoteShares(ctx, user, &v1.ListNoteSharesInput{NoteID: 42})
+		require.ErrorIs(t, err, usecases.ErrPermissionDenied)
+		assert.Nil(t, got)
+	})
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			ctx    = t.Context()
+			owner  = &entities.User{ID: id.New(10)}
+			note   = &entities.Note{Owner: owner, ID: id.New(42)}
+			want   = []*entities.Share{{Note: note, User: &entities.User{ID: id.New(20)}, Role: entities.RoleViewer}}
+			notes  = mocks.NewMockNotesRepository(t)
+			shares = mocks.NewMockSharesRepository(t)
+			store  = ports.Store{Notes: notes, Shares: shares}
+			use    = v1.NewCases(unitOfWork(store), store)
+		)
+
+		notes.On("GetNote", ctx, note.ID).
+			Return(note, nil)
+		shares.On("GetSharesByNote", ctx, note).
+			Return(want, nil)
+
+		got, err := use.ListNoteShares(ctx, owner, &v1.ListNoteSharesInput{NoteID: 42})
+		require.NoError(t, err)
+		assert.Equal(t, want, got)
+	})
+}
+
+func TestUseCasesSharedAccess(t *testing.T) {
+	t.Parallel()
+
+	uuid.SetGenerator(generate.NewUUID())
+
+	title := "title"
+
+	t.Run("viewer cannot update", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			ctx    = t.Context()
+			viewer = &entities.User{ID: id.New(20)}
+			note   = &entities.Note{Owner: &entities.User{ID: id.New(10)}, ID: id.New(42)}
+			notes  = mocks.NewMockNotesRepository(t)
+			shares = mocks.NewMockSharesRepository(t)
+			store  = ports.Store{Notes: notes, Shares: shares}
+			use    = v1.NewCases(unitOfWork(store), store)
+		)
+
+		notes.On("GetNote", ctx, note.ID).
+			Return(note, nil)
+		shares.On("GetShare", ctx, note, viewer).
+			Return(entities.NewShare(note, viewer, entities.RoleViewer), nil)
+
+		got, err := use.UpdateNote(ctx, viewer, &v1.UpdateNoteInput{ID: 42, Title: &title})
+		require.ErrorIs(t, err, usecases.ErrPermissionDenied)
+		assert.Nil(t, got)
+	})
+
+	t.Run("viewer can retrieve", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			ctx    = t.Context()
+			viewer = &entities.User{ID: id.New(20)}
+			note   = &entities.Note{Owner: &entities.User{ID: id.New(10)}, ID: id.New(42)}
+			notes  = mocks.NewMockNotesRepository(t)
+			shares = mocks.NewMockSharesRepository(t)
+			store  = ports.Store{Notes: notes, Shares: shares}
+			use    = v1.NewCases(unitOfWork(store), store)
+		)
+
+		notes.On("GetNote", ctx, note.ID).
+			Return(note, nil)
+		shares.On("GetShare", ctx, note, viewer).
+			Return(entities.NewShare(note, viewer, entities.RoleViewer), nil)
+
+		got, err := use.RetrieveNote(ctx, viewer, &v1.RetrieveNoteInput{ID: 42})
+		require.NoError(t, err)
+		assert.Equal(t, note, got)
+	})
+
+	t.Run("editor cannot delete", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			ctx    = t.Context()
+			editor = &entities.User{ID: id.New(20)}
+			note   = &entities.Note{Owner: &entities.User{ID: id.New(10)}, ID: id.New(42)}
+			notes  = mocks.NewMockNotesRepository(t)
+			store  = ports.Store{Notes: notes}
+			use    = v1.NewCases(unitOfWork(store), store)
+		)
+
+		notes.On("GetNote", ctx, note.ID).
+			Return(note, nil)
+
+		err := use.DeleteNote(ctx, editor, &v1.DeleteNoteInput{ID: 42})
+		require.ErrorIs(t, err, usecases.ErrPermissionDenied)
+	})
+
+	t.Run("editor can update", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			ctx       = t.Context()
+			owner     = &entities.User{ID: id.New(10)}
+			editor    = &entities.User{ID: id.New(20)}
+			note      = &entities.Note{Owner: owner, Title: "old", Content: "the content", ID: id.New(42), Version: 1}
+			notes     = mocks.NewMockNotesRepository(t)
+			shares    = mocks.NewMockSharesRepository(t)
+			revisions = mocks.NewMockRevisionsRepository(t)
+			events    = mocks.NewMockEventsRepository(t)
+			store     = ports.Store{Notes: notes, Revisions: revisions, Shares: shares, Events: events}
+			use       = v1.NewCases(unitOfWork(store), store)
+		)
+
+		notes.On("GetNote", ctx, note.ID).
+			Return(note, nil)
+		shares.On("GetShare", ctx, note, editor).
+			Return(entities.NewShare(note, editor, entities.RoleEditor), nil)
+		notes.On("UpdateNote", ctx, note).
+			Return(nil)
+		revisions.On("SaveRevision", ctx, mock.MatchedBy(func(revision *entities.Revision) bool {
+			return revision.Author == editor
+		})).
+			Return(new(entities.Revision), nil)
+		events.On("SaveEvent", ctx, mock.MatchedBy(func(event *entities.Event) bool {
+			return event.Recipient == owner
+		})).
+			Return(nil)
+
+		got, err := use.UpdateNote(ctx, editor, &v1.UpdateNoteInput{ID: 42, Title: &title, Version: 1})
+		require.NoError(t, err)
+		assert.Equal(t, title, got.Title)
+	})
+}
+
+func TestUseCasesListSharedNotes(t *testing.T) {
+	t.Parallel()
+
+	cursor.SetSigner(vault.NewCursorSigner("secret"))
+
+	var (
+		ctx    = t.Context()
+		now    = testkit.NowByMinute()
+		user   = &entities.User{ID: id.New(20)}
+		shares = mocks.NewMockSharesRepository(t)
+		store  = ports.Store{Shares: shares}
+		use    = v1.NewCases(nil, store)
+		found  = []*entities.Share{
+			{CreatedAt: now, Note: &entities.Note{ID: id.New(2)}, User: user, Role: entities.RoleViewer},
+			{CreatedAt: now.Add(-time.Minute), Note: &entities.Note{ID: id.New(1)}, User: user, Role: entities.RoleEditor},
+		}
+	)
+
+	shares.On("GetSharesByUser", ctx, user, &ports.SharesQuery{After: nil, Limit: 2}).
+		Return(found, nil)
+	shares.On("CountSharesByUser", ctx, user).
+		Return(int32(2), nil)
+
+	got, err := use.ListSharedNotes(ctx, user, &v1.ListSharedNotesInput{PageSize: 1})
+	require.NoError(t, err)
+	assert.Equal(t, found[:1], got.Shares)
+	assert.Equal(t, int32(2), got.TotalSize)
+	require.NotEmpty(t, got.NextPageToken)
+
+	shares.On("GetSharesByUser", ctx, user, mock.MatchedBy(func(query *ports.SharesQuery) bool {
+		return query.After != nil && query.After.Note.ID == found[0].Note.ID && query.After.CreatedAt.Equal(found[0].CreatedAt)
+	})).
+		Return(found[1:], nil)
+
+	got, err = use.ListSharedNotes(ctx, user, &v1.ListSharedNotesInput{PageToken: got.NextPageToken, PageSize: 1})
+	require.NoError(t, err)
+	assert.Equal(t, found[1:], got.Shares)
+	assert.Empty(t, got.NextPageToken)
+}
+
+func TestUseCasesCreateShareLink(t *testing.T) {
+	t.Parallel()
+
+	t.Run("not owner", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			ctx   = t.Context()
+			user  = &entities.User{ID: id.New(20)}
+			note  = &entities.Note{Owner: &entities.User{ID: id.New(10)}, ID: id.New(42)}
+			notes = mocks.NewMockNotesRepository(t)
+			store = ports.Store{Notes: notes}
+			use   = v1.NewCases(nil, store)
+		)
+
+		notes.On("GetNote", ctx, note.ID).
+			Return(note, nil)
+
+		got, err := use.CreateShareLink(ctx, user, &v1.CreateShareLinkInput{TTL: 0, NoteID: 42})
+		require.ErrorIs(t, err, usecases.ErrPermissionDenied)
+		assert.Nil(t, got)
+	})
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			ctx   = t.Context()
+			owner = &entities.User{ID: id.New(10)}
+			note  = &entities.Note{Owner: owner, ID: id.New(42)}
+			notes = mocks.NewMockNotesRepository(t)
+			links = mocks.NewMockLinksRepository(t)
+			store = ports.Store{Notes: notes, Links: links}
+			use   = v1.NewCases(nil, store)
+		)
+
+		notes.On("GetNote", ctx, note.ID).
+			Return(note, nil)
+		links.On("SaveLink", ctx, mock.AnythingOfType("*entities.Link")).
+			Return(func(_ context.Context, link *entities.Link) (*entities.Link, error) {
+				return link, nil
+			})
+
+		got, err := use.CreateShareLink(ctx, owner, &v1.CreateShareLinkInput{TTL: time.Hour, NoteID: 42})
+		require.NoError(t, err)
+		assert.Equal(t, note, got.Note)
+		assert.NotEmpty(t, got.Token)
+		require.NotNil(t, got.ExpiresAt)
+		assert.WithinDuration(t, time.Now().Add(time.Hour), *got.ExpiresAt, time.Minute)
+	})
+}
+
+func TestUseCasesRevokeShareLink(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx   = t.Context()
+		owner = &entities.User{ID: id.New(10)}
+		note  = &entities.Note{Owner: owner, ID: id.New(42)}
+		notes = mocks.NewMockNotesRepository(t)
+		links = mocks.NewMockLinksRepository(t)
+		store = ports.Store{Notes: notes, Links: links}
+		use   = v1.NewCases(nil, store)
+	)
+
+	notes.On("GetNote", ctx, note.ID).
+		Return(note, nil)
+	links.On("DeleteLink", ctx, mock.MatchedBy(func(link *entities.Link) bool {
+		return link.Note == note && link.Token == "token"
+	})).
+		Return(v1.ErrLinkNotFound)
+
+	err := use.RevokeShareLink(ctx, owner, &v1.RevokeShareLinkInput{Token: "token", NoteID: 42})
+	require.ErrorIs(t, err, v1.ErrLinkNotFound)
+}
+
+func TestUseCasesGetPublicNote(t *testing.T) {
+	t.Parallel()
+
+	t.Run("expired", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			ctx     = t.Context()
+			expired = time.Now().Add(-time.Minute)
+			link    = &entities.Link{ExpiresAt: &expired, Note: &entities.Note{ID: id.New(42)}, Token: "token"}
+			links   = mocks.NewMockLinksRepository(t)
+			store   = ports.Store{Links: links}
+			use     = v1.NewCases(unitOfWork(store), store)
+		)
+
+		links.On("GetLink", ctx, "token").
+			Return(link, nil)
+
+		got, err := use.GetPublicNote(ctx, &v1.GetPublicNoteInput{Token: "token"})
+		require.ErrorIs(t, err, v1.ErrLinkNotFound)
+		assert.Nil(t, got)
+	})
+
+	t.Run("trashed", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			ctx     = t.Context()
+			trashed = testkit.NowByMinute()
+			note    = &entities.Note{DeletedAt: &trashed, ID: id.New(42)}
+			link    = &entities.Link{Note: &entities.Note{ID: note.ID}, Token: "token"}
+			notes   = mocks.NewMockNotesRepository(t)
+			links   = mocks.NewMockLinksRepository(t)
+			store   = ports.Store{Notes: notes, Links: links}
+			use     = v1.NewCases(unitOfWork(store), store)
+		)
+
+		links.On("GetLink", ctx, "token").
+			Return(link, nil)
+		notes.On("GetNote", ctx, note.ID).
+			Return(note, nil)
+
+		got, err := use.GetPublicNote(ctx, &v1.GetPublicNoteInput{Token: "token"})
+		require.ErrorIs(t, err, v1.ErrLinkNotFound)
+		assert.Nil(t, got)
+	})
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			ctx   = t.Context()
+			note  = &entities.Note{Title: "title", ID: id.New(42)}
+			link  = &entities.Link{Note: &entities.Note{ID: note.ID}, Token: "token", Views: 2}
+			notes = mocks.NewMockNotesRepository(t)
+			links = mocks.NewMockLinksRepository(t)
+			store = ports.Store{Notes: notes, Links: links}
+			use   = v1.NewCases(unitOfWork(store), store)
+		)
+
+		links.On("GetLink", ctx, "token").
+			Return(link, nil)
+		notes.On("GetNote", ctx, note.ID).
+			Return(note, nil)
+		links.On("CountView", ctx, link).
+			Run(func(args mock.Arguments) {
+				args.Get(1).(*entities.Link).Views++
+			}).
+			Return(nil)
+
+		got, err := use.GetPublicNote(ctx, &v1.GetPublicNoteInput{Token: "token"})
+		require.NoError(t, err)
+		assert.Equal(t, note, got.Note)
+		assert.Equal(t, int64(3), got.Views)
+	})
+}
+
+func TestUseCasesCreateReminder(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid schedule", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			ctx   = t.Context()
+			owner = &entities.User{ID: id.New(10)}
+			note  = &entities.Note{Owner: owner, ID: id.New(42)}
+			notes = mocks.NewMockNotesRepository(t)
+			store = ports.Store{Notes: notes}
+			use   = v1.NewCases(nil, store)
+		)
+
+		notes.On("GetNote", ctx, note.ID).
+			Return(note, nil)
+
+		got, err := use.CreateReminder(ctx, owner, &v1.CreateReminderInput{
+			FireAt: time.Now(), Recurrence: "FREQ=SECONDLY", TimeZone: "", NoteID: 42,
+		})
+		require.ErrorIs(t, err, rrule.ErrInvalidRule)
+		assert.Nil(t, got)
+
+		got, err = use.CreateReminder(ctx, owner, &v1.CreateReminderInput{
+			FireAt: time.Now(), Recurrence: "FREQ=DAILY;COUNT=2;UNTIL=20300101", TimeZone: "", NoteID: 42,
+		})
+		require.ErrorIs(t, err, rrule.ErrInvalidRule)
+		assert.Nil(t, got)
+
+		got, err = use.CreateReminder(ctx, owner, &v1.CreateReminderInput{
+			FireAt: time.Now(), Recurrence: "", TimeZone: "Mars/Olympus", NoteID: 42,
+		})
+		require.ErrorIs(t, err, entities.ErrInvalidTimeZone)
+		assert.Nil(t, got)
+
+		got, err = use.CreateReminder(ctx, owner, &v1.CreateReminderInput{
+			FireAt: time.Now().AddDate(0, 0, -2), Recurrence: "", TimeZone: "", NoteID: 42,
+		})
+		require.ErrorIs(t, err, entities.ErrReminderInPast)
+		assert.Nil(t, got)
+	})
+
+	t.Run("not shared", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			ctx    = t.Context()
+			user   = &entities.User{ID: id.New(20)}
+			note   = &entities.Note{Owner: &entities.User{ID: id.New(10)}, ID: id.New(42)}
+			notes  = mocks.NewMockNotesRepository(t)
+			shares = mocks.NewMockSharesRepository(t)
+			store  = ports.Store{Notes: notes, Shares: shares}
+			use    = v1.NewCases(nil, store)
+		)
+
+		notes.On("GetNote", ctx, note.ID).
+			Return(note, nil)
+		shares.On("GetShare", ctx, note, user).
+			Return(nil, v1.ErrShareNotFound)
+
+		got, err := use.CreateReminder(ctx, user, &v1.CreateReminderInput{
+			FireAt: time.Now(), Recurrence: "", TimeZone: "", NoteID: 42,
+		})
+		require.ErrorIs(t, err, v1.ErrPermissionDenied)
+		assert.Nil(t, got)
+	})
+
+	t.Run("viewer", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			ctx       = t.Context()
+			user      = &entities.User{ID: id.New(20)}
+			note      = &entities.Note{Owner: &entities.User{ID: id.New(10)}, ID: id.New(42)}
+			notes     = mocks.NewMockNotesRepository(t)
+			shares    = mocks.NewMockSharesRepository(t)
+			reminders = mocks.NewMockRemindersRepository(t)
+			store     = ports.Store{Notes: notes, Shares: shares, Reminders: reminders}
+			use       = v1.NewCases(nil, store)
+			fireAt    = time.Date(2030, time.January, 7, 9, 0, 0, 0, time.UTC)
+		)
+
+		notes.On("GetNote", ctx, note.ID).
+			Return(note, nil)
+		shares.On("GetShare", ctx, note, user).
+			Return(entities.NewShare(note, user, entities.RoleViewer), nil)
+		reminders.On("SaveReminder", ctx, mock.AnythingOfType("*entities.Reminder")).
+			Return(func(_ context.Context, reminder *entities.Reminder) (*entities.Reminder, error) {
+				reminder.ID = id.New(1)
+
+				return reminder, nil
+			}).Once()
+
+		got, err := use.CreateReminder(ctx, user, &v1.CreateReminderInput{
+			FireAt: fireAt, Recurrence: "rrule:freq=weekly;byday=we,mo", TimeZone: "Europe/Berlin", NoteID: 42,
+		})
+		require.NoError(t, err)
+
+		assert.Equal(t, id.New(1), got.ID)
+		assert.Equal(t, note, got.Note)
+		assert.Equal(t, user, got.User)
+		assert.Equal(t, fireAt, *got.FireAt)
+		assert.Equal(t, "FREQ=WEEKLY;BYDAY=MO,WE", got.Recurrence.String())
+		assert.Equal(t, "Europe/Berlin", got.TimeZone)
+		assert.False(t, got.Done())
+	})
+}
+
+func TestUseCasesUpdateReminder(t *testing.T) {
+	t.Parallel()
+
+	owner := &entities.User{ID: id.New(10)}
+	note := &entities.Note{Owner: owner, ID: id.New(42)}
+
+	t.Run("nothing to update", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			ctx   = t.Context()
+			store = ports.Store{}
+			use   = v1.NewCases(nil, store)
+		)
+
+		got, err := use.UpdateReminder(ctx, owner, &v1.UpdateReminderInput{ID: 1, NoteID: 42})
+		require.ErrorIs(t, err, v1.ErrNothingToUpdate)
+		assert.Nil(t, got)
+	})
+
+	t.Run("reminder of another user", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			ctx        = t.Context()
+			notes      = mocks.NewMockNotesRepository(t)
+			reminders  = mocks.NewMockRemindersRepository(t)
+			store      = ports.Store{Notes: notes, Reminders: reminders}
+			use        = v1.NewCases(nil, store)
+			recurrence = "FREQ=DAILY"
+		)
+
+		notes.On("GetNote", ctx, note.ID).
+			Return(note, nil)
+		reminders.On("GetReminder", ctx, id.New(1)).
+			Return(&entities.Reminder{ID: id.New(1), Note: note, User: &entities.User{ID: id.New(20)}}, nil)
+
+		got, err := use.UpdateReminder(ctx, owner, &v1.UpdateReminderInput{Recurrence: &recurrence, ID: 1, NoteID: 42})
+		require.ErrorIs(t, err, v1.ErrReminderNotFound)
+		assert.Nil(t, got)
+	})
+
+	t.Run("finished reminder", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			ctx        = t.Context()
+			notes      = mocks.NewMockNotesRepository(t)
+			reminders  = mocks.NewMockRemindersRepository(t)
+			store      = ports.Store{Notes: notes, Reminders: reminders}
+			use        = v1.NewCases(nil, store)
+			recurrence = "FREQ=DAILY"
+		)
+
+		notes.On("GetNote", ctx, note.ID).
+			Return(note, nil)
+		reminders.On("GetReminder", ctx, id.New(1)).
+			Return(&entities.Reminder{ID: id.New(1), Note: note, User: owner, FireAt: nil}, nil)
+
+		got, err := use.UpdateReminder(ctx, owner, &v1.UpdateReminderInput{Recurrence: &recurrence, ID: 1, NoteID: 42})
+		require.ErrorIs(t, err, v1.ErrReminderTimeRequired)
+		assert.Nil(t, got)
+	})
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			ctx        = t.Context()
+			notes      = mocks.NewMockNotesRepository(t)
+			reminders  = mocks.NewMockRemindersRepository(t)
+			store      = ports.Store{Notes: notes, Reminders: reminders}
+			use        = v1.NewCases(nil, store)
+			fireAt     = time.Date(2030, time.January, 7, 9, 0, 0, 0, time.UTC)
+			recurrence = "FREQ=MONTHLY;INTERVAL=2"
+			reminder   = &entities.Reminder{
+				ID: id.New(1), Note: note, User: owner, FireAt: &fireAt, TimeZone: "UTC", Occurrences: 3,
+			}
+		)
+
+		notes.On("GetNote", ctx, note.ID).
+			Return(note, nil)
+		reminders.On("GetReminder", ctx, id.New(1)).
+			Return(reminder, nil)
+		reminders.On("UpdateReminder", ctx, reminder).
+			Return(nil).Once()
+
+		got, err := use.UpdateReminder(ctx, owner, &v1.UpdateReminderInput{Recurrence: &recurrence, ID: 1, NoteID: 42})
+		require.NoError(t, err)
+
+		assert.Equal(t, fireAt, *got.FireAt)
+		assert.Equal(t, recurrence, got.Recurrence.String())
+		assert.Zero(t, got.Occurrences)
+	})
+}
+
+func TestUseCasesDeleteReminder(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx       = t.Context()
+		owner     = &entities.User{ID: id.New(10)}
+		note      = &entities.Note{Owner: owner, ID: id.New(42)}
+		reminder  = &entities.Reminder{ID: id.New(1), Note: &entities.Note{ID: note.ID}, User: owner}
+		notes     = mocks.NewMockNotesRepository(t)
+		reminders = mocks.NewMockRemindersRepository(t)
+		store     = ports.Store{Notes: notes, Reminders: reminders}
+		use       = v1.NewCases(nil, store)
+	)
+
+	notes.On("GetNote", ctx, note.ID).
+		Return(note, nil)
+	reminders.On("GetReminder", ctx, id.New(1)).
+		Return(reminder, nil)
+	reminders.On("DeleteReminder", ctx, reminder).
+		Return(nil).Once()
+
+	err := use.DeleteReminder(ctx, owner, &v1.ReminderInput{ID: 1, NoteID: 42})
+	require.NoError(t, err)
+}
+
+func TestUseCasesMoveNote(t *testing.T) {
+	t.Parallel()
+
+	uuid.SetGenerator(generate.NewUUID())
+
+	t.Run("foreign notebook", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			ctx       = t.Context()
+			owner     = &entities.User{ID: id.New(10)}
+			note      = &entities.Note{Owner: owner, ID: id.New(42), Version: 2}
+			notebook  = &entities.Notebook{Owner: &entities.User{ID: id.New(20)}, ID: id.New(7)}
+			notes     = mocks.NewMockNotesRepository(t)
+			notebooks = mocks.NewMockNotebooksRepository(t)
+			store     = ports.Store{Notes: notes, Notebooks: notebooks}
+			use       = v1.NewCases(unitOfWork(store), store)
+		)
+
+		notes.On("GetNote", ctx, note.ID).
+			Return(note, nil)
+		notebooks.On("GetNotebook", ctx, notebook.ID).
+			Return(notebook, nil)
+
+		got, err := use.MoveNote(ctx, owner, &v1.MoveNoteInput{NotebookID: 7, ID: 42, Version: 2})
+		require.ErrorIs(t, err, v1.ErrNotebookNotFound)
+		assert.Nil(t, got)
+	})
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			ctx       = t.Context()
+			owner     = &entities.User{ID: id.New(10)}
+			note      = &entities.Note{Owner: owner, ID: id.New(42), Version: 2}
+			notebook  = &entities.Notebook{Owner: owner, ID: id.New(7)}
+			notes     = mocks.NewMockNotesRepository(t)
+			notebooks = mocks.NewMockNotebooksRepository(t)
+			events    = mocks.NewMockEventsRepository(t)
+			store     = ports.Store{Notes: notes, Notebooks: notebooks, Events: events}
+			use       = v1.NewCases(unitOfWork(store), store)
+		)
+
+		notes.On("GetNote", ctx, note.ID).
+			Return(note, nil)
+		notebooks.On("GetNotebook", ctx, notebook.ID).
+			Return(notebook, nil)
+		notes.On("MoveNote", ctx, note).
+			Return(nil)
+		events.On("SaveEvent", ctx, mock.AnythingOfType("*entities.Event")).
+			Return(nil)
+
+		got, err := use.MoveNote(ctx, owner, &v1.MoveNoteInput{NotebookID: 7, ID: 42, Version: 2})
+		require.NoError(t, err)
+		assert.Equal(t, notebook, got.Notebook)
+	})
+}
+
+func TestUseCasesCreateNotebook(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty name", func(t *testing.T) {
+		t.Parallel()
+
+		use := v1.NewCases(nil, ports.Store{})
+
+		got, err := use.CreateNotebook(t.Context(), new(entities.User), &v1.CreateNotebookInput{Name: " ", ParentID: 0})
+		require.ErrorIs(t, err, entities.ErrEmptyNotebookName)
+		assert.Nil(t, got)
+	})
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			ctx       = t.Context()
+			owner     = &entities.User{ID: id.New(10)}
+			parent    = &entities.Notebook{Owner: owner, ID: id.New(7)}
+			notebooks = mocks.NewMockNotebooksRepository(t)
+			store     = ports.Store{Notebooks: notebooks}
+			use       = v1.NewCases(unitOfWork(store), store)
+		)
+
+		notebooks.On("GetNotebook", ctx, parent.ID).
+			Return(parent, nil)
+		notebooks.On("SaveNotebook", ctx, mock.AnythingOfType("*entities.Notebook")).
+			Return(func(_ context.Context, notebook *entities.Notebook) (*entities.Notebook, error) {
+				notebook.ID = id.New(8)
+
+				return notebook, nil
+			})
+
+		got, err := use.CreateNotebook(ctx, owner, &v1.CreateNotebookInput{Name: " Work ", ParentID: 7})
+		require.NoError(t, err)
+		assert.Equal(t, "Work", got.Name)
+		assert.Equal(t, owner, got.Owner)
+		assert.Equal(t, parent, got.Parent)
+		assert.Equal(t, id.New(8), got.ID)
+	})
+}
+
+func TestUseCasesUpdateNotebook(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nothing to update", func(t *testing.T) {
+		t.Parallel()
+
+		use := v1.NewCases(nil, ports.Store{})
+
+		got, err := use.UpdateNotebook(t.Context(), new(entities.User), &v1.UpdateNotebookInput{ID: 7})
+		require.ErrorIs(t, err, v1.ErrNothingToUpdate)
+		assert.Nil(t, got)
+	})
+
+	t.Run("cycle", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			ctx       = t.Context()
+			owner     = &entities.User{ID: id.New(10)}
+			notebook  = &entities.Notebook{Owner: owner, ID: id.New(7)}
+			child     = &entities.Notebook{Owner: owner, Parent: notebook, ID: id.New(8)}
+			notebooks = mocks.NewMockNotebooksRepository(t)
+			store     = ports.Store{Notebooks: notebooks}
+			use       = v1.NewCases(unitOfWork(store), store)
+		)
+
+		notebooks.On("GetNotebook", ctx, notebook.ID).
+			Return(notebook, nil)
+		notebooks.On("LockNotebooks", ctx, owner).
+			Return(nil).Twice()
+		notebooks.On("GetNotebook", ctx, child.ID).
+			Return(child, nil)
+		notebooks.On("GetDescendants", ctx, notebook).
+			Return([]*entities.Notebook{child}, nil)
+
+		parentID := int64(8)
+
+		got, err := use.UpdateNotebook(ctx, owner, &v1.UpdateNotebookInput{ParentID: &parentID, ID: 7})
+		require.ErrorIs(t, err, v1.ErrNotebookCycle)
+		assert.Nil(t, got)
+
+		parentID = 7
+
+		got, err = use.UpdateNotebook(ctx, owner, &v1.UpdateNotebookInput{ParentID: &parentID, ID: 7})
+		require.ErrorIs(t, err, v1.ErrNotebookCycle)
+		assert.Nil(t, got)
+	})
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			ctx       = t.Context()
+			owner     = &entities.User{ID: id.New(10)}
+			parent    = &entities.Notebook{Owner: owner, ID: id.New(7)}
+			notebook  = &entities.Notebook{Owner: owner, Parent: parent, Name: "old", ID: id.New(8)}
+			notebooks = mocks.NewMockNotebooksRepository(t)
+			store     = ports.Store{Notebooks: notebooks}
+			use       = v1.NewCases(unitOfWork(store), store)
+		)
+
+		notebooks.On("GetNotebook", ctx, notebook.ID).
+			Return(notebook, nil)
+		notebooks.On("UpdateNotebook", ctx, notebook).
+			Return(nil)
+
+		name, parentID := "new", int64(0)
+
+		got, err := use.UpdateNotebook(ctx, owner, &v1.UpdateNotebookInput{Name: &name, ParentID: &parentID, ID: 8})
+		require.NoError(t, err)
+		assert.Equal(t, "new", got.Name)
+		assert.Nil(t, got.Parent)
+	})
+}
+
+func TestUseCasesDeleteNotebook(t *testing.T) {
+	t.Parallel()
+
+	uuid.SetGenerator(generate.NewUUID())
+
+	t.Run("not found", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			ctx       = t.Context()
+			notebooks = mocks.NewMockNotebooksRepository(t)
+			store     = ports.Store{Notebooks: notebooks}
+			use       = v1.NewCases(unitOfWork(store), store)
+		)
+
+		notebooks.On("GetNotebook", ctx, id.New(7)).
+			Return(nil, v1.ErrNotebookNotFound)
+
+		err := use.DeleteNotebook(ctx, new(entities.User), &v1.DeleteNotebookInput{ID: 7, Mode: v1.DeleteModeCascade})
+		require.ErrorIs(t, err, v1.ErrNotebookNotFound)
+	})
+
+	t.Run("move to root", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			ctx       = t.Context()
+			owner     = &entities.User{ID: id.New(10)}
+			notebook  = &entities.Notebook{Owner: owner, ID: id.New(7)}
+			notebooks = mocks.NewMockNotebooksRepository(t)
+			store     = ports.Store{Notebooks: notebooks}
+			use       = v1.NewCases(unitOfWork(store), store)
+		)
+
+		notebooks.On("GetNotebook", ctx, notebook.ID).
+			Return(notebook, nil)
+		notebooks.On("DetachNotebook", ctx, notebook).
+			Return(nil)
+		notebooks.On("DeleteNotebooks", ctx, []*entities.Notebook{notebook}).
+			Return(nil)
+
+		err := use.DeleteNotebook(ctx, owner, &v1.DeleteNotebookInput{ID: 7, Mode: v1.DeleteModeMoveToRoot})
+		require.NoError(t, err)
+	})
+
+	t.Run("cascade", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			ctx       = t.Context()
+			owner     = &entities.User{ID: id.New(10)}
+			notebook  = &entities.Notebook{Owner: owner, ID: id.New(7)}
+			child     = &entities.Notebook{Owner: owner, Parent: notebook, ID: id.New(8)}
+			all       = []*entities.Notebook{notebook, child}
+			trashed   = []*entities.Note{{Owner: owner, ID: id.New(42)}, {Owner: nil, ID: id.New(43)}}
+			notebooks = mocks.NewMockNotebooksRepository(t)
+			events    = mocks.NewMockEventsRepository(t)
+			store     = ports.Store{Notebooks: notebooks, Events: events}
+			use       = v1.NewCases(unitOfWork(store), store)
+		)
+
+		notebooks.On("GetNotebook", ctx, notebook.ID).
+			Return(notebook, nil)
+		notebooks.On("GetDescendants", ctx, notebook).
+			Return([]*entities.Notebook{child}, nil)
+		notebooks.On("TrashNotes", ctx, all, mock.AnythingOfType("time.Time")).
+			Return(trashed, nil)
+		events.On("SaveEvent", ctx, mock.MatchedBy(func(event *entities.Event) bool {
+			return event.EventType == entities.EventTypeDeleted && event.Note == trashed[0]
+		})).
+			Return(nil).Once()
+		notebooks.On("DeleteNotebooks", ctx, all).
+			Return(nil)
+
+		err := use.DeleteNotebook(ctx, owner, &v1.DeleteNotebookInput{ID: 7, Mode: v1.DeleteModeCascade})
+		require.NoError(t, err)
+	})
+}
+
+func TestUseCasesCreateTemplate(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid template", func(t *testing.T) {
+		t.Parallel()
+
+		use := v1.NewCases(nil, ports.Store{})
+
+		got, err := use.CreateTemplate(t.Context(), new(entities.User), &v1.CreateTemplateInput{
+			Name: " ", Title: "", Content: "the content",
+		})
+		require.ErrorIs(t, err, entities.ErrEmptyTemplateName)
+		assert.Nil(t, got)
+
+		got, err = use.CreateTemplate(t.Context(), new(entities.User), &v1.CreateTemplateInput{
+			Name: "Incident", Title: "Incident {{}}", Content: "the content",
+		})
+		require.ErrorIs(t, err, placeholder.ErrInvalidPlaceholder)
+		assert.Nil(t, got)
+	})
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			ctx       = t.Context()
+			owner     = &entities.User{ID: id.New(10)}
+			templates = mocks.NewMockTemplatesRepository(t)
+			store     = ports.Store{Templates: templates}
+			use       = v1.NewCases(nil, store)
+		)
+
+		templates.On("SaveTemplate", ctx, mock.AnythingOfType("*entities.Template")).
+			Return(func(_ context.Context, template *entities.Template) (*entities.Template, error) {
+				template.ID = id.New(3)
+
+				return template, nil
+			})
+
+		got, err := use.CreateTemplate(ctx, owner, &v1.CreateTemplateInput{
+			Name: " Incident ", Title: "Incident {{date}}", Content: "Impact: {{impact}}\nOwner: {{user.name}}",
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "Incident", got.Name)
+		assert.Equal(t, owner, got.Owner)
+		assert.Equal(t, id.New(3), got.ID)
+		assert.Equal(t, []string{"date", "impact", "user.name"}, got.Placeholders())
+	})
+}
+
+func TestUseCasesUpdateTemplate(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nothing to update", func(t *testing.T) {
+		t.Parallel()
+
+		use := v1.NewCases(nil, ports.Store{})
+
+		got, err := use.UpdateTemplate(t.Context(), new(entities.User), &v1.UpdateTemplateInput{ID: 3})
+		require.ErrorIs(t, err, v1.ErrNothingToUpdate)
+		assert.Nil(t, got)
+	})
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			ctx       = t.Context()
+			owner     = &entities.User{ID: id.New(10)}
+			template  = &entities.Template{Owner: owner, Name: "Meeting", Title: "", Content: "the content", ID: id.New(3)}
+			templates = mocks.NewMockTemplatesRepository(t)
+			store     = ports.Store{Templates: templates}
+			use       = v1.NewCases(unitOfWork(store), store)
+			title     = "Meeting {{date}}"
+		)
+
+		templates.On("GetTemplate", ctx, template.ID).
+			Return(template, nil)
+		templates.On("UpdateTemplate", ctx, template).
+			Return(nil).Once()
+
+		got, err := use.UpdateTemplate(ctx, owner, &v1.UpdateTemplateInput{Title: &title, ID: 3})
+		require.NoError(t, err)
+		assert.Equal(t, "Meeting {{date}}", got.Title)
+		assert.Equal(t, "Meeting", got.Name)
+
+		got, err = use.UpdateTemplate(ctx, &entities.User{ID: id.New(20)}, &v1.UpdateTemplateInput{Title: &title, ID: 3})
+		require.ErrorIs(t, err, v1.ErrTemplateNotFound)
+		assert.Nil(t, got)
+	})
+}
+
+func TestUseCasesDeleteTemplate(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx       = t.Context()
+		owner     = &entities.User{ID: id.New(10)}
+		template  = &entities.Template{Owner: owner, Name: "Meeting", Content: "the content", ID: id.New(3)}
+		templates = mocks.NewMockTemplatesRepository(t)
+		store     = ports.Store{Templates: templates}
+		use       = v1.NewCases(unitOfWork(store), store)
+	)
+
+	templates.On("GetTemplate", ctx, template.ID).
+		Return(template, nil)
+	templates.On("DeleteTemplate", ctx, template).
+		Return(nil).Once()
+
+	err := use.DeleteTemplate(ctx, &entities.User{ID: id.New(20)}, &v1.TemplateInput{ID: 3})
+	require.ErrorIs(t, err, v1.ErrTemplateNotFound)
+
+	err = use.DeleteTemplate(ctx, owner, &v1.TemplateInput{ID: 3})
+	require.NoError(t, err)
+}
+
+func TestUseCasesNoteReferences(t *testing.T) {
+	t.Parallel()
+
+	uuid.SetGenerator(generate.NewUUID())
+
+	t.Run("create", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			ctx    = t.Context()
+			user   = &entities.User{ID: id.New(10)}
+			input  = &v1.CreateNoteInput{Title: "title", Content: "see [[Roadmap]], [[42|the plan]] and [[Roadmap]]"}
+			notes  = mocks.NewMockNotesRepository(t)
+			revs   = mocks.NewMockRevisionsRepository(t)
+			refs   = mocks.NewMockReferencesRepository(t)
+			events = mocks.NewMockEventsRepository(t)
+			store  = ports.Store{Notes: notes, Revisions: revs, References: refs, Events: events}
+			use    = v1.NewCases(unitOfWork(store), store)
+		)
+
+		notes.On("SaveNote", ctx, mock.AnythingOfType("*entities.Note")).
+			Return(func(_ context.Context, note *entities.Note) *entities.Note {
+				note.ID = id.New(7)
+
+				return note
+			}, nil)
+		refs.On("SaveReferences", ctx, mock.AnythingOfType("*entities.Note"), mock.Anything).
+			Return(func(_ context.Context, note *entities.Note, references []*entities.Reference) error {
+				want := []*entities.Reference{
+					{Source: note, Link: wikilink.Target{Title: "Roadmap"}, Position: 0},
+					{Source: note, Link: wikilink.Target{ID: 42}, Position: 1},
+				}
+
+				assert.Equal(t, want, references)
+
+				return nil
+			}).Once()
+		revs.On("SaveRevision", ctx, mock.AnythingOfType("*entities.Revision")).
+			Return(func(_ context.Context, revision *entities.Revision) *entities.Revision {
+				return revision
+			}, nil)
+		events.On("SaveEvent", ctx, mock.AnythingOfType("*entities.Event")).
+			Return(nil)
+
+		_, err := use.CreateNote(ctx, user, input)
+		require.NoError(t, err)
+	})
+
+	t.Run("update", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			ctx    = t.Context()
+			owner  = &entities.User{ID: id.New(10)}
+			note   = &entities.Note{Owner: owner, Title: "title", Content: "[[Roadmap]]", ID: id.New(42), Version: 3}
+			notes  = mocks.NewMockNotesRepository(t)
+			revs   = mocks.NewMockRevisionsRepository(t)
+			refs   = mocks.NewMockReferencesRepository(t)
+			events = mocks.NewMockEventsRepository(t)
+			store  = ports.Store{Notes: notes, Revisions: revs, References: refs, Events: events}
+			use    = v1.NewCases(unitOfWork(store), store)
+		)
+
+		notes.On("GetNote", ctx, note.ID).
+			Return(note, nil)
+		notes.On("UpdateNote", ctx, note).
+			Return(nil)
+		revs.On("SaveRevision", ctx, mock.AnythingOfType("*entities.Revision")).
+			Return(func(_ context.Context, revision *entities.Revision) *entities.Revision {
+				return revision
+			}, nil)
+		events.On("SaveEvent", ctx, mock.AnythingOfType("*entities.Event")).
+			Return(nil)
+
+		// the links stay the same, so do the references
+		same := "more about [[ Roadmap | the roadmap ]]"
+
+		_, err := use.UpdateNote(ctx, owner, &v1.UpdateNoteInput{ID: 42, Content: &same, Version: 3})
+		require.NoError(t, err)
+
+		refs.On("SaveReferences", ctx, note, []*entities.Reference{}).
+			Return(ex.ErrUnknown).Once()
+
+		removed := "no links"
+
+		_, err = use.UpdateNote(ctx, owner, &v1.UpdateNoteInput{ID: 42, Content: &removed, Version: 3})
+		require.ErrorIs(t, err, ex.ErrUnknown)
+	})
+}
+
+func TestUseCasesListBacklinks(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx       = t.Context()
+		owner     = &entities.User{ID: id.New(10)}
+		note      = &entities.Note{Owner: owner, Title: "Roadmap", ID: id.New(42)}
+		backlinks = []*entities.Note{{Owner: owner, Title: "Plans", ID: id.New(7)}}
+		notes     = mocks.NewMockNotesRepository(t)
+		refs      = mocks.NewMockReferencesRepository(t)
+		store     = ports.Store{Notes: notes, References: refs}
+		use       = v1.NewCases(nil, store)
+	)
+
+	notes.On("GetNote", ctx, note.ID).
+		Return(note, nil)
+	refs.On("GetBacklinks", ctx, note).
+		Return(backlinks, nil).Once()
+
+	got, err := use.ListBacklinks(ctx, &entities.User{ID: id.New(20)}, &v1.ListLinksInput{NoteID: 42})
+	require.ErrorIs(t, err, usecases.ErrPermissionDenied)
+	assert.Nil(t, got)
+
+	got, err = use.ListBacklinks(ctx, owner, &v1.ListLinksInput{NoteID: 42})
+	require.NoError(t, err)
+	assert.Equal(t, backlinks, got)
+}
+
+func TestUseCasesListOutgoingLinks(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx   = t.Context()
+		owner = &entities.User{ID: id.New(10)}
+		note  = &entities.Note{Owner: owner, Content: "[[Roadmap]] [[Missing]]", ID: id.New(42)}
+		want  = []*entities.Reference{
+			{Source: note, Target: &entities.Note{Title: "Roadmap", ID: id.New(7)}, Link: wikilink.Target{Title: "Roadmap"}},
+			{Source: note, Link: wikilink.Target{Title: "Missing"}, Position: 1},
+		}
+		notes = mocks.NewMockNotesRepository(t)
+		refs  = mocks.NewMockReferencesRepository(t)
+		store = ports.Store{Notes: notes, References: refs}
+		use   = v1.NewCases(nil, store)
+	)
+
+	notes.On("GetNote", ctx, note.ID).
+		Return(note, nil)
+	refs.On("GetReferences", ctx, note).
+		Return(want, nil)
+
+	got, err := use.ListOutgoingLinks(ctx, owner, &v1.ListLinksInput{NoteID: -1})
+	require.ErrorIs(t, err, id.ErrInvalidID)
+	assert.Nil(t, got)
+
+	got, err = use.ListOutgoingLinks(ctx, owner, &v1.ListLinksInput{NoteID: 42})
+	require.NoError(t, err)
+	assert.Equal(t, want, got)
+	assert.False(t, got[0].IsDangling())
+	assert.True(t, got[1].IsDangling())
+}
+
+func TestUseCasesListDanglingLinks(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx   = t.Context()
+		user  = &entities.User{ID: id.New(10)}
+		refs  = mocks.NewMockReferencesRepository(t)
+		store = ports.Store{References: refs}
+		use   = v1.NewCases(nil, store)
+	)
+
+	refs.On("GetDanglingReferences", ctx, user).
+		Return(nil, ex.ErrUnknown)
+
+	got, err := use.ListDanglingLinks(ctx, user)
+	require.ErrorIs(t, err, ex.ErrUnknown)
+	assert.Nil(t, got)
+}
+
+func TestUseCasesBatchCreateNotes(t *testing.T) {
+	t.Parallel()
+
+	uuid.SetGenerator(generate.NewUUID())
+
+	t.Run("too large", func(t *testing.T) {
+		t.Parallel()
+
+		use := v1.NewCases(nil, ports.Store{})
+		use.SetBatchLimit(1)
+
+		got, err := use.BatchCreateNotes(t.Context(), new(entities.User), []*v1.CreateNoteInput{
+			{Title: "first", Content: "the content"},
+			{Title: "second", Content: "the content"},
+		})
+		require.ErrorIs(t, err, v1.ErrBatchTooLarge)
+		assert.Nil(t, got)
+	})
+
+	t.Run("store error", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			ctx   = t.Context()
+			notes = mocks.NewMockNotesRepository(t)
+			store = ports.Store{Notes: notes}
+			use   = v1.NewCases(unitOfWork(store), store)
+		)
+
+		notes.On("SaveNote", ctx, mock.AnythingOfType("*entities.Note")).
+			Return(nil, ex.ErrUnknown)
+
+		got, err := use.BatchCreateNotes(ctx, new(entities.User), []*v1.CreateNoteInput{
+			{Title: "title", Content: "the content"},
+		})
+		require.ErrorIs(t, err, ex.ErrUnknown)
+		assert.Nil(t, got)
+	})
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			ctx    = t.Context()
+			user   = new(entities.User)
+			notes  = mocks.NewMockNotesRepository(t)
+			revs   = mocks.NewMockRevisionsRepository(t)
+			events = mocks.NewMockEventsRepository(t)
+			store  = ports.Store{Notes: notes, Revisions: revs, Events: events}
+			use    = v1.NewCases(unitOfWork(store), store)
+		)
+
+		notes.On("SaveNote", ctx, mock.AnythingOfType("*entities.Note")).
+			Return(func(_ context.Context, note *entities.Note) (*entities.Note, error) {
+				note.ID = id.New(42)
+
+				return note, nil
+			}).Once()
+		revs.On("SaveRevision", ctx, mock.AnythingOfType("*entities.Revision")).
+			Return(func(_ context.Context, revision *entities.Revision) (*entities.Revision, error) {
+				return revision, nil
+			}).Once()
+		events.On("SaveEvents", ctx, mock.MatchedBy(func(events []*entities.Event) bool {
+			return len(events) == 1 && events[0].EventType == entities.EventTypeCreated
+		})).
+			Return(nil).Once()
+
+		got, err := use.BatchCreateNotes(ctx, user, []*v1.CreateNoteInput{
+			{Title: "", Content: "the content"},
+			{Title: "title", Content: "the content"},
+		})
+		require.NoError(t, err)
+		require.Len(t, got, 2)
+
+		require.ErrorIs(t, got[0].Err, entities.ErrEmptyTitle)
+		assert.Nil(t, got[0].Note)
+
+		require.NoError(t, got[1].Err)
+		assert.Equal(t, id.New(42), got[1].Note.ID)
+		assert.Equal(t, user, got[1].Note.Owner)
+	})
+}
+
+func TestUseCasesBatchDeleteNotes(t *testing.T) {
+	t.Parallel()
+
+	uuid.SetGenerator(generate.NewUUID())
+
+	var (
+		ctx     = t.Context()
+		owner   = &entities.User{ID: id.New(10)}
+		note    = &entities.Note{Owner: owner, ID: id.New(42), Version: 3}
+		foreign = &entities.Note{Owner: &entities.User{ID: id.New(20)}, ID: id.New(43)}
+		changed = &entities.Note{Owner: owner, ID: id.New(45), Version: 5}
+		blind   = &entities.Note{Owner: owner, ID: id.New(46), Version: 2}
+		notes   = mocks.NewMockNotesRepository(t)
+		shares  = mocks.NewMockSharesRepository(t)
+		events  = mocks.NewMockEventsRepository(t)
+		store   = ports.Store{Notes: notes, Shares: shares, Events: events}
+		use     = v1.NewCases(unitOfWork(store), store)
+	)
+
+	notes.On("GetNote", ctx, note.ID).
+		Return(note, nil)
+	notes.On("GetNote", ctx, foreign.ID).
+		Return(foreign, nil)
+	notes.On("GetNote", ctx, id.New(44)).
+		Return(nil, v1.ErrNoteNotFound)
+	notes.On("GetNote", ctx, changed.ID).
+		Return(changed, nil)
+	notes.On("GetNote", ctx, blind.ID).
+		Return(blind, nil)
+	notes.On("TrashNote", ctx, note).
+		Return(nil).Once()
+	events.On("SaveEvents", ctx, mock.MatchedBy(func(events []*entities.Event) bool {
+		return len(events) == 1 && events[0].EventType == entities.EventTypeDeleted && events[0].Note == note
+	})).
+		Return(nil).Once()
+
+	got, err := use.BatchDeleteNotes(ctx, owner, []*v1.DeleteNoteInput{
+		{ID: 42, Version: 3},
+		{ID: 43},
+		{ID: 44},
+		{ID: 0},
+		{ID: 45, Version: 4},
+		{ID: 46},
+	})
+	require.NoError(t, err)
+	require.Len(t, got, 6)
+
+	require.NoError(t, got[0].Err)
+	assert.NotNil(t, got[0].Note.DeletedAt)
+	require.ErrorIs(t, got[1].Err, v1.ErrPermissionDenied)
+	require.ErrorIs(t, got[2].Err, v1.ErrNoteNotFound)
+	require.ErrorIs(t, got[3].Err, id.ErrInvalidID)
+	require.ErrorIs(t, got[4].Err, v1.ErrVersionMismatch)
+	assert.Nil(t, changed.DeletedAt)
+	require.ErrorIs(t, got[5].Err, v1.ErrVersionRequired)
+	assert.Nil(t, blind.DeletedAt)
+}
+
+func TestUseCasesBatchGetNotes(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx     = t.Context()
+		owner   = &entities.User{ID: id.New(10)}
+		note    = &entities.Note{Owner: owner, ID: id.New(42)}
+		trashed = &entities.Note{Owner: owner, DeletedAt: new(time.Time), ID: id.New(43)}
+		notes   = mocks.NewMockNotesRepository(t)
+		store   = ports.Store{Notes: notes}
+		use     = v1.NewCases(unitOfWork(store), store)
+	)
+
+	notes.On("GetNote", ctx, note.ID).
+		Return(note, nil)
+	notes.On("GetNote", ctx, trashed.ID).
+		Return(trashed, nil)
+
+	got, err := use.BatchGetNotes(ctx, owner, []int64{42, 43})
+	require.NoError(t, err)
+	require.Len(t, got, 2)
+
+	assert.Equal(t, &v1.BatchResult{Note: note, Err: nil}, got[0])
+	require.ErrorIs(t, got[1].Err, v1.ErrNoteNotFound)
+}
+
+func TestUseCasesExportNotes(t *testing.T) {
+	t.Parallel()
+
+	t.Run("paging", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			ctx   = t.Context()
+			user  = new(entities.User)
+			page  = make([]*entities.Note, 100)
+			last  = &entities.Note{ID: id.New(101)}
+			notes = mocks.NewMockNotesRepository(t)
+			store = ports.Store{Notes: notes}
+			use   = v1.NewCases(nil, store)
+		)
+
+		for i := range page {
+			page[i] = &entities.Note{ID: id.New(int64(i + 1))}
+		}
+
+		notes.On("GetNotesByUser", ctx, user, mock.MatchedBy(func(query *ports.NotesQuery) bool {
+			return query.After == nil && query.Order.Field == ports.SortByCreatedAt && !query.Order.Descending
+		})).
+			Return(page, nil).Once()
+		notes.On("GetNotesByUser", ctx, user, mock.MatchedBy(func(query *ports.NotesQuery) bool {
+			return query.After == page[99]
+		})).
+			Return([]*entities.Note{last}, nil).Once()
+
+		exported := make([]*entities.Note, 0)
+
+		err := use.ExportNotes(ctx, user, func(note *entities.Note) error {
+			exported = append(exported, note)
+
+			return nil
+		})
+		require.NoError(t, err)
+		assert.Len(t, exported, 101)
+		assert.Equal(t, last, exported[100])
+	})
+
+	t.Run("archived", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			ctx      = t.Context()
+			user     = new(entities.User)
+			archived = &entities.Note{ID: id.New(1), Archived: true}
+			notes    = mocks.NewMockNotesRepository(t)
+			store    = ports.Store{Notes: notes}
+			use      = v1.NewCases(nil, store)
+		)
+
+		notes.On("GetNotesByUser", ctx, user, mock.MatchedBy(func(query *ports.NotesQuery) bool {
+			return query.Filter.Archived == ports.ArchivedIncluded
+		})).
+			Return([]*entities.Note{archived}, nil).Once()
+
+		exported := make([]*entities.Note, 0)
+
+		err := use.ExportNotes(ctx, user, func(note *entities.Note) error {
+			exported = append(exported, note)
+
+			return nil
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []*entities.Note{archived}, exported)
+	})
+
+	t.Run("export error", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			ctx   = t.Context()
+			user  = new(entities.User)
+			notes = mocks.NewMockNotesRepository(t)
+			store = ports.Store{Notes: notes}
+			use   = v1.NewCases(nil, store)
+		)
+
+		notes.On("GetNotesByUser", ctx, user, mock.AnythingOfType("*ports.NotesQuery")).
+			Return([]*entities.Note{{ID: id.New(1)}}, nil).Once()
+
+		err := use.ExportNotes(ctx, user, func(*entities.Note) error {
+			return ex.ErrUnknown
+		})
+		require.ErrorIs(t, err, ex.ErrUnknown)
+	})
+}
+
+func TestUseCasesImportNotes(t *testing.T) {
+	t.Parallel()
+
+	uuid.SetGenerator(generate.NewUUID())
+
+	t.Run("store error", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			ctx   = t.Context()
+			user  = new(entities.User)
+			notes = mocks.NewMockNotesRepository(t)
+			store = ports.Store{Notes: notes}
+			use   = v1.NewCases(unitOfWork(store), store)
+		)
+
+		notes.On("GetNoteByContent", ctx, user, "title", "the content").
+			Return(nil, ex.ErrUnknown)
+
+		got, err := use.ImportNotes(ctx, user, []*v1.ImportNoteInput{
+			{Title: "title", Content: "the content"},
+		})
+		require.ErrorIs(t, err, ex.ErrUnknown)
+		assert.Nil(t, got)
+	})
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			ctx       = t.Context()
+			user      = new(entities.User)
+			createdAt = time.Date(2024, time.March, 1, 10, 0, 0, 0, time.UTC)
+			existing  = &entities.Note{Owner: user, Title: "old note", Content: "the content", ID: id.New(7)}
+			notes     = mocks.NewMockNotesRepository(t)
+			revs      = mocks.NewMockRevisionsRepository(t)
+			tags      = mocks.NewMockTagsRepository(t)
+			events    = mocks.NewMockEventsRepository(t)
+			store     = ports.Store{Notes: notes, Revisions: revs, Tags: tags, Events: events}
+			use       = v1.NewCases(unitOfWork(store), store)
+		)
+
+		notes.On("GetNoteByContent", ctx, user, "old note", "the content").
+			Return(existing, nil).Once()
+		notes.On("GetNoteByContent", ctx, user, "new note", "the content").
+			Return(nil, v1.ErrNoteNotFound).Once()
+		notes.On("SaveNote", ctx, mock.AnythingOfType("*entities.Note")).
+			Return(func(_ context.Context, note *entities.Note) (*entities.Note, error) {
+				note.ID = id.New(42)
+
+				return note, nil
+			}).Once()
+		revs.On("SaveRevision", ctx, mock.AnythingOfType("*entities.Revision")).
+			Return(func(_ context.Context, revision *entities.Revision) (*entities.Revision, error) {
+				return revision, nil
+			}).Once()
+		tags.On("AddNoteTags", ctx, mock.AnythingOfType("*entities.Note"), mock.MatchedBy(func(tags []*entities.Tag) bool {
+			return len(tags) == 1 && tags[0].Name == "work" && tags[0].Owner == user
+		})).
+			Return(nil).Once()
+		events.On("SaveEvents", ctx, mock.MatchedBy(func(events []*entities.Event) bool {
+			return len(events) == 1 && events[0].EventType == entities.EventTypeCreated
+		})).
+			Return(nil).Once()
+
+		got, err := use.ImportNotes(ctx, user, []*v1.ImportNoteInput{
+			{Title: "old note", Content: "the content"},
+			{Title: "new note", Content: "the content", Tags: []string{"Work", " work "}, CreatedAt: createdAt},
+			{Title: "", Content: "the content"},
+			{Title: "tagged", Content: "the content", Tags: []string{" "}},
+		})
+		require.NoError(t, err)
+		require.Len(t, got, 4)
+
+		assert.Equal(t, &v1.ImportResult{Note: existing, Err: nil, Duplicate: true}, got[0])
+
+		require.NoError(t, got[1].Err)
+		assert.False(t, got[1].Duplicate)
+		assert.Equal(t, id.New(42), got[1].Note.ID)
+		assert.Equal(t, user, got[1].Note.Owner)
+		assert.Equal(t, createdAt, got[1].Note.CreatedAt)
+		assert.Len(t, got[1].Note.Tags, 1)
+
+		require.ErrorIs(t, got[2].Err, entities.ErrEmptyTitle)
+		require.ErrorIs(t, got[3].Err, entities.ErrEmptyTag)
+	})
+
+	t.Run("chunks", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			ctx      = t.Context()
+			user     = new(entities.User)
+			existing = &entities.Note{Owner: user, Title: "old note", Content: "the content", ID: id.New(7)}
+			notes    = mocks.NewMockNotesRepository(t)
+			events   = mocks.NewMockEventsRepository(t)
+			store    = ports.Store{Notes: notes, Events: events}
+			use      = v1.NewCases(unitOfWork(store), store)
+		)
+
+		use.SetBatchLimit(2)
+
+		notes.On("GetNoteByContent", ctx, user, "old note", "the content").
+			Return(existing, nil).Times(3)
+		// every chunk is imported by a transaction of its own
+		events.On("SaveEvents", ctx, []*entities.Event{}).
+			Return(nil).Twice()
+
+		got, err := use.ImportNotes(ctx, user, []*v1.ImportNoteInput{
+			{Title: "old note", Content: "the content"},
+			{Title: "old note", Content: "the content"},
+			{Title: "old note", Content: "the content"},
+		})
+		require.NoError(t, err)
+		require.Len(t, got, 3)
+
+		for _, result := range got {
+			assert.True(t, result.Duplicate)
+		}
+	})
+}
+
+func TestUseCasesUploadAttachment(t *testing.T) {
+	t.Parallel()
+
+	const checksum = "2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824" // hello
+
+	var (
+		owner = &entities.User{ID: id.New(10)}
+		note  = &entities.Note{Owner: owner, ID: id.New(42)}
+	)
+
+	store := func(t *testing.T) (ports.Store, *mocks.MockAttachmentsRepository, *mocks.MockBlobStorage) {
+		t.Helper()
+
+		notes := mocks.NewMockNotesRepository(t)
+		files := mocks.NewMockAttachmentsRepository(t)
+		blobs := mocks.NewMockBlobStorage(t)
+
+		notes.On("GetNote", mock.Anything, note.ID).
+			Return(note, nil)
+		blobs.On("Put", mock.Anything, mock.AnythingOfType("string"), mock.Anything).
+			Return(func(_ context.Context, _ string, content io.Reader) error {
+				_, err := io.Copy(io.Discard, content)
+
+				return err
+			}).Once()
+
+		return ports.Store{Notes: notes, Attachments: files, Blobs: blobs}, files, blobs
+	}
+
+	t.Run("too large", func(t *testing.T) {
+		t.Parallel()
+
+		store, _, blobs := store(t)
+		use := v1.NewCases(unitOfWork(store), store)
+		use.SetAttachmentLimit(4)
+
+		blobs.On("Delete", mock.Anything, mock.AnythingOfType("string")).
+			Return(nil).Once()
+
+		got, err := use.UploadAttachment(t.Context(), owner, &v1.UploadAttachmentInput{
+			Content: strings.NewReader("hello"),
+			Name:    "hello.txt",
+			NoteID:  42,
+		})
+		require.ErrorIs(t, err, v1.ErrAttachmentTooLarge)
+		assert.Nil(t, got)
+	})
+
+	t.Run("checksum mismatch", func(t *testing.T) {
+		t.Parallel()
+
+		store, _, blobs := store(t)
+		use := v1.NewCases(unitOfWork(store), store)
+
+		blobs.On("Delete", mock.Anything, mock.AnythingOfType("string")).
+			Return(nil).Once()
+
+		got, err := use.UploadAttachment(t.Context(), owner, &v1.UploadAttachmentInput{
+			Content:  strings.NewReader("hello!"),
+			Name:     "hello.txt",
+			Checksum: checksum,
+			NoteID:   42,
+		})
+		require.ErrorIs(t, err, v1.ErrChecksumMismatch)
+		assert.Nil(t, got)
+	})
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		store, files, _ := store(t)
+		use := v1.NewCases(unitOfWork(store), store)
+
+		files.On("SaveAttachment", mock.Anything, mock.AnythingOfType("*entities.Attachment")).
+			Return(func(_ context.Context, attachment *entities.Attachment) (*entities.Attachment, error) {
+				attachment.ID = id.New(7)
+
+				return attachment, nil
+			}).Once()
+
+		got, err := use.UploadAttachment(t.Context(), owner, &v1.UploadAttachmentInput{
+			Content:  strings.NewReader("hello"),
+			Name:     "hello.txt",
+			Checksum: strings.ToUpper(checksum),
+			NoteID:   42,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, id.New(7), got.ID)
+		assert.Equal(t, note, got.Note)
+		assert.Equal(t, owner, got.Owner)
+		assert.Equal(t, int64(5), got.Size)
+		assert.Equal(t, checksum, got.Checksum)
+		assert.Equal(t, entities.DefaultContentType, got.ContentType)
+		assert.NotEmpty(t, got.Key)
+	})
+}
+
+func TestUseCasesDownloadAttachment(t *testing.T) {
+	t.Parallel()
+
+	var (
+		owner    = &entities.User{ID: id.New(10)}
+		stranger = &entities.User{ID: id.New(20)}
+		note     = &entities.Note{Owner: owner, ID: id.New(42)}
+		notes    = mocks.NewMockNotesRepository(t)
+		files    = mocks.NewMockAttachmentsRepository(t)
+		shares   = mocks.NewMockSharesRepository(t)
+		blobs    = mocks.NewMockBlobStorage(t)
+		store    = ports.Store{Notes: notes, Attachments: files, Shares: shares, Blobs: blobs}
+		use      = v1.NewCases(unitOfWork(store), store)
+	)
+
+	attachment := func(key string) *entities.Attachment {
+		return &entities.Attachment{
+			Note:     &entities.Note{ID: note.ID},
+			Key:      key,
+			Checksum: "2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824",
+			Size:     5,
+		}
+	}
+
+	notes.On("GetNote", mock.Anything, note.ID).
+		Return(note, nil)
+	files.On("GetAttachment", mock.Anything, id.New(1)).
+		Return(attachment("valid"), nil)
+	files.On("GetAttachment", mock.Anything, id.New(2)).
+		Return(attachment("tampered"), nil)
+	files.On("GetAttachment", mock.Anything, id.New(3)).
+		Return(nil, v1.ErrAttachmentNotFound)
+	shares.On("GetShare", mock.Anything, note, stranger).
+		Return(nil, v1.ErrShareNotFound)
+	blobs.On("Get", mock.Anything, "valid").
+		Return(io.NopCloser(strings.NewReader("hello")), nil)
+	blobs.On("Get", mock.Anything, "tampered").
+		Return(io.NopCloser(strings.NewReader("hellO")), nil)
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		got, content, err := use.DownloadAttachment(t.Context(), owner, &v1.AttachmentInput{ID: 1})
+		require.NoError(t, err)
+		assert.Equal(t, note, got.Note)
+
+		data, err := io.ReadAll(content)
+		require.NoError(t, err)
+		assert.Equal(t, "hello", string(data))
+	})
+
+	t.Run("corrupted", func(t *testing.T) {
+		t.Parallel()
+
+		_, content, err := use.DownloadAttachment(t.Context(), owner, &v1.AttachmentInput{ID: 2})
+		require.NoError(t, err)
+
+		_, err = io.ReadAll(content)
+		require.ErrorIs(t, err, v1.ErrAttachmentCorrupted)
+	})
+
+	t.Run("not permit", func(t *testing.T) {
+		t.Parallel()
+
+		_, _, err := use.DownloadAttachment(t.Context(), stranger, &v1.AttachmentInput{ID: 1})
+		require.ErrorIs(t, err, v1.ErrPermissionDenied)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		t.Parallel()
+
+		_, _, err := use.DownloadAttachment(t.Context(), owner, &v1.AttachmentInput{ID: 3})
+		require.ErrorIs(t, err, v1.ErrAttachmentNotFound)
+	})
+}
+
+func TestUseCasesDeleteAttachment(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx        = t.Context()
+		owner      = &entities.User{ID: id.New(10)}
+		note       = &entities.Note{Owner: owner, ID: id.New(42)}
+		attachment = &entities.Attachment{Note: &entities.Note{ID: note.ID}, Key: "blob", ID: id.New(7)}
+		notes      = mocks.NewMockNotesRepository(t)
+		files      = mocks.NewMockAttachmentsRepository(t)
+		store      = ports.Store{Notes: notes, Attachments: files}
+		use        = v1.NewCases(unitOfWork(store), store)
+	)
+
+	notes.On("GetNote", ctx, note.ID).
+		Return(note, nil)
+	files.On("GetAttachment", ctx, attachment.ID).
+		Return(attachment, nil)
+	files.On("DeleteAttachment", ctx, attachment).
+		Return(nil).Once()
+
+	err := use.DeleteAttachment(ctx, owner, &v1.AttachmentInput{ID: 7})
+	require.NoError(t, err)
+}
+
+func TestUseCasesListAttachments(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx         = t.Context()
+		owner       = &entities.User{ID: id.New(10)}
+		note        = &entities.Note{Owner: owner, ID: id.New(42)}
+		attachments = []*entities.Attachment{{Note: note, ID: id.New(7)}}
+		notes       = mocks.NewMockNotesRepository(t)
+		files       = mocks.NewMockAttachmentsRepository(t)
+		store       = ports.Store{Notes: notes, Attachments: files}
+		use         = v1.NewCases(unitOfWork(store), store)
+	)
+
+	notes.On("GetNote", ctx, note.ID).
+		Return(note, nil)
+	files.On("GetAttachmentsByNote", ctx, note).
+		Return(attachments, nil)
+
+	got, err := use.ListAttachments(ctx, owner, &v1.ListAttachmentsInput{NoteID: 42})
+	require.NoError(t, err)
+	assert.Equal(t, attachments, got)
+}
+
+func TestUseCasesSweepBlobs(t *testing.T) {
+	t.Parallel()
+
+	buried := []string{"first", "second", "third"}
+
+	t.Run("empty", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			ctx   = t.Context()
+			files = mocks.NewMockAttachmentsRepository(t)
+			store = ports.Store{Attachments: files}
+			use   = v1.NewCases(unitOfWork(store), store)
+		)
+
+		files.On("LockBuriedBlobs", ctx, 10).
+			Return([]string{}, nil).Once()
+
+		swept, err := use.SweepBlobs(ctx, 10)
+		require.NoError(t, err)
+		assert.Zero(t, swept)
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			ctx   = t.Context()
+			files = mocks.NewMockAttachmentsRepository(t)
+			blobs = mocks.NewMockBlobStorage(t)
+			store = ports.Store{Attachments: files, Blobs: blobs}
+			use   = v1.NewCases(unitOfWork(store), store)
+			fail  = ex.Unexpected(ex.New("connection refused"))
+		)
+
+		files.On("LockBuriedBlobs", ctx, 10).
+			Return(buried, nil).Once()
+		blobs.On("Delete", ctx, "first").
+			Return(nil).Once()
+		blobs.On("Delete", ctx, "second").
+			Return(fail).Once()
+		files.On("ForgetBuriedBlobs", ctx, []string{"first"}).
+			Return(nil).Once()
+
+		swept, err := use.SweepBlobs(ctx, 10)
+		require.ErrorIs(t, err, fail)
+		assert.Equal(t, 1, swept)
+	})
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			ctx   = t.Context()
+			files = mocks.NewMockAttachmentsRepository(t)
+			blobs = mocks.NewMockBlobStorage(t)
+			store = ports.Store{Attachments: files, Blobs: blobs}
+			use   = v1.NewCases(unitOfWork(store), store)
+		)
+
+		files.On("LockBuriedBlobs", ctx, 10).
+			Return(buried, nil).Once()
+		blobs.On("Delete", ctx, mock.AnythingOfType("string")).
+			Return(nil).Times(len(buried))
+		files.On("ForgetBuriedBlobs", ctx, buried).
+			Return(nil).Once()
+
+		swept, err := use.SweepBlobs(ctx, 10)
+		require.NoError(t, err)
+		assert.Equal(t, 3, swept)
+	})
+}
+
+func TestUseCasesRelayEvents(t *testing.T) {
+	t.Parallel()
+
+	pending := []*entities.Event{
+		{Recipient: &entities.User{ID: id.New(10)}, EventType: entities.EventTypeCreated},
+		{Recipient: &entities.User{ID: id.New(20)}, EventType: entities.EventTypeShared},
+	}
+
+	t.Run("empty", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			ctx    = t.Context()
+			events = mocks.NewMockEventsRepository(t)
+			stream = mocks.NewMockEventStream(t)
+			store  = ports.Store{Events: events, Stream: stream}
+			use    = v1.NewCases(unitOfWork(store), store)
+		)
+
+		events.On("LockEvents", ctx, 10).
+			Return([]*entities.Event{}, nil).Once()
+
+		published, err := use.RelayEvents(ctx, 10)
+		require.NoError(t, err)
+		assert.Zero(t, published)
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			ctx    = t.Context()
+			events = mocks.NewMockEventsRepository(t)
+			stream = mocks.NewMockEventStream(t)
+			store  = ports.Store{Events: events, Stream: stream}
+			use    = v1.NewCases(unitOfWork(store), store)
+			fail   = ex.Unexpected(ex.New("connection refused"))
+		)
+
+		events.On("LockEvents", ctx, 10).
+			Return(pending, nil).Once()
+		stream.On("Publish", ctx, pending).
+			Return(fail).Once()
+		events.On("PostponeEvents", ctx, pending, fail.Error(), time.Second, 5*time.Minute).
+			Return(nil).Once()
+
+		published, err := use.RelayEvents(ctx, 10)
+		require.ErrorIs(t, err, fail)
+		assert.Zero(t, published)
+	})
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			ctx    = t.Context()
+			events = mocks.NewMockEventsRepository(t)
+			stream = mocks.NewMockEventStream(t)
+			store  = ports.Store{Events: events, Stream: stream}
+			use    = v1.NewCases(unitOfWork(store), store)
+		)
+
+		events.On("LockEvents", ctx, 10).
+			Return(pending, nil).Once()
+		stream.On("Publish", ctx, pending).
+			Return(nil).Once()
+		events.On("DeleteEvents", ctx, pending).
+			Return(nil).Once()
+
+		published, err := use.RelayEvents(ctx, 10)
+		require.NoError(t, err)
+		assert.Equal(t, 2, published)
+	})
+}
+
+func TestUseCasesFireReminders(t *testing.T) {
+	t.Parallel()
+
+	uuid.SetGenerator(generate.NewUUID())
+
+	owner := &entities.User{ID: id.New(10)}
+	note := &entities.Note{Owner: owner, ID: id.New(42)}
+
+	newReminder := func(t *testing.T, fireAt time.Time, recurrence, timeZone string) *entities.Reminder {
+		t.Helper()
+
+		reminder, err := entities.NewReminder(time.Now(), recurrence, timeZone)
+		require.NoError(t, err)
+
+		// the reminders may have missed their occurrences for any time
+		reminder.FireAt = &fireAt
+		reminder.ID = id.New(1)
+		reminder.SetNote(&entities.Note{ID: note.ID})
+		reminder.SetUser(owner)
+
+		return reminder
+	}
+
+	fire := func(t *testing.T, reminder *entities.Reminder) {
+		t.Helper()
+
+		var (
+			ctx       = t.Context()
+			notes     = mocks.NewMockNotesRepository(t)
+			events    = mocks.NewMockEventsRepository(t)
+			reminders = mocks.NewMockRemindersRepository(t)
+			store     = ports.Store{Notes: notes, Events: events, Reminders: reminders}
+			use       = v1.NewCases(unitOfWork(store), store)
+		)
+
+		reminders.On("LockDueReminders", ctx, 10).
+			Return([]*entities.Reminder{reminder}, nil).Once()
+		notes.On("GetNote", ctx, note.ID).
+			Return(note, nil).Once()
+		reminders.On("UpdateReminder", ctx, reminder).
+			Return(nil).Once()
+		events.On("SaveEvents", ctx, mock.MatchedBy(func(events []*entities.Event) bool {
+			return len(events) == 1 &&
+				events[0].EventType == entities.EventTypeReminder &&
+				events[0].Note == note &&
+				events[0].Recipient == owner
+		})).
+			Return(nil).Once()
+
+		fired, err := use.FireReminders(ctx, 10)
+		require.NoError(t, err)
+		assert.Equal(t, 1, fired)
+		require.NotNil(t, reminder.FiredAt)
+	}
+
+	t.Run("empty", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			ctx       = t.Context()
+			reminders = mocks.NewMockRemindersRepository(t)
+			store     = ports.Store{Reminders: reminders}
+			use       = v1.NewCases(unitOfWork(store), store)
+		)
+
+		reminders.On("LockDueReminders", ctx, 10).
+			Return([]*entities.Reminder{}, nil).Once()
+
+		fired, err := use.FireReminders(ctx, 10)
+		require.NoError(t, err)
+		assert.Zero(t, fired)
+	})
+
+	t.Run("once", func(t *testing.T) {
+		t.Parallel()
+
+		reminder := newReminder(t, time.Now().Add(-time.Minute), "", "")
+
+		fire(t, reminder)
+
+		assert.True(t, reminder.Done())
+		assert.Equal(t, int32(1), reminder.Occurrences)
+	})
+
+	t.Run("hourly skips missed occurrences", func(t *testing.T) {
+		t.Parallel()
+
+		start := time.Now().Add(-90 * time.Minute).Truncate(time.Second)
+		reminder := newReminder(t, start, "FREQ=HOURLY", "")
+
+		fire(t, reminder)
+
+		require.False(t, reminder.Done())
+		assert.True(t, start.Add(2*time.Hour).Equal(*reminder.FireAt))
+		assert.Equal(t, int32(2), reminder.Occurrences)
+	})
+
+	t.Run("hourly late for years", func(t *testing.T) {
+		t.Parallel()
+
+		start := time.Now().AddDate(-10, 0, 0).Truncate(time.Hour).Add(30 * time.Minute)
+		reminder := newReminder(t, start, "FREQ=HOURLY", "Europe/Berlin")
+
+		fire(t, reminder)
+
+		require.False(t, reminder.Done())
+		assert.True(t, reminder.FireAt.After(time.Now()))
+		assert.False(t, reminder.FireAt.After(time.Now().Add(time.Hour)))
+		assert.Equal(t, int32(reminder.FireAt.Sub(start)/time.Hour), reminder.Occurrences)
+	})
+
+	t.Run("daily late for years until count", func(t *testing.T) {
+		t.Parallel()
+
+		start := time.Now().AddDate(-10, 0, 0)
+		reminder := newReminder(t, start, "FREQ=DAILY;COUNT=1000", "")
+
+		fire(t, reminder)
+
+		assert.True(t, reminder.Done())
+		assert.Equal(t, int32(1000), reminder.Occurrences)
+	})
+
+	t.Run("weekly until count", func(t *testing.T) {
+		t.Parallel()
+
+		// Monday, then Wednesday and the next Monday, all of them missed
+		reminder := newReminder(t, time.Date(2020, time.January, 6, 9, 0, 0, 0, time.UTC), "FREQ=WEEKLY;BYDAY=MO,WE;COUNT=3", "")
+
+		fire(t, reminder)
+
+		assert.True(t, reminder.Done())
+		assert.Equal(t, int32(3), reminder.Occurrences)
+	})
+
+	t.Run("monthly skips short months", func(t *testing.T) {
+		t.Parallel()
+
+		// January 31st and March 31st, February and April have no 31st and May 31st is past the end
+		reminder := newReminder(t, time.Date(2020, time.January, 31, 9, 0, 0, 0, time.UTC), "FREQ=MONTHLY;UNTIL=20200501", "")
+
+		fire(t, reminder)
+
+		assert.True(t, reminder.Done())
+		assert.Equal(t, int32(2), reminder.Occurrences)
+	})
+
+	t.Run("daily keeps wall clock time", func(t *testing.T) {
+		t.Parallel()
+
+		berlin, err := time.LoadLocation("Europe/Berlin")
+		require.NoError(t, err)
+
+		// daylight saving time ends on October 25th, 2020 in Berlin
+		start := time.Date(2020, time.October, 24, 9, 0, 0, 0, berlin)
+		reminder := newReminder(t, start.UTC(), "FREQ=DAILY;COUNT=2", "Europe/Berlin")
+
+		fire(t, reminder)
+
+		assert.True(t, reminder.Done())
+		assert.Equal(t, int32(2), reminder.Occurrences)
+
+		next, ok := reminder.Recurrence.Next(start)
+		require.True(t, ok)
+		assert.Equal(t, 9, next.Hour())
+		assert.Equal(t, 25*time.Hour, next.Sub(start))
+	})
+
+	t.Run("lost access", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			ctx       = t.Context()
+			user      = &entities.User{ID: id.New(20)}
+			notes     = mocks.NewMockNotesRepository(t)
+			shares    = mocks.NewMockSharesRepository(t)
+			reminders = mocks.NewMockRemindersRepository(t)
+			store     = ports.Store{Notes: notes, Shares: shares, Reminders: reminders}
+			use       = v1.NewCases(unitOfWork(store), store)
+			reminder  = newReminder(t, time.Now().Add(-time.Minute), "", "")
+		)
+
+		reminder.SetUser(user)
+
+		reminders.On("LockDueReminders", ctx, 10).
+			Return([]*entities.Reminder{reminder}, nil).Once()
+		notes.On("GetNote", ctx, note.ID).
+			Return(note, nil).Once()
+		shares.On("GetShare", ctx, note, user).
+			Return(nil, v1.ErrShareNotFound).Once()
+		reminders.On("DeleteReminder", ctx, reminder).
+			Return(nil).Once()
+
+		fired, err := use.FireReminders(ctx, 10)
+		require.NoError(t, err)
+		assert.Zero(t, fired)
+	})
+
+	t.Run("trashed note", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			ctx       = t.Context()
+			deletedAt = time.Now()
+			trashed   = &entities.Note{Owner: owner, ID: note.ID, DeletedAt: &deletedAt}
+			notes     = mocks.NewMockNotesRepository(t)
+			reminders = mocks.NewMockRemindersRepository(t)
+			store     = ports.Store{Notes: notes, Reminders: reminders}
+			use       = v1.NewCases(unitOfWork(store), store)
+			reminder  = newReminder(t, time.Now().Add(-time.Minute), "", "")
+		)
+
+		reminders.On("LockDueReminders", ctx, 10).
+			Return([]*entities.Reminder{reminder}, nil).Once()
+		notes.On("GetNote", ctx, note.ID).
+			Return(trashed, nil).Once()
+		reminders.On("UpdateReminder", ctx, reminder).
+			Return(nil).Once()
+
+		fired, err := use.FireReminders(ctx, 10)
+		require.NoError(t, err)
+		assert.Zero(t, fired)
+		assert.True(t, reminder.Done())
+	})
+}
+
+func TestUseCasesEventsBacklog(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx    = t.Context()
+		events = mocks.NewMockEventsRepository(t)
+		store  = ports.Store{Events: events}
+		use    = v1.NewCases(unitOfWork(store), store)
+	)
+
+	events.On("Backlog", ctx).
+		Return(int64(3), time.Now().Add(-time.Minute), nil).Once()
+
+	count, lag, err := use.EventsBacklog(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), count)
+	assert.GreaterOrEqual(t, lag, time.Minute)
+}
+
+func TestUseCasesSubscribe(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx    = t.Context()
+		user   = &entities.User{ID: id.New(10)}
+		stream = mocks.NewMockEventStream(t)
+		store  = ports.Store{Stream: stream}
+		use    = v1.NewCases(unitOfWork(store), store)
+	)
+
+	stream.On("Subscribe", ctx, mock.AnythingOfType("*entities.Subscription"), "").
+		Return(nil).Times(3)
+
+	all, err := use.Subscribe(ctx, user, &v1.SubscribeInput{Device: "phone"})
+	require.NoError(t, err)
+	assert.Equal(t, "phone", all.Group())
+
+	filter := ports.EventsFilter{Tags: []string{"work"}}
+
+	work, err := use.Subscribe(ctx, user, &v1.SubscribeInput{Device: "phone", Filter: filter})
+	require.NoError(t, err)
+	assert.NotEqual(t, all.Group(), work.Group())
+
+	again, err := use.Subscribe(ctx, user, &v1.SubscribeInput{Device: "phone", Filter: filter})
+	require.NoError(t, err)
+	assert.Equal(t, work.Group(), again.Group())
+}
+
+func TestUseCasesWaitNextEvents(t *testing.T) {
+	t.Parallel()
+
+	uuid.SetGenerator(generate.NewUUID())
+
+	t.Run("timeout", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			ctx   = t.Context()
+			store = ports.Store{}
+			use   = v1.NewCases(unitOfWork(store), store)
+		)
+
+		got, err := use.WaitNextEvents(ctx, new(entities.Subscription), new(ports.EventsFilter), 0)
+		require.ErrorIs(t, err, v1.ErrZeroEvents)
+		assert.Nil(t, got)
+	})
+
+	t.Run("skip filtered", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			ctx     = t.Context()
+			user    = &entities.User{ID: id.New(10)}
+			sub     = entities.NewSubscription(user, "phone")
+			tags    = mocks.NewMockTagsRepository(t)
+			stream  = mocks.NewMockEventStream(t)
+			store   = ports.Store{Tags: tags, Stream: stream}
+			use     = v1.NewCases(unitOfWork(store), store)
+			timeout = mock.AnythingOfType("time.Duration")
+			filter  = &ports.EventsFilter{
+				Types: []entities.EventType{entities.EventTypeUpdated},
+				Tags:  []string{"work"},
+			}
+		)
+
+		created := &entities.Event{Note: &entities.Note{ID: id.New(1)}, ID: uuid.New()}
+		missing := &entities.Event{Note: &entities.Note{ID: id.New(2)}, ID: uuid.New(), EventType: entities.EventTypeUpdated}
+		updated := &entities.Event{Note: &entities.Note{ID: id.New(3)}, ID: uuid.New(), EventType: entities.EventTypeUpdated}
+		again := &entities.Event{Note: &entities.Note{ID: id.New(3)}, ID: uuid.New(), EventType: entities.EventTypeUpdated}
+
+		stream.On("WaitEvents", ctx, sub, 20, timeout).
+			Return([]*entities.Event{created}, nil).Once()
+		stream.On("AckEvent", ctx, sub, created).
+			Return(nil).Once()
+		stream.On("WaitEvents", ctx, sub, 20, timeout).
+			Return([]*entities.Event{missing, updated, again}, nil).Once()
+		// the tags of all the notes are loaded at once
+		tags.On("LoadNoteTags", ctx, mock.MatchedBy(func(notes []*entities.Note) bool {
+			return len(notes) == 2
+		})).
+			Return(func(_ context.Context, notes []*entities.Note) error {
+				for _, note := range notes {
+					if note.ID == updated.Note.ID {
+						note.Tags = []*entities.Tag{{Name: "home"}, {Name: "work"}}
+					}
+				}
+
+				return nil
+			}).Once()
+		stream.On("AckEvent", ctx, sub, missing).
+			Return(nil).Once()
+
+		got, err := use.WaitNextEvents(ctx, sub, filter, time.Minute)
+		require.NoError(t, err)
+		assert.Equal(t, []*entities.Event{updated, again}, got)
+	})
+}
+
+func TestUseCasesListEvents(t *testing.T) {
+	t.Parallel()
+
+	uuid.SetGenerator(generate.NewUUID())
+	cursor.SetSigner(vault.NewCursorSigner("secret"))
+
+	t.Run("invalid page token", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			ctx   = t.Context()
+			user  = &entities.User{ID: id.New(10)}
+			store = ports.Store{}
+			use   = v1.NewCases(unitOfWork(store), store)
+		)
+
+		got, err := use.ListEvents(ctx, user, &v1.ListEventsInput{PageToken: "invalid"})
+		require.ErrorIs(t, err, v1.ErrInvalidPageToken)
+		assert.Nil(t, got)
+	})
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			ctx    = t.Context()
+			user   = &entities.User{ID: id.New(10)}
+			now    = time.Now().UTC().Truncate(time.Second)
+			input  = &v1.ListEventsInput{PageSize: 2, Filter: ports.EventsFilter{Unread: true}}
+			events = mocks.NewMockEventsRepository(t)
+			store  = ports.Store{Events: events}
+			use    = v1.NewCases(unitOfWork(store), store)
+		)
+
+		page := []*entities.Event{
+			{EventTime: now, ID: uuid.New()},
+			{EventTime: now.Add(-time.Second), ID: uuid.New()},
+			{EventTime: now.Add(-2 * time.Second), ID: uuid.New()},
+		}
+
+		events.On("GetEventsByUser", ctx, user, &ports.EventsQuery{Filter: input.Filter, Limit: 3}).
+			Return(page, nil).
+			Once()
+		events.On("CountUnreadEvents", ctx, user, &input.Filter).
+			Return(int32(3), nil)
+
+		got, err := use.ListEvents(ctx, user, input)
+		require.NoError(t, err)
+
+		assert.Equal(t, page[:2], got.Events)
+		assert.Equal(t, int32(3), got.Unread)
+		assert.NotEmpty(t, got.NextPageToken)
+
+		input.PageToken = got.NextPageToken
+		after := &entities.Event{EventTime: page[1].EventTime, ID: page[1].ID}
+
+		events.On("GetEventsByUser", ctx, user, &ports.EventsQuery{After: after, Filter: input.Filter, Limit: 3}).
+			Return(page[2:], nil).
+			Once()
+
+		got, err = use.ListEvents(ctx, user, input)
 		require.NoError(t, err)
 
-		want := []*entities.Note{new(entities.Note), new(entities.Note)}
+		want := &v1.ListEventsOutput{Events: page[2:], Unread: 3}
 
 		assert.Equal(t, want, got)
 	})
 }
+
+func TestUseCasesMarkEventsRead(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nothing to update", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			ctx   = t.Context()
+			user  = &entities.User{ID: id.New(10)}
+			store = ports.Store{}
+			use   = v1.NewCases(unitOfWork(store), store)
+		)
+
+		got, err := use.MarkEventsRead(ctx, user, &v1.MarkEventsReadInput{})
+		require.ErrorIs(t, err, v1.ErrNothingToUpdate)
+		assert.Nil(t, got)
+	})
+
+	t.Run("invalid event id", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			ctx   = t.Context()
+			user  = &entities.User{ID: id.New(10)}
+			store = ports.Store{}
+			use   = v1.NewCases(unitOfWork(store), store)
+		)
+
+		got, err := use.MarkEventsRead(ctx, user, &v1.MarkEventsReadInput{IDs: []string{"invalid"}})
+		require.ErrorIs(t, err, v1.ErrInvalidEventID)
+		assert.Nil(t, got)
+	})
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			ctx    = t.Context()
+			user   = &entities.User{ID: id.New(10)}
+			upTo   = time.Now()
+			ident  = "0199f1b2-7c3a-7d4e-8f5a-1b2c3d4e5f60"
+			events = mocks.NewMockEventsRepository(t)
+			store  = ports.Store{Events: events}
+			use    = v1.NewCases(unitOfWork(store), store)
+		)
+
+		events.On("MarkEventsRead", ctx, user, []uuid.UUID{uuid.Conv(ident)}, &upTo).
+			Return(int32(4), nil).Once()
+		events.On("CountUnreadEvents", ctx, user, new(ports.EventsFilter)).
+			Return(int32(1), nil).Once()
+
+		got, err := use.MarkEventsRead(ctx, user, &v1.MarkEventsReadInput{IDs: []string{ident}, UpTo: &upTo})
+		require.NoError(t, err)
+		assert.Equal(t, &v1.MarkEventsReadOutput{Marked: 4, Unread: 1}, got)
+	})
+}
+
+func TestUseCasesJoinEdit(t *testing.T) {
+	t.Parallel()
+
+	uuid.SetGenerator(generate.NewUUID())
+
+	owner := &entities.User{ID: id.New(10)}
+	note := &entities.Note{Owner: owner, Content: "hello world", ID: id.New(42)}
+
+	t.Run("not shared", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			ctx    = t.Context()
+			user   = &entities.User{ID: id.New(20)}
+			notes  = mocks.NewMockNotesRepository(t)
+			shares = mocks.NewMockSharesRepository(t)
+			store  = ports.Store{Notes: notes, Shares: shares}
+			use    = v1.NewCases(nil, store)
+		)
+
+		notes.On("GetNote", ctx, note.ID).
+			Return(note, nil)
+		shares.On("GetShare", ctx, note, user).
+			Return(nil, v1.ErrShareNotFound)
+
+		got, err := use.JoinEdit(ctx, user, &v1.JoinEditInput{NoteID: 42})
+		require.ErrorIs(t, err, v1.ErrPermissionDenied)
+		assert.Nil(t, got)
+	})
+
+	t.Run("viewer", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			ctx    = t.Context()
+			user   = &entities.User{ID: id.New(20)}
+			notes  = mocks.NewMockNotesRepository(t)
+			shares = mocks.NewMockSharesRepository(t)
+			drafts = mocks.NewMockDrafts(t)
+			store  = ports.Store{Notes: notes, Shares: shares, Drafts: drafts}
+			use    = v1.NewCases(nil, store)
+			draft  = &entities.Draft{Note: note, Content: "hello, world", Position: "1700000000000-3", Revision: 3}
+		)
+
+		notes.On("GetNote", ctx, note.ID).
+			Return(note, nil)
+		shares.On("GetShare", ctx, note, user).
+			Return(entities.NewShare(note, user, entities.RoleViewer), nil)
+		drafts.On("OpenDraft", ctx, note).
+			Return(draft, nil).Once()
+
+		got, err := use.JoinEdit(ctx, user, &v1.JoinEditInput{NoteID: 42})
+		require.NoError(t, err)
+
+		assert.Equal(t, draft, got.Draft)
+		assert.Equal(t, user, got.Editor.User)
+		assert.Equal(t, note, got.Editor.Note)
+		assert.Equal(t, draft.Position, got.Editor.Position)
+		assert.NotEmpty(t, got.Editor.Session)
+		assert.False(t, got.Editor.CanWrite)
+	})
+
+	t.Run("owner", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			ctx    = t.Context()
+			notes  = mocks.NewMockNotesRepository(t)
+			drafts = mocks.NewMockDrafts(t)
+			store  = ports.Store{Notes: notes, Drafts: drafts}
+			use    = v1.NewCases(nil, store)
+		)
+
+		notes.On("GetNote", ctx, note.ID).
+			Return(note, nil)
+		drafts.On("OpenDraft", ctx, note).
+			Return(entities.NewDraft(note), nil).Once()
+
+		got, err := use.JoinEdit(ctx, owner, &v1.JoinEditInput{NoteID: 42})
+		require.NoError(t, err)
+
+		assert.Equal(t, note.Content, got.Draft.Content)
+		assert.True(t, got.Editor.CanWrite)
+	})
+
+	t.Run("note changed elsewhere", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			ctx     = t.Context()
+			changed = &entities.Note{Owner: owner, Content: "hello there", ID: id.New(43), Version: 3}
+			notes   = mocks.NewMockNotesRepository(t)
+			drafts  = mocks.NewMockDrafts(t)
+			store   = ports.Store{Notes: notes, Drafts: drafts}
+			use     = v1.NewCases(nil, store)
+			draft   = &entities.Draft{Note: changed, Content: "hello, world", Revision: 3, Saved: 3, Version: 2}
+		)
+
+		notes.On("GetNote", ctx, changed.ID).
+			Return(changed, nil)
+		drafts.On("OpenDraft", ctx, changed).
+			Return(draft, nil).Once()
+		drafts.On("SaveEdit", ctx, draft, mock.AnythingOfType("*entities.Edit")).
+			Return(func(_ context.Context, _ *entities.Draft, edit *entities.Edit) error {
+				assert.Equal(t, int64(4), edit.Revision)
+
+				edit.Position = "1700000000000-4"
+
+				return nil
+			}).Once()
+
+		got, err := use.JoinEdit(ctx, owner, &v1.JoinEditInput{NoteID: 43})
+		require.NoError(t, err)
+
+		assert.Equal(t, "hello there", got.Draft.Content)
+		assert.Equal(t, int64(3), got.Draft.Version)
+		assert.True(t, got.Draft.IsSaved())
+		// the editor has the reset draft already, so it follows the edits after the reset
+		assert.Equal(t, "1700000000000-4", got.Editor.Position)
+	})
+}
+
+func TestUseCasesApplyEdit(t *testing.T) {
+	t.Parallel()
+
+	uuid.SetGenerator(generate.NewUUID())
+
+	var (
+		owner = &entities.User{ID: id.New(10)}
+		note  = &entities.Note{Owner: owner, Content: "hello world", ID: id.New(42)}
+		// the other editor put a comma after hello, the draft is at the second revision
+		comma = &entities.Edit{
+			User:      &entities.User{ID: id.New(20)},
+			Operation: ot.Operation{}.Retain(5).Insert(",").Retain(6),
+			Revision:  2,
+		}
+		// the editor added an exclamation mark to the first revision of the draft
+		exclamation = ot.Operation{}.Retain(11).Insert("!")
+	)
+
+	draft := func() *entities.Draft {
+		return &entities.Draft{Note: note, Content: "hello, world", Revision: 2, Saved: 2}
+	}
+
+	t.Run("viewer", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			ctx    = t.Context()
+			editor = entities.NewEditor(&entities.User{ID: id.New(20)}, note, false)
+			use    = v1.NewCases(nil, ports.Store{})
+		)
+
+		got, err := use.ApplyEdit(ctx, editor, &v1.ApplyEditInput{Operation: exclamation, Revision: 1})
+		require.ErrorIs(t, err, v1.ErrPermissionDenied)
+		assert.Nil(t, got)
+	})
+
+	t.Run("invalid edits", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			ctx    = t.Context()
+			editor = entities.NewEditor(owner, note, true)
+			drafts = mocks.NewMockDrafts(t)
+			store  = ports.Store{Drafts: drafts}
+			use    = v1.NewCases(nil, store)
+		)
+
+		got, err := use.ApplyEdit(ctx, editor, &v1.ApplyEditInput{
+			Operation: ot.Operation{{Insert: "", Retain: 0, Delete: 0}},
+			Revision:  2,
+		})
+		require.ErrorIs(t, err, ot.ErrInvalidOperation)
+		assert.Nil(t, got)
+
+		drafts.On("GetDraft", ctx, note).
+			Return(func(context.Context, *entities.Note) (*entities.Draft, error) { return draft(), nil })
+
+		got, err = use.ApplyEdit(ctx, editor, &v1.ApplyEditInput{Operation: exclamation, Revision: 3})
+		require.ErrorIs(t, err, v1.ErrInvalidRevision)
+		assert.Nil(t, got)
+
+		drafts.On("GetEdits", ctx, draft(), int64(2)).
+			Return([]*entities.Edit{}, nil).Once()
+
+		got, err = use.ApplyEdit(ctx, editor, &v1.ApplyEditInput{Operation: exclamation, Revision: 2})
+		require.ErrorIs(t, err, ot.ErrLengthMismatch)
+		assert.Nil(t, got)
+
+		drafts.On("GetEdits", ctx, draft(), int64(0)).
+			Return(nil, v1.ErrRevisionTooOld).Once()
+
+		got, err = use.ApplyEdit(ctx, editor, &v1.ApplyEditInput{Operation: exclamation, Revision: 0})
+		require.ErrorIs(t, err, v1.ErrRevisionTooOld)
+		assert.Nil(t, got)
+	})
+
+	t.Run("concurrent edit", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			ctx    = t.Context()
+			editor = entities.NewEditor(owner, note, true)
+			drafts = mocks.NewMockDrafts(t)
+			store  = ports.Store{Drafts: drafts}
+			use    = v1.NewCases(nil, store)
+		)
+
+		drafts.On("GetDraft", ctx, note).
+			Return(draft(), nil).Once()
+		drafts.On("GetEdits", ctx, draft(), int64(1)).
+			Return([]*entities.Edit{comma}, nil).Once()
+		drafts.On("SaveEdit", ctx, mock.AnythingOfType("*entities.Draft"), mock.AnythingOfType("*entities.Edit")).
+			Return(func(_ context.Context, draft *entities.Draft, edit *entities.Edit) error {
+				assert.Equal(t, "hello, world!", draft.Content)
+				assert.Equal(t, int64(3), draft.Revision)
+				assert.Equal(t, int64(3), edit.Revision)
+
+				edit.Position = "1700000000000-4"
+
+				return nil
+			}).Once()
+
+		got, err := use.ApplyEdit(ctx, editor, &v1.ApplyEditInput{Operation: exclamation, Revision: 1})
+		require.NoError(t, err)
+
+		assert.Equal(t, ot.Operation{}.Retain(12).Insert("!"), got.Operation)
+		assert.Equal(t, editor.Session, got.Session)
+		assert.Equal(t, owner, got.User)
+		assert.Equal(t, "1700000000000-4", got.Position)
+		assert.True(t, editor.IsOwn(got))
+		assert.False(t, got.IsCursor())
+	})
+
+	t.Run("draft changed meanwhile", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			ctx    = t.Context()
+			editor = entities.NewEditor(owner, note, true)
+			drafts = mocks.NewMockDrafts(t)
+			store  = ports.Store{Drafts: drafts}
+			use    = v1.NewCases(nil, store)
+			latest = &entities.Draft{Note: note, Content: "hello, world", Revision: 3, Saved: 2}
+			// somebody else deleted the world before the edit was saved
+			deletion = &entities.Edit{
+				User:      &entities.User{ID: id.New(20)},
+				Operation: ot.Operation{}.Retain(6).Delete(6),
+				Revision:  3,
+			}
+		)
+
+		latest.Content = "hello,"
+
+		drafts.On("GetDraft", ctx, note).
+			Return(draft(), nil).Once()
+		drafts.On("GetEdits", ctx, draft(), int64(1)).
+			Return([]*entities.Edit{comma}, nil).Once()
+		drafts.On("SaveEdit", ctx, mock.AnythingOfType("*entities.Draft"), mock.AnythingOfType("*entities.Edit")).
+			Return(v1.ErrDraftChanged).Once()
+		drafts.On("GetDraft", ctx, note).
+			Return(latest, nil).Once()
+		drafts.On("GetEdits", ctx, latest, int64(1)).
+			Return([]*entities.Edit{comma, deletion}, nil).Once()
+		drafts.On("SaveEdit", ctx, latest, mock.AnythingOfType("*entities.Edit")).
+			Return(nil).Once()
+
+		got, err := use.ApplyEdit(ctx, editor, &v1.ApplyEditInput{Operation: exclamation, Revision: 1})
+		require.NoError(t, err)
+
+		assert.Equal(t, "hello,!", latest.Content)
+		assert.Equal(t, int64(4), got.Revision)
+	})
+}
+
+func TestUseCasesMoveCursor(t *testing.T) {
+	t.Parallel()
+
+	uuid.SetGenerator(generate.NewUUID())
+
+	var (
+		ctx    = t.Context()
+		user   = &entities.User{ID: id.New(20)}
+		note   = &entities.Note{Owner: &entities.User{ID: id.New(10)}, Content: "hello world", ID: id.New(42)}
+		editor = entities.NewEditor(user, note, false)
+		draft  = &entities.Draft{Note: note, Content: "hello, world", Revision: 2}
+		drafts = mocks.NewMockDrafts(t)
+		store  = ports.Store{Drafts: drafts}
+		use    = v1.NewCases(nil, store)
+		comma  = &entities.Edit{Operation: ot.Operation{}.Retain(5).Insert(",").Retain(6), Revision: 2}
+	)
+
+	drafts.On("GetDraft", ctx, note).
+		Return(draft, nil)
+	drafts.On("GetEdits", ctx, draft, int64(1)).
+		Return([]*entities.Edit{comma}, nil).Once()
+	drafts.On("SendCursor", ctx, note, mock.AnythingOfType("*entities.Edit")).
+		Return(func(_ context.Context, _ *entities.Note, edit *entities.Edit) error {
+			// the selection of the world moves along the comma
+			assert.Equal(t, &entities.Selection{Anchor: 7, Head: 12}, edit.Selection)
+			assert.Equal(t, int64(2), edit.Revision)
+			assert.Equal(t, editor.Session, edit.Session)
+			assert.True(t, edit.IsCursor())
+
+			return nil
+		}).Once()
+
+	err := use.MoveCursor(ctx, editor, &v1.MoveCursorInput{
+		Selection: entities.Selection{Anchor: 6, Head: 11},
+		Revision:  1,
+	})
+	require.NoError(t, err)
+
+	err = use.MoveCursor(ctx, editor, &v1.MoveCursorInput{Selection: entities.Selection{Anchor: 0, Head: 0}, Revision: -1})
+	require.ErrorIs(t, err, v1.ErrInvalidRevision)
+}
+
+func TestUseCasesWaitEdits(t *testing.T) {
+	t.Parallel()
+
+	uuid.SetGenerator(generate.NewUUID())
+
+	var (
+		ctx     = t.Context()
+		note    = &entities.Note{ID: id.New(42)}
+		editor  = entities.NewEditor(&entities.User{ID: id.New(10)}, note, true)
+		drafts  = mocks.NewMockDrafts(t)
+		store   = ports.Store{Drafts: drafts}
+		use     = v1.NewCases(nil, store)
+		edits   = []*entities.Edit{{Position: "1700000000000-0"}, {Position: "1700000000000-1"}}
+		timeout = time.Second
+	)
+
+	editor.Position = "0"
+
+	drafts.On("WaitEdits", ctx, note, "0", timeout).
+		Return(edits, nil).Once()
+	drafts.On("WaitEdits", ctx, note, "1700000000000-1", timeout).
+		Return(nil, v1.ErrZeroEdits).Once()
+
+	got, err := use.WaitEdits(ctx, editor, timeout)
+	require.NoError(t, err)
+	assert.Equal(t, edits, got)
+	assert.Equal(t, "1700000000000-1", editor.Position)
+
+	got, err = use.WaitEdits(ctx, editor, timeout)
+	require.ErrorIs(t, err, v1.ErrZeroEdits)
+	assert.Nil(t, got)
+	assert.Equal(t, "1700000000000-1", editor.Position)
+}
+
+func TestUseCasesSaveDraft(t *testing.T) {
+	t.Parallel()
+
+	uuid.SetGenerator(generate.NewUUID())
+
+	owner := &entities.User{ID: id.New(10)}
+
+	t.Run("nothing to save", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			ctx    = t.Context()
+			note   = &entities.Note{Owner: owner, Content: "hello world", ID: id.New(42)}
+			drafts = mocks.NewMockDrafts(t)
+			store  = ports.Store{Drafts: drafts}
+			use    = v1.NewCases(unitOfWork(store), store)
+		)
+
+		// viewers never save the draft
+		err := use.SaveDraft(ctx, entities.NewEditor(&entities.User{ID: id.New(20)}, note, false))
+		require.NoError(t, err)
+
+		drafts.On("GetDraft", ctx, note).
+			Return(&entities.Draft{Note: note, Content: "hello, world", Revision: 3, Saved: 3}, nil).Once()
+		drafts.On("GetDraft", ctx, note).
+			Return(&entities.Draft{Note: note, Content: "", Revision: 4, Saved: 3}, nil).Once()
+
+		err = use.SaveDraft(ctx, entities.NewEditor(owner, note, true))
+		require.NoError(t, err)
+
+		err = use.SaveDraft(ctx, entities.NewEditor(owner, note, true))
+		require.NoError(t, err)
+	})
+
+	t.Run("saved by another editor", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			ctx    = t.Context()
+			note   = &entities.Note{Owner: owner, Content: "hello, world", ID: id.New(42), Version: 3}
+			notes  = mocks.NewMockNotesRepository(t)
+			drafts = mocks.NewMockDrafts(t)
+			store  = ports.Store{Notes: notes, Drafts: drafts}
+			use    = v1.NewCases(unitOfWork(store), store)
+		)
+
+		drafts.On("GetDraft", ctx, note).
+			Return(&entities.Draft{Note: note, Content: "hello, world", Revision: 3, Saved: 1, Version: 2}, nil).Once()
+		notes.On("GetNote", ctx, note.ID).
+			Return(note, nil).Once()
+		notes.On("LockNote", ctx, note).
+			Return(nil).Once()
+		drafts.On("GetDraft", ctx, note).
+			Return(&entities.Draft{Note: note, Content: "hello, world", Revision: 3, Saved: 3, Version: 3}, nil).Once()
+
+		err := use.SaveDraft(ctx, entities.NewEditor(owner, note, true))
+		require.NoError(t, err)
+	})
+
+	t.Run("changed before the lock", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			ctx    = t.Context()
+			note   = &entities.Note{Owner: owner, Content: "hello world", ID: id.New(42), Version: 2}
+			notes  = mocks.NewMockNotesRepository(t)
+			drafts = mocks.NewMockDrafts(t)
+			store  = ports.Store{Notes: notes, Drafts: drafts}
+			use    = v1.NewCases(unitOfWork(store), store)
+		)
+
+		drafts.On("GetDraft", ctx, note).
+			Return(&entities.Draft{Note: note, Content: "hello, world", Revision: 3, Saved: 1, Version: 2}, nil).Once()
+		notes.On("GetNote", ctx, note.ID).
+			Return(note, nil).Once()
+		notes.On("LockNote", ctx, note).
+			Return(v1.ErrVersionMismatch).Once()
+
+		err := use.SaveDraft(ctx, entities.NewEditor(owner, note, true))
+		require.NoError(t, err)
+	})
+
+	t.Run("note changed elsewhere", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			ctx    = t.Context()
+			note   = &entities.Note{Owner: owner, Content: "hello there", ID: id.New(42), Version: 3}
+			draft  = &entities.Draft{Note: note, Content: "hello, world", Revision: 3, Saved: 1, Version: 2}
+			notes  = mocks.NewMockNotesRepository(t)
+			drafts = mocks.NewMockDrafts(t)
+			store  = ports.Store{Notes: notes, Drafts: drafts}
+			use    = v1.NewCases(unitOfWork(store), store)
+		)
+
+		drafts.On("GetDraft", ctx, note).
+			Return(draft, nil).Twice()
+		notes.On("GetNote", ctx, note.ID).
+			Return(note, nil).Once()
+		notes.On("LockNote", ctx, note).
+			Return(nil).Once()
+		drafts.On("SaveEdit", ctx, draft, mock.AnythingOfType("*entities.Edit")).
+			Return(nil).Once()
+
+		err := use.SaveDraft(ctx, entities.NewEditor(owner, note, true))
+		require.NoError(t, err)
+
+		// the update made elsewhere is kept, the draft continues from it
+		assert.Equal(t, "hello there", note.Content)
+		assert.Equal(t, "hello there", draft.Content)
+		assert.Equal(t, int64(3), draft.Version)
+		assert.True(t, draft.IsSaved())
+	})
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			ctx    = t.Context()
+			note   = &entities.Note{Owner: owner, Content: "hello world", ID: id.New(42), Version: 2}
+			draft  = &entities.Draft{Note: note, Content: "hello, world", Revision: 3, Saved: 1, Version: 2}
+			notes  = mocks.NewMockNotesRepository(t)
+			revs   = mocks.NewMockRevisionsRepository(t)
+			events = mocks.NewMockEventsRepository(t)
+			drafts = mocks.NewMockDrafts(t)
+			store  = ports.Store{Notes: notes, Revisions: revs, Events: events, Drafts: drafts}
+			use    = v1.NewCases(unitOfWork(store), store)
+		)
+
+		drafts.On("GetDraft", ctx, note).
+			Return(draft, nil).Twice()
+		notes.On("GetNote", ctx, note.ID).
+			Return(note, nil).Once()
+		notes.On("LockNote", ctx, note).
+			Return(nil).Once()
+		notes.On("UpdateNote", ctx, note).
+			Return(func(_ context.Context, note *entities.Note) error {
+				note.Version++
+
+				return nil
+			}).Once()
+		revs.On("SaveRevision", ctx, mock.AnythingOfType("*entities.Revision")).
+			Return(func(_ context.Context, revision *entities.Revision) *entities.Revision {
+				assert.Equal(t, "hello, world", revision.Content)
+				assert.Equal(t, owner, revision.Author)
+
+				return revision
+			}, nil).Once()
+		events.On("SaveEvent", ctx, mock.AnythingOfType("*entities.Event")).
+			Return(func(_ context.Context, event *entities.Event) error {
+				assert.Equal(t, entities.EventTypeUpdated, event.EventType)
+
+				return nil
+			}).Once()
+		drafts.On("MarkSaved", ctx, draft).
+			Return(nil).Once()
+
+		err := use.SaveDraft(ctx, entities.NewEditor(owner, note, true))
+		require.NoError(t, err)
+
+		assert.Equal(t, "hello, world", note.Content)
+		// the draft is based on the version it made
+		assert.Equal(t, int64(3), draft.Version)
+	})
+}
@@ -0,0 +1,85 @@
+package http
+
+import (
+	"net"
+	"net/http"
+	"net/netip"
+	"syscall"
+	"time"
+
+	"github.com/therenotomorrow/ex"
+)
+
+const ErrForbiddenAddress ex.Error = "webhook address is not public"
+
+// reserved are the networks not reachable from the internet besides the private, loopback and link-local ones,
+// e.g. the shared address space of carriers and the translation of IPv4 into IPv6.
+var reserved = []netip.Prefix{
+	netip.MustParsePrefix("0.0.0.0/8"),
+	netip.MustParsePrefix("100.64.0.0/10"),
+	netip.MustParsePrefix("192.0.0.0/24"),
+	netip.MustParsePrefix("198.18.0.0/15"),
+	netip.MustParsePrefix("240.0.0.0/4"),
+	netip.MustParsePrefix("64:ff9b::/96"),
+	netip.MustParsePrefix("64:ff9b:1::/48"),
+	netip.MustParsePrefix("2002::/16"),
+}
+
+// NewClient returns the client that reaches only the public addresses, so the webhooks cannot probe the network
+// of the server, e.g. the metadata of the cloud at 169.254.169.254. The addresses are checked after the names are
+// resolved, and the redirects are not followed, so neither the DNS nor the endpoint can lead the client elsewhere.
+// The allowed networks are reachable even though they are not public.
+func NewClient(timeout time.Duration, allowed ...netip.Prefix) *http.Client {
+	dialer := new(net.Dialer)
+	dialer.Timeout = timeout
+	dialer.Control = func(_, address string, _ syscall.RawConn) error {
+		addrPort, err := netip.ParseAddrPort(address)
+		if err != nil {
+			return ErrForbiddenAddress
+		}
+
+		addr := addrPort.Addr().Unmap()
+		for _, network := range allowed {
+			if network.Contains(addr) {
+				return nil
+			}
+		}
+
+		if !IsPublic(addr) {
+			return ErrForbiddenAddress
+		}
+
+		return nil
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone() //nolint:forcetypeassert // it is always a transport
+	// a proxy would dial the addresses on behalf of the client and skip the check
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+
+	client := new(http.Client)
+	client.Transport = transport
+	client.Timeout = timeout
+	client.CheckRedirect = func(*http.Request, []*http.Request) error {
+		return http.ErrUseLastResponse
+	}
+
+	return client
+}
+
+// IsPublic tells whether the address is reachable from the internet.
+func IsPublic(addr netip.Addr) bool {
+	addr = addr.Unmap()
+
+	if !addr.IsGlobalUnicast() || addr.IsPrivate() {
+		return false
+	}
+
+	for _, network := range reserved {
+		if network.Contains(addr) {
+			return false
+		}
+	}
+
+	return true
+}
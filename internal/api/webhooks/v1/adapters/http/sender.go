@@ -0,0 +1,107 @@
+package http
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/therenotomorrow/gotes/internal/domain/entities"
+)
+
+const (
+	// SignatureHeader carries the time of the request and the signature of the payload, e.g. `t=1700000000,v1=5f2b...`.
+	SignatureHeader = "X-Gotes-Signature"
+	// EventHeader carries the type of the event.
+	EventHeader = "X-Gotes-Event"
+	// DeliveryHeader carries the id of the event, it stays the same across the attempts to deliver the event.
+	DeliveryHeader = "X-Gotes-Delivery"
+
+	userAgent = "gotes-webhooks/1.0"
+	// responseLimit is how much of the response is read before the connection is closed.
+	responseLimit = 64 << 10
+)
+
+// payload is the body of the request, it describes the event.
+type payload struct {
+	EventTime time.Time `json:"eventTime"`
+	ID        string    `json:"id"`
+	Type      string    `json:"type"`
+	WebhookID int64     `json:"webhookId"`
+	NoteID    int64     `json:"noteId"`
+	UserID    int64     `json:"userId"`
+	Attempt   int32     `json:"attempt"`
+}
+
+// Sender posts the events as JSON signed with HMAC-SHA256 by the secret of the webhook.
+type Sender struct {
+	client *http.Client
+}
+
+func NewSender(client *http.Client) *Sender {
+	return &Sender{client: client}
+}
+
+func (s *Sender) Send(ctx context.Context, delivery *entities.Delivery) *entities.Attempt {
+	attempt := entities.NewAttempt(delivery)
+
+	body, err := json.Marshal(&payload{
+		EventTime: delivery.Event.EventTime.UTC(),
+		ID:        delivery.Event.ID.Value(),
+		Type:      delivery.Event.EventType.String(),
+		WebhookID: delivery.Webhook.ID.Value(),
+		NoteID:    delivery.Event.Note.ID.Value(),
+		UserID:    delivery.Event.Recipient.ID.Value(),
+		Attempt:   delivery.Attempts,
+	})
+	if err != nil {
+		attempt.Finish(0, err)
+
+		return attempt
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, delivery.Webhook.URL, bytes.NewReader(body))
+	if err != nil {
+		attempt.Finish(0, err)
+
+		return attempt
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", userAgent)
+	req.Header.Set(EventHeader, delivery.Event.EventType.String())
+	req.Header.Set(DeliveryHeader, delivery.Event.ID.Value())
+	req.Header.Set(SignatureHeader, Sign(delivery.Webhook.Secret, attempt.AttemptedAt, body))
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		attempt.Finish(0, err)
+
+		return attempt
+	}
+
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, responseLimit))
+	_ = resp.Body.Close()
+
+	attempt.Finish(resp.StatusCode, nil)
+
+	return attempt
+}
+
+// Sign returns the value of the signature header, the signed message is the unix time of the request,
+// a dot and the body, so the receivers can refuse replayed requests.
+func Sign(secret string, now time.Time, body []byte) string {
+	timestamp := strconv.FormatInt(now.Unix(), 10)
+
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp + "."))
+	mac.Write(body)
+
+	return "t=" + timestamp + ",v1=" + hex.EncodeToString(mac.Sum(nil))
+}
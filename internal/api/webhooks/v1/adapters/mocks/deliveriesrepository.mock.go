@@ -0,0 +1,505 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"context"
+	"time"
+
+	mock "github.com/stretchr/testify/mock"
+	"github.com/therenotomorrow/gotes/internal/domain/entities"
+)
+
+// NewMockDeliveriesRepository creates a new instance of MockDeliveriesRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockDeliveriesRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockDeliveriesRepository {
+	mock := &MockDeliveriesRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockDeliveriesRepository is an autogenerated mock type for the DeliveriesRepository type
+type MockDeliveriesRepository struct {
+	mock.Mock
+}
+
+type MockDeliveriesRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockDeliveriesRepository) EXPECT() *MockDeliveriesRepository_Expecter {
+	return &MockDeliveriesRepository_Expecter{mock: &_m.Mock}
+}
+
+// ClaimDeliveries provides a mock function for the type MockDeliveriesRepository
+func (_mock *MockDeliveriesRepository) ClaimDeliveries(ctx context.Context, limit int, lease time.Duration) ([]*entities.Delivery, error) {
+	ret := _mock.Called(ctx, limit, lease)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ClaimDeliveries")
+	}
+
+	var r0 []*entities.Delivery
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int, time.Duration) ([]*entities.Delivery, error)); ok {
+		return returnFunc(ctx, limit, lease)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int, time.Duration) []*entities.Delivery); ok {
+		r0 = returnFunc(ctx, limit, lease)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.Delivery)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int, time.Duration) error); ok {
+		r1 = returnFunc(ctx, limit, lease)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockDeliveriesRepository_ClaimDeliveries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ClaimDeliveries'
+type MockDeliveriesRepository_ClaimDeliveries_Call struct {
+	*mock.Call
+}
+
+// ClaimDeliveries is a helper method to define mock.On call
+//   - ctx context.Context
+//   - limit int
+//   - lease time.Duration
+func (_e *MockDeliveriesRepository_Expecter) ClaimDeliveries(ctx interface{}, limit interface{}, lease interface{}) *MockDeliveriesRepository_ClaimDeliveries_Call {
+	return &MockDeliveriesRepository_ClaimDeliveries_Call{Call: _e.mock.On("ClaimDeliveries", ctx, limit, lease)}
+}
+
+func (_c *MockDeliveriesRepository_ClaimDeliveries_Call) Run(run func(ctx context.Context, limit int, lease time.Duration)) *MockDeliveriesRepository_ClaimDeliveries_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int
+		if args[1] != nil {
+			arg1 = args[1].(int)
+		}
+		var arg2 time.Duration
+		if args[2] != nil {
+			arg2 = args[2].(time.Duration)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockDeliveriesRepository_ClaimDeliveries_Call) Return(deliverys []*entities.Delivery, err error) *MockDeliveriesRepository_ClaimDeliveries_Call {
+	_c.Call.Return(deliverys, err)
+	return _c
+}
+
+func (_c *MockDeliveriesRepository_ClaimDeliveries_Call) RunAndReturn(run func(ctx context.Context, limit int, lease time.Duration) ([]*entities.Delivery, error)) *MockDeliveriesRepository_ClaimDeliveries_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteDeliveries provides a mock function for the type MockDeliveriesRepository
+func (_mock *MockDeliveriesRepository) DeleteDeliveries(ctx context.Context, webhook *entities.Webhook) error {
+	ret := _mock.Called(ctx, webhook)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteDeliveries")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *entities.Webhook) error); ok {
+		r0 = returnFunc(ctx, webhook)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockDeliveriesRepository_DeleteDeliveries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteDeliveries'
+type MockDeliveriesRepository_DeleteDeliveries_Call struct {
+	*mock.Call
+}
+
+// DeleteDeliveries is a helper method to define mock.On call
+//   - ctx context.Context
+//   - webhook *entities.Webhook
+func (_e *MockDeliveriesRepository_Expecter) DeleteDeliveries(ctx interface{}, webhook interface{}) *MockDeliveriesRepository_DeleteDeliveries_Call {
+	return &MockDeliveriesRepository_DeleteDeliveries_Call{Call: _e.mock.On("DeleteDeliveries", ctx, webhook)}
+}
+
+func (_c *MockDeliveriesRepository_DeleteDeliveries_Call) Run(run func(ctx context.Context, webhook *entities.Webhook)) *MockDeliveriesRepository_DeleteDeliveries_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *entities.Webhook
+		if args[1] != nil {
+			arg1 = args[1].(*entities.Webhook)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockDeliveriesRepository_DeleteDeliveries_Call) Return(err error) *MockDeliveriesRepository_DeleteDeliveries_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockDeliveriesRepository_DeleteDeliveries_Call) RunAndReturn(run func(ctx context.Context, webhook *entities.Webhook) error) *MockDeliveriesRepository_DeleteDeliveries_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteDelivery provides a mock function for the type MockDeliveriesRepository
+func (_mock *MockDeliveriesRepository) DeleteDelivery(ctx context.Context, delivery *entities.Delivery) error {
+	ret := _mock.Called(ctx, delivery)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteDelivery")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *entities.Delivery) error); ok {
+		r0 = returnFunc(ctx, delivery)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockDeliveriesRepository_DeleteDelivery_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteDelivery'
+type MockDeliveriesRepository_DeleteDelivery_Call struct {
+	*mock.Call
+}
+
+// DeleteDelivery is a helper method to define mock.On call
+//   - ctx context.Context
+//   - delivery *entities.Delivery
+func (_e *MockDeliveriesRepository_Expecter) DeleteDelivery(ctx interface{}, delivery interface{}) *MockDeliveriesRepository_DeleteDelivery_Call {
+	return &MockDeliveriesRepository_DeleteDelivery_Call{Call: _e.mock.On("DeleteDelivery", ctx, delivery)}
+}
+
+func (_c *MockDeliveriesRepository_DeleteDelivery_Call) Run(run func(ctx context.Context, delivery *entities.Delivery)) *MockDeliveriesRepository_DeleteDelivery_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *entities.Delivery
+		if args[1] != nil {
+			arg1 = args[1].(*entities.Delivery)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockDeliveriesRepository_DeleteDelivery_Call) Return(err error) *MockDeliveriesRepository_DeleteDelivery_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockDeliveriesRepository_DeleteDelivery_Call) RunAndReturn(run func(ctx context.Context, delivery *entities.Delivery) error) *MockDeliveriesRepository_DeleteDelivery_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAttempts provides a mock function for the type MockDeliveriesRepository
+func (_mock *MockDeliveriesRepository) GetAttempts(ctx context.Context, webhook *entities.Webhook, limit int32) ([]*entities.Attempt, error) {
+	ret := _mock.Called(ctx, webhook, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAttempts")
+	}
+
+	var r0 []*entities.Attempt
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *entities.Webhook, int32) ([]*entities.Attempt, error)); ok {
+		return returnFunc(ctx, webhook, limit)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *entities.Webhook, int32) []*entities.Attempt); ok {
+		r0 = returnFunc(ctx, webhook, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.Attempt)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *entities.Webhook, int32) error); ok {
+		r1 = returnFunc(ctx, webhook, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockDeliveriesRepository_GetAttempts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAttempts'
+type MockDeliveriesRepository_GetAttempts_Call struct {
+	*mock.Call
+}
+
+// GetAttempts is a helper method to define mock.On call
+//   - ctx context.Context
+//   - webhook *entities.Webhook
+//   - limit int32
+func (_e *MockDeliveriesRepository_Expecter) GetAttempts(ctx interface{}, webhook interface{}, limit interface{}) *MockDeliveriesRepository_GetAttempts_Call {
+	return &MockDeliveriesRepository_GetAttempts_Call{Call: _e.mock.On("GetAttempts", ctx, webhook, limit)}
+}
+
+func (_c *MockDeliveriesRepository_GetAttempts_Call) Run(run func(ctx context.Context, webhook *entities.Webhook, limit int32)) *MockDeliveriesRepository_GetAttempts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *entities.Webhook
+		if args[1] != nil {
+			arg1 = args[1].(*entities.Webhook)
+		}
+		var arg2 int32
+		if args[2] != nil {
+			arg2 = args[2].(int32)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockDeliveriesRepository_GetAttempts_Call) Return(attempts []*entities.Attempt, err error) *MockDeliveriesRepository_GetAttempts_Call {
+	_c.Call.Return(attempts, err)
+	return _c
+}
+
+func (_c *MockDeliveriesRepository_GetAttempts_Call) RunAndReturn(run func(ctx context.Context, webhook *entities.Webhook, limit int32) ([]*entities.Attempt, error)) *MockDeliveriesRepository_GetAttempts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PostponeDelivery provides a mock function for the type MockDeliveriesRepository
+func (_mock *MockDeliveriesRepository) PostponeDelivery(ctx context.Context, delivery *entities.Delivery, backoff time.Duration, maxBackoff time.Duration) error {
+	ret := _mock.Called(ctx, delivery, backoff, maxBackoff)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PostponeDelivery")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *entities.Delivery, time.Duration, time.Duration) error); ok {
+		r0 = returnFunc(ctx, delivery, backoff, maxBackoff)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockDeliveriesRepository_PostponeDelivery_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PostponeDelivery'
+type MockDeliveriesRepository_PostponeDelivery_Call struct {
+	*mock.Call
+}
+
+// PostponeDelivery is a helper method to define mock.On call
+//   - ctx context.Context
+//   - delivery *entities.Delivery
+//   - backoff time.Duration
+//   - maxBackoff time.Duration
+func (_e *MockDeliveriesRepository_Expecter) PostponeDelivery(ctx interface{}, delivery interface{}, backoff interface{}, maxBackoff interface{}) *MockDeliveriesRepository_PostponeDelivery_Call {
+	return &MockDeliveriesRepository_PostponeDelivery_Call{Call: _e.mock.On("PostponeDelivery", ctx, delivery, backoff, maxBackoff)}
+}
+
+func (_c *MockDeliveriesRepository_PostponeDelivery_Call) Run(run func(ctx context.Context, delivery *entities.Delivery, backoff time.Duration, maxBackoff time.Duration)) *MockDeliveriesRepository_PostponeDelivery_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *entities.Delivery
+		if args[1] != nil {
+			arg1 = args[1].(*entities.Delivery)
+		}
+		var arg2 time.Duration
+		if args[2] != nil {
+			arg2 = args[2].(time.Duration)
+		}
+		var arg3 time.Duration
+		if args[3] != nil {
+			arg3 = args[3].(time.Duration)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockDeliveriesRepository_PostponeDelivery_Call) Return(err error) *MockDeliveriesRepository_PostponeDelivery_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockDeliveriesRepository_PostponeDelivery_Call) RunAndReturn(run func(ctx context.Context, delivery *entities.Delivery, backoff time.Duration, maxBackoff time.Duration) error) *MockDeliveriesRepository_PostponeDelivery_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PurgeAttempts provides a mock function for the type MockDeliveriesRepository
+func (_mock *MockDeliveriesRepository) PurgeAttempts(ctx context.Context, before time.Time) (int64, error) {
+	ret := _mock.Called(ctx, before)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PurgeAttempts")
+	}
+
+	var r0 int64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Time) (int64, error)); ok {
+		return returnFunc(ctx, before)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Time) int64); ok {
+		r0 = returnFunc(ctx, before)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = returnFunc(ctx, before)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockDeliveriesRepository_PurgeAttempts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PurgeAttempts'
+type MockDeliveriesRepository_PurgeAttempts_Call struct {
+	*mock.Call
+}
+
+// PurgeAttempts is a helper method to define mock.On call
+//   - ctx context.Context
+//   - before time.Time
+func (_e *MockDeliveriesRepository_Expecter) PurgeAttempts(ctx interface{}, before interface{}) *MockDeliveriesRepository_PurgeAttempts_Call {
+	return &MockDeliveriesRepository_PurgeAttempts_Call{Call: _e.mock.On("PurgeAttempts", ctx, before)}
+}
+
+func (_c *MockDeliveriesRepository_PurgeAttempts_Call) Run(run func(ctx context.Context, before time.Time)) *MockDeliveriesRepository_PurgeAttempts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 time.Time
+		if args[1] != nil {
+			arg1 = args[1].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockDeliveriesRepository_PurgeAttempts_Call) Return(n int64, err error) *MockDeliveriesRepository_PurgeAttempts_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockDeliveriesRepository_PurgeAttempts_Call) RunAndReturn(run func(ctx context.Context, before time.Time) (int64, error)) *MockDeliveriesRepository_PurgeAttempts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SaveAttempt provides a mock function for the type MockDeliveriesRepository
+func (_mock *MockDeliveriesRepository) SaveAttempt(ctx context.Context, attempt *entities.Attempt) (*entities.Attempt, error) {
+	ret := _mock.Called(ctx, attempt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveAttempt")
+	}
+
+	var r0 *entities.Attempt
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *entities.Attempt) (*entities.Attempt, error)); ok {
+		return returnFunc(ctx, attempt)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *entities.Attempt) *entities.Attempt); ok {
+		r0 = returnFunc(ctx, attempt)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.Attempt)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *entities.Attempt) error); ok {
+		r1 = returnFunc(ctx, attempt)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockDeliveriesRepository_SaveAttempt_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveAttempt'
+type MockDeliveriesRepository_SaveAttempt_Call struct {
+	*mock.Call
+}
+
+// SaveAttempt is a helper method to define mock.On call
+//   - ctx context.Context
+//   - attempt *entities.Attempt
+func (_e *MockDeliveriesRepository_Expecter) SaveAttempt(ctx interface{}, attempt interface{}) *MockDeliveriesRepository_SaveAttempt_Call {
+	return &MockDeliveriesRepository_SaveAttempt_Call{Call: _e.mock.On("SaveAttempt", ctx, attempt)}
+}
+
+func (_c *MockDeliveriesRepository_SaveAttempt_Call) Run(run func(ctx context.Context, attempt *entities.Attempt)) *MockDeliveriesRepository_SaveAttempt_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *entities.Attempt
+		if args[1] != nil {
+			arg1 = args[1].(*entities.Attempt)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockDeliveriesRepository_SaveAttempt_Call) Return(attempt1 *entities.Attempt, err error) *MockDeliveriesRepository_SaveAttempt_Call {
+	_c.Call.Return(attempt1, err)
+	return _c
+}
+
+func (_c *MockDeliveriesRepository_SaveAttempt_Call) RunAndReturn(run func(ctx context.Context, attempt *entities.Attempt) (*entities.Attempt, error)) *MockDeliveriesRepository_SaveAttempt_Call {
+	_c.Call.Return(run)
+	return _c
+}
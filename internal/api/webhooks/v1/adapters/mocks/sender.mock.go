@@ -0,0 +1,98 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"context"
+
+	mock "github.com/stretchr/testify/mock"
+	"github.com/therenotomorrow/gotes/internal/domain/entities"
+)
+
+// NewMockSender creates a new instance of MockSender. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockSender(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockSender {
+	mock := &MockSender{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockSender is an autogenerated mock type for the Sender type
+type MockSender struct {
+	mock.Mock
+}
+
+type MockSender_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockSender) EXPECT() *MockSender_Expecter {
+	return &MockSender_Expecter{mock: &_m.Mock}
+}
+
+// Send provides a mock function for the type MockSender
+func (_mock *MockSender) Send(ctx context.Context, delivery *entities.Delivery) *entities.Attempt {
+	ret := _mock.Called(ctx, delivery)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Send")
+	}
+
+	var r0 *entities.Attempt
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *entities.Delivery) *entities.Attempt); ok {
+		r0 = returnFunc(ctx, delivery)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.Attempt)
+		}
+	}
+	return r0
+}
+
+// MockSender_Send_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Send'
+type MockSender_Send_Call struct {
+	*mock.Call
+}
+
+// Send is a helper method to define mock.On call
+//   - ctx context.Context
+//   - delivery *entities.Delivery
+func (_e *MockSender_Expecter) Send(ctx interface{}, delivery interface{}) *MockSender_Send_Call {
+	return &MockSender_Send_Call{Call: _e.mock.On("Send", ctx, delivery)}
+}
+
+func (_c *MockSender_Send_Call) Run(run func(ctx context.Context, delivery *entities.Delivery)) *MockSender_Send_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *entities.Delivery
+		if args[1] != nil {
+			arg1 = args[1].(*entities.Delivery)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSender_Send_Call) Return(attempt *entities.Attempt) *MockSender_Send_Call {
+	_c.Call.Return(attempt)
+	return _c
+}
+
+func (_c *MockSender_Send_Call) RunAndReturn(run func(ctx context.Context, delivery *entities.Delivery) *entities.Attempt) *MockSender_Send_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -0,0 +1,430 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"context"
+
+	mock "github.com/stretchr/testify/mock"
+	"github.com/therenotomorrow/gotes/internal/domain/entities"
+	"github.com/therenotomorrow/gotes/internal/domain/types/id"
+)
+
+// NewMockWebhooksRepository creates a new instance of MockWebhooksRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockWebhooksRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockWebhooksRepository {
+	mock := &MockWebhooksRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockWebhooksRepository is an autogenerated mock type for the WebhooksRepository type
+type MockWebhooksRepository struct {
+	mock.Mock
+}
+
+type MockWebhooksRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockWebhooksRepository) EXPECT() *MockWebhooksRepository_Expecter {
+	return &MockWebhooksRepository_Expecter{mock: &_m.Mock}
+}
+
+// CountFailure provides a mock function for the type MockWebhooksRepository
+func (_mock *MockWebhooksRepository) CountFailure(ctx context.Context, webhook *entities.Webhook, failed bool) (int32, error) {
+	ret := _mock.Called(ctx, webhook, failed)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountFailure")
+	}
+
+	var r0 int32
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *entities.Webhook, bool) (int32, error)); ok {
+		return returnFunc(ctx, webhook, failed)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *entities.Webhook, bool) int32); ok {
+		r0 = returnFunc(ctx, webhook, failed)
+	} else {
+		r0 = ret.Get(0).(int32)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *entities.Webhook, bool) error); ok {
+		r1 = returnFunc(ctx, webhook, failed)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockWebhooksRepository_CountFailure_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountFailure'
+type MockWebhooksRepository_CountFailure_Call struct {
+	*mock.Call
+}
+
+// CountFailure is a helper method to define mock.On call
+//   - ctx context.Context
+//   - webhook *entities.Webhook
+//   - failed bool
+func (_e *MockWebhooksRepository_Expecter) CountFailure(ctx interface{}, webhook interface{}, failed interface{}) *MockWebhooksRepository_CountFailure_Call {
+	return &MockWebhooksRepository_CountFailure_Call{Call: _e.mock.On("CountFailure", ctx, webhook, failed)}
+}
+
+func (_c *MockWebhooksRepository_CountFailure_Call) Run(run func(ctx context.Context, webhook *entities.Webhook, failed bool)) *MockWebhooksRepository_CountFailure_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *entities.Webhook
+		if args[1] != nil {
+			arg1 = args[1].(*entities.Webhook)
+		}
+		var arg2 bool
+		if args[2] != nil {
+			arg2 = args[2].(bool)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockWebhooksRepository_CountFailure_Call) Return(n int32, err error) *MockWebhooksRepository_CountFailure_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockWebhooksRepository_CountFailure_Call) RunAndReturn(run func(ctx context.Context, webhook *entities.Webhook, failed bool) (int32, error)) *MockWebhooksRepository_CountFailure_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteWebhook provides a mock function for the type MockWebhooksRepository
+func (_mock *MockWebhooksRepository) DeleteWebhook(ctx context.Context, webhook *entities.Webhook) error {
+	ret := _mock.Called(ctx, webhook)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteWebhook")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *entities.Webhook) error); ok {
+		r0 = returnFunc(ctx, webhook)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockWebhooksRepository_DeleteWebhook_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteWebhook'
+type MockWebhooksRepository_DeleteWebhook_Call struct {
+	*mock.Call
+}
+
+// DeleteWebhook is a helper method to define mock.On call
+//   - ctx context.Context
+//   - webhook *entities.Webhook
+func (_e *MockWebhooksRepository_Expecter) DeleteWebhook(ctx interface{}, webhook interface{}) *MockWebhooksRepository_DeleteWebhook_Call {
+	return &MockWebhooksRepository_DeleteWebhook_Call{Call: _e.mock.On("DeleteWebhook", ctx, webhook)}
+}
+
+func (_c *MockWebhooksRepository_DeleteWebhook_Call) Run(run func(ctx context.Context, webhook *entities.Webhook)) *MockWebhooksRepository_DeleteWebhook_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *entities.Webhook
+		if args[1] != nil {
+			arg1 = args[1].(*entities.Webhook)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockWebhooksRepository_DeleteWebhook_Call) Return(err error) *MockWebhooksRepository_DeleteWebhook_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockWebhooksRepository_DeleteWebhook_Call) RunAndReturn(run func(ctx context.Context, webhook *entities.Webhook) error) *MockWebhooksRepository_DeleteWebhook_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetWebhook provides a mock function for the type MockWebhooksRepository
+func (_mock *MockWebhooksRepository) GetWebhook(ctx context.Context, ident id.ID) (*entities.Webhook, error) {
+	ret := _mock.Called(ctx, ident)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetWebhook")
+	}
+
+	var r0 *entities.Webhook
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, id.ID) (*entities.Webhook, error)); ok {
+		return returnFunc(ctx, ident)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, id.ID) *entities.Webhook); ok {
+		r0 = returnFunc(ctx, ident)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.Webhook)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, id.ID) error); ok {
+		r1 = returnFunc(ctx, ident)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockWebhooksRepository_GetWebhook_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetWebhook'
+type MockWebhooksRepository_GetWebhook_Call struct {
+	*mock.Call
+}
+
+// GetWebhook is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ident id.ID
+func (_e *MockWebhooksRepository_Expecter) GetWebhook(ctx interface{}, ident interface{}) *MockWebhooksRepository_GetWebhook_Call {
+	return &MockWebhooksRepository_GetWebhook_Call{Call: _e.mock.On("GetWebhook", ctx, ident)}
+}
+
+func (_c *MockWebhooksRepository_GetWebhook_Call) Run(run func(ctx context.Context, ident id.ID)) *MockWebhooksRepository_GetWebhook_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 id.ID
+		if args[1] != nil {
+			arg1 = args[1].(id.ID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockWebhooksRepository_GetWebhook_Call) Return(webhook *entities.Webhook, err error) *MockWebhooksRepository_GetWebhook_Call {
+	_c.Call.Return(webhook, err)
+	return _c
+}
+
+func (_c *MockWebhooksRepository_GetWebhook_Call) RunAndReturn(run func(ctx context.Context, ident id.ID) (*entities.Webhook, error)) *MockWebhooksRepository_GetWebhook_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetWebhooksByUser provides a mock function for the type MockWebhooksRepository
+func (_mock *MockWebhooksRepository) GetWebhooksByUser(ctx context.Context, user *entities.User) ([]*entities.Webhook, error) {
+	ret := _mock.Called(ctx, user)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetWebhooksByUser")
+	}
+
+	var r0 []*entities.Webhook
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *entities.User) ([]*entities.Webhook, error)); ok {
+		return returnFunc(ctx, user)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *entities.User) []*entities.Webhook); ok {
+		r0 = returnFunc(ctx, user)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.Webhook)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *entities.User) error); ok {
+		r1 = returnFunc(ctx, user)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockWebhooksRepository_GetWebhooksByUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetWebhooksByUser'
+type MockWebhooksRepository_GetWebhooksByUser_Call struct {
+	*mock.Call
+}
+
+// GetWebhooksByUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - user *entities.User
+func (_e *MockWebhooksRepository_Expecter) GetWebhooksByUser(ctx interface{}, user interface{}) *MockWebhooksRepository_GetWebhooksByUser_Call {
+	return &MockWebhooksRepository_GetWebhooksByUser_Call{Call: _e.mock.On("GetWebhooksByUser", ctx, user)}
+}
+
+func (_c *MockWebhooksRepository_GetWebhooksByUser_Call) Run(run func(ctx context.Context, user *entities.User)) *MockWebhooksRepository_GetWebhooksByUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *entities.User
+		if args[1] != nil {
+			arg1 = args[1].(*entities.User)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockWebhooksRepository_GetWebhooksByUser_Call) Return(webhooks []*entities.Webhook, err error) *MockWebhooksRepository_GetWebhooksByUser_Call {
+	_c.Call.Return(webhooks, err)
+	return _c
+}
+
+func (_c *MockWebhooksRepository_GetWebhooksByUser_Call) RunAndReturn(run func(ctx context.Context, user *entities.User) ([]*entities.Webhook, error)) *MockWebhooksRepository_GetWebhooksByUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SaveWebhook provides a mock function for the type MockWebhooksRepository
+func (_mock *MockWebhooksRepository) SaveWebhook(ctx context.Context, webhook *entities.Webhook) (*entities.Webhook, error) {
+	ret := _mock.Called(ctx, webhook)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveWebhook")
+	}
+
+	var r0 *entities.Webhook
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *entities.Webhook) (*entities.Webhook, error)); ok {
+		return returnFunc(ctx, webhook)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *entities.Webhook) *entities.Webhook); ok {
+		r0 = returnFunc(ctx, webhook)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.Webhook)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *entities.Webhook) error); ok {
+		r1 = returnFunc(ctx, webhook)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockWebhooksRepository_SaveWebhook_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveWebhook'
+type MockWebhooksRepository_SaveWebhook_Call struct {
+	*mock.Call
+}
+
+// SaveWebhook is a helper method to define mock.On call
+//   - ctx context.Context
+//   - webhook *entities.Webhook
+func (_e *MockWebhooksRepository_Expecter) SaveWebhook(ctx interface{}, webhook interface{}) *MockWebhooksRepository_SaveWebhook_Call {
+	return &MockWebhooksRepository_SaveWebhook_Call{Call: _e.mock.On("SaveWebhook", ctx, webhook)}
+}
+
+func (_c *MockWebhooksRepository_SaveWebhook_Call) Run(run func(ctx context.Context, webhook *entities.Webhook)) *MockWebhooksRepository_SaveWebhook_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *entities.Webhook
+		if args[1] != nil {
+			arg1 = args[1].(*entities.Webhook)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockWebhooksRepository_SaveWebhook_Call) Return(webhook1 *entities.Webhook, err error) *MockWebhooksRepository_SaveWebhook_Call {
+	_c.Call.Return(webhook1, err)
+	return _c
+}
+
+func (_c *MockWebhooksRepository_SaveWebhook_Call) RunAndReturn(run func(ctx context.Context, webhook *entities.Webhook) (*entities.Webhook, error)) *MockWebhooksRepository_SaveWebhook_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateWebhook provides a mock function for the type MockWebhooksRepository
+func (_mock *MockWebhooksRepository) UpdateWebhook(ctx context.Context, webhook *entities.Webhook) error {
+	ret := _mock.Called(ctx, webhook)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateWebhook")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *entities.Webhook) error); ok {
+		r0 = returnFunc(ctx, webhook)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockWebhooksRepository_UpdateWebhook_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateWebhook'
+type MockWebhooksRepository_UpdateWebhook_Call struct {
+	*mock.Call
+}
+
+// UpdateWebhook is a helper method to define mock.On call
+//   - ctx context.Context
+//   - webhook *entities.Webhook
+func (_e *MockWebhooksRepository_Expecter) UpdateWebhook(ctx interface{}, webhook interface{}) *MockWebhooksRepository_UpdateWebhook_Call {
+	return &MockWebhooksRepository_UpdateWebhook_Call{Call: _e.mock.On("UpdateWebhook", ctx, webhook)}
+}
+
+func (_c *MockWebhooksRepository_UpdateWebhook_Call) Run(run func(ctx context.Context, webhook *entities.Webhook)) *MockWebhooksRepository_UpdateWebhook_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *entities.Webhook
+		if args[1] != nil {
+			arg1 = args[1].(*entities.Webhook)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockWebhooksRepository_UpdateWebhook_Call) Return(err error) *MockWebhooksRepository_UpdateWebhook_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockWebhooksRepository_UpdateWebhook_Call) RunAndReturn(run func(ctx context.Context, webhook *entities.Webhook) error) *MockWebhooksRepository_UpdateWebhook_Call {
+	_c.Call.Return(run)
+	return _c
+}
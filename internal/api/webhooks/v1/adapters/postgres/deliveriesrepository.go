@@ -0,0 +1,94 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/therenotomorrow/ex"
+	"github.com/therenotomorrow/gotes/internal/domain/entities"
+	"github.com/therenotomorrow/gotes/internal/domain/types/id"
+	"github.com/therenotomorrow/gotes/internal/storages/postgres"
+	commands "github.com/therenotomorrow/gotes/internal/storages/postgres/commands/webhooks"
+	queries "github.com/therenotomorrow/gotes/internal/storages/postgres/queries/webhooks"
+)
+
+type DeliveriesRepository struct {
+	commands commands.Querier
+	queries  queries.Querier
+}
+
+func NewDeliveriesRepository(dbtx postgres.DBTX) *DeliveriesRepository {
+	return &DeliveriesRepository{commands: commands.New(dbtx), queries: queries.New(dbtx)}
+}
+
+func (r *DeliveriesRepository) ClaimDeliveries(
+	ctx context.Context,
+	limit int,
+	lease time.Duration,
+) ([]*entities.Delivery, error) {
+	deliveries, err := r.commands.ClaimWebhookDeliveries(ctx, commands.NewClaimWebhookDeliveriesParams(limit, lease))
+	if err != nil {
+		return nil, ex.Unexpected(err)
+	}
+
+	return commands.ClaimWebhookDeliveriesRows(deliveries).ToEntities(), nil
+}
+
+func (r *DeliveriesRepository) DeleteDelivery(ctx context.Context, delivery *entities.Delivery) error {
+	err := r.commands.DeleteWebhookDelivery(ctx, commands.NewDeleteWebhookDeliveryParams(delivery))
+
+	return ex.Unexpected(err)
+}
+
+func (r *DeliveriesRepository) PostponeDelivery(
+	ctx context.Context,
+	delivery *entities.Delivery,
+	backoff, maxBackoff time.Duration,
+) error {
+	params := commands.NewUpdateWebhookDeliveryAvailableAtParams(delivery, backoff, maxBackoff)
+	err := r.commands.UpdateWebhookDeliveryAvailableAt(ctx, params)
+
+	return ex.Unexpected(err)
+}
+
+func (r *DeliveriesRepository) DeleteDeliveries(ctx context.Context, webhook *entities.Webhook) error {
+	err := r.commands.DeleteWebhookDeliveries(ctx, webhook.ID.Value())
+
+	return ex.Unexpected(err)
+}
+
+func (r *DeliveriesRepository) SaveAttempt(ctx context.Context, attempt *entities.Attempt) (*entities.Attempt, error) {
+	ident, err := r.commands.InsertWebhookAttempt(ctx, commands.NewInsertWebhookAttemptParams(attempt))
+	if err != nil {
+		return nil, ex.Unexpected(err)
+	}
+
+	attempt.ID = id.New(ident)
+
+	return attempt, nil
+}
+
+func (r *DeliveriesRepository) GetAttempts(
+	ctx context.Context,
+	webhook *entities.Webhook,
+	limit int32,
+) ([]*entities.Attempt, error) {
+	attempts, err := r.queries.SelectWebhookAttempts(ctx, &queries.SelectWebhookAttemptsParams{
+		WebhookID: webhook.ID.Value(),
+		MaxCount:  limit,
+	})
+	if err != nil {
+		return nil, ex.Unexpected(err)
+	}
+
+	return queries.WebhookAttempts(attempts).ToEntities(webhook), nil
+}
+
+func (r *DeliveriesRepository) PurgeAttempts(ctx context.Context, before time.Time) (int64, error) {
+	cnt, err := r.commands.DeleteWebhookAttempts(ctx, before)
+	if err != nil {
+		return 0, ex.Unexpected(err)
+	}
+
+	return cnt, nil
+}
@@ -0,0 +1,29 @@
+package postgres
+
+import (
+	"context"
+	"net/http"
+
+	adapters "github.com/therenotomorrow/gotes/internal/api/webhooks/v1/adapters/http"
+	"github.com/therenotomorrow/gotes/internal/api/webhooks/v1/ports"
+	"github.com/therenotomorrow/gotes/internal/storages/postgres"
+)
+
+type StoreProvider struct {
+	db     postgres.Database
+	client *http.Client
+}
+
+func NewStoreProvider(db postgres.Database, client *http.Client) *StoreProvider {
+	return &StoreProvider{db: db, client: client}
+}
+
+func (p *StoreProvider) Provide(ctx context.Context) ports.Store {
+	conn := p.db.Conn(ctx)
+
+	return ports.Store{
+		Webhooks:   NewWebhooksRepository(conn),
+		Deliveries: NewDeliveriesRepository(conn),
+		Sender:     adapters.NewSender(p.client),
+	}
+}
@@ -0,0 +1,85 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/therenotomorrow/ex"
+	"github.com/therenotomorrow/gotes/internal/api/webhooks/v1/usecases"
+	"github.com/therenotomorrow/gotes/internal/domain/entities"
+	"github.com/therenotomorrow/gotes/internal/domain/types/id"
+	"github.com/therenotomorrow/gotes/internal/storages/postgres"
+	commands "github.com/therenotomorrow/gotes/internal/storages/postgres/commands/webhooks"
+	queries "github.com/therenotomorrow/gotes/internal/storages/postgres/queries/webhooks"
+)
+
+type WebhooksRepository struct {
+	commands commands.Querier
+	queries  queries.Querier
+}
+
+func NewWebhooksRepository(dbtx postgres.DBTX) *WebhooksRepository {
+	return &WebhooksRepository{commands: commands.New(dbtx), queries: queries.New(dbtx)}
+}
+
+func (r *WebhooksRepository) SaveWebhook(ctx context.Context, webhook *entities.Webhook) (*entities.Webhook, error) {
+	ident, err := r.commands.InsertWebhook(ctx, commands.NewInsertWebhookParams(webhook))
+	if err != nil {
+		return nil, ex.Unexpected(err)
+	}
+
+	webhook.ID = id.New(ident)
+
+	return webhook, nil
+}
+
+func (r *WebhooksRepository) GetWebhook(ctx context.Context, ident id.ID) (*entities.Webhook, error) {
+	webhook, err := r.queries.SelectWebhook(ctx, ident.Value())
+
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		return nil, usecases.ErrWebhookNotFound
+	case err != nil:
+		return nil, ex.Unexpected(err)
+	}
+
+	return webhook.ToEntity(), nil
+}
+
+func (r *WebhooksRepository) GetWebhooksByUser(ctx context.Context, user *entities.User) ([]*entities.Webhook, error) {
+	webhooks, err := r.queries.SelectWebhooksByUser(ctx, user.ID.Value())
+	if err != nil {
+		return nil, ex.Unexpected(err)
+	}
+
+	return queries.Webhooks(webhooks).ToEntities(), nil
+}
+
+func (r *WebhooksRepository) UpdateWebhook(ctx context.Context, webhook *entities.Webhook) error {
+	err := r.commands.UpdateWebhook(ctx, commands.NewUpdateWebhookParams(webhook))
+
+	return ex.Unexpected(err)
+}
+
+func (r *WebhooksRepository) DeleteWebhook(ctx context.Context, webhook *entities.Webhook) error {
+	err := r.commands.DeleteWebhook(ctx, webhook.ID.Value())
+
+	return ex.Unexpected(err)
+}
+
+func (r *WebhooksRepository) CountFailure(
+	ctx context.Context,
+	webhook *entities.Webhook,
+	failed bool,
+) (int32, error) {
+	failures, err := r.commands.UpdateWebhookFailures(ctx, &commands.UpdateWebhookFailuresParams{
+		Failed: failed,
+		ID:     webhook.ID.Value(),
+	})
+	if err != nil {
+		return 0, ex.Unexpected(err)
+	}
+
+	return failures, nil
+}
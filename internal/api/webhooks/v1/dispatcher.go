@@ -0,0 +1,142 @@
+package v1
+
+import (
+	"context"
+	"expvar"
+	"log/slog"
+	"time"
+
+	sender "github.com/therenotomorrow/gotes/internal/api/webhooks/v1/adapters/http"
+	adapters "github.com/therenotomorrow/gotes/internal/api/webhooks/v1/adapters/postgres"
+	"github.com/therenotomorrow/gotes/internal/api/webhooks/v1/ports"
+	"github.com/therenotomorrow/gotes/internal/api/webhooks/v1/usecases"
+	"github.com/therenotomorrow/gotes/internal/storages/postgres"
+	"github.com/therenotomorrow/gotes/pkg/services/trace"
+)
+
+const (
+	// DispatchBatchSize is the number of deliveries the dispatcher claims at once.
+	DispatchBatchSize = 20
+	// DispatchWorkers is the number of deliveries the dispatcher sends at once.
+	DispatchWorkers = 4
+	// purgeInterval is how often the dispatcher removes the attempts older than the retention.
+	purgeInterval = time.Hour
+)
+
+// dispatchMetrics are exposed with expvar: the attempted deliveries and the failures to settle them.
+var dispatchMetrics = expvar.NewMap("webhooks.v1.dispatcher")
+
+// Dispatcher delivers the events to the webhooks, an event is delivered at least once.
+type Dispatcher struct {
+	tracer    *trace.Tracer
+	cases     *usecases.UseCases
+	interval  time.Duration
+	retention time.Duration
+}
+
+// NewDispatcher makes a dispatcher waiting for a webhook to respond up to the timeout.
+func NewDispatcher(db postgres.Database, interval, timeout time.Duration, logger *slog.Logger) *Dispatcher {
+	provider := adapters.NewStoreProvider(db, sender.NewClient(timeout))
+	uow := adapters.NewUnitOfWork(db, provider)
+
+	dispatcher := NewDispatcherWithProvider(uow, provider, interval, logger)
+	dispatcher.cases.SetDeliveryLease(deliveryLease(timeout))
+
+	return dispatcher
+}
+
+// deliveryLease keeps the claimed deliveries for as long as twice the time the workers may take to send the batch,
+// so the deliveries are recorded before they are claimed again.
+func deliveryLease(timeout time.Duration) time.Duration {
+	rounds := (DispatchBatchSize + DispatchWorkers - 1) / DispatchWorkers
+
+	return max(2*time.Duration(rounds)*timeout, usecases.DefaultDeliveryLease) //nolint:mnd // twice the time
+}
+
+func NewDispatcherWithProvider(
+	uow ports.UnitOfWork,
+	provider ports.StoreProvider,
+	interval time.Duration,
+	logger *slog.Logger,
+) *Dispatcher {
+	cases := usecases.NewCases(uow, provider.Provide(context.Background()))
+	cases.SetDeliveryWorkers(DispatchWorkers)
+
+	return &Dispatcher{
+		tracer:    trace.Service("webhooks.v1.dispatcher", logger),
+		cases:     cases,
+		interval:  interval,
+		retention: 0,
+	}
+}
+
+// SetFailureLimit changes the number of deliveries failed in a row that disables a webhook.
+func (d *Dispatcher) SetFailureLimit(limit int32) {
+	d.cases.SetFailureLimit(limit)
+}
+
+// SetAttemptsRetention changes how long the attempts are kept, zero keeps them forever.
+func (d *Dispatcher) SetAttemptsRetention(retention time.Duration) {
+	d.retention = retention
+}
+
+// Run delivers the due events every interval and removes the old attempts until the context is done.
+func (d *Dispatcher) Run(ctx context.Context) {
+	ticker := time.NewTicker(d.interval)
+	defer ticker.Stop()
+
+	purger := time.NewTicker(purgeInterval)
+	defer purger.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+
+		case <-ticker.C:
+			d.Drain(ctx)
+
+		case <-purger.C:
+			d.Purge(ctx)
+		}
+	}
+}
+
+// Purge removes the attempts older than the retention.
+func (d *Dispatcher) Purge(ctx context.Context) {
+	if d.retention <= 0 {
+		return
+	}
+
+	purged, err := d.cases.PurgeAttempts(ctx, d.retention)
+	if err != nil {
+		d.tracer.Error(ctx, "PurgeAttempts", err)
+
+		return
+	}
+
+	if purged > 0 {
+		d.tracer.Info(ctx, "purge attempts", "attempts", purged)
+	}
+}
+
+// Drain delivers batches of events while there are due ones, it stops at the first failure
+// and leaves the rest to the next run.
+func (d *Dispatcher) Drain(ctx context.Context) {
+	for {
+		attempted, err := d.cases.DeliverWebhooks(ctx, DispatchBatchSize)
+
+		dispatchMetrics.Add("attempted", int64(attempted))
+
+		if err != nil {
+			dispatchMetrics.Add("failures", 1)
+			d.tracer.Error(ctx, "DeliverWebhooks", err)
+
+			return
+		}
+
+		if attempted < DispatchBatchSize {
+			return
+		}
+	}
+}
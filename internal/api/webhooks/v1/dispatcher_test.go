@@ -0,0 +1,232 @@
+package v1_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	v1 "github.com/therenotomorrow/gotes/internal/api/webhooks/v1"
+	sender "github.com/therenotomorrow/gotes/internal/api/webhooks/v1/adapters/http"
+	"github.com/therenotomorrow/gotes/internal/api/webhooks/v1/adapters/mocks"
+	"github.com/therenotomorrow/gotes/internal/api/webhooks/v1/ports"
+	"github.com/therenotomorrow/gotes/internal/domain/entities"
+	"github.com/therenotomorrow/gotes/internal/domain/types/id"
+	"github.com/therenotomorrow/gotes/internal/domain/types/uuid"
+	"github.com/therenotomorrow/gotes/pkg/services/generate"
+	"github.com/therenotomorrow/gotes/pkg/services/trace"
+)
+
+type unitOfWork struct {
+	provider ports.StoreProvider
+}
+
+func (uow unitOfWork) Do(ctx context.Context, work func(store ports.Store) error) error {
+	return work(uow.provider.Provide(ctx))
+}
+
+var log = trace.Logger(trace.TEXT, true)
+
+// receiver is a webhook endpoint that accepts the requests signed by the secret.
+func receiver(t *testing.T, secret string, status int, received *atomic.Int32) *httptest.Server {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		if !assert.NoError(t, err) {
+			return
+		}
+
+		signature := r.Header.Get(sender.SignatureHeader)
+		timestamp, _, _ := strings.Cut(strings.TrimPrefix(signature, "t="), ",")
+
+		unix, err := strconv.ParseInt(timestamp, 10, 64)
+		if !assert.NoError(t, err) {
+			return
+		}
+
+		assert.Equal(t, sender.Sign(secret, time.Unix(unix, 0), body), signature)
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		assert.Equal(t, "updated", r.Header.Get(sender.EventHeader))
+
+		var payload map[string]any
+
+		assert.NoError(t, json.Unmarshal(body, &payload))
+		assert.Equal(t, r.Header.Get(sender.DeliveryHeader), payload["id"])
+		assert.Equal(t, "updated", payload["type"])
+		assert.InDelta(t, 42, payload["noteId"], 0)
+		assert.InDelta(t, 10, payload["userId"], 0)
+
+		received.Add(1)
+		w.WriteHeader(status)
+	}))
+
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func newDelivery(url string) *entities.Delivery {
+	user := &entities.User{ID: id.New(10)}
+
+	return &entities.Delivery{
+		Webhook: &entities.Webhook{ID: id.New(1), Owner: user, URL: url, Secret: "secret"},
+		Event: &entities.Event{
+			ID:        uuid.New(),
+			EventType: entities.EventTypeUpdated,
+			Note:      &entities.Note{ID: id.New(42), Owner: user},
+			Recipient: user,
+			EventTime: time.Now(),
+		},
+		Attempts: 1,
+	}
+}
+
+func TestDispatcherDrain(t *testing.T) {
+	t.Parallel()
+
+	uuid.SetGenerator(generate.NewUUID())
+
+	t.Run("delivered", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			ctx        = t.Context()
+			received   atomic.Int32
+			server     = receiver(t, "secret", http.StatusOK, &received)
+			delivery   = newDelivery(server.URL)
+			webhooks   = mocks.NewMockWebhooksRepository(t)
+			deliveries = mocks.NewMockDeliveriesRepository(t)
+			store      = ports.Store{Webhooks: webhooks, Deliveries: deliveries, Sender: sender.NewSender(server.Client())}
+			provider   = mocks.NewMockStoreProvider(t)
+		)
+
+		provider.On("Provide", mock.Anything).Return(store)
+		deliveries.On("ClaimDeliveries", ctx, v1.DispatchBatchSize, time.Minute).
+			Return([]*entities.Delivery{delivery}, nil).Once()
+		deliveries.On("SaveAttempt", ctx, mock.MatchedBy(func(attempt *entities.Attempt) bool {
+			return attempt.Delivered() && attempt.StatusCode == http.StatusOK && attempt.EventID == delivery.Event.ID
+		})).
+			Return(func(_ context.Context, attempt *entities.Attempt) (*entities.Attempt, error) {
+				return attempt, nil
+			}).Once()
+		deliveries.On("DeleteDelivery", ctx, delivery).
+			Return(nil).Once()
+		webhooks.On("CountFailure", ctx, delivery.Webhook, false).
+			Return(int32(0), nil).Once()
+
+		v1.NewDispatcherWithProvider(unitOfWork{provider: provider}, provider, time.Second, log).Drain(ctx)
+
+		assert.Equal(t, int32(1), received.Load())
+	})
+
+	t.Run("disabled after failures", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			ctx        = t.Context()
+			received   atomic.Int32
+			server     = receiver(t, "secret", http.StatusServiceUnavailable, &received)
+			delivery   = newDelivery(server.URL)
+			webhook    = &entities.Webhook{ID: delivery.Webhook.ID, Owner: delivery.Webhook.Owner, Failures: 2}
+			webhooks   = mocks.NewMockWebhooksRepository(t)
+			deliveries = mocks.NewMockDeliveriesRepository(t)
+			store      = ports.Store{Webhooks: webhooks, Deliveries: deliveries, Sender: sender.NewSender(server.Client())}
+			provider   = mocks.NewMockStoreProvider(t)
+		)
+
+		provider.On("Provide", mock.Anything).Return(store)
+		deliveries.On("ClaimDeliveries", ctx, v1.DispatchBatchSize, time.Minute).
+			Return([]*entities.Delivery{delivery}, nil).Once()
+		deliveries.On("SaveAttempt", ctx, mock.MatchedBy(func(attempt *entities.Attempt) bool {
+			return !attempt.Delivered() && attempt.StatusCode == http.StatusServiceUnavailable
+		})).
+			Return(func(_ context.Context, attempt *entities.Attempt) (*entities.Attempt, error) {
+				return attempt, nil
+			}).Once()
+		deliveries.On("PostponeDelivery", ctx, delivery, 5*time.Second, time.Hour).
+			Return(nil).Once()
+		webhooks.On("CountFailure", ctx, delivery.Webhook, true).
+			Return(int32(2), nil).Once()
+		webhooks.On("GetWebhook", ctx, webhook.ID).
+			Return(webhook, nil).Once()
+		deliveries.On("DeleteDeliveries", ctx, webhook).
+			Return(nil).Once()
+		webhooks.On("UpdateWebhook", ctx, webhook).
+			Return(nil).Once()
+
+		dispatcher := v1.NewDispatcherWithProvider(unitOfWork{provider: provider}, provider, time.Second, log)
+		dispatcher.SetFailureLimit(2)
+		dispatcher.Drain(ctx)
+
+		assert.Equal(t, int32(1), received.Load())
+		assert.False(t, webhook.Enabled())
+	})
+}
+
+func TestSenderRefusesInternalNetwork(t *testing.T) {
+	t.Parallel()
+
+	uuid.SetGenerator(generate.NewUUID())
+
+	t.Run("private address", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			received atomic.Int32
+			server   = receiver(t, "secret", http.StatusOK, &received)
+			client   = sender.NewClient(time.Second)
+		)
+
+		attempt := sender.NewSender(client).Send(t.Context(), newDelivery(server.URL))
+
+		assert.False(t, attempt.Delivered())
+		assert.Contains(t, attempt.Error, sender.ErrForbiddenAddress.Error())
+		assert.Zero(t, received.Load())
+	})
+
+	t.Run("redirect", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			received atomic.Int32
+			target   = receiver(t, "secret", http.StatusOK, &received)
+			server   = httptest.NewServer(http.RedirectHandler(target.URL, http.StatusTemporaryRedirect))
+			addr     = netip.MustParseAddrPort(server.Listener.Addr().String()).Addr()
+			// the endpoint is allowed to be reached, the redirects are never followed anyway
+			client = sender.NewClient(time.Second, netip.PrefixFrom(addr, addr.BitLen()))
+		)
+
+		t.Cleanup(server.Close)
+
+		attempt := sender.NewSender(client).Send(t.Context(), newDelivery(server.URL))
+
+		assert.False(t, attempt.Delivered())
+		assert.Equal(t, int32(http.StatusTemporaryRedirect), attempt.StatusCode)
+		assert.Zero(t, received.Load())
+	})
+}
+
+func TestIsPublic(t *testing.T) {
+	t.Parallel()
+
+	for _, addr := range []string{"93.184.216.34", "2606:2800:220:1::"} {
+		assert.True(t, sender.IsPublic(netip.MustParseAddr(addr)), addr)
+	}
+
+	for _, addr := range []string{
+		"127.0.0.1", "10.1.2.3", "172.16.0.1", "192.168.1.1", "169.254.169.254", "100.64.0.1", "0.0.0.0",
+		"::1", "fe80::1", "fd00::1", "::ffff:127.0.0.1", "64:ff9b::a00:1",
+	} {
+		assert.False(t, sender.IsPublic(netip.MustParseAddr(addr)), addr)
+	}
+}
@@ -0,0 +1,153 @@
+package v1
+
+import (
+	"context"
+	"time"
+
+	"github.com/therenotomorrow/ex"
+	notesv1 "github.com/therenotomorrow/gotes/internal/api/notes/v1"
+	"github.com/therenotomorrow/gotes/internal/api/webhooks/v1/usecases"
+	"github.com/therenotomorrow/gotes/internal/domain/entities"
+	"github.com/therenotomorrow/gotes/internal/domain/types/id"
+	"github.com/therenotomorrow/gotes/internal/services/secure"
+	pbnotesv1 "github.com/therenotomorrow/gotes/pkg/api/notes/v1"
+	typespb "github.com/therenotomorrow/gotes/pkg/api/types"
+	pb "github.com/therenotomorrow/gotes/pkg/api/webhooks/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func MarshalWebhook(webhook *entities.Webhook) *pb.Webhook {
+	types := make([]pbnotesv1.EventType, len(webhook.EventTypes))
+	for i, eventType := range webhook.EventTypes {
+		types[i] = notesv1.MarshalEventType(eventType)
+	}
+
+	return &pb.Webhook{
+		Id:         &typespb.ID{Value: webhook.ID.Value()},
+		Url:        webhook.URL,
+		EventTypes: types,
+		Enabled:    webhook.Enabled(),
+		Failures:   webhook.Failures,
+		CreatedAt:  timestamppb.New(webhook.CreatedAt),
+		UpdatedAt:  timestamppb.New(webhook.UpdatedAt),
+		DisabledAt: marshalTime(webhook.DisabledAt),
+	}
+}
+
+func MarshalWebhooks(webhooks []*entities.Webhook) []*pb.Webhook {
+	pbWebhooks := make([]*pb.Webhook, len(webhooks))
+	for i, webhook := range webhooks {
+		pbWebhooks[i] = MarshalWebhook(webhook)
+	}
+
+	return pbWebhooks
+}
+
+func MarshalAttempt(attempt *entities.Attempt) *pb.WebhookAttempt {
+	return &pb.WebhookAttempt{
+		Id:          &typespb.ID{Value: attempt.ID.Value()},
+		EventId:     attempt.EventID.Value(),
+		EventType:   notesv1.MarshalEventType(attempt.EventType),
+		StatusCode:  attempt.StatusCode,
+		Error:       attempt.Error,
+		Delivered:   attempt.Delivered(),
+		Duration:    durationpb.New(attempt.Duration),
+		AttemptedAt: timestamppb.New(attempt.AttemptedAt),
+	}
+}
+
+func MarshalAttempts(attempts []*entities.Attempt) []*pb.WebhookAttempt {
+	pbAttempts := make([]*pb.WebhookAttempt, len(attempts))
+	for i, attempt := range attempts {
+		pbAttempts[i] = MarshalAttempt(attempt)
+	}
+
+	return pbAttempts
+}
+
+func UnmarshalUpdateWebhook(request *pb.UpdateWebhookRequest) *usecases.UpdateWebhookInput {
+	input := &usecases.UpdateWebhookInput{
+		URL:        nil,
+		EventTypes: nil,
+		Enabled:    nil,
+		ID:         request.GetId().GetValue(),
+	}
+
+	for _, path := range request.GetUpdateMask().GetPaths() {
+		switch path {
+		case "url":
+			url := request.GetUrl()
+			input.URL = &url
+		case "event_types":
+			types := UnmarshalEventTypes(request.GetEventTypes())
+			input.EventTypes = &types
+		case "enabled":
+			enabled := request.GetEnabled()
+			input.Enabled = &enabled
+		}
+	}
+
+	return input
+}
+
+func UnmarshalEventTypes(eventTypes []pbnotesv1.EventType) []entities.EventType {
+	types := make([]entities.EventType, 0, len(eventTypes))
+
+	for _, eventType := range eventTypes {
+		if known, ok := notesv1.UnmarshalEventType(eventType); ok {
+			types = append(types, known)
+		}
+	}
+
+	return types
+}
+
+func marshalTime(t *time.Time) *timestamppb.Timestamp {
+	if t == nil {
+		return nil
+	}
+
+	return timestamppb.New(*t)
+}
+
+type ErrorMarshaler struct {
+	errorToCode      map[error]codes.Code
+	errorToErrorCode map[error]typespb.ErrorCode
+}
+
+func NewErrorMarshaler() *ErrorMarshaler {
+	return &ErrorMarshaler{
+		errorToCode: map[error]codes.Code{
+			usecases.ErrWebhookNotFound:   codes.NotFound,
+			usecases.ErrNothingToUpdate:   codes.InvalidArgument,
+			entities.ErrInvalidWebhookURL: codes.InvalidArgument,
+			id.ErrInvalidID:               codes.InvalidArgument,
+			context.Canceled:              codes.Canceled,
+			ex.ErrUnexpected:              codes.Internal,
+			secure.ErrUnauthorized:        codes.Unauthenticated,
+		},
+		errorToErrorCode: map[error]typespb.ErrorCode{
+			usecases.ErrWebhookNotFound:   typespb.ErrorCode_ERROR_CODE_ENTITY_NOT_FOUND,
+			usecases.ErrNothingToUpdate:   typespb.ErrorCode_ERROR_CODE_BUSINESS,
+			entities.ErrInvalidWebhookURL: typespb.ErrorCode_ERROR_CODE_BUSINESS,
+			id.ErrInvalidID:               typespb.ErrorCode_ERROR_CODE_BUSINESS,
+			context.Canceled:              typespb.ErrorCode_ERROR_CODE_INTERNAL,
+			ex.ErrUnexpected:              typespb.ErrorCode_ERROR_CODE_INTERNAL,
+			secure.ErrUnauthorized:        typespb.ErrorCode_ERROR_CODE_PERMISSION_DENIED,
+		},
+	}
+}
+
+func (e *ErrorMarshaler) Code(err error) (codes.Code, bool) {
+	code, ok := e.errorToCode[err]
+
+	return code, ok
+}
+
+func (e *ErrorMarshaler) ErrorCode(err error) (typespb.ErrorCode, bool) {
+	errCode, ok := e.errorToErrorCode[err]
+
+	return errCode, ok
+}
@@ -0,0 +1,53 @@
+package ports
+
+import (
+	"context"
+	"time"
+
+	"github.com/therenotomorrow/gotes/internal/domain/entities"
+	"github.com/therenotomorrow/gotes/internal/domain/types/id"
+)
+
+type WebhooksRepository interface {
+	SaveWebhook(ctx context.Context, webhook *entities.Webhook) (*entities.Webhook, error)
+	GetWebhook(ctx context.Context, ident id.ID) (*entities.Webhook, error)
+	GetWebhooksByUser(ctx context.Context, user *entities.User) ([]*entities.Webhook, error)
+	UpdateWebhook(ctx context.Context, webhook *entities.Webhook) error
+	DeleteWebhook(ctx context.Context, webhook *entities.Webhook) error
+	// CountFailure adds the failed delivery to the failures in a row of the webhook, or resets them
+	// when the delivery succeeded, and returns the failures in a row.
+	CountFailure(ctx context.Context, webhook *entities.Webhook, failed bool) (int32, error)
+}
+
+type DeliveriesRepository interface {
+	// ClaimDeliveries takes the due deliveries of enabled webhooks and hides them from other workers for the lease,
+	// a delivery comes back once the lease is over unless it is deleted or postponed.
+	ClaimDeliveries(ctx context.Context, limit int, lease time.Duration) ([]*entities.Delivery, error)
+	DeleteDelivery(ctx context.Context, delivery *entities.Delivery) error
+	// PostponeDelivery makes the delivery due after the backoff, doubled for every attempt made up to maxBackoff.
+	PostponeDelivery(ctx context.Context, delivery *entities.Delivery, backoff, maxBackoff time.Duration) error
+	DeleteDeliveries(ctx context.Context, webhook *entities.Webhook) error
+	SaveAttempt(ctx context.Context, attempt *entities.Attempt) (*entities.Attempt, error)
+	GetAttempts(ctx context.Context, webhook *entities.Webhook, limit int32) ([]*entities.Attempt, error)
+	// PurgeAttempts removes the attempts made before the time and returns the number of them.
+	PurgeAttempts(ctx context.Context, before time.Time) (int64, error)
+}
+
+// Sender posts the event of the delivery to the webhook, the outcome is recorded in the attempt.
+type Sender interface {
+	Send(ctx context.Context, delivery *entities.Delivery) *entities.Attempt
+}
+
+type Store struct {
+	Webhooks   WebhooksRepository
+	Deliveries DeliveriesRepository
+	Sender     Sender
+}
+
+type StoreProvider interface {
+	Provide(ctx context.Context) Store
+}
+
+type UnitOfWork interface {
+	Do(ctx context.Context, work func(store Store) error) error
+}
@@ -0,0 +1,46 @@
+package ports_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/therenotomorrow/gotes/internal/api/webhooks/v1/adapters/http"
+	"github.com/therenotomorrow/gotes/internal/api/webhooks/v1/adapters/mocks"
+	"github.com/therenotomorrow/gotes/internal/api/webhooks/v1/adapters/postgres"
+	"github.com/therenotomorrow/gotes/internal/api/webhooks/v1/ports"
+)
+
+func TestWebhooksRepository(t *testing.T) {
+	t.Parallel()
+
+	assert.Implements(t, (*ports.WebhooksRepository)(nil), new(postgres.WebhooksRepository))
+	assert.Implements(t, (*ports.WebhooksRepository)(nil), new(mocks.MockWebhooksRepository))
+}
+
+func TestDeliveriesRepository(t *testing.T) {
+	t.Parallel()
+
+	assert.Implements(t, (*ports.DeliveriesRepository)(nil), new(postgres.DeliveriesRepository))
+	assert.Implements(t, (*ports.DeliveriesRepository)(nil), new(mocks.MockDeliveriesRepository))
+}
+
+func TestSender(t *testing.T) {
+	t.Parallel()
+
+	assert.Implements(t, (*ports.Sender)(nil), new(http.Sender))
+	assert.Implements(t, (*ports.Sender)(nil), new(mocks.MockSender))
+}
+
+func TestStoreProvider(t *testing.T) {
+	t.Parallel()
+
+	assert.Implements(t, (*ports.StoreProvider)(nil), new(postgres.StoreProvider))
+	assert.Implements(t, (*ports.StoreProvider)(nil), new(mocks.MockStoreProvider))
+}
+
+func TestUnitOfWork(t *testing.T) {
+	t.Parallel()
+
+	assert.Implements(t, (*ports.UnitOfWork)(nil), new(postgres.UnitOfWork))
+	assert.Implements(t, (*ports.UnitOfWork)(nil), new(mocks.MockUnitOfWork))
+}
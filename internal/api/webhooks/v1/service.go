@@ -0,0 +1,131 @@
+package v1
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+
+	"github.com/therenotomorrow/gotes/internal/api"
+	adapters "github.com/therenotomorrow/gotes/internal/api/webhooks/v1/adapters/postgres"
+	"github.com/therenotomorrow/gotes/internal/api/webhooks/v1/ports"
+	"github.com/therenotomorrow/gotes/internal/api/webhooks/v1/usecases"
+	"github.com/therenotomorrow/gotes/internal/services/secure"
+	"github.com/therenotomorrow/gotes/internal/storages/postgres"
+	pb "github.com/therenotomorrow/gotes/pkg/api/webhooks/v1"
+	"github.com/therenotomorrow/gotes/pkg/services/trace"
+)
+
+type WebhooksService struct {
+	pb.UnimplementedWebhooksServiceServer
+
+	handle api.ErrorHandlerFunc
+	tracer *trace.Tracer
+	cases  *usecases.UseCases
+}
+
+func NewService(db postgres.Database, logger *slog.Logger) *WebhooksService {
+	provider := adapters.NewStoreProvider(db, http.DefaultClient)
+	uow := adapters.NewUnitOfWork(db, provider)
+
+	return NewServiceWithProvider(uow, provider, logger)
+}
+
+func NewServiceWithProvider(uow ports.UnitOfWork, provider ports.StoreProvider, logger *slog.Logger) *WebhooksService {
+	return &WebhooksService{
+		UnimplementedWebhooksServiceServer: pb.UnimplementedWebhooksServiceServer{},
+		handle:                             api.ErrorHandler(NewErrorMarshaler()),
+		tracer:                             trace.Service("webhooks.v1", logger),
+		cases:                              usecases.NewCases(uow, provider.Provide(context.Background())),
+	}
+}
+
+func (svc *WebhooksService) CreateWebhook(
+	ctx context.Context,
+	request *pb.CreateWebhookRequest,
+) (*pb.CreateWebhookResponse, error) {
+	user, err := secure.User(ctx)
+	if err != nil {
+		return nil, svc.handle(err)
+	}
+
+	webhook, err := svc.cases.CreateWebhook(ctx, user, &usecases.CreateWebhookInput{
+		URL:        request.GetUrl(),
+		EventTypes: UnmarshalEventTypes(request.GetEventTypes()),
+	})
+	if err != nil {
+		return nil, svc.handle(err)
+	}
+
+	return &pb.CreateWebhookResponse{Webhook: MarshalWebhook(webhook), Secret: webhook.Secret}, nil
+}
+
+func (svc *WebhooksService) ListWebhooks(
+	ctx context.Context,
+	_ *pb.ListWebhooksRequest,
+) (*pb.ListWebhooksResponse, error) {
+	user, err := secure.User(ctx)
+	if err != nil {
+		return nil, svc.handle(err)
+	}
+
+	webhooks, err := svc.cases.ListWebhooks(ctx, user)
+	if err != nil {
+		return nil, svc.handle(err)
+	}
+
+	return &pb.ListWebhooksResponse{Webhooks: MarshalWebhooks(webhooks)}, nil
+}
+
+func (svc *WebhooksService) UpdateWebhook(
+	ctx context.Context,
+	request *pb.UpdateWebhookRequest,
+) (*pb.UpdateWebhookResponse, error) {
+	user, err := secure.User(ctx)
+	if err != nil {
+		return nil, svc.handle(err)
+	}
+
+	webhook, err := svc.cases.UpdateWebhook(ctx, user, UnmarshalUpdateWebhook(request))
+	if err != nil {
+		return nil, svc.handle(err)
+	}
+
+	return &pb.UpdateWebhookResponse{Webhook: MarshalWebhook(webhook)}, nil
+}
+
+func (svc *WebhooksService) DeleteWebhook(
+	ctx context.Context,
+	request *pb.DeleteWebhookRequest,
+) (*pb.DeleteWebhookResponse, error) {
+	user, err := secure.User(ctx)
+	if err != nil {
+		return nil, svc.handle(err)
+	}
+
+	err = svc.cases.DeleteWebhook(ctx, user, &usecases.WebhookInput{ID: request.GetId().GetValue()})
+	if err != nil {
+		return nil, svc.handle(err)
+	}
+
+	return &pb.DeleteWebhookResponse{}, nil
+}
+
+func (svc *WebhooksService) ListWebhookAttempts(
+	ctx context.Context,
+	request *pb.ListWebhookAttemptsRequest,
+) (*pb.ListWebhookAttemptsResponse, error) {
+	user, err := secure.User(ctx)
+	if err != nil {
+		return nil, svc.handle(err)
+	}
+
+	attempts, err := svc.cases.ListWebhookAttempts(ctx, user, &usecases.ListWebhookAttemptsInput{
+		ID:    request.GetId().GetValue(),
+		Limit: request.GetLimit(),
+	})
+	if err != nil {
+		return nil, svc.handle(err)
+	}
+
+	return &pb.ListWebhookAttemptsResponse{Attempts: MarshalAttempts(attempts)}, nil
+}
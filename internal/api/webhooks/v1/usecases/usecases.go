@@ -0,0 +1,306 @@
+package usecases
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+
+	"github.com/therenotomorrow/gotes/internal/api/webhooks/v1/ports"
+	"github.com/therenotomorrow/gotes/internal/domain"
+	"github.com/therenotomorrow/gotes/internal/domain/entities"
+	"github.com/therenotomorrow/gotes/internal/domain/types/id"
+	"golang.org/x/sync/errgroup"
+)
+
+const (
+	ErrWebhookNotFound domain.Error = "webhook not found"
+	ErrNothingToUpdate domain.Error = "nothing to update"
+)
+
+const (
+	// DefaultFailureLimit is the number of deliveries failed in a row that disables a webhook
+	// unless changed by SetFailureLimit.
+	DefaultFailureLimit = 10
+	// DefaultDeliveryLease is how long a claimed delivery stays hidden from other workers unless changed
+	// by SetDeliveryLease, it must be longer than the requests of a batch take on a single worker.
+	DefaultDeliveryLease = time.Minute
+	// DefaultDeliveryWorkers is the number of deliveries sent at once unless changed by SetDeliveryWorkers.
+	DefaultDeliveryWorkers = 4
+	// DefaultAttemptsLimit is the number of attempts returned when the request does not tell.
+	DefaultAttemptsLimit = 50
+)
+
+// deliveryBackoff is the delay before a failed delivery is due again, it doubles on every attempt
+// up to deliveryMaxBackoff.
+const (
+	deliveryBackoff    = 5 * time.Second
+	deliveryMaxBackoff = time.Hour
+)
+
+type UseCases struct {
+	uow          ports.UnitOfWork
+	store        ports.Store
+	lease        time.Duration
+	workers      int
+	failureLimit int32
+}
+
+func NewCases(uow ports.UnitOfWork, store ports.Store) *UseCases {
+	return &UseCases{
+		uow:          uow,
+		store:        store,
+		lease:        DefaultDeliveryLease,
+		workers:      DefaultDeliveryWorkers,
+		failureLimit: DefaultFailureLimit,
+	}
+}
+
+func (use *UseCases) SetFailureLimit(limit int32) {
+	use.failureLimit = limit
+}
+
+func (use *UseCases) SetDeliveryLease(lease time.Duration) {
+	use.lease = lease
+}
+
+func (use *UseCases) SetDeliveryWorkers(workers int) {
+	use.workers = workers
+}
+
+type CreateWebhookInput struct {
+	URL        string
+	EventTypes []entities.EventType
+}
+
+func (use *UseCases) CreateWebhook(
+	ctx context.Context,
+	user *entities.User,
+	input *CreateWebhookInput,
+) (*entities.Webhook, error) {
+	webhook, err := entities.NewWebhook(input.URL, input.EventTypes)
+	if err != nil {
+		return nil, err
+	}
+
+	webhook.SetOwner(user)
+
+	return use.store.Webhooks.SaveWebhook(ctx, webhook)
+}
+
+func (use *UseCases) ListWebhooks(ctx context.Context, user *entities.User) ([]*entities.Webhook, error) {
+	return use.store.Webhooks.GetWebhooksByUser(ctx, user)
+}
+
+type UpdateWebhookInput struct {
+	URL        *string
+	EventTypes *[]entities.EventType
+	// Enabled enables the webhook again or disables it, enabling forgives the failures.
+	Enabled *bool
+	ID      int64
+}
+
+func (use *UseCases) UpdateWebhook(
+	ctx context.Context,
+	user *entities.User,
+	input *UpdateWebhookInput,
+) (*entities.Webhook, error) {
+	if input.URL == nil && input.EventTypes == nil && input.Enabled == nil {
+		return nil, ErrNothingToUpdate
+	}
+
+	var webhook *entities.Webhook
+
+	err := use.uow.Do(ctx, func(store ports.Store) error {
+		var err error
+
+		webhook, err = use.webhook(ctx, store, user, input.ID)
+		if err != nil {
+			return err
+		}
+
+		if input.URL != nil {
+			err = webhook.SetURL(*input.URL)
+			if err != nil {
+				return err
+			}
+		}
+
+		if input.EventTypes != nil {
+			webhook.SetEventTypes(*input.EventTypes)
+		}
+
+		if input.Enabled != nil {
+			err = use.enable(ctx, store, webhook, *input.Enabled)
+			if err != nil {
+				return err
+			}
+		}
+
+		return store.Webhooks.UpdateWebhook(ctx, webhook)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return webhook, nil
+}
+
+type WebhookInput struct {
+	ID int64
+}
+
+func (use *UseCases) DeleteWebhook(ctx context.Context, user *entities.User, input *WebhookInput) error {
+	return use.uow.Do(ctx, func(store ports.Store) error {
+		webhook, err := use.webhook(ctx, store, user, input.ID)
+		if err != nil {
+			return err
+		}
+
+		return store.Webhooks.DeleteWebhook(ctx, webhook)
+	})
+}
+
+type ListWebhookAttemptsInput struct {
+	ID    int64
+	Limit int32
+}
+
+func (use *UseCases) ListWebhookAttempts(
+	ctx context.Context,
+	user *entities.User,
+	input *ListWebhookAttemptsInput,
+) ([]*entities.Attempt, error) {
+	webhook, err := use.webhook(ctx, use.store, user, input.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	limit := input.Limit
+	if limit <= 0 {
+		limit = DefaultAttemptsLimit
+	}
+
+	return use.store.Deliveries.GetAttempts(ctx, webhook, limit)
+}
+
+// DeliverWebhooks sends up to limit due deliveries by the workers and returns how many of them were attempted.
+// A failed delivery is tried again with a backoff, and the webhook is disabled once its deliveries fail too many
+// times in a row. A delivery failed to be recorded comes back once its lease is over.
+func (use *UseCases) DeliverWebhooks(ctx context.Context, limit int) (int, error) {
+	deliveries, err := use.store.Deliveries.ClaimDeliveries(ctx, limit, use.lease)
+	if err != nil {
+		return 0, err
+	}
+
+	var (
+		group     errgroup.Group
+		attempted atomic.Int64
+	)
+
+	group.SetLimit(use.workers)
+
+	for _, delivery := range deliveries {
+		group.Go(func() error {
+			attempt := use.store.Sender.Send(ctx, delivery)
+
+			err := use.uow.Do(ctx, func(store ports.Store) error {
+				return use.record(ctx, store, delivery, attempt)
+			})
+			if err != nil {
+				return err
+			}
+
+			attempted.Add(1)
+
+			return nil
+		})
+	}
+
+	err = group.Wait()
+
+	return int(attempted.Load()), err
+}
+
+// PurgeAttempts removes the attempts older than the retention and returns the number of them.
+func (use *UseCases) PurgeAttempts(ctx context.Context, retention time.Duration) (int, error) {
+	purged, err := use.store.Deliveries.PurgeAttempts(ctx, time.Now().Add(-retention))
+
+	return int(purged), err
+}
+
+// record saves the attempt and settles the delivery: a delivered event is done with, a failed one is postponed.
+func (use *UseCases) record(
+	ctx context.Context,
+	store ports.Store,
+	delivery *entities.Delivery,
+	attempt *entities.Attempt,
+) error {
+	_, err := store.Deliveries.SaveAttempt(ctx, attempt)
+	if err != nil {
+		return err
+	}
+
+	if attempt.Delivered() {
+		err = store.Deliveries.DeleteDelivery(ctx, delivery)
+	} else {
+		err = store.Deliveries.PostponeDelivery(ctx, delivery, deliveryBackoff, deliveryMaxBackoff)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	failures, err := store.Webhooks.CountFailure(ctx, delivery.Webhook, !attempt.Delivered())
+	if err != nil || failures < use.failureLimit {
+		return err
+	}
+
+	webhook, err := store.Webhooks.GetWebhook(ctx, delivery.Webhook.ID)
+	if err != nil {
+		return err
+	}
+
+	err = use.enable(ctx, store, webhook, false)
+	if err != nil {
+		return err
+	}
+
+	return store.Webhooks.UpdateWebhook(ctx, webhook)
+}
+
+// enable switches the webhook on or off, the pending deliveries of a disabled webhook are dropped,
+// so it does not receive stale events once enabled again.
+func (use *UseCases) enable(ctx context.Context, store ports.Store, webhook *entities.Webhook, enabled bool) error {
+	if enabled {
+		webhook.Enable()
+
+		return nil
+	}
+
+	webhook.Disable()
+
+	return store.Deliveries.DeleteDeliveries(ctx, webhook)
+}
+
+func (use *UseCases) webhook(
+	ctx context.Context,
+	store ports.Store,
+	user *entities.User,
+	webhookID int64,
+) (*entities.Webhook, error) {
+	ident, err := id.Conv(webhookID)
+	if err != nil {
+		return nil, err
+	}
+
+	webhook, err := store.Webhooks.GetWebhook(ctx, ident)
+	if err != nil {
+		return nil, err
+	}
+
+	if !webhook.IsOwner(user) {
+		return nil, ErrWebhookNotFound
+	}
+
+	return webhook, nil
+}
@@ -0,0 +1,409 @@
+package usecases_test
+
+import (
+	"context"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/therenotomorrow/gotes/internal/api/webhooks/v1/adapters/mocks"
+	"github.com/therenotomorrow/gotes/internal/api/webhooks/v1/ports"
+	v1 "github.com/therenotomorrow/gotes/internal/api/webhooks/v1/usecases"
+	"github.com/therenotomorrow/gotes/internal/domain/entities"
+	"github.com/therenotomorrow/gotes/internal/domain/types/id"
+	"github.com/therenotomorrow/gotes/internal/domain/types/uuid"
+	"github.com/therenotomorrow/gotes/pkg/services/generate"
+)
+
+type unitOfWork ports.Store
+
+func (uow unitOfWork) Do(_ context.Context, work func(store ports.Store) error) error {
+	return work(ports.Store(uow))
+}
+
+func TestUseCasesCreateWebhook(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid url", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			ctx   = t.Context()
+			user  = &entities.User{ID: id.New(10)}
+			store = ports.Store{}
+			use   = v1.NewCases(unitOfWork(store), store)
+		)
+
+		urls := []string{
+			"", "example.com/hook", "ftp://example.com/hook", "https://", "http://localhost:8080/hook",
+			"http://127.0.0.1/hook", "http://10.0.0.1/hook", "http://169.254.169.254/latest/meta-data", "http://[::1]/hook",
+		}
+
+		for _, url := range urls {
+			got, err := use.CreateWebhook(ctx, user, &v1.CreateWebhookInput{URL: url})
+			require.ErrorIs(t, err, entities.ErrInvalidWebhookURL)
+			assert.Nil(t, got)
+		}
+	})
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			ctx      = t.Context()
+			user     = &entities.User{ID: id.New(10)}
+			webhooks = mocks.NewMockWebhooksRepository(t)
+			store    = ports.Store{Webhooks: webhooks}
+			use      = v1.NewCases(unitOfWork(store), store)
+			types    = []entities.EventType{entities.EventTypeCreated}
+		)
+
+		webhooks.On("SaveWebhook", ctx, mock.AnythingOfType("*entities.Webhook")).
+			Return(func(_ context.Context, webhook *entities.Webhook) (*entities.Webhook, error) {
+				webhook.ID = id.New(1)
+
+				return webhook, nil
+			}).Once()
+
+		got, err := use.CreateWebhook(ctx, user, &v1.CreateWebhookInput{URL: "https://example.com/hook", EventTypes: types})
+		require.NoError(t, err)
+
+		assert.Equal(t, id.New(1), got.ID)
+		assert.Equal(t, user, got.Owner)
+		assert.Equal(t, types, got.EventTypes)
+		assert.NotEmpty(t, got.Secret)
+		assert.True(t, got.Enabled())
+	})
+}
+
+func TestUseCasesUpdateWebhook(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nothing to update", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			ctx   = t.Context()
+			user  = &entities.User{ID: id.New(10)}
+			store = ports.Store{}
+			use   = v1.NewCases(unitOfWork(store), store)
+		)
+
+		got, err := use.UpdateWebhook(ctx, user, &v1.UpdateWebhookInput{ID: 1})
+		require.ErrorIs(t, err, v1.ErrNothingToUpdate)
+		assert.Nil(t, got)
+	})
+
+	t.Run("webhook of another user", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			ctx      = t.Context()
+			user     = &entities.User{ID: id.New(10)}
+			enabled  = true
+			webhooks = mocks.NewMockWebhooksRepository(t)
+			store    = ports.Store{Webhooks: webhooks}
+			use      = v1.NewCases(unitOfWork(store), store)
+		)
+
+		webhooks.On("GetWebhook", ctx, id.New(1)).
+			Return(&entities.Webhook{ID: id.New(1), Owner: &entities.User{ID: id.New(20)}}, nil).Once()
+
+		got, err := use.UpdateWebhook(ctx, user, &v1.UpdateWebhookInput{ID: 1, Enabled: &enabled})
+		require.ErrorIs(t, err, v1.ErrWebhookNotFound)
+		assert.Nil(t, got)
+	})
+
+	t.Run("enable", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			ctx      = t.Context()
+			user     = &entities.User{ID: id.New(10)}
+			enabled  = true
+			url      = "https://example.com/new"
+			disabled = time.Now()
+			webhooks = mocks.NewMockWebhooksRepository(t)
+			store    = ports.Store{Webhooks: webhooks}
+			use      = v1.NewCases(unitOfWork(store), store)
+			webhook  = &entities.Webhook{ID: id.New(1), Owner: user, DisabledAt: &disabled, Failures: 10}
+		)
+
+		webhooks.On("GetWebhook", ctx, id.New(1)).
+			Return(webhook, nil).Once()
+		webhooks.On("UpdateWebhook", ctx, webhook).
+			Return(nil).Once()
+
+		got, err := use.UpdateWebhook(ctx, user, &v1.UpdateWebhookInput{ID: 1, URL: &url, Enabled: &enabled})
+		require.NoError(t, err)
+
+		assert.True(t, got.Enabled())
+		assert.Zero(t, got.Failures)
+		assert.Equal(t, url, got.URL)
+	})
+
+	t.Run("disable", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			ctx        = t.Context()
+			user       = &entities.User{ID: id.New(10)}
+			enabled    = false
+			webhooks   = mocks.NewMockWebhooksRepository(t)
+			deliveries = mocks.NewMockDeliveriesRepository(t)
+			store      = ports.Store{Webhooks: webhooks, Deliveries: deliveries}
+			use        = v1.NewCases(unitOfWork(store), store)
+			webhook    = &entities.Webhook{ID: id.New(1), Owner: user}
+		)
+
+		webhooks.On("GetWebhook", ctx, id.New(1)).
+			Return(webhook, nil).Once()
+		deliveries.On("DeleteDeliveries", ctx, webhook).
+			Return(nil).Once()
+		webhooks.On("UpdateWebhook", ctx, webhook).
+			Return(nil).Once()
+
+		got, err := use.UpdateWebhook(ctx, user, &v1.UpdateWebhookInput{ID: 1, Enabled: &enabled})
+		require.NoError(t, err)
+		assert.False(t, got.Enabled())
+	})
+}
+
+func TestUseCasesDeleteWebhook(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx      = t.Context()
+		user     = &entities.User{ID: id.New(10)}
+		webhooks = mocks.NewMockWebhooksRepository(t)
+		store    = ports.Store{Webhooks: webhooks}
+		use      = v1.NewCases(unitOfWork(store), store)
+		webhook  = &entities.Webhook{ID: id.New(1), Owner: user}
+	)
+
+	webhooks.On("GetWebhook", ctx, id.New(1)).
+		Return(webhook, nil).Once()
+	webhooks.On("DeleteWebhook", ctx, webhook).
+		Return(nil).Once()
+
+	err := use.DeleteWebhook(ctx, user, &v1.WebhookInput{ID: 1})
+	require.NoError(t, err)
+}
+
+func TestUseCasesListWebhookAttempts(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx        = t.Context()
+		user       = &entities.User{ID: id.New(10)}
+		webhooks   = mocks.NewMockWebhooksRepository(t)
+		deliveries = mocks.NewMockDeliveriesRepository(t)
+		store      = ports.Store{Webhooks: webhooks, Deliveries: deliveries}
+		use        = v1.NewCases(unitOfWork(store), store)
+		webhook    = &entities.Webhook{ID: id.New(1), Owner: user}
+		attempts   = []*entities.Attempt{{ID: id.New(2), Webhook: webhook, StatusCode: http.StatusOK}}
+	)
+
+	webhooks.On("GetWebhook", ctx, id.New(1)).
+		Return(webhook, nil).Once()
+	deliveries.On("GetAttempts", ctx, webhook, int32(v1.DefaultAttemptsLimit)).
+		Return(attempts, nil).Once()
+
+	got, err := use.ListWebhookAttempts(ctx, user, &v1.ListWebhookAttemptsInput{ID: 1})
+	require.NoError(t, err)
+	assert.Equal(t, attempts, got)
+}
+
+func TestUseCasesDeliverWebhooks(t *testing.T) {
+	t.Parallel()
+
+	uuid.SetGenerator(generate.NewUUID())
+
+	newDelivery := func() *entities.Delivery {
+		return &entities.Delivery{
+			Webhook:  &entities.Webhook{ID: id.New(1), URL: "https://example.com/hook", Secret: "secret"},
+			Event:    &entities.Event{ID: uuid.New(), Note: &entities.Note{ID: id.New(42)}},
+			Attempts: 1,
+		}
+	}
+
+	attempted := func(delivery *entities.Delivery, status int) *entities.Attempt {
+		attempt := entities.NewAttempt(delivery)
+		attempt.Finish(status, nil)
+
+		return attempt
+	}
+
+	t.Run("delivered", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			ctx        = t.Context()
+			webhooks   = mocks.NewMockWebhooksRepository(t)
+			deliveries = mocks.NewMockDeliveriesRepository(t)
+			sender     = mocks.NewMockSender(t)
+			store      = ports.Store{Webhooks: webhooks, Deliveries: deliveries, Sender: sender}
+			use        = v1.NewCases(unitOfWork(store), store)
+			delivery   = newDelivery()
+			attempt    = attempted(delivery, http.StatusNoContent)
+		)
+
+		deliveries.On("ClaimDeliveries", ctx, 10, v1.DefaultDeliveryLease).
+			Return([]*entities.Delivery{delivery}, nil).Once()
+		sender.On("Send", ctx, delivery).
+			Return(attempt).Once()
+		deliveries.On("SaveAttempt", ctx, attempt).
+			Return(attempt, nil).Once()
+		deliveries.On("DeleteDelivery", ctx, delivery).
+			Return(nil).Once()
+		webhooks.On("CountFailure", ctx, delivery.Webhook, false).
+			Return(int32(0), nil).Once()
+
+		got, err := use.DeliverWebhooks(ctx, 10)
+		require.NoError(t, err)
+		assert.Equal(t, 1, got)
+	})
+
+	t.Run("failed", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			ctx        = t.Context()
+			webhooks   = mocks.NewMockWebhooksRepository(t)
+			deliveries = mocks.NewMockDeliveriesRepository(t)
+			sender     = mocks.NewMockSender(t)
+			store      = ports.Store{Webhooks: webhooks, Deliveries: deliveries, Sender: sender}
+			use        = v1.NewCases(unitOfWork(store), store)
+			delivery   = newDelivery()
+			attempt    = attempted(delivery, http.StatusInternalServerError)
+		)
+
+		deliveries.On("ClaimDeliveries", ctx, 10, v1.DefaultDeliveryLease).
+			Return([]*entities.Delivery{delivery}, nil).Once()
+		sender.On("Send", ctx, delivery).
+			Return(attempt).Once()
+		deliveries.On("SaveAttempt", ctx, attempt).
+			Return(attempt, nil).Once()
+		deliveries.On("PostponeDelivery", ctx, delivery, 5*time.Second, time.Hour).
+			Return(nil).Once()
+		webhooks.On("CountFailure", ctx, delivery.Webhook, true).
+			Return(int32(3), nil).Once()
+
+		got, err := use.DeliverWebhooks(ctx, 10)
+		require.NoError(t, err)
+		assert.Equal(t, 1, got)
+		assert.False(t, attempt.Delivered())
+	})
+
+	t.Run("disable after failures", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			ctx        = t.Context()
+			webhooks   = mocks.NewMockWebhooksRepository(t)
+			deliveries = mocks.NewMockDeliveriesRepository(t)
+			sender     = mocks.NewMockSender(t)
+			store      = ports.Store{Webhooks: webhooks, Deliveries: deliveries, Sender: sender}
+			use        = v1.NewCases(unitOfWork(store), store)
+			delivery   = newDelivery()
+			webhook    = &entities.Webhook{ID: delivery.Webhook.ID, Failures: 3}
+			attempt    = entities.NewAttempt(delivery)
+		)
+
+		use.SetFailureLimit(3)
+		attempt.Finish(0, context.DeadlineExceeded)
+
+		deliveries.On("ClaimDeliveries", ctx, 10, v1.DefaultDeliveryLease).
+			Return([]*entities.Delivery{delivery}, nil).Once()
+		sender.On("Send", ctx, delivery).
+			Return(attempt).Once()
+		deliveries.On("SaveAttempt", ctx, attempt).
+			Return(attempt, nil).Once()
+		deliveries.On("PostponeDelivery", ctx, delivery, 5*time.Second, time.Hour).
+			Return(nil).Once()
+		webhooks.On("CountFailure", ctx, delivery.Webhook, true).
+			Return(int32(3), nil).Once()
+		webhooks.On("GetWebhook", ctx, webhook.ID).
+			Return(webhook, nil).Once()
+		deliveries.On("DeleteDeliveries", ctx, webhook).
+			Return(nil).Once()
+		webhooks.On("UpdateWebhook", ctx, webhook).
+			Return(nil).Once()
+
+		got, err := use.DeliverWebhooks(ctx, 10)
+		require.NoError(t, err)
+		assert.Equal(t, 1, got)
+		assert.False(t, webhook.Enabled())
+		assert.Equal(t, context.DeadlineExceeded.Error(), attempt.Error)
+	})
+
+	t.Run("workers", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			ctx        = t.Context()
+			webhooks   = mocks.NewMockWebhooksRepository(t)
+			deliveries = mocks.NewMockDeliveriesRepository(t)
+			sender     = mocks.NewMockSender(t)
+			store      = ports.Store{Webhooks: webhooks, Deliveries: deliveries, Sender: sender}
+			use        = v1.NewCases(unitOfWork(store), store)
+			claimed    = []*entities.Delivery{newDelivery(), newDelivery(), newDelivery(), newDelivery(), newDelivery()}
+			sending    atomic.Int32
+			peak       atomic.Int32
+		)
+
+		use.SetDeliveryWorkers(2)
+
+		deliveries.On("ClaimDeliveries", ctx, 10, v1.DefaultDeliveryLease).
+			Return(claimed, nil).Once()
+		sender.On("Send", ctx, mock.AnythingOfType("*entities.Delivery")).
+			Return(func(_ context.Context, delivery *entities.Delivery) *entities.Attempt {
+				now := sending.Add(1)
+				defer sending.Add(-1)
+
+				for prev := peak.Load(); now > prev && !peak.CompareAndSwap(prev, now); prev = peak.Load() {
+				}
+
+				time.Sleep(10 * time.Millisecond)
+
+				return attempted(delivery, http.StatusNoContent)
+			}).Times(len(claimed))
+		deliveries.On("SaveAttempt", ctx, mock.AnythingOfType("*entities.Attempt")).
+			Return(func(_ context.Context, attempt *entities.Attempt) (*entities.Attempt, error) {
+				return attempt, nil
+			}).Times(len(claimed))
+		deliveries.On("DeleteDelivery", ctx, mock.AnythingOfType("*entities.Delivery")).
+			Return(nil).Times(len(claimed))
+		webhooks.On("CountFailure", ctx, mock.AnythingOfType("*entities.Webhook"), false).
+			Return(int32(0), nil).Times(len(claimed))
+
+		got, err := use.DeliverWebhooks(ctx, 10)
+		require.NoError(t, err)
+		assert.Equal(t, len(claimed), got)
+		assert.Equal(t, int32(2), peak.Load())
+	})
+}
+
+func TestUseCasesPurgeAttempts(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx        = t.Context()
+		deliveries = mocks.NewMockDeliveriesRepository(t)
+		store      = ports.Store{Deliveries: deliveries}
+		use        = v1.NewCases(unitOfWork(store), store)
+	)
+
+	deliveries.On("PurgeAttempts", ctx, mock.MatchedBy(func(before time.Time) bool {
+		return before.Before(time.Now().Add(-time.Hour+time.Minute)) && before.After(time.Now().Add(-time.Hour-time.Minute))
+	})).
+		Return(int64(3), nil).Once()
+
+	got, err := use.PurgeAttempts(ctx, time.Hour)
+	require.NoError(t, err)
+	assert.Equal(t, 3, got)
+}
@@ -34,16 +34,19 @@ type KeepAlive struct {
 type CORS struct {
 	AllowedOrigins string   `json:"allowedOrigins"`
 	AllowedHeaders string   `json:"allowedHeaders"`
+	ExposedHeaders string   `json:"exposedHeaders"`
 	AllowedMethods []string `json:"allowedMethods"`
 }
 
 type Gateway struct {
-	Address string `env:"GOTES_GATEWAY_ADDRESS,required" json:"gateway"`
-	CORS    CORS   `                                     json:"cors"`
+	Address   string        `env:"GOTES_GATEWAY_ADDRESS,required"       json:"gateway"`
+	CORS      CORS          `                                           json:"cors"`
+	KeepAlive time.Duration `env:"GOTES_GATEWAY_KEEP_ALIVE,default=15s" json:"keepAlive"`
 }
 
 type Server struct {
 	Address              string    `env:"GOTES_SERVER_ADDRESS,required" json:"address"`
+	Secret               string    `env:"GOTES_SERVER_SECRET,required"  json:"secret"`
 	Gateway              Gateway   `                                    json:"gateway"`
 	Secure               bool      `env:"GOTES_SERVER_SECURE,required"  json:"secure"`
 	MaxConcurrentStreams uint32    `                                    json:"maxConcurrentStreams"`
@@ -59,11 +62,55 @@ type Redis struct {
 	Password string `env:"GOTES_REDIS_PASSWORD,required" json:"password"`
 }
 
+type S3 struct {
+	Endpoint  string        `env:"GOTES_BLOBS_S3_ENDPOINT"                  json:"endpoint"`
+	Region    string        `env:"GOTES_BLOBS_S3_REGION,default=us-east-1" json:"region"`
+	Bucket    string        `env:"GOTES_BLOBS_S3_BUCKET"                    json:"bucket"`
+	AccessKey string        `env:"GOTES_BLOBS_S3_ACCESS_KEY"                json:"accessKey"`
+	SecretKey string        `env:"GOTES_BLOBS_S3_SECRET_KEY"                json:"secretKey"`
+	Timeout   time.Duration `env:"GOTES_BLOBS_S3_TIMEOUT,default=1m"        json:"timeout"`
+}
+
+type Blobs struct {
+	Driver string `env:"GOTES_BLOBS_DRIVER,default=filesystem" json:"driver"`
+	Dir    string `env:"GOTES_BLOBS_DIR,default=.data/blobs"   json:"dir"`
+	S3     S3     `                                            json:"s3"`
+}
+
+type Notes struct {
+	TrashRetention     time.Duration `env:"GOTES_NOTES_TRASH_RETENTION,required"          json:"trashRetention"`
+	PurgeInterval      time.Duration `                                                    json:"purgeInterval"`
+	BatchLimit         int           `env:"GOTES_NOTES_BATCH_LIMIT,default=100"           json:"batchLimit"`
+	AttachmentLimit    int64         `env:"GOTES_NOTES_ATTACHMENT_LIMIT,default=10485760" json:"attachmentLimit"`
+	Heartbeat          time.Duration `env:"GOTES_NOTES_HEARTBEAT_INTERVAL,default=15s"    json:"heartbeat"`
+	RelayInterval      time.Duration `env:"GOTES_NOTES_RELAY_INTERVAL,default=250ms"      json:"relayInterval"`
+	ReminderInterval   time.Duration `env:"GOTES_NOTES_REMINDER_INTERVAL,default=1s"      json:"reminderInterval"`
+	CheckpointInterval time.Duration `env:"GOTES_NOTES_CHECKPOINT_INTERVAL,default=5s"    json:"checkpointInterval"`
+}
+
+type Events struct {
+	MaxLength  int64         `env:"GOTES_EVENTS_MAX_LENGTH,default=10000" json:"maxLength"`
+	MaxAge     time.Duration `env:"GOTES_EVENTS_MAX_AGE,default=0s"       json:"maxAge"`
+	MaxDevices int           `env:"GOTES_EVENTS_MAX_DEVICES,default=32"   json:"maxDevices"`
+	DeviceIdle time.Duration `env:"GOTES_EVENTS_DEVICE_IDLE,default=720h" json:"deviceIdle"`
+}
+
+type Webhooks struct {
+	Interval          time.Duration `env:"GOTES_WEBHOOKS_INTERVAL,default=1s"             json:"interval"`
+	Timeout           time.Duration `env:"GOTES_WEBHOOKS_TIMEOUT,default=10s"             json:"timeout"`
+	AttemptsRetention time.Duration `env:"GOTES_WEBHOOKS_ATTEMPTS_RETENTION,default=720h" json:"attemptsRetention"`
+	FailureLimit      int32         `env:"GOTES_WEBHOOKS_FAILURE_LIMIT,default=10"        json:"failureLimit"`
+}
+
 type Config struct {
 	Tier     Tier     `env:"GOTES_TIER,required"  json:"tier"`
 	Postgres Postgres `                           json:"postgres"`
 	Redis    Redis    `                           json:"redis"`
 	Server   Server   `                           json:"server"`
+	Notes    Notes    `                           json:"notes"`
+	Blobs    Blobs    `                           json:"blobs"`
+	Events   Events   `                           json:"events"`
+	Webhooks Webhooks `                           json:"webhooks"`
 	Debug    bool     `env:"GOTES_DEBUG,required" json:"debug"`
 }
 
@@ -97,10 +144,12 @@ func New(filenames ...string) (*Config, error) {
 			PermitWithoutStream: false,
 		},
 	}
+	cfg.Notes.PurgeInterval = time.Hour
 	cfg.Server.Gateway.CORS = CORS{
 		AllowedOrigins: "*",
 		AllowedHeaders: "*",
-		AllowedMethods: []string{"GET", "POST", "DELETE", "HEAD", "OPTIONS"},
+		ExposedHeaders: "ETag",
+		AllowedMethods: []string{"GET", "POST", "PATCH", "DELETE", "HEAD", "OPTIONS"},
 	}
 
 	return cfg, nil
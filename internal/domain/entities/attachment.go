@@ -0,0 +1,63 @@
+package entities
+
+import (
+	"crypto/rand"
+	"time"
+
+	"github.com/therenotomorrow/gotes/internal/domain"
+	"github.com/therenotomorrow/gotes/internal/domain/types/id"
+)
+
+const (
+	ErrEmptyAttachmentName domain.Error = "empty attachment name"
+
+	// DefaultContentType is the media type of files uploaded without one.
+	DefaultContentType = "application/octet-stream"
+)
+
+// Attachment is a file attached to a note, the content itself lives in the blob storage under the key.
+type Attachment struct {
+	CreatedAt   time.Time
+	Note        *Note
+	Owner       *User
+	Name        string
+	ContentType string
+	// Key is the unguessable name of the content in the blob storage.
+	Key string
+	// Checksum is the hex encoded SHA-256 of the content.
+	Checksum string
+	ID       id.ID
+	Size     int64
+}
+
+func NewAttachment(note *Note, name, contentType string) (*Attachment, error) {
+	if name == "" {
+		return nil, ErrEmptyAttachmentName
+	}
+
+	if contentType == "" {
+		contentType = DefaultContentType
+	}
+
+	return &Attachment{
+		ID:          id.ID{},
+		Note:        note,
+		Owner:       nil,
+		Name:        name,
+		ContentType: contentType,
+		Key:         rand.Text(),
+		Checksum:    "",
+		Size:        0,
+		CreatedAt:   time.Now(),
+	}, nil
+}
+
+func (a *Attachment) SetOwner(u *User) {
+	a.Owner = u
+}
+
+// Seal records the size and the checksum of the content once it is stored.
+func (a *Attachment) Seal(size int64, checksum string) {
+	a.Size = size
+	a.Checksum = checksum
+}
@@ -0,0 +1,146 @@
+package entities
+
+import (
+	"unicode/utf8"
+
+	"github.com/therenotomorrow/gotes/internal/domain/types/ot"
+	"github.com/therenotomorrow/gotes/internal/domain/types/uuid"
+)
+
+// Draft is the content of the note being edited together, the edits of all editors are applied to it one after
+// another and it is saved into the note from time to time.
+type Draft struct {
+	Note    *Note
+	Content string
+	// Position is where the edits made after the draft was read start in the stream of edits.
+	Position string
+	// Revision is the number of edits applied to the draft.
+	Revision int64
+	// Saved is the revision of the draft last saved into the note.
+	Saved int64
+	// Version is the version of the note the draft is based on, the draft is saved into the note at this version only.
+	Version int64
+}
+
+func NewDraft(note *Note) *Draft {
+	return &Draft{Note: note, Content: note.Content, Position: "", Revision: 0, Saved: 0, Version: note.Version}
+}
+
+// Apply changes the content by the edit, the edit gets the revision it made.
+func (d *Draft) Apply(edit *Edit) error {
+	content, err := edit.Operation.Apply(d.Content)
+	if err != nil {
+		return err
+	}
+
+	d.Content = content
+	d.Revision++
+	edit.Revision = d.Revision
+
+	return nil
+}
+
+func (d *Draft) IsSaved() bool {
+	return d.Saved >= d.Revision
+}
+
+// IsStale tells whether the note was changed apart from the draft, e.g. updated or restored to an older revision.
+// Saving the stale draft would overwrite the change, so the draft is reset to the note instead.
+func (d *Draft) IsStale() bool {
+	return d.Note.Version > d.Version
+}
+
+// Selection is the cursor of the editor, Anchor and Head are equal unless some text is selected.
+type Selection struct {
+	Anchor int
+	Head   int
+}
+
+// Transform moves the selection along the change made by the operation.
+func (s *Selection) Transform(operation ot.Operation) {
+	s.Anchor = ot.TransformIndex(s.Anchor, operation)
+	s.Head = ot.TransformIndex(s.Head, operation)
+}
+
+// Edit is the change of the draft made by the editor, or the move of their cursor when it has the selection.
+type Edit struct {
+	User      *User
+	Selection *Selection
+	Session   string
+	// Position is where the edit is in the stream of edits.
+	Position  string
+	Operation ot.Operation
+	// Revision is the revision of the draft the edit is based on, once applied it is the revision the edit made.
+	Revision int64
+}
+
+func (e *Edit) IsCursor() bool {
+	return e.Selection != nil
+}
+
+// Editor is the user editing the note in a session, a user editing on several devices has a session on each of them.
+type Editor struct {
+	User    *User
+	Note    *Note
+	Session string
+	// Position is where the edits the editor has not seen yet start in the stream of edits.
+	Position string
+	// CanWrite is false for the collaborators who are allowed only to read the note, they just follow the edits.
+	CanWrite bool
+}
+
+func NewEditor(user *User, note *Note, canWrite bool) *Editor {
+	return &Editor{User: user, Note: note, Session: uuid.New().Value(), Position: "", CanWrite: canWrite}
+}
+
+// Join makes the editor follow the edits made after the draft.
+func (e *Editor) Join(draft *Draft) {
+	e.Position = draft.Position
+}
+
+// Edit makes the change of the draft at the revision.
+func (e *Editor) Edit(operation ot.Operation, revision int64) *Edit {
+	return &Edit{
+		User:      e.User,
+		Selection: nil,
+		Session:   e.Session,
+		Position:  "",
+		Operation: operation,
+		Revision:  revision,
+	}
+}
+
+// Reset makes the change of the draft at its revision into the content of its note, the editors following the draft
+// get the content of the note as any other edit.
+func (e *Editor) Reset(draft *Draft) *Edit {
+	length := utf8.RuneCountInString(draft.Content)
+	operation := ot.Operation{}.Retain(length)
+
+	if draft.Content != draft.Note.Content {
+		operation = ot.Operation{}.Delete(length).Insert(draft.Note.Content)
+	}
+
+	return e.Edit(operation, draft.Revision)
+}
+
+// Move makes the move of the cursor in the draft at the revision.
+func (e *Editor) Move(selection Selection, revision int64) *Edit {
+	return &Edit{
+		User:      e.User,
+		Selection: &selection,
+		Session:   e.Session,
+		Position:  "",
+		Operation: nil,
+		Revision:  revision,
+	}
+}
+
+// Follow remembers the edit as seen.
+func (e *Editor) Follow(edit *Edit) {
+	e.Position = edit.Position
+}
+
+// IsOwn tells whether the edit is made in the session of the editor.
+func (e *Editor) IsOwn(edit *Edit) bool {
+	return e.Session == edit.Session
+}
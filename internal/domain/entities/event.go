@@ -11,11 +11,49 @@ type EventType int
 const (
 	EventTypeCreated EventType = iota
 	EventTypeDeleted
+	EventTypeUpdated
+	EventTypeRestored
+	EventTypePurged
+	EventTypeShared
+	EventTypeUnshared
+	EventTypeReminder
 )
 
+func (t EventType) String() string {
+	switch t {
+	case EventTypeCreated:
+		return "created"
+	case EventTypeDeleted:
+		return "deleted"
+	case EventTypeUpdated:
+		return "updated"
+	case EventTypeRestored:
+		return "restored"
+	case EventTypePurged:
+		return "purged"
+	case EventTypeShared:
+		return "shared"
+	case EventTypeUnshared:
+		return "unshared"
+	case EventTypeReminder:
+		return "reminder"
+	default:
+		return "unknown"
+	}
+}
+
+// DefaultDevice is the device of subscribers that do not tell theirs.
+const DefaultDevice = "default"
+
 type Event struct {
 	EventTime time.Time
-	Note      *Note
+	// ReadAt is when the recipient marked the event as read, nil while it is unread.
+	ReadAt *time.Time
+	Note   *Note
+	// Recipient is the user whose event stream receives the event, the owner of the note by default.
+	Recipient *User
+	// Position is where the event is stored in the stream of the recipient, it is known once the event is read.
+	Position  string
 	ID        uuid.UUID
 	EventType EventType
 }
@@ -25,6 +63,39 @@ func NewEvent(t EventType, n *Note) *Event {
 		ID:        uuid.New(),
 		EventType: t,
 		Note:      n,
+		Recipient: n.Owner,
+		Position:  "",
 		EventTime: time.Now(),
+		ReadAt:    nil,
+	}
+}
+
+func (e *Event) SetRecipient(u *User) {
+	e.Recipient = u
+}
+
+// Subscription is the event stream of a single device of the user, every device receives all events of the user.
+type Subscription struct {
+	User   *User
+	Device string
+	// Filter identifies the filter of the events the device receives, the device keeps a separate position
+	// for every filter, so the events skipped by one filter are still delivered without it.
+	Filter string
+}
+
+func NewSubscription(user *User, device string) *Subscription {
+	if device == "" {
+		device = DefaultDevice
+	}
+
+	return &Subscription{User: user, Device: device, Filter: ""}
+}
+
+// Group returns the name the stream tracks the position of the subscription by.
+func (s *Subscription) Group() string {
+	if s.Filter == "" {
+		return s.Device
 	}
+
+	return s.Device + ":" + s.Filter
 }
@@ -0,0 +1,43 @@
+package entities
+
+import (
+	"crypto/rand"
+	"time"
+
+	"github.com/therenotomorrow/gotes/internal/domain/types/id"
+)
+
+// Link is a public read-only link to a note, anyone knowing the token can read the note.
+type Link struct {
+	CreatedAt time.Time
+	ExpiresAt *time.Time
+	Note      *Note
+	Token     string
+	ID        id.ID
+	Views     int64
+}
+
+// NewLink mints an unguessable token for the note, a zero ttl makes the link never expire.
+func NewLink(note *Note, ttl time.Duration) *Link {
+	now := time.Now()
+
+	var expiresAt *time.Time
+
+	if ttl > 0 {
+		expires := now.Add(ttl)
+		expiresAt = &expires
+	}
+
+	return &Link{
+		ID:        id.ID{},
+		Note:      note,
+		Token:     rand.Text(),
+		Views:     0,
+		ExpiresAt: expiresAt,
+		CreatedAt: now,
+	}
+}
+
+func (l *Link) IsExpired() bool {
+	return l.ExpiresAt != nil && !time.Now().Before(*l.ExpiresAt)
+}
@@ -2,23 +2,45 @@ package entities
 
 import (
 	"time"
+	"unicode/utf8"
 
 	"github.com/therenotomorrow/gotes/internal/domain"
 	"github.com/therenotomorrow/gotes/internal/domain/types/id"
 )
 
 const (
-	ErrEmptyTitle   domain.Error = "empty title"
-	ErrEmptyContent domain.Error = "empty content"
+	ErrEmptyTitle    domain.Error = "empty title"
+	ErrEmptyContent  domain.Error = "empty content"
+	ErrTitleLength   domain.Error = "title must be 5 to 255 characters"
+	ErrContentLength domain.Error = "content must be at least 10 characters"
+)
+
+// The lengths of a new note in characters, the same as the API requires, so the notes made from templates
+// or imported follow them too.
+const (
+	minTitleLength   = 5
+	maxTitleLength   = 255
+	minContentLength = 10
 )
 
 type Note struct {
 	CreatedAt time.Time
 	UpdatedAt time.Time
 	Owner     *User
-	Title     string
-	Content   string
-	ID        id.ID
+	DeletedAt *time.Time
+	// Notebook is nil for notes in the root.
+	Notebook *Notebook
+	Title    string
+	Content  string
+	Tags     []*Tag
+	ID       id.ID
+	// Version grows with every change of the note, it guards concurrent changes from overwriting each other.
+	Version int64
+	// Pinned notes are listed before the others.
+	Pinned bool
+	// Archived notes are hidden from the listing unless asked for.
+	Archived bool
+	Starred  bool
 }
 
 func NewNote(title, content string) (*Note, error) {
@@ -30,6 +52,14 @@ func NewNote(title, content string) (*Note, error) {
 		return nil, ErrEmptyContent
 	}
 
+	if length := utf8.RuneCountInString(title); length < minTitleLength || length > maxTitleLength {
+		return nil, ErrTitleLength
+	}
+
+	if utf8.RuneCountInString(content) < minContentLength {
+		return nil, ErrContentLength
+	}
+
 	now := time.Now()
 
 	return &Note{
@@ -39,9 +69,66 @@ func NewNote(title, content string) (*Note, error) {
 		CreatedAt: now,
 		UpdatedAt: now,
 		Owner:     nil,
+		DeletedAt: nil,
+		Notebook:  nil,
+		Tags:      nil,
+		Version:   1,
+		Pinned:    false,
+		Archived:  false,
+		Starred:   false,
 	}, nil
 }
 
+func (n *Note) SetTitle(title string) error {
+	if title == "" {
+		return ErrEmptyTitle
+	}
+
+	n.Title = title
+	n.UpdatedAt = time.Now()
+
+	return nil
+}
+
+func (n *Note) SetContent(content string) error {
+	if content == "" {
+		return ErrEmptyContent
+	}
+
+	n.Content = content
+	n.UpdatedAt = time.Now()
+
+	return nil
+}
+
+// Trash moves the note to the trash, it stays recoverable until purged.
+func (n *Note) Trash() {
+	now := time.Now()
+	n.DeletedAt = &now
+}
+
+func (n *Note) Restore() {
+	n.DeletedAt = nil
+}
+
+func (n *Note) IsTrashed() bool {
+	return n.DeletedAt != nil
+}
+
+// SetPinned keeps UpdatedAt as it is like the other states, so pinning does not move the note in the listing by the
+// time of update.
+func (n *Note) SetPinned(pinned bool) {
+	n.Pinned = pinned
+}
+
+func (n *Note) SetArchived(archived bool) {
+	n.Archived = archived
+}
+
+func (n *Note) SetStarred(starred bool) {
+	n.Starred = starred
+}
+
 func (n *Note) IsOwner(u *User) bool {
 	return n.Owner.ID == u.ID
 }
@@ -49,3 +136,20 @@ func (n *Note) IsOwner(u *User) bool {
 func (n *Note) SetOwner(u *User) {
 	n.Owner = u
 }
+
+// SetNotebook moves the note into the notebook, nil moves it to the root.
+func (n *Note) SetNotebook(nb *Notebook) {
+	n.Notebook = nb
+	n.UpdatedAt = time.Now()
+}
+
+// Backdate keeps the timestamps of a note brought from elsewhere, zero timestamps are left as they are.
+func (n *Note) Backdate(createdAt, updatedAt time.Time) {
+	if !createdAt.IsZero() {
+		n.CreatedAt = createdAt
+	}
+
+	if !updatedAt.IsZero() {
+		n.UpdatedAt = updatedAt
+	}
+}
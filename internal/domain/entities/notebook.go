@@ -0,0 +1,67 @@
+package entities
+
+import (
+	"strings"
+	"time"
+
+	"github.com/therenotomorrow/gotes/internal/domain"
+	"github.com/therenotomorrow/gotes/internal/domain/types/id"
+)
+
+const (
+	ErrEmptyNotebookName domain.Error = "empty notebook name"
+)
+
+type Notebook struct {
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	Owner     *User
+	// Parent is nil for notebooks in the root.
+	Parent *Notebook
+	Name   string
+	ID     id.ID
+}
+
+func NewNotebook(name string) (*Notebook, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return nil, ErrEmptyNotebookName
+	}
+
+	now := time.Now()
+
+	return &Notebook{
+		ID:        id.ID{},
+		Name:      name,
+		Owner:     nil,
+		Parent:    nil,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}, nil
+}
+
+func (n *Notebook) SetName(name string) error {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return ErrEmptyNotebookName
+	}
+
+	n.Name = name
+	n.UpdatedAt = time.Now()
+
+	return nil
+}
+
+// SetParent moves the notebook into the parent, nil moves it to the root.
+func (n *Notebook) SetParent(parent *Notebook) {
+	n.Parent = parent
+	n.UpdatedAt = time.Now()
+}
+
+func (n *Notebook) IsOwner(u *User) bool {
+	return n.Owner.ID == u.ID
+}
+
+func (n *Notebook) SetOwner(u *User) {
+	n.Owner = u
+}
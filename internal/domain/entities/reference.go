@@ -0,0 +1,38 @@
+package entities
+
+import (
+	"github.com/therenotomorrow/gotes/internal/domain/types/wikilink"
+)
+
+// Reference is the wiki link from the content of the note to another note of the same owner, e.g. `[[Roadmap]]`.
+// The target is resolved when the reference is read, so the links written before their notes exist work as soon as
+// the notes are created.
+type Reference struct {
+	Source *Note
+	// Target is nil while the reference is dangling, i.e. there is no such note of the owner or it is in the trash.
+	Target *Note
+	Link   wikilink.Target
+	// Position is the order of the reference among the references of the source.
+	Position int32
+}
+
+// NewReferences returns the references of the wiki links in the content of the note.
+func NewReferences(note *Note) []*Reference {
+	targets := wikilink.Parse(note.Content)
+	references := make([]*Reference, len(targets))
+
+	for i, target := range targets {
+		references[i] = &Reference{
+			Source:   note,
+			Target:   nil,
+			Link:     target,
+			Position: int32(i), //nolint:gosec // allowed conversation
+		}
+	}
+
+	return references
+}
+
+func (r *Reference) IsDangling() bool {
+	return r.Target == nil
+}
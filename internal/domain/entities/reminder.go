@@ -0,0 +1,176 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/therenotomorrow/gotes/internal/domain"
+	"github.com/therenotomorrow/gotes/internal/domain/types/id"
+	"github.com/therenotomorrow/gotes/internal/domain/types/rrule"
+)
+
+const (
+	ErrInvalidTimeZone domain.Error = "invalid time zone"
+	ErrReminderInPast  domain.Error = "reminder time is too far in the past"
+)
+
+// reminderPastLimit is how far in the past the reminder may start, such a reminder fires right away.
+const reminderPastLimit = 24 * time.Hour
+
+// Reminder reminds the user about the note at a time, once or by the recurrence rule.
+type Reminder struct {
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	// FireAt is when the reminder fires next, nil once it has nothing more to fire.
+	FireAt *time.Time
+	// FiredAt is when the reminder fired last, nil until it fires.
+	FiredAt *time.Time
+	Note    *Note
+	// User is the one to remind, the owner of the note or a collaborator.
+	User *User
+	// Recurrence repeats the reminder, nil for reminders firing once.
+	Recurrence *rrule.Rule
+	// TimeZone keeps the wall clock time of the recurring reminder across the daylight saving changes.
+	TimeZone string
+	ID       id.ID
+	// Occurrences is the number of occurrences passed, the missed ones included.
+	Occurrences int32
+}
+
+func NewReminder(fireAt time.Time, recurrence, timeZone string) (*Reminder, error) {
+	now := time.Now()
+	reminder := &Reminder{
+		ID:          id.ID{},
+		Note:        nil,
+		User:        nil,
+		FireAt:      nil,
+		FiredAt:     nil,
+		Recurrence:  nil,
+		TimeZone:    "",
+		Occurrences: 0,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+
+	err := reminder.Schedule(fireAt, recurrence, timeZone)
+	if err != nil {
+		return nil, err
+	}
+
+	return reminder, nil
+}
+
+// Schedule starts the reminder over from the time, an empty recurrence makes it fire once.
+func (r *Reminder) Schedule(fireAt time.Time, recurrence, timeZone string) error {
+	if fireAt.Before(time.Now().Add(-reminderPastLimit)) {
+		return ErrReminderInPast
+	}
+
+	if timeZone == "" {
+		timeZone = time.UTC.String()
+	}
+
+	_, err := time.LoadLocation(timeZone)
+	if err != nil {
+		return ErrInvalidTimeZone
+	}
+
+	var rule *rrule.Rule
+
+	if recurrence != "" {
+		rule, err = rrule.Parse(recurrence)
+		if err != nil {
+			return err
+		}
+	}
+
+	r.FireAt = &fireAt
+	r.Recurrence = rule
+	r.TimeZone = timeZone
+	r.Occurrences = 0
+	r.UpdatedAt = time.Now()
+
+	return nil
+}
+
+func (r *Reminder) SetNote(n *Note) {
+	r.Note = n
+}
+
+func (r *Reminder) SetUser(u *User) {
+	r.User = u
+}
+
+func (r *Reminder) IsUser(u *User) bool {
+	return r.User.ID == u.ID
+}
+
+// Done tells whether the reminder has nothing more to fire.
+func (r *Reminder) Done() bool {
+	return r.FireAt == nil
+}
+
+// Fire makes the event reminding about the note and moves the reminder to the first occurrence after the time,
+// the occurrences missed meanwhile are skipped, so a late reminder fires only once.
+func (r *Reminder) Fire(now time.Time) *Event {
+	event := NewEvent(EventTypeReminder, r.Note)
+	event.SetRecipient(r.User)
+
+	r.FiredAt = &now
+	r.UpdatedAt = now
+
+	location := r.location()
+
+	for r.FireAt != nil && !r.FireAt.After(now) {
+		r.jump(now, location)
+		r.Occurrences++
+		r.FireAt = r.next(location)
+	}
+
+	return event
+}
+
+// jump moves the reminder over the missed occurrences to the last one before the time at once, instead of going
+// through them one by one, the occurrences past the end of the rule are not jumped over.
+func (r *Reminder) jump(now time.Time, location *time.Location) {
+	if r.Recurrence == nil {
+		return
+	}
+
+	limit := now
+	if !r.Recurrence.Until.IsZero() && r.Recurrence.Until.Before(limit) {
+		limit = r.Recurrence.Until
+	}
+
+	remaining := -1
+	if r.Recurrence.Count > 0 {
+		remaining = max(int(r.Recurrence.Count-r.Occurrences)-1, 0)
+	}
+
+	occurrence, passed := r.Recurrence.Jump(r.FireAt.In(location), limit, remaining)
+
+	r.FireAt = &occurrence
+	r.Occurrences += int32(passed) //nolint:gosec // limited by the count of the rule or the time
+}
+
+func (r *Reminder) next(location *time.Location) *time.Time {
+	if r.Recurrence == nil || (r.Recurrence.Count > 0 && r.Occurrences >= r.Recurrence.Count) {
+		return nil
+	}
+
+	next, ok := r.Recurrence.Next(r.FireAt.In(location))
+	if !ok {
+		return nil
+	}
+
+	return &next
+}
+
+// location returns the time zone of the reminder, the zone is checked on schedule, so it is always known.
+func (r *Reminder) location() *time.Location {
+	location, err := time.LoadLocation(r.TimeZone)
+	if err != nil {
+		return time.UTC
+	}
+
+	return location
+}
@@ -0,0 +1,29 @@
+package entities
+
+// Format is how the content of the note is given to the reader, the content is written in Markdown.
+type Format string
+
+const (
+	FormatRaw Format = "raw"
+	// FormatHTML is the content rendered from Markdown into HTML safe to embed into a page.
+	FormatHTML Format = "html"
+	// FormatText is the content rendered from Markdown into the plain text without any markup.
+	FormatText Format = "text"
+)
+
+// IsRaw tells whether the content is given as it is written, the zero format is raw as well.
+func (f Format) IsRaw() bool {
+	return f == "" || f == FormatRaw
+}
+
+// Render is the content of the note in the format, it stays valid until the version of the note changes.
+type Render struct {
+	Note    *Note
+	Format  Format
+	Content string
+	Version int64
+}
+
+func NewRender(note *Note, format Format, content string) *Render {
+	return &Render{Note: note, Format: format, Content: content, Version: note.Version}
+}
@@ -0,0 +1,35 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/therenotomorrow/gotes/internal/domain/types/diff"
+	"github.com/therenotomorrow/gotes/internal/domain/types/id"
+)
+
+type Revision struct {
+	CreatedAt time.Time
+	Note      *Note
+	Author    *User
+	Title     string
+	Content   string
+	ID        id.ID
+	Number    int32
+}
+
+// NewRevision snapshots the current title and content of the note made by the author.
+func NewRevision(note *Note, author *User) *Revision {
+	return &Revision{
+		ID:        id.ID{},
+		Number:    0,
+		Note:      note,
+		Author:    author,
+		Title:     note.Title,
+		Content:   note.Content,
+		CreatedAt: time.Now(),
+	}
+}
+
+func (r *Revision) Diff(other *Revision) []diff.Line {
+	return diff.Lines(r.Content, other.Content)
+}
@@ -0,0 +1,34 @@
+package entities
+
+import (
+	"time"
+)
+
+type Role string
+
+const (
+	// RoleViewer allows reading the note and its history.
+	RoleViewer Role = "viewer"
+	// RoleEditor additionally allows changing the title and the content of the note.
+	RoleEditor Role = "editor"
+)
+
+type Share struct {
+	CreatedAt time.Time
+	Note      *Note
+	User      *User
+	Role      Role
+}
+
+func NewShare(note *Note, user *User, role Role) *Share {
+	return &Share{
+		Note:      note,
+		User:      user,
+		Role:      role,
+		CreatedAt: time.Now(),
+	}
+}
+
+func (s *Share) CanWrite() bool {
+	return s.Role == RoleEditor
+}
@@ -0,0 +1,54 @@
+package entities
+
+import (
+	"strings"
+	"time"
+
+	"github.com/therenotomorrow/gotes/internal/domain"
+	"github.com/therenotomorrow/gotes/internal/domain/types/id"
+)
+
+const (
+	ErrEmptyTag domain.Error = "empty tag"
+)
+
+type Tag struct {
+	CreatedAt time.Time
+	Owner     *User
+	Name      string
+	ID        id.ID
+}
+
+// NewTag normalizes the name, so `Work` and ` work ` end up as the same tag.
+func NewTag(name string) (*Tag, error) {
+	name = NormalizeTag(name)
+	if name == "" {
+		return nil, ErrEmptyTag
+	}
+
+	return &Tag{
+		ID:        id.ID{},
+		Name:      name,
+		Owner:     nil,
+		CreatedAt: time.Now(),
+	}, nil
+}
+
+func NormalizeTag(name string) string {
+	return strings.ToLower(strings.TrimSpace(name))
+}
+
+func (t *Tag) SetName(name string) error {
+	name = NormalizeTag(name)
+	if name == "" {
+		return ErrEmptyTag
+	}
+
+	t.Name = name
+
+	return nil
+}
+
+func (t *Tag) SetOwner(u *User) {
+	t.Owner = u
+}
@@ -0,0 +1,147 @@
+package entities
+
+import (
+	"maps"
+	"strings"
+	"time"
+
+	"github.com/therenotomorrow/gotes/internal/domain"
+	"github.com/therenotomorrow/gotes/internal/domain/types/id"
+	"github.com/therenotomorrow/gotes/internal/domain/types/placeholder"
+)
+
+const (
+	ErrEmptyTemplateName domain.Error = "empty template name"
+)
+
+// The placeholders every template may use without passing their variables.
+const (
+	VariableDate      = "date"
+	VariableTime      = "time"
+	VariableUserName  = "user.name"
+	VariableUserEmail = "user.email"
+)
+
+// Template is the predefined structure of notes of the user, its title and content may have placeholders
+// such as `{{date}}` filled when a note is created from it.
+type Template struct {
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	Owner     *User
+	Name      string
+	// Title is empty for templates leaving the title to the note.
+	Title   string
+	Content string
+	ID      id.ID
+}
+
+func NewTemplate(name, title, content string) (*Template, error) {
+	now := time.Now()
+	template := &Template{
+		ID:        id.ID{},
+		Owner:     nil,
+		Name:      "",
+		Title:     "",
+		Content:   "",
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+
+	err := template.SetName(name)
+	if err != nil {
+		return nil, err
+	}
+
+	err = template.SetTitle(title)
+	if err != nil {
+		return nil, err
+	}
+
+	err = template.SetContent(content)
+	if err != nil {
+		return nil, err
+	}
+
+	return template, nil
+}
+
+func (t *Template) SetName(name string) error {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return ErrEmptyTemplateName
+	}
+
+	t.Name = name
+	t.UpdatedAt = time.Now()
+
+	return nil
+}
+
+func (t *Template) SetTitle(title string) error {
+	_, err := placeholder.Names(title)
+	if err != nil {
+		return err
+	}
+
+	t.Title = title
+	t.UpdatedAt = time.Now()
+
+	return nil
+}
+
+func (t *Template) SetContent(content string) error {
+	if content == "" {
+		return ErrEmptyContent
+	}
+
+	_, err := placeholder.Names(content)
+	if err != nil {
+		return err
+	}
+
+	t.Content = content
+	t.UpdatedAt = time.Now()
+
+	return nil
+}
+
+// Placeholders returns the names of the placeholders of the title and the content in the order they first appear.
+func (t *Template) Placeholders() []string {
+	// the title and the content are checked when set, so the names are always there
+	names, _ := placeholder.Names(t.Title + "\n" + t.Content)
+
+	return names
+}
+
+// Render fills the placeholders of the title and the content, the variables take precedence over the built-in
+// ones, e.g. to give the date in the time zone of the user.
+func (t *Template) Render(user *User, variables map[string]string, now time.Time) (string, string, error) {
+	values := map[string]string{
+		VariableDate:      now.Format(time.DateOnly),
+		VariableTime:      now.Format("15:04"),
+		VariableUserName:  user.Name,
+		VariableUserEmail: user.Email.Value(),
+	}
+
+	maps.Copy(values, variables)
+
+	title, err := placeholder.Render(t.Title, values)
+	if err != nil {
+		return "", "", err
+	}
+
+	content, err := placeholder.Render(t.Content, values)
+	if err != nil {
+		return "", "", err
+	}
+
+	return title, content, nil
+}
+
+func (t *Template) IsOwner(u *User) bool {
+	return t.Owner.ID == u.ID
+}
+
+func (t *Template) SetOwner(u *User) {
+	t.Owner = u
+}
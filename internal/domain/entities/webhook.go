@@ -0,0 +1,179 @@
+package entities
+
+import (
+	"crypto/rand"
+	"net/http"
+	"net/netip"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/therenotomorrow/gotes/internal/domain"
+	"github.com/therenotomorrow/gotes/internal/domain/types/id"
+	"github.com/therenotomorrow/gotes/internal/domain/types/uuid"
+)
+
+const (
+	ErrInvalidWebhookURL domain.Error = "invalid webhook url"
+)
+
+// Webhook is an endpoint of the user that receives the events of the subscribed types as signed HTTP requests.
+type Webhook struct {
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	// DisabledAt is when the webhook stopped receiving events, nil while it is enabled.
+	DisabledAt *time.Time
+	Owner      *User
+	URL        string
+	// Secret signs the requests, so the endpoint can tell them from forged ones.
+	Secret string
+	// EventTypes are the types of the events the webhook receives, all of them when empty.
+	EventTypes []EventType
+	ID         id.ID
+	// Failures is the number of deliveries failed in a row.
+	Failures int32
+}
+
+func NewWebhook(rawURL string, types []EventType) (*Webhook, error) {
+	err := checkWebhookURL(rawURL)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+
+	return &Webhook{
+		ID:         id.ID{},
+		Owner:      nil,
+		URL:        rawURL,
+		Secret:     rand.Text(),
+		EventTypes: types,
+		Failures:   0,
+		DisabledAt: nil,
+		CreatedAt:  now,
+		UpdatedAt:  now,
+	}, nil
+}
+
+func (w *Webhook) SetURL(rawURL string) error {
+	err := checkWebhookURL(rawURL)
+	if err != nil {
+		return err
+	}
+
+	w.URL = rawURL
+	w.UpdatedAt = time.Now()
+
+	return nil
+}
+
+func (w *Webhook) SetEventTypes(types []EventType) {
+	w.EventTypes = types
+	w.UpdatedAt = time.Now()
+}
+
+func (w *Webhook) Enabled() bool {
+	return w.DisabledAt == nil
+}
+
+// Enable makes the disabled webhook receive events again, the failures are forgiven.
+func (w *Webhook) Enable() {
+	w.DisabledAt = nil
+	w.Failures = 0
+	w.UpdatedAt = time.Now()
+}
+
+func (w *Webhook) Disable() {
+	if w.DisabledAt != nil {
+		return
+	}
+
+	now := time.Now()
+
+	w.DisabledAt = &now
+	w.UpdatedAt = now
+}
+
+func (w *Webhook) IsOwner(u *User) bool {
+	return w.Owner.ID == u.ID
+}
+
+func (w *Webhook) SetOwner(u *User) {
+	w.Owner = u
+}
+
+// Delivery is an event waiting to be sent to the webhook.
+type Delivery struct {
+	Webhook *Webhook
+	Event   *Event
+	// Attempts is the number of times the event was sent, the current attempt included.
+	Attempts int32
+}
+
+// Attempt is the outcome of sending the event to the webhook once.
+type Attempt struct {
+	AttemptedAt time.Time
+	Webhook     *Webhook
+	// Error tells why the attempt failed, it is empty for delivered events.
+	Error     string
+	EventID   uuid.UUID
+	ID        id.ID
+	Duration  time.Duration
+	EventType EventType
+	// StatusCode is the HTTP status of the response, zero when the endpoint did not respond.
+	StatusCode int32
+}
+
+func NewAttempt(delivery *Delivery) *Attempt {
+	return &Attempt{
+		AttemptedAt: time.Now(),
+		Webhook:     delivery.Webhook,
+		Error:       "",
+		EventID:     delivery.Event.ID,
+		ID:          id.ID{},
+		Duration:    0,
+		EventType:   delivery.Event.EventType,
+		StatusCode:  0,
+	}
+}
+
+// Finish records how the endpoint responded, any status but 2xx fails the attempt.
+func (a *Attempt) Finish(status int, err error) {
+	a.Duration = time.Since(a.AttemptedAt)
+	a.StatusCode = int32(status) //nolint:gosec // allowed conversation
+
+	switch {
+	case err != nil:
+		a.Error = err.Error()
+	case status < http.StatusOK || status >= http.StatusMultipleChoices:
+		a.Error = http.StatusText(status)
+		if a.Error == "" {
+			a.Error = "unexpected status"
+		}
+	}
+}
+
+func (a *Attempt) Delivered() bool {
+	return a.Error == ""
+}
+
+// checkWebhookURL refuses the endpoints that are obviously internal, the names resolved into the internal
+// addresses are refused when the events are delivered.
+func checkWebhookURL(rawURL string) error {
+	endpoint, err := url.Parse(rawURL)
+	if err != nil || endpoint.Hostname() == "" || (endpoint.Scheme != "https" && endpoint.Scheme != "http") {
+		return ErrInvalidWebhookURL
+	}
+
+	host := strings.ToLower(strings.TrimSuffix(endpoint.Hostname(), "."))
+	if host == "localhost" || strings.HasSuffix(host, ".localhost") {
+		return ErrInvalidWebhookURL
+	}
+
+	addr, err := netip.ParseAddr(host)
+	if err == nil && (!addr.Unmap().IsGlobalUnicast() || addr.Unmap().IsPrivate()) {
+		return ErrInvalidWebhookURL
+	}
+
+	return nil
+}
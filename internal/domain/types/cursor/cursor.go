@@ -0,0 +1,28 @@
+package cursor
+
+import (
+	"encoding/json"
+)
+
+func Encode(payload any) (string, error) {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return "", ErrInvalidCursor
+	}
+
+	return signer.Sign(data)
+}
+
+func Decode(token string, payload any) error {
+	data, err := signer.Verify(token)
+	if err != nil {
+		return ErrInvalidCursor
+	}
+
+	err = json.Unmarshal(data, payload)
+	if err != nil {
+		return ErrInvalidCursor
+	}
+
+	return nil
+}
@@ -0,0 +1,27 @@
+package cursor
+
+import (
+	"sync"
+
+	"github.com/therenotomorrow/gotes/internal/domain"
+)
+
+const (
+	ErrInvalidCursor domain.Error = "invalid cursor"
+)
+
+var (
+	signer Signer
+	once   = sync.Once{}
+)
+
+type Signer interface {
+	Sign(payload []byte) (string, error)
+	Verify(token string) ([]byte, error)
+}
+
+func SetSigner(s Signer) {
+	once.Do(func() {
+		signer = s
+	})
+}
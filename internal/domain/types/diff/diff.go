@@ -0,0 +1,161 @@
+package diff
+
+import (
+	"strings"
+)
+
+type Operation int
+
+const (
+	OperationEqual Operation = iota
+	OperationInsert
+	OperationDelete
+)
+
+type Line struct {
+	Text      string
+	Operation Operation
+}
+
+// Lines returns the shortest line-level edit script that turns from into to (Myers' algorithm in linear space).
+func Lines(from, to string) []Line {
+	return compute(split(from), split(to))
+}
+
+func split(text string) []string {
+	if text == "" {
+		return nil
+	}
+
+	return strings.Split(text, "\n")
+}
+
+func compute(from, to []string) []Line {
+	half := (len(from)+len(to)+1)/2 + 1 //nolint:mnd // the middle snake is found within half of the edit distance
+	script := &script{
+		from:     from,
+		to:       to,
+		lines:    make([]Line, 0, len(from)+len(to)),
+		forward:  make([]int, 2*half+1), //nolint:mnd // diagonals from -half to half
+		backward: make([]int, 2*half+1), //nolint:mnd // diagonals from -half to half
+		offset:   half,
+	}
+
+	script.compare(0, len(from), 0, len(to))
+
+	return script.lines
+}
+
+// script keeps the state of the linear space variant of the algorithm: instead of keeping every frontier
+// to backtrack the path, it finds the middle snake of the path and divides the texts around it.
+type script struct {
+	from     []string
+	to       []string
+	lines    []Line
+	forward  []int
+	backward []int
+	offset   int
+}
+
+// compare appends the edit script that turns from[fromLow:fromHigh] into to[toLow:toHigh].
+func (s *script) compare(fromLow, fromHigh, toLow, toHigh int) {
+	for fromLow < fromHigh && toLow < toHigh && s.from[fromLow] == s.to[toLow] {
+		s.lines = append(s.lines, Line{Text: s.from[fromLow], Operation: OperationEqual})
+		fromLow++
+		toLow++
+	}
+
+	suffix := 0
+	for fromLow < fromHigh-suffix && toLow < toHigh-suffix && s.from[fromHigh-suffix-1] == s.to[toHigh-suffix-1] {
+		suffix++
+	}
+
+	fromHigh -= suffix
+	toHigh -= suffix
+
+	switch {
+	case fromLow == fromHigh:
+		for _, text := range s.to[toLow:toHigh] {
+			s.lines = append(s.lines, Line{Text: text, Operation: OperationInsert})
+		}
+	case toLow == toHigh:
+		for _, text := range s.from[fromLow:fromHigh] {
+			s.lines = append(s.lines, Line{Text: text, Operation: OperationDelete})
+		}
+	default:
+		x, y, u, v := s.middle(fromLow, fromHigh, toLow, toHigh)
+
+		s.compare(fromLow, fromLow+x, toLow, toLow+y)
+
+		for _, text := range s.from[fromLow+x : fromLow+u] {
+			s.lines = append(s.lines, Line{Text: text, Operation: OperationEqual})
+		}
+
+		s.compare(fromLow+u, fromHigh, toLow+v, toHigh)
+	}
+
+	for _, text := range s.from[fromHigh : fromHigh+suffix] {
+		s.lines = append(s.lines, Line{Text: text, Operation: OperationEqual})
+	}
+}
+
+// middle returns the snake from (x, y) to (u, v) in the middle of the shortest path, the coordinates are relative
+// to the low bounds. The paths are searched from both ends at once until they overlap.
+func (s *script) middle(fromLow, fromHigh, toLow, toHigh int) (int, int, int, int) {
+	width, height := fromHigh-fromLow, toHigh-toLow
+	delta := width - height
+	odd := delta%2 != 0
+
+	s.forward[s.offset+1] = 0
+	s.backward[s.offset+1] = 0
+
+	for depth := 0; depth <= (width+height+1)/2; depth++ {
+		for diagonal := -depth; diagonal <= depth; diagonal += 2 {
+			x := s.step(s.forward, diagonal, depth)
+			y := x - diagonal
+			startX, startY := x, y
+
+			for x < width && y < height && s.from[fromLow+x] == s.to[toLow+y] {
+				x++
+				y++
+			}
+
+			s.forward[s.offset+diagonal] = x
+
+			opposite := delta - diagonal
+			if odd && opposite >= -(depth-1) && opposite <= depth-1 && x+s.backward[s.offset+opposite] >= width {
+				return startX, startY, x, y
+			}
+		}
+
+		for diagonal := -depth; diagonal <= depth; diagonal += 2 {
+			x := s.step(s.backward, diagonal, depth)
+			y := x - diagonal
+			startX, startY := x, y
+
+			for x < width && y < height && s.from[fromHigh-x-1] == s.to[toHigh-y-1] {
+				x++
+				y++
+			}
+
+			s.backward[s.offset+diagonal] = x
+
+			opposite := delta - diagonal
+			if !odd && opposite >= -depth && opposite <= depth && x+s.forward[s.offset+opposite] >= width {
+				return width - x, height - y, width - startX, height - startY
+			}
+		}
+	}
+
+	// the paths always overlap by the half of the longest edit script
+	return 0, 0, 0, 0
+}
+
+// step returns where the furthest path on the diagonal starts: one insertion below it or one deletion above it.
+func (s *script) step(frontier []int, diagonal, depth int) int {
+	if diagonal == -depth || (diagonal != depth && frontier[s.offset+diagonal-1] < frontier[s.offset+diagonal+1]) {
+		return frontier[s.offset+diagonal+1]
+	}
+
+	return frontier[s.offset+diagonal-1] + 1
+}
@@ -0,0 +1,164 @@
+package diff_test
+
+import (
+	"math/rand/v2"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/therenotomorrow/gotes/internal/domain/types/diff"
+)
+
+func equal(text string) diff.Line {
+	return diff.Line{Text: text, Operation: diff.OperationEqual}
+}
+
+func insert(text string) diff.Line {
+	return diff.Line{Text: text, Operation: diff.OperationInsert}
+}
+
+func remove(text string) diff.Line {
+	return diff.Line{Text: text, Operation: diff.OperationDelete}
+}
+
+// apply returns both sides of the edit script.
+func apply(lines []diff.Line) (string, string) {
+	var from, to []string
+
+	for _, line := range lines {
+		if line.Operation != diff.OperationInsert {
+			from = append(from, line.Text)
+		}
+
+		if line.Operation != diff.OperationDelete {
+			to = append(to, line.Text)
+		}
+	}
+
+	return strings.Join(from, "\n"), strings.Join(to, "\n")
+}
+
+// distance returns the length of the shortest edit script by the longest common subsequence.
+func distance(from, to []string) int {
+	common := make([][]int, len(from)+1)
+	for i := range common {
+		common[i] = make([]int, len(to)+1)
+	}
+
+	for i := len(from) - 1; i >= 0; i-- {
+		for j := len(to) - 1; j >= 0; j-- {
+			if from[i] == to[j] {
+				common[i][j] = common[i+1][j+1] + 1
+			} else {
+				common[i][j] = max(common[i+1][j], common[i][j+1])
+			}
+		}
+	}
+
+	return len(from) + len(to) - 2*common[0][0]
+}
+
+func edits(lines []diff.Line) int {
+	count := 0
+
+	for _, line := range lines {
+		if line.Operation != diff.OperationEqual {
+			count++
+		}
+	}
+
+	return count
+}
+
+func TestLines(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		from string
+		to   string
+		want []diff.Line
+	}{
+		{name: "empty", from: "", to: "", want: []diff.Line{}},
+		{name: "same", from: "a\nb", to: "a\nb", want: []diff.Line{equal("a"), equal("b")}},
+		{name: "created", from: "", to: "a\nb", want: []diff.Line{insert("a"), insert("b")}},
+		{name: "cleared", from: "a\nb", to: "", want: []diff.Line{remove("a"), remove("b")}},
+		{
+			name: "replaced",
+			from: "a\nb\nc",
+			to:   "a\nx\nc",
+			want: []diff.Line{equal("a"), remove("b"), insert("x"), equal("c")},
+		},
+		{
+			name: "appended",
+			from: "a\nb",
+			to:   "a\nb\nc",
+			want: []diff.Line{equal("a"), equal("b"), insert("c")},
+		},
+		{
+			name: "prepended",
+			from: "b\nc",
+			to:   "a\nb\nc",
+			want: []diff.Line{insert("a"), equal("b"), equal("c")},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, test.want, diff.Lines(test.from, test.to))
+		})
+	}
+}
+
+func TestLinesShortest(t *testing.T) {
+	t.Parallel()
+
+	// the example of the paper
+	got := diff.Lines("a\nb\nc\na\nb\nb\na", "c\nb\na\nb\na\nc")
+
+	from, to := apply(got)
+	assert.Equal(t, "a\nb\nc\na\nb\nb\na", from)
+	assert.Equal(t, "c\nb\na\nb\na\nc", to)
+	assert.Equal(t, 5, edits(got))
+
+	random := rand.New(rand.NewPCG(1, 2)) //nolint:gosec // reproducible inputs
+
+	lines := func() []string {
+		text := make([]string, random.IntN(30))
+		for i := range text {
+			text[i] = strconv.Itoa(random.IntN(4))
+		}
+
+		return text
+	}
+
+	for range 500 {
+		fromLines, toLines := lines(), lines()
+		got := diff.Lines(strings.Join(fromLines, "\n"), strings.Join(toLines, "\n"))
+
+		from, to := apply(got)
+		require.Equal(t, strings.Join(fromLines, "\n"), from)
+		require.Equal(t, strings.Join(toLines, "\n"), to)
+		require.Equal(t, distance(fromLines, toLines), edits(got), "%v -> %v", fromLines, toLines)
+	}
+}
+
+func TestLinesLarge(t *testing.T) {
+	t.Parallel()
+
+	from := make([]string, 200_000)
+	for i := range from {
+		from[i] = strconv.Itoa(i)
+	}
+
+	to := append([]string{"first"}, from...)
+	to[len(to)/2] = "middle"
+	to = append(to, "last")
+
+	got := diff.Lines(strings.Join(from, "\n"), strings.Join(to, "\n"))
+	assert.Equal(t, 4, edits(got))
+}
@@ -0,0 +1,269 @@
+package ot
+
+import (
+	"strings"
+	"unicode/utf8"
+
+	"github.com/therenotomorrow/gotes/internal/domain"
+)
+
+const (
+	ErrInvalidOperation domain.Error = "invalid operation"
+	ErrLengthMismatch   domain.Error = "operation does not match the content"
+)
+
+// Component is a single step of the operation, exactly one of its fields is set.
+// Lengths are in runes, so the operation does not depend on how the text is encoded.
+type Component struct {
+	Insert string
+	Retain int
+	Delete int
+}
+
+func (c Component) valid() bool {
+	switch {
+	case c.Insert != "":
+		return c.Retain == 0 && c.Delete == 0
+	case c.Retain > 0:
+		return c.Delete == 0
+	default:
+		return c.Delete > 0 && c.Retain == 0
+	}
+}
+
+// Operation changes the text going over it from the start to the end: it retains, inserts or deletes runes.
+// Operations made concurrently on the same text are transformed against each other, so applying them in any order
+// gives the same text.
+type Operation []Component
+
+// Retain appends keeping the next runes, it merges with the retain before.
+func (o Operation) Retain(count int) Operation {
+	if count <= 0 {
+		return o
+	}
+
+	if last := len(o) - 1; last >= 0 && o[last].Retain > 0 {
+		o[last].Retain += count
+
+		return o
+	}
+
+	return append(o, Component{Insert: "", Retain: count, Delete: 0})
+}
+
+// Insert appends inserting the text, inserts always go before deletes next to them.
+func (o Operation) Insert(text string) Operation {
+	if text == "" {
+		return o
+	}
+
+	last := len(o) - 1
+
+	switch {
+	case last >= 0 && o[last].Insert != "":
+		o[last].Insert += text
+
+		return o
+	case last >= 0 && o[last].Delete > 0:
+		// the same text comes out of both orders, the fixed one keeps operations comparable
+		if last > 0 && o[last-1].Insert != "" {
+			o[last-1].Insert += text
+
+			return o
+		}
+
+		o = append(o, o[last])
+		o[last] = Component{Insert: text, Retain: 0, Delete: 0}
+
+		return o
+	}
+
+	return append(o, Component{Insert: text, Retain: 0, Delete: 0})
+}
+
+// Delete appends deleting the next runes, it merges with the delete before.
+func (o Operation) Delete(count int) Operation {
+	if count <= 0 {
+		return o
+	}
+
+	if last := len(o) - 1; last >= 0 && o[last].Delete > 0 {
+		o[last].Delete += count
+
+		return o
+	}
+
+	return append(o, Component{Insert: "", Retain: 0, Delete: count})
+}
+
+// BaseLength is the length of the text the operation applies to.
+func (o Operation) BaseLength() int {
+	length := 0
+
+	for _, c := range o {
+		length += c.Retain + c.Delete
+	}
+
+	return length
+}
+
+// TargetLength is the length of the text the operation makes.
+func (o Operation) TargetLength() int {
+	length := 0
+
+	for _, c := range o {
+		length += c.Retain + utf8.RuneCountInString(c.Insert)
+	}
+
+	return length
+}
+
+// Validate checks that every component does exactly one thing.
+func (o Operation) Validate() error {
+	for _, c := range o {
+		if !c.valid() {
+			return ErrInvalidOperation
+		}
+	}
+
+	return nil
+}
+
+// Apply changes the text, the operation must go over the whole text.
+func (o Operation) Apply(text string) (string, error) {
+	err := o.Validate()
+	if err != nil {
+		return "", err
+	}
+
+	runes := []rune(text)
+	if o.BaseLength() != len(runes) {
+		return "", ErrLengthMismatch
+	}
+
+	var (
+		result strings.Builder
+		offset int
+	)
+
+	for _, c := range o {
+		switch {
+		case c.Insert != "":
+			result.WriteString(c.Insert)
+		case c.Retain > 0:
+			result.WriteString(string(runes[offset : offset+c.Retain]))
+			offset += c.Retain
+		default:
+			offset += c.Delete
+		}
+	}
+
+	return result.String(), nil
+}
+
+// Transform takes two operations made concurrently on the same text and returns their counterparts:
+// the first one to apply after the second one and the second one to apply after the first one.
+// When both insert at the same place, the text of the first one goes first.
+func Transform(first, second Operation) (Operation, Operation, error) {
+	if first.Validate() != nil || second.Validate() != nil {
+		return nil, nil, ErrInvalidOperation
+	}
+
+	if first.BaseLength() != second.BaseLength() {
+		return nil, nil, ErrLengthMismatch
+	}
+
+	var (
+		primes              = [2]Operation{make(Operation, 0, len(first)), make(Operation, 0, len(second))}
+		nextLeft, nextRight = iterate(first), iterate(second)
+		left, right         = nextLeft(), nextRight()
+	)
+
+	for left != nil || right != nil {
+		switch {
+		case left != nil && left.Insert != "":
+			primes[0] = primes[0].Insert(left.Insert)
+			primes[1] = primes[1].Retain(utf8.RuneCountInString(left.Insert))
+			left = nextLeft()
+
+			continue
+		case right != nil && right.Insert != "":
+			primes[0] = primes[0].Retain(utf8.RuneCountInString(right.Insert))
+			primes[1] = primes[1].Insert(right.Insert)
+			right = nextRight()
+
+			continue
+		}
+
+		count := min(left.Retain+left.Delete, right.Retain+right.Delete)
+
+		switch {
+		case left.Retain > 0 && right.Retain > 0:
+			primes[0] = primes[0].Retain(count)
+			primes[1] = primes[1].Retain(count)
+		case left.Delete > 0 && right.Retain > 0:
+			primes[0] = primes[0].Delete(count)
+		case left.Retain > 0 && right.Delete > 0:
+			primes[1] = primes[1].Delete(count)
+		}
+
+		// both deleted the same runes, there is nothing left to do with them
+		left, right = consume(left, count, nextLeft), consume(right, count, nextRight)
+	}
+
+	return primes[0], primes[1], nil
+}
+
+// TransformIndex moves the index in the text to the same place in the text changed by the operation,
+// text inserted right at the index moves it forward.
+func TransformIndex(index int, operation Operation) int {
+	moved := index
+
+	for _, c := range operation {
+		switch {
+		case c.Retain > 0:
+			index -= c.Retain
+		case c.Insert != "":
+			moved += utf8.RuneCountInString(c.Insert)
+		default:
+			moved -= min(index, c.Delete)
+			index -= c.Delete
+		}
+
+		if index < 0 {
+			break
+		}
+	}
+
+	return moved
+}
+
+// iterate returns the copies of the components one by one, so they can be consumed partially.
+func iterate(operation Operation) func() *Component {
+	next := 0
+
+	return func() *Component {
+		if next == len(operation) {
+			return nil
+		}
+
+		c := operation[next]
+		next++
+
+		return &c
+	}
+}
+
+func consume(c *Component, count int, next func() *Component) *Component {
+	if c.Retain > 0 {
+		c.Retain -= count
+	} else {
+		c.Delete -= count
+	}
+
+	if c.Retain == 0 && c.Delete == 0 {
+		return next()
+	}
+
+	return c
+}
@@ -0,0 +1,65 @@
+package placeholder
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/therenotomorrow/gotes/internal/domain"
+)
+
+const (
+	ErrInvalidPlaceholder domain.Error = "invalid placeholder"
+	ErrMissingVariable    domain.Error = "missing template variable"
+)
+
+var (
+	// placeholder is the name in double braces, spaces around the name are allowed, e.g. `{{ user.name }}`.
+	placeholder = regexp.MustCompile(`\{\{([^{}]*)\}\}`)
+	// name is a dotted path of words, e.g. `date` or `user.name`.
+	name = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*(\.[A-Za-z_][A-Za-z0-9_]*)*$`)
+)
+
+// Names returns the names of the placeholders in the text in the order they first appear.
+func Names(text string) ([]string, error) {
+	names := make([]string, 0)
+	seen := make(map[string]bool)
+
+	for _, match := range placeholder.FindAllStringSubmatch(text, -1) {
+		key := strings.TrimSpace(match[1])
+		if !name.MatchString(key) {
+			return nil, ErrInvalidPlaceholder
+		}
+
+		if !seen[key] {
+			seen[key] = true
+			names = append(names, key)
+		}
+	}
+
+	return names, nil
+}
+
+// Render replaces every placeholder in the text by its variable, all of them must be given.
+func Render(text string, variables map[string]string) (string, error) {
+	var err error
+
+	rendered := placeholder.ReplaceAllStringFunc(text, func(match string) string {
+		key := strings.TrimSpace(match[2 : len(match)-2])
+
+		value, found := variables[key]
+
+		switch {
+		case !name.MatchString(key):
+			err = ErrInvalidPlaceholder
+		case !found:
+			err = ErrMissingVariable
+		}
+
+		return value
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return rendered, nil
+}
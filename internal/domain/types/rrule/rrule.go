@@ -0,0 +1,322 @@
+package rrule
+
+import (
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/therenotomorrow/gotes/internal/domain"
+)
+
+const (
+	ErrInvalidRule domain.Error = "invalid recurrence rule"
+)
+
+const (
+	prefix      = "RRULE:"
+	untilFormat = "20060102T150405Z"
+	dateFormat  = "20060102"
+	// maxSkips is how many periods in a row may have no occurrence, e.g. February for the 30th of every month.
+	maxSkips = 100
+	week     = 7
+)
+
+type Frequency int
+
+const (
+	FrequencyHourly Frequency = iota + 1
+	FrequencyDaily
+	FrequencyWeekly
+	FrequencyMonthly
+	FrequencyYearly
+)
+
+var (
+	frequencies = map[string]Frequency{
+		"HOURLY":  FrequencyHourly,
+		"DAILY":   FrequencyDaily,
+		"WEEKLY":  FrequencyWeekly,
+		"MONTHLY": FrequencyMonthly,
+		"YEARLY":  FrequencyYearly,
+	}
+	weekdays = map[string]time.Weekday{
+		"MO": time.Monday,
+		"TU": time.Tuesday,
+		"WE": time.Wednesday,
+		"TH": time.Thursday,
+		"FR": time.Friday,
+		"SA": time.Saturday,
+		"SU": time.Sunday,
+	}
+)
+
+// Rule is the subset of the RFC 5545 recurrence rule: FREQ, INTERVAL, COUNT, UNTIL and BYDAY without ordinals,
+// the latter only with the DAILY and WEEKLY frequencies. Weeks start on Monday.
+type Rule struct {
+	// Until is the last moment an occurrence may happen, zero for rules without the end.
+	Until    time.Time
+	Weekdays []time.Weekday
+	// Count is the number of occurrences, the first one included, zero for rules without the limit.
+	Count     int32
+	Interval  int
+	Frequency Frequency
+}
+
+// Parse reads the rule as written in the RRULE property, the `RRULE:` prefix is optional.
+func Parse(text string) (*Rule, error) {
+	text = strings.TrimPrefix(strings.ToUpper(strings.TrimSpace(text)), prefix)
+
+	rule := &Rule{Until: time.Time{}, Weekdays: nil, Count: 0, Interval: 1, Frequency: 0}
+
+	for part := range strings.SplitSeq(text, ";") {
+		key, value, found := strings.Cut(part, "=")
+		if !found {
+			return nil, ErrInvalidRule
+		}
+
+		err := rule.set(key, value)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	switch {
+	case rule.Frequency == 0:
+		return nil, ErrInvalidRule
+	case rule.Count > 0 && !rule.Until.IsZero():
+		return nil, ErrInvalidRule
+	case len(rule.Weekdays) > 0 && rule.Frequency != FrequencyDaily && rule.Frequency != FrequencyWeekly:
+		return nil, ErrInvalidRule
+	}
+
+	return rule, nil
+}
+
+func (r *Rule) set(key, value string) error {
+	var err error
+
+	switch key {
+	case "FREQ":
+		r.Frequency = frequencies[value]
+		if r.Frequency == 0 {
+			return ErrInvalidRule
+		}
+	case "INTERVAL":
+		r.Interval, err = strconv.Atoi(value)
+		if err != nil || r.Interval < 1 {
+			return ErrInvalidRule
+		}
+	case "COUNT":
+		var count int64
+
+		count, err = strconv.ParseInt(value, 10, 32)
+		if err != nil || count < 1 {
+			return ErrInvalidRule
+		}
+
+		r.Count = int32(count)
+	case "UNTIL":
+		r.Until, err = time.Parse(untilFormat, value)
+		if err != nil {
+			r.Until, err = time.Parse(dateFormat, value)
+		}
+
+		if err != nil {
+			return ErrInvalidRule
+		}
+	case "BYDAY":
+		for day := range strings.SplitSeq(value, ",") {
+			weekday, ok := weekdays[day]
+			if !ok {
+				return ErrInvalidRule
+			}
+
+			if !slices.Contains(r.Weekdays, weekday) {
+				r.Weekdays = append(r.Weekdays, weekday)
+			}
+		}
+
+		slices.SortFunc(r.Weekdays, func(a, b time.Weekday) int { return weekOffset(a) - weekOffset(b) })
+	default:
+		return ErrInvalidRule
+	}
+
+	return nil
+}
+
+// String returns the rule in the canonical form, without the `RRULE:` prefix.
+func (r *Rule) String() string {
+	parts := make([]string, 0)
+
+	for name, frequency := range frequencies {
+		if frequency == r.Frequency {
+			parts = append(parts, "FREQ="+name)
+
+			break
+		}
+	}
+
+	if r.Interval > 1 {
+		parts = append(parts, "INTERVAL="+strconv.Itoa(r.Interval))
+	}
+
+	if r.Count > 0 {
+		parts = append(parts, "COUNT="+strconv.Itoa(int(r.Count)))
+	}
+
+	if !r.Until.IsZero() {
+		parts = append(parts, "UNTIL="+r.Until.UTC().Format(untilFormat))
+	}
+
+	if len(r.Weekdays) > 0 {
+		days := make([]string, len(r.Weekdays))
+
+		for i, weekday := range r.Weekdays {
+			days[i] = strings.ToUpper(weekday.String()[:2])
+		}
+
+		parts = append(parts, "BYDAY="+strings.Join(days, ","))
+	}
+
+	return strings.Join(parts, ";")
+}
+
+// Next returns the occurrence right after the given one, the wall clock time is kept in the location
+// of the occurrence. It returns false once the rule ends, the count of occurrences is left to the caller.
+func (r *Rule) Next(occurrence time.Time) (time.Time, bool) {
+	next, ok := r.next(occurrence)
+	if !ok || (!r.Until.IsZero() && next.After(r.Until)) {
+		return time.Time{}, false
+	}
+
+	return next, true
+}
+
+// Jump returns the last occurrence not after the limit that is reachable from the given one by whole cycles
+// of the rule and the number of occurrences it jumps over, the given one included. A cycle has the same number
+// of occurrences wherever it starts, so the occurrences in between are not visited, at most the maximum of them
+// is jumped over, a negative maximum is no limit. Monthly and yearly rules are never jumped, their occurrences
+// are rare enough to visit them one by one.
+func (r *Rule) Jump(occurrence, limit time.Time, maximum int) (time.Time, int) {
+	size, days := r.cycle(occurrence)
+	if size == 0 || !occurrence.Before(limit) {
+		return occurrence, 0
+	}
+
+	var length time.Duration
+	if r.Frequency == FrequencyHourly {
+		length = time.Duration(r.Interval) * time.Hour
+	} else {
+		length = time.Duration(days) * 24 * time.Hour //nolint:mnd // hours of a day, days are shifted by the calendar
+	}
+
+	cycles := int(limit.Sub(occurrence) / length)
+	if maximum >= 0 {
+		cycles = min(cycles, maximum/size)
+	}
+
+	for ; cycles > 0; cycles-- {
+		// the days shifted by the calendar are an hour shorter or longer across the daylight saving changes
+		jumped := occurrence.AddDate(0, 0, cycles*days)
+		if r.Frequency == FrequencyHourly {
+			jumped = occurrence.Add(time.Duration(cycles) * length)
+		}
+
+		if !jumped.After(limit) {
+			return jumped, cycles * size
+		}
+	}
+
+	return occurrence, 0
+}
+
+// cycle returns the number of occurrences in a cycle of the rule and its length in days, an empty cycle is not
+// known for the rule or the occurrence does not belong to the rule.
+func (r *Rule) cycle(occurrence time.Time) (int, int) {
+	if len(r.Weekdays) > 0 && !slices.Contains(r.Weekdays, occurrence.Weekday()) {
+		return 0, 0
+	}
+
+	switch r.Frequency {
+	case FrequencyHourly:
+		return 1, 0
+	case FrequencyDaily:
+		if len(r.Weekdays) == 0 {
+			return 1, r.Interval
+		}
+
+		// the days of the interval fall on every weekday once in seven intervals at most
+		size := 0
+
+		for period := 1; period <= week; period++ {
+			if slices.Contains(r.Weekdays, r.shift(occurrence, period*r.Interval).Weekday()) {
+				size++
+			}
+		}
+
+		return size, week * r.Interval
+	case FrequencyWeekly:
+		return max(len(r.Weekdays), 1), week * r.Interval
+	default:
+		return 0, 0
+	}
+}
+
+func (r *Rule) next(occurrence time.Time) (time.Time, bool) {
+	switch {
+	case r.Frequency == FrequencyHourly:
+		return occurrence.Add(time.Duration(r.Interval) * time.Hour), true
+	case r.Frequency == FrequencyWeekly && len(r.Weekdays) > 0:
+		return r.nextWeekday(occurrence), true
+	}
+
+	for period := 1; period <= maxSkips; period++ {
+		next := r.shift(occurrence, period*r.Interval)
+
+		// months and years without the day of the occurrence are skipped, e.g. February 30th
+		if next.Day() != occurrence.Day() && (r.Frequency == FrequencyMonthly || r.Frequency == FrequencyYearly) {
+			continue
+		}
+
+		if len(r.Weekdays) > 0 && !slices.Contains(r.Weekdays, next.Weekday()) {
+			continue
+		}
+
+		return next, true
+	}
+
+	return time.Time{}, false
+}
+
+// nextWeekday returns the following day of the rule in the week of the occurrence or the first day
+// of the rule in the next week of the interval.
+func (r *Rule) nextWeekday(occurrence time.Time) time.Time {
+	offset := weekOffset(occurrence.Weekday())
+
+	for _, weekday := range r.Weekdays {
+		if weekOffset(weekday) > offset {
+			return occurrence.AddDate(0, 0, weekOffset(weekday)-offset)
+		}
+	}
+
+	return occurrence.AddDate(0, 0, r.Interval*week-offset+weekOffset(r.Weekdays[0]))
+}
+
+func (r *Rule) shift(occurrence time.Time, periods int) time.Time {
+	switch r.Frequency {
+	case FrequencyDaily:
+		return occurrence.AddDate(0, 0, periods)
+	case FrequencyWeekly:
+		return occurrence.AddDate(0, 0, periods*week)
+	case FrequencyMonthly:
+		return occurrence.AddDate(0, periods, 0)
+	default:
+		return occurrence.AddDate(periods, 0, 0)
+	}
+}
+
+// weekOffset is the number of days since Monday.
+func weekOffset(weekday time.Weekday) int {
+	return (int(weekday) + week - 1) % week
+}
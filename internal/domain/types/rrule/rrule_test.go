@@ -0,0 +1,253 @@
+package rrule_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/therenotomorrow/gotes/internal/domain/types/rrule"
+)
+
+func parse(t *testing.T, text string) *rrule.Rule {
+	t.Helper()
+
+	rule, err := rrule.Parse(text)
+	require.NoError(t, err)
+
+	return rule
+}
+
+// occurrences returns the occurrences following the given one, at most the limit of them.
+func occurrences(rule *rrule.Rule, occurrence time.Time, limit int) []time.Time {
+	next := make([]time.Time, 0, limit)
+
+	for range limit {
+		var ok bool
+
+		occurrence, ok = rule.Next(occurrence)
+		if !ok {
+			break
+		}
+
+		next = append(next, occurrence)
+	}
+
+	return next
+}
+
+func TestParse(t *testing.T) {
+	t.Parallel()
+
+	invalid := []string{
+		"",
+		"FREQ=SECONDLY",
+		"FREQ=DAILY;INTERVAL=0",
+		"FREQ=DAILY;COUNT=0",
+		"FREQ=DAILY;COUNT=2;UNTIL=20300101",
+		"FREQ=MONTHLY;BYDAY=MO",
+		"FREQ=WEEKLY;BYDAY=1MO",
+		"FREQ=WEEKLY;WKST=MO",
+		"FREQ",
+	}
+
+	for _, text := range invalid {
+		_, err := rrule.Parse(text)
+		require.ErrorIs(t, err, rrule.ErrInvalidRule, text)
+	}
+
+	rule := parse(t, "rrule:freq=weekly;interval=2;count=5;byday=we,mo,we")
+	assert.Equal(t, rrule.FrequencyWeekly, rule.Frequency)
+	assert.Equal(t, 2, rule.Interval)
+	assert.Equal(t, int32(5), rule.Count)
+	assert.Equal(t, []time.Weekday{time.Monday, time.Wednesday}, rule.Weekdays)
+	assert.Equal(t, "FREQ=WEEKLY;INTERVAL=2;COUNT=5;BYDAY=MO,WE", rule.String())
+
+	rule = parse(t, "FREQ=DAILY;UNTIL=20300101")
+	assert.Equal(t, time.Date(2030, time.January, 1, 0, 0, 0, 0, time.UTC), rule.Until)
+	assert.Equal(t, "FREQ=DAILY;UNTIL=20300101T000000Z", rule.String())
+}
+
+func TestRuleNext(t *testing.T) {
+	t.Parallel()
+
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	require.NoError(t, err)
+
+	tests := []struct {
+		start time.Time
+		name  string
+		rule  string
+		want  []time.Time
+	}{
+		{
+			name:  "hourly",
+			rule:  "FREQ=HOURLY;INTERVAL=5",
+			start: time.Date(2030, time.January, 1, 22, 0, 0, 0, time.UTC),
+			want: []time.Time{
+				time.Date(2030, time.January, 2, 3, 0, 0, 0, time.UTC),
+				time.Date(2030, time.January, 2, 8, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name:  "daily by day",
+			rule:  "FREQ=DAILY;BYDAY=MO,TU,WE,TH,FR",
+			start: time.Date(2030, time.January, 3, 9, 0, 0, 0, time.UTC), // Thursday
+			want: []time.Time{
+				time.Date(2030, time.January, 4, 9, 0, 0, 0, time.UTC),
+				time.Date(2030, time.January, 7, 9, 0, 0, 0, time.UTC),
+				time.Date(2030, time.January, 8, 9, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name:  "weekly",
+			rule:  "FREQ=WEEKLY",
+			start: time.Date(2030, time.January, 28, 9, 0, 0, 0, time.UTC),
+			want: []time.Time{
+				time.Date(2030, time.February, 4, 9, 0, 0, 0, time.UTC),
+				time.Date(2030, time.February, 11, 9, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name:  "weekly by day",
+			rule:  "FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,WE",
+			start: time.Date(2030, time.January, 7, 9, 0, 0, 0, time.UTC), // Monday
+			want: []time.Time{
+				time.Date(2030, time.January, 9, 9, 0, 0, 0, time.UTC),
+				time.Date(2030, time.January, 21, 9, 0, 0, 0, time.UTC),
+				time.Date(2030, time.January, 23, 9, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name:  "monthly skips short months",
+			rule:  "FREQ=MONTHLY",
+			start: time.Date(2030, time.January, 31, 9, 0, 0, 0, time.UTC),
+			want: []time.Time{
+				time.Date(2030, time.March, 31, 9, 0, 0, 0, time.UTC),
+				time.Date(2030, time.May, 31, 9, 0, 0, 0, time.UTC),
+				time.Date(2030, time.July, 31, 9, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name:  "yearly skips common years",
+			rule:  "FREQ=YEARLY",
+			start: time.Date(2028, time.February, 29, 9, 0, 0, 0, time.UTC),
+			want: []time.Time{
+				time.Date(2032, time.February, 29, 9, 0, 0, 0, time.UTC),
+				time.Date(2036, time.February, 29, 9, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name:  "until",
+			rule:  "FREQ=DAILY;UNTIL=20300103T090000Z",
+			start: time.Date(2030, time.January, 1, 9, 0, 0, 0, time.UTC),
+			want: []time.Time{
+				time.Date(2030, time.January, 2, 9, 0, 0, 0, time.UTC),
+				time.Date(2030, time.January, 3, 9, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name:  "daylight saving",
+			rule:  "FREQ=DAILY",
+			start: time.Date(2030, time.March, 30, 9, 0, 0, 0, berlin),
+			want: []time.Time{
+				time.Date(2030, time.March, 31, 9, 0, 0, 0, berlin),
+				time.Date(2030, time.April, 1, 9, 0, 0, 0, berlin),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := occurrences(parse(t, test.rule), test.start, len(test.want))
+			assert.Equal(t, test.want, got)
+		})
+	}
+
+	t.Run("after until", func(t *testing.T) {
+		t.Parallel()
+
+		rule := parse(t, "FREQ=DAILY;UNTIL=20300103T090000Z")
+
+		_, ok := rule.Next(time.Date(2030, time.January, 3, 9, 0, 0, 0, time.UTC))
+		assert.False(t, ok)
+	})
+}
+
+func TestRuleJump(t *testing.T) {
+	t.Parallel()
+
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	require.NoError(t, err)
+
+	rules := []string{
+		"FREQ=HOURLY",
+		"FREQ=HOURLY;INTERVAL=7",
+		"FREQ=DAILY",
+		"FREQ=DAILY;INTERVAL=3",
+		"FREQ=DAILY;INTERVAL=2;BYDAY=MO,FR",
+		"FREQ=WEEKLY",
+		"FREQ=WEEKLY;INTERVAL=3;BYDAY=MO,WE,SU",
+		"FREQ=MONTHLY",
+	}
+	start := time.Date(2030, time.January, 6, 9, 30, 0, 0, berlin) // Sunday
+	limit := start.AddDate(2, 3, 5)
+
+	for _, text := range rules {
+		t.Run(text, func(t *testing.T) {
+			t.Parallel()
+
+			rule := parse(t, text)
+
+			// visit every occurrence to find the last one before the limit
+			want, passed := start, 0
+
+			for {
+				next, _ := rule.Next(want)
+				if next.After(limit) {
+					break
+				}
+
+				want, passed = next, passed+1
+			}
+
+			got, jumped := rule.Jump(start, limit, -1)
+			require.False(t, got.After(limit))
+
+			for _, next := range occurrences(rule, got, passed) {
+				if next.After(limit) {
+					break
+				}
+
+				got, jumped = next, jumped+1
+			}
+
+			assert.True(t, want.Equal(got), "want %s, got %s", want, got)
+			assert.Equal(t, passed, jumped)
+		})
+	}
+
+	t.Run("count", func(t *testing.T) {
+		t.Parallel()
+
+		rule := parse(t, "FREQ=WEEKLY;BYDAY=MO,WE;COUNT=5")
+		monday := time.Date(2030, time.January, 7, 9, 0, 0, 0, time.UTC)
+
+		got, jumped := rule.Jump(monday, monday.AddDate(1, 0, 0), int(rule.Count)-1)
+		assert.Equal(t, time.Date(2030, time.January, 21, 9, 0, 0, 0, time.UTC), got)
+		assert.Equal(t, 4, jumped)
+	})
+
+	t.Run("not an occurrence", func(t *testing.T) {
+		t.Parallel()
+
+		rule := parse(t, "FREQ=WEEKLY;BYDAY=MO")
+		tuesday := time.Date(2030, time.January, 8, 9, 0, 0, 0, time.UTC)
+
+		got, jumped := rule.Jump(tuesday, tuesday.AddDate(1, 0, 0), -1)
+		assert.Equal(t, tuesday, got)
+		assert.Zero(t, jumped)
+	})
+}
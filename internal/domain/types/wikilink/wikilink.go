@@ -0,0 +1,66 @@
+package wikilink
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// maxTitle is the longest title of a note, longer links cannot refer to any note and are not links at all.
+const maxTitle = 255
+
+// link is the target in double brackets, optionally followed by the text shown instead, e.g. `[[42|the plan]]`.
+var link = regexp.MustCompile(`\[\[([^\[\]|\n]+)(?:\|[^\[\]\n]*)?\]\]`)
+
+// Target is what the link refers to: the note by its id, e.g. `[[42]]`, or by its title, e.g. `[[Roadmap]]`.
+// Exactly one of the fields is set.
+type Target struct {
+	Title string
+	ID    int64
+}
+
+// Parse returns the targets of the links in the text in the order they first appear.
+// A link of digits only refers to the note by its id.
+func Parse(text string) []Target {
+	targets := make([]Target, 0)
+	seen := make(map[Target]bool)
+
+	for _, match := range link.FindAllStringSubmatch(text, -1) {
+		target, ok := parse(strings.TrimSpace(match[1]))
+		if !ok || seen[target] {
+			continue
+		}
+
+		seen[target] = true
+		targets = append(targets, target)
+	}
+
+	return targets
+}
+
+func parse(text string) (Target, bool) {
+	if text == "" || utf8.RuneCountInString(text) > maxTitle {
+		return Target{Title: "", ID: 0}, false
+	}
+
+	ident, err := strconv.ParseInt(text, 10, 64)
+
+	switch {
+	case err == nil && ident > 0:
+		return Target{Title: "", ID: ident}, true
+	case err == nil:
+		return Target{Title: "", ID: 0}, false
+	}
+
+	return Target{Title: text, ID: 0}, true
+}
+
+// String returns the target as written in the link.
+func (t Target) String() string {
+	if t.ID != 0 {
+		return strconv.FormatInt(t.ID, 10)
+	}
+
+	return t.Title
+}
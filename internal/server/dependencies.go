@@ -3,20 +3,24 @@ package server
 import (
 	redislib "github.com/redis/go-redis/v9"
 	"github.com/therenotomorrow/ex"
+	"github.com/therenotomorrow/gotes/internal/domain/types/cursor"
 	"github.com/therenotomorrow/gotes/internal/domain/types/email"
 	"github.com/therenotomorrow/gotes/internal/domain/types/password"
 	"github.com/therenotomorrow/gotes/internal/domain/types/uuid"
 	"github.com/therenotomorrow/gotes/internal/services/secure"
+	"github.com/therenotomorrow/gotes/internal/storages/blobs"
 	"github.com/therenotomorrow/gotes/internal/storages/postgres"
 )
 
 type Dependencies struct {
 	Database       postgres.Database
 	Redis          redislib.UniversalClient
+	Blobs          blobs.Storage
 	Authenticator  secure.Authenticator
 	PasswordHasher password.Hasher
 	UUIDGenerator  uuid.Generator
 	EmailValidator email.Validator
+	CursorSigner   cursor.Signer
 }
 
 func (d *Dependencies) Close() {
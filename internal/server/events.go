@@ -0,0 +1,194 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/utilities"
+	"github.com/therenotomorrow/gotes/internal/services/secure"
+	pbnotesv1 "github.com/therenotomorrow/gotes/pkg/api/notes/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// EventStreamPath is the Server-Sent Events counterpart of the SubscribeToEvents route.
+	EventStreamPath = "/api/v1/notes/events/stream"
+	// LastEventIDHeader is sent by reconnecting clients, it holds the position of the last event they have seen.
+	LastEventIDHeader = "Last-Event-ID"
+)
+
+// streamed is a message of the event stream or the error that ended it.
+type streamed struct {
+	response *pbnotesv1.SubscribeToEventsResponse
+	err      error
+}
+
+// EventStreamHandler bridges SubscribeToEvents to a `text/event-stream` response. The request takes the same
+// query parameters as the route of the stream, the Last-Event-ID header takes precedence over resume_from.
+// Events carry their position as the id, so the reconnecting clients continue right after the last seen event.
+// Comments are sent every keep-alive interval and for heartbeats, so idle connections are not dropped by proxies,
+// the keep-alive comments are off when the interval is not positive.
+// Errors before the stream starts are reported with the HTTP status, later ones as the `error` event.
+func EventStreamHandler(
+	client pbnotesv1.NotesServiceClient,
+	mux *runtime.ServeMux,
+	keepAlive time.Duration,
+) http.Handler {
+	marshaler := new(runtime.JSONPb)
+
+	return http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		ctx, cancel := context.WithCancel(request.Context())
+		defer cancel()
+
+		input := new(pbnotesv1.SubscribeToEventsRequest)
+
+		err := runtime.PopulateQueryParameters(input, request.URL.Query(), utilities.NewDoubleArray(nil))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, marshaler, writer, request, status.Error(codes.InvalidArgument, err.Error()))
+
+			return
+		}
+
+		if position := request.Header.Get(LastEventIDHeader); position != "" {
+			input.ResumeFrom = position
+		}
+
+		if token := request.Header.Get("Authorization"); token != "" {
+			ctx = metadata.AppendToOutgoingContext(ctx, secure.AuthKey, token)
+		}
+
+		stream, err := client.SubscribeToEvents(ctx, input)
+		if err != nil {
+			runtime.HTTPError(ctx, mux, marshaler, writer, request, err)
+
+			return
+		}
+
+		// the first message tells whether the subscription is accepted, so the failures still get their status
+		first, err := stream.Recv()
+		if err != nil {
+			runtime.HTTPError(ctx, mux, marshaler, writer, request, err)
+
+			return
+		}
+
+		messages := make(chan streamed)
+
+		go func() {
+			for response, err := first, error(nil); ; response, err = stream.Recv() {
+				select {
+				case messages <- streamed{response: response, err: err}:
+				case <-ctx.Done():
+					return
+				}
+
+				if err != nil {
+					return
+				}
+			}
+		}()
+
+		writer.Header().Set("Content-Type", "text/event-stream")
+		writer.Header().Set("Cache-Control", "no-cache")
+		writer.Header().Set("X-Accel-Buffering", "no")
+		writer.WriteHeader(http.StatusOK)
+
+		events := &eventWriter{writer: writer, flusher: http.NewResponseController(writer)}
+
+		var (
+			ticker *time.Ticker
+			ticks  <-chan time.Time
+		)
+
+		if keepAlive > 0 {
+			ticker = time.NewTicker(keepAlive)
+			defer ticker.Stop()
+
+			ticks = ticker.C
+		}
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+
+			case <-ticks:
+				err = events.comment("keep-alive")
+
+			case message := <-messages:
+				switch {
+				case message.err != nil:
+					if !errors.Is(message.err, io.EOF) && ctx.Err() == nil {
+						_ = events.event("error", "", status.Convert(message.err).Proto())
+					}
+
+					return
+				default:
+					if ticker != nil {
+						ticker.Reset(keepAlive)
+					}
+
+					err = events.send(message.response)
+				}
+			}
+
+			if err != nil {
+				return
+			}
+		}
+	})
+}
+
+// eventWriter writes the messages in the Server-Sent Events format and flushes every one of them.
+type eventWriter struct {
+	writer  io.Writer
+	flusher *http.ResponseController
+}
+
+func (w *eventWriter) send(response *pbnotesv1.SubscribeToEventsResponse) error {
+	switch payload := response.GetPayload().(type) {
+	case *pbnotesv1.SubscribeToEventsResponse_Event:
+		return w.event("event", payload.Event.GetPosition(), payload.Event)
+	case *pbnotesv1.SubscribeToEventsResponse_Unread:
+		return w.event("unread", "", payload.Unread)
+	default:
+		return w.comment("heartbeat")
+	}
+}
+
+func (w *eventWriter) event(name, id string, message proto.Message) error {
+	data, err := protojson.Marshal(message)
+	if err != nil {
+		return err
+	}
+
+	frame := "event: " + name + "\n"
+	if id != "" {
+		frame += "id: " + id + "\n"
+	}
+
+	_, err = fmt.Fprintf(w.writer, "%sdata: %s\n\n", frame, data)
+	if err != nil {
+		return err
+	}
+
+	return w.flusher.Flush()
+}
+
+func (w *eventWriter) comment(text string) error {
+	_, err := fmt.Fprintf(w.writer, ": %s\n\n", text)
+	if err != nil {
+		return err
+	}
+
+	return w.flusher.Flush()
+}
@@ -0,0 +1,133 @@
+package server_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/therenotomorrow/gotes/internal/server"
+	pbnotesv1 "github.com/therenotomorrow/gotes/pkg/api/notes/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// notesClient serves the scripted messages as the event stream and keeps the request it was given.
+type notesClient struct {
+	pbnotesv1.NotesServiceClient
+
+	request  *pbnotesv1.SubscribeToEventsRequest
+	messages []*pbnotesv1.SubscribeToEventsResponse
+	err      error
+}
+
+func (c *notesClient) SubscribeToEvents(
+	_ context.Context,
+	request *pbnotesv1.SubscribeToEventsRequest,
+	_ ...grpc.CallOption,
+) (grpc.ServerStreamingClient[pbnotesv1.SubscribeToEventsResponse], error) {
+	c.request = request
+
+	return &eventsStream{messages: c.messages, err: c.err}, nil
+}
+
+type eventsStream struct {
+	grpc.ClientStream
+
+	messages []*pbnotesv1.SubscribeToEventsResponse
+	err      error
+}
+
+func (s *eventsStream) Recv() (*pbnotesv1.SubscribeToEventsResponse, error) {
+	if len(s.messages) == 0 {
+		return nil, s.err
+	}
+
+	message := s.messages[0]
+	s.messages = s.messages[1:]
+
+	return message, nil
+}
+
+func event(position string) *pbnotesv1.SubscribeToEventsResponse {
+	return &pbnotesv1.SubscribeToEventsResponse{
+		Payload: &pbnotesv1.SubscribeToEventsResponse_Event{Event: &pbnotesv1.Event{Position: position}},
+	}
+}
+
+func TestEventStreamHandler(t *testing.T) {
+	t.Parallel()
+
+	t.Run("last event id", func(t *testing.T) {
+		t.Parallel()
+
+		client := &notesClient{messages: []*pbnotesv1.SubscribeToEventsResponse{event("6-0")}, err: io.EOF}
+		handler := server.EventStreamHandler(client, runtime.NewServeMux(), 0)
+
+		target := server.EventStreamPath + "?resume_from=1-0"
+		request := httptest.NewRequestWithContext(t.Context(), http.MethodGet, target, nil)
+		request.Header.Set(server.LastEventIDHeader, "5-0")
+
+		recorder := httptest.NewRecorder()
+		handler.ServeHTTP(recorder, request)
+
+		require.Equal(t, http.StatusOK, recorder.Code)
+		assert.Equal(t, "5-0", client.request.GetResumeFrom())
+		assert.Equal(t, "text/event-stream", recorder.Header().Get("Content-Type"))
+		assert.Contains(t, recorder.Body.String(), "event: event\nid: 6-0\ndata: ")
+	})
+
+	t.Run("resume from", func(t *testing.T) {
+		t.Parallel()
+
+		client := &notesClient{messages: []*pbnotesv1.SubscribeToEventsResponse{event("6-0")}, err: io.EOF}
+		handler := server.EventStreamHandler(client, runtime.NewServeMux(), 0)
+
+		target := server.EventStreamPath + "?resume_from=1-0"
+		request := httptest.NewRequestWithContext(t.Context(), http.MethodGet, target, nil)
+
+		handler.ServeHTTP(httptest.NewRecorder(), request)
+
+		assert.Equal(t, "1-0", client.request.GetResumeFrom())
+	})
+
+	t.Run("error event", func(t *testing.T) {
+		t.Parallel()
+
+		client := &notesClient{
+			messages: []*pbnotesv1.SubscribeToEventsResponse{event("6-0")},
+			err:      status.Error(codes.Internal, "stream broken"),
+		}
+		handler := server.EventStreamHandler(client, runtime.NewServeMux(), 0)
+
+		request := httptest.NewRequestWithContext(t.Context(), http.MethodGet, server.EventStreamPath, nil)
+
+		recorder := httptest.NewRecorder()
+		handler.ServeHTTP(recorder, request)
+
+		require.Equal(t, http.StatusOK, recorder.Code)
+		assert.Contains(t, recorder.Body.String(), "event: event\nid: 6-0\n")
+		assert.Contains(t, recorder.Body.String(), "event: error\ndata: ")
+		assert.Contains(t, recorder.Body.String(), "stream broken")
+	})
+
+	t.Run("rejected", func(t *testing.T) {
+		t.Parallel()
+
+		client := &notesClient{messages: nil, err: status.Error(codes.Unauthenticated, "no token")}
+		handler := server.EventStreamHandler(client, runtime.NewServeMux(), 0)
+
+		request := httptest.NewRequestWithContext(t.Context(), http.MethodGet, server.EventStreamPath, nil)
+
+		recorder := httptest.NewRecorder()
+		handler.ServeHTTP(recorder, request)
+
+		assert.Equal(t, http.StatusUnauthorized, recorder.Code)
+		assert.NotContains(t, recorder.Body.String(), "event: ")
+	})
+}
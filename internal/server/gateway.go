@@ -3,6 +3,7 @@ package server
 import (
 	"context"
 	"crypto/tls"
+	"expvar"
 	"log/slog"
 	"net/http"
 
@@ -10,15 +11,22 @@ import (
 	"github.com/therenotomorrow/ex"
 	openapinotesv1 "github.com/therenotomorrow/gotes/docs/api/notes/v1"
 	openapiusersv1 "github.com/therenotomorrow/gotes/docs/api/users/v1"
+	openapiwebhooksv1 "github.com/therenotomorrow/gotes/docs/api/webhooks/v1"
+	notesv1 "github.com/therenotomorrow/gotes/internal/api/notes/v1"
 	"github.com/therenotomorrow/gotes/internal/config"
+	"github.com/therenotomorrow/gotes/internal/domain/entities"
 	pbnotesv1 "github.com/therenotomorrow/gotes/pkg/api/notes/v1"
+	typespb "github.com/therenotomorrow/gotes/pkg/api/types"
 	pbusersv1 "github.com/therenotomorrow/gotes/pkg/api/users/v1"
+	pbwebhooksv1 "github.com/therenotomorrow/gotes/pkg/api/webhooks/v1"
 	"github.com/therenotomorrow/gotes/pkg/services/trace"
 	"github.com/therenotomorrow/gotes/tools/swagger"
 	"github.com/tmc/grpc-websocket-proxy/wsproxy"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 )
 
 func NewGateway(cfg *config.Config, logger *slog.Logger) (*http.Server, error) {
@@ -34,13 +42,18 @@ func NewGateway(cfg *config.Config, logger *slog.Logger) (*http.Server, error) {
 	handler := http.NewServeMux()
 
 	// ---- NotesService
-	notesGateway := runtime.NewServeMux()
+	notesGateway := runtime.NewServeMux(
+		runtime.WithForwardResponseOption(ETagResponseOption),
+		runtime.WithForwardResponseOption(VaryResponseOption),
+		runtime.WithErrorHandler(PreconditionErrorHandler),
+	)
 	notesMiddlewares := []func(next http.Handler) http.Handler{
 		tracer.Middleware,
 		LoggingMiddleware(tracer),
 		CORSMiddleware(cfg.Server.Gateway.CORS),
 		TrimSlashMiddleware,
 		WebSocketMiddleware,
+		NotModifiedMiddleware,
 	}
 
 	err := pbnotesv1.RegisterNotesServiceHandlerFromEndpoint(ctx, notesGateway, cfg.Server.Address, options)
@@ -50,6 +63,21 @@ func NewGateway(cfg *config.Config, logger *slog.Logger) (*http.Server, error) {
 
 	handler.Handle("/api/v1/notes/", ApplyMiddlewares(notesGateway, notesMiddlewares...))
 
+	notesClient, err := grpc.NewClient(cfg.Server.Address, options...)
+	if err != nil {
+		return nil, ex.Unexpected(err)
+	}
+
+	eventsMiddlewares := []func(next http.Handler) http.Handler{
+		tracer.Middleware,
+		LoggingMiddleware(tracer),
+		CORSMiddleware(cfg.Server.Gateway.CORS),
+	}
+	events := EventStreamHandler(pbnotesv1.NewNotesServiceClient(notesClient), notesGateway, cfg.Server.Gateway.KeepAlive)
+
+	handler.Handle("GET "+EventStreamPath, ApplyMiddlewares(events, eventsMiddlewares...))
+	handler.Handle("OPTIONS "+EventStreamPath, ApplyMiddlewares(events, eventsMiddlewares...))
+
 	// ---- UsersService
 	usersGateway := runtime.NewServeMux()
 	usersMiddlewares := []func(next http.Handler) http.Handler{
@@ -65,8 +93,29 @@ func NewGateway(cfg *config.Config, logger *slog.Logger) (*http.Server, error) {
 
 	handler.Handle("/api/v1/users/", ApplyMiddlewares(usersGateway, usersMiddlewares...))
 
+	// ---- WebhooksService
+	webhooksGateway := runtime.NewServeMux()
+	webhooksMiddlewares := []func(next http.Handler) http.Handler{
+		tracer.Middleware,
+		LoggingMiddleware(tracer),
+		CORSMiddleware(cfg.Server.Gateway.CORS),
+		TrimSlashMiddleware,
+	}
+
+	err = pbwebhooksv1.RegisterWebhooksServiceHandlerFromEndpoint(ctx, webhooksGateway, cfg.Server.Address, options)
+	if err != nil {
+		return nil, ex.Unexpected(err)
+	}
+
+	handler.Handle("/api/v1/webhooks", ApplyMiddlewares(webhooksGateway, webhooksMiddlewares...))
+	handler.Handle("/api/v1/webhooks/", ApplyMiddlewares(webhooksGateway, webhooksMiddlewares...))
+
 	HandleDocs(handler)
 
+	if cfg.Debug {
+		handler.Handle("/debug/vars", expvar.Handler())
+	}
+
 	gateway := new(http.Server)
 	gateway.Addr = cfg.Server.Gateway.Address
 	gateway.Handler = wsproxy.WebsocketProxy(handler)
@@ -74,6 +123,64 @@ func NewGateway(cfg *config.Config, logger *slog.Logger) (*http.Server, error) {
 	return gateway, nil
 }
 
+// ETagResponseOption exposes the version of the returned note and the format of its content as the ETag header.
+func ETagResponseOption(ctx context.Context, writer http.ResponseWriter, message proto.Message) error {
+	response, ok := message.(interface{ GetNote() *pbnotesv1.Note })
+	if !ok || response.GetNote() == nil {
+		return nil
+	}
+
+	var format entities.Format
+
+	if md, found := runtime.ServerMetadataFromContext(ctx); found {
+		if values := md.HeaderMD.Get(notesv1.FormatKey); len(values) > 0 {
+			format = entities.Format(values[0])
+		}
+	}
+
+	writer.Header().Set("ETag", notesv1.MarshalETag(response.GetNote().GetVersion(), format))
+
+	return nil
+}
+
+// VaryResponseOption tells caches that the contents of the returned notes depend on the Accept header.
+func VaryResponseOption(_ context.Context, writer http.ResponseWriter, message proto.Message) error {
+	switch message.(type) {
+	case *pbnotesv1.RetrieveNoteResponse, *pbnotesv1.ListNotesResponse:
+		writer.Header().Add("Vary", "Accept")
+	}
+
+	return nil
+}
+
+// PreconditionErrorHandler reports the note version errors with the HTTP statuses of conditional requests.
+func PreconditionErrorHandler(
+	ctx context.Context,
+	mux *runtime.ServeMux,
+	marshaler runtime.Marshaler,
+	writer http.ResponseWriter,
+	request *http.Request,
+	err error,
+) {
+	st, _ := status.FromError(err)
+
+	for _, detail := range st.Details() {
+		details, ok := detail.(*typespb.Error)
+		if !ok {
+			continue
+		}
+
+		switch {
+		case details.GetCode() == typespb.ErrorCode_ERROR_CODE_VERSION_REQUIRED:
+			err = &runtime.HTTPStatusError{HTTPStatus: http.StatusPreconditionRequired, Err: err}
+		case details.GetCode() == typespb.ErrorCode_ERROR_CODE_VERSION_MISMATCH && request.Header.Get("If-Match") != "":
+			err = &runtime.HTTPStatusError{HTTPStatus: http.StatusPreconditionFailed, Err: err}
+		}
+	}
+
+	runtime.DefaultHTTPErrorHandler(ctx, mux, marshaler, writer, request, err)
+}
+
 func HandleDocs(handler *http.ServeMux) {
 	handler.Handle(
 		"GET /docs/",
@@ -89,4 +196,9 @@ func HandleDocs(handler *http.ServeMux) {
 		"GET /docs/users/",
 		http.StripPrefix("/docs/users", http.FileServer(http.FS(openapiusersv1.Content))),
 	)
+
+	handler.Handle(
+		"GET /docs/webhooks/",
+		http.StripPrefix("/docs/webhooks", http.FileServer(http.FS(openapiwebhooksv1.Content))),
+	)
 }
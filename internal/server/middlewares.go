@@ -6,6 +6,7 @@ import (
 	"slices"
 	"strings"
 
+	"github.com/therenotomorrow/ex"
 	"github.com/therenotomorrow/gotes/internal/config"
 	"github.com/therenotomorrow/gotes/internal/services/secure"
 )
@@ -14,12 +15,14 @@ func CORSMiddleware(cfg config.CORS) func(next http.Handler) http.Handler {
 	allowedMethods := strings.Join(cfg.AllowedMethods, ", ")
 	allowedOrigins := cfg.AllowedOrigins
 	allowedHeaders := cfg.AllowedHeaders
+	exposedHeaders := cfg.ExposedHeaders
 
 	return func(next http.Handler) http.Handler {
 		handlerFunc := func(writer http.ResponseWriter, request *http.Request) {
 			writer.Header().Set("Access-Control-Allow-Methods", allowedMethods)
 			writer.Header().Set("Access-Control-Allow-Origin", allowedOrigins)
 			writer.Header().Set("Access-Control-Allow-Headers", allowedHeaders)
+			writer.Header().Set("Access-Control-Expose-Headers", exposedHeaders)
 
 			if request.Method == http.MethodOptions {
 				writer.WriteHeader(http.StatusNoContent)
@@ -75,6 +78,93 @@ func WebSocketMiddleware(next http.Handler) http.Handler {
 	})
 }
 
+// NotModifiedMiddleware answers a conditional GET with 304 Not Modified when the ETag of the response still matches.
+func NotModifiedMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		match := request.Header.Get("If-None-Match")
+		if request.Method != http.MethodGet || match == "" {
+			next.ServeHTTP(writer, request)
+
+			return
+		}
+
+		next.ServeHTTP(&notModifiedWriter{ResponseWriter: writer, match: match, wrote: false, skip: false}, request)
+	})
+}
+
+type notModifiedWriter struct {
+	http.ResponseWriter
+
+	match string
+	wrote bool
+	skip  bool
+}
+
+func (w *notModifiedWriter) WriteHeader(code int) {
+	if w.wrote {
+		return
+	}
+
+	w.wrote = true
+
+	if code == http.StatusOK && etagMatch(w.match, w.Header().Get("ETag")) {
+		w.skip = true
+		code = http.StatusNotModified
+
+		w.Header().Del("Content-Type")
+		w.Header().Del("Content-Length")
+	}
+
+	w.ResponseWriter.WriteHeader(code)
+}
+
+func (w *notModifiedWriter) Write(data []byte) (int, error) {
+	w.WriteHeader(http.StatusOK)
+
+	if w.skip {
+		return len(data), nil
+	}
+
+	return w.ResponseWriter.Write(data)
+}
+
+// Flush commits the status first, so streamed responses still get their 304 when the ETag matches.
+func (w *notModifiedWriter) Flush() {
+	w.WriteHeader(http.StatusOK)
+
+	if w.skip {
+		return
+	}
+
+	ex.Skip(http.NewResponseController(w.ResponseWriter).Flush())
+}
+
+// Unwrap lets http.ResponseController reach the underlying writer.
+func (w *notModifiedWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
+
+// etagMatch compares the If-None-Match header with the ETag the weak way, as RFC 9110 requires for it.
+func etagMatch(header, etag string) bool {
+	if etag == "" {
+		return false
+	}
+
+	if strings.TrimSpace(header) == "*" {
+		return true
+	}
+
+	etag = strings.TrimPrefix(etag, "W/")
+
+	for tag := range strings.SplitSeq(header, ",") {
+		if strings.TrimPrefix(strings.TrimSpace(tag), "W/") == etag {
+			return true
+		}
+	}
+
+	return false
+}
+
 func ApplyMiddlewares(handler http.Handler, middlewares ...func(http.Handler) http.Handler) http.Handler {
 	slices.Reverse(middlewares)
 
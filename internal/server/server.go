@@ -16,17 +16,21 @@ import (
 	metricsv1 "github.com/therenotomorrow/gotes/internal/api/metrics/v1"
 	notesv1 "github.com/therenotomorrow/gotes/internal/api/notes/v1"
 	usersv1 "github.com/therenotomorrow/gotes/internal/api/users/v1"
+	webhooksv1 "github.com/therenotomorrow/gotes/internal/api/webhooks/v1"
 	"github.com/therenotomorrow/gotes/internal/config"
+	"github.com/therenotomorrow/gotes/internal/domain/types/cursor"
 	"github.com/therenotomorrow/gotes/internal/domain/types/email"
 	"github.com/therenotomorrow/gotes/internal/domain/types/password"
 	"github.com/therenotomorrow/gotes/internal/domain/types/uuid"
 	"github.com/therenotomorrow/gotes/internal/services/secure"
+	"github.com/therenotomorrow/gotes/internal/storages/blobs"
 	"github.com/therenotomorrow/gotes/internal/storages/postgres"
 	"github.com/therenotomorrow/gotes/internal/storages/redis"
 	pbchatv1 "github.com/therenotomorrow/gotes/pkg/api/chat/v1"
 	pbmetricsv1 "github.com/therenotomorrow/gotes/pkg/api/metrics/v1"
 	pbnotesv1 "github.com/therenotomorrow/gotes/pkg/api/notes/v1"
 	pbusersv1 "github.com/therenotomorrow/gotes/pkg/api/users/v1"
+	pbwebhooksv1 "github.com/therenotomorrow/gotes/pkg/api/webhooks/v1"
 	"github.com/therenotomorrow/gotes/pkg/services/generate"
 	"github.com/therenotomorrow/gotes/pkg/services/trace"
 	"github.com/therenotomorrow/gotes/pkg/services/validate"
@@ -37,12 +41,16 @@ import (
 )
 
 type Server struct {
-	deps    *Dependencies
-	logger  *slog.Logger
-	grpc    *grpc.Server
-	config  *config.Config
-	gateway *http.Server
-	once    sync.Once
+	deps       *Dependencies
+	logger     *slog.Logger
+	grpc       *grpc.Server
+	config     *config.Config
+	gateway    *http.Server
+	purger     *notesv1.Purger
+	relay      *notesv1.Relay
+	scheduler  *notesv1.Scheduler
+	dispatcher *webhooksv1.Dispatcher
+	once       sync.Once
 }
 
 func New(cfg *config.Config, deps *Dependencies, logger *slog.Logger) (*Server, error) {
@@ -71,6 +79,8 @@ func New(cfg *config.Config, deps *Dependencies, logger *slog.Logger) (*Server,
 			secure.UnaryServerInterceptor(deps.Authenticator, []string{
 				"/api.users.v1.UsersService/RegisterUser",
 				"/api.users.v1.UsersService/RefreshToken",
+				"/api.notes.v1.NotesService/GetPublicNote",
+				"/api.notes.v1.NotesService/RenderPublicNote",
 			}...),
 		),
 		grpc.ChainStreamInterceptor(
@@ -86,11 +96,20 @@ func New(cfg *config.Config, deps *Dependencies, logger *slog.Logger) (*Server,
 	email.SetValidator(deps.EmailValidator)
 	uuid.SetGenerator(deps.UUIDGenerator)
 	password.SetHasher(deps.PasswordHasher)
+	cursor.SetSigner(deps.CursorSigner)
+	notesv1.SetEventsRetention(cfg.Events.MaxLength, cfg.Events.MaxAge, cfg.Events.MaxDevices, cfg.Events.DeviceIdle)
+
+	notes := notesv1.NewService(deps.Database, deps.Redis, deps.Blobs, logger)
+	notes.SetBatchLimit(cfg.Notes.BatchLimit)
+	notes.SetAttachmentLimit(cfg.Notes.AttachmentLimit)
+	notes.SetHeartbeatInterval(cfg.Notes.Heartbeat)
+	notes.SetCheckpointInterval(cfg.Notes.CheckpointInterval)
 
 	pbmetricsv1.RegisterMetricsServiceServer(server, metricsv1.NewService(logger))
-	pbnotesv1.RegisterNotesServiceServer(server, notesv1.NewService(deps.Database, deps.Redis, logger))
+	pbnotesv1.RegisterNotesServiceServer(server, notes)
 	pbusersv1.RegisterUsersServiceServer(server, usersv1.NewService(deps.Database, logger))
 	pbchatv1.RegisterChatServiceServer(server, chatv1.NewService(validator, logger))
+	pbwebhooksv1.RegisterWebhooksServiceServer(server, webhooksv1.NewService(deps.Database, logger))
 
 	if cfg.Debug {
 		reflection.Register(server)
@@ -101,7 +120,35 @@ func New(cfg *config.Config, deps *Dependencies, logger *slog.Logger) (*Server,
 		return nil, err
 	}
 
-	return &Server{logger: logger, gateway: gateway, grpc: server, config: cfg, deps: deps, once: sync.Once{}}, nil
+	purger := notesv1.NewPurger(
+		deps.Database,
+		deps.Redis,
+		deps.Blobs,
+		cfg.Notes.TrashRetention,
+		cfg.Notes.PurgeInterval,
+		logger,
+	)
+
+	relay := notesv1.NewRelay(deps.Database, deps.Redis, deps.Blobs, cfg.Notes.RelayInterval, logger)
+
+	scheduler := notesv1.NewScheduler(deps.Database, deps.Redis, deps.Blobs, cfg.Notes.ReminderInterval, logger)
+
+	dispatcher := webhooksv1.NewDispatcher(deps.Database, cfg.Webhooks.Interval, cfg.Webhooks.Timeout, logger)
+	dispatcher.SetFailureLimit(cfg.Webhooks.FailureLimit)
+	dispatcher.SetAttemptsRetention(cfg.Webhooks.AttemptsRetention)
+
+	return &Server{
+		logger:     logger,
+		gateway:    gateway,
+		grpc:       server,
+		config:     cfg,
+		deps:       deps,
+		purger:     purger,
+		relay:      relay,
+		scheduler:  scheduler,
+		dispatcher: dispatcher,
+		once:       sync.Once{},
+	}, nil
 }
 
 func MustNew(cfg *config.Config, deps *Dependencies, logger *slog.Logger) *Server {
@@ -114,14 +161,28 @@ func Default(cfg *config.Config) *Server {
 	logger := trace.Logger(trace.JSON, cfg.Debug)
 	database := postgres.MustNew(postgres.Config{DSN: cfg.Postgres.DSN}, logger)
 	rdb := redis.MustNew(redis.Config{Address: cfg.Redis.Address, Password: cfg.Redis.Password}, logger)
+	storage := blobs.MustNew(blobs.Config{
+		Driver: cfg.Blobs.Driver,
+		Dir:    cfg.Blobs.Dir,
+		S3: blobs.S3Config{
+			Endpoint:  cfg.Blobs.S3.Endpoint,
+			Region:    cfg.Blobs.S3.Region,
+			Bucket:    cfg.Blobs.S3.Bucket,
+			AccessKey: cfg.Blobs.S3.AccessKey,
+			SecretKey: cfg.Blobs.S3.SecretKey,
+			Timeout:   cfg.Blobs.S3.Timeout,
+		},
+	}, logger)
 
 	return MustNew(cfg, &Dependencies{
 		Database:       database,
 		Redis:          rdb,
+		Blobs:          storage,
 		Authenticator:  secure.NewTokenAuthenticator(database),
 		PasswordHasher: vault.NewPasswordHasher(),
 		UUIDGenerator:  generate.NewUUID(),
 		EmailValidator: validate.NewEmail(),
+		CursorSigner:   vault.NewCursorSigner(cfg.Server.Secret),
 	}, logger)
 }
 
@@ -159,6 +220,30 @@ func (s *Server) Serve(ctx context.Context) {
 		}
 	}()
 
+	go func() {
+		s.logger.InfoContext(ctx, "run purger...", "retention", s.config.Notes.TrashRetention)
+
+		s.purger.Run(ctx)
+	}()
+
+	go func() {
+		s.logger.InfoContext(ctx, "run relay...", "interval", s.config.Notes.RelayInterval)
+
+		s.relay.Run(ctx)
+	}()
+
+	go func() {
+		s.logger.InfoContext(ctx, "run scheduler...", "interval", s.config.Notes.ReminderInterval)
+
+		s.scheduler.Run(ctx)
+	}()
+
+	go func() {
+		s.logger.InfoContext(ctx, "run dispatcher...", "interval", s.config.Webhooks.Interval)
+
+		s.dispatcher.Run(ctx)
+	}()
+
 	<-ctx.Done()
 }
 
@@ -0,0 +1,64 @@
+package blobs
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"path/filepath"
+	"strings"
+
+	"github.com/therenotomorrow/ex"
+	"github.com/therenotomorrow/gotes/pkg/services/validate"
+)
+
+const (
+	ErrInvalidConfig ex.Error = "invalid config"
+	ErrInvalidKey    ex.Error = "invalid blob key"
+	ErrBlobNotFound  ex.Error = "blob not found"
+
+	DriverFilesystem = "filesystem"
+	DriverS3         = "s3"
+)
+
+// Storage keeps blobs of any size by their keys, the keys are chosen by the caller.
+type Storage interface {
+	// Put stores everything read from the content under the key, the blob is not visible until the content ends.
+	Put(ctx context.Context, key string, content io.Reader) error
+	Get(ctx context.Context, key string) (io.ReadCloser, error)
+	// Delete removes the blob, missing blobs are not an error.
+	Delete(ctx context.Context, key string) error
+}
+
+type Config struct {
+	Driver string   `json:"driver" validate:"required,oneof=filesystem s3"`
+	Dir    string   `json:"dir"    validate:"required_if=Driver filesystem"`
+	S3     S3Config `json:"s3"     validate:"-"`
+}
+
+func New(cfg Config, logger *slog.Logger) (Storage, error) {
+	err := validate.Struct(cfg)
+	if err != nil {
+		return nil, ErrInvalidConfig.Because(err)
+	}
+
+	if cfg.Driver == DriverS3 {
+		return NewS3(cfg.S3, logger)
+	}
+
+	return NewFilesystem(cfg.Dir, logger)
+}
+
+func MustNew(cfg Config, logger *slog.Logger) Storage {
+	storage, err := New(cfg, logger)
+
+	return ex.Critical(storage, err)
+}
+
+// checkKey refuses keys that could escape the storage, e.g. `../secret`.
+func checkKey(key string) error {
+	if key == "" || strings.ContainsAny(key, `/\`) || !filepath.IsLocal(key) {
+		return ErrInvalidKey
+	}
+
+	return nil
+}
@@ -0,0 +1,106 @@
+package blobs_test
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/therenotomorrow/gotes/internal/storages/blobs"
+	"github.com/therenotomorrow/gotes/pkg/services/trace"
+)
+
+var log = trace.Logger(trace.TEXT, true)
+
+func TestNew(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		cfg  blobs.Config
+		want error
+	}{
+		{name: "unknown driver", cfg: blobs.Config{Driver: "ftp", Dir: t.TempDir()}, want: blobs.ErrInvalidConfig},
+		{name: "no dir", cfg: blobs.Config{Driver: blobs.DriverFilesystem}, want: blobs.ErrInvalidConfig},
+		{name: "no s3", cfg: blobs.Config{Driver: blobs.DriverS3}, want: blobs.ErrInvalidConfig},
+		{name: "filesystem", cfg: blobs.Config{Driver: blobs.DriverFilesystem, Dir: t.TempDir()}, want: nil},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := blobs.New(test.cfg, log)
+			if test.want == nil {
+				require.NoError(t, err)
+
+				return
+			}
+
+			require.ErrorIs(t, err, test.want)
+		})
+	}
+}
+
+func TestFilesystem(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+
+	storage, err := blobs.NewFilesystem(dir, log)
+	require.NoError(t, err)
+
+	t.Run("invalid key", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+
+		for _, key := range []string{"", "..", "../secret", "nested/key", `nested\key`} {
+			require.ErrorIs(t, storage.Put(ctx, key, strings.NewReader("content")), blobs.ErrInvalidKey, key)
+
+			_, err := storage.Get(ctx, key)
+			require.ErrorIs(t, err, blobs.ErrInvalidKey, key)
+			require.ErrorIs(t, storage.Delete(ctx, key), blobs.ErrInvalidKey, key)
+		}
+	})
+
+	t.Run("missing", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+
+		_, err := storage.Get(ctx, "missing")
+		require.ErrorIs(t, err, blobs.ErrBlobNotFound)
+		require.NoError(t, storage.Delete(ctx, "missing"))
+	})
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+
+		err := storage.Put(ctx, "blob", strings.NewReader("content"))
+		require.NoError(t, err)
+
+		content, err := storage.Get(ctx, "blob")
+		require.NoError(t, err)
+
+		got, err := io.ReadAll(content)
+		require.NoError(t, err)
+		require.NoError(t, content.Close())
+		assert.Equal(t, "content", string(got))
+
+		// no temporary files are left behind
+		uploads, err := filepath.Glob(filepath.Join(dir, ".upload-*"))
+		require.NoError(t, err)
+		assert.Empty(t, uploads)
+
+		require.NoError(t, storage.Delete(ctx, "blob"))
+
+		_, err = os.Stat(filepath.Join(dir, "blob"))
+		require.ErrorIs(t, err, os.ErrNotExist)
+	})
+}
@@ -0,0 +1,101 @@
+package blobs
+
+import (
+	"context"
+	"errors"
+	"io"
+	"io/fs"
+	"log/slog"
+	"os"
+	"path/filepath"
+
+	"github.com/therenotomorrow/ex"
+	"github.com/therenotomorrow/gotes/pkg/services/trace"
+)
+
+const dirPerm = 0o750
+
+// Filesystem keeps every blob as a file in the directory.
+type Filesystem struct {
+	tracer *trace.Tracer
+	dir    string
+}
+
+func NewFilesystem(dir string, logger *slog.Logger) (*Filesystem, error) {
+	err := os.MkdirAll(dir, dirPerm)
+	if err != nil {
+		return nil, ErrInvalidConfig.Because(err)
+	}
+
+	return &Filesystem{tracer: trace.Service("blobs.filesystem", logger), dir: dir}, nil
+}
+
+func (s *Filesystem) Put(ctx context.Context, key string, content io.Reader) error {
+	err := checkKey(key)
+	if err != nil {
+		return err
+	}
+
+	// write to a temporary file first, so readers never see a partial blob
+	file, err := os.CreateTemp(s.dir, ".upload-*")
+	if err != nil {
+		return ex.Unexpected(err)
+	}
+
+	defer func() {
+		err := os.Remove(file.Name())
+		if err != nil && !errors.Is(err, fs.ErrNotExist) {
+			s.tracer.Warning(ctx, "remove temporary blob", "error", err)
+		}
+	}()
+
+	_, err = io.Copy(file, content)
+	if err != nil {
+		_ = file.Close()
+
+		return ex.Unexpected(err)
+	}
+
+	err = file.Close()
+	if err != nil {
+		return ex.Unexpected(err)
+	}
+
+	return ex.Unexpected(os.Rename(file.Name(), s.path(key)))
+}
+
+func (s *Filesystem) Get(_ context.Context, key string) (io.ReadCloser, error) {
+	err := checkKey(key)
+	if err != nil {
+		return nil, err
+	}
+
+	file, err := os.Open(s.path(key))
+
+	switch {
+	case errors.Is(err, fs.ErrNotExist):
+		return nil, ErrBlobNotFound
+	case err != nil:
+		return nil, ex.Unexpected(err)
+	}
+
+	return file, nil
+}
+
+func (s *Filesystem) Delete(_ context.Context, key string) error {
+	err := checkKey(key)
+	if err != nil {
+		return err
+	}
+
+	err = os.Remove(s.path(key))
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+
+	return ex.Unexpected(err)
+}
+
+func (s *Filesystem) path(key string) string {
+	return filepath.Join(s.dir, key)
+}
@@ -0,0 +1,247 @@
+package blobs
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/therenotomorrow/ex"
+	"github.com/therenotomorrow/gotes/pkg/services/trace"
+	"github.com/therenotomorrow/gotes/pkg/services/validate"
+)
+
+const (
+	ErrUnexpectedStatus ex.Error = "unexpected status"
+
+	signAlgorithm   = "AWS4-HMAC-SHA256"
+	signService     = "s3"
+	signRequest     = "aws4_request"
+	signedHeaders   = "host;x-amz-content-sha256;x-amz-date"
+	unsignedPayload = "UNSIGNED-PAYLOAD"
+	amzDateFormat   = "20060102T150405Z"
+	amzDayFormat    = "20060102"
+)
+
+type S3Config struct {
+	// Endpoint is the base URL of the service, e.g. `https://s3.eu-central-1.amazonaws.com` or a local stand-in.
+	Endpoint  string `json:"endpoint"  validate:"required,url"`
+	Region    string `json:"region"    validate:"required"`
+	Bucket    string `json:"bucket"    validate:"required"`
+	AccessKey string `json:"accessKey" validate:"required"`
+	SecretKey string `json:"secretKey" validate:"required"`
+	// Timeout limits the whole exchange with the service, including the transfer of the content.
+	Timeout time.Duration `json:"timeout" validate:"gt=0"`
+}
+
+// S3 keeps blobs as objects of the bucket in any S3 compatible service, objects are addressed path-style
+// and requests are signed with Signature Version 4.
+type S3 struct {
+	client   *http.Client
+	tracer   *trace.Tracer
+	endpoint *url.URL
+	config   S3Config
+}
+
+func NewS3(cfg S3Config, logger *slog.Logger) (*S3, error) {
+	err := validate.Struct(cfg)
+	if err != nil {
+		return nil, ErrInvalidConfig.Because(err)
+	}
+
+	endpoint, err := url.Parse(strings.TrimSuffix(cfg.Endpoint, "/"))
+	if err != nil {
+		return nil, ErrInvalidConfig.Because(err)
+	}
+
+	client := new(http.Client)
+	client.Timeout = cfg.Timeout
+
+	return &S3{
+		client:   client,
+		tracer:   trace.Service("blobs.s3", logger),
+		endpoint: endpoint,
+		config:   cfg,
+	}, nil
+}
+
+func (s *S3) Put(ctx context.Context, key string, content io.Reader) error {
+	err := checkKey(key)
+	if err != nil {
+		return err
+	}
+
+	// the service needs the length upfront, so the content is spooled to a temporary file first
+	file, err := os.CreateTemp("", "gotes-blob-*")
+	if err != nil {
+		return ex.Unexpected(err)
+	}
+
+	defer func() {
+		_ = file.Close()
+
+		err := os.Remove(file.Name())
+		if err != nil && !errors.Is(err, fs.ErrNotExist) {
+			s.tracer.Warning(ctx, "remove temporary blob", "error", err)
+		}
+	}()
+
+	size, err := io.Copy(file, content)
+	if err != nil {
+		return ex.Unexpected(err)
+	}
+
+	_, err = file.Seek(0, io.SeekStart)
+	if err != nil {
+		return ex.Unexpected(err)
+	}
+
+	req, err := s.request(ctx, http.MethodPut, key, io.NopCloser(file))
+	if err != nil {
+		return err
+	}
+
+	req.ContentLength = size
+
+	resp, err := s.do(req)
+	if err != nil {
+		return err
+	}
+
+	return s.close(resp)
+}
+
+func (s *S3) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	err := checkKey(key)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := s.request(ctx, http.MethodGet, key, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Body, nil
+}
+
+func (s *S3) Delete(ctx context.Context, key string) error {
+	err := checkKey(key)
+	if err != nil {
+		return err
+	}
+
+	req, err := s.request(ctx, http.MethodDelete, key, nil)
+	if err != nil {
+		return err
+	}
+
+	resp, err := s.do(req)
+	if errors.Is(err, ErrBlobNotFound) {
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	return s.close(resp)
+}
+
+func (s *S3) request(ctx context.Context, method, key string, body io.ReadCloser) (*http.Request, error) {
+	object := s.endpoint.JoinPath(s.config.Bucket, key)
+
+	req, err := http.NewRequestWithContext(ctx, method, object.String(), body)
+	if err != nil {
+		return nil, ex.Unexpected(err)
+	}
+
+	s.sign(req, time.Now().UTC())
+
+	return req, nil
+}
+
+// do sends the request, a missing object is reported as ErrBlobNotFound and any other failure as ErrUnexpectedStatus.
+func (s *S3) do(req *http.Request) (*http.Response, error) {
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, ex.Unexpected(err)
+	}
+
+	if resp.StatusCode >= http.StatusOK && resp.StatusCode < http.StatusMultipleChoices {
+		return resp, nil
+	}
+
+	err = s.close(resp)
+	ex.Skip(err)
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, ErrBlobNotFound
+	}
+
+	return nil, ErrUnexpectedStatus.Because(fmt.Errorf("%s %s: %s", req.Method, req.URL.Path, resp.Status))
+}
+
+func (s *S3) close(resp *http.Response) error {
+	_, err := io.Copy(io.Discard, resp.Body)
+	ex.Skip(err)
+
+	return ex.Unexpected(resp.Body.Close())
+}
+
+// sign adds the Signature Version 4 authorization, the payload is left unsigned to allow streaming it.
+func (s *S3) sign(req *http.Request, now time.Time) {
+	amzDate := now.Format(amzDateFormat)
+	day := now.Format(amzDayFormat)
+	scope := strings.Join([]string{day, s.config.Region, signService, signRequest}, "/")
+
+	req.Header.Set("X-Amz-Date", amzDate)
+	req.Header.Set("X-Amz-Content-Sha256", unsignedPayload)
+
+	canonical := strings.Join([]string{
+		req.Method,
+		req.URL.EscapedPath(),
+		req.URL.RawQuery,
+		"host:" + req.URL.Host,
+		"x-amz-content-sha256:" + unsignedPayload,
+		"x-amz-date:" + amzDate,
+		"",
+		signedHeaders,
+		unsignedPayload,
+	}, "\n")
+
+	digest := sha256.Sum256([]byte(canonical))
+	payload := strings.Join([]string{signAlgorithm, amzDate, scope, hex.EncodeToString(digest[:])}, "\n")
+
+	key := hmacSHA256([]byte("AWS4"+s.config.SecretKey), day)
+	key = hmacSHA256(key, s.config.Region)
+	key = hmacSHA256(key, signService)
+	key = hmacSHA256(key, signRequest)
+
+	req.Header.Set("Authorization", fmt.Sprintf(
+		"%s Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		signAlgorithm, s.config.AccessKey, scope, signedHeaders, hex.EncodeToString(hmacSHA256(key, payload)),
+	))
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+
+	return mac.Sum(nil)
+}
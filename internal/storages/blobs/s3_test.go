@@ -0,0 +1,220 @@
+package blobs_test
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/therenotomorrow/gotes/internal/storages/blobs"
+)
+
+const (
+	accessKey = "AKIDEXAMPLE"
+	secretKey = "wJalrXUtnFEMI/K7MDENG+bPxRfiCYEXAMPLEKEY"
+	region    = "eu-central-1"
+	bucket    = "gotes"
+)
+
+// bucketServer is a stand-in of the service, it keeps the objects in memory and refuses requests
+// that are not signed with the secret.
+func bucketServer(t *testing.T, secret string) *httptest.Server {
+	t.Helper()
+
+	var (
+		mu      sync.Mutex
+		objects = make(map[string][]byte)
+	)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != signature(r, secret) {
+			w.WriteHeader(http.StatusForbidden)
+
+			return
+		}
+
+		mu.Lock()
+		defer mu.Unlock()
+
+		switch r.Method {
+		case http.MethodPut:
+			content, err := io.ReadAll(r.Body)
+			assert.NoError(t, err)
+			assert.Equal(t, int64(len(content)), r.ContentLength)
+
+			objects[r.URL.Path] = content
+		case http.MethodGet:
+			content, ok := objects[r.URL.Path]
+			if !ok {
+				w.WriteHeader(http.StatusNotFound)
+
+				return
+			}
+
+			_, _ = w.Write(content)
+		case http.MethodDelete:
+			if _, ok := objects[r.URL.Path]; !ok {
+				w.WriteHeader(http.StatusNotFound)
+
+				return
+			}
+
+			delete(objects, r.URL.Path)
+			w.WriteHeader(http.StatusNoContent)
+		}
+	}))
+
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+// signature computes the Signature Version 4 authorization of the request the way the service does.
+func signature(r *http.Request, secret string) string {
+	amzDate := r.Header.Get("X-Amz-Date")
+	day := amzDate[:8]
+	scope := day + "/" + region + "/s3/aws4_request"
+	canonical := strings.Join([]string{
+		r.Method,
+		r.URL.EscapedPath(),
+		r.URL.RawQuery,
+		"host:" + r.Host,
+		"x-amz-content-sha256:" + r.Header.Get("X-Amz-Content-Sha256"),
+		"x-amz-date:" + amzDate,
+		"",
+		"host;x-amz-content-sha256;x-amz-date",
+		"UNSIGNED-PAYLOAD",
+	}, "\n")
+	digest := sha256.Sum256([]byte(canonical))
+	payload := "AWS4-HMAC-SHA256\n" + amzDate + "\n" + scope + "\n" + hex.EncodeToString(digest[:])
+
+	key := []byte("AWS4" + secret)
+	for _, part := range []string{day, region, "s3", "aws4_request", payload} {
+		mac := hmac.New(sha256.New, key)
+		mac.Write([]byte(part))
+		key = mac.Sum(nil)
+	}
+
+	return fmt.Sprintf(
+		"AWS4-HMAC-SHA256 Credential=%s/%s, SignedHeaders=host;x-amz-content-sha256;x-amz-date, Signature=%s",
+		accessKey, scope, hex.EncodeToString(key),
+	)
+}
+
+func newS3(t *testing.T, endpoint, secret string, timeout time.Duration) *blobs.S3 {
+	t.Helper()
+
+	storage, err := blobs.NewS3(blobs.S3Config{
+		Endpoint:  endpoint,
+		Region:    region,
+		Bucket:    bucket,
+		AccessKey: accessKey,
+		SecretKey: secret,
+		Timeout:   timeout,
+	}, log)
+	require.NoError(t, err)
+
+	return storage
+}
+
+func TestNewS3(t *testing.T) {
+	t.Parallel()
+
+	_, err := blobs.NewS3(blobs.S3Config{Endpoint: "http://localhost:9000", Region: region, Bucket: bucket}, log)
+	require.ErrorIs(t, err, blobs.ErrInvalidConfig)
+
+	_, err = blobs.NewS3(blobs.S3Config{
+		Endpoint:  "http://localhost:9000",
+		Region:    region,
+		Bucket:    bucket,
+		AccessKey: accessKey,
+		SecretKey: secretKey,
+		Timeout:   0,
+	}, log)
+	require.ErrorIs(t, err, blobs.ErrInvalidConfig)
+}
+
+func TestS3(t *testing.T) {
+	t.Parallel()
+
+	server := bucketServer(t, secretKey)
+
+	t.Run("invalid key", func(t *testing.T) {
+		t.Parallel()
+
+		storage := newS3(t, server.URL, secretKey, time.Second)
+
+		err := storage.Put(t.Context(), "../secret", strings.NewReader("content"))
+		require.ErrorIs(t, err, blobs.ErrInvalidKey)
+	})
+
+	t.Run("missing", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			ctx     = t.Context()
+			storage = newS3(t, server.URL, secretKey, time.Second)
+		)
+
+		_, err := storage.Get(ctx, "missing")
+		require.ErrorIs(t, err, blobs.ErrBlobNotFound)
+		require.NoError(t, storage.Delete(ctx, "missing"))
+	})
+
+	t.Run("wrong secret", func(t *testing.T) {
+		t.Parallel()
+
+		storage := newS3(t, server.URL, "wrong", time.Second)
+
+		err := storage.Put(t.Context(), "blob", strings.NewReader("content"))
+		require.ErrorIs(t, err, blobs.ErrUnexpectedStatus)
+	})
+
+	t.Run("timeout", func(t *testing.T) {
+		t.Parallel()
+
+		server := httptest.NewServer(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+			<-r.Context().Done()
+		}))
+		t.Cleanup(server.Close)
+
+		storage := newS3(t, server.URL, secretKey, 50*time.Millisecond)
+
+		_, err := storage.Get(t.Context(), "blob")
+		require.Error(t, err)
+		require.NotErrorIs(t, err, blobs.ErrBlobNotFound)
+	})
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			ctx     = t.Context()
+			storage = newS3(t, server.URL+"/", secretKey, time.Second)
+		)
+
+		require.NoError(t, storage.Put(ctx, "blob", strings.NewReader("content")))
+
+		content, err := storage.Get(ctx, "blob")
+		require.NoError(t, err)
+
+		got, err := io.ReadAll(content)
+		require.NoError(t, err)
+		require.NoError(t, content.Close())
+		assert.Equal(t, "content", string(got))
+
+		require.NoError(t, storage.Delete(ctx, "blob"))
+
+		_, err = storage.Get(ctx, "blob")
+		require.ErrorIs(t, err, blobs.ErrBlobNotFound)
+	})
+}
@@ -1,13 +1,384 @@
 package commands
 
-import "github.com/therenotomorrow/gotes/internal/domain/entities"
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/therenotomorrow/gotes/internal/domain/entities"
+	"github.com/therenotomorrow/gotes/internal/domain/types/id"
+	"github.com/therenotomorrow/gotes/internal/domain/types/rrule"
+	domuuid "github.com/therenotomorrow/gotes/internal/domain/types/uuid"
+)
 
 func NewInsertNoteParams(note *entities.Note) *InsertNoteParams {
 	return &InsertNoteParams{
+		Title:      note.Title,
+		Content:    note.Content,
+		UserID:     note.Owner.ID.ValuePtr(),
+		NotebookID: notebookID(note.Notebook),
+		CreatedAt:  note.CreatedAt,
+		UpdatedAt:  note.UpdatedAt,
+	}
+}
+
+func notebookID(notebook *entities.Notebook) *int64 {
+	if notebook == nil {
+		return nil
+	}
+
+	return notebook.ID.ValuePtr()
+}
+
+func NewUpdateNoteParams(note *entities.Note) *UpdateNoteParams {
+	return &UpdateNoteParams{
 		Title:     note.Title,
 		Content:   note.Content,
-		UserID:    note.Owner.ID.ValuePtr(),
-		CreatedAt: note.CreatedAt,
 		UpdatedAt: note.UpdatedAt,
+		ID:        note.ID.Value(),
+		Version:   note.Version,
+		Pinned:    note.Pinned,
+		Archived:  note.Archived,
+		Starred:   note.Starred,
+	}
+}
+
+func NewDeleteNoteParams(note *entities.Note) *DeleteNoteParams {
+	return &DeleteNoteParams{
+		ID:      note.ID.Value(),
+		Version: note.Version,
+	}
+}
+
+func NewLockNoteParams(note *entities.Note) *LockNoteParams {
+	return &LockNoteParams{
+		ID:      note.ID.Value(),
+		Version: note.Version,
+	}
+}
+
+func NewUpdateNoteVersionParams(note *entities.Note) *UpdateNoteVersionParams {
+	return &UpdateNoteVersionParams{
+		ID:      note.ID.Value(),
+		Version: note.Version,
+	}
+}
+
+func NewInsertRevisionParams(revision *entities.Revision) *InsertRevisionParams {
+	return &InsertRevisionParams{
+		Title:     revision.Title,
+		Content:   revision.Content,
+		UserID:    revision.Author.ID.ValuePtr(),
+		CreatedAt: revision.CreatedAt,
+		NoteID:    revision.Note.ID.Value(),
+	}
+}
+
+func NewUpdateNoteDeletedAtParams(note *entities.Note) *UpdateNoteDeletedAtParams {
+	return &UpdateNoteDeletedAtParams{
+		DeletedAt: note.DeletedAt,
+		ID:        note.ID.Value(),
+		Version:   note.Version,
+	}
+}
+
+func (r *DeleteTrashedNotesRow) ToEntity() *entities.Note {
+	var owner *entities.User
+
+	if r.UserID != nil {
+		owner = new(entities.User)
+		owner.ID = id.New(*r.UserID)
+	}
+
+	return &entities.Note{
+		CreatedAt: time.Time{},
+		UpdatedAt: time.Time{},
+		Owner:     owner,
+		DeletedAt: nil,
+		Notebook:  nil,
+		Title:     "",
+		Content:   "",
+		Tags:      nil,
+		ID:        id.New(r.ID),
+		Version:   0,
+		Pinned:    false,
+		Archived:  false,
+		Starred:   false,
 	}
 }
+
+func NewUpsertTagParams(tag *entities.Tag) *UpsertTagParams {
+	return &UpsertTagParams{
+		UserID:    tag.Owner.ID.Value(),
+		Name:      tag.Name,
+		CreatedAt: tag.CreatedAt,
+	}
+}
+
+func NewUpsertNoteShareParams(share *entities.Share) *UpsertNoteShareParams {
+	return &UpsertNoteShareParams{
+		NoteID:    share.Note.ID.Value(),
+		UserID:    share.User.ID.Value(),
+		Role:      string(share.Role),
+		CreatedAt: share.CreatedAt,
+	}
+}
+
+func NewInsertNoteLinkParams(link *entities.Link) *InsertNoteLinkParams {
+	return &InsertNoteLinkParams{
+		NoteID:    link.Note.ID.Value(),
+		Token:     link.Token,
+		ExpiresAt: link.ExpiresAt,
+		CreatedAt: link.CreatedAt,
+	}
+}
+
+func NewUpdateNoteNotebookParams(note *entities.Note) *UpdateNoteNotebookParams {
+	return &UpdateNoteNotebookParams{
+		NotebookID: notebookID(note.Notebook),
+		UpdatedAt:  note.UpdatedAt,
+		ID:         note.ID.Value(),
+		Version:    note.Version,
+	}
+}
+
+func NewInsertNotebookParams(notebook *entities.Notebook) *InsertNotebookParams {
+	return &InsertNotebookParams{
+		UserID:    notebook.Owner.ID.Value(),
+		ParentID:  notebookID(notebook.Parent),
+		Name:      notebook.Name,
+		CreatedAt: notebook.CreatedAt,
+		UpdatedAt: notebook.UpdatedAt,
+	}
+}
+
+func NewUpdateNotebookParams(notebook *entities.Notebook) *UpdateNotebookParams {
+	return &UpdateNotebookParams{
+		ParentID:  notebookID(notebook.Parent),
+		Name:      notebook.Name,
+		UpdatedAt: notebook.UpdatedAt,
+		ID:        notebook.ID.Value(),
+	}
+}
+
+func (r *TrashNotebooksNotesRow) ToEntity(deletedAt time.Time) *entities.Note {
+	var owner *entities.User
+
+	if r.UserID != nil {
+		owner = new(entities.User)
+		owner.ID = id.New(*r.UserID)
+	}
+
+	return &entities.Note{
+		CreatedAt: time.Time{},
+		UpdatedAt: time.Time{},
+		Owner:     owner,
+		DeletedAt: &deletedAt,
+		Notebook:  nil,
+		Title:     "",
+		Content:   "",
+		Tags:      nil,
+		ID:        id.New(r.ID),
+		Version:   0,
+		Pinned:    false,
+		Archived:  false,
+		Starred:   false,
+	}
+}
+
+func NewInsertNoteAttachmentParams(attachment *entities.Attachment) *InsertNoteAttachmentParams {
+	return &InsertNoteAttachmentParams{
+		NoteID:      attachment.Note.ID.Value(),
+		UserID:      attachment.Owner.ID.ValuePtr(),
+		Name:        attachment.Name,
+		ContentType: attachment.ContentType,
+		BlobKey:     attachment.Key,
+		Size:        attachment.Size,
+		Checksum:    attachment.Checksum,
+		CreatedAt:   attachment.CreatedAt,
+	}
+}
+
+func NewInsertEventsParams(events []*entities.Event) *InsertEventsParams {
+	params := &InsertEventsParams{
+		EventIds:     make([]uuid.UUID, len(events)),
+		EventTypes:   make([]int16, len(events)),
+		NoteIds:      make([]int64, len(events)),
+		RecipientIds: make([]int64, len(events)),
+		EventTimes:   make([]time.Time, len(events)),
+	}
+
+	for i, event := range events {
+		params.EventIds[i] = uuid.MustParse(event.ID.Value())
+		params.EventTypes[i] = int16(event.EventType) //nolint:gosec // allowed conversation
+		params.NoteIds[i] = event.Note.ID.Value()
+		params.RecipientIds[i] = event.Recipient.ID.Value()
+		params.EventTimes[i] = event.EventTime
+	}
+
+	return params
+}
+
+func NewUpdateOutboxEventsAttemptsParams(
+	events []*entities.Event,
+	reason string,
+	backoff, maxBackoff time.Duration,
+) *UpdateOutboxEventsAttemptsParams {
+	return &UpdateOutboxEventsAttemptsParams{
+		LastError:  &reason,
+		MaxBackoff: pgtype.Interval{Microseconds: maxBackoff.Microseconds(), Days: 0, Months: 0, Valid: true},
+		Backoff:    pgtype.Interval{Microseconds: backoff.Microseconds(), Days: 0, Months: 0, Valid: true},
+		EventIds:   EventIDs(events),
+	}
+}
+
+func EventIDs(events []*entities.Event) []uuid.UUID {
+	ids := make([]uuid.UUID, len(events))
+	for i, event := range events {
+		ids[i] = uuid.MustParse(event.ID.Value())
+	}
+
+	return ids
+}
+
+func (e *EventOutbox) ToEntity() *entities.Event {
+	note := new(entities.Note)
+	note.ID = id.New(e.NoteID)
+
+	recipient := new(entities.User)
+	recipient.ID = id.New(e.RecipientID)
+
+	return &entities.Event{
+		EventTime: e.EventTime,
+		ReadAt:    nil,
+		Note:      note,
+		Recipient: recipient,
+		Position:  "",
+		ID:        domuuid.Conv(e.EventID.String()),
+		EventType: entities.EventType(e.EventType),
+	}
+}
+
+type EventOutboxes []*EventOutbox
+
+func (e EventOutboxes) ToEntities() []*entities.Event {
+	events := make([]*entities.Event, len(e))
+	for i, event := range e {
+		events[i] = event.ToEntity()
+	}
+
+	return events
+}
+
+func NewInsertNoteReminderParams(reminder *entities.Reminder) *InsertNoteReminderParams {
+	return &InsertNoteReminderParams{
+		NoteID:     reminder.Note.ID.Value(),
+		UserID:     reminder.User.ID.Value(),
+		FireAt:     reminder.FireAt,
+		Recurrence: Recurrence(reminder.Recurrence),
+		TimeZone:   reminder.TimeZone,
+		CreatedAt:  reminder.CreatedAt,
+		UpdatedAt:  reminder.UpdatedAt,
+	}
+}
+
+func NewUpdateNoteReminderParams(reminder *entities.Reminder) *UpdateNoteReminderParams {
+	return &UpdateNoteReminderParams{
+		FireAt:      reminder.FireAt,
+		FiredAt:     reminder.FiredAt,
+		Recurrence:  Recurrence(reminder.Recurrence),
+		TimeZone:    reminder.TimeZone,
+		Occurrences: reminder.Occurrences,
+		UpdatedAt:   reminder.UpdatedAt,
+		ID:          reminder.ID.Value(),
+	}
+}
+
+// Recurrence returns the rule as it is stored, reminders firing once have no rule.
+func Recurrence(rule *rrule.Rule) string {
+	if rule == nil {
+		return ""
+	}
+
+	return rule.String()
+}
+
+func (r *NoteReminder) ToEntity() *entities.Reminder {
+	note := new(entities.Note)
+	note.ID = id.New(r.NoteID)
+
+	user := new(entities.User)
+	user.ID = id.New(r.UserID)
+
+	return &entities.Reminder{
+		CreatedAt:   r.CreatedAt,
+		UpdatedAt:   r.UpdatedAt,
+		FireAt:      r.FireAt,
+		FiredAt:     r.FiredAt,
+		Note:        note,
+		User:        user,
+		Recurrence:  setRecurrence(r.Recurrence),
+		TimeZone:    r.TimeZone,
+		ID:          id.New(r.ID),
+		Occurrences: r.Occurrences,
+	}
+}
+
+type NoteReminders []*NoteReminder
+
+func (r NoteReminders) ToEntities() []*entities.Reminder {
+	reminders := make([]*entities.Reminder, len(r))
+	for i, reminder := range r {
+		reminders[i] = reminder.ToEntity()
+	}
+
+	return reminders
+}
+
+// setRecurrence reads the stored rule, reminders firing once have none.
+func setRecurrence(text string) *rrule.Rule {
+	rule, err := rrule.Parse(text)
+	if err != nil {
+		return nil
+	}
+
+	return rule
+}
+
+func NewInsertNoteTemplateParams(template *entities.Template) *InsertNoteTemplateParams {
+	return &InsertNoteTemplateParams{
+		UserID:    template.Owner.ID.Value(),
+		Name:      template.Name,
+		Title:     template.Title,
+		Content:   template.Content,
+		CreatedAt: template.CreatedAt,
+		UpdatedAt: template.UpdatedAt,
+	}
+}
+
+func NewUpdateNoteTemplateParams(template *entities.Template) *UpdateNoteTemplateParams {
+	return &UpdateNoteTemplateParams{
+		Name:      template.Name,
+		Title:     template.Title,
+		Content:   template.Content,
+		UpdatedAt: template.UpdatedAt,
+		ID:        template.ID.Value(),
+	}
+}
+
+func NewInsertNoteReferencesParams(note *entities.Note, references []*entities.Reference) *InsertNoteReferencesParams {
+	params := &InsertNoteReferencesParams{
+		SourceID:     note.ID.Value(),
+		Positions:    make([]int32, len(references)),
+		TargetIds:    make([]int64, len(references)),
+		TargetTitles: make([]string, len(references)),
+	}
+
+	for i, reference := range references {
+		params.Positions[i] = reference.Position
+		params.TargetIds[i] = reference.Link.ID
+		params.TargetTitles[i] = reference.Link.Title
+	}
+
+	return params
+}
@@ -0,0 +1,21 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: delete_blob_tombstones.sql
+
+package commands
+
+import (
+	"context"
+)
+
+const deleteBlobTombstones = `-- name: DeleteBlobTombstones :exec
+DELETE
+FROM blob_tombstones
+WHERE blob_key = ANY ($1::VARCHAR[])
+`
+
+func (q *Queries) DeleteBlobTombstones(ctx context.Context, blobKeys []string) error {
+	_, err := q.db.Exec(ctx, deleteBlobTombstones, blobKeys)
+	return err
+}
@@ -9,13 +9,22 @@ import (
 	"context"
 )
 
-const deleteNote = `-- name: DeleteNote :exec
+const deleteNote = `-- name: DeleteNote :execrows
 DELETE
 FROM notes
 WHERE id = $1
+  AND version = $2
 `
 
-func (q *Queries) DeleteNote(ctx context.Context, id int64) error {
-	_, err := q.db.Exec(ctx, deleteNote, id)
-	return err
+type DeleteNoteParams struct {
+	ID      int64 `db:"id"`
+	Version int64 `db:"version"`
+}
+
+func (q *Queries) DeleteNote(ctx context.Context, arg *DeleteNoteParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteNote, arg.ID, arg.Version)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
 }
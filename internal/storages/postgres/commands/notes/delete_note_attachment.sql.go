@@ -0,0 +1,30 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: delete_note_attachment.sql
+
+package commands
+
+import (
+	"context"
+)
+
+const deleteNoteAttachment = `-- name: DeleteNoteAttachment :execrows
+WITH deleted AS (
+    DELETE
+    FROM note_attachments
+    WHERE id = $1
+    RETURNING blob_key)
+INSERT
+INTO blob_tombstones (blob_key)
+SELECT blob_key
+FROM deleted
+`
+
+func (q *Queries) DeleteNoteAttachment(ctx context.Context, id int64) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteNoteAttachment, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
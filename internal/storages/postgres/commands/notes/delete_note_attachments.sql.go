@@ -0,0 +1,27 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: delete_note_attachments.sql
+
+package commands
+
+import (
+	"context"
+)
+
+const deleteNoteAttachments = `-- name: DeleteNoteAttachments :exec
+WITH deleted AS (
+    DELETE
+    FROM note_attachments
+    WHERE note_id = $1
+    RETURNING blob_key)
+INSERT
+INTO blob_tombstones (blob_key)
+SELECT blob_key
+FROM deleted
+`
+
+func (q *Queries) DeleteNoteAttachments(ctx context.Context, noteID int64) error {
+	_, err := q.db.Exec(ctx, deleteNoteAttachments, noteID)
+	return err
+}
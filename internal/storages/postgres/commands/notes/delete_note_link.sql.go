@@ -0,0 +1,30 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: delete_note_link.sql
+
+package commands
+
+import (
+	"context"
+)
+
+const deleteNoteLink = `-- name: DeleteNoteLink :execrows
+DELETE
+FROM note_links
+WHERE note_id = $1
+  AND token = $2
+`
+
+type DeleteNoteLinkParams struct {
+	NoteID int64  `db:"note_id"`
+	Token  string `db:"token"`
+}
+
+func (q *Queries) DeleteNoteLink(ctx context.Context, arg *DeleteNoteLinkParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteNoteLink, arg.NoteID, arg.Token)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
@@ -0,0 +1,21 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: delete_note_references.sql
+
+package commands
+
+import (
+	"context"
+)
+
+const deleteNoteReferences = `-- name: DeleteNoteReferences :exec
+DELETE
+FROM note_references
+WHERE source_id = $1
+`
+
+func (q *Queries) DeleteNoteReferences(ctx context.Context, sourceID int64) error {
+	_, err := q.db.Exec(ctx, deleteNoteReferences, sourceID)
+	return err
+}
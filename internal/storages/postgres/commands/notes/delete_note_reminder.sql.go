@@ -0,0 +1,24 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: delete_note_reminder.sql
+
+package commands
+
+import (
+	"context"
+)
+
+const deleteNoteReminder = `-- name: DeleteNoteReminder :execrows
+DELETE
+FROM note_reminders
+WHERE id = $1
+`
+
+func (q *Queries) DeleteNoteReminder(ctx context.Context, id int64) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteNoteReminder, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
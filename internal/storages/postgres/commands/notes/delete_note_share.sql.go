@@ -0,0 +1,30 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: delete_note_share.sql
+
+package commands
+
+import (
+	"context"
+)
+
+const deleteNoteShare = `-- name: DeleteNoteShare :execrows
+DELETE
+FROM note_shares
+WHERE note_id = $1
+  AND user_id = $2
+`
+
+type DeleteNoteShareParams struct {
+	NoteID int64 `db:"note_id"`
+	UserID int64 `db:"user_id"`
+}
+
+func (q *Queries) DeleteNoteShare(ctx context.Context, arg *DeleteNoteShareParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteNoteShare, arg.NoteID, arg.UserID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
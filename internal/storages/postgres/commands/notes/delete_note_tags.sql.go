@@ -0,0 +1,29 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: delete_note_tags.sql
+
+package commands
+
+import (
+	"context"
+)
+
+const deleteNoteTags = `-- name: DeleteNoteTags :exec
+DELETE
+FROM note_tags
+    USING tags
+WHERE note_tags.tag_id = tags.id
+  AND note_tags.note_id = $1
+  AND tags.name = ANY ($2::text[])
+`
+
+type DeleteNoteTagsParams struct {
+	NoteID int64    `db:"note_id"`
+	Names  []string `db:"names"`
+}
+
+func (q *Queries) DeleteNoteTags(ctx context.Context, arg *DeleteNoteTagsParams) error {
+	_, err := q.db.Exec(ctx, deleteNoteTags, arg.NoteID, arg.Names)
+	return err
+}
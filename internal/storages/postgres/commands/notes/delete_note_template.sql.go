@@ -0,0 +1,21 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: delete_note_template.sql
+
+package commands
+
+import (
+	"context"
+)
+
+const deleteNoteTemplate = `-- name: DeleteNoteTemplate :exec
+DELETE
+FROM note_templates
+WHERE id = $1
+`
+
+func (q *Queries) DeleteNoteTemplate(ctx context.Context, id int64) error {
+	_, err := q.db.Exec(ctx, deleteNoteTemplate, id)
+	return err
+}
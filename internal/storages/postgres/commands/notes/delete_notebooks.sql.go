@@ -0,0 +1,21 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: delete_notebooks.sql
+
+package commands
+
+import (
+	"context"
+)
+
+const deleteNotebooks = `-- name: DeleteNotebooks :exec
+DELETE
+FROM notebooks
+WHERE id = ANY ($1::bigint[])
+`
+
+func (q *Queries) DeleteNotebooks(ctx context.Context, ids []int64) error {
+	_, err := q.db.Exec(ctx, deleteNotebooks, ids)
+	return err
+}
@@ -0,0 +1,23 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: delete_outbox_events.sql
+
+package commands
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+const deleteOutboxEvents = `-- name: DeleteOutboxEvents :exec
+DELETE
+FROM event_outbox
+WHERE event_id = ANY ($1::UUID[])
+`
+
+func (q *Queries) DeleteOutboxEvents(ctx context.Context, eventIds []uuid.UUID) error {
+	_, err := q.db.Exec(ctx, deleteOutboxEvents, eventIds)
+	return err
+}
@@ -0,0 +1,21 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: delete_tag.sql
+
+package commands
+
+import (
+	"context"
+)
+
+const deleteTag = `-- name: DeleteTag :exec
+DELETE
+FROM tags
+WHERE id = $1
+`
+
+func (q *Queries) DeleteTag(ctx context.Context, id int64) error {
+	_, err := q.db.Exec(ctx, deleteTag, id)
+	return err
+}
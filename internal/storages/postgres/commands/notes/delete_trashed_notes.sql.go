@@ -0,0 +1,43 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: delete_trashed_notes.sql
+
+package commands
+
+import (
+	"context"
+	"time"
+)
+
+const deleteTrashedNotes = `-- name: DeleteTrashedNotes :many
+DELETE
+FROM notes
+WHERE deleted_at < $1
+RETURNING id, user_id
+`
+
+type DeleteTrashedNotesRow struct {
+	ID     int64  `db:"id"`
+	UserID *int64 `db:"user_id"`
+}
+
+func (q *Queries) DeleteTrashedNotes(ctx context.Context, deletedBefore *time.Time) ([]*DeleteTrashedNotesRow, error) {
+	rows, err := q.db.Query(ctx, deleteTrashedNotes, deletedBefore)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*DeleteTrashedNotesRow
+	for rows.Next() {
+		var i DeleteTrashedNotesRow
+		if err := rows.Scan(&i.ID, &i.UserID); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -0,0 +1,28 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: delete_trashed_notes_attachments.sql
+
+package commands
+
+import (
+	"context"
+	"time"
+)
+
+const deleteTrashedNotesAttachments = `-- name: DeleteTrashedNotesAttachments :exec
+WITH deleted AS (
+    DELETE
+    FROM note_attachments
+    WHERE note_id IN (SELECT id FROM notes WHERE deleted_at < $1)
+    RETURNING blob_key)
+INSERT
+INTO blob_tombstones (blob_key)
+SELECT blob_key
+FROM deleted
+`
+
+func (q *Queries) DeleteTrashedNotesAttachments(ctx context.Context, deletedBefore *time.Time) error {
+	_, err := q.db.Exec(ctx, deleteTrashedNotesAttachments, deletedBefore)
+	return err
+}
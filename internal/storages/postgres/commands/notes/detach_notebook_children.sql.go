@@ -0,0 +1,28 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: detach_notebook_children.sql
+
+package commands
+
+import (
+	"context"
+	"time"
+)
+
+const detachNotebookChildren = `-- name: DetachNotebookChildren :exec
+UPDATE notebooks
+SET parent_id  = NULL,
+    updated_at = $1
+WHERE parent_id = $2
+`
+
+type DetachNotebookChildrenParams struct {
+	UpdatedAt time.Time `db:"updated_at"`
+	ParentID  *int64    `db:"parent_id"`
+}
+
+func (q *Queries) DetachNotebookChildren(ctx context.Context, arg *DetachNotebookChildrenParams) error {
+	_, err := q.db.Exec(ctx, detachNotebookChildren, arg.UpdatedAt, arg.ParentID)
+	return err
+}
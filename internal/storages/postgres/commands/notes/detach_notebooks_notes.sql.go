@@ -0,0 +1,22 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: detach_notebooks_notes.sql
+
+package commands
+
+import (
+	"context"
+)
+
+const detachNotebooksNotes = `-- name: DetachNotebooksNotes :exec
+UPDATE notes
+SET notebook_id = NULL,
+    version     = version + 1
+WHERE notebook_id = ANY ($1::bigint[])
+`
+
+func (q *Queries) DetachNotebooksNotes(ctx context.Context, notebookIds []int64) error {
+	_, err := q.db.Exec(ctx, detachNotebooksNotes, notebookIds)
+	return err
+}
@@ -0,0 +1,52 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: insert_events.sql
+
+package commands
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const insertEvents = `-- name: InsertEvents :exec
+WITH events AS (SELECT unnest($1::UUID[])            AS event_id,
+                       unnest($2::SMALLINT[])      AS event_type,
+                       unnest($3::BIGINT[])           AS note_id,
+                       unnest($4::BIGINT[])      AS recipient_id,
+                       unnest($5::TIMESTAMPTZ[])   AS event_time),
+     history AS (INSERT INTO note_events (id, event_type, note_id, recipient_id, event_time)
+         SELECT event_id, event_type, note_id, recipient_id, event_time FROM events),
+     deliveries AS (INSERT INTO webhook_deliveries (webhook_id, event_id, event_type, note_id, recipient_id, event_time)
+         SELECT w.id, e.event_id, e.event_type, e.note_id, e.recipient_id, e.event_time
+         FROM events e
+                  JOIN webhooks w ON w.user_id = e.recipient_id
+         WHERE w.disabled_at IS NULL
+           AND (cardinality(w.event_types) = 0 OR e.event_type = ANY (w.event_types)))
+INSERT
+INTO event_outbox (event_id, event_type, note_id, recipient_id, event_time)
+SELECT event_id, event_type, note_id, recipient_id, event_time
+FROM events
+`
+
+type InsertEventsParams struct {
+	EventIds     []uuid.UUID `db:"event_ids"`
+	EventTypes   []int16     `db:"event_types"`
+	NoteIds      []int64     `db:"note_ids"`
+	RecipientIds []int64     `db:"recipient_ids"`
+	EventTimes   []time.Time `db:"event_times"`
+}
+
+func (q *Queries) InsertEvents(ctx context.Context, arg *InsertEventsParams) error {
+	_, err := q.db.Exec(ctx, insertEvents,
+		arg.EventIds,
+		arg.EventTypes,
+		arg.NoteIds,
+		arg.RecipientIds,
+		arg.EventTimes,
+	)
+	return err
+}
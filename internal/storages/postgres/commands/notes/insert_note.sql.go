@@ -11,17 +11,18 @@ import (
 )
 
 const insertNote = `-- name: InsertNote :one
-INSERT INTO notes (title, content, user_id, created_at, updated_at)
-VALUES ($1, $2, $3, $4, $5)
+INSERT INTO notes (title, content, user_id, notebook_id, created_at, updated_at)
+VALUES ($1, $2, $3, $4, $5, $6)
 RETURNING id
 `
 
 type InsertNoteParams struct {
-	Title     string    `db:"title"`
-	Content   string    `db:"content"`
-	UserID    *int64    `db:"user_id"`
-	CreatedAt time.Time `db:"created_at"`
-	UpdatedAt time.Time `db:"updated_at"`
+	Title      string    `db:"title"`
+	Content    string    `db:"content"`
+	UserID     *int64    `db:"user_id"`
+	NotebookID *int64    `db:"notebook_id"`
+	CreatedAt  time.Time `db:"created_at"`
+	UpdatedAt  time.Time `db:"updated_at"`
 }
 
 func (q *Queries) InsertNote(ctx context.Context, arg *InsertNoteParams) (int64, error) {
@@ -29,6 +30,7 @@ func (q *Queries) InsertNote(ctx context.Context, arg *InsertNoteParams) (int64,
 		arg.Title,
 		arg.Content,
 		arg.UserID,
+		arg.NotebookID,
 		arg.CreatedAt,
 		arg.UpdatedAt,
 	)
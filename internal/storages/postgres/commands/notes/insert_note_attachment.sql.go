@@ -0,0 +1,44 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: insert_note_attachment.sql
+
+package commands
+
+import (
+	"context"
+	"time"
+)
+
+const insertNoteAttachment = `-- name: InsertNoteAttachment :one
+INSERT INTO note_attachments (note_id, user_id, name, content_type, blob_key, size, checksum, created_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+RETURNING id
+`
+
+type InsertNoteAttachmentParams struct {
+	NoteID      int64     `db:"note_id"`
+	UserID      *int64    `db:"user_id"`
+	Name        string    `db:"name"`
+	ContentType string    `db:"content_type"`
+	BlobKey     string    `db:"blob_key"`
+	Size        int64     `db:"size"`
+	Checksum    string    `db:"checksum"`
+	CreatedAt   time.Time `db:"created_at"`
+}
+
+func (q *Queries) InsertNoteAttachment(ctx context.Context, arg *InsertNoteAttachmentParams) (int64, error) {
+	row := q.db.QueryRow(ctx, insertNoteAttachment,
+		arg.NoteID,
+		arg.UserID,
+		arg.Name,
+		arg.ContentType,
+		arg.BlobKey,
+		arg.Size,
+		arg.Checksum,
+		arg.CreatedAt,
+	)
+	var id int64
+	err := row.Scan(&id)
+	return id, err
+}
@@ -0,0 +1,36 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: insert_note_link.sql
+
+package commands
+
+import (
+	"context"
+	"time"
+)
+
+const insertNoteLink = `-- name: InsertNoteLink :one
+INSERT INTO note_links (note_id, token, expires_at, created_at)
+VALUES ($1, $2, $3, $4)
+RETURNING id
+`
+
+type InsertNoteLinkParams struct {
+	NoteID    int64      `db:"note_id"`
+	Token     string     `db:"token"`
+	ExpiresAt *time.Time `db:"expires_at"`
+	CreatedAt time.Time  `db:"created_at"`
+}
+
+func (q *Queries) InsertNoteLink(ctx context.Context, arg *InsertNoteLinkParams) (int64, error) {
+	row := q.db.QueryRow(ctx, insertNoteLink,
+		arg.NoteID,
+		arg.Token,
+		arg.ExpiresAt,
+		arg.CreatedAt,
+	)
+	var id int64
+	err := row.Scan(&id)
+	return id, err
+}
@@ -0,0 +1,37 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: insert_note_references.sql
+
+package commands
+
+import (
+	"context"
+)
+
+const insertNoteReferences = `-- name: InsertNoteReferences :exec
+WITH refs AS (SELECT unnest($2::INTEGER[])   AS position,
+                     unnest($3::BIGINT[])   AS target_id,
+                     unnest($4::TEXT[])  AS target_title)
+INSERT
+INTO note_references (source_id, position, target_id, target_title)
+SELECT $1, position, NULLIF(target_id, 0), NULLIF(target_title, '')
+FROM refs
+`
+
+type InsertNoteReferencesParams struct {
+	SourceID     int64    `db:"source_id"`
+	Positions    []int32  `db:"positions"`
+	TargetIds    []int64  `db:"target_ids"`
+	TargetTitles []string `db:"target_titles"`
+}
+
+func (q *Queries) InsertNoteReferences(ctx context.Context, arg *InsertNoteReferencesParams) error {
+	_, err := q.db.Exec(ctx, insertNoteReferences,
+		arg.SourceID,
+		arg.Positions,
+		arg.TargetIds,
+		arg.TargetTitles,
+	)
+	return err
+}
@@ -0,0 +1,42 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: insert_note_reminder.sql
+
+package commands
+
+import (
+	"context"
+	"time"
+)
+
+const insertNoteReminder = `-- name: InsertNoteReminder :one
+INSERT INTO note_reminders (note_id, user_id, fire_at, recurrence, time_zone, created_at, updated_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+RETURNING id
+`
+
+type InsertNoteReminderParams struct {
+	NoteID     int64      `db:"note_id"`
+	UserID     int64      `db:"user_id"`
+	FireAt     *time.Time `db:"fire_at"`
+	Recurrence string     `db:"recurrence"`
+	TimeZone   string     `db:"time_zone"`
+	CreatedAt  time.Time  `db:"created_at"`
+	UpdatedAt  time.Time  `db:"updated_at"`
+}
+
+func (q *Queries) InsertNoteReminder(ctx context.Context, arg *InsertNoteReminderParams) (int64, error) {
+	row := q.db.QueryRow(ctx, insertNoteReminder,
+		arg.NoteID,
+		arg.UserID,
+		arg.FireAt,
+		arg.Recurrence,
+		arg.TimeZone,
+		arg.CreatedAt,
+		arg.UpdatedAt,
+	)
+	var id int64
+	err := row.Scan(&id)
+	return id, err
+}
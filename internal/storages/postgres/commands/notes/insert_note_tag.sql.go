@@ -0,0 +1,26 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: insert_note_tag.sql
+
+package commands
+
+import (
+	"context"
+)
+
+const insertNoteTag = `-- name: InsertNoteTag :exec
+INSERT INTO note_tags (note_id, tag_id)
+VALUES ($1, $2)
+ON CONFLICT DO NOTHING
+`
+
+type InsertNoteTagParams struct {
+	NoteID int64 `db:"note_id"`
+	TagID  int64 `db:"tag_id"`
+}
+
+func (q *Queries) InsertNoteTag(ctx context.Context, arg *InsertNoteTagParams) error {
+	_, err := q.db.Exec(ctx, insertNoteTag, arg.NoteID, arg.TagID)
+	return err
+}
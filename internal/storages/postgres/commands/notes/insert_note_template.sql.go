@@ -0,0 +1,40 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: insert_note_template.sql
+
+package commands
+
+import (
+	"context"
+	"time"
+)
+
+const insertNoteTemplate = `-- name: InsertNoteTemplate :one
+INSERT INTO note_templates (user_id, name, title, content, created_at, updated_at)
+VALUES ($1, $2, $3, $4, $5, $6)
+RETURNING id
+`
+
+type InsertNoteTemplateParams struct {
+	UserID    int64     `db:"user_id"`
+	Name      string    `db:"name"`
+	Title     string    `db:"title"`
+	Content   string    `db:"content"`
+	CreatedAt time.Time `db:"created_at"`
+	UpdatedAt time.Time `db:"updated_at"`
+}
+
+func (q *Queries) InsertNoteTemplate(ctx context.Context, arg *InsertNoteTemplateParams) (int64, error) {
+	row := q.db.QueryRow(ctx, insertNoteTemplate,
+		arg.UserID,
+		arg.Name,
+		arg.Title,
+		arg.Content,
+		arg.CreatedAt,
+		arg.UpdatedAt,
+	)
+	var id int64
+	err := row.Scan(&id)
+	return id, err
+}
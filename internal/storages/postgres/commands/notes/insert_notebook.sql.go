@@ -0,0 +1,38 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: insert_notebook.sql
+
+package commands
+
+import (
+	"context"
+	"time"
+)
+
+const insertNotebook = `-- name: InsertNotebook :one
+INSERT INTO notebooks (user_id, parent_id, name, created_at, updated_at)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING id
+`
+
+type InsertNotebookParams struct {
+	UserID    int64     `db:"user_id"`
+	ParentID  *int64    `db:"parent_id"`
+	Name      string    `db:"name"`
+	CreatedAt time.Time `db:"created_at"`
+	UpdatedAt time.Time `db:"updated_at"`
+}
+
+func (q *Queries) InsertNotebook(ctx context.Context, arg *InsertNotebookParams) (int64, error) {
+	row := q.db.QueryRow(ctx, insertNotebook,
+		arg.UserID,
+		arg.ParentID,
+		arg.Name,
+		arg.CreatedAt,
+		arg.UpdatedAt,
+	)
+	var id int64
+	err := row.Scan(&id)
+	return id, err
+}
@@ -0,0 +1,45 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: insert_revision.sql
+
+package commands
+
+import (
+	"context"
+	"time"
+)
+
+const insertRevision = `-- name: InsertRevision :one
+INSERT INTO note_revisions (note_id, revision, title, content, user_id, created_at)
+VALUES ($1,
+        (SELECT coalesce(max(revision), 0) + 1 FROM note_revisions WHERE note_id = $1),
+        $2, $3, $4, $5)
+RETURNING id, revision
+`
+
+type InsertRevisionParams struct {
+	NoteID    int64     `db:"note_id"`
+	Title     string    `db:"title"`
+	Content   string    `db:"content"`
+	UserID    *int64    `db:"user_id"`
+	CreatedAt time.Time `db:"created_at"`
+}
+
+type InsertRevisionRow struct {
+	ID       int64 `db:"id"`
+	Revision int32 `db:"revision"`
+}
+
+func (q *Queries) InsertRevision(ctx context.Context, arg *InsertRevisionParams) (*InsertRevisionRow, error) {
+	row := q.db.QueryRow(ctx, insertRevision,
+		arg.NoteID,
+		arg.Title,
+		arg.Content,
+		arg.UserID,
+		arg.CreatedAt,
+	)
+	var i InsertRevisionRow
+	err := row.Scan(&i.ID, &i.Revision)
+	return &i, err
+}
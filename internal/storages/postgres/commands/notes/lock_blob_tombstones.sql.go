@@ -0,0 +1,37 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: lock_blob_tombstones.sql
+
+package commands
+
+import (
+	"context"
+)
+
+const lockBlobTombstones = `-- name: LockBlobTombstones :many
+SELECT blob_key
+FROM blob_tombstones
+ORDER BY buried_at, blob_key
+LIMIT $1 FOR UPDATE SKIP LOCKED
+`
+
+func (q *Queries) LockBlobTombstones(ctx context.Context, maxCount int32) ([]string, error) {
+	rows, err := q.db.Query(ctx, lockBlobTombstones, maxCount)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []string
+	for rows.Next() {
+		var blob_key string
+		if err := rows.Scan(&blob_key); err != nil {
+			return nil, err
+		}
+		items = append(items, blob_key)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -0,0 +1,49 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: lock_due_note_reminders.sql
+
+package commands
+
+import (
+	"context"
+)
+
+const lockDueNoteReminders = `-- name: LockDueNoteReminders :many
+SELECT id, note_id, user_id, fire_at, fired_at, recurrence, time_zone, occurrences, created_at, updated_at
+FROM note_reminders
+WHERE fire_at <= now()
+ORDER BY fire_at, id
+LIMIT $1 FOR UPDATE SKIP LOCKED
+`
+
+func (q *Queries) LockDueNoteReminders(ctx context.Context, maxCount int32) ([]*NoteReminder, error) {
+	rows, err := q.db.Query(ctx, lockDueNoteReminders, maxCount)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*NoteReminder
+	for rows.Next() {
+		var i NoteReminder
+		if err := rows.Scan(
+			&i.ID,
+			&i.NoteID,
+			&i.UserID,
+			&i.FireAt,
+			&i.FiredAt,
+			&i.Recurrence,
+			&i.TimeZone,
+			&i.Occurrences,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -0,0 +1,30 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: lock_note.sql
+
+package commands
+
+import (
+	"context"
+)
+
+const lockNote = `-- name: LockNote :one
+SELECT version
+FROM notes
+WHERE id = $1
+  AND version = $2
+FOR UPDATE
+`
+
+type LockNoteParams struct {
+	ID      int64 `db:"id"`
+	Version int64 `db:"version"`
+}
+
+func (q *Queries) LockNote(ctx context.Context, arg *LockNoteParams) (int64, error) {
+	row := q.db.QueryRow(ctx, lockNote, arg.ID, arg.Version)
+	var version int64
+	err := row.Scan(&version)
+	return version, err
+}
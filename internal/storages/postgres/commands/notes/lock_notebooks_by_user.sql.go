@@ -0,0 +1,22 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: lock_notebooks_by_user.sql
+
+package commands
+
+import (
+	"context"
+)
+
+const lockNotebooksByUser = `-- name: LockNotebooksByUser :exec
+SELECT id
+FROM notebooks
+WHERE user_id = $1
+FOR UPDATE
+`
+
+func (q *Queries) LockNotebooksByUser(ctx context.Context, userID int64) error {
+	_, err := q.db.Exec(ctx, lockNotebooksByUser, userID)
+	return err
+}
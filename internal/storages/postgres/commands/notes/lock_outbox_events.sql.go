@@ -0,0 +1,48 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: lock_outbox_events.sql
+
+package commands
+
+import (
+	"context"
+)
+
+const lockOutboxEvents = `-- name: LockOutboxEvents :many
+SELECT event_id, event_type, note_id, recipient_id, event_time, attempts, last_error, available_at, created_at
+FROM event_outbox
+WHERE available_at <= now()
+ORDER BY created_at, event_id
+LIMIT $1 FOR UPDATE SKIP LOCKED
+`
+
+func (q *Queries) LockOutboxEvents(ctx context.Context, maxCount int32) ([]*EventOutbox, error) {
+	rows, err := q.db.Query(ctx, lockOutboxEvents, maxCount)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*EventOutbox
+	for rows.Next() {
+		var i EventOutbox
+		if err := rows.Scan(
+			&i.EventID,
+			&i.EventType,
+			&i.NoteID,
+			&i.RecipientID,
+			&i.EventTime,
+			&i.Attempts,
+			&i.LastError,
+			&i.AvailableAt,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -0,0 +1,28 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: merge_note_tags.sql
+
+package commands
+
+import (
+	"context"
+)
+
+const mergeNoteTags = `-- name: MergeNoteTags :exec
+INSERT INTO note_tags (note_id, tag_id)
+SELECT source.note_id, $1::bigint
+FROM note_tags AS source
+WHERE source.tag_id = $2::bigint
+ON CONFLICT DO NOTHING
+`
+
+type MergeNoteTagsParams struct {
+	IntoID int64 `db:"into_id"`
+	FromID int64 `db:"from_id"`
+}
+
+func (q *Queries) MergeNoteTags(ctx context.Context, arg *MergeNoteTagsParams) error {
+	_, err := q.db.Exec(ctx, mergeNoteTags, arg.IntoID, arg.FromID)
+	return err
+}
@@ -3,3 +3,34 @@
 //   sqlc v1.30.0
 
 package commands
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type EventOutbox struct {
+	EventID     uuid.UUID `db:"event_id"`
+	EventType   int16     `db:"event_type"`
+	NoteID      int64     `db:"note_id"`
+	RecipientID int64     `db:"recipient_id"`
+	EventTime   time.Time `db:"event_time"`
+	Attempts    int32     `db:"attempts"`
+	LastError   *string   `db:"last_error"`
+	AvailableAt time.Time `db:"available_at"`
+	CreatedAt   time.Time `db:"created_at"`
+}
+
+type NoteReminder struct {
+	ID          int64      `db:"id"`
+	NoteID      int64      `db:"note_id"`
+	UserID      int64      `db:"user_id"`
+	FireAt      *time.Time `db:"fire_at"`
+	FiredAt     *time.Time `db:"fired_at"`
+	Recurrence  string     `db:"recurrence"`
+	TimeZone    string     `db:"time_zone"`
+	Occurrences int32      `db:"occurrences"`
+	CreatedAt   time.Time  `db:"created_at"`
+	UpdatedAt   time.Time  `db:"updated_at"`
+}
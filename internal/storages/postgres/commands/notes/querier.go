@@ -6,11 +6,59 @@ package commands
 
 import (
 	"context"
+	"time"
+
+	"github.com/google/uuid"
 )
 
 type Querier interface {
-	DeleteNote(ctx context.Context, id int64) error
+	DeleteBlobTombstones(ctx context.Context, blobKeys []string) error
+	DeleteNote(ctx context.Context, arg *DeleteNoteParams) (int64, error)
+	DeleteNoteAttachment(ctx context.Context, id int64) (int64, error)
+	DeleteNoteAttachments(ctx context.Context, noteID int64) error
+	DeleteNoteLink(ctx context.Context, arg *DeleteNoteLinkParams) (int64, error)
+	DeleteNoteReferences(ctx context.Context, sourceID int64) error
+	DeleteNoteReminder(ctx context.Context, id int64) (int64, error)
+	DeleteNoteShare(ctx context.Context, arg *DeleteNoteShareParams) (int64, error)
+	DeleteNoteTags(ctx context.Context, arg *DeleteNoteTagsParams) error
+	DeleteNoteTemplate(ctx context.Context, id int64) error
+	DeleteNotebooks(ctx context.Context, ids []int64) error
+	DeleteOutboxEvents(ctx context.Context, eventIds []uuid.UUID) error
+	DeleteTag(ctx context.Context, id int64) error
+	DeleteTrashedNotes(ctx context.Context, deletedBefore *time.Time) ([]*DeleteTrashedNotesRow, error)
+	DeleteTrashedNotesAttachments(ctx context.Context, deletedBefore *time.Time) error
+	DetachNotebookChildren(ctx context.Context, arg *DetachNotebookChildrenParams) error
+	DetachNotebooksNotes(ctx context.Context, notebookIds []int64) error
+	InsertEvents(ctx context.Context, arg *InsertEventsParams) error
 	InsertNote(ctx context.Context, arg *InsertNoteParams) (int64, error)
+	InsertNoteAttachment(ctx context.Context, arg *InsertNoteAttachmentParams) (int64, error)
+	InsertNoteLink(ctx context.Context, arg *InsertNoteLinkParams) (int64, error)
+	InsertNoteReferences(ctx context.Context, arg *InsertNoteReferencesParams) error
+	InsertNoteReminder(ctx context.Context, arg *InsertNoteReminderParams) (int64, error)
+	InsertNoteTag(ctx context.Context, arg *InsertNoteTagParams) error
+	InsertNoteTemplate(ctx context.Context, arg *InsertNoteTemplateParams) (int64, error)
+	InsertNotebook(ctx context.Context, arg *InsertNotebookParams) (int64, error)
+	InsertRevision(ctx context.Context, arg *InsertRevisionParams) (*InsertRevisionRow, error)
+	LockBlobTombstones(ctx context.Context, maxCount int32) ([]string, error)
+	LockDueNoteReminders(ctx context.Context, maxCount int32) ([]*NoteReminder, error)
+	LockNote(ctx context.Context, arg *LockNoteParams) (int64, error)
+	LockNotebooksByUser(ctx context.Context, userID int64) error
+	LockOutboxEvents(ctx context.Context, maxCount int32) ([]*EventOutbox, error)
+	MergeNoteTags(ctx context.Context, arg *MergeNoteTagsParams) error
+	TrashNotebooksNotes(ctx context.Context, arg *TrashNotebooksNotesParams) ([]*TrashNotebooksNotesRow, error)
+	UpdateNote(ctx context.Context, arg *UpdateNoteParams) (int64, error)
+	UpdateNoteDeletedAt(ctx context.Context, arg *UpdateNoteDeletedAtParams) (int64, error)
+	UpdateNoteEventsReadAt(ctx context.Context, arg *UpdateNoteEventsReadAtParams) (int64, error)
+	UpdateNoteLinkViews(ctx context.Context, id int64) (int64, error)
+	UpdateNoteNotebook(ctx context.Context, arg *UpdateNoteNotebookParams) (int64, error)
+	UpdateNoteReminder(ctx context.Context, arg *UpdateNoteReminderParams) (int64, error)
+	UpdateNoteTemplate(ctx context.Context, arg *UpdateNoteTemplateParams) error
+	UpdateNoteVersion(ctx context.Context, arg *UpdateNoteVersionParams) (int64, error)
+	UpdateNotebook(ctx context.Context, arg *UpdateNotebookParams) error
+	UpdateOutboxEventsAttempts(ctx context.Context, arg *UpdateOutboxEventsAttemptsParams) error
+	UpdateTag(ctx context.Context, arg *UpdateTagParams) error
+	UpsertNoteShare(ctx context.Context, arg *UpsertNoteShareParams) (time.Time, error)
+	UpsertTag(ctx context.Context, arg *UpsertTagParams) (*UpsertTagRow, error)
 }
 
 var _ Querier = (*Queries)(nil)
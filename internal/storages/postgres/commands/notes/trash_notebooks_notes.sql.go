@@ -0,0 +1,50 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: trash_notebooks_notes.sql
+
+package commands
+
+import (
+	"context"
+	"time"
+)
+
+const trashNotebooksNotes = `-- name: TrashNotebooksNotes :many
+UPDATE notes
+SET deleted_at = $1,
+    version    = version + 1
+WHERE notebook_id = ANY ($2::bigint[])
+  AND deleted_at IS NULL
+RETURNING id, user_id
+`
+
+type TrashNotebooksNotesParams struct {
+	DeletedAt   *time.Time `db:"deleted_at"`
+	NotebookIds []int64    `db:"notebook_ids"`
+}
+
+type TrashNotebooksNotesRow struct {
+	ID     int64  `db:"id"`
+	UserID *int64 `db:"user_id"`
+}
+
+func (q *Queries) TrashNotebooksNotes(ctx context.Context, arg *TrashNotebooksNotesParams) ([]*TrashNotebooksNotesRow, error) {
+	rows, err := q.db.Query(ctx, trashNotebooksNotes, arg.DeletedAt, arg.NotebookIds)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*TrashNotebooksNotesRow
+	for rows.Next() {
+		var i TrashNotebooksNotesRow
+		if err := rows.Scan(&i.ID, &i.UserID); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
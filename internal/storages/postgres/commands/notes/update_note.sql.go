@@ -0,0 +1,52 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: update_note.sql
+
+package commands
+
+import (
+	"context"
+	"time"
+)
+
+const updateNote = `-- name: UpdateNote :one
+UPDATE notes
+SET title      = $1,
+    content    = $2,
+    pinned     = $3,
+    archived   = $4,
+    starred    = $5,
+    updated_at = $6,
+    version    = version + 1
+WHERE id = $7
+  AND version = $8
+RETURNING version
+`
+
+type UpdateNoteParams struct {
+	Title     string    `db:"title"`
+	Content   string    `db:"content"`
+	Pinned    bool      `db:"pinned"`
+	Archived  bool      `db:"archived"`
+	Starred   bool      `db:"starred"`
+	UpdatedAt time.Time `db:"updated_at"`
+	ID        int64     `db:"id"`
+	Version   int64     `db:"version"`
+}
+
+func (q *Queries) UpdateNote(ctx context.Context, arg *UpdateNoteParams) (int64, error) {
+	row := q.db.QueryRow(ctx, updateNote,
+		arg.Title,
+		arg.Content,
+		arg.Pinned,
+		arg.Archived,
+		arg.Starred,
+		arg.UpdatedAt,
+		arg.ID,
+		arg.Version,
+	)
+	var version int64
+	err := row.Scan(&version)
+	return version, err
+}
@@ -0,0 +1,33 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: update_note_deleted_at.sql
+
+package commands
+
+import (
+	"context"
+	"time"
+)
+
+const updateNoteDeletedAt = `-- name: UpdateNoteDeletedAt :one
+UPDATE notes
+SET deleted_at = $1,
+    version    = version + 1
+WHERE id = $2
+  AND version = $3
+RETURNING version
+`
+
+type UpdateNoteDeletedAtParams struct {
+	DeletedAt *time.Time `db:"deleted_at"`
+	ID        int64      `db:"id"`
+	Version   int64      `db:"version"`
+}
+
+func (q *Queries) UpdateNoteDeletedAt(ctx context.Context, arg *UpdateNoteDeletedAtParams) (int64, error) {
+	row := q.db.QueryRow(ctx, updateNoteDeletedAt, arg.DeletedAt, arg.ID, arg.Version)
+	var version int64
+	err := row.Scan(&version)
+	return version, err
+}
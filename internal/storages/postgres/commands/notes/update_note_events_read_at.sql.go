@@ -0,0 +1,35 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: update_note_events_read_at.sql
+
+package commands
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const updateNoteEventsReadAt = `-- name: UpdateNoteEventsReadAt :execrows
+UPDATE note_events
+SET read_at = now()
+WHERE recipient_id = $1
+  AND read_at IS NULL
+  AND (id = ANY ($2::UUID[]) OR event_time <= $3::TIMESTAMPTZ)
+`
+
+type UpdateNoteEventsReadAtParams struct {
+	RecipientID int64       `db:"recipient_id"`
+	Ids         []uuid.UUID `db:"ids"`
+	UpTo        *time.Time  `db:"up_to"`
+}
+
+func (q *Queries) UpdateNoteEventsReadAt(ctx context.Context, arg *UpdateNoteEventsReadAtParams) (int64, error) {
+	result, err := q.db.Exec(ctx, updateNoteEventsReadAt, arg.RecipientID, arg.Ids, arg.UpTo)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
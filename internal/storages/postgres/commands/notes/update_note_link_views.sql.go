@@ -0,0 +1,24 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: update_note_link_views.sql
+
+package commands
+
+import (
+	"context"
+)
+
+const updateNoteLinkViews = `-- name: UpdateNoteLinkViews :one
+UPDATE note_links
+SET views = views + 1
+WHERE id = $1
+RETURNING views
+`
+
+func (q *Queries) UpdateNoteLinkViews(ctx context.Context, id int64) (int64, error) {
+	row := q.db.QueryRow(ctx, updateNoteLinkViews, id)
+	var views int64
+	err := row.Scan(&views)
+	return views, err
+}
@@ -0,0 +1,40 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: update_note_notebook.sql
+
+package commands
+
+import (
+	"context"
+	"time"
+)
+
+const updateNoteNotebook = `-- name: UpdateNoteNotebook :one
+UPDATE notes
+SET notebook_id = $1,
+    updated_at  = $2,
+    version     = version + 1
+WHERE id = $3
+  AND version = $4
+RETURNING version
+`
+
+type UpdateNoteNotebookParams struct {
+	NotebookID *int64    `db:"notebook_id"`
+	UpdatedAt  time.Time `db:"updated_at"`
+	ID         int64     `db:"id"`
+	Version    int64     `db:"version"`
+}
+
+func (q *Queries) UpdateNoteNotebook(ctx context.Context, arg *UpdateNoteNotebookParams) (int64, error) {
+	row := q.db.QueryRow(ctx, updateNoteNotebook,
+		arg.NotebookID,
+		arg.UpdatedAt,
+		arg.ID,
+		arg.Version,
+	)
+	var version int64
+	err := row.Scan(&version)
+	return version, err
+}
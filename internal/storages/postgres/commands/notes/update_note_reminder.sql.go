@@ -0,0 +1,48 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: update_note_reminder.sql
+
+package commands
+
+import (
+	"context"
+	"time"
+)
+
+const updateNoteReminder = `-- name: UpdateNoteReminder :execrows
+UPDATE note_reminders
+SET fire_at     = $1,
+    fired_at    = $2,
+    recurrence  = $3,
+    time_zone   = $4,
+    occurrences = $5,
+    updated_at  = $6
+WHERE id = $7
+`
+
+type UpdateNoteReminderParams struct {
+	FireAt      *time.Time `db:"fire_at"`
+	FiredAt     *time.Time `db:"fired_at"`
+	Recurrence  string     `db:"recurrence"`
+	TimeZone    string     `db:"time_zone"`
+	Occurrences int32      `db:"occurrences"`
+	UpdatedAt   time.Time  `db:"updated_at"`
+	ID          int64      `db:"id"`
+}
+
+func (q *Queries) UpdateNoteReminder(ctx context.Context, arg *UpdateNoteReminderParams) (int64, error) {
+	result, err := q.db.Exec(ctx, updateNoteReminder,
+		arg.FireAt,
+		arg.FiredAt,
+		arg.Recurrence,
+		arg.TimeZone,
+		arg.Occurrences,
+		arg.UpdatedAt,
+		arg.ID,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
@@ -0,0 +1,39 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: update_note_template.sql
+
+package commands
+
+import (
+	"context"
+	"time"
+)
+
+const updateNoteTemplate = `-- name: UpdateNoteTemplate :exec
+UPDATE note_templates
+SET name       = $1,
+    title      = $2,
+    content    = $3,
+    updated_at = $4
+WHERE id = $5
+`
+
+type UpdateNoteTemplateParams struct {
+	Name      string    `db:"name"`
+	Title     string    `db:"title"`
+	Content   string    `db:"content"`
+	UpdatedAt time.Time `db:"updated_at"`
+	ID        int64     `db:"id"`
+}
+
+func (q *Queries) UpdateNoteTemplate(ctx context.Context, arg *UpdateNoteTemplateParams) error {
+	_, err := q.db.Exec(ctx, updateNoteTemplate,
+		arg.Name,
+		arg.Title,
+		arg.Content,
+		arg.UpdatedAt,
+		arg.ID,
+	)
+	return err
+}
@@ -0,0 +1,30 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: update_note_version.sql
+
+package commands
+
+import (
+	"context"
+)
+
+const updateNoteVersion = `-- name: UpdateNoteVersion :one
+UPDATE notes
+SET version = version + 1
+WHERE id = $1
+  AND version = $2
+RETURNING version
+`
+
+type UpdateNoteVersionParams struct {
+	ID      int64 `db:"id"`
+	Version int64 `db:"version"`
+}
+
+func (q *Queries) UpdateNoteVersion(ctx context.Context, arg *UpdateNoteVersionParams) (int64, error) {
+	row := q.db.QueryRow(ctx, updateNoteVersion, arg.ID, arg.Version)
+	var version int64
+	err := row.Scan(&version)
+	return version, err
+}
@@ -0,0 +1,36 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: update_notebook.sql
+
+package commands
+
+import (
+	"context"
+	"time"
+)
+
+const updateNotebook = `-- name: UpdateNotebook :exec
+UPDATE notebooks
+SET parent_id  = $1,
+    name       = $2,
+    updated_at = $3
+WHERE id = $4
+`
+
+type UpdateNotebookParams struct {
+	ParentID  *int64    `db:"parent_id"`
+	Name      string    `db:"name"`
+	UpdatedAt time.Time `db:"updated_at"`
+	ID        int64     `db:"id"`
+}
+
+func (q *Queries) UpdateNotebook(ctx context.Context, arg *UpdateNotebookParams) error {
+	_, err := q.db.Exec(ctx, updateNotebook,
+		arg.ParentID,
+		arg.Name,
+		arg.UpdatedAt,
+		arg.ID,
+	)
+	return err
+}
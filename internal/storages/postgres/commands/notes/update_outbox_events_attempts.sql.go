@@ -0,0 +1,38 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: update_outbox_events_attempts.sql
+
+package commands
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const updateOutboxEventsAttempts = `-- name: UpdateOutboxEventsAttempts :exec
+UPDATE event_outbox
+SET attempts     = attempts + 1,
+    last_error   = $1,
+    available_at = now() + LEAST($2::INTERVAL, $3::INTERVAL * POWER(2, LEAST(attempts, 20)))
+WHERE event_id = ANY ($4::UUID[])
+`
+
+type UpdateOutboxEventsAttemptsParams struct {
+	LastError  *string         `db:"last_error"`
+	MaxBackoff pgtype.Interval `db:"max_backoff"`
+	Backoff    pgtype.Interval `db:"backoff"`
+	EventIds   []uuid.UUID     `db:"event_ids"`
+}
+
+func (q *Queries) UpdateOutboxEventsAttempts(ctx context.Context, arg *UpdateOutboxEventsAttemptsParams) error {
+	_, err := q.db.Exec(ctx, updateOutboxEventsAttempts,
+		arg.LastError,
+		arg.MaxBackoff,
+		arg.Backoff,
+		arg.EventIds,
+	)
+	return err
+}
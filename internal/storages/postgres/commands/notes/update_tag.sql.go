@@ -0,0 +1,26 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: update_tag.sql
+
+package commands
+
+import (
+	"context"
+)
+
+const updateTag = `-- name: UpdateTag :exec
+UPDATE tags
+SET name = $1
+WHERE id = $2
+`
+
+type UpdateTagParams struct {
+	Name string `db:"name"`
+	ID   int64  `db:"id"`
+}
+
+func (q *Queries) UpdateTag(ctx context.Context, arg *UpdateTagParams) error {
+	_, err := q.db.Exec(ctx, updateTag, arg.Name, arg.ID)
+	return err
+}
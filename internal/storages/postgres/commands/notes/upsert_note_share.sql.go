@@ -0,0 +1,37 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: upsert_note_share.sql
+
+package commands
+
+import (
+	"context"
+	"time"
+)
+
+const upsertNoteShare = `-- name: UpsertNoteShare :one
+INSERT INTO note_shares (note_id, user_id, role, created_at)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT (note_id, user_id) DO UPDATE SET role = excluded.role
+RETURNING created_at
+`
+
+type UpsertNoteShareParams struct {
+	NoteID    int64     `db:"note_id"`
+	UserID    int64     `db:"user_id"`
+	Role      string    `db:"role"`
+	CreatedAt time.Time `db:"created_at"`
+}
+
+func (q *Queries) UpsertNoteShare(ctx context.Context, arg *UpsertNoteShareParams) (time.Time, error) {
+	row := q.db.QueryRow(ctx, upsertNoteShare,
+		arg.NoteID,
+		arg.UserID,
+		arg.Role,
+		arg.CreatedAt,
+	)
+	var created_at time.Time
+	err := row.Scan(&created_at)
+	return created_at, err
+}
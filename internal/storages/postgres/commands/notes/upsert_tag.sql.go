@@ -0,0 +1,36 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: upsert_tag.sql
+
+package commands
+
+import (
+	"context"
+	"time"
+)
+
+const upsertTag = `-- name: UpsertTag :one
+INSERT INTO tags (user_id, name, created_at)
+VALUES ($1, $2, $3)
+ON CONFLICT (user_id, name) DO UPDATE SET name = excluded.name
+RETURNING id, created_at
+`
+
+type UpsertTagParams struct {
+	UserID    int64     `db:"user_id"`
+	Name      string    `db:"name"`
+	CreatedAt time.Time `db:"created_at"`
+}
+
+type UpsertTagRow struct {
+	ID        int64     `db:"id"`
+	CreatedAt time.Time `db:"created_at"`
+}
+
+func (q *Queries) UpsertTag(ctx context.Context, arg *UpsertTagParams) (*UpsertTagRow, error) {
+	row := q.db.QueryRow(ctx, upsertTag, arg.UserID, arg.Name, arg.CreatedAt)
+	var i UpsertTagRow
+	err := row.Scan(&i.ID, &i.CreatedAt)
+	return &i, err
+}
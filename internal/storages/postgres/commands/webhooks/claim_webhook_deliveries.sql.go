@@ -0,0 +1,85 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: claim_webhook_deliveries.sql
+
+package commands
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const claimWebhookDeliveries = `-- name: ClaimWebhookDeliveries :many
+WITH due AS (SELECT d.webhook_id, d.event_id
+             FROM webhook_deliveries d
+                      JOIN webhooks w ON w.id = d.webhook_id
+             WHERE d.available_at <= now()
+               AND w.disabled_at IS NULL
+             ORDER BY d.available_at, d.created_at
+             LIMIT $2 FOR UPDATE OF d SKIP LOCKED)
+UPDATE webhook_deliveries d
+SET attempts     = d.attempts + 1,
+    available_at = now() + $1::INTERVAL
+FROM due,
+     webhooks w
+WHERE d.webhook_id = due.webhook_id
+  AND d.event_id = due.event_id
+  AND w.id = d.webhook_id
+RETURNING d.webhook_id, d.event_id, d.event_type, d.note_id, d.recipient_id, d.event_time, d.attempts,
+    w.user_id, w.url, w.secret, w.failures
+`
+
+type ClaimWebhookDeliveriesParams struct {
+	Lease    pgtype.Interval `db:"lease"`
+	MaxCount int32           `db:"max_count"`
+}
+
+type ClaimWebhookDeliveriesRow struct {
+	WebhookID   int64     `db:"webhook_id"`
+	EventID     uuid.UUID `db:"event_id"`
+	EventType   int16     `db:"event_type"`
+	NoteID      int64     `db:"note_id"`
+	RecipientID int64     `db:"recipient_id"`
+	EventTime   time.Time `db:"event_time"`
+	Attempts    int32     `db:"attempts"`
+	UserID      int64     `db:"user_id"`
+	Url         string    `db:"url"`
+	Secret      string    `db:"secret"`
+	Failures    int32     `db:"failures"`
+}
+
+func (q *Queries) ClaimWebhookDeliveries(ctx context.Context, arg *ClaimWebhookDeliveriesParams) ([]*ClaimWebhookDeliveriesRow, error) {
+	rows, err := q.db.Query(ctx, claimWebhookDeliveries, arg.Lease, arg.MaxCount)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*ClaimWebhookDeliveriesRow
+	for rows.Next() {
+		var i ClaimWebhookDeliveriesRow
+		if err := rows.Scan(
+			&i.WebhookID,
+			&i.EventID,
+			&i.EventType,
+			&i.NoteID,
+			&i.RecipientID,
+			&i.EventTime,
+			&i.Attempts,
+			&i.UserID,
+			&i.Url,
+			&i.Secret,
+			&i.Failures,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
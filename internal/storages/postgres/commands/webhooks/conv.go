@@ -0,0 +1,136 @@
+package commands
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/therenotomorrow/gotes/internal/domain/entities"
+	"github.com/therenotomorrow/gotes/internal/domain/types/id"
+	domuuid "github.com/therenotomorrow/gotes/internal/domain/types/uuid"
+)
+
+func NewInsertWebhookParams(webhook *entities.Webhook) *InsertWebhookParams {
+	return &InsertWebhookParams{
+		UserID:     webhook.Owner.ID.Value(),
+		Url:        webhook.URL,
+		Secret:     webhook.Secret,
+		EventTypes: EventTypes(webhook.EventTypes),
+		CreatedAt:  webhook.CreatedAt,
+		UpdatedAt:  webhook.UpdatedAt,
+	}
+}
+
+func NewUpdateWebhookParams(webhook *entities.Webhook) *UpdateWebhookParams {
+	return &UpdateWebhookParams{
+		Url:        webhook.URL,
+		EventTypes: EventTypes(webhook.EventTypes),
+		Failures:   webhook.Failures,
+		DisabledAt: webhook.DisabledAt,
+		UpdatedAt:  webhook.UpdatedAt,
+		ID:         webhook.ID.Value(),
+	}
+}
+
+func NewClaimWebhookDeliveriesParams(limit int, lease time.Duration) *ClaimWebhookDeliveriesParams {
+	return &ClaimWebhookDeliveriesParams{
+		Lease:    interval(lease),
+		MaxCount: int32(limit), //nolint:gosec // allowed conversation
+	}
+}
+
+func NewDeleteWebhookDeliveryParams(delivery *entities.Delivery) *DeleteWebhookDeliveryParams {
+	return &DeleteWebhookDeliveryParams{
+		WebhookID: delivery.Webhook.ID.Value(),
+		EventID:   uuid.MustParse(delivery.Event.ID.Value()),
+	}
+}
+
+func NewUpdateWebhookDeliveryAvailableAtParams(
+	delivery *entities.Delivery,
+	backoff, maxBackoff time.Duration,
+) *UpdateWebhookDeliveryAvailableAtParams {
+	return &UpdateWebhookDeliveryAvailableAtParams{
+		MaxBackoff: interval(maxBackoff),
+		Backoff:    interval(backoff),
+		WebhookID:  delivery.Webhook.ID.Value(),
+		EventID:    uuid.MustParse(delivery.Event.ID.Value()),
+	}
+}
+
+func NewInsertWebhookAttemptParams(attempt *entities.Attempt) *InsertWebhookAttemptParams {
+	params := &InsertWebhookAttemptParams{
+		WebhookID:   attempt.Webhook.ID.Value(),
+		EventID:     uuid.MustParse(attempt.EventID.Value()),
+		EventType:   int16(attempt.EventType), //nolint:gosec // allowed conversation
+		StatusCode:  attempt.StatusCode,
+		Error:       nil,
+		Duration:    interval(attempt.Duration),
+		AttemptedAt: attempt.AttemptedAt,
+	}
+
+	if attempt.Error != "" {
+		params.Error = &attempt.Error
+	}
+
+	return params
+}
+
+func EventTypes(types []entities.EventType) []int16 {
+	values := make([]int16, len(types))
+	for i, eventType := range types {
+		values[i] = int16(eventType) //nolint:gosec // allowed conversation
+	}
+
+	return values
+}
+
+func (r *ClaimWebhookDeliveriesRow) ToEntity() *entities.Delivery {
+	owner := new(entities.User)
+	owner.ID = id.New(r.UserID)
+
+	recipient := new(entities.User)
+	recipient.ID = id.New(r.RecipientID)
+
+	note := new(entities.Note)
+	note.ID = id.New(r.NoteID)
+
+	return &entities.Delivery{
+		Webhook: &entities.Webhook{
+			CreatedAt:  time.Time{},
+			UpdatedAt:  time.Time{},
+			DisabledAt: nil,
+			Owner:      owner,
+			URL:        r.Url,
+			Secret:     r.Secret,
+			EventTypes: nil,
+			ID:         id.New(r.WebhookID),
+			Failures:   r.Failures,
+		},
+		Event: &entities.Event{
+			EventTime: r.EventTime,
+			ReadAt:    nil,
+			Note:      note,
+			Recipient: recipient,
+			Position:  "",
+			ID:        domuuid.Conv(r.EventID.String()),
+			EventType: entities.EventType(r.EventType),
+		},
+		Attempts: r.Attempts,
+	}
+}
+
+type ClaimWebhookDeliveriesRows []*ClaimWebhookDeliveriesRow
+
+func (r ClaimWebhookDeliveriesRows) ToEntities() []*entities.Delivery {
+	deliveries := make([]*entities.Delivery, len(r))
+	for i, row := range r {
+		deliveries[i] = row.ToEntity()
+	}
+
+	return deliveries
+}
+
+func interval(d time.Duration) pgtype.Interval {
+	return pgtype.Interval{Microseconds: d.Microseconds(), Days: 0, Months: 0, Valid: true}
+}
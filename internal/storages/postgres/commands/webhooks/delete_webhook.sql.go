@@ -0,0 +1,21 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: delete_webhook.sql
+
+package commands
+
+import (
+	"context"
+)
+
+const deleteWebhook = `-- name: DeleteWebhook :exec
+DELETE
+FROM webhooks
+WHERE id = $1
+`
+
+func (q *Queries) DeleteWebhook(ctx context.Context, id int64) error {
+	_, err := q.db.Exec(ctx, deleteWebhook, id)
+	return err
+}
@@ -0,0 +1,25 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: delete_webhook_attempts.sql
+
+package commands
+
+import (
+	"context"
+	"time"
+)
+
+const deleteWebhookAttempts = `-- name: DeleteWebhookAttempts :execrows
+DELETE
+FROM webhook_attempts
+WHERE attempted_at < $1
+`
+
+func (q *Queries) DeleteWebhookAttempts(ctx context.Context, attemptedBefore time.Time) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteWebhookAttempts, attemptedBefore)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
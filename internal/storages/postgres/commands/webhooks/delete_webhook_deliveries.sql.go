@@ -0,0 +1,21 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: delete_webhook_deliveries.sql
+
+package commands
+
+import (
+	"context"
+)
+
+const deleteWebhookDeliveries = `-- name: DeleteWebhookDeliveries :exec
+DELETE
+FROM webhook_deliveries
+WHERE webhook_id = $1
+`
+
+func (q *Queries) DeleteWebhookDeliveries(ctx context.Context, webhookID int64) error {
+	_, err := q.db.Exec(ctx, deleteWebhookDeliveries, webhookID)
+	return err
+}
@@ -0,0 +1,29 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: delete_webhook_delivery.sql
+
+package commands
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+const deleteWebhookDelivery = `-- name: DeleteWebhookDelivery :exec
+DELETE
+FROM webhook_deliveries
+WHERE webhook_id = $1
+  AND event_id = $2
+`
+
+type DeleteWebhookDeliveryParams struct {
+	WebhookID int64     `db:"webhook_id"`
+	EventID   uuid.UUID `db:"event_id"`
+}
+
+func (q *Queries) DeleteWebhookDelivery(ctx context.Context, arg *DeleteWebhookDeliveryParams) error {
+	_, err := q.db.Exec(ctx, deleteWebhookDelivery, arg.WebhookID, arg.EventID)
+	return err
+}
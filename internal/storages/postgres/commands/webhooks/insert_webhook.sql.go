@@ -0,0 +1,40 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: insert_webhook.sql
+
+package commands
+
+import (
+	"context"
+	"time"
+)
+
+const insertWebhook = `-- name: InsertWebhook :one
+INSERT INTO webhooks (user_id, url, secret, event_types, created_at, updated_at)
+VALUES ($1, $2, $3, $4, $5, $6)
+RETURNING id
+`
+
+type InsertWebhookParams struct {
+	UserID     int64     `db:"user_id"`
+	Url        string    `db:"url"`
+	Secret     string    `db:"secret"`
+	EventTypes []int16   `db:"event_types"`
+	CreatedAt  time.Time `db:"created_at"`
+	UpdatedAt  time.Time `db:"updated_at"`
+}
+
+func (q *Queries) InsertWebhook(ctx context.Context, arg *InsertWebhookParams) (int64, error) {
+	row := q.db.QueryRow(ctx, insertWebhook,
+		arg.UserID,
+		arg.Url,
+		arg.Secret,
+		arg.EventTypes,
+		arg.CreatedAt,
+		arg.UpdatedAt,
+	)
+	var id int64
+	err := row.Scan(&id)
+	return id, err
+}
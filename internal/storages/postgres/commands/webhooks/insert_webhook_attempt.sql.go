@@ -0,0 +1,45 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: insert_webhook_attempt.sql
+
+package commands
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const insertWebhookAttempt = `-- name: InsertWebhookAttempt :one
+INSERT INTO webhook_attempts (webhook_id, event_id, event_type, status_code, error, duration, attempted_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+RETURNING id
+`
+
+type InsertWebhookAttemptParams struct {
+	WebhookID   int64           `db:"webhook_id"`
+	EventID     uuid.UUID       `db:"event_id"`
+	EventType   int16           `db:"event_type"`
+	StatusCode  int32           `db:"status_code"`
+	Error       *string         `db:"error"`
+	Duration    pgtype.Interval `db:"duration"`
+	AttemptedAt time.Time       `db:"attempted_at"`
+}
+
+func (q *Queries) InsertWebhookAttempt(ctx context.Context, arg *InsertWebhookAttemptParams) (int64, error) {
+	row := q.db.QueryRow(ctx, insertWebhookAttempt,
+		arg.WebhookID,
+		arg.EventID,
+		arg.EventType,
+		arg.StatusCode,
+		arg.Error,
+		arg.Duration,
+		arg.AttemptedAt,
+	)
+	var id int64
+	err := row.Scan(&id)
+	return id, err
+}
@@ -0,0 +1,25 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+
+package commands
+
+import (
+	"context"
+	"time"
+)
+
+type Querier interface {
+	ClaimWebhookDeliveries(ctx context.Context, arg *ClaimWebhookDeliveriesParams) ([]*ClaimWebhookDeliveriesRow, error)
+	DeleteWebhook(ctx context.Context, id int64) error
+	DeleteWebhookAttempts(ctx context.Context, attemptedBefore time.Time) (int64, error)
+	DeleteWebhookDeliveries(ctx context.Context, webhookID int64) error
+	DeleteWebhookDelivery(ctx context.Context, arg *DeleteWebhookDeliveryParams) error
+	InsertWebhook(ctx context.Context, arg *InsertWebhookParams) (int64, error)
+	InsertWebhookAttempt(ctx context.Context, arg *InsertWebhookAttemptParams) (int64, error)
+	UpdateWebhook(ctx context.Context, arg *UpdateWebhookParams) error
+	UpdateWebhookDeliveryAvailableAt(ctx context.Context, arg *UpdateWebhookDeliveryAvailableAtParams) error
+	UpdateWebhookFailures(ctx context.Context, arg *UpdateWebhookFailuresParams) (int32, error)
+}
+
+var _ Querier = (*Queries)(nil)
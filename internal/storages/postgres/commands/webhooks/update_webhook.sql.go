@@ -0,0 +1,42 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: update_webhook.sql
+
+package commands
+
+import (
+	"context"
+	"time"
+)
+
+const updateWebhook = `-- name: UpdateWebhook :exec
+UPDATE webhooks
+SET url         = $1,
+    event_types = $2,
+    failures    = $3,
+    disabled_at = $4,
+    updated_at  = $5
+WHERE id = $6
+`
+
+type UpdateWebhookParams struct {
+	Url        string     `db:"url"`
+	EventTypes []int16    `db:"event_types"`
+	Failures   int32      `db:"failures"`
+	DisabledAt *time.Time `db:"disabled_at"`
+	UpdatedAt  time.Time  `db:"updated_at"`
+	ID         int64      `db:"id"`
+}
+
+func (q *Queries) UpdateWebhook(ctx context.Context, arg *UpdateWebhookParams) error {
+	_, err := q.db.Exec(ctx, updateWebhook,
+		arg.Url,
+		arg.EventTypes,
+		arg.Failures,
+		arg.DisabledAt,
+		arg.UpdatedAt,
+		arg.ID,
+	)
+	return err
+}
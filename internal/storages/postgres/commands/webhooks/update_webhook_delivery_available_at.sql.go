@@ -0,0 +1,37 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: update_webhook_delivery_available_at.sql
+
+package commands
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const updateWebhookDeliveryAvailableAt = `-- name: UpdateWebhookDeliveryAvailableAt :exec
+UPDATE webhook_deliveries
+SET available_at = now() + LEAST($1::INTERVAL, $2::INTERVAL * POWER(2, LEAST(attempts - 1, 20)))
+WHERE webhook_id = $3
+  AND event_id = $4
+`
+
+type UpdateWebhookDeliveryAvailableAtParams struct {
+	MaxBackoff pgtype.Interval `db:"max_backoff"`
+	Backoff    pgtype.Interval `db:"backoff"`
+	WebhookID  int64           `db:"webhook_id"`
+	EventID    uuid.UUID       `db:"event_id"`
+}
+
+func (q *Queries) UpdateWebhookDeliveryAvailableAt(ctx context.Context, arg *UpdateWebhookDeliveryAvailableAtParams) error {
+	_, err := q.db.Exec(ctx, updateWebhookDeliveryAvailableAt,
+		arg.MaxBackoff,
+		arg.Backoff,
+		arg.WebhookID,
+		arg.EventID,
+	)
+	return err
+}
@@ -0,0 +1,29 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: update_webhook_failures.sql
+
+package commands
+
+import (
+	"context"
+)
+
+const updateWebhookFailures = `-- name: UpdateWebhookFailures :one
+UPDATE webhooks
+SET failures = CASE WHEN $1::BOOLEAN THEN failures + 1 ELSE 0 END
+WHERE id = $2
+RETURNING failures
+`
+
+type UpdateWebhookFailuresParams struct {
+	Failed bool  `db:"failed"`
+	ID     int64 `db:"id"`
+}
+
+func (q *Queries) UpdateWebhookFailures(ctx context.Context, arg *UpdateWebhookFailuresParams) (int32, error) {
+	row := q.db.QueryRow(ctx, updateWebhookFailures, arg.Failed, arg.ID)
+	var failures int32
+	err := row.Scan(&failures)
+	return failures, err
+}
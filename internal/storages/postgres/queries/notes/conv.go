@@ -1,8 +1,15 @@
 package queries
 
 import (
+	"time"
+
 	"github.com/therenotomorrow/gotes/internal/domain/entities"
+	"github.com/therenotomorrow/gotes/internal/domain/types/email"
 	"github.com/therenotomorrow/gotes/internal/domain/types/id"
+	"github.com/therenotomorrow/gotes/internal/domain/types/password"
+	"github.com/therenotomorrow/gotes/internal/domain/types/rrule"
+	"github.com/therenotomorrow/gotes/internal/domain/types/uuid"
+	"github.com/therenotomorrow/gotes/internal/domain/types/wikilink"
 )
 
 func (n *Note) ToEntity() *entities.Note {
@@ -12,7 +19,14 @@ func (n *Note) ToEntity() *entities.Note {
 		Content:   n.Content,
 		CreatedAt: n.CreatedAt,
 		UpdatedAt: n.UpdatedAt,
+		DeletedAt: n.DeletedAt,
 		Owner:     setOwner(n.UserID),
+		Notebook:  setNotebook(n.NotebookID),
+		Tags:      nil,
+		Version:   n.Version,
+		Pinned:    n.Pinned,
+		Archived:  n.Archived,
+		Starred:   n.Starred,
 	}
 }
 
@@ -27,6 +41,17 @@ func setOwner(userID *int64) *entities.User {
 	return owner
 }
 
+func setNotebook(notebookID *int64) *entities.Notebook {
+	if notebookID == nil {
+		return nil
+	}
+
+	notebook := new(entities.Notebook)
+	notebook.ID = id.New(*notebookID)
+
+	return notebook
+}
+
 type Notes []*Note
 
 func (n Notes) ToEntities() []*entities.Note {
@@ -37,3 +62,282 @@ func (n Notes) ToEntities() []*entities.Note {
 
 	return notes
 }
+
+func (r *NoteRevision) ToEntity(note *entities.Note) *entities.Revision {
+	return &entities.Revision{
+		ID:        id.New(r.ID),
+		Number:    r.Revision,
+		Note:      note,
+		Author:    setOwner(r.UserID),
+		Title:     r.Title,
+		Content:   r.Content,
+		CreatedAt: r.CreatedAt,
+	}
+}
+
+type NoteRevisions []*NoteRevision
+
+func (r NoteRevisions) ToEntities(note *entities.Note) []*entities.Revision {
+	revisions := make([]*entities.Revision, len(r))
+	for i, revision := range r {
+		revisions[i] = revision.ToEntity(note)
+	}
+
+	return revisions
+}
+
+func (t *Tag) ToEntity() *entities.Tag {
+	return &entities.Tag{
+		ID:        id.New(t.ID),
+		Name:      t.Name,
+		Owner:     setOwner(&t.UserID),
+		CreatedAt: t.CreatedAt,
+	}
+}
+
+func (r *SelectCollaboratorRow) ToEntity() *entities.User {
+	return setCollaborator(r.ID, r.Name, r.Email)
+}
+
+func setCollaborator(userID int64, name, mail string) *entities.User {
+	return &entities.User{
+		CreatedAt: time.Time{},
+		UpdatedAt: time.Time{},
+		Name:      name,
+		Email:     email.New(mail),
+		Password:  password.Password{},
+		Token:     uuid.UUID{},
+		ID:        id.New(userID),
+	}
+}
+
+func (s *NoteShare) ToEntity(note *entities.Note, user *entities.User) *entities.Share {
+	return &entities.Share{
+		CreatedAt: s.CreatedAt,
+		Note:      note,
+		User:      user,
+		Role:      entities.Role(s.Role),
+	}
+}
+
+func (r *SelectNoteSharesRow) ToEntity(note *entities.Note) *entities.Share {
+	return r.NoteShare.ToEntity(note, setCollaborator(r.NoteShare.UserID, r.Name, r.Email))
+}
+
+func (r *SelectSharedNotesByUserRow) ToEntity(user *entities.User) *entities.Share {
+	return &entities.Share{
+		CreatedAt: r.SharedAt,
+		Note:      r.Note.ToEntity(),
+		User:      user,
+		Role:      entities.Role(r.Role),
+	}
+}
+
+func (l *NoteLink) ToEntity(note *entities.Note) *entities.Link {
+	return &entities.Link{
+		CreatedAt: l.CreatedAt,
+		ExpiresAt: l.ExpiresAt,
+		Note:      note,
+		Token:     l.Token,
+		ID:        id.New(l.ID),
+		Views:     l.Views,
+	}
+}
+
+type NoteLinks []*NoteLink
+
+func (l NoteLinks) ToEntities(note *entities.Note) []*entities.Link {
+	links := make([]*entities.Link, len(l))
+	for i, link := range l {
+		links[i] = link.ToEntity(note)
+	}
+
+	return links
+}
+
+func (n *Notebook) ToEntity() *entities.Notebook {
+	return &entities.Notebook{
+		CreatedAt: n.CreatedAt,
+		UpdatedAt: n.UpdatedAt,
+		Owner:     setOwner(&n.UserID),
+		Parent:    setNotebook(n.ParentID),
+		Name:      n.Name,
+		ID:        id.New(n.ID),
+	}
+}
+
+type Notebooks []*Notebook
+
+func (n Notebooks) ToEntities() []*entities.Notebook {
+	notebooks := make([]*entities.Notebook, len(n))
+	for i, notebook := range n {
+		notebooks[i] = notebook.ToEntity()
+	}
+
+	return notebooks
+}
+
+func (a *NoteAttachment) ToEntity(note *entities.Note) *entities.Attachment {
+	return &entities.Attachment{
+		CreatedAt:   a.CreatedAt,
+		Note:        note,
+		Owner:       setOwner(a.UserID),
+		Name:        a.Name,
+		ContentType: a.ContentType,
+		Key:         a.BlobKey,
+		Checksum:    a.Checksum,
+		ID:          id.New(a.ID),
+		Size:        a.Size,
+	}
+}
+
+type NoteAttachments []*NoteAttachment
+
+func (a NoteAttachments) ToEntities(note *entities.Note) []*entities.Attachment {
+	attachments := make([]*entities.Attachment, len(a))
+	for i, attachment := range a {
+		attachments[i] = attachment.ToEntity(note)
+	}
+
+	return attachments
+}
+
+func (e *NoteEvent) ToEntity() *entities.Event {
+	note := new(entities.Note)
+	note.ID = id.New(e.NoteID)
+
+	recipient := new(entities.User)
+	recipient.ID = id.New(e.RecipientID)
+
+	return &entities.Event{
+		EventTime: e.EventTime,
+		ReadAt:    e.ReadAt,
+		Note:      note,
+		Recipient: recipient,
+		Position:  "",
+		ID:        uuid.Conv(e.ID.String()),
+		EventType: entities.EventType(e.EventType),
+	}
+}
+
+type NoteEvents []*NoteEvent
+
+func (e NoteEvents) ToEntities() []*entities.Event {
+	events := make([]*entities.Event, len(e))
+	for i, event := range e {
+		events[i] = event.ToEntity()
+	}
+
+	return events
+}
+
+func (r *NoteReminder) ToEntity(note *entities.Note) *entities.Reminder {
+	user := new(entities.User)
+	user.ID = id.New(r.UserID)
+
+	return &entities.Reminder{
+		CreatedAt:   r.CreatedAt,
+		UpdatedAt:   r.UpdatedAt,
+		FireAt:      r.FireAt,
+		FiredAt:     r.FiredAt,
+		Note:        note,
+		User:        user,
+		Recurrence:  setRecurrence(r.Recurrence),
+		TimeZone:    r.TimeZone,
+		ID:          id.New(r.ID),
+		Occurrences: r.Occurrences,
+	}
+}
+
+type NoteReminders []*NoteReminder
+
+func (r NoteReminders) ToEntities(note *entities.Note) []*entities.Reminder {
+	reminders := make([]*entities.Reminder, len(r))
+	for i, reminder := range r {
+		reminders[i] = reminder.ToEntity(note)
+	}
+
+	return reminders
+}
+
+// setRecurrence reads the stored rule, reminders firing once have none.
+func setRecurrence(text string) *rrule.Rule {
+	rule, err := rrule.Parse(text)
+	if err != nil {
+		return nil
+	}
+
+	return rule
+}
+
+func (t *NoteTemplate) ToEntity() *entities.Template {
+	return &entities.Template{
+		CreatedAt: t.CreatedAt,
+		UpdatedAt: t.UpdatedAt,
+		Owner:     setOwner(&t.UserID),
+		Name:      t.Name,
+		Title:     t.Title,
+		Content:   t.Content,
+		ID:        id.New(t.ID),
+	}
+}
+
+type NoteTemplates []*NoteTemplate
+
+func (t NoteTemplates) ToEntities() []*entities.Template {
+	templates := make([]*entities.Template, len(t))
+	for i, template := range t {
+		templates[i] = template.ToEntity()
+	}
+
+	return templates
+}
+
+func (r *NoteReference) ToEntity(source, target *entities.Note) *entities.Reference {
+	link := wikilink.Target{Title: "", ID: 0}
+
+	if r.TargetID != nil {
+		link.ID = *r.TargetID
+	}
+
+	if r.TargetTitle != nil {
+		link.Title = *r.TargetTitle
+	}
+
+	return &entities.Reference{Source: source, Target: target, Link: link, Position: r.Position}
+}
+
+func (r *SelectOutgoingReferencesRow) ToEntity(source *entities.Note) *entities.Reference {
+	if r.ResolvedID == nil || r.ResolvedTitle == nil {
+		return r.NoteReference.ToEntity(source, nil)
+	}
+
+	target := new(entities.Note)
+	target.ID = id.New(*r.ResolvedID)
+	target.Title = *r.ResolvedTitle
+	target.Owner = source.Owner
+
+	return r.NoteReference.ToEntity(source, target)
+}
+
+type SelectOutgoingReferencesRows []*SelectOutgoingReferencesRow
+
+func (r SelectOutgoingReferencesRows) ToEntities(source *entities.Note) []*entities.Reference {
+	references := make([]*entities.Reference, len(r))
+	for i, reference := range r {
+		references[i] = reference.ToEntity(source)
+	}
+
+	return references
+}
+
+type SelectDanglingReferencesRows []*SelectDanglingReferencesRow
+
+func (r SelectDanglingReferencesRows) ToEntities() []*entities.Reference {
+	references := make([]*entities.Reference, len(r))
+	for i, reference := range r {
+		references[i] = reference.NoteReference.ToEntity(reference.Note.ToEntity(), nil)
+	}
+
+	return references
+}
@@ -0,0 +1,73 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: count_notes_by_user.sql
+
+package queries
+
+import (
+	"context"
+	"time"
+)
+
+const countNotesByUser = `-- name: CountNotesByUser :one
+SELECT count(*)
+FROM notes
+WHERE notes.user_id = $1
+  AND deleted_at IS NULL
+  AND ($2::timestamptz IS NULL OR created_at >= $2)
+  AND ($3::timestamptz IS NULL OR created_at < $3)
+  AND ($4::timestamptz IS NULL OR updated_at >= $4)
+  AND ($5::timestamptz IS NULL OR updated_at < $5)
+  AND ($6::text IS NULL OR starts_with(title, $6))
+  AND ($7::bigint IS NULL OR notes.notebook_id = $7)
+  AND ($8::boolean IS NULL OR pinned = $8)
+  AND ($9::boolean IS NULL OR archived = $9)
+  AND ($10::boolean IS NULL OR starred = $10)
+  AND (coalesce(cardinality($11::text[]), 0) = 0 OR EXISTS (SELECT 1
+                                                                  FROM note_tags
+                                                                           JOIN tags ON tags.id = note_tags.tag_id
+                                                                  WHERE note_tags.note_id = notes.id
+                                                                    AND tags.name = ANY ($11::text[])))
+  AND (coalesce(cardinality($12::text[]), 0) = 0 OR (SELECT count(DISTINCT tags.name)
+                                                           FROM note_tags
+                                                                    JOIN tags ON tags.id = note_tags.tag_id
+                                                           WHERE note_tags.note_id = notes.id
+                                                             AND tags.name = ANY ($12::text[]))
+    = cardinality($12::text[]))
+`
+
+type CountNotesByUserParams struct {
+	UserID        *int64     `db:"user_id"`
+	CreatedAfter  *time.Time `db:"created_after"`
+	CreatedBefore *time.Time `db:"created_before"`
+	UpdatedAfter  *time.Time `db:"updated_after"`
+	UpdatedBefore *time.Time `db:"updated_before"`
+	TitlePrefix   *string    `db:"title_prefix"`
+	NotebookID    *int64     `db:"notebook_id"`
+	Pinned        *bool      `db:"pinned"`
+	Archived      *bool      `db:"archived"`
+	Starred       *bool      `db:"starred"`
+	TagsAny       []string   `db:"tags_any"`
+	TagsAll       []string   `db:"tags_all"`
+}
+
+func (q *Queries) CountNotesByUser(ctx context.Context, arg *CountNotesByUserParams) (int64, error) {
+	row := q.db.QueryRow(ctx, countNotesByUser,
+		arg.UserID,
+		arg.CreatedAfter,
+		arg.CreatedBefore,
+		arg.UpdatedAfter,
+		arg.UpdatedBefore,
+		arg.TitlePrefix,
+		arg.NotebookID,
+		arg.Pinned,
+		arg.Archived,
+		arg.Starred,
+		arg.TagsAny,
+		arg.TagsAll,
+	)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
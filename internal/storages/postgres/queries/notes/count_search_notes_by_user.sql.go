@@ -0,0 +1,30 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: count_search_notes_by_user.sql
+
+package queries
+
+import (
+	"context"
+)
+
+const countSearchNotesByUser = `-- name: CountSearchNotesByUser :one
+SELECT count(*)
+FROM notes
+WHERE notes.user_id = $1
+  AND notes.deleted_at IS NULL
+  AND notes.search @@ websearch_to_tsquery('simple', $2)
+`
+
+type CountSearchNotesByUserParams struct {
+	UserID *int64 `db:"user_id"`
+	Query  string `db:"query"`
+}
+
+func (q *Queries) CountSearchNotesByUser(ctx context.Context, arg *CountSearchNotesByUserParams) (int64, error) {
+	row := q.db.QueryRow(ctx, countSearchNotesByUser, arg.UserID, arg.Query)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
@@ -0,0 +1,25 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: count_shared_notes_by_user.sql
+
+package queries
+
+import (
+	"context"
+)
+
+const countSharedNotesByUser = `-- name: CountSharedNotesByUser :one
+SELECT count(*)
+FROM note_shares
+         JOIN notes ON notes.id = note_shares.note_id
+WHERE note_shares.user_id = $1
+  AND notes.deleted_at IS NULL
+`
+
+func (q *Queries) CountSharedNotesByUser(ctx context.Context, userID int64) (int64, error) {
+	row := q.db.QueryRow(ctx, countSharedNotesByUser, userID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
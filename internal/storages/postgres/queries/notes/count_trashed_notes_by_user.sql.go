@@ -0,0 +1,24 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: count_trashed_notes_by_user.sql
+
+package queries
+
+import (
+	"context"
+)
+
+const countTrashedNotesByUser = `-- name: CountTrashedNotesByUser :one
+SELECT count(*)
+FROM notes
+WHERE user_id = $1
+  AND deleted_at IS NOT NULL
+`
+
+func (q *Queries) CountTrashedNotesByUser(ctx context.Context, userID *int64) (int64, error) {
+	row := q.db.QueryRow(ctx, countTrashedNotesByUser, userID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
@@ -0,0 +1,43 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: count_unread_events_by_user.sql
+
+package queries
+
+import (
+	"context"
+)
+
+const countUnreadEventsByUser = `-- name: CountUnreadEventsByUser :one
+SELECT count(*)
+FROM note_events
+WHERE note_events.recipient_id = $1
+  AND read_at IS NULL
+  AND (coalesce(cardinality($2::smallint[]), 0) = 0 OR event_type = ANY ($2::smallint[]))
+  AND (coalesce(cardinality($3::bigint[]), 0) = 0 OR note_id = ANY ($3::bigint[]))
+  AND (coalesce(cardinality($4::text[]), 0) = 0 OR EXISTS (SELECT 1
+                                                              FROM note_tags
+                                                                       JOIN tags ON tags.id = note_tags.tag_id
+                                                              WHERE note_tags.note_id = note_events.note_id
+                                                                AND tags.name = ANY ($4::text[])))
+`
+
+type CountUnreadEventsByUserParams struct {
+	RecipientID int64    `db:"recipient_id"`
+	EventTypes  []int16  `db:"event_types"`
+	NoteIds     []int64  `db:"note_ids"`
+	Tags        []string `db:"tags"`
+}
+
+func (q *Queries) CountUnreadEventsByUser(ctx context.Context, arg *CountUnreadEventsByUserParams) (int64, error) {
+	row := q.db.QueryRow(ctx, countUnreadEventsByUser,
+		arg.RecipientID,
+		arg.EventTypes,
+		arg.NoteIds,
+		arg.Tags,
+	)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
@@ -6,13 +6,115 @@ package queries
 
 import (
 	"time"
+
+	"github.com/google/uuid"
 )
 
 type Note struct {
+	ID         int64       `db:"id"`
+	Title      string      `db:"title"`
+	Content    string      `db:"content"`
+	UserID     *int64      `db:"user_id"`
+	CreatedAt  time.Time   `db:"created_at"`
+	UpdatedAt  time.Time   `db:"updated_at"`
+	DeletedAt  *time.Time  `db:"deleted_at"`
+	NotebookID *int64      `db:"notebook_id"`
+	Version    int64       `db:"version"`
+	Pinned     bool        `db:"pinned"`
+	Archived   bool        `db:"archived"`
+	Starred    bool        `db:"starred"`
+	Search     interface{} `db:"search"`
+}
+
+type NoteAttachment struct {
+	ID          int64     `db:"id"`
+	NoteID      int64     `db:"note_id"`
+	UserID      *int64    `db:"user_id"`
+	Name        string    `db:"name"`
+	ContentType string    `db:"content_type"`
+	BlobKey     string    `db:"blob_key"`
+	Size        int64     `db:"size"`
+	Checksum    string    `db:"checksum"`
+	CreatedAt   time.Time `db:"created_at"`
+}
+
+type NoteEvent struct {
+	ID          uuid.UUID  `db:"id"`
+	EventType   int16      `db:"event_type"`
+	NoteID      int64      `db:"note_id"`
+	RecipientID int64      `db:"recipient_id"`
+	EventTime   time.Time  `db:"event_time"`
+	ReadAt      *time.Time `db:"read_at"`
+}
+
+type NoteLink struct {
+	ID        int64      `db:"id"`
+	NoteID    int64      `db:"note_id"`
+	Token     string     `db:"token"`
+	Views     int64      `db:"views"`
+	ExpiresAt *time.Time `db:"expires_at"`
+	CreatedAt time.Time  `db:"created_at"`
+}
+
+type NoteReference struct {
+	SourceID    int64   `db:"source_id"`
+	Position    int32   `db:"position"`
+	TargetID    *int64  `db:"target_id"`
+	TargetTitle *string `db:"target_title"`
+}
+
+type NoteReminder struct {
+	ID          int64      `db:"id"`
+	NoteID      int64      `db:"note_id"`
+	UserID      int64      `db:"user_id"`
+	FireAt      *time.Time `db:"fire_at"`
+	FiredAt     *time.Time `db:"fired_at"`
+	Recurrence  string     `db:"recurrence"`
+	TimeZone    string     `db:"time_zone"`
+	Occurrences int32      `db:"occurrences"`
+	CreatedAt   time.Time  `db:"created_at"`
+	UpdatedAt   time.Time  `db:"updated_at"`
+}
+
+type NoteRevision struct {
 	ID        int64     `db:"id"`
+	NoteID    int64     `db:"note_id"`
+	Revision  int32     `db:"revision"`
 	Title     string    `db:"title"`
 	Content   string    `db:"content"`
 	UserID    *int64    `db:"user_id"`
 	CreatedAt time.Time `db:"created_at"`
+}
+
+type NoteShare struct {
+	NoteID    int64     `db:"note_id"`
+	UserID    int64     `db:"user_id"`
+	Role      string    `db:"role"`
+	CreatedAt time.Time `db:"created_at"`
+}
+
+type NoteTemplate struct {
+	ID        int64     `db:"id"`
+	UserID    int64     `db:"user_id"`
+	Name      string    `db:"name"`
+	Title     string    `db:"title"`
+	Content   string    `db:"content"`
+	CreatedAt time.Time `db:"created_at"`
 	UpdatedAt time.Time `db:"updated_at"`
 }
+
+type Notebook struct {
+	ID        int64     `db:"id"`
+	UserID    int64     `db:"user_id"`
+	ParentID  *int64    `db:"parent_id"`
+	Name      string    `db:"name"`
+	CreatedAt time.Time `db:"created_at"`
+	UpdatedAt time.Time `db:"updated_at"`
+}
+
+type Tag struct {
+	ID        int64     `db:"id"`
+	UserID    int64     `db:"user_id"`
+	Name      string    `db:"name"`
+	CreatedAt time.Time `db:"created_at"`
+}
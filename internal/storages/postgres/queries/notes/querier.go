@@ -9,8 +9,46 @@ import (
 )
 
 type Querier interface {
+	CountNotesByUser(ctx context.Context, arg *CountNotesByUserParams) (int64, error)
+	CountSearchNotesByUser(ctx context.Context, arg *CountSearchNotesByUserParams) (int64, error)
+	CountSharedNotesByUser(ctx context.Context, userID int64) (int64, error)
+	CountTrashedNotesByUser(ctx context.Context, userID *int64) (int64, error)
+	CountUnreadEventsByUser(ctx context.Context, arg *CountUnreadEventsByUserParams) (int64, error)
+	SearchNotesByUser(ctx context.Context, arg *SearchNotesByUserParams) ([]*SearchNotesByUserRow, error)
+	SelectBacklinks(ctx context.Context, arg *SelectBacklinksParams) ([]*Note, error)
+	SelectCollaborator(ctx context.Context, email string) (*SelectCollaboratorRow, error)
+	SelectDanglingReferences(ctx context.Context, userID *int64) ([]*SelectDanglingReferencesRow, error)
+	SelectEventsByUser(ctx context.Context, arg *SelectEventsByUserParams) ([]*NoteEvent, error)
 	SelectNote(ctx context.Context, id int64) (*Note, error)
-	SelectNotesByUser(ctx context.Context, userID *int64) ([]*Note, error)
+	SelectNoteAttachment(ctx context.Context, id int64) (*NoteAttachment, error)
+	SelectNoteAttachments(ctx context.Context, noteID int64) ([]*NoteAttachment, error)
+	SelectNoteByContent(ctx context.Context, arg *SelectNoteByContentParams) (*Note, error)
+	SelectNoteLink(ctx context.Context, token string) (*NoteLink, error)
+	SelectNoteLinks(ctx context.Context, noteID int64) ([]*NoteLink, error)
+	SelectNoteReminder(ctx context.Context, id int64) (*NoteReminder, error)
+	SelectNoteReminders(ctx context.Context, arg *SelectNoteRemindersParams) ([]*NoteReminder, error)
+	SelectNoteShare(ctx context.Context, arg *SelectNoteShareParams) (*NoteShare, error)
+	SelectNoteShares(ctx context.Context, noteID int64) ([]*SelectNoteSharesRow, error)
+	SelectNoteTemplate(ctx context.Context, id int64) (*NoteTemplate, error)
+	SelectNoteTemplatesByUser(ctx context.Context, userID int64) ([]*NoteTemplate, error)
+	SelectNotebook(ctx context.Context, id int64) (*Notebook, error)
+	SelectNotebookDescendants(ctx context.Context, id *int64) ([]*SelectNotebookDescendantsRow, error)
+	SelectNotebooksByUser(ctx context.Context, userID int64) ([]*Notebook, error)
+	SelectNotesByUserOrderByCreatedAtAsc(ctx context.Context, arg *SelectNotesByUserOrderByCreatedAtAscParams) ([]*Note, error)
+	SelectNotesByUserOrderByCreatedAtDesc(ctx context.Context, arg *SelectNotesByUserOrderByCreatedAtDescParams) ([]*Note, error)
+	SelectNotesByUserOrderByTitleAsc(ctx context.Context, arg *SelectNotesByUserOrderByTitleAscParams) ([]*Note, error)
+	SelectNotesByUserOrderByTitleDesc(ctx context.Context, arg *SelectNotesByUserOrderByTitleDescParams) ([]*Note, error)
+	SelectNotesByUserOrderByUpdatedAtAsc(ctx context.Context, arg *SelectNotesByUserOrderByUpdatedAtAscParams) ([]*Note, error)
+	SelectNotesByUserOrderByUpdatedAtDesc(ctx context.Context, arg *SelectNotesByUserOrderByUpdatedAtDescParams) ([]*Note, error)
+	SelectOutboxBacklog(ctx context.Context) (*SelectOutboxBacklogRow, error)
+	SelectOutgoingReferences(ctx context.Context, arg *SelectOutgoingReferencesParams) ([]*SelectOutgoingReferencesRow, error)
+	SelectRevision(ctx context.Context, arg *SelectRevisionParams) (*NoteRevision, error)
+	SelectRevisionsByNote(ctx context.Context, arg *SelectRevisionsByNoteParams) ([]*NoteRevision, error)
+	SelectSharedNotesByUser(ctx context.Context, arg *SelectSharedNotesByUserParams) ([]*SelectSharedNotesByUserRow, error)
+	SelectTag(ctx context.Context, arg *SelectTagParams) (*Tag, error)
+	SelectTagsByNotes(ctx context.Context, noteIds []int64) ([]*SelectTagsByNotesRow, error)
+	SelectTagsByUser(ctx context.Context, userID int64) ([]*SelectTagsByUserRow, error)
+	SelectTrashedNotesByUser(ctx context.Context, arg *SelectTrashedNotesByUserParams) ([]*Note, error)
 }
 
 var _ Querier = (*Queries)(nil)
@@ -0,0 +1,89 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: search_notes_by_user.sql
+
+package queries
+
+import (
+	"context"
+)
+
+const searchNotesByUser = `-- name: SearchNotesByUser :many
+SELECT notes.id, notes.title, notes.content, notes.user_id, notes.created_at, notes.updated_at, notes.deleted_at, notes.notebook_id, notes.version, notes.pinned, notes.archived, notes.starred, notes.search,
+       ts_rank(notes.search, websearch_to_tsquery('simple', $1))::real AS rank,
+       ts_headline('simple', translate(notes.title, chr(57344) || chr(57345), ''),
+                   websearch_to_tsquery('simple', $1),
+                   'StartSel=' || chr(57344) || ', StopSel=' || chr(57345) || ', HighlightAll=true')::text AS title_snippet,
+       ts_headline('simple', translate(notes.content, chr(57344) || chr(57345), ''),
+                   websearch_to_tsquery('simple', $1),
+                   'StartSel=' || chr(57344) || ', StopSel=' || chr(57345) ||
+                   ', MaxFragments=3, FragmentDelimiter=" ... "')::text AS content_snippet
+FROM notes
+WHERE notes.user_id = $2
+  AND notes.deleted_at IS NULL
+  AND notes.search @@ websearch_to_tsquery('simple', $1)
+  AND ($3::bigint IS NULL
+    OR (ts_rank(notes.search, websearch_to_tsquery('simple', $1))::real, notes.id)
+           < ($4::real, $3))
+ORDER BY rank DESC, notes.id DESC
+LIMIT $5
+`
+
+type SearchNotesByUserParams struct {
+	Query     string   `db:"query"`
+	UserID    *int64   `db:"user_id"`
+	AfterID   *int64   `db:"after_id"`
+	AfterRank *float32 `db:"after_rank"`
+	PageLimit int32    `db:"page_limit"`
+}
+
+type SearchNotesByUserRow struct {
+	Note           Note    `db:"note"`
+	Rank           float32 `db:"rank"`
+	TitleSnippet   string  `db:"title_snippet"`
+	ContentSnippet string  `db:"content_snippet"`
+}
+
+func (q *Queries) SearchNotesByUser(ctx context.Context, arg *SearchNotesByUserParams) ([]*SearchNotesByUserRow, error) {
+	rows, err := q.db.Query(ctx, searchNotesByUser,
+		arg.Query,
+		arg.UserID,
+		arg.AfterID,
+		arg.AfterRank,
+		arg.PageLimit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*SearchNotesByUserRow
+	for rows.Next() {
+		var i SearchNotesByUserRow
+		if err := rows.Scan(
+			&i.Note.ID,
+			&i.Note.Title,
+			&i.Note.Content,
+			&i.Note.UserID,
+			&i.Note.CreatedAt,
+			&i.Note.UpdatedAt,
+			&i.Note.DeletedAt,
+			&i.Note.NotebookID,
+			&i.Note.Version,
+			&i.Note.Pinned,
+			&i.Note.Archived,
+			&i.Note.Starred,
+			&i.Note.Search,
+			&i.Rank,
+			&i.TitleSnippet,
+			&i.ContentSnippet,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
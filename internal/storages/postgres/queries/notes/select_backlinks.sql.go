@@ -0,0 +1,64 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: select_backlinks.sql
+
+package queries
+
+import (
+	"context"
+)
+
+const selectBacklinks = `-- name: SelectBacklinks :many
+SELECT id, title, content, user_id, created_at, updated_at, deleted_at, notebook_id, version, pinned, archived, starred, search
+FROM notes
+WHERE user_id = $1
+  AND deleted_at IS NULL
+  AND id <> $2
+  AND EXISTS (SELECT 1
+              FROM note_references
+              WHERE note_references.source_id = notes.id
+                AND (note_references.target_id = $2
+                  OR lower(note_references.target_title) = lower($3::text)))
+ORDER BY updated_at DESC, id DESC
+`
+
+type SelectBacklinksParams struct {
+	UserID *int64 `db:"user_id"`
+	NoteID int64  `db:"note_id"`
+	Title  string `db:"title"`
+}
+
+func (q *Queries) SelectBacklinks(ctx context.Context, arg *SelectBacklinksParams) ([]*Note, error) {
+	rows, err := q.db.Query(ctx, selectBacklinks, arg.UserID, arg.NoteID, arg.Title)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*Note
+	for rows.Next() {
+		var i Note
+		if err := rows.Scan(
+			&i.ID,
+			&i.Title,
+			&i.Content,
+			&i.UserID,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.DeletedAt,
+			&i.NotebookID,
+			&i.Version,
+			&i.Pinned,
+			&i.Archived,
+			&i.Starred,
+			&i.Search,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -0,0 +1,29 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: select_collaborator.sql
+
+package queries
+
+import (
+	"context"
+)
+
+const selectCollaborator = `-- name: SelectCollaborator :one
+SELECT id, name, email
+FROM users
+WHERE email = $1
+`
+
+type SelectCollaboratorRow struct {
+	ID    int64  `db:"id"`
+	Name  string `db:"name"`
+	Email string `db:"email"`
+}
+
+func (q *Queries) SelectCollaborator(ctx context.Context, email string) (*SelectCollaboratorRow, error) {
+	row := q.db.QueryRow(ctx, selectCollaborator, email)
+	var i SelectCollaboratorRow
+	err := row.Scan(&i.ID, &i.Name, &i.Email)
+	return &i, err
+}
@@ -0,0 +1,68 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: select_dangling_references.sql
+
+package queries
+
+import (
+	"context"
+)
+
+const selectDanglingReferences = `-- name: SelectDanglingReferences :many
+SELECT note_references.source_id, note_references.position, note_references.target_id, note_references.target_title, notes.id, notes.title, notes.content, notes.user_id, notes.created_at, notes.updated_at, notes.deleted_at, notes.notebook_id, notes.version, notes.pinned, notes.archived, notes.starred, notes.search
+FROM note_references
+         JOIN notes ON notes.id = note_references.source_id
+WHERE notes.user_id = $1
+  AND notes.deleted_at IS NULL
+  AND NOT EXISTS (SELECT 1
+                  FROM notes targets
+                  WHERE targets.user_id = $1
+                    AND targets.deleted_at IS NULL
+                    AND (targets.id = note_references.target_id
+                      OR lower(targets.title) = lower(note_references.target_title)))
+ORDER BY notes.updated_at DESC, notes.id DESC, note_references.position
+`
+
+type SelectDanglingReferencesRow struct {
+	NoteReference NoteReference `db:"note_reference"`
+	Note          Note          `db:"note"`
+}
+
+func (q *Queries) SelectDanglingReferences(ctx context.Context, userID *int64) ([]*SelectDanglingReferencesRow, error) {
+	rows, err := q.db.Query(ctx, selectDanglingReferences, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*SelectDanglingReferencesRow
+	for rows.Next() {
+		var i SelectDanglingReferencesRow
+		if err := rows.Scan(
+			&i.NoteReference.SourceID,
+			&i.NoteReference.Position,
+			&i.NoteReference.TargetID,
+			&i.NoteReference.TargetTitle,
+			&i.Note.ID,
+			&i.Note.Title,
+			&i.Note.Content,
+			&i.Note.UserID,
+			&i.Note.CreatedAt,
+			&i.Note.UpdatedAt,
+			&i.Note.DeletedAt,
+			&i.Note.NotebookID,
+			&i.Note.Version,
+			&i.Note.Pinned,
+			&i.Note.Archived,
+			&i.Note.Starred,
+			&i.Note.Search,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
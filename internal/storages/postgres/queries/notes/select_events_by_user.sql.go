@@ -0,0 +1,78 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: select_events_by_user.sql
+
+package queries
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const selectEventsByUser = `-- name: SelectEventsByUser :many
+SELECT id, event_type, note_id, recipient_id, event_time, read_at
+FROM note_events
+WHERE note_events.recipient_id = $1
+  AND (coalesce(cardinality($2::smallint[]), 0) = 0 OR event_type = ANY ($2::smallint[]))
+  AND (coalesce(cardinality($3::bigint[]), 0) = 0 OR note_id = ANY ($3::bigint[]))
+  AND (coalesce(cardinality($4::text[]), 0) = 0 OR EXISTS (SELECT 1
+                                                              FROM note_tags
+                                                                       JOIN tags ON tags.id = note_tags.tag_id
+                                                              WHERE note_tags.note_id = note_events.note_id
+                                                                AND tags.name = ANY ($4::text[])))
+  AND (NOT $5::boolean OR read_at IS NULL)
+  AND ($6::uuid IS NULL
+    OR (event_time, id) < ($7::timestamptz, $6))
+ORDER BY event_time DESC, id DESC
+LIMIT $8
+`
+
+type SelectEventsByUserParams struct {
+	RecipientID    int64       `db:"recipient_id"`
+	EventTypes     []int16     `db:"event_types"`
+	NoteIds        []int64     `db:"note_ids"`
+	Tags           []string    `db:"tags"`
+	Unread         bool        `db:"unread"`
+	AfterID        pgtype.UUID `db:"after_id"`
+	AfterEventTime *time.Time  `db:"after_event_time"`
+	PageLimit      int32       `db:"page_limit"`
+}
+
+func (q *Queries) SelectEventsByUser(ctx context.Context, arg *SelectEventsByUserParams) ([]*NoteEvent, error) {
+	rows, err := q.db.Query(ctx, selectEventsByUser,
+		arg.RecipientID,
+		arg.EventTypes,
+		arg.NoteIds,
+		arg.Tags,
+		arg.Unread,
+		arg.AfterID,
+		arg.AfterEventTime,
+		arg.PageLimit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*NoteEvent
+	for rows.Next() {
+		var i NoteEvent
+		if err := rows.Scan(
+			&i.ID,
+			&i.EventType,
+			&i.NoteID,
+			&i.RecipientID,
+			&i.EventTime,
+			&i.ReadAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
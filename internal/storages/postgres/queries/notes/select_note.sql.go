@@ -10,7 +10,7 @@ import (
 )
 
 const selectNote = `-- name: SelectNote :one
-SELECT id, title, content, user_id, created_at, updated_at
+SELECT id, title, content, user_id, created_at, updated_at, deleted_at, notebook_id, version, pinned, archived, starred, search
 FROM notes
 WHERE id = $1
 `
@@ -25,6 +25,13 @@ func (q *Queries) SelectNote(ctx context.Context, id int64) (*Note, error) {
 		&i.UserID,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.DeletedAt,
+		&i.NotebookID,
+		&i.Version,
+		&i.Pinned,
+		&i.Archived,
+		&i.Starred,
+		&i.Search,
 	)
 	return &i, err
 }
@@ -0,0 +1,33 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: select_note_attachment.sql
+
+package queries
+
+import (
+	"context"
+)
+
+const selectNoteAttachment = `-- name: SelectNoteAttachment :one
+SELECT id, note_id, user_id, name, content_type, blob_key, size, checksum, created_at
+FROM note_attachments
+WHERE id = $1
+`
+
+func (q *Queries) SelectNoteAttachment(ctx context.Context, id int64) (*NoteAttachment, error) {
+	row := q.db.QueryRow(ctx, selectNoteAttachment, id)
+	var i NoteAttachment
+	err := row.Scan(
+		&i.ID,
+		&i.NoteID,
+		&i.UserID,
+		&i.Name,
+		&i.ContentType,
+		&i.BlobKey,
+		&i.Size,
+		&i.Checksum,
+		&i.CreatedAt,
+	)
+	return &i, err
+}
@@ -0,0 +1,47 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: select_note_attachments.sql
+
+package queries
+
+import (
+	"context"
+)
+
+const selectNoteAttachments = `-- name: SelectNoteAttachments :many
+SELECT id, note_id, user_id, name, content_type, blob_key, size, checksum, created_at
+FROM note_attachments
+WHERE note_id = $1
+ORDER BY created_at DESC, id DESC
+`
+
+func (q *Queries) SelectNoteAttachments(ctx context.Context, noteID int64) ([]*NoteAttachment, error) {
+	rows, err := q.db.Query(ctx, selectNoteAttachments, noteID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*NoteAttachment
+	for rows.Next() {
+		var i NoteAttachment
+		if err := rows.Scan(
+			&i.ID,
+			&i.NoteID,
+			&i.UserID,
+			&i.Name,
+			&i.ContentType,
+			&i.BlobKey,
+			&i.Size,
+			&i.Checksum,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -0,0 +1,49 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: select_note_by_content.sql
+
+package queries
+
+import (
+	"context"
+)
+
+const selectNoteByContent = `-- name: SelectNoteByContent :one
+SELECT id, title, content, user_id, created_at, updated_at, deleted_at, notebook_id, version, pinned, archived, starred, search
+FROM notes
+WHERE user_id = $1
+  AND deleted_at IS NULL
+  AND md5(content) = md5($2::text)
+  AND title = $3
+  AND content = $2
+ORDER BY id
+LIMIT 1
+`
+
+type SelectNoteByContentParams struct {
+	UserID  *int64 `db:"user_id"`
+	Content string `db:"content"`
+	Title   string `db:"title"`
+}
+
+func (q *Queries) SelectNoteByContent(ctx context.Context, arg *SelectNoteByContentParams) (*Note, error) {
+	row := q.db.QueryRow(ctx, selectNoteByContent, arg.UserID, arg.Content, arg.Title)
+	var i Note
+	err := row.Scan(
+		&i.ID,
+		&i.Title,
+		&i.Content,
+		&i.UserID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+		&i.NotebookID,
+		&i.Version,
+		&i.Pinned,
+		&i.Archived,
+		&i.Starred,
+		&i.Search,
+	)
+	return &i, err
+}
@@ -0,0 +1,30 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: select_note_link.sql
+
+package queries
+
+import (
+	"context"
+)
+
+const selectNoteLink = `-- name: SelectNoteLink :one
+SELECT id, note_id, token, views, expires_at, created_at
+FROM note_links
+WHERE token = $1
+`
+
+func (q *Queries) SelectNoteLink(ctx context.Context, token string) (*NoteLink, error) {
+	row := q.db.QueryRow(ctx, selectNoteLink, token)
+	var i NoteLink
+	err := row.Scan(
+		&i.ID,
+		&i.NoteID,
+		&i.Token,
+		&i.Views,
+		&i.ExpiresAt,
+		&i.CreatedAt,
+	)
+	return &i, err
+}
@@ -0,0 +1,44 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: select_note_links.sql
+
+package queries
+
+import (
+	"context"
+)
+
+const selectNoteLinks = `-- name: SelectNoteLinks :many
+SELECT id, note_id, token, views, expires_at, created_at
+FROM note_links
+WHERE note_id = $1
+ORDER BY created_at DESC, id DESC
+`
+
+func (q *Queries) SelectNoteLinks(ctx context.Context, noteID int64) ([]*NoteLink, error) {
+	rows, err := q.db.Query(ctx, selectNoteLinks, noteID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*NoteLink
+	for rows.Next() {
+		var i NoteLink
+		if err := rows.Scan(
+			&i.ID,
+			&i.NoteID,
+			&i.Token,
+			&i.Views,
+			&i.ExpiresAt,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -0,0 +1,34 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: select_note_reminder.sql
+
+package queries
+
+import (
+	"context"
+)
+
+const selectNoteReminder = `-- name: SelectNoteReminder :one
+SELECT id, note_id, user_id, fire_at, fired_at, recurrence, time_zone, occurrences, created_at, updated_at
+FROM note_reminders
+WHERE id = $1
+`
+
+func (q *Queries) SelectNoteReminder(ctx context.Context, id int64) (*NoteReminder, error) {
+	row := q.db.QueryRow(ctx, selectNoteReminder, id)
+	var i NoteReminder
+	err := row.Scan(
+		&i.ID,
+		&i.NoteID,
+		&i.UserID,
+		&i.FireAt,
+		&i.FiredAt,
+		&i.Recurrence,
+		&i.TimeZone,
+		&i.Occurrences,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return &i, err
+}
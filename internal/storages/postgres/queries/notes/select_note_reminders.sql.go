@@ -0,0 +1,54 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: select_note_reminders.sql
+
+package queries
+
+import (
+	"context"
+)
+
+const selectNoteReminders = `-- name: SelectNoteReminders :many
+SELECT id, note_id, user_id, fire_at, fired_at, recurrence, time_zone, occurrences, created_at, updated_at
+FROM note_reminders
+WHERE note_id = $1
+  AND user_id = $2
+ORDER BY fire_at NULLS LAST, id
+`
+
+type SelectNoteRemindersParams struct {
+	NoteID int64 `db:"note_id"`
+	UserID int64 `db:"user_id"`
+}
+
+func (q *Queries) SelectNoteReminders(ctx context.Context, arg *SelectNoteRemindersParams) ([]*NoteReminder, error) {
+	rows, err := q.db.Query(ctx, selectNoteReminders, arg.NoteID, arg.UserID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*NoteReminder
+	for rows.Next() {
+		var i NoteReminder
+		if err := rows.Scan(
+			&i.ID,
+			&i.NoteID,
+			&i.UserID,
+			&i.FireAt,
+			&i.FiredAt,
+			&i.Recurrence,
+			&i.TimeZone,
+			&i.Occurrences,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -0,0 +1,34 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: select_note_share.sql
+
+package queries
+
+import (
+	"context"
+)
+
+const selectNoteShare = `-- name: SelectNoteShare :one
+SELECT note_id, user_id, role, created_at
+FROM note_shares
+WHERE note_id = $1
+  AND user_id = $2
+`
+
+type SelectNoteShareParams struct {
+	NoteID int64 `db:"note_id"`
+	UserID int64 `db:"user_id"`
+}
+
+func (q *Queries) SelectNoteShare(ctx context.Context, arg *SelectNoteShareParams) (*NoteShare, error) {
+	row := q.db.QueryRow(ctx, selectNoteShare, arg.NoteID, arg.UserID)
+	var i NoteShare
+	err := row.Scan(
+		&i.NoteID,
+		&i.UserID,
+		&i.Role,
+		&i.CreatedAt,
+	)
+	return &i, err
+}
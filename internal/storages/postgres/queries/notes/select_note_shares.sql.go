@@ -0,0 +1,51 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: select_note_shares.sql
+
+package queries
+
+import (
+	"context"
+)
+
+const selectNoteShares = `-- name: SelectNoteShares :many
+SELECT note_shares.note_id, note_shares.user_id, note_shares.role, note_shares.created_at, users.name, users.email
+FROM note_shares
+         JOIN users ON users.id = note_shares.user_id
+WHERE note_shares.note_id = $1
+ORDER BY note_shares.created_at, note_shares.user_id
+`
+
+type SelectNoteSharesRow struct {
+	NoteShare NoteShare `db:"note_share"`
+	Name      string    `db:"name"`
+	Email     string    `db:"email"`
+}
+
+func (q *Queries) SelectNoteShares(ctx context.Context, noteID int64) ([]*SelectNoteSharesRow, error) {
+	rows, err := q.db.Query(ctx, selectNoteShares, noteID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*SelectNoteSharesRow
+	for rows.Next() {
+		var i SelectNoteSharesRow
+		if err := rows.Scan(
+			&i.NoteShare.NoteID,
+			&i.NoteShare.UserID,
+			&i.NoteShare.Role,
+			&i.NoteShare.CreatedAt,
+			&i.Name,
+			&i.Email,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -0,0 +1,31 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: select_note_template.sql
+
+package queries
+
+import (
+	"context"
+)
+
+const selectNoteTemplate = `-- name: SelectNoteTemplate :one
+SELECT id, user_id, name, title, content, created_at, updated_at
+FROM note_templates
+WHERE id = $1
+`
+
+func (q *Queries) SelectNoteTemplate(ctx context.Context, id int64) (*NoteTemplate, error) {
+	row := q.db.QueryRow(ctx, selectNoteTemplate, id)
+	var i NoteTemplate
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.Name,
+		&i.Title,
+		&i.Content,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return &i, err
+}
@@ -1,7 +1,7 @@
 // Code generated by sqlc. DO NOT EDIT.
 // versions:
 //   sqlc v1.30.0
-// source: select_notes_by_user.sql
+// source: select_note_templates_by_user.sql
 
 package queries
 
@@ -9,27 +9,28 @@ import (
 	"context"
 )
 
-const selectNotesByUser = `-- name: SelectNotesByUser :many
-SELECT id, title, content, user_id, created_at, updated_at
-FROM notes
+const selectNoteTemplatesByUser = `-- name: SelectNoteTemplatesByUser :many
+SELECT id, user_id, name, title, content, created_at, updated_at
+FROM note_templates
 WHERE user_id = $1
-ORDER BY notes.id
+ORDER BY name, id
 `
 
-func (q *Queries) SelectNotesByUser(ctx context.Context, userID *int64) ([]*Note, error) {
-	rows, err := q.db.Query(ctx, selectNotesByUser, userID)
+func (q *Queries) SelectNoteTemplatesByUser(ctx context.Context, userID int64) ([]*NoteTemplate, error) {
+	rows, err := q.db.Query(ctx, selectNoteTemplatesByUser, userID)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []*Note
+	var items []*NoteTemplate
 	for rows.Next() {
-		var i Note
+		var i NoteTemplate
 		if err := rows.Scan(
 			&i.ID,
+			&i.UserID,
+			&i.Name,
 			&i.Title,
 			&i.Content,
-			&i.UserID,
 			&i.CreatedAt,
 			&i.UpdatedAt,
 		); err != nil {
@@ -0,0 +1,30 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: select_notebook.sql
+
+package queries
+
+import (
+	"context"
+)
+
+const selectNotebook = `-- name: SelectNotebook :one
+SELECT id, user_id, parent_id, name, created_at, updated_at
+FROM notebooks
+WHERE id = $1
+`
+
+func (q *Queries) SelectNotebook(ctx context.Context, id int64) (*Notebook, error) {
+	row := q.db.QueryRow(ctx, selectNotebook, id)
+	var i Notebook
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.ParentID,
+		&i.Name,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return &i, err
+}
@@ -0,0 +1,54 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: select_notebook_descendants.sql
+
+package queries
+
+import (
+	"context"
+)
+
+const selectNotebookDescendants = `-- name: SelectNotebookDescendants :many
+WITH RECURSIVE descendants AS (SELECT notebooks.id, notebooks.user_id, notebooks.parent_id, notebooks.name, notebooks.created_at, notebooks.updated_at
+                               FROM notebooks
+                               WHERE notebooks.parent_id = $1
+                               UNION
+                               SELECT notebooks.id, notebooks.user_id, notebooks.parent_id, notebooks.name, notebooks.created_at, notebooks.updated_at
+                               FROM notebooks
+                                        JOIN descendants ON notebooks.parent_id = descendants.id)
+SELECT notebooks.id, notebooks.user_id, notebooks.parent_id, notebooks.name, notebooks.created_at, notebooks.updated_at
+FROM notebooks
+         JOIN descendants ON descendants.id = notebooks.id
+`
+
+type SelectNotebookDescendantsRow struct {
+	Notebook Notebook `db:"notebook"`
+}
+
+func (q *Queries) SelectNotebookDescendants(ctx context.Context, id *int64) ([]*SelectNotebookDescendantsRow, error) {
+	rows, err := q.db.Query(ctx, selectNotebookDescendants, id)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*SelectNotebookDescendantsRow
+	for rows.Next() {
+		var i SelectNotebookDescendantsRow
+		if err := rows.Scan(
+			&i.Notebook.ID,
+			&i.Notebook.UserID,
+			&i.Notebook.ParentID,
+			&i.Notebook.Name,
+			&i.Notebook.CreatedAt,
+			&i.Notebook.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -0,0 +1,44 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: select_notebooks_by_user.sql
+
+package queries
+
+import (
+	"context"
+)
+
+const selectNotebooksByUser = `-- name: SelectNotebooksByUser :many
+SELECT id, user_id, parent_id, name, created_at, updated_at
+FROM notebooks
+WHERE user_id = $1
+ORDER BY name, id
+`
+
+func (q *Queries) SelectNotebooksByUser(ctx context.Context, userID int64) ([]*Notebook, error) {
+	rows, err := q.db.Query(ctx, selectNotebooksByUser, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*Notebook
+	for rows.Next() {
+		var i Notebook
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.ParentID,
+			&i.Name,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -0,0 +1,113 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: select_notes_by_user_order_by_created_at_asc.sql
+
+package queries
+
+import (
+	"context"
+	"time"
+)
+
+const selectNotesByUserOrderByCreatedAtAsc = `-- name: SelectNotesByUserOrderByCreatedAtAsc :many
+SELECT id, title, content, user_id, created_at, updated_at, deleted_at, notebook_id, version, pinned, archived, starred, search
+FROM notes
+WHERE notes.user_id = $1
+  AND deleted_at IS NULL
+  AND ($2::timestamptz IS NULL OR created_at >= $2)
+  AND ($3::timestamptz IS NULL OR created_at < $3)
+  AND ($4::timestamptz IS NULL OR updated_at >= $4)
+  AND ($5::timestamptz IS NULL OR updated_at < $5)
+  AND ($6::text IS NULL OR starts_with(title, $6))
+  AND ($7::bigint IS NULL OR notes.notebook_id = $7)
+  AND ($8::boolean IS NULL OR pinned = $8)
+  AND ($9::boolean IS NULL OR archived = $9)
+  AND ($10::boolean IS NULL OR starred = $10)
+  AND (coalesce(cardinality($11::text[]), 0) = 0 OR EXISTS (SELECT 1
+                                                                  FROM note_tags
+                                                                           JOIN tags ON tags.id = note_tags.tag_id
+                                                                  WHERE note_tags.note_id = notes.id
+                                                                    AND tags.name = ANY ($11::text[])))
+  AND (coalesce(cardinality($12::text[]), 0) = 0 OR (SELECT count(DISTINCT tags.name)
+                                                           FROM note_tags
+                                                                    JOIN tags ON tags.id = note_tags.tag_id
+                                                           WHERE note_tags.note_id = notes.id
+                                                             AND tags.name = ANY ($12::text[]))
+    = cardinality($12::text[]))
+  AND ($13::bigint IS NULL
+    OR pinned < $14::boolean
+    OR (pinned = $14 AND (created_at, id) > ($15::timestamptz, $13)))
+ORDER BY pinned DESC, created_at, id
+LIMIT $16
+`
+
+type SelectNotesByUserOrderByCreatedAtAscParams struct {
+	UserID         *int64     `db:"user_id"`
+	CreatedAfter   *time.Time `db:"created_after"`
+	CreatedBefore  *time.Time `db:"created_before"`
+	UpdatedAfter   *time.Time `db:"updated_after"`
+	UpdatedBefore  *time.Time `db:"updated_before"`
+	TitlePrefix    *string    `db:"title_prefix"`
+	NotebookID     *int64     `db:"notebook_id"`
+	Pinned         *bool      `db:"pinned"`
+	Archived       *bool      `db:"archived"`
+	Starred        *bool      `db:"starred"`
+	TagsAny        []string   `db:"tags_any"`
+	TagsAll        []string   `db:"tags_all"`
+	AfterID        *int64     `db:"after_id"`
+	AfterPinned    *bool      `db:"after_pinned"`
+	AfterCreatedAt *time.Time `db:"after_created_at"`
+	PageLimit      int32      `db:"page_limit"`
+}
+
+func (q *Queries) SelectNotesByUserOrderByCreatedAtAsc(ctx context.Context, arg *SelectNotesByUserOrderByCreatedAtAscParams) ([]*Note, error) {
+	rows, err := q.db.Query(ctx, selectNotesByUserOrderByCreatedAtAsc,
+		arg.UserID,
+		arg.CreatedAfter,
+		arg.CreatedBefore,
+		arg.UpdatedAfter,
+		arg.UpdatedBefore,
+		arg.TitlePrefix,
+		arg.NotebookID,
+		arg.Pinned,
+		arg.Archived,
+		arg.Starred,
+		arg.TagsAny,
+		arg.TagsAll,
+		arg.AfterID,
+		arg.AfterPinned,
+		arg.AfterCreatedAt,
+		arg.PageLimit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*Note
+	for rows.Next() {
+		var i Note
+		if err := rows.Scan(
+			&i.ID,
+			&i.Title,
+			&i.Content,
+			&i.UserID,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.DeletedAt,
+			&i.NotebookID,
+			&i.Version,
+			&i.Pinned,
+			&i.Archived,
+			&i.Starred,
+			&i.Search,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -0,0 +1,28 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: select_outbox_backlog.sql
+
+package queries
+
+import (
+	"context"
+	"time"
+)
+
+const selectOutboxBacklog = `-- name: SelectOutboxBacklog :one
+SELECT COUNT(*) AS events, COALESCE(MIN(created_at), now())::TIMESTAMPTZ AS oldest
+FROM event_outbox
+`
+
+type SelectOutboxBacklogRow struct {
+	Events int64     `db:"events"`
+	Oldest time.Time `db:"oldest"`
+}
+
+func (q *Queries) SelectOutboxBacklog(ctx context.Context) (*SelectOutboxBacklogRow, error) {
+	row := q.db.QueryRow(ctx, selectOutboxBacklog)
+	var i SelectOutboxBacklogRow
+	err := row.Scan(&i.Events, &i.Oldest)
+	return &i, err
+}
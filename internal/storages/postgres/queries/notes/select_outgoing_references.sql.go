@@ -0,0 +1,60 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: select_outgoing_references.sql
+
+package queries
+
+import (
+	"context"
+)
+
+const selectOutgoingReferences = `-- name: SelectOutgoingReferences :many
+SELECT DISTINCT ON (note_references.position) note_references.source_id, note_references.position, note_references.target_id, note_references.target_title,
+                                              targets.id    AS resolved_id,
+                                              targets.title AS resolved_title
+FROM note_references
+         LEFT JOIN notes targets ON targets.user_id = $1
+    AND targets.deleted_at IS NULL
+    AND (targets.id = note_references.target_id OR lower(targets.title) = lower(note_references.target_title))
+WHERE note_references.source_id = $2
+ORDER BY note_references.position, targets.id
+`
+
+type SelectOutgoingReferencesParams struct {
+	UserID   *int64 `db:"user_id"`
+	SourceID int64  `db:"source_id"`
+}
+
+type SelectOutgoingReferencesRow struct {
+	NoteReference NoteReference `db:"note_reference"`
+	ResolvedID    *int64        `db:"resolved_id"`
+	ResolvedTitle *string       `db:"resolved_title"`
+}
+
+func (q *Queries) SelectOutgoingReferences(ctx context.Context, arg *SelectOutgoingReferencesParams) ([]*SelectOutgoingReferencesRow, error) {
+	rows, err := q.db.Query(ctx, selectOutgoingReferences, arg.UserID, arg.SourceID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*SelectOutgoingReferencesRow
+	for rows.Next() {
+		var i SelectOutgoingReferencesRow
+		if err := rows.Scan(
+			&i.NoteReference.SourceID,
+			&i.NoteReference.Position,
+			&i.NoteReference.TargetID,
+			&i.NoteReference.TargetTitle,
+			&i.ResolvedID,
+			&i.ResolvedTitle,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
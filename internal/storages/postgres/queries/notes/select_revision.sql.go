@@ -0,0 +1,37 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: select_revision.sql
+
+package queries
+
+import (
+	"context"
+)
+
+const selectRevision = `-- name: SelectRevision :one
+SELECT id, note_id, revision, title, content, user_id, created_at
+FROM note_revisions
+WHERE note_id = $1
+  AND revision = $2
+`
+
+type SelectRevisionParams struct {
+	NoteID   int64 `db:"note_id"`
+	Revision int32 `db:"revision"`
+}
+
+func (q *Queries) SelectRevision(ctx context.Context, arg *SelectRevisionParams) (*NoteRevision, error) {
+	row := q.db.QueryRow(ctx, selectRevision, arg.NoteID, arg.Revision)
+	var i NoteRevision
+	err := row.Scan(
+		&i.ID,
+		&i.NoteID,
+		&i.Revision,
+		&i.Title,
+		&i.Content,
+		&i.UserID,
+		&i.CreatedAt,
+	)
+	return &i, err
+}
@@ -0,0 +1,53 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: select_revisions_by_note.sql
+
+package queries
+
+import (
+	"context"
+)
+
+const selectRevisionsByNote = `-- name: SelectRevisionsByNote :many
+SELECT id, note_id, revision, title, content, user_id, created_at
+FROM note_revisions
+WHERE note_id = $1
+  AND ($2::integer IS NULL OR revision < $2)
+ORDER BY revision DESC
+LIMIT $3
+`
+
+type SelectRevisionsByNoteParams struct {
+	NoteID        int64  `db:"note_id"`
+	AfterRevision *int32 `db:"after_revision"`
+	PageLimit     int32  `db:"page_limit"`
+}
+
+func (q *Queries) SelectRevisionsByNote(ctx context.Context, arg *SelectRevisionsByNoteParams) ([]*NoteRevision, error) {
+	rows, err := q.db.Query(ctx, selectRevisionsByNote, arg.NoteID, arg.AfterRevision, arg.PageLimit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*NoteRevision
+	for rows.Next() {
+		var i NoteRevision
+		if err := rows.Scan(
+			&i.ID,
+			&i.NoteID,
+			&i.Revision,
+			&i.Title,
+			&i.Content,
+			&i.UserID,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
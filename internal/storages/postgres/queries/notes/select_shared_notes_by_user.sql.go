@@ -0,0 +1,77 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: select_shared_notes_by_user.sql
+
+package queries
+
+import (
+	"context"
+	"time"
+)
+
+const selectSharedNotesByUser = `-- name: SelectSharedNotesByUser :many
+SELECT notes.id, notes.title, notes.content, notes.user_id, notes.created_at, notes.updated_at, notes.deleted_at, notes.notebook_id, notes.version, notes.pinned, notes.archived, notes.starred, notes.search, note_shares.role, note_shares.created_at AS shared_at
+FROM note_shares
+         JOIN notes ON notes.id = note_shares.note_id
+WHERE note_shares.user_id = $1
+  AND notes.deleted_at IS NULL
+  AND ($2::bigint IS NULL
+    OR (note_shares.created_at, notes.id) < ($3::timestamptz, $2))
+ORDER BY note_shares.created_at DESC, notes.id DESC
+LIMIT $4
+`
+
+type SelectSharedNotesByUserParams struct {
+	UserID        int64      `db:"user_id"`
+	AfterID       *int64     `db:"after_id"`
+	AfterSharedAt *time.Time `db:"after_shared_at"`
+	PageLimit     int32      `db:"page_limit"`
+}
+
+type SelectSharedNotesByUserRow struct {
+	Note     Note      `db:"note"`
+	Role     string    `db:"role"`
+	SharedAt time.Time `db:"shared_at"`
+}
+
+func (q *Queries) SelectSharedNotesByUser(ctx context.Context, arg *SelectSharedNotesByUserParams) ([]*SelectSharedNotesByUserRow, error) {
+	rows, err := q.db.Query(ctx, selectSharedNotesByUser,
+		arg.UserID,
+		arg.AfterID,
+		arg.AfterSharedAt,
+		arg.PageLimit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*SelectSharedNotesByUserRow
+	for rows.Next() {
+		var i SelectSharedNotesByUserRow
+		if err := rows.Scan(
+			&i.Note.ID,
+			&i.Note.Title,
+			&i.Note.Content,
+			&i.Note.UserID,
+			&i.Note.CreatedAt,
+			&i.Note.UpdatedAt,
+			&i.Note.DeletedAt,
+			&i.Note.NotebookID,
+			&i.Note.Version,
+			&i.Note.Pinned,
+			&i.Note.Archived,
+			&i.Note.Starred,
+			&i.Note.Search,
+			&i.Role,
+			&i.SharedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -0,0 +1,34 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: select_tag.sql
+
+package queries
+
+import (
+	"context"
+)
+
+const selectTag = `-- name: SelectTag :one
+SELECT id, user_id, name, created_at
+FROM tags
+WHERE user_id = $1
+  AND name = $2
+`
+
+type SelectTagParams struct {
+	UserID int64  `db:"user_id"`
+	Name   string `db:"name"`
+}
+
+func (q *Queries) SelectTag(ctx context.Context, arg *SelectTagParams) (*Tag, error) {
+	row := q.db.QueryRow(ctx, selectTag, arg.UserID, arg.Name)
+	var i Tag
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.Name,
+		&i.CreatedAt,
+	)
+	return &i, err
+}
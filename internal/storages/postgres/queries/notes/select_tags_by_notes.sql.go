@@ -0,0 +1,49 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: select_tags_by_notes.sql
+
+package queries
+
+import (
+	"context"
+)
+
+const selectTagsByNotes = `-- name: SelectTagsByNotes :many
+SELECT note_tags.note_id, tags.id, tags.user_id, tags.name, tags.created_at
+FROM note_tags
+         JOIN tags ON tags.id = note_tags.tag_id
+WHERE note_tags.note_id = ANY ($1::bigint[])
+ORDER BY tags.name
+`
+
+type SelectTagsByNotesRow struct {
+	NoteID int64 `db:"note_id"`
+	Tag    Tag   `db:"tag"`
+}
+
+func (q *Queries) SelectTagsByNotes(ctx context.Context, noteIds []int64) ([]*SelectTagsByNotesRow, error) {
+	rows, err := q.db.Query(ctx, selectTagsByNotes, noteIds)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*SelectTagsByNotesRow
+	for rows.Next() {
+		var i SelectTagsByNotesRow
+		if err := rows.Scan(
+			&i.NoteID,
+			&i.Tag.ID,
+			&i.Tag.UserID,
+			&i.Tag.Name,
+			&i.Tag.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
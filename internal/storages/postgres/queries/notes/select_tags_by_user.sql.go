@@ -0,0 +1,51 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: select_tags_by_user.sql
+
+package queries
+
+import (
+	"context"
+)
+
+const selectTagsByUser = `-- name: SelectTagsByUser :many
+SELECT tags.id, tags.user_id, tags.name, tags.created_at, count(notes.id)::integer AS notes
+FROM tags
+         LEFT JOIN note_tags ON note_tags.tag_id = tags.id
+         LEFT JOIN notes ON notes.id = note_tags.note_id AND notes.deleted_at IS NULL
+WHERE tags.user_id = $1
+GROUP BY tags.id
+ORDER BY tags.name
+`
+
+type SelectTagsByUserRow struct {
+	Tag   Tag   `db:"tag"`
+	Notes int32 `db:"notes"`
+}
+
+func (q *Queries) SelectTagsByUser(ctx context.Context, userID int64) ([]*SelectTagsByUserRow, error) {
+	rows, err := q.db.Query(ctx, selectTagsByUser, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*SelectTagsByUserRow
+	for rows.Next() {
+		var i SelectTagsByUserRow
+		if err := rows.Scan(
+			&i.Tag.ID,
+			&i.Tag.UserID,
+			&i.Tag.Name,
+			&i.Tag.CreatedAt,
+			&i.Notes,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
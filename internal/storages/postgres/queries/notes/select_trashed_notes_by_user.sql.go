@@ -0,0 +1,68 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: select_trashed_notes_by_user.sql
+
+package queries
+
+import (
+	"context"
+	"time"
+)
+
+const selectTrashedNotesByUser = `-- name: SelectTrashedNotesByUser :many
+SELECT id, title, content, user_id, created_at, updated_at, deleted_at, notebook_id, version, pinned, archived, starred, search
+FROM notes
+WHERE user_id = $1
+  AND deleted_at IS NOT NULL
+  AND ($2::bigint IS NULL
+    OR (deleted_at, id) < ($3::timestamptz, $2))
+ORDER BY deleted_at DESC, id DESC
+LIMIT $4
+`
+
+type SelectTrashedNotesByUserParams struct {
+	UserID         *int64     `db:"user_id"`
+	AfterID        *int64     `db:"after_id"`
+	AfterDeletedAt *time.Time `db:"after_deleted_at"`
+	PageLimit      int32      `db:"page_limit"`
+}
+
+func (q *Queries) SelectTrashedNotesByUser(ctx context.Context, arg *SelectTrashedNotesByUserParams) ([]*Note, error) {
+	rows, err := q.db.Query(ctx, selectTrashedNotesByUser,
+		arg.UserID,
+		arg.AfterID,
+		arg.AfterDeletedAt,
+		arg.PageLimit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*Note
+	for rows.Next() {
+		var i Note
+		if err := rows.Scan(
+			&i.ID,
+			&i.Title,
+			&i.Content,
+			&i.UserID,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.DeletedAt,
+			&i.NotebookID,
+			&i.Version,
+			&i.Pinned,
+			&i.Archived,
+			&i.Starred,
+			&i.Search,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
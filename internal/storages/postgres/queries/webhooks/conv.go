@@ -0,0 +1,72 @@
+package queries
+
+import (
+	"time"
+
+	"github.com/therenotomorrow/gotes/internal/domain/entities"
+	"github.com/therenotomorrow/gotes/internal/domain/types/id"
+	"github.com/therenotomorrow/gotes/internal/domain/types/uuid"
+)
+
+func (w *Webhook) ToEntity() *entities.Webhook {
+	owner := new(entities.User)
+	owner.ID = id.New(w.UserID)
+
+	types := make([]entities.EventType, len(w.EventTypes))
+	for i, eventType := range w.EventTypes {
+		types[i] = entities.EventType(eventType)
+	}
+
+	return &entities.Webhook{
+		CreatedAt:  w.CreatedAt,
+		UpdatedAt:  w.UpdatedAt,
+		DisabledAt: w.DisabledAt,
+		Owner:      owner,
+		URL:        w.Url,
+		Secret:     w.Secret,
+		EventTypes: types,
+		ID:         id.New(w.ID),
+		Failures:   w.Failures,
+	}
+}
+
+type Webhooks []*Webhook
+
+func (w Webhooks) ToEntities() []*entities.Webhook {
+	webhooks := make([]*entities.Webhook, len(w))
+	for i, webhook := range w {
+		webhooks[i] = webhook.ToEntity()
+	}
+
+	return webhooks
+}
+
+func (a *WebhookAttempt) ToEntity(webhook *entities.Webhook) *entities.Attempt {
+	attempt := &entities.Attempt{
+		AttemptedAt: a.AttemptedAt,
+		Webhook:     webhook,
+		Error:       "",
+		EventID:     uuid.Conv(a.EventID.String()),
+		ID:          id.New(a.ID),
+		Duration:    time.Duration(a.Duration.Microseconds) * time.Microsecond,
+		EventType:   entities.EventType(a.EventType),
+		StatusCode:  a.StatusCode,
+	}
+
+	if a.Error != nil {
+		attempt.Error = *a.Error
+	}
+
+	return attempt
+}
+
+type WebhookAttempts []*WebhookAttempt
+
+func (a WebhookAttempts) ToEntities(webhook *entities.Webhook) []*entities.Attempt {
+	attempts := make([]*entities.Attempt, len(a))
+	for i, attempt := range a {
+		attempts[i] = attempt.ToEntity(webhook)
+	}
+
+	return attempts
+}
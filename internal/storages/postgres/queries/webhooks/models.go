@@ -0,0 +1,35 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+
+package queries
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+type Webhook struct {
+	ID         int64      `db:"id"`
+	UserID     int64      `db:"user_id"`
+	Url        string     `db:"url"`
+	Secret     string     `db:"secret"`
+	EventTypes []int16    `db:"event_types"`
+	Failures   int32      `db:"failures"`
+	DisabledAt *time.Time `db:"disabled_at"`
+	CreatedAt  time.Time  `db:"created_at"`
+	UpdatedAt  time.Time  `db:"updated_at"`
+}
+
+type WebhookAttempt struct {
+	ID          int64           `db:"id"`
+	WebhookID   int64           `db:"webhook_id"`
+	EventID     uuid.UUID       `db:"event_id"`
+	EventType   int16           `db:"event_type"`
+	StatusCode  int32           `db:"status_code"`
+	Error       *string         `db:"error"`
+	Duration    pgtype.Interval `db:"duration"`
+	AttemptedAt time.Time       `db:"attempted_at"`
+}
@@ -0,0 +1,17 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+
+package queries
+
+import (
+	"context"
+)
+
+type Querier interface {
+	SelectWebhook(ctx context.Context, id int64) (*Webhook, error)
+	SelectWebhookAttempts(ctx context.Context, arg *SelectWebhookAttemptsParams) ([]*WebhookAttempt, error)
+	SelectWebhooksByUser(ctx context.Context, userID int64) ([]*Webhook, error)
+}
+
+var _ Querier = (*Queries)(nil)
@@ -0,0 +1,33 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: select_webhook.sql
+
+package queries
+
+import (
+	"context"
+)
+
+const selectWebhook = `-- name: SelectWebhook :one
+SELECT id, user_id, url, secret, event_types, failures, disabled_at, created_at, updated_at
+FROM webhooks
+WHERE id = $1
+`
+
+func (q *Queries) SelectWebhook(ctx context.Context, id int64) (*Webhook, error) {
+	row := q.db.QueryRow(ctx, selectWebhook, id)
+	var i Webhook
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.Url,
+		&i.Secret,
+		&i.EventTypes,
+		&i.Failures,
+		&i.DisabledAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return &i, err
+}
@@ -0,0 +1,52 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: select_webhook_attempts.sql
+
+package queries
+
+import (
+	"context"
+)
+
+const selectWebhookAttempts = `-- name: SelectWebhookAttempts :many
+SELECT id, webhook_id, event_id, event_type, status_code, error, duration, attempted_at
+FROM webhook_attempts
+WHERE webhook_id = $1
+ORDER BY attempted_at DESC, id DESC
+LIMIT $2
+`
+
+type SelectWebhookAttemptsParams struct {
+	WebhookID int64 `db:"webhook_id"`
+	MaxCount  int32 `db:"max_count"`
+}
+
+func (q *Queries) SelectWebhookAttempts(ctx context.Context, arg *SelectWebhookAttemptsParams) ([]*WebhookAttempt, error) {
+	rows, err := q.db.Query(ctx, selectWebhookAttempts, arg.WebhookID, arg.MaxCount)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*WebhookAttempt
+	for rows.Next() {
+		var i WebhookAttempt
+		if err := rows.Scan(
+			&i.ID,
+			&i.WebhookID,
+			&i.EventID,
+			&i.EventType,
+			&i.StatusCode,
+			&i.Error,
+			&i.Duration,
+			&i.AttemptedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
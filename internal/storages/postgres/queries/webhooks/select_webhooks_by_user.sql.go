@@ -0,0 +1,47 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: select_webhooks_by_user.sql
+
+package queries
+
+import (
+	"context"
+)
+
+const selectWebhooksByUser = `-- name: SelectWebhooksByUser :many
+SELECT id, user_id, url, secret, event_types, failures, disabled_at, created_at, updated_at
+FROM webhooks
+WHERE user_id = $1
+ORDER BY id
+`
+
+func (q *Queries) SelectWebhooksByUser(ctx context.Context, userID int64) ([]*Webhook, error) {
+	rows, err := q.db.Query(ctx, selectWebhooksByUser, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*Webhook
+	for rows.Next() {
+		var i Webhook
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.Url,
+			&i.Secret,
+			&i.EventTypes,
+			&i.Failures,
+			&i.DisabledAt,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -11,6 +11,8 @@ import (
 	types "github.com/therenotomorrow/gotes/pkg/api/types"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
@@ -24,6 +26,337 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// ContentFormat defines how the Markdown content of notes is returned.
+type ContentFormat int32
+
+const (
+	// Default value, the format is taken from the `Accept` header on the HTTP gateway,
+	// i.e. `text/html` or `text/plain`, and is `CONTENT_FORMAT_RAW` otherwise.
+	ContentFormat_CONTENT_FORMAT_UNKNOWN ContentFormat = 0
+	// The content as it is written.
+	ContentFormat_CONTENT_FORMAT_RAW ContentFormat = 1
+	// The content rendered into HTML, sanitized to be safe to embed into a page.
+	ContentFormat_CONTENT_FORMAT_HTML ContentFormat = 2
+	// The content rendered into plain text without any markup.
+	ContentFormat_CONTENT_FORMAT_TEXT ContentFormat = 3
+)
+
+// Enum value maps for ContentFormat.
+var (
+	ContentFormat_name = map[int32]string{
+		0: "CONTENT_FORMAT_UNKNOWN",
+		1: "CONTENT_FORMAT_RAW",
+		2: "CONTENT_FORMAT_HTML",
+		3: "CONTENT_FORMAT_TEXT",
+	}
+	ContentFormat_value = map[string]int32{
+		"CONTENT_FORMAT_UNKNOWN": 0,
+		"CONTENT_FORMAT_RAW":     1,
+		"CONTENT_FORMAT_HTML":    2,
+		"CONTENT_FORMAT_TEXT":    3,
+	}
+)
+
+func (x ContentFormat) Enum() *ContentFormat {
+	p := new(ContentFormat)
+	*p = x
+	return p
+}
+
+func (x ContentFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ContentFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_notes_v1_messages_proto_enumTypes[0].Descriptor()
+}
+
+func (ContentFormat) Type() protoreflect.EnumType {
+	return &file_api_notes_v1_messages_proto_enumTypes[0]
+}
+
+func (x ContentFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ContentFormat.Descriptor instead.
+func (ContentFormat) EnumDescriptor() ([]byte, []int) {
+	return file_api_notes_v1_messages_proto_rawDescGZIP(), []int{0}
+}
+
+// DiffOperation defines how a line changed between two revisions.
+type DiffOperation int32
+
+const (
+	// Default value, should not be used.
+	DiffOperation_DIFF_OPERATION_UNKNOWN DiffOperation = 0
+	// The line is present in both revisions.
+	DiffOperation_DIFF_OPERATION_EQUAL DiffOperation = 1
+	// The line is present only in the newer revision.
+	DiffOperation_DIFF_OPERATION_INSERT DiffOperation = 2
+	// The line is present only in the older revision.
+	DiffOperation_DIFF_OPERATION_DELETE DiffOperation = 3
+)
+
+// Enum value maps for DiffOperation.
+var (
+	DiffOperation_name = map[int32]string{
+		0: "DIFF_OPERATION_UNKNOWN",
+		1: "DIFF_OPERATION_EQUAL",
+		2: "DIFF_OPERATION_INSERT",
+		3: "DIFF_OPERATION_DELETE",
+	}
+	DiffOperation_value = map[string]int32{
+		"DIFF_OPERATION_UNKNOWN": 0,
+		"DIFF_OPERATION_EQUAL":   1,
+		"DIFF_OPERATION_INSERT":  2,
+		"DIFF_OPERATION_DELETE":  3,
+	}
+)
+
+func (x DiffOperation) Enum() *DiffOperation {
+	p := new(DiffOperation)
+	*p = x
+	return p
+}
+
+func (x DiffOperation) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DiffOperation) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_notes_v1_messages_proto_enumTypes[1].Descriptor()
+}
+
+func (DiffOperation) Type() protoreflect.EnumType {
+	return &file_api_notes_v1_messages_proto_enumTypes[1]
+}
+
+func (x DiffOperation) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DiffOperation.Descriptor instead.
+func (DiffOperation) EnumDescriptor() ([]byte, []int) {
+	return file_api_notes_v1_messages_proto_rawDescGZIP(), []int{1}
+}
+
+// ArchiveFormat defines how notes are laid out in an export archive.
+type ArchiveFormat int32
+
+const (
+	// Default value, should not be used.
+	ArchiveFormat_ARCHIVE_FORMAT_UNKNOWN ArchiveFormat = 0
+	// One JSON object per line, each line is a single note.
+	ArchiveFormat_ARCHIVE_FORMAT_JSON_LINES ArchiveFormat = 1
+	// A zip of Markdown files, each file is a single note with its metadata in the front matter.
+	ArchiveFormat_ARCHIVE_FORMAT_MARKDOWN_ZIP ArchiveFormat = 2
+)
+
+// Enum value maps for ArchiveFormat.
+var (
+	ArchiveFormat_name = map[int32]string{
+		0: "ARCHIVE_FORMAT_UNKNOWN",
+		1: "ARCHIVE_FORMAT_JSON_LINES",
+		2: "ARCHIVE_FORMAT_MARKDOWN_ZIP",
+	}
+	ArchiveFormat_value = map[string]int32{
+		"ARCHIVE_FORMAT_UNKNOWN":      0,
+		"ARCHIVE_FORMAT_JSON_LINES":   1,
+		"ARCHIVE_FORMAT_MARKDOWN_ZIP": 2,
+	}
+)
+
+func (x ArchiveFormat) Enum() *ArchiveFormat {
+	p := new(ArchiveFormat)
+	*p = x
+	return p
+}
+
+func (x ArchiveFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ArchiveFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_notes_v1_messages_proto_enumTypes[2].Descriptor()
+}
+
+func (ArchiveFormat) Type() protoreflect.EnumType {
+	return &file_api_notes_v1_messages_proto_enumTypes[2]
+}
+
+func (x ArchiveFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ArchiveFormat.Descriptor instead.
+func (ArchiveFormat) EnumDescriptor() ([]byte, []int) {
+	return file_api_notes_v1_messages_proto_rawDescGZIP(), []int{2}
+}
+
+// ImportStatus defines what happened to a single note of an import.
+type ImportStatus int32
+
+const (
+	// Default value, should not be used.
+	ImportStatus_IMPORT_STATUS_UNKNOWN ImportStatus = 0
+	// The note has been created.
+	ImportStatus_IMPORT_STATUS_CREATED ImportStatus = 1
+	// The note has been skipped, the user already has a note with the same title and content.
+	ImportStatus_IMPORT_STATUS_DUPLICATE ImportStatus = 2
+	// The note has been rejected, the error tells why.
+	ImportStatus_IMPORT_STATUS_FAILED ImportStatus = 3
+)
+
+// Enum value maps for ImportStatus.
+var (
+	ImportStatus_name = map[int32]string{
+		0: "IMPORT_STATUS_UNKNOWN",
+		1: "IMPORT_STATUS_CREATED",
+		2: "IMPORT_STATUS_DUPLICATE",
+		3: "IMPORT_STATUS_FAILED",
+	}
+	ImportStatus_value = map[string]int32{
+		"IMPORT_STATUS_UNKNOWN":   0,
+		"IMPORT_STATUS_CREATED":   1,
+		"IMPORT_STATUS_DUPLICATE": 2,
+		"IMPORT_STATUS_FAILED":    3,
+	}
+)
+
+func (x ImportStatus) Enum() *ImportStatus {
+	p := new(ImportStatus)
+	*p = x
+	return p
+}
+
+func (x ImportStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ImportStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_notes_v1_messages_proto_enumTypes[3].Descriptor()
+}
+
+func (ImportStatus) Type() protoreflect.EnumType {
+	return &file_api_notes_v1_messages_proto_enumTypes[3]
+}
+
+func (x ImportStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ImportStatus.Descriptor instead.
+func (ImportStatus) EnumDescriptor() ([]byte, []int) {
+	return file_api_notes_v1_messages_proto_rawDescGZIP(), []int{3}
+}
+
+// NotebookDeleteMode defines what happens with the content of a deleted notebook.
+type NotebookDeleteMode int32
+
+const (
+	// Default value, treated as `NOTEBOOK_DELETE_MODE_MOVE_TO_ROOT`.
+	NotebookDeleteMode_NOTEBOOK_DELETE_MODE_UNKNOWN NotebookDeleteMode = 0
+	// Moves nested notebooks and notes of the notebook to the root.
+	NotebookDeleteMode_NOTEBOOK_DELETE_MODE_MOVE_TO_ROOT NotebookDeleteMode = 1
+	// Deletes nested notebooks at any depth and moves all their notes to the trash.
+	NotebookDeleteMode_NOTEBOOK_DELETE_MODE_CASCADE NotebookDeleteMode = 2
+)
+
+// Enum value maps for NotebookDeleteMode.
+var (
+	NotebookDeleteMode_name = map[int32]string{
+		0: "NOTEBOOK_DELETE_MODE_UNKNOWN",
+		1: "NOTEBOOK_DELETE_MODE_MOVE_TO_ROOT",
+		2: "NOTEBOOK_DELETE_MODE_CASCADE",
+	}
+	NotebookDeleteMode_value = map[string]int32{
+		"NOTEBOOK_DELETE_MODE_UNKNOWN":      0,
+		"NOTEBOOK_DELETE_MODE_MOVE_TO_ROOT": 1,
+		"NOTEBOOK_DELETE_MODE_CASCADE":      2,
+	}
+)
+
+func (x NotebookDeleteMode) Enum() *NotebookDeleteMode {
+	p := new(NotebookDeleteMode)
+	*p = x
+	return p
+}
+
+func (x NotebookDeleteMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (NotebookDeleteMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_notes_v1_messages_proto_enumTypes[4].Descriptor()
+}
+
+func (NotebookDeleteMode) Type() protoreflect.EnumType {
+	return &file_api_notes_v1_messages_proto_enumTypes[4]
+}
+
+func (x NotebookDeleteMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use NotebookDeleteMode.Descriptor instead.
+func (NotebookDeleteMode) EnumDescriptor() ([]byte, []int) {
+	return file_api_notes_v1_messages_proto_rawDescGZIP(), []int{4}
+}
+
+// ShareRole defines what a collaborator is allowed to do with a shared note.
+type ShareRole int32
+
+const (
+	// Default value, should not be used.
+	ShareRole_SHARE_ROLE_UNKNOWN ShareRole = 0
+	// Allows reading the note and its revisions.
+	ShareRole_SHARE_ROLE_VIEWER ShareRole = 1
+	// Allows reading and changing the title and the content of the note.
+	ShareRole_SHARE_ROLE_EDITOR ShareRole = 2
+)
+
+// Enum value maps for ShareRole.
+var (
+	ShareRole_name = map[int32]string{
+		0: "SHARE_ROLE_UNKNOWN",
+		1: "SHARE_ROLE_VIEWER",
+		2: "SHARE_ROLE_EDITOR",
+	}
+	ShareRole_value = map[string]int32{
+		"SHARE_ROLE_UNKNOWN": 0,
+		"SHARE_ROLE_VIEWER":  1,
+		"SHARE_ROLE_EDITOR":  2,
+	}
+)
+
+func (x ShareRole) Enum() *ShareRole {
+	p := new(ShareRole)
+	*p = x
+	return p
+}
+
+func (x ShareRole) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ShareRole) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_notes_v1_messages_proto_enumTypes[5].Descriptor()
+}
+
+func (ShareRole) Type() protoreflect.EnumType {
+	return &file_api_notes_v1_messages_proto_enumTypes[5]
+}
+
+func (x ShareRole) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ShareRole.Descriptor instead.
+func (ShareRole) EnumDescriptor() ([]byte, []int) {
+	return file_api_notes_v1_messages_proto_rawDescGZIP(), []int{5}
+}
+
 // EventType defines the type of action that occurred to a note.
 type EventType int32
 
@@ -32,8 +365,20 @@ const (
 	EventType_EVENT_TYPE_UNKNOWN EventType = 0
 	// Indicates that a new note has been created.
 	EventType_EVENT_TYPE_CREATED EventType = 1
-	// Indicates that a note has been deleted.
+	// Indicates that a note has been moved to the trash.
 	EventType_EVENT_TYPE_DELETED EventType = 2
+	// Indicates that a note has been updated.
+	EventType_EVENT_TYPE_UPDATED EventType = 3
+	// Indicates that a note has been restored from the trash.
+	EventType_EVENT_TYPE_RESTORED EventType = 4
+	// Indicates that a note has been permanently deleted.
+	EventType_EVENT_TYPE_PURGED EventType = 5
+	// Indicates that a note has been shared with the user.
+	EventType_EVENT_TYPE_SHARED EventType = 6
+	// Indicates that access to a note has been revoked from the user.
+	EventType_EVENT_TYPE_UNSHARED EventType = 7
+	// Indicates that a reminder about a note has fired.
+	EventType_EVENT_TYPE_REMINDER EventType = 8
 )
 
 // Enum value maps for EventType.
@@ -42,11 +387,23 @@ var (
 		0: "EVENT_TYPE_UNKNOWN",
 		1: "EVENT_TYPE_CREATED",
 		2: "EVENT_TYPE_DELETED",
+		3: "EVENT_TYPE_UPDATED",
+		4: "EVENT_TYPE_RESTORED",
+		5: "EVENT_TYPE_PURGED",
+		6: "EVENT_TYPE_SHARED",
+		7: "EVENT_TYPE_UNSHARED",
+		8: "EVENT_TYPE_REMINDER",
 	}
 	EventType_value = map[string]int32{
-		"EVENT_TYPE_UNKNOWN": 0,
-		"EVENT_TYPE_CREATED": 1,
-		"EVENT_TYPE_DELETED": 2,
+		"EVENT_TYPE_UNKNOWN":  0,
+		"EVENT_TYPE_CREATED":  1,
+		"EVENT_TYPE_DELETED":  2,
+		"EVENT_TYPE_UPDATED":  3,
+		"EVENT_TYPE_RESTORED": 4,
+		"EVENT_TYPE_PURGED":   5,
+		"EVENT_TYPE_SHARED":   6,
+		"EVENT_TYPE_UNSHARED": 7,
+		"EVENT_TYPE_REMINDER": 8,
 	}
 )
 
@@ -61,11 +418,11 @@ func (x EventType) String() string {
 }
 
 func (EventType) Descriptor() protoreflect.EnumDescriptor {
-	return file_api_notes_v1_messages_proto_enumTypes[0].Descriptor()
+	return file_api_notes_v1_messages_proto_enumTypes[6].Descriptor()
 }
 
 func (EventType) Type() protoreflect.EnumType {
-	return &file_api_notes_v1_messages_proto_enumTypes[0]
+	return &file_api_notes_v1_messages_proto_enumTypes[6]
 }
 
 func (x EventType) Number() protoreflect.EnumNumber {
@@ -74,7 +431,7 @@ func (x EventType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use EventType.Descriptor instead.
 func (EventType) EnumDescriptor() ([]byte, []int) {
-	return file_api_notes_v1_messages_proto_rawDescGZIP(), []int{0}
+	return file_api_notes_v1_messages_proto_rawDescGZIP(), []int{6}
 }
 
 // Note represents a single note entity.
@@ -89,7 +446,21 @@ type Note struct {
 	// Timestamp when the note was created.
 	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	// Timestamp when the note was last updated.
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// Timestamp when the note was moved to the trash, unset for notes that are not trashed.
+	DeletedAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
+	// Names of the tags attached to the note, sorted alphabetically.
+	Tags []string `protobuf:"bytes,7,rep,name=tags,proto3" json:"tags,omitempty"`
+	// ID of the notebook containing the note, unset for notes in the root.
+	NotebookId *types.ID `protobuf:"bytes,8,opt,name=notebook_id,json=notebookId,proto3" json:"notebook_id,omitempty"`
+	// Version of the note, it grows with every change and is required to change the note.
+	Version int64 `protobuf:"varint,9,opt,name=version,proto3" json:"version,omitempty"`
+	// Whether the note is pinned, pinned notes are listed first.
+	Pinned bool `protobuf:"varint,10,opt,name=pinned,proto3" json:"pinned,omitempty"`
+	// Whether the note is archived, archived notes are hidden from the listing unless asked for.
+	Archived bool `protobuf:"varint,11,opt,name=archived,proto3" json:"archived,omitempty"`
+	// Whether the note is starred.
+	Starred       bool `protobuf:"varint,12,opt,name=starred,proto3" json:"starred,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -159,9 +530,92 @@ func (x *Note) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *Note) GetDeletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return nil
+}
+
+func (x *Note) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *Note) GetNotebookId() *types.ID {
+	if x != nil {
+		return x.NotebookId
+	}
+	return nil
+}
+
+func (x *Note) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *Note) GetPinned() bool {
+	if x != nil {
+		return x.Pinned
+	}
+	return false
+}
+
+func (x *Note) GetArchived() bool {
+	if x != nil {
+		return x.Archived
+	}
+	return false
+}
+
+func (x *Note) GetStarred() bool {
+	if x != nil {
+		return x.Starred
+	}
+	return false
+}
+
 // ListNotesRequest is the request message for listing notes.
 type ListNotesRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Maximum number of notes to return, the server uses 50 when unset.
+	PageSize int32 `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// Page token received as `next_page_token` from a previous call.
+	//
+	// All other request parameters must match the call that provided the token.
+	PageToken string `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// Sort order in the form of `field [asc|desc]`, defaults to `created_at asc`.
+	//
+	// Supported fields are `created_at`, `updated_at` and `title`.
+	OrderBy string `protobuf:"bytes,3,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	// Only notes created at or after this time are returned.
+	CreatedAfter *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_after,json=createdAfter,proto3" json:"created_after,omitempty"`
+	// Only notes created before this time are returned.
+	CreatedBefore *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_before,json=createdBefore,proto3" json:"created_before,omitempty"`
+	// Only notes updated at or after this time are returned.
+	UpdatedAfter *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_after,json=updatedAfter,proto3" json:"updated_after,omitempty"`
+	// Only notes updated before this time are returned.
+	UpdatedBefore *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=updated_before,json=updatedBefore,proto3" json:"updated_before,omitempty"`
+	// Only notes whose title starts with this prefix are returned.
+	TitlePrefix string `protobuf:"bytes,8,opt,name=title_prefix,json=titlePrefix,proto3" json:"title_prefix,omitempty"`
+	// Only notes tagged with at least one of these tags are returned.
+	TagsAny []string `protobuf:"bytes,9,rep,name=tags_any,json=tagsAny,proto3" json:"tags_any,omitempty"`
+	// Only notes tagged with every one of these tags are returned.
+	TagsAll []string `protobuf:"bytes,10,rep,name=tags_all,json=tagsAll,proto3" json:"tags_all,omitempty"`
+	// Only notes placed directly in this notebook are returned.
+	NotebookId *types.ID `protobuf:"bytes,11,opt,name=notebook_id,json=notebookId,proto3" json:"notebook_id,omitempty"`
+	// Only pinned notes are returned when true, only notes that are not pinned when false.
+	Pinned *bool `protobuf:"varint,12,opt,name=pinned,proto3,oneof" json:"pinned,omitempty"`
+	// Only archived notes are returned when true, archived notes are hidden when false or unset.
+	Archived *bool `protobuf:"varint,13,opt,name=archived,proto3,oneof" json:"archived,omitempty"`
+	// Only starred notes are returned when true, only notes that are not starred when false.
+	Starred *bool `protobuf:"varint,14,opt,name=starred,proto3,oneof" json:"starred,omitempty"`
+	// Format of the contents of the notes, it may differ between the pages.
+	Format        ContentFormat `protobuf:"varint,15,opt,name=format,proto3,enum=api.notes.v1.ContentFormat" json:"format,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -196,64 +650,390 @@ func (*ListNotesRequest) Descriptor() ([]byte, []int) {
 	return file_api_notes_v1_messages_proto_rawDescGZIP(), []int{1}
 }
 
-// ListNotesResponse is the response message containing a list of notes.
-type ListNotesResponse struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// List of notes.
-	Notes         []*Note `protobuf:"bytes,1,rep,name=notes,proto3" json:"notes,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *ListNotesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
 }
 
-func (x *ListNotesResponse) Reset() {
-	*x = ListNotesResponse{}
-	mi := &file_api_notes_v1_messages_proto_msgTypes[2]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *ListNotesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
 }
 
-func (x *ListNotesResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *ListNotesRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
 }
 
-func (*ListNotesResponse) ProtoMessage() {}
-
-func (x *ListNotesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_notes_v1_messages_proto_msgTypes[2]
+func (x *ListNotesRequest) GetCreatedAfter() *timestamppb.Timestamp {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.CreatedAfter
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use ListNotesResponse.ProtoReflect.Descriptor instead.
-func (*ListNotesResponse) Descriptor() ([]byte, []int) {
-	return file_api_notes_v1_messages_proto_rawDescGZIP(), []int{2}
+func (x *ListNotesRequest) GetCreatedBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedBefore
+	}
+	return nil
 }
 
-func (x *ListNotesResponse) GetNotes() []*Note {
+func (x *ListNotesRequest) GetUpdatedAfter() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Notes
+		return x.UpdatedAfter
 	}
 	return nil
 }
 
-// RetrieveNoteRequest is the request message for fetching a single note by ID.
-type RetrieveNoteRequest struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// ID of the note to retrieve.
-	Id            *types.ID `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *ListNotesRequest) GetUpdatedBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedBefore
+	}
+	return nil
 }
 
-func (x *RetrieveNoteRequest) Reset() {
-	*x = RetrieveNoteRequest{}
+func (x *ListNotesRequest) GetTitlePrefix() string {
+	if x != nil {
+		return x.TitlePrefix
+	}
+	return ""
+}
+
+func (x *ListNotesRequest) GetTagsAny() []string {
+	if x != nil {
+		return x.TagsAny
+	}
+	return nil
+}
+
+func (x *ListNotesRequest) GetTagsAll() []string {
+	if x != nil {
+		return x.TagsAll
+	}
+	return nil
+}
+
+func (x *ListNotesRequest) GetNotebookId() *types.ID {
+	if x != nil {
+		return x.NotebookId
+	}
+	return nil
+}
+
+func (x *ListNotesRequest) GetPinned() bool {
+	if x != nil && x.Pinned != nil {
+		return *x.Pinned
+	}
+	return false
+}
+
+func (x *ListNotesRequest) GetArchived() bool {
+	if x != nil && x.Archived != nil {
+		return *x.Archived
+	}
+	return false
+}
+
+func (x *ListNotesRequest) GetStarred() bool {
+	if x != nil && x.Starred != nil {
+		return *x.Starred
+	}
+	return false
+}
+
+func (x *ListNotesRequest) GetFormat() ContentFormat {
+	if x != nil {
+		return x.Format
+	}
+	return ContentFormat_CONTENT_FORMAT_UNKNOWN
+}
+
+// ListNotesResponse is the response message containing a list of notes.
+type ListNotesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// List of notes.
+	Notes []*Note `protobuf:"bytes,1,rep,name=notes,proto3" json:"notes,omitempty"`
+	// Token to retrieve the next page, empty when there are no more notes.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	// Total number of notes matching the filters, regardless of pagination.
+	TotalSize     int32 `protobuf:"varint,3,opt,name=total_size,json=totalSize,proto3" json:"total_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListNotesResponse) Reset() {
+	*x = ListNotesResponse{}
+	mi := &file_api_notes_v1_messages_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListNotesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListNotesResponse) ProtoMessage() {}
+
+func (x *ListNotesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_notes_v1_messages_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListNotesResponse.ProtoReflect.Descriptor instead.
+func (*ListNotesResponse) Descriptor() ([]byte, []int) {
+	return file_api_notes_v1_messages_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListNotesResponse) GetNotes() []*Note {
+	if x != nil {
+		return x.Notes
+	}
+	return nil
+}
+
+func (x *ListNotesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListNotesResponse) GetTotalSize() int32 {
+	if x != nil {
+		return x.TotalSize
+	}
+	return 0
+}
+
+// SearchNotesRequest is the request message for full-text search over notes.
+type SearchNotesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Search query in web search syntax, e.g. `"exact phrase" word -excluded`.
+	Query string `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	// Maximum number of hits to return, the server uses 50 when unset.
+	PageSize int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// Page token received as `next_page_token` from a previous call with the same query.
+	PageToken     string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchNotesRequest) Reset() {
+	*x = SearchNotesRequest{}
+	mi := &file_api_notes_v1_messages_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchNotesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchNotesRequest) ProtoMessage() {}
+
+func (x *SearchNotesRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_notes_v1_messages_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchNotesRequest.ProtoReflect.Descriptor instead.
+func (*SearchNotesRequest) Descriptor() ([]byte, []int) {
+	return file_api_notes_v1_messages_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *SearchNotesRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchNotesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *SearchNotesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+// SearchHit represents a single note matching the search query.
+type SearchHit struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The matching note.
+	Note *Note `protobuf:"bytes,1,opt,name=note,proto3" json:"note,omitempty"`
+	// Relevance of the note to the query, higher is better.
+	Rank float32 `protobuf:"fixed32,2,opt,name=rank,proto3" json:"rank,omitempty"`
+	// HTML escaped title of the note with matches wrapped in `<mark>` tags.
+	TitleSnippet string `protobuf:"bytes,3,opt,name=title_snippet,json=titleSnippet,proto3" json:"title_snippet,omitempty"`
+	// HTML escaped fragments of the content with matches wrapped in `<mark>` tags.
+	ContentSnippet string `protobuf:"bytes,4,opt,name=content_snippet,json=contentSnippet,proto3" json:"content_snippet,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SearchHit) Reset() {
+	*x = SearchHit{}
+	mi := &file_api_notes_v1_messages_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchHit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchHit) ProtoMessage() {}
+
+func (x *SearchHit) ProtoReflect() protoreflect.Message {
+	mi := &file_api_notes_v1_messages_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchHit.ProtoReflect.Descriptor instead.
+func (*SearchHit) Descriptor() ([]byte, []int) {
+	return file_api_notes_v1_messages_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *SearchHit) GetNote() *Note {
+	if x != nil {
+		return x.Note
+	}
+	return nil
+}
+
+func (x *SearchHit) GetRank() float32 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *SearchHit) GetTitleSnippet() string {
+	if x != nil {
+		return x.TitleSnippet
+	}
+	return ""
+}
+
+func (x *SearchHit) GetContentSnippet() string {
+	if x != nil {
+		return x.ContentSnippet
+	}
+	return ""
+}
+
+// SearchNotesResponse is the response message containing ranked search hits.
+type SearchNotesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Hits ordered by relevance.
+	Hits []*SearchHit `protobuf:"bytes,1,rep,name=hits,proto3" json:"hits,omitempty"`
+	// Token to retrieve the next page, empty when there are no more hits.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	// Total number of notes matching the query, regardless of pagination.
+	TotalSize     int32 `protobuf:"varint,3,opt,name=total_size,json=totalSize,proto3" json:"total_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchNotesResponse) Reset() {
+	*x = SearchNotesResponse{}
+	mi := &file_api_notes_v1_messages_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchNotesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchNotesResponse) ProtoMessage() {}
+
+func (x *SearchNotesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_notes_v1_messages_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchNotesResponse.ProtoReflect.Descriptor instead.
+func (*SearchNotesResponse) Descriptor() ([]byte, []int) {
+	return file_api_notes_v1_messages_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *SearchNotesResponse) GetHits() []*SearchHit {
+	if x != nil {
+		return x.Hits
+	}
+	return nil
+}
+
+func (x *SearchNotesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *SearchNotesResponse) GetTotalSize() int32 {
+	if x != nil {
+		return x.TotalSize
+	}
+	return 0
+}
+
+// RetrieveNoteRequest is the request message for fetching a single note by ID.
+type RetrieveNoteRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ID of the note to retrieve.
+	Id *types.ID `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Format of the content of the note.
+	Format        ContentFormat `protobuf:"varint,2,opt,name=format,proto3,enum=api.notes.v1.ContentFormat" json:"format,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RetrieveNoteRequest) Reset() {
+	*x = RetrieveNoteRequest{}
+	mi := &file_api_notes_v1_messages_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -265,7 +1045,7 @@ func (x *RetrieveNoteRequest) String() string {
 func (*RetrieveNoteRequest) ProtoMessage() {}
 
 func (x *RetrieveNoteRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_notes_v1_messages_proto_msgTypes[3]
+	mi := &file_api_notes_v1_messages_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -278,7 +1058,7 @@ func (x *RetrieveNoteRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RetrieveNoteRequest.ProtoReflect.Descriptor instead.
 func (*RetrieveNoteRequest) Descriptor() ([]byte, []int) {
-	return file_api_notes_v1_messages_proto_rawDescGZIP(), []int{3}
+	return file_api_notes_v1_messages_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *RetrieveNoteRequest) GetId() *types.ID {
@@ -288,6 +1068,13 @@ func (x *RetrieveNoteRequest) GetId() *types.ID {
 	return nil
 }
 
+func (x *RetrieveNoteRequest) GetFormat() ContentFormat {
+	if x != nil {
+		return x.Format
+	}
+	return ContentFormat_CONTENT_FORMAT_UNKNOWN
+}
+
 // RetrieveNoteResponse is the response message for a single note retrieval.
 type RetrieveNoteResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -299,7 +1086,7 @@ type RetrieveNoteResponse struct {
 
 func (x *RetrieveNoteResponse) Reset() {
 	*x = RetrieveNoteResponse{}
-	mi := &file_api_notes_v1_messages_proto_msgTypes[4]
+	mi := &file_api_notes_v1_messages_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -311,7 +1098,7 @@ func (x *RetrieveNoteResponse) String() string {
 func (*RetrieveNoteResponse) ProtoMessage() {}
 
 func (x *RetrieveNoteResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_notes_v1_messages_proto_msgTypes[4]
+	mi := &file_api_notes_v1_messages_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -324,42 +1111,7221 @@ func (x *RetrieveNoteResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RetrieveNoteResponse.ProtoReflect.Descriptor instead.
 func (*RetrieveNoteResponse) Descriptor() ([]byte, []int) {
-	return file_api_notes_v1_messages_proto_rawDescGZIP(), []int{4}
+	return file_api_notes_v1_messages_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *RetrieveNoteResponse) GetNote() *Note {
+	if x != nil {
+		return x.Note
+	}
+	return nil
+}
+
+// CreateNoteRequest is the request message for creating a new note.
+type CreateNoteRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Title of the new note, replaces the title of the template when set.
+	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	// Content/body of the new note, replaces the content of the template when set.
+	Content string `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	// ID of the notebook to create the note in, the note is created in the root when unset.
+	NotebookId *types.ID `protobuf:"bytes,3,opt,name=notebook_id,json=notebookId,proto3" json:"notebook_id,omitempty"`
+	// ID of the template to create the note from, its placeholders are filled on the server.
+	TemplateId *types.ID `protobuf:"bytes,4,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"`
+	// Values of the placeholders of the template by their names, e.g. `attendees`.
+	// They take precedence over the built-in `date`, `time`, `user.name` and `user.email`.
+	Variables     map[string]string `protobuf:"bytes,5,rep,name=variables,proto3" json:"variables,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateNoteRequest) Reset() {
+	*x = CreateNoteRequest{}
+	mi := &file_api_notes_v1_messages_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateNoteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateNoteRequest) ProtoMessage() {}
+
+func (x *CreateNoteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_notes_v1_messages_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateNoteRequest.ProtoReflect.Descriptor instead.
+func (*CreateNoteRequest) Descriptor() ([]byte, []int) {
+	return file_api_notes_v1_messages_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *CreateNoteRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *CreateNoteRequest) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *CreateNoteRequest) GetNotebookId() *types.ID {
+	if x != nil {
+		return x.NotebookId
+	}
+	return nil
+}
+
+func (x *CreateNoteRequest) GetTemplateId() *types.ID {
+	if x != nil {
+		return x.TemplateId
+	}
+	return nil
+}
+
+func (x *CreateNoteRequest) GetVariables() map[string]string {
+	if x != nil {
+		return x.Variables
+	}
+	return nil
+}
+
+// CreateNoteResponse is the response message after creating a note.
+type CreateNoteResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The created note, including its generated ID and timestamps.
+	Note          *Note `protobuf:"bytes,1,opt,name=note,proto3" json:"note,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateNoteResponse) Reset() {
+	*x = CreateNoteResponse{}
+	mi := &file_api_notes_v1_messages_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateNoteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateNoteResponse) ProtoMessage() {}
+
+func (x *CreateNoteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_notes_v1_messages_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateNoteResponse.ProtoReflect.Descriptor instead.
+func (*CreateNoteResponse) Descriptor() ([]byte, []int) {
+	return file_api_notes_v1_messages_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *CreateNoteResponse) GetNote() *Note {
+	if x != nil {
+		return x.Note
+	}
+	return nil
+}
+
+// UpdateNoteRequest is the request message for partially updating a note.
+type UpdateNoteRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ID of the note to update.
+	Id *types.ID `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// New title of the note, applied when `title` is present in the update mask.
+	Title string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	// New content of the note, applied when `content` is present in the update mask.
+	Content string `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+	// Fields of the note to update, allowed paths are `title`, `content`, `pinned`, `archived` and `starred`.
+	//
+	// Only the owner of the note may change `pinned`, `archived` and `starred`.
+	UpdateMask *fieldmaskpb.FieldMask `protobuf:"bytes,4,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	// Version of the note the change is based on, REST clients may send it as the `If-Match` header instead.
+	Version int64 `protobuf:"varint,5,opt,name=version,proto3" json:"version,omitempty"`
+	// Whether the note is pinned, applied when `pinned` is present in the update mask.
+	Pinned bool `protobuf:"varint,6,opt,name=pinned,proto3" json:"pinned,omitempty"`
+	// Whether the note is archived, applied when `archived` is present in the update mask.
+	Archived bool `protobuf:"varint,7,opt,name=archived,proto3" json:"archived,omitempty"`
+	// Whether the note is starred, applied when `starred` is present in the update mask.
+	Starred       bool `protobuf:"varint,8,opt,name=starred,proto3" json:"starred,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateNoteRequest) Reset() {
+	*x = UpdateNoteRequest{}
+	mi := &file_api_notes_v1_messages_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateNoteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateNoteRequest) ProtoMessage() {}
+
+func (x *UpdateNoteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_notes_v1_messages_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateNoteRequest.ProtoReflect.Descriptor instead.
+func (*UpdateNoteRequest) Descriptor() ([]byte, []int) {
+	return file_api_notes_v1_messages_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *UpdateNoteRequest) GetId() *types.ID {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+func (x *UpdateNoteRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *UpdateNoteRequest) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *UpdateNoteRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+func (x *UpdateNoteRequest) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *UpdateNoteRequest) GetPinned() bool {
+	if x != nil {
+		return x.Pinned
+	}
+	return false
+}
+
+func (x *UpdateNoteRequest) GetArchived() bool {
+	if x != nil {
+		return x.Archived
+	}
+	return false
+}
+
+func (x *UpdateNoteRequest) GetStarred() bool {
+	if x != nil {
+		return x.Starred
+	}
+	return false
+}
+
+// UpdateNoteResponse is the response message after updating a note.
+type UpdateNoteResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The updated note with refreshed timestamps.
+	Note          *Note `protobuf:"bytes,1,opt,name=note,proto3" json:"note,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateNoteResponse) Reset() {
+	*x = UpdateNoteResponse{}
+	mi := &file_api_notes_v1_messages_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateNoteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateNoteResponse) ProtoMessage() {}
+
+func (x *UpdateNoteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_notes_v1_messages_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateNoteResponse.ProtoReflect.Descriptor instead.
+func (*UpdateNoteResponse) Descriptor() ([]byte, []int) {
+	return file_api_notes_v1_messages_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *UpdateNoteResponse) GetNote() *Note {
+	if x != nil {
+		return x.Note
+	}
+	return nil
+}
+
+// EditComponent is a single step of an operation, lengths are in Unicode code points.
+type EditComponent struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// What the step does with the content.
+	//
+	// Types that are valid to be assigned to Kind:
+	//
+	//	*EditComponent_Retain
+	//	*EditComponent_Insert
+	//	*EditComponent_Delete
+	Kind          isEditComponent_Kind `protobuf_oneof:"kind"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EditComponent) Reset() {
+	*x = EditComponent{}
+	mi := &file_api_notes_v1_messages_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EditComponent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EditComponent) ProtoMessage() {}
+
+func (x *EditComponent) ProtoReflect() protoreflect.Message {
+	mi := &file_api_notes_v1_messages_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EditComponent.ProtoReflect.Descriptor instead.
+func (*EditComponent) Descriptor() ([]byte, []int) {
+	return file_api_notes_v1_messages_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *EditComponent) GetKind() isEditComponent_Kind {
+	if x != nil {
+		return x.Kind
+	}
+	return nil
+}
+
+func (x *EditComponent) GetRetain() int32 {
+	if x != nil {
+		if x, ok := x.Kind.(*EditComponent_Retain); ok {
+			return x.Retain
+		}
+	}
+	return 0
+}
+
+func (x *EditComponent) GetInsert() string {
+	if x != nil {
+		if x, ok := x.Kind.(*EditComponent_Insert); ok {
+			return x.Insert
+		}
+	}
+	return ""
+}
+
+func (x *EditComponent) GetDelete() int32 {
+	if x != nil {
+		if x, ok := x.Kind.(*EditComponent_Delete); ok {
+			return x.Delete
+		}
+	}
+	return 0
+}
+
+type isEditComponent_Kind interface {
+	isEditComponent_Kind()
+}
+
+type EditComponent_Retain struct {
+	// Number of code points kept as they are.
+	Retain int32 `protobuf:"varint,1,opt,name=retain,proto3,oneof"`
+}
+
+type EditComponent_Insert struct {
+	// Text inserted at the current place.
+	Insert string `protobuf:"bytes,2,opt,name=insert,proto3,oneof"`
+}
+
+type EditComponent_Delete struct {
+	// Number of code points deleted.
+	Delete int32 `protobuf:"varint,3,opt,name=delete,proto3,oneof"`
+}
+
+func (*EditComponent_Retain) isEditComponent_Kind() {}
+
+func (*EditComponent_Insert) isEditComponent_Kind() {}
+
+func (*EditComponent_Delete) isEditComponent_Kind() {}
+
+// EditOperation is a change of the content going over the whole content from the start to the end.
+type EditOperation struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Revision of the draft the operation is based on, or the revision the operation made when sent by the server.
+	Revision int64 `protobuf:"varint,1,opt,name=revision,proto3" json:"revision,omitempty"`
+	// Steps of the operation in order.
+	Components    []*EditComponent `protobuf:"bytes,2,rep,name=components,proto3" json:"components,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EditOperation) Reset() {
+	*x = EditOperation{}
+	mi := &file_api_notes_v1_messages_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EditOperation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EditOperation) ProtoMessage() {}
+
+func (x *EditOperation) ProtoReflect() protoreflect.Message {
+	mi := &file_api_notes_v1_messages_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EditOperation.ProtoReflect.Descriptor instead.
+func (*EditOperation) Descriptor() ([]byte, []int) {
+	return file_api_notes_v1_messages_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *EditOperation) GetRevision() int64 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+func (x *EditOperation) GetComponents() []*EditComponent {
+	if x != nil {
+		return x.Components
+	}
+	return nil
+}
+
+// EditCursor is the cursor of an editor, anchor and head are equal unless some text is selected.
+type EditCursor struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Revision of the draft the cursor is in.
+	Revision int64 `protobuf:"varint,1,opt,name=revision,proto3" json:"revision,omitempty"`
+	// Where the selection starts, in code points.
+	Anchor int32 `protobuf:"varint,2,opt,name=anchor,proto3" json:"anchor,omitempty"`
+	// Where the selection ends and the cursor is, in code points.
+	Head          int32 `protobuf:"varint,3,opt,name=head,proto3" json:"head,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EditCursor) Reset() {
+	*x = EditCursor{}
+	mi := &file_api_notes_v1_messages_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EditCursor) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EditCursor) ProtoMessage() {}
+
+func (x *EditCursor) ProtoReflect() protoreflect.Message {
+	mi := &file_api_notes_v1_messages_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EditCursor.ProtoReflect.Descriptor instead.
+func (*EditCursor) Descriptor() ([]byte, []int) {
+	return file_api_notes_v1_messages_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *EditCursor) GetRevision() int64 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+func (x *EditCursor) GetAnchor() int32 {
+	if x != nil {
+		return x.Anchor
+	}
+	return 0
+}
+
+func (x *EditCursor) GetHead() int32 {
+	if x != nil {
+		return x.Head
+	}
+	return 0
+}
+
+// Draft is the content of a note being edited together.
+type Draft struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Content of the draft, it is saved into the note from time to time.
+	Content string `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
+	// Number of operations applied to the draft.
+	Revision int64 `protobuf:"varint,2,opt,name=revision,proto3" json:"revision,omitempty"`
+	// Session of the editor, it tells the editors of the same user apart.
+	Session string `protobuf:"bytes,3,opt,name=session,proto3" json:"session,omitempty"`
+	// Whether the editor may change the content, collaborators allowed only to read the note just follow the edits.
+	Writable      bool `protobuf:"varint,4,opt,name=writable,proto3" json:"writable,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Draft) Reset() {
+	*x = Draft{}
+	mi := &file_api_notes_v1_messages_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Draft) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Draft) ProtoMessage() {}
+
+func (x *Draft) ProtoReflect() protoreflect.Message {
+	mi := &file_api_notes_v1_messages_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Draft.ProtoReflect.Descriptor instead.
+func (*Draft) Descriptor() ([]byte, []int) {
+	return file_api_notes_v1_messages_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *Draft) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *Draft) GetRevision() int64 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+func (x *Draft) GetSession() string {
+	if x != nil {
+		return x.Session
+	}
+	return ""
+}
+
+func (x *Draft) GetWritable() bool {
+	if x != nil {
+		return x.Writable
+	}
+	return false
+}
+
+// EditAck acknowledges an operation of the editor, it comes in order with the operations of the others.
+type EditAck struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Revision of the draft the operation made.
+	Revision      int64 `protobuf:"varint,1,opt,name=revision,proto3" json:"revision,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EditAck) Reset() {
+	*x = EditAck{}
+	mi := &file_api_notes_v1_messages_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EditAck) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EditAck) ProtoMessage() {}
+
+func (x *EditAck) ProtoReflect() protoreflect.Message {
+	mi := &file_api_notes_v1_messages_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EditAck.ProtoReflect.Descriptor instead.
+func (*EditAck) Descriptor() ([]byte, []int) {
+	return file_api_notes_v1_messages_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *EditAck) GetRevision() int64 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+// RemoteEdit is an operation of another editor, already transformed against the operations applied before it.
+type RemoteEdit struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ID of the user who made the operation.
+	UserId *types.ID `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// Session of the editor who made the operation.
+	Session string `protobuf:"bytes,2,opt,name=session,proto3" json:"session,omitempty"`
+	// The operation, its revision is the revision it made.
+	Operation     *EditOperation `protobuf:"bytes,3,opt,name=operation,proto3" json:"operation,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoteEdit) Reset() {
+	*x = RemoteEdit{}
+	mi := &file_api_notes_v1_messages_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoteEdit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoteEdit) ProtoMessage() {}
+
+func (x *RemoteEdit) ProtoReflect() protoreflect.Message {
+	mi := &file_api_notes_v1_messages_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoteEdit.ProtoReflect.Descriptor instead.
+func (*RemoteEdit) Descriptor() ([]byte, []int) {
+	return file_api_notes_v1_messages_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *RemoteEdit) GetUserId() *types.ID {
+	if x != nil {
+		return x.UserId
+	}
+	return nil
+}
+
+func (x *RemoteEdit) GetSession() string {
+	if x != nil {
+		return x.Session
+	}
+	return ""
+}
+
+func (x *RemoteEdit) GetOperation() *EditOperation {
+	if x != nil {
+		return x.Operation
+	}
+	return nil
+}
+
+// RemoteCursor is the cursor of another editor.
+type RemoteCursor struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ID of the user whose cursor moved.
+	UserId *types.ID `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// Session of the editor whose cursor moved.
+	Session string `protobuf:"bytes,2,opt,name=session,proto3" json:"session,omitempty"`
+	// The cursor in the latest revision of the draft.
+	Cursor        *EditCursor `protobuf:"bytes,3,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoteCursor) Reset() {
+	*x = RemoteCursor{}
+	mi := &file_api_notes_v1_messages_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoteCursor) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoteCursor) ProtoMessage() {}
+
+func (x *RemoteCursor) ProtoReflect() protoreflect.Message {
+	mi := &file_api_notes_v1_messages_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoteCursor.ProtoReflect.Descriptor instead.
+func (*RemoteCursor) Descriptor() ([]byte, []int) {
+	return file_api_notes_v1_messages_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *RemoteCursor) GetUserId() *types.ID {
+	if x != nil {
+		return x.UserId
+	}
+	return nil
+}
+
+func (x *RemoteCursor) GetSession() string {
+	if x != nil {
+		return x.Session
+	}
+	return ""
+}
+
+func (x *RemoteCursor) GetCursor() *EditCursor {
+	if x != nil {
+		return x.Cursor
+	}
+	return nil
+}
+
+// EditNoteRequest is the request message in the editing stream.
+type EditNoteRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The content of the edit request, the note to join comes first and the operations and cursors follow it.
+	//
+	// Types that are valid to be assigned to Payload:
+	//
+	//	*EditNoteRequest_NoteId
+	//	*EditNoteRequest_Operation
+	//	*EditNoteRequest_Cursor
+	Payload       isEditNoteRequest_Payload `protobuf_oneof:"payload"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EditNoteRequest) Reset() {
+	*x = EditNoteRequest{}
+	mi := &file_api_notes_v1_messages_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EditNoteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EditNoteRequest) ProtoMessage() {}
+
+func (x *EditNoteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_notes_v1_messages_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EditNoteRequest.ProtoReflect.Descriptor instead.
+func (*EditNoteRequest) Descriptor() ([]byte, []int) {
+	return file_api_notes_v1_messages_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *EditNoteRequest) GetPayload() isEditNoteRequest_Payload {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *EditNoteRequest) GetNoteId() *types.ID {
+	if x != nil {
+		if x, ok := x.Payload.(*EditNoteRequest_NoteId); ok {
+			return x.NoteId
+		}
+	}
+	return nil
+}
+
+func (x *EditNoteRequest) GetOperation() *EditOperation {
+	if x != nil {
+		if x, ok := x.Payload.(*EditNoteRequest_Operation); ok {
+			return x.Operation
+		}
+	}
+	return nil
+}
+
+func (x *EditNoteRequest) GetCursor() *EditCursor {
+	if x != nil {
+		if x, ok := x.Payload.(*EditNoteRequest_Cursor); ok {
+			return x.Cursor
+		}
+	}
+	return nil
+}
+
+type isEditNoteRequest_Payload interface {
+	isEditNoteRequest_Payload()
+}
+
+type EditNoteRequest_NoteId struct {
+	// ID of the note to edit, sent once at the start of the stream.
+	NoteId *types.ID `protobuf:"bytes,1,opt,name=note_id,json=noteId,proto3,oneof"`
+}
+
+type EditNoteRequest_Operation struct {
+	// An operation of the editor.
+	Operation *EditOperation `protobuf:"bytes,2,opt,name=operation,proto3,oneof"`
+}
+
+type EditNoteRequest_Cursor struct {
+	// A move of the cursor of the editor.
+	Cursor *EditCursor `protobuf:"bytes,3,opt,name=cursor,proto3,oneof"`
+}
+
+func (*EditNoteRequest_NoteId) isEditNoteRequest_Payload() {}
+
+func (*EditNoteRequest_Operation) isEditNoteRequest_Payload() {}
+
+func (*EditNoteRequest_Cursor) isEditNoteRequest_Payload() {}
+
+// EditNoteResponse is the response message in the editing stream.
+type EditNoteResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The content of the edit response, the draft comes first and the edits of all editors follow it.
+	//
+	// Types that are valid to be assigned to Payload:
+	//
+	//	*EditNoteResponse_Draft
+	//	*EditNoteResponse_Ack
+	//	*EditNoteResponse_Edit
+	//	*EditNoteResponse_Cursor
+	Payload       isEditNoteResponse_Payload `protobuf_oneof:"payload"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EditNoteResponse) Reset() {
+	*x = EditNoteResponse{}
+	mi := &file_api_notes_v1_messages_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EditNoteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EditNoteResponse) ProtoMessage() {}
+
+func (x *EditNoteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_notes_v1_messages_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EditNoteResponse.ProtoReflect.Descriptor instead.
+func (*EditNoteResponse) Descriptor() ([]byte, []int) {
+	return file_api_notes_v1_messages_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *EditNoteResponse) GetPayload() isEditNoteResponse_Payload {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *EditNoteResponse) GetDraft() *Draft {
+	if x != nil {
+		if x, ok := x.Payload.(*EditNoteResponse_Draft); ok {
+			return x.Draft
+		}
+	}
+	return nil
+}
+
+func (x *EditNoteResponse) GetAck() *EditAck {
+	if x != nil {
+		if x, ok := x.Payload.(*EditNoteResponse_Ack); ok {
+			return x.Ack
+		}
+	}
+	return nil
+}
+
+func (x *EditNoteResponse) GetEdit() *RemoteEdit {
+	if x != nil {
+		if x, ok := x.Payload.(*EditNoteResponse_Edit); ok {
+			return x.Edit
+		}
+	}
+	return nil
+}
+
+func (x *EditNoteResponse) GetCursor() *RemoteCursor {
+	if x != nil {
+		if x, ok := x.Payload.(*EditNoteResponse_Cursor); ok {
+			return x.Cursor
+		}
+	}
+	return nil
+}
+
+type isEditNoteResponse_Payload interface {
+	isEditNoteResponse_Payload()
+}
+
+type EditNoteResponse_Draft struct {
+	// The draft of the note, sent once at the start of the stream.
+	Draft *Draft `protobuf:"bytes,1,opt,name=draft,proto3,oneof"`
+}
+
+type EditNoteResponse_Ack struct {
+	// An operation of the editor is applied.
+	Ack *EditAck `protobuf:"bytes,2,opt,name=ack,proto3,oneof"`
+}
+
+type EditNoteResponse_Edit struct {
+	// An operation of another editor.
+	Edit *RemoteEdit `protobuf:"bytes,3,opt,name=edit,proto3,oneof"`
+}
+
+type EditNoteResponse_Cursor struct {
+	// A move of the cursor of another editor.
+	Cursor *RemoteCursor `protobuf:"bytes,4,opt,name=cursor,proto3,oneof"`
+}
+
+func (*EditNoteResponse_Draft) isEditNoteResponse_Payload() {}
+
+func (*EditNoteResponse_Ack) isEditNoteResponse_Payload() {}
+
+func (*EditNoteResponse_Edit) isEditNoteResponse_Payload() {}
+
+func (*EditNoteResponse_Cursor) isEditNoteResponse_Payload() {}
+
+// DeleteNoteRequest is the request message for deleting a note by ID.
+type DeleteNoteRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ID of the note to delete.
+	Id *types.ID `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Version of the note the change is based on, REST clients may send it as the `If-Match` header instead.
+	Version       int64 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteNoteRequest) Reset() {
+	*x = DeleteNoteRequest{}
+	mi := &file_api_notes_v1_messages_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteNoteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteNoteRequest) ProtoMessage() {}
+
+func (x *DeleteNoteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_notes_v1_messages_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteNoteRequest.ProtoReflect.Descriptor instead.
+func (*DeleteNoteRequest) Descriptor() ([]byte, []int) {
+	return file_api_notes_v1_messages_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *DeleteNoteRequest) GetId() *types.ID {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+func (x *DeleteNoteRequest) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+// DeleteNoteResponse is the response message after deleting a note.
+type DeleteNoteResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteNoteResponse) Reset() {
+	*x = DeleteNoteResponse{}
+	mi := &file_api_notes_v1_messages_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteNoteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteNoteResponse) ProtoMessage() {}
+
+func (x *DeleteNoteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_notes_v1_messages_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteNoteResponse.ProtoReflect.Descriptor instead.
+func (*DeleteNoteResponse) Descriptor() ([]byte, []int) {
+	return file_api_notes_v1_messages_proto_rawDescGZIP(), []int{22}
+}
+
+// ListTrashedNotesRequest is the request message for listing notes in the trash.
+type ListTrashedNotesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Maximum number of notes to return, the server uses 50 when unset.
+	PageSize int32 `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// Page token received as `next_page_token` from a previous call.
+	PageToken     string `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTrashedNotesRequest) Reset() {
+	*x = ListTrashedNotesRequest{}
+	mi := &file_api_notes_v1_messages_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTrashedNotesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTrashedNotesRequest) ProtoMessage() {}
+
+func (x *ListTrashedNotesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_notes_v1_messages_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTrashedNotesRequest.ProtoReflect.Descriptor instead.
+func (*ListTrashedNotesRequest) Descriptor() ([]byte, []int) {
+	return file_api_notes_v1_messages_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ListTrashedNotesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListTrashedNotesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+// ListTrashedNotesResponse is the response message containing trashed notes, most recently deleted first.
+type ListTrashedNotesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// List of trashed notes.
+	Notes []*Note `protobuf:"bytes,1,rep,name=notes,proto3" json:"notes,omitempty"`
+	// Token to retrieve the next page, empty when there are no more notes.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	// Total number of notes in the trash, regardless of pagination.
+	TotalSize     int32 `protobuf:"varint,3,opt,name=total_size,json=totalSize,proto3" json:"total_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTrashedNotesResponse) Reset() {
+	*x = ListTrashedNotesResponse{}
+	mi := &file_api_notes_v1_messages_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTrashedNotesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTrashedNotesResponse) ProtoMessage() {}
+
+func (x *ListTrashedNotesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_notes_v1_messages_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTrashedNotesResponse.ProtoReflect.Descriptor instead.
+func (*ListTrashedNotesResponse) Descriptor() ([]byte, []int) {
+	return file_api_notes_v1_messages_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ListTrashedNotesResponse) GetNotes() []*Note {
+	if x != nil {
+		return x.Notes
+	}
+	return nil
+}
+
+func (x *ListTrashedNotesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListTrashedNotesResponse) GetTotalSize() int32 {
+	if x != nil {
+		return x.TotalSize
+	}
+	return 0
+}
+
+// RestoreNoteRequest is the request message for restoring a note from the trash.
+type RestoreNoteRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ID of the note to restore.
+	Id *types.ID `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Version of the note the change is based on, REST clients may send it as the `If-Match` header instead.
+	Version       int64 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreNoteRequest) Reset() {
+	*x = RestoreNoteRequest{}
+	mi := &file_api_notes_v1_messages_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreNoteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreNoteRequest) ProtoMessage() {}
+
+func (x *RestoreNoteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_notes_v1_messages_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreNoteRequest.ProtoReflect.Descriptor instead.
+func (*RestoreNoteRequest) Descriptor() ([]byte, []int) {
+	return file_api_notes_v1_messages_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *RestoreNoteRequest) GetId() *types.ID {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+func (x *RestoreNoteRequest) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+// RestoreNoteResponse is the response message after restoring a note.
+type RestoreNoteResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The restored note.
+	Note          *Note `protobuf:"bytes,1,opt,name=note,proto3" json:"note,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreNoteResponse) Reset() {
+	*x = RestoreNoteResponse{}
+	mi := &file_api_notes_v1_messages_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreNoteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreNoteResponse) ProtoMessage() {}
+
+func (x *RestoreNoteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_notes_v1_messages_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreNoteResponse.ProtoReflect.Descriptor instead.
+func (*RestoreNoteResponse) Descriptor() ([]byte, []int) {
+	return file_api_notes_v1_messages_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *RestoreNoteResponse) GetNote() *Note {
+	if x != nil {
+		return x.Note
+	}
+	return nil
+}
+
+// PurgeNoteRequest is the request message for permanently deleting a trashed note.
+type PurgeNoteRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ID of the note to purge.
+	Id *types.ID `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Version of the note the change is based on, REST clients may send it as the `If-Match` header instead.
+	Version       int64 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PurgeNoteRequest) Reset() {
+	*x = PurgeNoteRequest{}
+	mi := &file_api_notes_v1_messages_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PurgeNoteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeNoteRequest) ProtoMessage() {}
+
+func (x *PurgeNoteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_notes_v1_messages_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgeNoteRequest.ProtoReflect.Descriptor instead.
+func (*PurgeNoteRequest) Descriptor() ([]byte, []int) {
+	return file_api_notes_v1_messages_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *PurgeNoteRequest) GetId() *types.ID {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+func (x *PurgeNoteRequest) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+// PurgeNoteResponse is the response message after purging a note.
+type PurgeNoteResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PurgeNoteResponse) Reset() {
+	*x = PurgeNoteResponse{}
+	mi := &file_api_notes_v1_messages_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PurgeNoteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeNoteResponse) ProtoMessage() {}
+
+func (x *PurgeNoteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_notes_v1_messages_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgeNoteResponse.ProtoReflect.Descriptor instead.
+func (*PurgeNoteResponse) Descriptor() ([]byte, []int) {
+	return file_api_notes_v1_messages_proto_rawDescGZIP(), []int{28}
+}
+
+// Tag represents a label that groups notes of a user.
+type Tag struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Name of the tag, always lowercase.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Timestamp when the tag was created.
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Tag) Reset() {
+	*x = Tag{}
+	mi := &file_api_notes_v1_messages_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Tag) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Tag) ProtoMessage() {}
+
+func (x *Tag) ProtoReflect() protoreflect.Message {
+	mi := &file_api_notes_v1_messages_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Tag.ProtoReflect.Descriptor instead.
+func (*Tag) Descriptor() ([]byte, []int) {
+	return file_api_notes_v1_messages_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *Tag) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Tag) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// TagCount represents a tag together with the number of notes using it.
+type TagCount struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The tag.
+	Tag *Tag `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
+	// Number of notes, not counting trashed ones, tagged with the tag.
+	Notes         int32 `protobuf:"varint,2,opt,name=notes,proto3" json:"notes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TagCount) Reset() {
+	*x = TagCount{}
+	mi := &file_api_notes_v1_messages_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TagCount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TagCount) ProtoMessage() {}
+
+func (x *TagCount) ProtoReflect() protoreflect.Message {
+	mi := &file_api_notes_v1_messages_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TagCount.ProtoReflect.Descriptor instead.
+func (*TagCount) Descriptor() ([]byte, []int) {
+	return file_api_notes_v1_messages_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *TagCount) GetTag() *Tag {
+	if x != nil {
+		return x.Tag
+	}
+	return nil
+}
+
+func (x *TagCount) GetNotes() int32 {
+	if x != nil {
+		return x.Notes
+	}
+	return 0
+}
+
+// AddNoteTagsRequest is the request message for tagging a note.
+type AddNoteTagsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ID of the note to tag.
+	NoteId *types.ID `protobuf:"bytes,1,opt,name=note_id,json=noteId,proto3" json:"note_id,omitempty"`
+	// Names of the tags to add, missing tags are created.
+	Tags []string `protobuf:"bytes,2,rep,name=tags,proto3" json:"tags,omitempty"`
+	// Version of the note the change is based on, REST clients may send it as the `If-Match` header instead.
+	Version       int64 `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddNoteTagsRequest) Reset() {
+	*x = AddNoteTagsRequest{}
+	mi := &file_api_notes_v1_messages_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddNoteTagsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddNoteTagsRequest) ProtoMessage() {}
+
+func (x *AddNoteTagsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_notes_v1_messages_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddNoteTagsRequest.ProtoReflect.Descriptor instead.
+func (*AddNoteTagsRequest) Descriptor() ([]byte, []int) {
+	return file_api_notes_v1_messages_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *AddNoteTagsRequest) GetNoteId() *types.ID {
+	if x != nil {
+		return x.NoteId
+	}
+	return nil
+}
+
+func (x *AddNoteTagsRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *AddNoteTagsRequest) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+// AddNoteTagsResponse is the response message after tagging a note.
+type AddNoteTagsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The note with its current tags.
+	Note          *Note `protobuf:"bytes,1,opt,name=note,proto3" json:"note,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddNoteTagsResponse) Reset() {
+	*x = AddNoteTagsResponse{}
+	mi := &file_api_notes_v1_messages_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddNoteTagsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddNoteTagsResponse) ProtoMessage() {}
+
+func (x *AddNoteTagsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_notes_v1_messages_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddNoteTagsResponse.ProtoReflect.Descriptor instead.
+func (*AddNoteTagsResponse) Descriptor() ([]byte, []int) {
+	return file_api_notes_v1_messages_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *AddNoteTagsResponse) GetNote() *Note {
+	if x != nil {
+		return x.Note
+	}
+	return nil
+}
+
+// RemoveNoteTagsRequest is the request message for untagging a note.
+type RemoveNoteTagsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ID of the note to untag.
+	NoteId *types.ID `protobuf:"bytes,1,opt,name=note_id,json=noteId,proto3" json:"note_id,omitempty"`
+	// Names of the tags to remove.
+	Tags []string `protobuf:"bytes,2,rep,name=tags,proto3" json:"tags,omitempty"`
+	// Version of the note the change is based on, REST clients may send it as the `If-Match` header instead.
+	Version       int64 `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveNoteTagsRequest) Reset() {
+	*x = RemoveNoteTagsRequest{}
+	mi := &file_api_notes_v1_messages_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveNoteTagsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveNoteTagsRequest) ProtoMessage() {}
+
+func (x *RemoveNoteTagsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_notes_v1_messages_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveNoteTagsRequest.ProtoReflect.Descriptor instead.
+func (*RemoveNoteTagsRequest) Descriptor() ([]byte, []int) {
+	return file_api_notes_v1_messages_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *RemoveNoteTagsRequest) GetNoteId() *types.ID {
+	if x != nil {
+		return x.NoteId
+	}
+	return nil
+}
+
+func (x *RemoveNoteTagsRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *RemoveNoteTagsRequest) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+// RemoveNoteTagsResponse is the response message after untagging a note.
+type RemoveNoteTagsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The note with its current tags.
+	Note          *Note `protobuf:"bytes,1,opt,name=note,proto3" json:"note,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveNoteTagsResponse) Reset() {
+	*x = RemoveNoteTagsResponse{}
+	mi := &file_api_notes_v1_messages_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveNoteTagsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveNoteTagsResponse) ProtoMessage() {}
+
+func (x *RemoveNoteTagsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_notes_v1_messages_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveNoteTagsResponse.ProtoReflect.Descriptor instead.
+func (*RemoveNoteTagsResponse) Descriptor() ([]byte, []int) {
+	return file_api_notes_v1_messages_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *RemoveNoteTagsResponse) GetNote() *Note {
+	if x != nil {
+		return x.Note
+	}
+	return nil
+}
+
+// ListTagsRequest is the request message for listing tags of the user.
+type ListTagsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTagsRequest) Reset() {
+	*x = ListTagsRequest{}
+	mi := &file_api_notes_v1_messages_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTagsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTagsRequest) ProtoMessage() {}
+
+func (x *ListTagsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_notes_v1_messages_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTagsRequest.ProtoReflect.Descriptor instead.
+func (*ListTagsRequest) Descriptor() ([]byte, []int) {
+	return file_api_notes_v1_messages_proto_rawDescGZIP(), []int{35}
+}
+
+// ListTagsResponse is the response message containing tags of the user with their usage.
+type ListTagsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Tags sorted by name.
+	Tags          []*TagCount `protobuf:"bytes,1,rep,name=tags,proto3" json:"tags,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTagsResponse) Reset() {
+	*x = ListTagsResponse{}
+	mi := &file_api_notes_v1_messages_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTagsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTagsResponse) ProtoMessage() {}
+
+func (x *ListTagsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_notes_v1_messages_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTagsResponse.ProtoReflect.Descriptor instead.
+func (*ListTagsResponse) Descriptor() ([]byte, []int) {
+	return file_api_notes_v1_messages_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ListTagsResponse) GetTags() []*TagCount {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+// RenameTagRequest is the request message for renaming a tag.
+type RenameTagRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Current name of the tag.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// New name of the tag, when a tag with this name already exists both are merged into it.
+	NewName       string `protobuf:"bytes,2,opt,name=new_name,json=newName,proto3" json:"new_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RenameTagRequest) Reset() {
+	*x = RenameTagRequest{}
+	mi := &file_api_notes_v1_messages_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RenameTagRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenameTagRequest) ProtoMessage() {}
+
+func (x *RenameTagRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_notes_v1_messages_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenameTagRequest.ProtoReflect.Descriptor instead.
+func (*RenameTagRequest) Descriptor() ([]byte, []int) {
+	return file_api_notes_v1_messages_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *RenameTagRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RenameTagRequest) GetNewName() string {
+	if x != nil {
+		return x.NewName
+	}
+	return ""
+}
+
+// RenameTagResponse is the response message after renaming a tag.
+type RenameTagResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The renamed tag, or the tag it was merged into.
+	Tag           *Tag `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RenameTagResponse) Reset() {
+	*x = RenameTagResponse{}
+	mi := &file_api_notes_v1_messages_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RenameTagResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenameTagResponse) ProtoMessage() {}
+
+func (x *RenameTagResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_notes_v1_messages_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenameTagResponse.ProtoReflect.Descriptor instead.
+func (*RenameTagResponse) Descriptor() ([]byte, []int) {
+	return file_api_notes_v1_messages_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *RenameTagResponse) GetTag() *Tag {
+	if x != nil {
+		return x.Tag
+	}
+	return nil
+}
+
+// NoteRevision represents an immutable snapshot of a note taken on every change.
+type NoteRevision struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Unique identifier of the revision.
+	Id *types.ID `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// ID of the note the revision belongs to.
+	NoteId *types.ID `protobuf:"bytes,2,opt,name=note_id,json=noteId,proto3" json:"note_id,omitempty"`
+	// Sequential number of the revision within the note, starting at 1.
+	Revision int32 `protobuf:"varint,3,opt,name=revision,proto3" json:"revision,omitempty"`
+	// Title of the note at this revision.
+	Title string `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`
+	// Content/body of the note at this revision.
+	Content string `protobuf:"bytes,5,opt,name=content,proto3" json:"content,omitempty"`
+	// Timestamp when the revision was made.
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NoteRevision) Reset() {
+	*x = NoteRevision{}
+	mi := &file_api_notes_v1_messages_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NoteRevision) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NoteRevision) ProtoMessage() {}
+
+func (x *NoteRevision) ProtoReflect() protoreflect.Message {
+	mi := &file_api_notes_v1_messages_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NoteRevision.ProtoReflect.Descriptor instead.
+func (*NoteRevision) Descriptor() ([]byte, []int) {
+	return file_api_notes_v1_messages_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *NoteRevision) GetId() *types.ID {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+func (x *NoteRevision) GetNoteId() *types.ID {
+	if x != nil {
+		return x.NoteId
+	}
+	return nil
+}
+
+func (x *NoteRevision) GetRevision() int32 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+func (x *NoteRevision) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *NoteRevision) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *NoteRevision) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// ListNoteRevisionsRequest is the request message for listing revisions of a note.
+type ListNoteRevisionsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ID of the note whose revisions are listed.
+	NoteId *types.ID `protobuf:"bytes,1,opt,name=note_id,json=noteId,proto3" json:"note_id,omitempty"`
+	// Maximum number of revisions to return, the server uses 50 when unset.
+	PageSize int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// Page token received as `next_page_token` from a previous call for the same note.
+	PageToken     string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListNoteRevisionsRequest) Reset() {
+	*x = ListNoteRevisionsRequest{}
+	mi := &file_api_notes_v1_messages_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListNoteRevisionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListNoteRevisionsRequest) ProtoMessage() {}
+
+func (x *ListNoteRevisionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_notes_v1_messages_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListNoteRevisionsRequest.ProtoReflect.Descriptor instead.
+func (*ListNoteRevisionsRequest) Descriptor() ([]byte, []int) {
+	return file_api_notes_v1_messages_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *ListNoteRevisionsRequest) GetNoteId() *types.ID {
+	if x != nil {
+		return x.NoteId
+	}
+	return nil
+}
+
+func (x *ListNoteRevisionsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListNoteRevisionsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+// ListNoteRevisionsResponse is the response message containing revisions, newest first.
+type ListNoteRevisionsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// List of revisions.
+	Revisions []*NoteRevision `protobuf:"bytes,1,rep,name=revisions,proto3" json:"revisions,omitempty"`
+	// Token to retrieve the next page, empty when there are no more revisions.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListNoteRevisionsResponse) Reset() {
+	*x = ListNoteRevisionsResponse{}
+	mi := &file_api_notes_v1_messages_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListNoteRevisionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListNoteRevisionsResponse) ProtoMessage() {}
+
+func (x *ListNoteRevisionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_notes_v1_messages_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListNoteRevisionsResponse.ProtoReflect.Descriptor instead.
+func (*ListNoteRevisionsResponse) Descriptor() ([]byte, []int) {
+	return file_api_notes_v1_messages_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *ListNoteRevisionsResponse) GetRevisions() []*NoteRevision {
+	if x != nil {
+		return x.Revisions
+	}
+	return nil
+}
+
+func (x *ListNoteRevisionsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// GetNoteRevisionRequest is the request message for fetching a single revision.
+type GetNoteRevisionRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ID of the note the revision belongs to.
+	NoteId *types.ID `protobuf:"bytes,1,opt,name=note_id,json=noteId,proto3" json:"note_id,omitempty"`
+	// Number of the revision to retrieve.
+	Revision      int32 `protobuf:"varint,2,opt,name=revision,proto3" json:"revision,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetNoteRevisionRequest) Reset() {
+	*x = GetNoteRevisionRequest{}
+	mi := &file_api_notes_v1_messages_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNoteRevisionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNoteRevisionRequest) ProtoMessage() {}
+
+func (x *GetNoteRevisionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_notes_v1_messages_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNoteRevisionRequest.ProtoReflect.Descriptor instead.
+func (*GetNoteRevisionRequest) Descriptor() ([]byte, []int) {
+	return file_api_notes_v1_messages_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *GetNoteRevisionRequest) GetNoteId() *types.ID {
+	if x != nil {
+		return x.NoteId
+	}
+	return nil
+}
+
+func (x *GetNoteRevisionRequest) GetRevision() int32 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+// GetNoteRevisionResponse is the response message for a single revision retrieval.
+type GetNoteRevisionResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The requested revision.
+	Revision      *NoteRevision `protobuf:"bytes,1,opt,name=revision,proto3" json:"revision,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetNoteRevisionResponse) Reset() {
+	*x = GetNoteRevisionResponse{}
+	mi := &file_api_notes_v1_messages_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNoteRevisionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNoteRevisionResponse) ProtoMessage() {}
+
+func (x *GetNoteRevisionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_notes_v1_messages_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNoteRevisionResponse.ProtoReflect.Descriptor instead.
+func (*GetNoteRevisionResponse) Descriptor() ([]byte, []int) {
+	return file_api_notes_v1_messages_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *GetNoteRevisionResponse) GetRevision() *NoteRevision {
+	if x != nil {
+		return x.Revision
+	}
+	return nil
+}
+
+// DiffLine represents a single line of the content diff.
+type DiffLine struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// How the line changed.
+	Operation DiffOperation `protobuf:"varint,1,opt,name=operation,proto3,enum=api.notes.v1.DiffOperation" json:"operation,omitempty"`
+	// Text of the line without the trailing newline.
+	Text          string `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DiffLine) Reset() {
+	*x = DiffLine{}
+	mi := &file_api_notes_v1_messages_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiffLine) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiffLine) ProtoMessage() {}
+
+func (x *DiffLine) ProtoReflect() protoreflect.Message {
+	mi := &file_api_notes_v1_messages_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiffLine.ProtoReflect.Descriptor instead.
+func (*DiffLine) Descriptor() ([]byte, []int) {
+	return file_api_notes_v1_messages_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *DiffLine) GetOperation() DiffOperation {
+	if x != nil {
+		return x.Operation
+	}
+	return DiffOperation_DIFF_OPERATION_UNKNOWN
+}
+
+func (x *DiffLine) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+// DiffNoteRevisionsRequest is the request message for comparing two revisions of a note.
+type DiffNoteRevisionsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ID of the note the revisions belong to.
+	NoteId *types.ID `protobuf:"bytes,1,opt,name=note_id,json=noteId,proto3" json:"note_id,omitempty"`
+	// Number of the revision to compare from.
+	FromRevision int32 `protobuf:"varint,2,opt,name=from_revision,json=fromRevision,proto3" json:"from_revision,omitempty"`
+	// Number of the revision to compare to.
+	ToRevision    int32 `protobuf:"varint,3,opt,name=to_revision,json=toRevision,proto3" json:"to_revision,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DiffNoteRevisionsRequest) Reset() {
+	*x = DiffNoteRevisionsRequest{}
+	mi := &file_api_notes_v1_messages_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiffNoteRevisionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiffNoteRevisionsRequest) ProtoMessage() {}
+
+func (x *DiffNoteRevisionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_notes_v1_messages_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiffNoteRevisionsRequest.ProtoReflect.Descriptor instead.
+func (*DiffNoteRevisionsRequest) Descriptor() ([]byte, []int) {
+	return file_api_notes_v1_messages_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *DiffNoteRevisionsRequest) GetNoteId() *types.ID {
+	if x != nil {
+		return x.NoteId
+	}
+	return nil
+}
+
+func (x *DiffNoteRevisionsRequest) GetFromRevision() int32 {
+	if x != nil {
+		return x.FromRevision
+	}
+	return 0
+}
+
+func (x *DiffNoteRevisionsRequest) GetToRevision() int32 {
+	if x != nil {
+		return x.ToRevision
+	}
+	return 0
+}
+
+// DiffNoteRevisionsResponse is the response message containing the line-level diff.
+type DiffNoteRevisionsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Title of the note at the `from` revision.
+	FromTitle string `protobuf:"bytes,1,opt,name=from_title,json=fromTitle,proto3" json:"from_title,omitempty"`
+	// Title of the note at the `to` revision.
+	ToTitle string `protobuf:"bytes,2,opt,name=to_title,json=toTitle,proto3" json:"to_title,omitempty"`
+	// Lines of the content diff, in order.
+	Lines         []*DiffLine `protobuf:"bytes,3,rep,name=lines,proto3" json:"lines,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DiffNoteRevisionsResponse) Reset() {
+	*x = DiffNoteRevisionsResponse{}
+	mi := &file_api_notes_v1_messages_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiffNoteRevisionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiffNoteRevisionsResponse) ProtoMessage() {}
+
+func (x *DiffNoteRevisionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_notes_v1_messages_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiffNoteRevisionsResponse.ProtoReflect.Descriptor instead.
+func (*DiffNoteRevisionsResponse) Descriptor() ([]byte, []int) {
+	return file_api_notes_v1_messages_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *DiffNoteRevisionsResponse) GetFromTitle() string {
+	if x != nil {
+		return x.FromTitle
+	}
+	return ""
+}
+
+func (x *DiffNoteRevisionsResponse) GetToTitle() string {
+	if x != nil {
+		return x.ToTitle
+	}
+	return ""
+}
+
+func (x *DiffNoteRevisionsResponse) GetLines() []*DiffLine {
+	if x != nil {
+		return x.Lines
+	}
+	return nil
+}
+
+// RestoreNoteRevisionRequest is the request message for restoring a note to a previous revision.
+type RestoreNoteRevisionRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ID of the note to restore.
+	NoteId *types.ID `protobuf:"bytes,1,opt,name=note_id,json=noteId,proto3" json:"note_id,omitempty"`
+	// Number of the revision to restore.
+	Revision int32 `protobuf:"varint,2,opt,name=revision,proto3" json:"revision,omitempty"`
+	// Version of the note the change is based on, REST clients may send it as the `If-Match` header instead.
+	Version       int64 `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreNoteRevisionRequest) Reset() {
+	*x = RestoreNoteRevisionRequest{}
+	mi := &file_api_notes_v1_messages_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreNoteRevisionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreNoteRevisionRequest) ProtoMessage() {}
+
+func (x *RestoreNoteRevisionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_notes_v1_messages_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreNoteRevisionRequest.ProtoReflect.Descriptor instead.
+func (*RestoreNoteRevisionRequest) Descriptor() ([]byte, []int) {
+	return file_api_notes_v1_messages_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *RestoreNoteRevisionRequest) GetNoteId() *types.ID {
+	if x != nil {
+		return x.NoteId
+	}
+	return nil
+}
+
+func (x *RestoreNoteRevisionRequest) GetRevision() int32 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+func (x *RestoreNoteRevisionRequest) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+// RestoreNoteRevisionResponse is the response message after restoring a note.
+type RestoreNoteRevisionResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The restored note with refreshed timestamps.
+	Note *Note `protobuf:"bytes,1,opt,name=note,proto3" json:"note,omitempty"`
+	// The new revision recorded by the restore.
+	Revision      *NoteRevision `protobuf:"bytes,2,opt,name=revision,proto3" json:"revision,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreNoteRevisionResponse) Reset() {
+	*x = RestoreNoteRevisionResponse{}
+	mi := &file_api_notes_v1_messages_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreNoteRevisionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreNoteRevisionResponse) ProtoMessage() {}
+
+func (x *RestoreNoteRevisionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_notes_v1_messages_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreNoteRevisionResponse.ProtoReflect.Descriptor instead.
+func (*RestoreNoteRevisionResponse) Descriptor() ([]byte, []int) {
+	return file_api_notes_v1_messages_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *RestoreNoteRevisionResponse) GetNote() *Note {
+	if x != nil {
+		return x.Note
+	}
+	return nil
+}
+
+func (x *RestoreNoteRevisionResponse) GetRevision() *NoteRevision {
+	if x != nil {
+		return x.Revision
+	}
+	return nil
+}
+
+// BatchNoteResult represents the outcome of a single item of a batch operation.
+type BatchNoteResult struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ID of the note, unset for notes that failed to be created.
+	Id *types.ID `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The note, set for succeeded items, deleted notes are returned as they are in the trash.
+	Note *Note `protobuf:"bytes,2,opt,name=note,proto3" json:"note,omitempty"`
+	// The reason the item failed, unset for succeeded items.
+	Error         *types.Error `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchNoteResult) Reset() {
+	*x = BatchNoteResult{}
+	mi := &file_api_notes_v1_messages_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchNoteResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchNoteResult) ProtoMessage() {}
+
+func (x *BatchNoteResult) ProtoReflect() protoreflect.Message {
+	mi := &file_api_notes_v1_messages_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchNoteResult.ProtoReflect.Descriptor instead.
+func (*BatchNoteResult) Descriptor() ([]byte, []int) {
+	return file_api_notes_v1_messages_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *BatchNoteResult) GetId() *types.ID {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+func (x *BatchNoteResult) GetNote() *Note {
+	if x != nil {
+		return x.Note
+	}
+	return nil
+}
+
+func (x *BatchNoteResult) GetError() *types.Error {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+// BatchCreateNotesRequest is the request message for creating many notes at once.
+type BatchCreateNotesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Notes to create, the server rejects batches larger than its configured limit.
+	Notes         []*CreateNoteRequest `protobuf:"bytes,1,rep,name=notes,proto3" json:"notes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchCreateNotesRequest) Reset() {
+	*x = BatchCreateNotesRequest{}
+	mi := &file_api_notes_v1_messages_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchCreateNotesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchCreateNotesRequest) ProtoMessage() {}
+
+func (x *BatchCreateNotesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_notes_v1_messages_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchCreateNotesRequest.ProtoReflect.Descriptor instead.
+func (*BatchCreateNotesRequest) Descriptor() ([]byte, []int) {
+	return file_api_notes_v1_messages_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *BatchCreateNotesRequest) GetNotes() []*CreateNoteRequest {
+	if x != nil {
+		return x.Notes
+	}
+	return nil
+}
+
+// BatchCreateNotesResponse is the response message after creating many notes.
+type BatchCreateNotesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Results in the order of the requested notes.
+	Results       []*BatchNoteResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchCreateNotesResponse) Reset() {
+	*x = BatchCreateNotesResponse{}
+	mi := &file_api_notes_v1_messages_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchCreateNotesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchCreateNotesResponse) ProtoMessage() {}
+
+func (x *BatchCreateNotesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_notes_v1_messages_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchCreateNotesResponse.ProtoReflect.Descriptor instead.
+func (*BatchCreateNotesResponse) Descriptor() ([]byte, []int) {
+	return file_api_notes_v1_messages_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *BatchCreateNotesResponse) GetResults() []*BatchNoteResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+// BatchDeleteNotesRequest is the request message for moving many notes to the trash at once.
+type BatchDeleteNotesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// IDs of the notes to delete, the server rejects batches larger than its configured limit.
+	Ids []*types.ID `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
+	// Versions of the notes the deletion is based on by their IDs, every note needs one. A note without a version
+	// or of another version is not deleted and is reported in its result.
+	Versions      map[int64]int64 `protobuf:"bytes,2,rep,name=versions,proto3" json:"versions,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchDeleteNotesRequest) Reset() {
+	*x = BatchDeleteNotesRequest{}
+	mi := &file_api_notes_v1_messages_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchDeleteNotesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchDeleteNotesRequest) ProtoMessage() {}
+
+func (x *BatchDeleteNotesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_notes_v1_messages_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchDeleteNotesRequest.ProtoReflect.Descriptor instead.
+func (*BatchDeleteNotesRequest) Descriptor() ([]byte, []int) {
+	return file_api_notes_v1_messages_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *BatchDeleteNotesRequest) GetIds() []*types.ID {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+func (x *BatchDeleteNotesRequest) GetVersions() map[int64]int64 {
+	if x != nil {
+		return x.Versions
+	}
+	return nil
+}
+
+// BatchDeleteNotesResponse is the response message after deleting many notes.
+type BatchDeleteNotesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Results in the order of the requested IDs.
+	Results       []*BatchNoteResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchDeleteNotesResponse) Reset() {
+	*x = BatchDeleteNotesResponse{}
+	mi := &file_api_notes_v1_messages_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchDeleteNotesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchDeleteNotesResponse) ProtoMessage() {}
+
+func (x *BatchDeleteNotesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_notes_v1_messages_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchDeleteNotesResponse.ProtoReflect.Descriptor instead.
+func (*BatchDeleteNotesResponse) Descriptor() ([]byte, []int) {
+	return file_api_notes_v1_messages_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *BatchDeleteNotesResponse) GetResults() []*BatchNoteResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+// BatchGetNotesRequest is the request message for fetching many notes at once.
+type BatchGetNotesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// IDs of the notes to retrieve, the server rejects batches larger than its configured limit.
+	Ids           []*types.ID `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchGetNotesRequest) Reset() {
+	*x = BatchGetNotesRequest{}
+	mi := &file_api_notes_v1_messages_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchGetNotesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchGetNotesRequest) ProtoMessage() {}
+
+func (x *BatchGetNotesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_notes_v1_messages_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchGetNotesRequest.ProtoReflect.Descriptor instead.
+func (*BatchGetNotesRequest) Descriptor() ([]byte, []int) {
+	return file_api_notes_v1_messages_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *BatchGetNotesRequest) GetIds() []*types.ID {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+// BatchGetNotesResponse is the response message containing many notes.
+type BatchGetNotesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Results in the order of the requested IDs.
+	Results       []*BatchNoteResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchGetNotesResponse) Reset() {
+	*x = BatchGetNotesResponse{}
+	mi := &file_api_notes_v1_messages_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchGetNotesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchGetNotesResponse) ProtoMessage() {}
+
+func (x *BatchGetNotesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_notes_v1_messages_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchGetNotesResponse.ProtoReflect.Descriptor instead.
+func (*BatchGetNotesResponse) Descriptor() ([]byte, []int) {
+	return file_api_notes_v1_messages_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *BatchGetNotesResponse) GetResults() []*BatchNoteResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+// ExportNotesRequest is the request message for exporting all notes of the user.
+type ExportNotesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Format of the archive.
+	Format        ArchiveFormat `protobuf:"varint,1,opt,name=format,proto3,enum=api.notes.v1.ArchiveFormat" json:"format,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportNotesRequest) Reset() {
+	*x = ExportNotesRequest{}
+	mi := &file_api_notes_v1_messages_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportNotesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportNotesRequest) ProtoMessage() {}
+
+func (x *ExportNotesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_notes_v1_messages_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportNotesRequest.ProtoReflect.Descriptor instead.
+func (*ExportNotesRequest) Descriptor() ([]byte, []int) {
+	return file_api_notes_v1_messages_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *ExportNotesRequest) GetFormat() ArchiveFormat {
+	if x != nil {
+		return x.Format
+	}
+	return ArchiveFormat_ARCHIVE_FORMAT_UNKNOWN
+}
+
+// ExportNotesResponse is the response message in the export stream.
+type ExportNotesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The next chunk of the archive, the archive is the concatenation of all chunks.
+	Chunk         []byte `protobuf:"bytes,1,opt,name=chunk,proto3" json:"chunk,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportNotesResponse) Reset() {
+	*x = ExportNotesResponse{}
+	mi := &file_api_notes_v1_messages_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportNotesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportNotesResponse) ProtoMessage() {}
+
+func (x *ExportNotesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_notes_v1_messages_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportNotesResponse.ProtoReflect.Descriptor instead.
+func (*ExportNotesResponse) Descriptor() ([]byte, []int) {
+	return file_api_notes_v1_messages_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *ExportNotesResponse) GetChunk() []byte {
+	if x != nil {
+		return x.Chunk
+	}
+	return nil
+}
+
+// ImportNotesRequest is the request message in the import stream.
+type ImportNotesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Format of the archive, only the first message of the stream has to carry it.
+	Format ArchiveFormat `protobuf:"varint,1,opt,name=format,proto3,enum=api.notes.v1.ArchiveFormat" json:"format,omitempty"`
+	// The next chunk of the archive, the archive is the concatenation of all chunks.
+	Chunk         []byte `protobuf:"bytes,2,opt,name=chunk,proto3" json:"chunk,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportNotesRequest) Reset() {
+	*x = ImportNotesRequest{}
+	mi := &file_api_notes_v1_messages_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportNotesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportNotesRequest) ProtoMessage() {}
+
+func (x *ImportNotesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_notes_v1_messages_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportNotesRequest.ProtoReflect.Descriptor instead.
+func (*ImportNotesRequest) Descriptor() ([]byte, []int) {
+	return file_api_notes_v1_messages_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *ImportNotesRequest) GetFormat() ArchiveFormat {
+	if x != nil {
+		return x.Format
+	}
+	return ArchiveFormat_ARCHIVE_FORMAT_UNKNOWN
+}
+
+func (x *ImportNotesRequest) GetChunk() []byte {
+	if x != nil {
+		return x.Chunk
+	}
+	return nil
+}
+
+// ImportNoteResult represents the outcome of a single note of an import.
+type ImportNoteResult struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Line of the note for JSON lines archives or name of the file for Markdown archives.
+	Source string `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
+	// What happened to the note.
+	Status ImportStatus `protobuf:"varint,2,opt,name=status,proto3,enum=api.notes.v1.ImportStatus" json:"status,omitempty"`
+	// The created note or the existing note it duplicates, unset for failed notes.
+	Note *Note `protobuf:"bytes,3,opt,name=note,proto3" json:"note,omitempty"`
+	// The reason the note failed, unset for created and duplicate notes.
+	Error         *types.Error `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportNoteResult) Reset() {
+	*x = ImportNoteResult{}
+	mi := &file_api_notes_v1_messages_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportNoteResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportNoteResult) ProtoMessage() {}
+
+func (x *ImportNoteResult) ProtoReflect() protoreflect.Message {
+	mi := &file_api_notes_v1_messages_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportNoteResult.ProtoReflect.Descriptor instead.
+func (*ImportNoteResult) Descriptor() ([]byte, []int) {
+	return file_api_notes_v1_messages_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *ImportNoteResult) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *ImportNoteResult) GetStatus() ImportStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ImportStatus_IMPORT_STATUS_UNKNOWN
+}
+
+func (x *ImportNoteResult) GetNote() *Note {
+	if x != nil {
+		return x.Note
+	}
+	return nil
+}
+
+func (x *ImportNoteResult) GetError() *types.Error {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+// ImportNotesResponse is the response message after importing notes.
+type ImportNotesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Results in the order of the notes in the archive.
+	Results []*ImportNoteResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	// Number of created notes.
+	Created int32 `protobuf:"varint,2,opt,name=created,proto3" json:"created,omitempty"`
+	// Number of skipped duplicate notes.
+	Duplicates int32 `protobuf:"varint,3,opt,name=duplicates,proto3" json:"duplicates,omitempty"`
+	// Number of failed notes.
+	Failed        int32 `protobuf:"varint,4,opt,name=failed,proto3" json:"failed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportNotesResponse) Reset() {
+	*x = ImportNotesResponse{}
+	mi := &file_api_notes_v1_messages_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportNotesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportNotesResponse) ProtoMessage() {}
+
+func (x *ImportNotesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_notes_v1_messages_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportNotesResponse.ProtoReflect.Descriptor instead.
+func (*ImportNotesResponse) Descriptor() ([]byte, []int) {
+	return file_api_notes_v1_messages_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *ImportNotesResponse) GetResults() []*ImportNoteResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *ImportNotesResponse) GetCreated() int32 {
+	if x != nil {
+		return x.Created
+	}
+	return 0
+}
+
+func (x *ImportNotesResponse) GetDuplicates() int32 {
+	if x != nil {
+		return x.Duplicates
+	}
+	return 0
+}
+
+func (x *ImportNotesResponse) GetFailed() int32 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+// Attachment represents a file attached to a note.
+type Attachment struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Unique identifier of the attachment.
+	Id *types.ID `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// ID of the note the file is attached to.
+	NoteId *types.ID `protobuf:"bytes,2,opt,name=note_id,json=noteId,proto3" json:"note_id,omitempty"`
+	// Name of the file.
+	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// Media type of the file, e.g. `image/png`.
+	ContentType string `protobuf:"bytes,4,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	// Size of the file in bytes.
+	Size int64 `protobuf:"varint,5,opt,name=size,proto3" json:"size,omitempty"`
+	// Hex encoded SHA-256 of the file content.
+	Checksum string `protobuf:"bytes,6,opt,name=checksum,proto3" json:"checksum,omitempty"`
+	// Timestamp when the file was uploaded.
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Attachment) Reset() {
+	*x = Attachment{}
+	mi := &file_api_notes_v1_messages_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Attachment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Attachment) ProtoMessage() {}
+
+func (x *Attachment) ProtoReflect() protoreflect.Message {
+	mi := &file_api_notes_v1_messages_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Attachment.ProtoReflect.Descriptor instead.
+func (*Attachment) Descriptor() ([]byte, []int) {
+	return file_api_notes_v1_messages_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *Attachment) GetId() *types.ID {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+func (x *Attachment) GetNoteId() *types.ID {
+	if x != nil {
+		return x.NoteId
+	}
+	return nil
+}
+
+func (x *Attachment) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Attachment) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *Attachment) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *Attachment) GetChecksum() string {
+	if x != nil {
+		return x.Checksum
+	}
+	return ""
+}
+
+func (x *Attachment) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// AttachmentHeader describes the file being uploaded.
+type AttachmentHeader struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ID of the note to attach the file to.
+	NoteId *types.ID `protobuf:"bytes,1,opt,name=note_id,json=noteId,proto3" json:"note_id,omitempty"`
+	// Name of the file.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Media type of the file, `application/octet-stream` when unset.
+	ContentType string `protobuf:"bytes,3,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	// Hex encoded SHA-256 of the file content, the upload is rejected when the received content does not match it.
+	Checksum      string `protobuf:"bytes,4,opt,name=checksum,proto3" json:"checksum,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AttachmentHeader) Reset() {
+	*x = AttachmentHeader{}
+	mi := &file_api_notes_v1_messages_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AttachmentHeader) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttachmentHeader) ProtoMessage() {}
+
+func (x *AttachmentHeader) ProtoReflect() protoreflect.Message {
+	mi := &file_api_notes_v1_messages_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttachmentHeader.ProtoReflect.Descriptor instead.
+func (*AttachmentHeader) Descriptor() ([]byte, []int) {
+	return file_api_notes_v1_messages_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *AttachmentHeader) GetNoteId() *types.ID {
+	if x != nil {
+		return x.NoteId
+	}
+	return nil
+}
+
+func (x *AttachmentHeader) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AttachmentHeader) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *AttachmentHeader) GetChecksum() string {
+	if x != nil {
+		return x.Checksum
+	}
+	return ""
+}
+
+// UploadAttachmentRequest is the request message in the upload stream.
+type UploadAttachmentRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The content of the upload request, the header comes first and the chunks of the file follow it.
+	//
+	// Types that are valid to be assigned to Payload:
+	//
+	//	*UploadAttachmentRequest_Header
+	//	*UploadAttachmentRequest_Chunk
+	Payload       isUploadAttachmentRequest_Payload `protobuf_oneof:"payload"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadAttachmentRequest) Reset() {
+	*x = UploadAttachmentRequest{}
+	mi := &file_api_notes_v1_messages_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadAttachmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadAttachmentRequest) ProtoMessage() {}
+
+func (x *UploadAttachmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_notes_v1_messages_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadAttachmentRequest.ProtoReflect.Descriptor instead.
+func (*UploadAttachmentRequest) Descriptor() ([]byte, []int) {
+	return file_api_notes_v1_messages_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *UploadAttachmentRequest) GetPayload() isUploadAttachmentRequest_Payload {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *UploadAttachmentRequest) GetHeader() *AttachmentHeader {
+	if x != nil {
+		if x, ok := x.Payload.(*UploadAttachmentRequest_Header); ok {
+			return x.Header
+		}
+	}
+	return nil
+}
+
+func (x *UploadAttachmentRequest) GetChunk() []byte {
+	if x != nil {
+		if x, ok := x.Payload.(*UploadAttachmentRequest_Chunk); ok {
+			return x.Chunk
+		}
+	}
+	return nil
+}
+
+type isUploadAttachmentRequest_Payload interface {
+	isUploadAttachmentRequest_Payload()
+}
+
+type UploadAttachmentRequest_Header struct {
+	// Description of the file, sent once at the start of the stream.
+	Header *AttachmentHeader `protobuf:"bytes,1,opt,name=header,proto3,oneof"`
+}
+
+type UploadAttachmentRequest_Chunk struct {
+	// The next chunk of the file, the file is the concatenation of all chunks.
+	Chunk []byte `protobuf:"bytes,2,opt,name=chunk,proto3,oneof"`
+}
+
+func (*UploadAttachmentRequest_Header) isUploadAttachmentRequest_Payload() {}
+
+func (*UploadAttachmentRequest_Chunk) isUploadAttachmentRequest_Payload() {}
+
+// UploadAttachmentResponse is the response message after uploading a file.
+type UploadAttachmentResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The uploaded attachment.
+	Attachment    *Attachment `protobuf:"bytes,1,opt,name=attachment,proto3" json:"attachment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadAttachmentResponse) Reset() {
+	*x = UploadAttachmentResponse{}
+	mi := &file_api_notes_v1_messages_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadAttachmentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadAttachmentResponse) ProtoMessage() {}
+
+func (x *UploadAttachmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_notes_v1_messages_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadAttachmentResponse.ProtoReflect.Descriptor instead.
+func (*UploadAttachmentResponse) Descriptor() ([]byte, []int) {
+	return file_api_notes_v1_messages_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *UploadAttachmentResponse) GetAttachment() *Attachment {
+	if x != nil {
+		return x.Attachment
+	}
+	return nil
+}
+
+// DownloadAttachmentRequest is the request message for downloading a file.
+type DownloadAttachmentRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ID of the attachment to download.
+	Id            *types.ID `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DownloadAttachmentRequest) Reset() {
+	*x = DownloadAttachmentRequest{}
+	mi := &file_api_notes_v1_messages_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DownloadAttachmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownloadAttachmentRequest) ProtoMessage() {}
+
+func (x *DownloadAttachmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_notes_v1_messages_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DownloadAttachmentRequest.ProtoReflect.Descriptor instead.
+func (*DownloadAttachmentRequest) Descriptor() ([]byte, []int) {
+	return file_api_notes_v1_messages_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *DownloadAttachmentRequest) GetId() *types.ID {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+// DownloadAttachmentResponse is the response message in the download stream.
+type DownloadAttachmentResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The content of the download response, the attachment comes first and the chunks of the file follow it.
+	//
+	// Types that are valid to be assigned to Payload:
+	//
+	//	*DownloadAttachmentResponse_Attachment
+	//	*DownloadAttachmentResponse_Chunk
+	Payload       isDownloadAttachmentResponse_Payload `protobuf_oneof:"payload"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DownloadAttachmentResponse) Reset() {
+	*x = DownloadAttachmentResponse{}
+	mi := &file_api_notes_v1_messages_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DownloadAttachmentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownloadAttachmentResponse) ProtoMessage() {}
+
+func (x *DownloadAttachmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_notes_v1_messages_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DownloadAttachmentResponse.ProtoReflect.Descriptor instead.
+func (*DownloadAttachmentResponse) Descriptor() ([]byte, []int) {
+	return file_api_notes_v1_messages_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *DownloadAttachmentResponse) GetPayload() isDownloadAttachmentResponse_Payload {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *DownloadAttachmentResponse) GetAttachment() *Attachment {
+	if x != nil {
+		if x, ok := x.Payload.(*DownloadAttachmentResponse_Attachment); ok {
+			return x.Attachment
+		}
+	}
+	return nil
+}
+
+func (x *DownloadAttachmentResponse) GetChunk() []byte {
+	if x != nil {
+		if x, ok := x.Payload.(*DownloadAttachmentResponse_Chunk); ok {
+			return x.Chunk
+		}
+	}
+	return nil
+}
+
+type isDownloadAttachmentResponse_Payload interface {
+	isDownloadAttachmentResponse_Payload()
+}
+
+type DownloadAttachmentResponse_Attachment struct {
+	// Description of the file, sent once at the start of the stream.
+	Attachment *Attachment `protobuf:"bytes,1,opt,name=attachment,proto3,oneof"`
+}
+
+type DownloadAttachmentResponse_Chunk struct {
+	// The next chunk of the file, the file is the concatenation of all chunks.
+	Chunk []byte `protobuf:"bytes,2,opt,name=chunk,proto3,oneof"`
+}
+
+func (*DownloadAttachmentResponse_Attachment) isDownloadAttachmentResponse_Payload() {}
+
+func (*DownloadAttachmentResponse_Chunk) isDownloadAttachmentResponse_Payload() {}
+
+// ListAttachmentsRequest is the request message for listing files attached to a note.
+type ListAttachmentsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ID of the note.
+	NoteId        *types.ID `protobuf:"bytes,1,opt,name=note_id,json=noteId,proto3" json:"note_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAttachmentsRequest) Reset() {
+	*x = ListAttachmentsRequest{}
+	mi := &file_api_notes_v1_messages_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAttachmentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAttachmentsRequest) ProtoMessage() {}
+
+func (x *ListAttachmentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_notes_v1_messages_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAttachmentsRequest.ProtoReflect.Descriptor instead.
+func (*ListAttachmentsRequest) Descriptor() ([]byte, []int) {
+	return file_api_notes_v1_messages_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *ListAttachmentsRequest) GetNoteId() *types.ID {
+	if x != nil {
+		return x.NoteId
+	}
+	return nil
+}
+
+// ListAttachmentsResponse is the response message containing files attached to a note.
+type ListAttachmentsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Attachments of the note, newest first.
+	Attachments   []*Attachment `protobuf:"bytes,1,rep,name=attachments,proto3" json:"attachments,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAttachmentsResponse) Reset() {
+	*x = ListAttachmentsResponse{}
+	mi := &file_api_notes_v1_messages_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAttachmentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAttachmentsResponse) ProtoMessage() {}
+
+func (x *ListAttachmentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_notes_v1_messages_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAttachmentsResponse.ProtoReflect.Descriptor instead.
+func (*ListAttachmentsResponse) Descriptor() ([]byte, []int) {
+	return file_api_notes_v1_messages_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *ListAttachmentsResponse) GetAttachments() []*Attachment {
+	if x != nil {
+		return x.Attachments
+	}
+	return nil
+}
+
+// DeleteAttachmentRequest is the request message for deleting a file.
+type DeleteAttachmentRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ID of the attachment to delete.
+	Id            *types.ID `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAttachmentRequest) Reset() {
+	*x = DeleteAttachmentRequest{}
+	mi := &file_api_notes_v1_messages_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAttachmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAttachmentRequest) ProtoMessage() {}
+
+func (x *DeleteAttachmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_notes_v1_messages_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAttachmentRequest.ProtoReflect.Descriptor instead.
+func (*DeleteAttachmentRequest) Descriptor() ([]byte, []int) {
+	return file_api_notes_v1_messages_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *DeleteAttachmentRequest) GetId() *types.ID {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+// DeleteAttachmentResponse is the response message after deleting a file.
+type DeleteAttachmentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAttachmentResponse) Reset() {
+	*x = DeleteAttachmentResponse{}
+	mi := &file_api_notes_v1_messages_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAttachmentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAttachmentResponse) ProtoMessage() {}
+
+func (x *DeleteAttachmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_notes_v1_messages_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAttachmentResponse.ProtoReflect.Descriptor instead.
+func (*DeleteAttachmentResponse) Descriptor() ([]byte, []int) {
+	return file_api_notes_v1_messages_proto_rawDescGZIP(), []int{70}
+}
+
+// MoveNoteRequest is the request message for moving a note between notebooks.
+type MoveNoteRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ID of the note to move.
+	Id *types.ID `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// ID of the target notebook, the note is moved to the root when unset.
+	NotebookId *types.ID `protobuf:"bytes,2,opt,name=notebook_id,json=notebookId,proto3" json:"notebook_id,omitempty"`
+	// Version of the note the change is based on, REST clients may send it as the `If-Match` header instead.
+	Version       int64 `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MoveNoteRequest) Reset() {
+	*x = MoveNoteRequest{}
+	mi := &file_api_notes_v1_messages_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MoveNoteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveNoteRequest) ProtoMessage() {}
+
+func (x *MoveNoteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_notes_v1_messages_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveNoteRequest.ProtoReflect.Descriptor instead.
+func (*MoveNoteRequest) Descriptor() ([]byte, []int) {
+	return file_api_notes_v1_messages_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *MoveNoteRequest) GetId() *types.ID {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+func (x *MoveNoteRequest) GetNotebookId() *types.ID {
+	if x != nil {
+		return x.NotebookId
+	}
+	return nil
+}
+
+func (x *MoveNoteRequest) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+// MoveNoteResponse is the response message after moving a note.
+type MoveNoteResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The moved note.
+	Note          *Note `protobuf:"bytes,1,opt,name=note,proto3" json:"note,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MoveNoteResponse) Reset() {
+	*x = MoveNoteResponse{}
+	mi := &file_api_notes_v1_messages_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MoveNoteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveNoteResponse) ProtoMessage() {}
+
+func (x *MoveNoteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_notes_v1_messages_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveNoteResponse.ProtoReflect.Descriptor instead.
+func (*MoveNoteResponse) Descriptor() ([]byte, []int) {
+	return file_api_notes_v1_messages_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *MoveNoteResponse) GetNote() *Note {
+	if x != nil {
+		return x.Note
+	}
+	return nil
+}
+
+// Notebook represents a folder grouping notes of a user, notebooks can be nested.
+type Notebook struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Unique identifier of the notebook.
+	Id *types.ID `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Name of the notebook.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// ID of the parent notebook, unset for notebooks in the root.
+	ParentId *types.ID `protobuf:"bytes,3,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	// Timestamp when the notebook was created.
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// Timestamp when the notebook was last updated.
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Notebook) Reset() {
+	*x = Notebook{}
+	mi := &file_api_notes_v1_messages_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Notebook) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Notebook) ProtoMessage() {}
+
+func (x *Notebook) ProtoReflect() protoreflect.Message {
+	mi := &file_api_notes_v1_messages_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Notebook.ProtoReflect.Descriptor instead.
+func (*Notebook) Descriptor() ([]byte, []int) {
+	return file_api_notes_v1_messages_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *Notebook) GetId() *types.ID {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+func (x *Notebook) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Notebook) GetParentId() *types.ID {
+	if x != nil {
+		return x.ParentId
+	}
+	return nil
+}
+
+func (x *Notebook) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Notebook) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// CreateNotebookRequest is the request message for creating a notebook.
+type CreateNotebookRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Name of the new notebook.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// ID of the parent notebook, the notebook is created in the root when unset.
+	ParentId      *types.ID `protobuf:"bytes,2,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateNotebookRequest) Reset() {
+	*x = CreateNotebookRequest{}
+	mi := &file_api_notes_v1_messages_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateNotebookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateNotebookRequest) ProtoMessage() {}
+
+func (x *CreateNotebookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_notes_v1_messages_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateNotebookRequest.ProtoReflect.Descriptor instead.
+func (*CreateNotebookRequest) Descriptor() ([]byte, []int) {
+	return file_api_notes_v1_messages_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *CreateNotebookRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateNotebookRequest) GetParentId() *types.ID {
+	if x != nil {
+		return x.ParentId
+	}
+	return nil
+}
+
+// CreateNotebookResponse is the response message after creating a notebook.
+type CreateNotebookResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The created notebook.
+	Notebook      *Notebook `protobuf:"bytes,1,opt,name=notebook,proto3" json:"notebook,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateNotebookResponse) Reset() {
+	*x = CreateNotebookResponse{}
+	mi := &file_api_notes_v1_messages_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateNotebookResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateNotebookResponse) ProtoMessage() {}
+
+func (x *CreateNotebookResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_notes_v1_messages_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateNotebookResponse.ProtoReflect.Descriptor instead.
+func (*CreateNotebookResponse) Descriptor() ([]byte, []int) {
+	return file_api_notes_v1_messages_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *CreateNotebookResponse) GetNotebook() *Notebook {
+	if x != nil {
+		return x.Notebook
+	}
+	return nil
+}
+
+// GetNotebookRequest is the request message for fetching a single notebook.
+type GetNotebookRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ID of the notebook to retrieve.
+	Id            *types.ID `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetNotebookRequest) Reset() {
+	*x = GetNotebookRequest{}
+	mi := &file_api_notes_v1_messages_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNotebookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNotebookRequest) ProtoMessage() {}
+
+func (x *GetNotebookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_notes_v1_messages_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNotebookRequest.ProtoReflect.Descriptor instead.
+func (*GetNotebookRequest) Descriptor() ([]byte, []int) {
+	return file_api_notes_v1_messages_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *GetNotebookRequest) GetId() *types.ID {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+// GetNotebookResponse is the response message for a single notebook retrieval.
+type GetNotebookResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The requested notebook.
+	Notebook      *Notebook `protobuf:"bytes,1,opt,name=notebook,proto3" json:"notebook,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetNotebookResponse) Reset() {
+	*x = GetNotebookResponse{}
+	mi := &file_api_notes_v1_messages_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNotebookResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNotebookResponse) ProtoMessage() {}
+
+func (x *GetNotebookResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_notes_v1_messages_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNotebookResponse.ProtoReflect.Descriptor instead.
+func (*GetNotebookResponse) Descriptor() ([]byte, []int) {
+	return file_api_notes_v1_messages_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *GetNotebookResponse) GetNotebook() *Notebook {
+	if x != nil {
+		return x.Notebook
+	}
+	return nil
+}
+
+// ListNotebooksRequest is the request message for listing notebooks of the user.
+type ListNotebooksRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListNotebooksRequest) Reset() {
+	*x = ListNotebooksRequest{}
+	mi := &file_api_notes_v1_messages_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListNotebooksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListNotebooksRequest) ProtoMessage() {}
+
+func (x *ListNotebooksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_notes_v1_messages_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListNotebooksRequest.ProtoReflect.Descriptor instead.
+func (*ListNotebooksRequest) Descriptor() ([]byte, []int) {
+	return file_api_notes_v1_messages_proto_rawDescGZIP(), []int{78}
+}
+
+// ListNotebooksResponse is the response message containing all notebooks of the user.
+type ListNotebooksResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Notebooks sorted by name, the hierarchy is restored by `parent_id`.
+	Notebooks     []*Notebook `protobuf:"bytes,1,rep,name=notebooks,proto3" json:"notebooks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListNotebooksResponse) Reset() {
+	*x = ListNotebooksResponse{}
+	mi := &file_api_notes_v1_messages_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListNotebooksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListNotebooksResponse) ProtoMessage() {}
+
+func (x *ListNotebooksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_notes_v1_messages_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListNotebooksResponse.ProtoReflect.Descriptor instead.
+func (*ListNotebooksResponse) Descriptor() ([]byte, []int) {
+	return file_api_notes_v1_messages_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *ListNotebooksResponse) GetNotebooks() []*Notebook {
+	if x != nil {
+		return x.Notebooks
+	}
+	return nil
+}
+
+// UpdateNotebookRequest is the request message for renaming or moving a notebook.
+type UpdateNotebookRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ID of the notebook to update.
+	Id *types.ID `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// New name of the notebook, applied when `name` is present in the update mask.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// New parent of the notebook, applied when `parent_id` is present in the update mask, unset moves it to the root.
+	ParentId *types.ID `protobuf:"bytes,3,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	// Fields of the notebook to update, allowed paths are `name` and `parent_id`.
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,4,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateNotebookRequest) Reset() {
+	*x = UpdateNotebookRequest{}
+	mi := &file_api_notes_v1_messages_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateNotebookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateNotebookRequest) ProtoMessage() {}
+
+func (x *UpdateNotebookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_notes_v1_messages_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateNotebookRequest.ProtoReflect.Descriptor instead.
+func (*UpdateNotebookRequest) Descriptor() ([]byte, []int) {
+	return file_api_notes_v1_messages_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *UpdateNotebookRequest) GetId() *types.ID {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+func (x *UpdateNotebookRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateNotebookRequest) GetParentId() *types.ID {
+	if x != nil {
+		return x.ParentId
+	}
+	return nil
+}
+
+func (x *UpdateNotebookRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+// UpdateNotebookResponse is the response message after updating a notebook.
+type UpdateNotebookResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The updated notebook.
+	Notebook      *Notebook `protobuf:"bytes,1,opt,name=notebook,proto3" json:"notebook,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateNotebookResponse) Reset() {
+	*x = UpdateNotebookResponse{}
+	mi := &file_api_notes_v1_messages_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateNotebookResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateNotebookResponse) ProtoMessage() {}
+
+func (x *UpdateNotebookResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_notes_v1_messages_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateNotebookResponse.ProtoReflect.Descriptor instead.
+func (*UpdateNotebookResponse) Descriptor() ([]byte, []int) {
+	return file_api_notes_v1_messages_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *UpdateNotebookResponse) GetNotebook() *Notebook {
+	if x != nil {
+		return x.Notebook
+	}
+	return nil
+}
+
+// DeleteNotebookRequest is the request message for deleting a notebook.
+type DeleteNotebookRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ID of the notebook to delete.
+	Id *types.ID `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// What happens with nested notebooks and notes, defaults to moving them to the root.
+	Mode          NotebookDeleteMode `protobuf:"varint,2,opt,name=mode,proto3,enum=api.notes.v1.NotebookDeleteMode" json:"mode,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteNotebookRequest) Reset() {
+	*x = DeleteNotebookRequest{}
+	mi := &file_api_notes_v1_messages_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteNotebookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteNotebookRequest) ProtoMessage() {}
+
+func (x *DeleteNotebookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_notes_v1_messages_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteNotebookRequest.ProtoReflect.Descriptor instead.
+func (*DeleteNotebookRequest) Descriptor() ([]byte, []int) {
+	return file_api_notes_v1_messages_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *DeleteNotebookRequest) GetId() *types.ID {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+func (x *DeleteNotebookRequest) GetMode() NotebookDeleteMode {
+	if x != nil {
+		return x.Mode
+	}
+	return NotebookDeleteMode_NOTEBOOK_DELETE_MODE_UNKNOWN
+}
+
+// DeleteNotebookResponse is the response message after deleting a notebook.
+type DeleteNotebookResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteNotebookResponse) Reset() {
+	*x = DeleteNotebookResponse{}
+	mi := &file_api_notes_v1_messages_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteNotebookResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteNotebookResponse) ProtoMessage() {}
+
+func (x *DeleteNotebookResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_notes_v1_messages_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteNotebookResponse.ProtoReflect.Descriptor instead.
+func (*DeleteNotebookResponse) Descriptor() ([]byte, []int) {
+	return file_api_notes_v1_messages_proto_rawDescGZIP(), []int{83}
+}
+
+// Template represents a predefined structure of notes of the user.
+type Template struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Unique identifier of the template.
+	Id *types.ID `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Name of the template.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Title of the notes created from the template, may have placeholders such as `{{date}}`.
+	Title string `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	// Content of the notes created from the template, may have placeholders such as `{{user.name}}`.
+	Content string `protobuf:"bytes,4,opt,name=content,proto3" json:"content,omitempty"`
+	// Names of the placeholders of the title and the content in the order they first appear.
+	Placeholders []string `protobuf:"bytes,5,rep,name=placeholders,proto3" json:"placeholders,omitempty"`
+	// Timestamp when the template was created.
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// Timestamp when the template was last updated.
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Template) Reset() {
+	*x = Template{}
+	mi := &file_api_notes_v1_messages_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Template) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Template) ProtoMessage() {}
+
+func (x *Template) ProtoReflect() protoreflect.Message {
+	mi := &file_api_notes_v1_messages_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Template.ProtoReflect.Descriptor instead.
+func (*Template) Descriptor() ([]byte, []int) {
+	return file_api_notes_v1_messages_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *Template) GetId() *types.ID {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+func (x *Template) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Template) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Template) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *Template) GetPlaceholders() []string {
+	if x != nil {
+		return x.Placeholders
+	}
+	return nil
+}
+
+func (x *Template) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Template) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// CreateTemplateRequest is the request message for creating a template.
+type CreateTemplateRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Name of the new template.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Title of the notes created from the template, the title of the note is required when unset.
+	Title string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	// Content of the notes created from the template.
+	Content       string `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTemplateRequest) Reset() {
+	*x = CreateTemplateRequest{}
+	mi := &file_api_notes_v1_messages_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTemplateRequest) ProtoMessage() {}
+
+func (x *CreateTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_notes_v1_messages_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTemplateRequest.ProtoReflect.Descriptor instead.
+func (*CreateTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_api_notes_v1_messages_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *CreateTemplateRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateTemplateRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *CreateTemplateRequest) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+// CreateTemplateResponse is the response message after creating a template.
+type CreateTemplateResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The created template.
+	Template      *Template `protobuf:"bytes,1,opt,name=template,proto3" json:"template,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTemplateResponse) Reset() {
+	*x = CreateTemplateResponse{}
+	mi := &file_api_notes_v1_messages_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTemplateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTemplateResponse) ProtoMessage() {}
+
+func (x *CreateTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_notes_v1_messages_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTemplateResponse.ProtoReflect.Descriptor instead.
+func (*CreateTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_api_notes_v1_messages_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *CreateTemplateResponse) GetTemplate() *Template {
+	if x != nil {
+		return x.Template
+	}
+	return nil
+}
+
+// GetTemplateRequest is the request message for fetching a single template.
+type GetTemplateRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ID of the template to retrieve.
+	Id            *types.ID `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTemplateRequest) Reset() {
+	*x = GetTemplateRequest{}
+	mi := &file_api_notes_v1_messages_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTemplateRequest) ProtoMessage() {}
+
+func (x *GetTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_notes_v1_messages_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTemplateRequest.ProtoReflect.Descriptor instead.
+func (*GetTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_api_notes_v1_messages_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *GetTemplateRequest) GetId() *types.ID {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+// GetTemplateResponse is the response message for a single template retrieval.
+type GetTemplateResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The requested template.
+	Template      *Template `protobuf:"bytes,1,opt,name=template,proto3" json:"template,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTemplateResponse) Reset() {
+	*x = GetTemplateResponse{}
+	mi := &file_api_notes_v1_messages_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTemplateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTemplateResponse) ProtoMessage() {}
+
+func (x *GetTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_notes_v1_messages_proto_msgTypes[88]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTemplateResponse.ProtoReflect.Descriptor instead.
+func (*GetTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_api_notes_v1_messages_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *GetTemplateResponse) GetTemplate() *Template {
+	if x != nil {
+		return x.Template
+	}
+	return nil
+}
+
+// ListTemplatesRequest is the request message for listing templates of the user.
+type ListTemplatesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTemplatesRequest) Reset() {
+	*x = ListTemplatesRequest{}
+	mi := &file_api_notes_v1_messages_proto_msgTypes[89]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTemplatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTemplatesRequest) ProtoMessage() {}
+
+func (x *ListTemplatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_notes_v1_messages_proto_msgTypes[89]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTemplatesRequest.ProtoReflect.Descriptor instead.
+func (*ListTemplatesRequest) Descriptor() ([]byte, []int) {
+	return file_api_notes_v1_messages_proto_rawDescGZIP(), []int{89}
+}
+
+// ListTemplatesResponse is the response message containing all templates of the user.
+type ListTemplatesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Templates sorted by name.
+	Templates     []*Template `protobuf:"bytes,1,rep,name=templates,proto3" json:"templates,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTemplatesResponse) Reset() {
+	*x = ListTemplatesResponse{}
+	mi := &file_api_notes_v1_messages_proto_msgTypes[90]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTemplatesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTemplatesResponse) ProtoMessage() {}
+
+func (x *ListTemplatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_notes_v1_messages_proto_msgTypes[90]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTemplatesResponse.ProtoReflect.Descriptor instead.
+func (*ListTemplatesResponse) Descriptor() ([]byte, []int) {
+	return file_api_notes_v1_messages_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *ListTemplatesResponse) GetTemplates() []*Template {
+	if x != nil {
+		return x.Templates
+	}
+	return nil
+}
+
+// UpdateTemplateRequest is the request message for partially updating a template.
+type UpdateTemplateRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ID of the template to update.
+	Id *types.ID `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// New name of the template, applied when `name` is present in the update mask.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// New title of the template, applied when `title` is present in the update mask.
+	Title string `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	// New content of the template, applied when `content` is present in the update mask.
+	Content string `protobuf:"bytes,4,opt,name=content,proto3" json:"content,omitempty"`
+	// Fields of the template to update, allowed paths are `name`, `title` and `content`.
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,5,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateTemplateRequest) Reset() {
+	*x = UpdateTemplateRequest{}
+	mi := &file_api_notes_v1_messages_proto_msgTypes[91]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTemplateRequest) ProtoMessage() {}
+
+func (x *UpdateTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_notes_v1_messages_proto_msgTypes[91]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTemplateRequest.ProtoReflect.Descriptor instead.
+func (*UpdateTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_api_notes_v1_messages_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *UpdateTemplateRequest) GetId() *types.ID {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+func (x *UpdateTemplateRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateTemplateRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *UpdateTemplateRequest) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *UpdateTemplateRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+// UpdateTemplateResponse is the response message after updating a template.
+type UpdateTemplateResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The updated template.
+	Template      *Template `protobuf:"bytes,1,opt,name=template,proto3" json:"template,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateTemplateResponse) Reset() {
+	*x = UpdateTemplateResponse{}
+	mi := &file_api_notes_v1_messages_proto_msgTypes[92]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTemplateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTemplateResponse) ProtoMessage() {}
+
+func (x *UpdateTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_notes_v1_messages_proto_msgTypes[92]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTemplateResponse.ProtoReflect.Descriptor instead.
+func (*UpdateTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_api_notes_v1_messages_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *UpdateTemplateResponse) GetTemplate() *Template {
+	if x != nil {
+		return x.Template
+	}
+	return nil
+}
+
+// DeleteTemplateRequest is the request message for deleting a template.
+type DeleteTemplateRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ID of the template to delete.
+	Id            *types.ID `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTemplateRequest) Reset() {
+	*x = DeleteTemplateRequest{}
+	mi := &file_api_notes_v1_messages_proto_msgTypes[93]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTemplateRequest) ProtoMessage() {}
+
+func (x *DeleteTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_notes_v1_messages_proto_msgTypes[93]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTemplateRequest.ProtoReflect.Descriptor instead.
+func (*DeleteTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_api_notes_v1_messages_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *DeleteTemplateRequest) GetId() *types.ID {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+// DeleteTemplateResponse is the response message after deleting a template.
+type DeleteTemplateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTemplateResponse) Reset() {
+	*x = DeleteTemplateResponse{}
+	mi := &file_api_notes_v1_messages_proto_msgTypes[94]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTemplateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTemplateResponse) ProtoMessage() {}
+
+func (x *DeleteTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_notes_v1_messages_proto_msgTypes[94]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTemplateResponse.ProtoReflect.Descriptor instead.
+func (*DeleteTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_api_notes_v1_messages_proto_rawDescGZIP(), []int{94}
+}
+
+// NoteReference represents a wiki link from the content of a note to another note of the same owner.
+type NoteReference struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ID of the note having the link.
+	SourceId *types.ID `protobuf:"bytes,1,opt,name=source_id,json=sourceId,proto3" json:"source_id,omitempty"`
+	// Target of the link as written between the brackets, the ID or the title of a note.
+	Target string `protobuf:"bytes,2,opt,name=target,proto3" json:"target,omitempty"`
+	// ID of the note the link refers to, unset when the link is dangling.
+	TargetId *types.ID `protobuf:"bytes,3,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`
+	// Title of the note the link refers to, empty when the link is dangling.
+	TargetTitle string `protobuf:"bytes,4,opt,name=target_title,json=targetTitle,proto3" json:"target_title,omitempty"`
+	// Whether the link refers to no existing note, the notes in the trash do not count.
+	Dangling      bool `protobuf:"varint,5,opt,name=dangling,proto3" json:"dangling,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NoteReference) Reset() {
+	*x = NoteReference{}
+	mi := &file_api_notes_v1_messages_proto_msgTypes[95]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NoteReference) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NoteReference) ProtoMessage() {}
+
+func (x *NoteReference) ProtoReflect() protoreflect.Message {
+	mi := &file_api_notes_v1_messages_proto_msgTypes[95]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NoteReference.ProtoReflect.Descriptor instead.
+func (*NoteReference) Descriptor() ([]byte, []int) {
+	return file_api_notes_v1_messages_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *NoteReference) GetSourceId() *types.ID {
+	if x != nil {
+		return x.SourceId
+	}
+	return nil
+}
+
+func (x *NoteReference) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+func (x *NoteReference) GetTargetId() *types.ID {
+	if x != nil {
+		return x.TargetId
+	}
+	return nil
+}
+
+func (x *NoteReference) GetTargetTitle() string {
+	if x != nil {
+		return x.TargetTitle
+	}
+	return ""
+}
+
+func (x *NoteReference) GetDangling() bool {
+	if x != nil {
+		return x.Dangling
+	}
+	return false
+}
+
+// ListBacklinksRequest is the request message for listing the notes referring to a note.
+type ListBacklinksRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ID of the note.
+	NoteId        *types.ID `protobuf:"bytes,1,opt,name=note_id,json=noteId,proto3" json:"note_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBacklinksRequest) Reset() {
+	*x = ListBacklinksRequest{}
+	mi := &file_api_notes_v1_messages_proto_msgTypes[96]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBacklinksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBacklinksRequest) ProtoMessage() {}
+
+func (x *ListBacklinksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_notes_v1_messages_proto_msgTypes[96]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBacklinksRequest.ProtoReflect.Descriptor instead.
+func (*ListBacklinksRequest) Descriptor() ([]byte, []int) {
+	return file_api_notes_v1_messages_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *ListBacklinksRequest) GetNoteId() *types.ID {
+	if x != nil {
+		return x.NoteId
+	}
+	return nil
+}
+
+// ListBacklinksResponse is the response message containing the notes referring to a note.
+type ListBacklinksResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Notes referring to the note, most recently updated first.
+	Notes         []*Note `protobuf:"bytes,1,rep,name=notes,proto3" json:"notes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBacklinksResponse) Reset() {
+	*x = ListBacklinksResponse{}
+	mi := &file_api_notes_v1_messages_proto_msgTypes[97]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBacklinksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBacklinksResponse) ProtoMessage() {}
+
+func (x *ListBacklinksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_notes_v1_messages_proto_msgTypes[97]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBacklinksResponse.ProtoReflect.Descriptor instead.
+func (*ListBacklinksResponse) Descriptor() ([]byte, []int) {
+	return file_api_notes_v1_messages_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *ListBacklinksResponse) GetNotes() []*Note {
+	if x != nil {
+		return x.Notes
+	}
+	return nil
+}
+
+// ListOutgoingLinksRequest is the request message for listing the wiki links of a note.
+type ListOutgoingLinksRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ID of the note.
+	NoteId        *types.ID `protobuf:"bytes,1,opt,name=note_id,json=noteId,proto3" json:"note_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListOutgoingLinksRequest) Reset() {
+	*x = ListOutgoingLinksRequest{}
+	mi := &file_api_notes_v1_messages_proto_msgTypes[98]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOutgoingLinksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOutgoingLinksRequest) ProtoMessage() {}
+
+func (x *ListOutgoingLinksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_notes_v1_messages_proto_msgTypes[98]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOutgoingLinksRequest.ProtoReflect.Descriptor instead.
+func (*ListOutgoingLinksRequest) Descriptor() ([]byte, []int) {
+	return file_api_notes_v1_messages_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *ListOutgoingLinksRequest) GetNoteId() *types.ID {
+	if x != nil {
+		return x.NoteId
+	}
+	return nil
+}
+
+// ListOutgoingLinksResponse is the response message containing the wiki links of a note.
+type ListOutgoingLinksResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Links in the order they first appear in the content.
+	Links         []*NoteReference `protobuf:"bytes,1,rep,name=links,proto3" json:"links,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListOutgoingLinksResponse) Reset() {
+	*x = ListOutgoingLinksResponse{}
+	mi := &file_api_notes_v1_messages_proto_msgTypes[99]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOutgoingLinksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOutgoingLinksResponse) ProtoMessage() {}
+
+func (x *ListOutgoingLinksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_notes_v1_messages_proto_msgTypes[99]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOutgoingLinksResponse.ProtoReflect.Descriptor instead.
+func (*ListOutgoingLinksResponse) Descriptor() ([]byte, []int) {
+	return file_api_notes_v1_messages_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *ListOutgoingLinksResponse) GetLinks() []*NoteReference {
+	if x != nil {
+		return x.Links
+	}
+	return nil
+}
+
+// ListDanglingLinksRequest is the request message for listing the dangling wiki links of the user.
+type ListDanglingLinksRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDanglingLinksRequest) Reset() {
+	*x = ListDanglingLinksRequest{}
+	mi := &file_api_notes_v1_messages_proto_msgTypes[100]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDanglingLinksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDanglingLinksRequest) ProtoMessage() {}
+
+func (x *ListDanglingLinksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_notes_v1_messages_proto_msgTypes[100]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDanglingLinksRequest.ProtoReflect.Descriptor instead.
+func (*ListDanglingLinksRequest) Descriptor() ([]byte, []int) {
+	return file_api_notes_v1_messages_proto_rawDescGZIP(), []int{100}
+}
+
+// ListDanglingLinksResponse is the response message containing the dangling wiki links of the user.
+type ListDanglingLinksResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Dangling links, grouped by the most recently updated notes first.
+	Links         []*NoteReference `protobuf:"bytes,1,rep,name=links,proto3" json:"links,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDanglingLinksResponse) Reset() {
+	*x = ListDanglingLinksResponse{}
+	mi := &file_api_notes_v1_messages_proto_msgTypes[101]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDanglingLinksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDanglingLinksResponse) ProtoMessage() {}
+
+func (x *ListDanglingLinksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_notes_v1_messages_proto_msgTypes[101]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDanglingLinksResponse.ProtoReflect.Descriptor instead.
+func (*ListDanglingLinksResponse) Descriptor() ([]byte, []int) {
+	return file_api_notes_v1_messages_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *ListDanglingLinksResponse) GetLinks() []*NoteReference {
+	if x != nil {
+		return x.Links
+	}
+	return nil
+}
+
+// NoteShare represents the access of a collaborator to a note.
+type NoteShare struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ID of the shared note.
+	NoteId *types.ID `protobuf:"bytes,1,opt,name=note_id,json=noteId,proto3" json:"note_id,omitempty"`
+	// Email of the collaborator.
+	Email string `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	// Name of the collaborator.
+	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// Role of the collaborator.
+	Role ShareRole `protobuf:"varint,4,opt,name=role,proto3,enum=api.notes.v1.ShareRole" json:"role,omitempty"`
+	// Timestamp when the note was shared.
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NoteShare) Reset() {
+	*x = NoteShare{}
+	mi := &file_api_notes_v1_messages_proto_msgTypes[102]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NoteShare) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NoteShare) ProtoMessage() {}
+
+func (x *NoteShare) ProtoReflect() protoreflect.Message {
+	mi := &file_api_notes_v1_messages_proto_msgTypes[102]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NoteShare.ProtoReflect.Descriptor instead.
+func (*NoteShare) Descriptor() ([]byte, []int) {
+	return file_api_notes_v1_messages_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *NoteShare) GetNoteId() *types.ID {
+	if x != nil {
+		return x.NoteId
+	}
+	return nil
+}
+
+func (x *NoteShare) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *NoteShare) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *NoteShare) GetRole() ShareRole {
+	if x != nil {
+		return x.Role
+	}
+	return ShareRole_SHARE_ROLE_UNKNOWN
+}
+
+func (x *NoteShare) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// SharedNote represents a note shared with the user by another user.
+type SharedNote struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The shared note.
+	Note *Note `protobuf:"bytes,1,opt,name=note,proto3" json:"note,omitempty"`
+	// Role of the user on the note.
+	Role ShareRole `protobuf:"varint,2,opt,name=role,proto3,enum=api.notes.v1.ShareRole" json:"role,omitempty"`
+	// Timestamp when the note was shared.
+	SharedAt      *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=shared_at,json=sharedAt,proto3" json:"shared_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SharedNote) Reset() {
+	*x = SharedNote{}
+	mi := &file_api_notes_v1_messages_proto_msgTypes[103]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SharedNote) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SharedNote) ProtoMessage() {}
+
+func (x *SharedNote) ProtoReflect() protoreflect.Message {
+	mi := &file_api_notes_v1_messages_proto_msgTypes[103]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SharedNote.ProtoReflect.Descriptor instead.
+func (*SharedNote) Descriptor() ([]byte, []int) {
+	return file_api_notes_v1_messages_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *SharedNote) GetNote() *Note {
+	if x != nil {
+		return x.Note
+	}
+	return nil
+}
+
+func (x *SharedNote) GetRole() ShareRole {
+	if x != nil {
+		return x.Role
+	}
+	return ShareRole_SHARE_ROLE_UNKNOWN
+}
+
+func (x *SharedNote) GetSharedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SharedAt
+	}
+	return nil
+}
+
+// ShareNoteRequest is the request message for sharing a note with another user.
+type ShareNoteRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ID of the note to share.
+	NoteId *types.ID `protobuf:"bytes,1,opt,name=note_id,json=noteId,proto3" json:"note_id,omitempty"`
+	// Email of the collaborator.
+	Email string `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	// Role to grant, sharing again with another role changes it.
+	Role          ShareRole `protobuf:"varint,3,opt,name=role,proto3,enum=api.notes.v1.ShareRole" json:"role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShareNoteRequest) Reset() {
+	*x = ShareNoteRequest{}
+	mi := &file_api_notes_v1_messages_proto_msgTypes[104]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShareNoteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShareNoteRequest) ProtoMessage() {}
+
+func (x *ShareNoteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_notes_v1_messages_proto_msgTypes[104]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShareNoteRequest.ProtoReflect.Descriptor instead.
+func (*ShareNoteRequest) Descriptor() ([]byte, []int) {
+	return file_api_notes_v1_messages_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *ShareNoteRequest) GetNoteId() *types.ID {
+	if x != nil {
+		return x.NoteId
+	}
+	return nil
+}
+
+func (x *ShareNoteRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *ShareNoteRequest) GetRole() ShareRole {
+	if x != nil {
+		return x.Role
+	}
+	return ShareRole_SHARE_ROLE_UNKNOWN
+}
+
+// ShareNoteResponse is the response message after sharing a note.
+type ShareNoteResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The created or updated share.
+	Share         *NoteShare `protobuf:"bytes,1,opt,name=share,proto3" json:"share,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShareNoteResponse) Reset() {
+	*x = ShareNoteResponse{}
+	mi := &file_api_notes_v1_messages_proto_msgTypes[105]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShareNoteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShareNoteResponse) ProtoMessage() {}
+
+func (x *ShareNoteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_notes_v1_messages_proto_msgTypes[105]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShareNoteResponse.ProtoReflect.Descriptor instead.
+func (*ShareNoteResponse) Descriptor() ([]byte, []int) {
+	return file_api_notes_v1_messages_proto_rawDescGZIP(), []int{105}
+}
+
+func (x *ShareNoteResponse) GetShare() *NoteShare {
+	if x != nil {
+		return x.Share
+	}
+	return nil
+}
+
+// UnshareNoteRequest is the request message for revoking access to a note from another user.
+type UnshareNoteRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ID of the shared note.
+	NoteId *types.ID `protobuf:"bytes,1,opt,name=note_id,json=noteId,proto3" json:"note_id,omitempty"`
+	// Email of the collaborator.
+	Email         string `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnshareNoteRequest) Reset() {
+	*x = UnshareNoteRequest{}
+	mi := &file_api_notes_v1_messages_proto_msgTypes[106]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnshareNoteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnshareNoteRequest) ProtoMessage() {}
+
+func (x *UnshareNoteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_notes_v1_messages_proto_msgTypes[106]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnshareNoteRequest.ProtoReflect.Descriptor instead.
+func (*UnshareNoteRequest) Descriptor() ([]byte, []int) {
+	return file_api_notes_v1_messages_proto_rawDescGZIP(), []int{106}
+}
+
+func (x *UnshareNoteRequest) GetNoteId() *types.ID {
+	if x != nil {
+		return x.NoteId
+	}
+	return nil
+}
+
+func (x *UnshareNoteRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+// UnshareNoteResponse is the response message after revoking access to a note.
+type UnshareNoteResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnshareNoteResponse) Reset() {
+	*x = UnshareNoteResponse{}
+	mi := &file_api_notes_v1_messages_proto_msgTypes[107]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnshareNoteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnshareNoteResponse) ProtoMessage() {}
+
+func (x *UnshareNoteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_notes_v1_messages_proto_msgTypes[107]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnshareNoteResponse.ProtoReflect.Descriptor instead.
+func (*UnshareNoteResponse) Descriptor() ([]byte, []int) {
+	return file_api_notes_v1_messages_proto_rawDescGZIP(), []int{107}
+}
+
+// ListNoteSharesRequest is the request message for listing collaborators of a note.
+type ListNoteSharesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ID of the note.
+	NoteId        *types.ID `protobuf:"bytes,1,opt,name=note_id,json=noteId,proto3" json:"note_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListNoteSharesRequest) Reset() {
+	*x = ListNoteSharesRequest{}
+	mi := &file_api_notes_v1_messages_proto_msgTypes[108]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListNoteSharesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListNoteSharesRequest) ProtoMessage() {}
+
+func (x *ListNoteSharesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_notes_v1_messages_proto_msgTypes[108]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListNoteSharesRequest.ProtoReflect.Descriptor instead.
+func (*ListNoteSharesRequest) Descriptor() ([]byte, []int) {
+	return file_api_notes_v1_messages_proto_rawDescGZIP(), []int{108}
+}
+
+func (x *ListNoteSharesRequest) GetNoteId() *types.ID {
+	if x != nil {
+		return x.NoteId
+	}
+	return nil
+}
+
+// ListNoteSharesResponse is the response message containing collaborators of a note.
+type ListNoteSharesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// List of shares, oldest first.
+	Shares        []*NoteShare `protobuf:"bytes,1,rep,name=shares,proto3" json:"shares,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListNoteSharesResponse) Reset() {
+	*x = ListNoteSharesResponse{}
+	mi := &file_api_notes_v1_messages_proto_msgTypes[109]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListNoteSharesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListNoteSharesResponse) ProtoMessage() {}
+
+func (x *ListNoteSharesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_notes_v1_messages_proto_msgTypes[109]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListNoteSharesResponse.ProtoReflect.Descriptor instead.
+func (*ListNoteSharesResponse) Descriptor() ([]byte, []int) {
+	return file_api_notes_v1_messages_proto_rawDescGZIP(), []int{109}
+}
+
+func (x *ListNoteSharesResponse) GetShares() []*NoteShare {
+	if x != nil {
+		return x.Shares
+	}
+	return nil
+}
+
+// ListSharedNotesRequest is the request message for listing notes shared with the user.
+type ListSharedNotesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Maximum number of notes to return, the server uses 50 when unset.
+	PageSize int32 `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// Page token received as `next_page_token` from a previous call.
+	PageToken     string `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSharedNotesRequest) Reset() {
+	*x = ListSharedNotesRequest{}
+	mi := &file_api_notes_v1_messages_proto_msgTypes[110]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSharedNotesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSharedNotesRequest) ProtoMessage() {}
+
+func (x *ListSharedNotesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_notes_v1_messages_proto_msgTypes[110]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSharedNotesRequest.ProtoReflect.Descriptor instead.
+func (*ListSharedNotesRequest) Descriptor() ([]byte, []int) {
+	return file_api_notes_v1_messages_proto_rawDescGZIP(), []int{110}
+}
+
+func (x *ListSharedNotesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListSharedNotesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+// ListSharedNotesResponse is the response message containing notes shared with the user, most recently shared first.
+type ListSharedNotesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// List of shared notes.
+	Notes []*SharedNote `protobuf:"bytes,1,rep,name=notes,proto3" json:"notes,omitempty"`
+	// Token to retrieve the next page, empty when there are no more notes.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	// Total number of notes shared with the user, regardless of pagination.
+	TotalSize     int32 `protobuf:"varint,3,opt,name=total_size,json=totalSize,proto3" json:"total_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSharedNotesResponse) Reset() {
+	*x = ListSharedNotesResponse{}
+	mi := &file_api_notes_v1_messages_proto_msgTypes[111]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSharedNotesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSharedNotesResponse) ProtoMessage() {}
+
+func (x *ListSharedNotesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_notes_v1_messages_proto_msgTypes[111]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSharedNotesResponse.ProtoReflect.Descriptor instead.
+func (*ListSharedNotesResponse) Descriptor() ([]byte, []int) {
+	return file_api_notes_v1_messages_proto_rawDescGZIP(), []int{111}
+}
+
+func (x *ListSharedNotesResponse) GetNotes() []*SharedNote {
+	if x != nil {
+		return x.Notes
+	}
+	return nil
+}
+
+func (x *ListSharedNotesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListSharedNotesResponse) GetTotalSize() int32 {
+	if x != nil {
+		return x.TotalSize
+	}
+	return 0
+}
+
+// ShareLink represents a public read-only link to a note.
+type ShareLink struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Unguessable token of the link, anyone knowing it can read the note.
+	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	// ID of the shared note.
+	NoteId *types.ID `protobuf:"bytes,2,opt,name=note_id,json=noteId,proto3" json:"note_id,omitempty"`
+	// Number of times the note was opened by the link.
+	Views int64 `protobuf:"varint,3,opt,name=views,proto3" json:"views,omitempty"`
+	// Timestamp when the link stops working, unset for links that never expire.
+	ExpiresAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	// Timestamp when the link was created.
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShareLink) Reset() {
+	*x = ShareLink{}
+	mi := &file_api_notes_v1_messages_proto_msgTypes[112]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShareLink) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShareLink) ProtoMessage() {}
+
+func (x *ShareLink) ProtoReflect() protoreflect.Message {
+	mi := &file_api_notes_v1_messages_proto_msgTypes[112]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShareLink.ProtoReflect.Descriptor instead.
+func (*ShareLink) Descriptor() ([]byte, []int) {
+	return file_api_notes_v1_messages_proto_rawDescGZIP(), []int{112}
+}
+
+func (x *ShareLink) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *ShareLink) GetNoteId() *types.ID {
+	if x != nil {
+		return x.NoteId
+	}
+	return nil
+}
+
+func (x *ShareLink) GetViews() int64 {
+	if x != nil {
+		return x.Views
+	}
+	return 0
+}
+
+func (x *ShareLink) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *ShareLink) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// CreateShareLinkRequest is the request message for creating a public link to a note.
+type CreateShareLinkRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ID of the note to share.
+	NoteId *types.ID `protobuf:"bytes,1,opt,name=note_id,json=noteId,proto3" json:"note_id,omitempty"`
+	// Lifetime of the link, the link never expires when unset.
+	Ttl           *durationpb.Duration `protobuf:"bytes,2,opt,name=ttl,proto3" json:"ttl,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateShareLinkRequest) Reset() {
+	*x = CreateShareLinkRequest{}
+	mi := &file_api_notes_v1_messages_proto_msgTypes[113]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateShareLinkRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateShareLinkRequest) ProtoMessage() {}
+
+func (x *CreateShareLinkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_notes_v1_messages_proto_msgTypes[113]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateShareLinkRequest.ProtoReflect.Descriptor instead.
+func (*CreateShareLinkRequest) Descriptor() ([]byte, []int) {
+	return file_api_notes_v1_messages_proto_rawDescGZIP(), []int{113}
+}
+
+func (x *CreateShareLinkRequest) GetNoteId() *types.ID {
+	if x != nil {
+		return x.NoteId
+	}
+	return nil
+}
+
+func (x *CreateShareLinkRequest) GetTtl() *durationpb.Duration {
+	if x != nil {
+		return x.Ttl
+	}
+	return nil
+}
+
+// CreateShareLinkResponse is the response message after creating a public link.
+type CreateShareLinkResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The created link.
+	Link          *ShareLink `protobuf:"bytes,1,opt,name=link,proto3" json:"link,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateShareLinkResponse) Reset() {
+	*x = CreateShareLinkResponse{}
+	mi := &file_api_notes_v1_messages_proto_msgTypes[114]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateShareLinkResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateShareLinkResponse) ProtoMessage() {}
+
+func (x *CreateShareLinkResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_notes_v1_messages_proto_msgTypes[114]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateShareLinkResponse.ProtoReflect.Descriptor instead.
+func (*CreateShareLinkResponse) Descriptor() ([]byte, []int) {
+	return file_api_notes_v1_messages_proto_rawDescGZIP(), []int{114}
+}
+
+func (x *CreateShareLinkResponse) GetLink() *ShareLink {
+	if x != nil {
+		return x.Link
+	}
+	return nil
+}
+
+// RevokeShareLinkRequest is the request message for revoking a public link to a note.
+type RevokeShareLinkRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ID of the shared note.
+	NoteId *types.ID `protobuf:"bytes,1,opt,name=note_id,json=noteId,proto3" json:"note_id,omitempty"`
+	// Token of the link to revoke.
+	Token         string `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeShareLinkRequest) Reset() {
+	*x = RevokeShareLinkRequest{}
+	mi := &file_api_notes_v1_messages_proto_msgTypes[115]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeShareLinkRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeShareLinkRequest) ProtoMessage() {}
+
+func (x *RevokeShareLinkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_notes_v1_messages_proto_msgTypes[115]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeShareLinkRequest.ProtoReflect.Descriptor instead.
+func (*RevokeShareLinkRequest) Descriptor() ([]byte, []int) {
+	return file_api_notes_v1_messages_proto_rawDescGZIP(), []int{115}
+}
+
+func (x *RevokeShareLinkRequest) GetNoteId() *types.ID {
+	if x != nil {
+		return x.NoteId
+	}
+	return nil
+}
+
+func (x *RevokeShareLinkRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+// RevokeShareLinkResponse is the response message after revoking a public link.
+type RevokeShareLinkResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeShareLinkResponse) Reset() {
+	*x = RevokeShareLinkResponse{}
+	mi := &file_api_notes_v1_messages_proto_msgTypes[116]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeShareLinkResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeShareLinkResponse) ProtoMessage() {}
+
+func (x *RevokeShareLinkResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_notes_v1_messages_proto_msgTypes[116]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeShareLinkResponse.ProtoReflect.Descriptor instead.
+func (*RevokeShareLinkResponse) Descriptor() ([]byte, []int) {
+	return file_api_notes_v1_messages_proto_rawDescGZIP(), []int{116}
+}
+
+// ListShareLinksRequest is the request message for listing public links to a note.
+type ListShareLinksRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ID of the note.
+	NoteId        *types.ID `protobuf:"bytes,1,opt,name=note_id,json=noteId,proto3" json:"note_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListShareLinksRequest) Reset() {
+	*x = ListShareLinksRequest{}
+	mi := &file_api_notes_v1_messages_proto_msgTypes[117]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListShareLinksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListShareLinksRequest) ProtoMessage() {}
+
+func (x *ListShareLinksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_notes_v1_messages_proto_msgTypes[117]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListShareLinksRequest.ProtoReflect.Descriptor instead.
+func (*ListShareLinksRequest) Descriptor() ([]byte, []int) {
+	return file_api_notes_v1_messages_proto_rawDescGZIP(), []int{117}
+}
+
+func (x *ListShareLinksRequest) GetNoteId() *types.ID {
+	if x != nil {
+		return x.NoteId
+	}
+	return nil
+}
+
+// ListShareLinksResponse is the response message containing public links to a note.
+type ListShareLinksResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// List of links, newest first.
+	Links         []*ShareLink `protobuf:"bytes,1,rep,name=links,proto3" json:"links,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListShareLinksResponse) Reset() {
+	*x = ListShareLinksResponse{}
+	mi := &file_api_notes_v1_messages_proto_msgTypes[118]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListShareLinksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListShareLinksResponse) ProtoMessage() {}
+
+func (x *ListShareLinksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_notes_v1_messages_proto_msgTypes[118]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListShareLinksResponse.ProtoReflect.Descriptor instead.
+func (*ListShareLinksResponse) Descriptor() ([]byte, []int) {
+	return file_api_notes_v1_messages_proto_rawDescGZIP(), []int{118}
+}
+
+func (x *ListShareLinksResponse) GetLinks() []*ShareLink {
+	if x != nil {
+		return x.Links
+	}
+	return nil
+}
+
+// Reminder reminds the user about a note at a time, once or by a recurrence rule.
+type Reminder struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Unique identifier of the reminder.
+	Id *types.ID `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// ID of the note to remind about.
+	NoteId *types.ID `protobuf:"bytes,2,opt,name=note_id,json=noteId,proto3" json:"note_id,omitempty"`
+	// Timestamp when the reminder fires next, unset once the reminder has nothing more to fire.
+	FireAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=fire_at,json=fireAt,proto3" json:"fire_at,omitempty"`
+	// Timestamp when the reminder fired last, unset until it fires.
+	FiredAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=fired_at,json=firedAt,proto3" json:"fired_at,omitempty"`
+	// Recurrence rule of the reminder, empty for reminders firing once.
+	Recurrence string `protobuf:"bytes,5,opt,name=recurrence,proto3" json:"recurrence,omitempty"`
+	// IANA time zone the recurrence keeps the wall clock time in.
+	TimeZone string `protobuf:"bytes,6,opt,name=time_zone,json=timeZone,proto3" json:"time_zone,omitempty"`
+	// Number of occurrences passed, the missed ones included.
+	Occurrences int32 `protobuf:"varint,7,opt,name=occurrences,proto3" json:"occurrences,omitempty"`
+	// Timestamp when the reminder was created.
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// Timestamp when the reminder was last rescheduled or fired.
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Reminder) Reset() {
+	*x = Reminder{}
+	mi := &file_api_notes_v1_messages_proto_msgTypes[119]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Reminder) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Reminder) ProtoMessage() {}
+
+func (x *Reminder) ProtoReflect() protoreflect.Message {
+	mi := &file_api_notes_v1_messages_proto_msgTypes[119]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Reminder.ProtoReflect.Descriptor instead.
+func (*Reminder) Descriptor() ([]byte, []int) {
+	return file_api_notes_v1_messages_proto_rawDescGZIP(), []int{119}
+}
+
+func (x *Reminder) GetId() *types.ID {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+func (x *Reminder) GetNoteId() *types.ID {
+	if x != nil {
+		return x.NoteId
+	}
+	return nil
+}
+
+func (x *Reminder) GetFireAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FireAt
+	}
+	return nil
+}
+
+func (x *Reminder) GetFiredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FiredAt
+	}
+	return nil
+}
+
+func (x *Reminder) GetRecurrence() string {
+	if x != nil {
+		return x.Recurrence
+	}
+	return ""
+}
+
+func (x *Reminder) GetTimeZone() string {
+	if x != nil {
+		return x.TimeZone
+	}
+	return ""
+}
+
+func (x *Reminder) GetOccurrences() int32 {
+	if x != nil {
+		return x.Occurrences
+	}
+	return 0
+}
+
+func (x *Reminder) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Reminder) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// CreateReminderRequest is the request message for creating a reminder about a note.
+type CreateReminderRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ID of the note to remind about.
+	NoteId *types.ID `protobuf:"bytes,1,opt,name=note_id,json=noteId,proto3" json:"note_id,omitempty"`
+	// Timestamp when the reminder fires, the first occurrence of recurring reminders, at most a day in the past.
+	FireAt *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=fire_at,json=fireAt,proto3" json:"fire_at,omitempty"`
+	// Recurrence rule in the RFC 5545 RRULE syntax, e.g. `FREQ=WEEKLY;BYDAY=MO,WE;COUNT=10`.
+	// Supported parts are FREQ (HOURLY, DAILY, WEEKLY, MONTHLY, YEARLY), INTERVAL, COUNT, UNTIL and BYDAY
+	// without ordinals. The reminder fires once when empty.
+	Recurrence string `protobuf:"bytes,3,opt,name=recurrence,proto3" json:"recurrence,omitempty"`
+	// IANA time zone the recurrence keeps the wall clock time in, e.g. `Europe/Berlin`. Defaults to UTC.
+	TimeZone      string `protobuf:"bytes,4,opt,name=time_zone,json=timeZone,proto3" json:"time_zone,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateReminderRequest) Reset() {
+	*x = CreateReminderRequest{}
+	mi := &file_api_notes_v1_messages_proto_msgTypes[120]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateReminderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateReminderRequest) ProtoMessage() {}
+
+func (x *CreateReminderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_notes_v1_messages_proto_msgTypes[120]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateReminderRequest.ProtoReflect.Descriptor instead.
+func (*CreateReminderRequest) Descriptor() ([]byte, []int) {
+	return file_api_notes_v1_messages_proto_rawDescGZIP(), []int{120}
+}
+
+func (x *CreateReminderRequest) GetNoteId() *types.ID {
+	if x != nil {
+		return x.NoteId
+	}
+	return nil
+}
+
+func (x *CreateReminderRequest) GetFireAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FireAt
+	}
+	return nil
+}
+
+func (x *CreateReminderRequest) GetRecurrence() string {
+	if x != nil {
+		return x.Recurrence
+	}
+	return ""
+}
+
+func (x *CreateReminderRequest) GetTimeZone() string {
+	if x != nil {
+		return x.TimeZone
+	}
+	return ""
+}
+
+// CreateReminderResponse is the response message after creating a reminder.
+type CreateReminderResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The created reminder.
+	Reminder      *Reminder `protobuf:"bytes,1,opt,name=reminder,proto3" json:"reminder,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateReminderResponse) Reset() {
+	*x = CreateReminderResponse{}
+	mi := &file_api_notes_v1_messages_proto_msgTypes[121]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateReminderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateReminderResponse) ProtoMessage() {}
+
+func (x *CreateReminderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_notes_v1_messages_proto_msgTypes[121]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateReminderResponse.ProtoReflect.Descriptor instead.
+func (*CreateReminderResponse) Descriptor() ([]byte, []int) {
+	return file_api_notes_v1_messages_proto_rawDescGZIP(), []int{121}
+}
+
+func (x *CreateReminderResponse) GetReminder() *Reminder {
+	if x != nil {
+		return x.Reminder
+	}
+	return nil
+}
+
+// ListRemindersRequest is the request message for listing reminders about a note.
+type ListRemindersRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ID of the note.
+	NoteId        *types.ID `protobuf:"bytes,1,opt,name=note_id,json=noteId,proto3" json:"note_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRemindersRequest) Reset() {
+	*x = ListRemindersRequest{}
+	mi := &file_api_notes_v1_messages_proto_msgTypes[122]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRemindersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRemindersRequest) ProtoMessage() {}
+
+func (x *ListRemindersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_notes_v1_messages_proto_msgTypes[122]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRemindersRequest.ProtoReflect.Descriptor instead.
+func (*ListRemindersRequest) Descriptor() ([]byte, []int) {
+	return file_api_notes_v1_messages_proto_rawDescGZIP(), []int{122}
+}
+
+func (x *ListRemindersRequest) GetNoteId() *types.ID {
+	if x != nil {
+		return x.NoteId
+	}
+	return nil
+}
+
+// ListRemindersResponse is the response message containing reminders about a note.
+type ListRemindersResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// List of reminders, the next to fire first and the finished ones last.
+	Reminders     []*Reminder `protobuf:"bytes,1,rep,name=reminders,proto3" json:"reminders,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRemindersResponse) Reset() {
+	*x = ListRemindersResponse{}
+	mi := &file_api_notes_v1_messages_proto_msgTypes[123]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRemindersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRemindersResponse) ProtoMessage() {}
+
+func (x *ListRemindersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_notes_v1_messages_proto_msgTypes[123]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRemindersResponse.ProtoReflect.Descriptor instead.
+func (*ListRemindersResponse) Descriptor() ([]byte, []int) {
+	return file_api_notes_v1_messages_proto_rawDescGZIP(), []int{123}
+}
+
+func (x *ListRemindersResponse) GetReminders() []*Reminder {
+	if x != nil {
+		return x.Reminders
+	}
+	return nil
+}
+
+// UpdateReminderRequest is the request message for rescheduling a reminder.
+type UpdateReminderRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ID of the note.
+	NoteId *types.ID `protobuf:"bytes,1,opt,name=note_id,json=noteId,proto3" json:"note_id,omitempty"`
+	// ID of the reminder to update.
+	Id *types.ID `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	// New time of the reminder, applied when `fire_at` is present in the update mask.
+	FireAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=fire_at,json=fireAt,proto3" json:"fire_at,omitempty"`
+	// New recurrence rule, applied when `recurrence` is present in the update mask, empty makes the reminder fire once.
+	Recurrence string `protobuf:"bytes,4,opt,name=recurrence,proto3" json:"recurrence,omitempty"`
+	// New time zone, applied when `time_zone` is present in the update mask.
+	TimeZone string `protobuf:"bytes,5,opt,name=time_zone,json=timeZone,proto3" json:"time_zone,omitempty"`
+	// Fields of the reminder to update, allowed paths are `fire_at`, `recurrence` and `time_zone`.
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,6,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateReminderRequest) Reset() {
+	*x = UpdateReminderRequest{}
+	mi := &file_api_notes_v1_messages_proto_msgTypes[124]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateReminderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateReminderRequest) ProtoMessage() {}
+
+func (x *UpdateReminderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_notes_v1_messages_proto_msgTypes[124]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateReminderRequest.ProtoReflect.Descriptor instead.
+func (*UpdateReminderRequest) Descriptor() ([]byte, []int) {
+	return file_api_notes_v1_messages_proto_rawDescGZIP(), []int{124}
+}
+
+func (x *UpdateReminderRequest) GetNoteId() *types.ID {
+	if x != nil {
+		return x.NoteId
+	}
+	return nil
+}
+
+func (x *UpdateReminderRequest) GetId() *types.ID {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+func (x *UpdateReminderRequest) GetFireAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FireAt
+	}
+	return nil
+}
+
+func (x *UpdateReminderRequest) GetRecurrence() string {
+	if x != nil {
+		return x.Recurrence
+	}
+	return ""
+}
+
+func (x *UpdateReminderRequest) GetTimeZone() string {
+	if x != nil {
+		return x.TimeZone
+	}
+	return ""
+}
+
+func (x *UpdateReminderRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+// UpdateReminderResponse is the response message after rescheduling a reminder.
+type UpdateReminderResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The rescheduled reminder.
+	Reminder      *Reminder `protobuf:"bytes,1,opt,name=reminder,proto3" json:"reminder,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateReminderResponse) Reset() {
+	*x = UpdateReminderResponse{}
+	mi := &file_api_notes_v1_messages_proto_msgTypes[125]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateReminderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateReminderResponse) ProtoMessage() {}
+
+func (x *UpdateReminderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_notes_v1_messages_proto_msgTypes[125]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateReminderResponse.ProtoReflect.Descriptor instead.
+func (*UpdateReminderResponse) Descriptor() ([]byte, []int) {
+	return file_api_notes_v1_messages_proto_rawDescGZIP(), []int{125}
+}
+
+func (x *UpdateReminderResponse) GetReminder() *Reminder {
+	if x != nil {
+		return x.Reminder
+	}
+	return nil
+}
+
+// DeleteReminderRequest is the request message for deleting a reminder.
+type DeleteReminderRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ID of the note.
+	NoteId *types.ID `protobuf:"bytes,1,opt,name=note_id,json=noteId,proto3" json:"note_id,omitempty"`
+	// ID of the reminder to delete.
+	Id            *types.ID `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteReminderRequest) Reset() {
+	*x = DeleteReminderRequest{}
+	mi := &file_api_notes_v1_messages_proto_msgTypes[126]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteReminderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteReminderRequest) ProtoMessage() {}
+
+func (x *DeleteReminderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_notes_v1_messages_proto_msgTypes[126]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteReminderRequest.ProtoReflect.Descriptor instead.
+func (*DeleteReminderRequest) Descriptor() ([]byte, []int) {
+	return file_api_notes_v1_messages_proto_rawDescGZIP(), []int{126}
+}
+
+func (x *DeleteReminderRequest) GetNoteId() *types.ID {
+	if x != nil {
+		return x.NoteId
+	}
+	return nil
+}
+
+func (x *DeleteReminderRequest) GetId() *types.ID {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+// DeleteReminderResponse is the response message after deleting a reminder.
+type DeleteReminderResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteReminderResponse) Reset() {
+	*x = DeleteReminderResponse{}
+	mi := &file_api_notes_v1_messages_proto_msgTypes[127]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteReminderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteReminderResponse) ProtoMessage() {}
+
+func (x *DeleteReminderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_notes_v1_messages_proto_msgTypes[127]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteReminderResponse.ProtoReflect.Descriptor instead.
+func (*DeleteReminderResponse) Descriptor() ([]byte, []int) {
+	return file_api_notes_v1_messages_proto_rawDescGZIP(), []int{127}
+}
+
+// GetPublicNoteRequest is the request message for reading a note by a public link.
+type GetPublicNoteRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Token of the link.
+	Token         string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPublicNoteRequest) Reset() {
+	*x = GetPublicNoteRequest{}
+	mi := &file_api_notes_v1_messages_proto_msgTypes[128]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPublicNoteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPublicNoteRequest) ProtoMessage() {}
+
+func (x *GetPublicNoteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_notes_v1_messages_proto_msgTypes[128]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPublicNoteRequest.ProtoReflect.Descriptor instead.
+func (*GetPublicNoteRequest) Descriptor() ([]byte, []int) {
+	return file_api_notes_v1_messages_proto_rawDescGZIP(), []int{128}
+}
+
+func (x *GetPublicNoteRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+// GetPublicNoteResponse is the response message containing the note behind a public link.
+type GetPublicNoteResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The shared note.
+	Note *Note `protobuf:"bytes,1,opt,name=note,proto3" json:"note,omitempty"`
+	// Number of times the note was opened by the link, including this time.
+	Views         int64 `protobuf:"varint,2,opt,name=views,proto3" json:"views,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPublicNoteResponse) Reset() {
+	*x = GetPublicNoteResponse{}
+	mi := &file_api_notes_v1_messages_proto_msgTypes[129]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPublicNoteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPublicNoteResponse) ProtoMessage() {}
+
+func (x *GetPublicNoteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_notes_v1_messages_proto_msgTypes[129]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPublicNoteResponse.ProtoReflect.Descriptor instead.
+func (*GetPublicNoteResponse) Descriptor() ([]byte, []int) {
+	return file_api_notes_v1_messages_proto_rawDescGZIP(), []int{129}
+}
+
+func (x *GetPublicNoteResponse) GetNote() *Note {
+	if x != nil {
+		return x.Note
+	}
+	return nil
+}
+
+func (x *GetPublicNoteResponse) GetViews() int64 {
+	if x != nil {
+		return x.Views
+	}
+	return 0
+}
+
+// RenderPublicNoteRequest is the request message for rendering a note by a public link as a HTML page.
+type RenderPublicNoteRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Token of the link.
+	Token         string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RenderPublicNoteRequest) Reset() {
+	*x = RenderPublicNoteRequest{}
+	mi := &file_api_notes_v1_messages_proto_msgTypes[130]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RenderPublicNoteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenderPublicNoteRequest) ProtoMessage() {}
+
+func (x *RenderPublicNoteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_notes_v1_messages_proto_msgTypes[130]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenderPublicNoteRequest.ProtoReflect.Descriptor instead.
+func (*RenderPublicNoteRequest) Descriptor() ([]byte, []int) {
+	return file_api_notes_v1_messages_proto_rawDescGZIP(), []int{130}
+}
+
+func (x *RenderPublicNoteRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+// Event represents a system notification about a change in notes.
+type Event struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Unique identifier of the event.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Type of the event.
+	Type EventType `protobuf:"varint,2,opt,name=type,proto3,enum=api.notes.v1.EventType" json:"type,omitempty"`
+	// ID of the note associated with this event.
+	NoteId *types.ID `protobuf:"bytes,3,opt,name=note_id,json=noteId,proto3" json:"note_id,omitempty"`
+	// Timestamp when the event occurred.
+	EventTime *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=event_time,json=eventTime,proto3" json:"event_time,omitempty"`
+	// Position of the event in the stream, pass it as resume_from to continue after the event.
+	Position string `protobuf:"bytes,5,opt,name=position,proto3" json:"position,omitempty"`
+	// Timestamp when the event was marked as read, unset while the event is unread.
+	ReadAt        *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=read_at,json=readAt,proto3" json:"read_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Event) Reset() {
+	*x = Event{}
+	mi := &file_api_notes_v1_messages_proto_msgTypes[131]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Event) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Event) ProtoMessage() {}
+
+func (x *Event) ProtoReflect() protoreflect.Message {
+	mi := &file_api_notes_v1_messages_proto_msgTypes[131]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Event.ProtoReflect.Descriptor instead.
+func (*Event) Descriptor() ([]byte, []int) {
+	return file_api_notes_v1_messages_proto_rawDescGZIP(), []int{131}
+}
+
+func (x *Event) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Event) GetType() EventType {
+	if x != nil {
+		return x.Type
+	}
+	return EventType_EVENT_TYPE_UNKNOWN
+}
+
+func (x *Event) GetNoteId() *types.ID {
+	if x != nil {
+		return x.NoteId
+	}
+	return nil
+}
+
+func (x *Event) GetEventTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EventTime
+	}
+	return nil
+}
+
+func (x *Event) GetPosition() string {
+	if x != nil {
+		return x.Position
+	}
+	return ""
+}
+
+func (x *Event) GetReadAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ReadAt
+	}
+	return nil
+}
+
+// EventFilter narrows down events, an event must match every set condition.
+type EventFilter struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Only events of these types are kept.
+	Types []EventType `protobuf:"varint,1,rep,packed,name=types,proto3,enum=api.notes.v1.EventType" json:"types,omitempty"`
+	// Only events of these notes are kept.
+	NoteIds []*types.ID `protobuf:"bytes,2,rep,name=note_ids,json=noteIds,proto3" json:"note_ids,omitempty"`
+	// Only events of notes tagged with at least one of these tags are kept.
+	Tags          []string `protobuf:"bytes,3,rep,name=tags,proto3" json:"tags,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EventFilter) Reset() {
+	*x = EventFilter{}
+	mi := &file_api_notes_v1_messages_proto_msgTypes[132]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EventFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventFilter) ProtoMessage() {}
+
+func (x *EventFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_api_notes_v1_messages_proto_msgTypes[132]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventFilter.ProtoReflect.Descriptor instead.
+func (*EventFilter) Descriptor() ([]byte, []int) {
+	return file_api_notes_v1_messages_proto_rawDescGZIP(), []int{132}
+}
+
+func (x *EventFilter) GetTypes() []EventType {
+	if x != nil {
+		return x.Types
+	}
+	return nil
+}
+
+func (x *EventFilter) GetNoteIds() []*types.ID {
+	if x != nil {
+		return x.NoteIds
+	}
+	return nil
 }
 
-func (x *RetrieveNoteResponse) GetNote() *Note {
+func (x *EventFilter) GetTags() []string {
 	if x != nil {
-		return x.Note
+		return x.Tags
 	}
 	return nil
 }
 
-// CreateNoteRequest is the request message for creating a new note.
-type CreateNoteRequest struct {
+// Unread represents information about the number of unread events.
+type Unread struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Title of the new note.
-	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
-	// Content/body of the new note.
-	Content       string `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	// Total count of unread events.
+	Events        int32 `protobuf:"varint,1,opt,name=events,proto3" json:"events,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateNoteRequest) Reset() {
-	*x = CreateNoteRequest{}
-	mi := &file_api_notes_v1_messages_proto_msgTypes[5]
+func (x *Unread) Reset() {
+	*x = Unread{}
+	mi := &file_api_notes_v1_messages_proto_msgTypes[133]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateNoteRequest) String() string {
+func (x *Unread) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateNoteRequest) ProtoMessage() {}
+func (*Unread) ProtoMessage() {}
 
-func (x *CreateNoteRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_notes_v1_messages_proto_msgTypes[5]
+func (x *Unread) ProtoReflect() protoreflect.Message {
+	mi := &file_api_notes_v1_messages_proto_msgTypes[133]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -370,49 +8336,50 @@ func (x *CreateNoteRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateNoteRequest.ProtoReflect.Descriptor instead.
-func (*CreateNoteRequest) Descriptor() ([]byte, []int) {
-	return file_api_notes_v1_messages_proto_rawDescGZIP(), []int{5}
-}
-
-func (x *CreateNoteRequest) GetTitle() string {
-	if x != nil {
-		return x.Title
-	}
-	return ""
+// Deprecated: Use Unread.ProtoReflect.Descriptor instead.
+func (*Unread) Descriptor() ([]byte, []int) {
+	return file_api_notes_v1_messages_proto_rawDescGZIP(), []int{133}
 }
 
-func (x *CreateNoteRequest) GetContent() string {
+func (x *Unread) GetEvents() int32 {
 	if x != nil {
-		return x.Content
+		return x.Events
 	}
-	return ""
+	return 0
 }
 
-// CreateNoteResponse is the response message after creating a note.
-type CreateNoteResponse struct {
+// ListEventsRequest is the request message for listing the history of events.
+type ListEventsRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The created note, including its generated ID and timestamps.
-	Note          *Note `protobuf:"bytes,1,opt,name=note,proto3" json:"note,omitempty"`
+	// Maximum number of events to return, the server uses 50 when unset.
+	PageSize int32 `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// Page token received as `next_page_token` from a previous call.
+	//
+	// All other request parameters must match the call that provided the token.
+	PageToken string `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// Only events matching the filter are returned.
+	Filter *EventFilter `protobuf:"bytes,3,opt,name=filter,proto3" json:"filter,omitempty"`
+	// Only events that are not marked as read are returned.
+	UnreadOnly    bool `protobuf:"varint,4,opt,name=unread_only,json=unreadOnly,proto3" json:"unread_only,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateNoteResponse) Reset() {
-	*x = CreateNoteResponse{}
-	mi := &file_api_notes_v1_messages_proto_msgTypes[6]
+func (x *ListEventsRequest) Reset() {
+	*x = ListEventsRequest{}
+	mi := &file_api_notes_v1_messages_proto_msgTypes[134]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateNoteResponse) String() string {
+func (x *ListEventsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateNoteResponse) ProtoMessage() {}
+func (*ListEventsRequest) ProtoMessage() {}
 
-func (x *CreateNoteResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_notes_v1_messages_proto_msgTypes[6]
+func (x *ListEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_notes_v1_messages_proto_msgTypes[134]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -423,42 +8390,67 @@ func (x *CreateNoteResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateNoteResponse.ProtoReflect.Descriptor instead.
-func (*CreateNoteResponse) Descriptor() ([]byte, []int) {
-	return file_api_notes_v1_messages_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use ListEventsRequest.ProtoReflect.Descriptor instead.
+func (*ListEventsRequest) Descriptor() ([]byte, []int) {
+	return file_api_notes_v1_messages_proto_rawDescGZIP(), []int{134}
 }
 
-func (x *CreateNoteResponse) GetNote() *Note {
+func (x *ListEventsRequest) GetPageSize() int32 {
 	if x != nil {
-		return x.Note
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListEventsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListEventsRequest) GetFilter() *EventFilter {
+	if x != nil {
+		return x.Filter
 	}
 	return nil
 }
 
-// DeleteNoteRequest is the request message for deleting a note by ID.
-type DeleteNoteRequest struct {
+func (x *ListEventsRequest) GetUnreadOnly() bool {
+	if x != nil {
+		return x.UnreadOnly
+	}
+	return false
+}
+
+// ListEventsResponse is the response message containing events, most recent first.
+type ListEventsResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// ID of the note to delete.
-	Id            *types.ID `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// List of events.
+	Events []*Event `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	// Token to retrieve the next page, empty when there are no more events.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	// Number of unread events matching the filter, regardless of pagination.
+	Unread        int32 `protobuf:"varint,3,opt,name=unread,proto3" json:"unread,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteNoteRequest) Reset() {
-	*x = DeleteNoteRequest{}
-	mi := &file_api_notes_v1_messages_proto_msgTypes[7]
+func (x *ListEventsResponse) Reset() {
+	*x = ListEventsResponse{}
+	mi := &file_api_notes_v1_messages_proto_msgTypes[135]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteNoteRequest) String() string {
+func (x *ListEventsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteNoteRequest) ProtoMessage() {}
+func (*ListEventsResponse) ProtoMessage() {}
 
-func (x *DeleteNoteRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_notes_v1_messages_proto_msgTypes[7]
+func (x *ListEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_notes_v1_messages_proto_msgTypes[135]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -469,40 +8461,58 @@ func (x *DeleteNoteRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteNoteRequest.ProtoReflect.Descriptor instead.
-func (*DeleteNoteRequest) Descriptor() ([]byte, []int) {
-	return file_api_notes_v1_messages_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use ListEventsResponse.ProtoReflect.Descriptor instead.
+func (*ListEventsResponse) Descriptor() ([]byte, []int) {
+	return file_api_notes_v1_messages_proto_rawDescGZIP(), []int{135}
 }
 
-func (x *DeleteNoteRequest) GetId() *types.ID {
+func (x *ListEventsResponse) GetEvents() []*Event {
 	if x != nil {
-		return x.Id
+		return x.Events
 	}
 	return nil
 }
 
-// DeleteNoteResponse is the response message after deleting a note.
-type DeleteNoteResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+func (x *ListEventsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListEventsResponse) GetUnread() int32 {
+	if x != nil {
+		return x.Unread
+	}
+	return 0
+}
+
+// MarkEventsReadRequest is the request message for marking events as read.
+type MarkEventsReadRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// IDs of the events to mark as read.
+	Ids []string `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
+	// All events that occurred at or before this time are marked as read as well.
+	UpTo          *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=up_to,json=upTo,proto3" json:"up_to,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteNoteResponse) Reset() {
-	*x = DeleteNoteResponse{}
-	mi := &file_api_notes_v1_messages_proto_msgTypes[8]
+func (x *MarkEventsReadRequest) Reset() {
+	*x = MarkEventsReadRequest{}
+	mi := &file_api_notes_v1_messages_proto_msgTypes[136]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteNoteResponse) String() string {
+func (x *MarkEventsReadRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteNoteResponse) ProtoMessage() {}
+func (*MarkEventsReadRequest) ProtoMessage() {}
 
-func (x *DeleteNoteResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_notes_v1_messages_proto_msgTypes[8]
+func (x *MarkEventsReadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_notes_v1_messages_proto_msgTypes[136]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -513,41 +8523,51 @@ func (x *DeleteNoteResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteNoteResponse.ProtoReflect.Descriptor instead.
-func (*DeleteNoteResponse) Descriptor() ([]byte, []int) {
-	return file_api_notes_v1_messages_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use MarkEventsReadRequest.ProtoReflect.Descriptor instead.
+func (*MarkEventsReadRequest) Descriptor() ([]byte, []int) {
+	return file_api_notes_v1_messages_proto_rawDescGZIP(), []int{136}
 }
 
-// Event represents a system notification about a change in notes.
-type Event struct {
+func (x *MarkEventsReadRequest) GetIds() []string {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+func (x *MarkEventsReadRequest) GetUpTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpTo
+	}
+	return nil
+}
+
+// MarkEventsReadResponse is the response message after marking events as read.
+type MarkEventsReadResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Unique identifier of the event.
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	// Type of the event.
-	Type EventType `protobuf:"varint,2,opt,name=type,proto3,enum=api.notes.v1.EventType" json:"type,omitempty"`
-	// ID of the note associated with this event.
-	NoteId *types.ID `protobuf:"bytes,3,opt,name=note_id,json=noteId,proto3" json:"note_id,omitempty"`
-	// Timestamp when the event occurred.
-	EventTime     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=event_time,json=eventTime,proto3" json:"event_time,omitempty"`
+	// Number of events that were unread and are marked as read now.
+	Marked int32 `protobuf:"varint,1,opt,name=marked,proto3" json:"marked,omitempty"`
+	// Number of events that are still unread.
+	Unread        int32 `protobuf:"varint,2,opt,name=unread,proto3" json:"unread,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Event) Reset() {
-	*x = Event{}
-	mi := &file_api_notes_v1_messages_proto_msgTypes[9]
+func (x *MarkEventsReadResponse) Reset() {
+	*x = MarkEventsReadResponse{}
+	mi := &file_api_notes_v1_messages_proto_msgTypes[137]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Event) String() string {
+func (x *MarkEventsReadResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Event) ProtoMessage() {}
+func (*MarkEventsReadResponse) ProtoMessage() {}
 
-func (x *Event) ProtoReflect() protoreflect.Message {
-	mi := &file_api_notes_v1_messages_proto_msgTypes[9]
+func (x *MarkEventsReadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_notes_v1_messages_proto_msgTypes[137]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -558,63 +8578,49 @@ func (x *Event) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Event.ProtoReflect.Descriptor instead.
-func (*Event) Descriptor() ([]byte, []int) {
-	return file_api_notes_v1_messages_proto_rawDescGZIP(), []int{9}
-}
-
-func (x *Event) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
-}
-
-func (x *Event) GetType() EventType {
-	if x != nil {
-		return x.Type
-	}
-	return EventType_EVENT_TYPE_UNKNOWN
+// Deprecated: Use MarkEventsReadResponse.ProtoReflect.Descriptor instead.
+func (*MarkEventsReadResponse) Descriptor() ([]byte, []int) {
+	return file_api_notes_v1_messages_proto_rawDescGZIP(), []int{137}
 }
 
-func (x *Event) GetNoteId() *types.ID {
+func (x *MarkEventsReadResponse) GetMarked() int32 {
 	if x != nil {
-		return x.NoteId
+		return x.Marked
 	}
-	return nil
+	return 0
 }
 
-func (x *Event) GetEventTime() *timestamppb.Timestamp {
+func (x *MarkEventsReadResponse) GetUnread() int32 {
 	if x != nil {
-		return x.EventTime
+		return x.Unread
 	}
-	return nil
+	return 0
 }
 
-// Unread represents information about the number of unread events.
-type Unread struct {
+// Heartbeat is sent periodically while there are no events to keep idle streams open.
+type Heartbeat struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Total count of unread events.
-	Events        int32 `protobuf:"varint,1,opt,name=events,proto3" json:"events,omitempty"`
+	// Server time when the heartbeat was sent.
+	Time          *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=time,proto3" json:"time,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Unread) Reset() {
-	*x = Unread{}
-	mi := &file_api_notes_v1_messages_proto_msgTypes[10]
+func (x *Heartbeat) Reset() {
+	*x = Heartbeat{}
+	mi := &file_api_notes_v1_messages_proto_msgTypes[138]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Unread) String() string {
+func (x *Heartbeat) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Unread) ProtoMessage() {}
+func (*Heartbeat) ProtoMessage() {}
 
-func (x *Unread) ProtoReflect() protoreflect.Message {
-	mi := &file_api_notes_v1_messages_proto_msgTypes[10]
+func (x *Heartbeat) ProtoReflect() protoreflect.Message {
+	mi := &file_api_notes_v1_messages_proto_msgTypes[138]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -625,28 +8631,37 @@ func (x *Unread) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Unread.ProtoReflect.Descriptor instead.
-func (*Unread) Descriptor() ([]byte, []int) {
-	return file_api_notes_v1_messages_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use Heartbeat.ProtoReflect.Descriptor instead.
+func (*Heartbeat) Descriptor() ([]byte, []int) {
+	return file_api_notes_v1_messages_proto_rawDescGZIP(), []int{138}
 }
 
-func (x *Unread) GetEvents() int32 {
+func (x *Heartbeat) GetTime() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Events
+		return x.Time
 	}
-	return 0
+	return nil
 }
 
 // SubscribeToEventsRequest is the request message for event stream subscription.
 type SubscribeToEventsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Name of the device, each device receives all events and keeps its own progress. Defaults to "default".
+	// Only letters, digits, "_" and "-" are allowed. The devices idle for long or beyond the limit of the server
+	// are forgotten and start over with the new events.
+	Device string `protobuf:"bytes,1,opt,name=device,proto3" json:"device,omitempty"`
+	// Position of the last event the device has seen, the stream continues right after it.
+	// Use "0" to replay all retained events, a device subscribing for the first time gets only new events otherwise.
+	ResumeFrom string `protobuf:"bytes,2,opt,name=resume_from,json=resumeFrom,proto3" json:"resume_from,omitempty"`
+	// Only events matching the filter are sent, the unread counter counts only them as well.
+	Filter        *EventFilter `protobuf:"bytes,3,opt,name=filter,proto3" json:"filter,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *SubscribeToEventsRequest) Reset() {
 	*x = SubscribeToEventsRequest{}
-	mi := &file_api_notes_v1_messages_proto_msgTypes[11]
+	mi := &file_api_notes_v1_messages_proto_msgTypes[139]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -658,7 +8673,7 @@ func (x *SubscribeToEventsRequest) String() string {
 func (*SubscribeToEventsRequest) ProtoMessage() {}
 
 func (x *SubscribeToEventsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_notes_v1_messages_proto_msgTypes[11]
+	mi := &file_api_notes_v1_messages_proto_msgTypes[139]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -671,7 +8686,28 @@ func (x *SubscribeToEventsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SubscribeToEventsRequest.ProtoReflect.Descriptor instead.
 func (*SubscribeToEventsRequest) Descriptor() ([]byte, []int) {
-	return file_api_notes_v1_messages_proto_rawDescGZIP(), []int{11}
+	return file_api_notes_v1_messages_proto_rawDescGZIP(), []int{139}
+}
+
+func (x *SubscribeToEventsRequest) GetDevice() string {
+	if x != nil {
+		return x.Device
+	}
+	return ""
+}
+
+func (x *SubscribeToEventsRequest) GetResumeFrom() string {
+	if x != nil {
+		return x.ResumeFrom
+	}
+	return ""
+}
+
+func (x *SubscribeToEventsRequest) GetFilter() *EventFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
 }
 
 // SubscribeToEventsResponse is the response message in the event stream.
@@ -683,6 +8719,7 @@ type SubscribeToEventsResponse struct {
 	//
 	//	*SubscribeToEventsResponse_Event
 	//	*SubscribeToEventsResponse_Unread
+	//	*SubscribeToEventsResponse_Heartbeat
 	Payload       isSubscribeToEventsResponse_Payload `protobuf_oneof:"payload"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -690,7 +8727,7 @@ type SubscribeToEventsResponse struct {
 
 func (x *SubscribeToEventsResponse) Reset() {
 	*x = SubscribeToEventsResponse{}
-	mi := &file_api_notes_v1_messages_proto_msgTypes[12]
+	mi := &file_api_notes_v1_messages_proto_msgTypes[140]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -702,7 +8739,7 @@ func (x *SubscribeToEventsResponse) String() string {
 func (*SubscribeToEventsResponse) ProtoMessage() {}
 
 func (x *SubscribeToEventsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_notes_v1_messages_proto_msgTypes[12]
+	mi := &file_api_notes_v1_messages_proto_msgTypes[140]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -715,7 +8752,7 @@ func (x *SubscribeToEventsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SubscribeToEventsResponse.ProtoReflect.Descriptor instead.
 func (*SubscribeToEventsResponse) Descriptor() ([]byte, []int) {
-	return file_api_notes_v1_messages_proto_rawDescGZIP(), []int{12}
+	return file_api_notes_v1_messages_proto_rawDescGZIP(), []int{140}
 }
 
 func (x *SubscribeToEventsResponse) GetPayload() isSubscribeToEventsResponse_Payload {
@@ -743,6 +8780,15 @@ func (x *SubscribeToEventsResponse) GetUnread() *Unread {
 	return nil
 }
 
+func (x *SubscribeToEventsResponse) GetHeartbeat() *Heartbeat {
+	if x != nil {
+		if x, ok := x.Payload.(*SubscribeToEventsResponse_Heartbeat); ok {
+			return x.Heartbeat
+		}
+	}
+	return nil
+}
+
 type isSubscribeToEventsResponse_Payload interface {
 	isSubscribeToEventsResponse_Payload()
 }
@@ -757,15 +8803,22 @@ type SubscribeToEventsResponse_Unread struct {
 	Unread *Unread `protobuf:"bytes,2,opt,name=unread,proto3,oneof"`
 }
 
+type SubscribeToEventsResponse_Heartbeat struct {
+	// A keep-alive sent when no events occurred for a while.
+	Heartbeat *Heartbeat `protobuf:"bytes,3,opt,name=heartbeat,proto3,oneof"`
+}
+
 func (*SubscribeToEventsResponse_Event) isSubscribeToEventsResponse_Payload() {}
 
 func (*SubscribeToEventsResponse_Unread) isSubscribeToEventsResponse_Payload() {}
 
+func (*SubscribeToEventsResponse_Heartbeat) isSubscribeToEventsResponse_Payload() {}
+
 var File_api_notes_v1_messages_proto protoreflect.FileDescriptor
 
 const file_api_notes_v1_messages_proto_rawDesc = "" +
 	"\n" +
-	"\x1bapi/notes/v1/messages.proto\x12\fapi.notes.v1\x1a\x12api/types/id.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1bbuf/validate/validate.proto\"\xcb\x01\n" +
+	"\x1bapi/notes/v1/messages.proto\x12\fapi.notes.v1\x1a\x15api/types/error.proto\x1a\x12api/types/id.proto\x1a\x1egoogle/protobuf/duration.proto\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1bbuf/validate/validate.proto\"\xb2\x03\n" +
 	"\x04Note\x12\x1d\n" +
 	"\x02id\x18\x01 \x01(\v2\r.api.types.IDR\x02id\x12\x14\n" +
 	"\x05title\x18\x02 \x01(\tR\x05title\x12\x18\n" +
@@ -773,42 +8826,587 @@ const file_api_notes_v1_messages_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
-	"updated_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\x12\n" +
-	"\x10ListNotesRequest\"=\n" +
+	"updated_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x129\n" +
+	"\n" +
+	"deleted_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tdeletedAt\x12\x12\n" +
+	"\x04tags\x18\a \x03(\tR\x04tags\x12.\n" +
+	"\vnotebook_id\x18\b \x01(\v2\r.api.types.IDR\n" +
+	"notebookId\x12\x18\n" +
+	"\aversion\x18\t \x01(\x03R\aversion\x12\x16\n" +
+	"\x06pinned\x18\n" +
+	" \x01(\bR\x06pinned\x12\x1a\n" +
+	"\barchived\x18\v \x01(\bR\barchived\x12\x18\n" +
+	"\astarred\x18\f \x01(\bR\astarred\"\xa8\x06\n" +
+	"\x10ListNotesRequest\x12&\n" +
+	"\tpage_size\x18\x01 \x01(\x05B\t\xbaH\x06\x1a\x04\x18d(\x00R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\x12R\n" +
+	"\border_by\x18\x03 \x01(\tB7\xbaH4\xd8\x01\x01r/2-^(created_at|updated_at|title)( (asc|desc))?$R\aorderBy\x12?\n" +
+	"\rcreated_after\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\fcreatedAfter\x12A\n" +
+	"\x0ecreated_before\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\rcreatedBefore\x12?\n" +
+	"\rupdated_after\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\fupdatedAfter\x12A\n" +
+	"\x0eupdated_before\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\rupdatedBefore\x12+\n" +
+	"\ftitle_prefix\x18\b \x01(\tB\b\xbaH\x05r\x03\x18\xff\x01R\vtitlePrefix\x12)\n" +
+	"\btags_any\x18\t \x03(\tB\x0e\xbaH\v\x92\x01\b\x10\x14\"\x04r\x02\x18@R\atagsAny\x12)\n" +
+	"\btags_all\x18\n" +
+	" \x03(\tB\x0e\xbaH\v\x92\x01\b\x10\x14\"\x04r\x02\x18@R\atagsAll\x12.\n" +
+	"\vnotebook_id\x18\v \x01(\v2\r.api.types.IDR\n" +
+	"notebookId\x12\x1b\n" +
+	"\x06pinned\x18\f \x01(\bH\x00R\x06pinned\x88\x01\x01\x12\x1f\n" +
+	"\barchived\x18\r \x01(\bH\x01R\barchived\x88\x01\x01\x12\x1d\n" +
+	"\astarred\x18\x0e \x01(\bH\x02R\astarred\x88\x01\x01\x12=\n" +
+	"\x06format\x18\x0f \x01(\x0e2\x1b.api.notes.v1.ContentFormatB\b\xbaH\x05\x82\x01\x02\x10\x01R\x06formatB\t\n" +
+	"\a_pinnedB\v\n" +
+	"\t_archivedB\n" +
+	"\n" +
+	"\b_starred\"\x84\x01\n" +
 	"\x11ListNotesResponse\x12(\n" +
-	"\x05notes\x18\x01 \x03(\v2\x12.api.notes.v1.NoteR\x05notes\"4\n" +
+	"\x05notes\x18\x01 \x03(\v2\x12.api.notes.v1.NoteR\x05notes\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12\x1d\n" +
+	"\n" +
+	"total_size\x18\x03 \x01(\x05R\ttotalSize\"~\n" +
+	"\x12SearchNotesRequest\x12!\n" +
+	"\x05query\x18\x01 \x01(\tB\v\xbaH\b\xc8\x01\x01r\x03\x18\xff\x01R\x05query\x12&\n" +
+	"\tpage_size\x18\x02 \x01(\x05B\t\xbaH\x06\x1a\x04\x18d(\x00R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\"\x95\x01\n" +
+	"\tSearchHit\x12&\n" +
+	"\x04note\x18\x01 \x01(\v2\x12.api.notes.v1.NoteR\x04note\x12\x12\n" +
+	"\x04rank\x18\x02 \x01(\x02R\x04rank\x12#\n" +
+	"\rtitle_snippet\x18\x03 \x01(\tR\ftitleSnippet\x12'\n" +
+	"\x0fcontent_snippet\x18\x04 \x01(\tR\x0econtentSnippet\"\x89\x01\n" +
+	"\x13SearchNotesResponse\x12+\n" +
+	"\x04hits\x18\x01 \x03(\v2\x17.api.notes.v1.SearchHitR\x04hits\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12\x1d\n" +
+	"\n" +
+	"total_size\x18\x03 \x01(\x05R\ttotalSize\"s\n" +
 	"\x13RetrieveNoteRequest\x12\x1d\n" +
-	"\x02id\x18\x01 \x01(\v2\r.api.types.IDR\x02id\">\n" +
+	"\x02id\x18\x01 \x01(\v2\r.api.types.IDR\x02id\x12=\n" +
+	"\x06format\x18\x02 \x01(\x0e2\x1b.api.notes.v1.ContentFormatB\b\xbaH\x05\x82\x01\x02\x10\x01R\x06format\">\n" +
 	"\x14RetrieveNoteResponse\x12&\n" +
-	"\x04note\x18\x01 \x01(\v2\x12.api.notes.v1.NoteR\x04note\"^\n" +
+	"\x04note\x18\x01 \x01(\v2\x12.api.notes.v1.NoteR\x04note\"\xf1\x03\n" +
 	"\x11CreateNoteRequest\x12#\n" +
 	"\x05title\x18\x01 \x01(\tB\r\xbaH\n" +
-	"\xc8\x01\x01r\x05\x10\x05\x18\xff\x01R\x05title\x12$\n" +
+	"\xd8\x01\x01r\x05\x10\x05\x18\xff\x01R\x05title\x12$\n" +
 	"\acontent\x18\x02 \x01(\tB\n" +
-	"\xbaH\a\xc8\x01\x01r\x02\x10\n" +
-	"R\acontent\"<\n" +
+	"\xbaH\a\xd8\x01\x01r\x02\x10\n" +
+	"R\acontent\x12.\n" +
+	"\vnotebook_id\x18\x03 \x01(\v2\r.api.types.IDR\n" +
+	"notebookId\x12.\n" +
+	"\vtemplate_id\x18\x04 \x01(\v2\r.api.types.IDR\n" +
+	"templateId\x12]\n" +
+	"\tvariables\x18\x05 \x03(\v2..api.notes.v1.CreateNoteRequest.VariablesEntryB\x0f\xbaH\f\x9a\x01\t\x10d*\x05r\x03\x18\x80 R\tvariables\x1a<\n" +
+	"\x0eVariablesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01:\x93\x01\xbaH\x8f\x01\x1a\x8c\x01\n" +
+	"\x14create_note.template\x121title and content are required without a template\x1aAhas(this.template_id) || (this.title != '' && this.content != '')\"<\n" +
 	"\x12CreateNoteResponse\x12&\n" +
-	"\x04note\x18\x01 \x01(\v2\x12.api.notes.v1.NoteR\x04note\"2\n" +
+	"\x04note\x18\x01 \x01(\v2\x12.api.notes.v1.NoteR\x04note\"\xe1\x02\n" +
+	"\x11UpdateNoteRequest\x12\x1d\n" +
+	"\x02id\x18\x01 \x01(\v2\r.api.types.IDR\x02id\x12#\n" +
+	"\x05title\x18\x02 \x01(\tB\r\xbaH\n" +
+	"\xd8\x01\x01r\x05\x10\x05\x18\xff\x01R\x05title\x12$\n" +
+	"\acontent\x18\x03 \x01(\tB\n" +
+	"\xbaH\a\xd8\x01\x01r\x02\x10\n" +
+	"R\acontent\x12q\n" +
+	"\vupdate_mask\x18\x04 \x01(\v2\x1a.google.protobuf.FieldMaskB4\xbaH1\xc8\x01\x01\xe2\x01+\x12\x05title\x12\acontent\x12\x06pinned\x12\barchived\x12\astarredR\n" +
+	"updateMask\x12!\n" +
+	"\aversion\x18\x05 \x01(\x03B\a\xbaH\x04\"\x02(\x00R\aversion\x12\x16\n" +
+	"\x06pinned\x18\x06 \x01(\bR\x06pinned\x12\x1a\n" +
+	"\barchived\x18\a \x01(\bR\barchived\x12\x18\n" +
+	"\astarred\x18\b \x01(\bR\astarred\"<\n" +
+	"\x12UpdateNoteResponse\x12&\n" +
+	"\x04note\x18\x01 \x01(\v2\x12.api.notes.v1.NoteR\x04note\"\x80\x01\n" +
+	"\rEditComponent\x12!\n" +
+	"\x06retain\x18\x01 \x01(\x05B\a\xbaH\x04\x1a\x02 \x00H\x00R\x06retain\x12!\n" +
+	"\x06insert\x18\x02 \x01(\tB\a\xbaH\x04r\x02\x10\x01H\x00R\x06insert\x12!\n" +
+	"\x06delete\x18\x03 \x01(\x05B\a\xbaH\x04\x1a\x02 \x00H\x00R\x06deleteB\x06\n" +
+	"\x04kind\"q\n" +
+	"\rEditOperation\x12#\n" +
+	"\brevision\x18\x01 \x01(\x03B\a\xbaH\x04\"\x02(\x00R\brevision\x12;\n" +
+	"\n" +
+	"components\x18\x02 \x03(\v2\x1b.api.notes.v1.EditComponentR\n" +
+	"components\"o\n" +
+	"\n" +
+	"EditCursor\x12#\n" +
+	"\brevision\x18\x01 \x01(\x03B\a\xbaH\x04\"\x02(\x00R\brevision\x12\x1f\n" +
+	"\x06anchor\x18\x02 \x01(\x05B\a\xbaH\x04\x1a\x02(\x00R\x06anchor\x12\x1b\n" +
+	"\x04head\x18\x03 \x01(\x05B\a\xbaH\x04\x1a\x02(\x00R\x04head\"s\n" +
+	"\x05Draft\x12\x18\n" +
+	"\acontent\x18\x01 \x01(\tR\acontent\x12\x1a\n" +
+	"\brevision\x18\x02 \x01(\x03R\brevision\x12\x18\n" +
+	"\asession\x18\x03 \x01(\tR\asession\x12\x1a\n" +
+	"\bwritable\x18\x04 \x01(\bR\bwritable\"%\n" +
+	"\aEditAck\x12\x1a\n" +
+	"\brevision\x18\x01 \x01(\x03R\brevision\"\x89\x01\n" +
+	"\n" +
+	"RemoteEdit\x12&\n" +
+	"\auser_id\x18\x01 \x01(\v2\r.api.types.IDR\x06userId\x12\x18\n" +
+	"\asession\x18\x02 \x01(\tR\asession\x129\n" +
+	"\toperation\x18\x03 \x01(\v2\x1b.api.notes.v1.EditOperationR\toperation\"\x82\x01\n" +
+	"\fRemoteCursor\x12&\n" +
+	"\auser_id\x18\x01 \x01(\v2\r.api.types.IDR\x06userId\x12\x18\n" +
+	"\asession\x18\x02 \x01(\tR\asession\x120\n" +
+	"\x06cursor\x18\x03 \x01(\v2\x18.api.notes.v1.EditCursorR\x06cursor\"\xb7\x01\n" +
+	"\x0fEditNoteRequest\x12(\n" +
+	"\anote_id\x18\x01 \x01(\v2\r.api.types.IDH\x00R\x06noteId\x12;\n" +
+	"\toperation\x18\x02 \x01(\v2\x1b.api.notes.v1.EditOperationH\x00R\toperation\x122\n" +
+	"\x06cursor\x18\x03 \x01(\v2\x18.api.notes.v1.EditCursorH\x00R\x06cursorB\t\n" +
+	"\apayload\"\xdb\x01\n" +
+	"\x10EditNoteResponse\x12+\n" +
+	"\x05draft\x18\x01 \x01(\v2\x13.api.notes.v1.DraftH\x00R\x05draft\x12)\n" +
+	"\x03ack\x18\x02 \x01(\v2\x15.api.notes.v1.EditAckH\x00R\x03ack\x12.\n" +
+	"\x04edit\x18\x03 \x01(\v2\x18.api.notes.v1.RemoteEditH\x00R\x04edit\x124\n" +
+	"\x06cursor\x18\x04 \x01(\v2\x1a.api.notes.v1.RemoteCursorH\x00R\x06cursorB\t\n" +
+	"\apayload\"U\n" +
 	"\x11DeleteNoteRequest\x12\x1d\n" +
-	"\x02id\x18\x01 \x01(\v2\r.api.types.IDR\x02id\"\x14\n" +
-	"\x12DeleteNoteResponse\"\xa7\x01\n" +
+	"\x02id\x18\x01 \x01(\v2\r.api.types.IDR\x02id\x12!\n" +
+	"\aversion\x18\x02 \x01(\x03B\a\xbaH\x04\"\x02(\x00R\aversion\"\x14\n" +
+	"\x12DeleteNoteResponse\"`\n" +
+	"\x17ListTrashedNotesRequest\x12&\n" +
+	"\tpage_size\x18\x01 \x01(\x05B\t\xbaH\x06\x1a\x04\x18d(\x00R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\"\x8b\x01\n" +
+	"\x18ListTrashedNotesResponse\x12(\n" +
+	"\x05notes\x18\x01 \x03(\v2\x12.api.notes.v1.NoteR\x05notes\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12\x1d\n" +
+	"\n" +
+	"total_size\x18\x03 \x01(\x05R\ttotalSize\"V\n" +
+	"\x12RestoreNoteRequest\x12\x1d\n" +
+	"\x02id\x18\x01 \x01(\v2\r.api.types.IDR\x02id\x12!\n" +
+	"\aversion\x18\x02 \x01(\x03B\a\xbaH\x04\"\x02(\x00R\aversion\"=\n" +
+	"\x13RestoreNoteResponse\x12&\n" +
+	"\x04note\x18\x01 \x01(\v2\x12.api.notes.v1.NoteR\x04note\"T\n" +
+	"\x10PurgeNoteRequest\x12\x1d\n" +
+	"\x02id\x18\x01 \x01(\v2\r.api.types.IDR\x02id\x12!\n" +
+	"\aversion\x18\x02 \x01(\x03B\a\xbaH\x04\"\x02(\x00R\aversion\"\x13\n" +
+	"\x11PurgeNoteResponse\"T\n" +
+	"\x03Tag\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x129\n" +
+	"\n" +
+	"created_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"E\n" +
+	"\bTagCount\x12#\n" +
+	"\x03tag\x18\x01 \x01(\v2\x11.api.notes.v1.TagR\x03tag\x12\x14\n" +
+	"\x05notes\x18\x02 \x01(\x05R\x05notes\"\x87\x01\n" +
+	"\x12AddNoteTagsRequest\x12&\n" +
+	"\anote_id\x18\x01 \x01(\v2\r.api.types.IDR\x06noteId\x12&\n" +
+	"\x04tags\x18\x02 \x03(\tB\x12\xbaH\x0f\x92\x01\f\b\x01\x10\x14\"\x06r\x04\x10\x01\x18@R\x04tags\x12!\n" +
+	"\aversion\x18\x03 \x01(\x03B\a\xbaH\x04\"\x02(\x00R\aversion\"=\n" +
+	"\x13AddNoteTagsResponse\x12&\n" +
+	"\x04note\x18\x01 \x01(\v2\x12.api.notes.v1.NoteR\x04note\"\x8a\x01\n" +
+	"\x15RemoveNoteTagsRequest\x12&\n" +
+	"\anote_id\x18\x01 \x01(\v2\r.api.types.IDR\x06noteId\x12&\n" +
+	"\x04tags\x18\x02 \x03(\tB\x12\xbaH\x0f\x92\x01\f\b\x01\x10\x14\"\x06r\x04\x10\x01\x18@R\x04tags\x12!\n" +
+	"\aversion\x18\x03 \x01(\x03B\a\xbaH\x04\"\x02(\x00R\aversion\"@\n" +
+	"\x16RemoveNoteTagsResponse\x12&\n" +
+	"\x04note\x18\x01 \x01(\v2\x12.api.notes.v1.NoteR\x04note\"\x11\n" +
+	"\x0fListTagsRequest\">\n" +
+	"\x10ListTagsResponse\x12*\n" +
+	"\x04tags\x18\x01 \x03(\v2\x16.api.notes.v1.TagCountR\x04tags\"W\n" +
+	"\x10RenameTagRequest\x12\x1d\n" +
+	"\x04name\x18\x01 \x01(\tB\t\xbaH\x06r\x04\x10\x01\x18@R\x04name\x12$\n" +
+	"\bnew_name\x18\x02 \x01(\tB\t\xbaH\x06r\x04\x10\x01\x18@R\anewName\"8\n" +
+	"\x11RenameTagResponse\x12#\n" +
+	"\x03tag\x18\x01 \x01(\v2\x11.api.notes.v1.TagR\x03tag\"\xdc\x01\n" +
+	"\fNoteRevision\x12\x1d\n" +
+	"\x02id\x18\x01 \x01(\v2\r.api.types.IDR\x02id\x12&\n" +
+	"\anote_id\x18\x02 \x01(\v2\r.api.types.IDR\x06noteId\x12\x1a\n" +
+	"\brevision\x18\x03 \x01(\x05R\brevision\x12\x14\n" +
+	"\x05title\x18\x04 \x01(\tR\x05title\x12\x18\n" +
+	"\acontent\x18\x05 \x01(\tR\acontent\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\x89\x01\n" +
+	"\x18ListNoteRevisionsRequest\x12&\n" +
+	"\anote_id\x18\x01 \x01(\v2\r.api.types.IDR\x06noteId\x12&\n" +
+	"\tpage_size\x18\x02 \x01(\x05B\t\xbaH\x06\x1a\x04\x18d(\x00R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\"}\n" +
+	"\x19ListNoteRevisionsResponse\x128\n" +
+	"\trevisions\x18\x01 \x03(\v2\x1a.api.notes.v1.NoteRevisionR\trevisions\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"e\n" +
+	"\x16GetNoteRevisionRequest\x12&\n" +
+	"\anote_id\x18\x01 \x01(\v2\r.api.types.IDR\x06noteId\x12#\n" +
+	"\brevision\x18\x02 \x01(\x05B\a\xbaH\x04\x1a\x02 \x00R\brevision\"Q\n" +
+	"\x17GetNoteRevisionResponse\x126\n" +
+	"\brevision\x18\x01 \x01(\v2\x1a.api.notes.v1.NoteRevisionR\brevision\"Y\n" +
+	"\bDiffLine\x129\n" +
+	"\toperation\x18\x01 \x01(\x0e2\x1b.api.notes.v1.DiffOperationR\toperation\x12\x12\n" +
+	"\x04text\x18\x02 \x01(\tR\x04text\"\x9a\x01\n" +
+	"\x18DiffNoteRevisionsRequest\x12&\n" +
+	"\anote_id\x18\x01 \x01(\v2\r.api.types.IDR\x06noteId\x12,\n" +
+	"\rfrom_revision\x18\x02 \x01(\x05B\a\xbaH\x04\x1a\x02 \x00R\ffromRevision\x12(\n" +
+	"\vto_revision\x18\x03 \x01(\x05B\a\xbaH\x04\x1a\x02 \x00R\n" +
+	"toRevision\"\x83\x01\n" +
+	"\x19DiffNoteRevisionsResponse\x12\x1d\n" +
+	"\n" +
+	"from_title\x18\x01 \x01(\tR\tfromTitle\x12\x19\n" +
+	"\bto_title\x18\x02 \x01(\tR\atoTitle\x12,\n" +
+	"\x05lines\x18\x03 \x03(\v2\x16.api.notes.v1.DiffLineR\x05lines\"\x8c\x01\n" +
+	"\x1aRestoreNoteRevisionRequest\x12&\n" +
+	"\anote_id\x18\x01 \x01(\v2\r.api.types.IDR\x06noteId\x12#\n" +
+	"\brevision\x18\x02 \x01(\x05B\a\xbaH\x04\x1a\x02 \x00R\brevision\x12!\n" +
+	"\aversion\x18\x03 \x01(\x03B\a\xbaH\x04\"\x02(\x00R\aversion\"}\n" +
+	"\x1bRestoreNoteRevisionResponse\x12&\n" +
+	"\x04note\x18\x01 \x01(\v2\x12.api.notes.v1.NoteR\x04note\x126\n" +
+	"\brevision\x18\x02 \x01(\v2\x1a.api.notes.v1.NoteRevisionR\brevision\"\x80\x01\n" +
+	"\x0fBatchNoteResult\x12\x1d\n" +
+	"\x02id\x18\x01 \x01(\v2\r.api.types.IDR\x02id\x12&\n" +
+	"\x04note\x18\x02 \x01(\v2\x12.api.notes.v1.NoteR\x04note\x12&\n" +
+	"\x05error\x18\x03 \x01(\v2\x10.api.types.ErrorR\x05error\"Z\n" +
+	"\x17BatchCreateNotesRequest\x12?\n" +
+	"\x05notes\x18\x01 \x03(\v2\x1f.api.notes.v1.CreateNoteRequestB\b\xbaH\x05\x92\x01\x02\b\x01R\x05notes\"S\n" +
+	"\x18BatchCreateNotesResponse\x127\n" +
+	"\aresults\x18\x01 \x03(\v2\x1d.api.notes.v1.BatchNoteResultR\aresults\"\xe0\x01\n" +
+	"\x17BatchDeleteNotesRequest\x12)\n" +
+	"\x03ids\x18\x01 \x03(\v2\r.api.types.IDB\b\xbaH\x05\x92\x01\x02\b\x01R\x03ids\x12]\n" +
+	"\bversions\x18\x02 \x03(\v23.api.notes.v1.BatchDeleteNotesRequest.VersionsEntryB\f\xbaH\t\x9a\x01\x06*\x04\"\x02 \x00R\bversions\x1a;\n" +
+	"\rVersionsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\x03R\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x03R\x05value:\x028\x01\"S\n" +
+	"\x18BatchDeleteNotesResponse\x127\n" +
+	"\aresults\x18\x01 \x03(\v2\x1d.api.notes.v1.BatchNoteResultR\aresults\"A\n" +
+	"\x14BatchGetNotesRequest\x12)\n" +
+	"\x03ids\x18\x01 \x03(\v2\r.api.types.IDB\b\xbaH\x05\x92\x01\x02\b\x01R\x03ids\"P\n" +
+	"\x15BatchGetNotesResponse\x127\n" +
+	"\aresults\x18\x01 \x03(\v2\x1d.api.notes.v1.BatchNoteResultR\aresults\"U\n" +
+	"\x12ExportNotesRequest\x12?\n" +
+	"\x06format\x18\x01 \x01(\x0e2\x1b.api.notes.v1.ArchiveFormatB\n" +
+	"\xbaH\a\x82\x01\x04\x10\x01 \x00R\x06format\"+\n" +
+	"\x13ExportNotesResponse\x12\x14\n" +
+	"\x05chunk\x18\x01 \x01(\fR\x05chunk\"i\n" +
+	"\x12ImportNotesRequest\x12=\n" +
+	"\x06format\x18\x01 \x01(\x0e2\x1b.api.notes.v1.ArchiveFormatB\b\xbaH\x05\x82\x01\x02\x10\x01R\x06format\x12\x14\n" +
+	"\x05chunk\x18\x02 \x01(\fR\x05chunk\"\xae\x01\n" +
+	"\x10ImportNoteResult\x12\x16\n" +
+	"\x06source\x18\x01 \x01(\tR\x06source\x122\n" +
+	"\x06status\x18\x02 \x01(\x0e2\x1a.api.notes.v1.ImportStatusR\x06status\x12&\n" +
+	"\x04note\x18\x03 \x01(\v2\x12.api.notes.v1.NoteR\x04note\x12&\n" +
+	"\x05error\x18\x04 \x01(\v2\x10.api.types.ErrorR\x05error\"\xa1\x01\n" +
+	"\x13ImportNotesResponse\x128\n" +
+	"\aresults\x18\x01 \x03(\v2\x1e.api.notes.v1.ImportNoteResultR\aresults\x12\x18\n" +
+	"\acreated\x18\x02 \x01(\x05R\acreated\x12\x1e\n" +
+	"\n" +
+	"duplicates\x18\x03 \x01(\x05R\n" +
+	"duplicates\x12\x16\n" +
+	"\x06failed\x18\x04 \x01(\x05R\x06failed\"\xf5\x01\n" +
+	"\n" +
+	"Attachment\x12\x1d\n" +
+	"\x02id\x18\x01 \x01(\v2\r.api.types.IDR\x02id\x12&\n" +
+	"\anote_id\x18\x02 \x01(\v2\r.api.types.IDR\x06noteId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12!\n" +
+	"\fcontent_type\x18\x04 \x01(\tR\vcontentType\x12\x12\n" +
+	"\x04size\x18\x05 \x01(\x03R\x04size\x12\x1a\n" +
+	"\bchecksum\x18\x06 \x01(\tR\bchecksum\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xc0\x01\n" +
+	"\x10AttachmentHeader\x12&\n" +
+	"\anote_id\x18\x01 \x01(\v2\r.api.types.IDR\x06noteId\x12\x1e\n" +
+	"\x04name\x18\x02 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\x04name\x12+\n" +
+	"\fcontent_type\x18\x03 \x01(\tB\b\xbaH\x05r\x03\x18\xff\x01R\vcontentType\x127\n" +
+	"\bchecksum\x18\x04 \x01(\tB\x1b\xbaH\x18r\x162\x14^([0-9a-fA-F]{64})?$R\bchecksum\"v\n" +
+	"\x17UploadAttachmentRequest\x128\n" +
+	"\x06header\x18\x01 \x01(\v2\x1e.api.notes.v1.AttachmentHeaderH\x00R\x06header\x12\x16\n" +
+	"\x05chunk\x18\x02 \x01(\fH\x00R\x05chunkB\t\n" +
+	"\apayload\"T\n" +
+	"\x18UploadAttachmentResponse\x128\n" +
+	"\n" +
+	"attachment\x18\x01 \x01(\v2\x18.api.notes.v1.AttachmentR\n" +
+	"attachment\":\n" +
+	"\x19DownloadAttachmentRequest\x12\x1d\n" +
+	"\x02id\x18\x01 \x01(\v2\r.api.types.IDR\x02id\"{\n" +
+	"\x1aDownloadAttachmentResponse\x12:\n" +
+	"\n" +
+	"attachment\x18\x01 \x01(\v2\x18.api.notes.v1.AttachmentH\x00R\n" +
+	"attachment\x12\x16\n" +
+	"\x05chunk\x18\x02 \x01(\fH\x00R\x05chunkB\t\n" +
+	"\apayload\"@\n" +
+	"\x16ListAttachmentsRequest\x12&\n" +
+	"\anote_id\x18\x01 \x01(\v2\r.api.types.IDR\x06noteId\"U\n" +
+	"\x17ListAttachmentsResponse\x12:\n" +
+	"\vattachments\x18\x01 \x03(\v2\x18.api.notes.v1.AttachmentR\vattachments\"8\n" +
+	"\x17DeleteAttachmentRequest\x12\x1d\n" +
+	"\x02id\x18\x01 \x01(\v2\r.api.types.IDR\x02id\"\x1a\n" +
+	"\x18DeleteAttachmentResponse\"\x83\x01\n" +
+	"\x0fMoveNoteRequest\x12\x1d\n" +
+	"\x02id\x18\x01 \x01(\v2\r.api.types.IDR\x02id\x12.\n" +
+	"\vnotebook_id\x18\x02 \x01(\v2\r.api.types.IDR\n" +
+	"notebookId\x12!\n" +
+	"\aversion\x18\x03 \x01(\x03B\a\xbaH\x04\"\x02(\x00R\aversion\":\n" +
+	"\x10MoveNoteResponse\x12&\n" +
+	"\x04note\x18\x01 \x01(\v2\x12.api.notes.v1.NoteR\x04note\"\xdf\x01\n" +
+	"\bNotebook\x12\x1d\n" +
+	"\x02id\x18\x01 \x01(\v2\r.api.types.IDR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12*\n" +
+	"\tparent_id\x18\x03 \x01(\v2\r.api.types.IDR\bparentId\x129\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"d\n" +
+	"\x15CreateNotebookRequest\x12\x1f\n" +
+	"\x04name\x18\x01 \x01(\tB\v\xbaH\b\xc8\x01\x01r\x03\x18\xff\x01R\x04name\x12*\n" +
+	"\tparent_id\x18\x02 \x01(\v2\r.api.types.IDR\bparentId\"L\n" +
+	"\x16CreateNotebookResponse\x122\n" +
+	"\bnotebook\x18\x01 \x01(\v2\x16.api.notes.v1.NotebookR\bnotebook\"3\n" +
+	"\x12GetNotebookRequest\x12\x1d\n" +
+	"\x02id\x18\x01 \x01(\v2\r.api.types.IDR\x02id\"I\n" +
+	"\x13GetNotebookResponse\x122\n" +
+	"\bnotebook\x18\x01 \x01(\v2\x16.api.notes.v1.NotebookR\bnotebook\"\x16\n" +
+	"\x14ListNotebooksRequest\"M\n" +
+	"\x15ListNotebooksResponse\x124\n" +
+	"\tnotebooks\x18\x01 \x03(\v2\x16.api.notes.v1.NotebookR\tnotebooks\"\xdc\x01\n" +
+	"\x15UpdateNotebookRequest\x12\x1d\n" +
+	"\x02id\x18\x01 \x01(\v2\r.api.types.IDR\x02id\x12\x1f\n" +
+	"\x04name\x18\x02 \x01(\tB\v\xbaH\b\xd8\x01\x01r\x03\x18\xff\x01R\x04name\x12*\n" +
+	"\tparent_id\x18\x03 \x01(\v2\r.api.types.IDR\bparentId\x12W\n" +
+	"\vupdate_mask\x18\x04 \x01(\v2\x1a.google.protobuf.FieldMaskB\x1a\xbaH\x17\xc8\x01\x01\xe2\x01\x11\x12\x04name\x12\tparent_idR\n" +
+	"updateMask\"L\n" +
+	"\x16UpdateNotebookResponse\x122\n" +
+	"\bnotebook\x18\x01 \x01(\v2\x16.api.notes.v1.NotebookR\bnotebook\"v\n" +
+	"\x15DeleteNotebookRequest\x12\x1d\n" +
+	"\x02id\x18\x01 \x01(\v2\r.api.types.IDR\x02id\x12>\n" +
+	"\x04mode\x18\x02 \x01(\x0e2 .api.notes.v1.NotebookDeleteModeB\b\xbaH\x05\x82\x01\x02\x10\x01R\x04mode\"\x18\n" +
+	"\x16DeleteNotebookResponse\"\x87\x02\n" +
+	"\bTemplate\x12\x1d\n" +
+	"\x02id\x18\x01 \x01(\v2\r.api.types.IDR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
+	"\x05title\x18\x03 \x01(\tR\x05title\x12\x18\n" +
+	"\acontent\x18\x04 \x01(\tR\acontent\x12\"\n" +
+	"\fplaceholders\x18\x05 \x03(\tR\fplaceholders\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"z\n" +
+	"\x15CreateTemplateRequest\x12\x1f\n" +
+	"\x04name\x18\x01 \x01(\tB\v\xbaH\b\xc8\x01\x01r\x03\x18\xff\x01R\x04name\x12\x1e\n" +
+	"\x05title\x18\x02 \x01(\tB\b\xbaH\x05r\x03\x18\xff\x01R\x05title\x12 \n" +
+	"\acontent\x18\x03 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\acontent\"L\n" +
+	"\x16CreateTemplateResponse\x122\n" +
+	"\btemplate\x18\x01 \x01(\v2\x16.api.notes.v1.TemplateR\btemplate\"3\n" +
+	"\x12GetTemplateRequest\x12\x1d\n" +
+	"\x02id\x18\x01 \x01(\v2\r.api.types.IDR\x02id\"I\n" +
+	"\x13GetTemplateResponse\x122\n" +
+	"\btemplate\x18\x01 \x01(\v2\x16.api.notes.v1.TemplateR\btemplate\"\x16\n" +
+	"\x14ListTemplatesRequest\"M\n" +
+	"\x15ListTemplatesResponse\x124\n" +
+	"\ttemplates\x18\x01 \x03(\v2\x16.api.notes.v1.TemplateR\ttemplates\"\xef\x01\n" +
+	"\x15UpdateTemplateRequest\x12\x1d\n" +
+	"\x02id\x18\x01 \x01(\v2\r.api.types.IDR\x02id\x12\x1f\n" +
+	"\x04name\x18\x02 \x01(\tB\v\xbaH\b\xd8\x01\x01r\x03\x18\xff\x01R\x04name\x12\x1e\n" +
+	"\x05title\x18\x03 \x01(\tB\b\xbaH\x05r\x03\x18\xff\x01R\x05title\x12\x18\n" +
+	"\acontent\x18\x04 \x01(\tR\acontent\x12\\\n" +
+	"\vupdate_mask\x18\x05 \x01(\v2\x1a.google.protobuf.FieldMaskB\x1f\xbaH\x1c\xc8\x01\x01\xe2\x01\x16\x12\x04name\x12\x05title\x12\acontentR\n" +
+	"updateMask\"L\n" +
+	"\x16UpdateTemplateResponse\x122\n" +
+	"\btemplate\x18\x01 \x01(\v2\x16.api.notes.v1.TemplateR\btemplate\"6\n" +
+	"\x15DeleteTemplateRequest\x12\x1d\n" +
+	"\x02id\x18\x01 \x01(\v2\r.api.types.IDR\x02id\"\x18\n" +
+	"\x16DeleteTemplateResponse\"\xbe\x01\n" +
+	"\rNoteReference\x12*\n" +
+	"\tsource_id\x18\x01 \x01(\v2\r.api.types.IDR\bsourceId\x12\x16\n" +
+	"\x06target\x18\x02 \x01(\tR\x06target\x12*\n" +
+	"\ttarget_id\x18\x03 \x01(\v2\r.api.types.IDR\btargetId\x12!\n" +
+	"\ftarget_title\x18\x04 \x01(\tR\vtargetTitle\x12\x1a\n" +
+	"\bdangling\x18\x05 \x01(\bR\bdangling\">\n" +
+	"\x14ListBacklinksRequest\x12&\n" +
+	"\anote_id\x18\x01 \x01(\v2\r.api.types.IDR\x06noteId\"A\n" +
+	"\x15ListBacklinksResponse\x12(\n" +
+	"\x05notes\x18\x01 \x03(\v2\x12.api.notes.v1.NoteR\x05notes\"B\n" +
+	"\x18ListOutgoingLinksRequest\x12&\n" +
+	"\anote_id\x18\x01 \x01(\v2\r.api.types.IDR\x06noteId\"N\n" +
+	"\x19ListOutgoingLinksResponse\x121\n" +
+	"\x05links\x18\x01 \x03(\v2\x1b.api.notes.v1.NoteReferenceR\x05links\"\x1a\n" +
+	"\x18ListDanglingLinksRequest\"N\n" +
+	"\x19ListDanglingLinksResponse\x121\n" +
+	"\x05links\x18\x01 \x03(\v2\x1b.api.notes.v1.NoteReferenceR\x05links\"\xc5\x01\n" +
+	"\tNoteShare\x12&\n" +
+	"\anote_id\x18\x01 \x01(\v2\r.api.types.IDR\x06noteId\x12\x14\n" +
+	"\x05email\x18\x02 \x01(\tR\x05email\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12+\n" +
+	"\x04role\x18\x04 \x01(\x0e2\x17.api.notes.v1.ShareRoleR\x04role\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\x9a\x01\n" +
+	"\n" +
+	"SharedNote\x12&\n" +
+	"\x04note\x18\x01 \x01(\v2\x12.api.notes.v1.NoteR\x04note\x12+\n" +
+	"\x04role\x18\x02 \x01(\x0e2\x17.api.notes.v1.ShareRoleR\x04role\x127\n" +
+	"\tshared_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\bsharedAt\"\x94\x01\n" +
+	"\x10ShareNoteRequest\x12&\n" +
+	"\anote_id\x18\x01 \x01(\v2\r.api.types.IDR\x06noteId\x12\x1f\n" +
+	"\x05email\x18\x02 \x01(\tB\t\xbaH\x06r\x04\x10\x01`\x01R\x05email\x127\n" +
+	"\x04role\x18\x03 \x01(\x0e2\x17.api.notes.v1.ShareRoleB\n" +
+	"\xbaH\a\x82\x01\x04\x10\x01 \x00R\x04role\"B\n" +
+	"\x11ShareNoteResponse\x12-\n" +
+	"\x05share\x18\x01 \x01(\v2\x17.api.notes.v1.NoteShareR\x05share\"]\n" +
+	"\x12UnshareNoteRequest\x12&\n" +
+	"\anote_id\x18\x01 \x01(\v2\r.api.types.IDR\x06noteId\x12\x1f\n" +
+	"\x05email\x18\x02 \x01(\tB\t\xbaH\x06r\x04\x10\x01`\x01R\x05email\"\x15\n" +
+	"\x13UnshareNoteResponse\"?\n" +
+	"\x15ListNoteSharesRequest\x12&\n" +
+	"\anote_id\x18\x01 \x01(\v2\r.api.types.IDR\x06noteId\"I\n" +
+	"\x16ListNoteSharesResponse\x12/\n" +
+	"\x06shares\x18\x01 \x03(\v2\x17.api.notes.v1.NoteShareR\x06shares\"_\n" +
+	"\x16ListSharedNotesRequest\x12&\n" +
+	"\tpage_size\x18\x01 \x01(\x05B\t\xbaH\x06\x1a\x04\x18d(\x00R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\"\x90\x01\n" +
+	"\x17ListSharedNotesResponse\x12.\n" +
+	"\x05notes\x18\x01 \x03(\v2\x18.api.notes.v1.SharedNoteR\x05notes\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12\x1d\n" +
+	"\n" +
+	"total_size\x18\x03 \x01(\x05R\ttotalSize\"\xd5\x01\n" +
+	"\tShareLink\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12&\n" +
+	"\anote_id\x18\x02 \x01(\v2\r.api.types.IDR\x06noteId\x12\x14\n" +
+	"\x05views\x18\x03 \x01(\x03R\x05views\x129\n" +
+	"\n" +
+	"expires_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"w\n" +
+	"\x16CreateShareLinkRequest\x12&\n" +
+	"\anote_id\x18\x01 \x01(\v2\r.api.types.IDR\x06noteId\x125\n" +
+	"\x03ttl\x18\x02 \x01(\v2\x19.google.protobuf.DurationB\b\xbaH\x05\xaa\x01\x02*\x00R\x03ttl\"F\n" +
+	"\x17CreateShareLinkResponse\x12+\n" +
+	"\x04link\x18\x01 \x01(\v2\x17.api.notes.v1.ShareLinkR\x04link\"a\n" +
+	"\x16RevokeShareLinkRequest\x12&\n" +
+	"\anote_id\x18\x01 \x01(\v2\r.api.types.IDR\x06noteId\x12\x1f\n" +
+	"\x05token\x18\x02 \x01(\tB\t\xbaH\x06r\x04\x10\x01\x18@R\x05token\"\x19\n" +
+	"\x17RevokeShareLinkResponse\"?\n" +
+	"\x15ListShareLinksRequest\x12&\n" +
+	"\anote_id\x18\x01 \x01(\v2\r.api.types.IDR\x06noteId\"G\n" +
+	"\x16ListShareLinksResponse\x12-\n" +
+	"\x05links\x18\x01 \x03(\v2\x17.api.notes.v1.ShareLinkR\x05links\"\x92\x03\n" +
+	"\bReminder\x12\x1d\n" +
+	"\x02id\x18\x01 \x01(\v2\r.api.types.IDR\x02id\x12&\n" +
+	"\anote_id\x18\x02 \x01(\v2\r.api.types.IDR\x06noteId\x123\n" +
+	"\afire_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x06fireAt\x125\n" +
+	"\bfired_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\afiredAt\x12\x1e\n" +
+	"\n" +
+	"recurrence\x18\x05 \x01(\tR\n" +
+	"recurrence\x12\x1b\n" +
+	"\ttime_zone\x18\x06 \x01(\tR\btimeZone\x12 \n" +
+	"\voccurrences\x18\a \x01(\x05R\voccurrences\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xcc\x01\n" +
+	"\x15CreateReminderRequest\x12&\n" +
+	"\anote_id\x18\x01 \x01(\v2\r.api.types.IDR\x06noteId\x12;\n" +
+	"\afire_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampB\x06\xbaH\x03\xc8\x01\x01R\x06fireAt\x12(\n" +
+	"\n" +
+	"recurrence\x18\x03 \x01(\tB\b\xbaH\x05r\x03\x18\x80\x02R\n" +
+	"recurrence\x12$\n" +
+	"\ttime_zone\x18\x04 \x01(\tB\a\xbaH\x04r\x02\x18@R\btimeZone\"L\n" +
+	"\x16CreateReminderResponse\x122\n" +
+	"\breminder\x18\x01 \x01(\v2\x16.api.notes.v1.ReminderR\breminder\">\n" +
+	"\x14ListRemindersRequest\x12&\n" +
+	"\anote_id\x18\x01 \x01(\v2\r.api.types.IDR\x06noteId\"M\n" +
+	"\x15ListRemindersResponse\x124\n" +
+	"\treminders\x18\x01 \x03(\v2\x16.api.notes.v1.ReminderR\treminders\"\xcb\x02\n" +
+	"\x15UpdateReminderRequest\x12&\n" +
+	"\anote_id\x18\x01 \x01(\v2\r.api.types.IDR\x06noteId\x12\x1d\n" +
+	"\x02id\x18\x02 \x01(\v2\r.api.types.IDR\x02id\x123\n" +
+	"\afire_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x06fireAt\x12(\n" +
+	"\n" +
+	"recurrence\x18\x04 \x01(\tB\b\xbaH\x05r\x03\x18\x80\x02R\n" +
+	"recurrence\x12$\n" +
+	"\ttime_zone\x18\x05 \x01(\tB\a\xbaH\x04r\x02\x18@R\btimeZone\x12f\n" +
+	"\vupdate_mask\x18\x06 \x01(\v2\x1a.google.protobuf.FieldMaskB)\xbaH&\xc8\x01\x01\xe2\x01 \x12\afire_at\x12\n" +
+	"recurrence\x12\ttime_zoneR\n" +
+	"updateMask\"L\n" +
+	"\x16UpdateReminderResponse\x122\n" +
+	"\breminder\x18\x01 \x01(\v2\x16.api.notes.v1.ReminderR\breminder\"^\n" +
+	"\x15DeleteReminderRequest\x12&\n" +
+	"\anote_id\x18\x01 \x01(\v2\r.api.types.IDR\x06noteId\x12\x1d\n" +
+	"\x02id\x18\x02 \x01(\v2\r.api.types.IDR\x02id\"\x18\n" +
+	"\x16DeleteReminderResponse\"7\n" +
+	"\x14GetPublicNoteRequest\x12\x1f\n" +
+	"\x05token\x18\x01 \x01(\tB\t\xbaH\x06r\x04\x10\x01\x18@R\x05token\"U\n" +
+	"\x15GetPublicNoteResponse\x12&\n" +
+	"\x04note\x18\x01 \x01(\v2\x12.api.notes.v1.NoteR\x04note\x12\x14\n" +
+	"\x05views\x18\x02 \x01(\x03R\x05views\":\n" +
+	"\x17RenderPublicNoteRequest\x12\x1f\n" +
+	"\x05token\x18\x01 \x01(\tB\t\xbaH\x06r\x04\x10\x01\x18@R\x05token\"\xf8\x01\n" +
 	"\x05Event\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12+\n" +
 	"\x04type\x18\x02 \x01(\x0e2\x17.api.notes.v1.EventTypeR\x04type\x12&\n" +
 	"\anote_id\x18\x03 \x01(\v2\r.api.types.IDR\x06noteId\x129\n" +
 	"\n" +
-	"event_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\teventTime\" \n" +
+	"event_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\teventTime\x12\x1a\n" +
+	"\bposition\x18\x05 \x01(\tR\bposition\x123\n" +
+	"\aread_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\x06readAt\"\xa7\x01\n" +
+	"\vEventFilter\x12@\n" +
+	"\x05types\x18\x01 \x03(\x0e2\x17.api.notes.v1.EventTypeB\x11\xbaH\x0e\x92\x01\v\x10\x10\"\a\x82\x01\x04\x10\x01 \x00R\x05types\x122\n" +
+	"\bnote_ids\x18\x02 \x03(\v2\r.api.types.IDB\b\xbaH\x05\x92\x01\x02\x10dR\anoteIds\x12\"\n" +
+	"\x04tags\x18\x03 \x03(\tB\x0e\xbaH\v\x92\x01\b\x10\x14\"\x04r\x02\x18@R\x04tags\" \n" +
 	"\x06Unread\x12\x16\n" +
-	"\x06events\x18\x01 \x01(\x05R\x06events\"\x1a\n" +
-	"\x18SubscribeToEventsRequest\"\x83\x01\n" +
+	"\x06events\x18\x01 \x01(\x05R\x06events\"\xae\x01\n" +
+	"\x11ListEventsRequest\x12&\n" +
+	"\tpage_size\x18\x01 \x01(\x05B\t\xbaH\x06\x1a\x04\x18d(\x00R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\x121\n" +
+	"\x06filter\x18\x03 \x01(\v2\x19.api.notes.v1.EventFilterR\x06filter\x12\x1f\n" +
+	"\vunread_only\x18\x04 \x01(\bR\n" +
+	"unreadOnly\"\x81\x01\n" +
+	"\x12ListEventsResponse\x12+\n" +
+	"\x06events\x18\x01 \x03(\v2\x13.api.notes.v1.EventR\x06events\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12\x16\n" +
+	"\x06unread\x18\x03 \x01(\x05R\x06unread\"k\n" +
+	"\x15MarkEventsReadRequest\x12!\n" +
+	"\x03ids\x18\x01 \x03(\tB\x0f\xbaH\f\x92\x01\t\x10d\"\x05r\x03\xb0\x01\x01R\x03ids\x12/\n" +
+	"\x05up_to\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x04upTo\"H\n" +
+	"\x16MarkEventsReadResponse\x12\x16\n" +
+	"\x06marked\x18\x01 \x01(\x05R\x06marked\x12\x16\n" +
+	"\x06unread\x18\x02 \x01(\x05R\x06unread\";\n" +
+	"\tHeartbeat\x12.\n" +
+	"\x04time\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x04time\"\xa9\x01\n" +
+	"\x18SubscribeToEventsRequest\x129\n" +
+	"\x06device\x18\x01 \x01(\tB!\xbaH\x1e\xd8\x01\x01r\x19\x18@2\x15^[A-Za-z0-9_-]{1,64}$R\x06device\x12\x1f\n" +
+	"\vresume_from\x18\x02 \x01(\tR\n" +
+	"resumeFrom\x121\n" +
+	"\x06filter\x18\x03 \x01(\v2\x19.api.notes.v1.EventFilterR\x06filter\"\xbc\x01\n" +
 	"\x19SubscribeToEventsResponse\x12+\n" +
 	"\x05event\x18\x01 \x01(\v2\x13.api.notes.v1.EventH\x00R\x05event\x12.\n" +
-	"\x06unread\x18\x02 \x01(\v2\x14.api.notes.v1.UnreadH\x00R\x06unreadB\t\n" +
-	"\apayload*S\n" +
+	"\x06unread\x18\x02 \x01(\v2\x14.api.notes.v1.UnreadH\x00R\x06unread\x127\n" +
+	"\theartbeat\x18\x03 \x01(\v2\x17.api.notes.v1.HeartbeatH\x00R\theartbeatB\t\n" +
+	"\apayload*u\n" +
+	"\rContentFormat\x12\x1a\n" +
+	"\x16CONTENT_FORMAT_UNKNOWN\x10\x00\x12\x16\n" +
+	"\x12CONTENT_FORMAT_RAW\x10\x01\x12\x17\n" +
+	"\x13CONTENT_FORMAT_HTML\x10\x02\x12\x17\n" +
+	"\x13CONTENT_FORMAT_TEXT\x10\x03*{\n" +
+	"\rDiffOperation\x12\x1a\n" +
+	"\x16DIFF_OPERATION_UNKNOWN\x10\x00\x12\x18\n" +
+	"\x14DIFF_OPERATION_EQUAL\x10\x01\x12\x19\n" +
+	"\x15DIFF_OPERATION_INSERT\x10\x02\x12\x19\n" +
+	"\x15DIFF_OPERATION_DELETE\x10\x03*k\n" +
+	"\rArchiveFormat\x12\x1a\n" +
+	"\x16ARCHIVE_FORMAT_UNKNOWN\x10\x00\x12\x1d\n" +
+	"\x19ARCHIVE_FORMAT_JSON_LINES\x10\x01\x12\x1f\n" +
+	"\x1bARCHIVE_FORMAT_MARKDOWN_ZIP\x10\x02*{\n" +
+	"\fImportStatus\x12\x19\n" +
+	"\x15IMPORT_STATUS_UNKNOWN\x10\x00\x12\x19\n" +
+	"\x15IMPORT_STATUS_CREATED\x10\x01\x12\x1b\n" +
+	"\x17IMPORT_STATUS_DUPLICATE\x10\x02\x12\x18\n" +
+	"\x14IMPORT_STATUS_FAILED\x10\x03*\x7f\n" +
+	"\x12NotebookDeleteMode\x12 \n" +
+	"\x1cNOTEBOOK_DELETE_MODE_UNKNOWN\x10\x00\x12%\n" +
+	"!NOTEBOOK_DELETE_MODE_MOVE_TO_ROOT\x10\x01\x12 \n" +
+	"\x1cNOTEBOOK_DELETE_MODE_CASCADE\x10\x02*Q\n" +
+	"\tShareRole\x12\x16\n" +
+	"\x12SHARE_ROLE_UNKNOWN\x10\x00\x12\x15\n" +
+	"\x11SHARE_ROLE_VIEWER\x10\x01\x12\x15\n" +
+	"\x11SHARE_ROLE_EDITOR\x10\x02*\xe4\x01\n" +
 	"\tEventType\x12\x16\n" +
 	"\x12EVENT_TYPE_UNKNOWN\x10\x00\x12\x16\n" +
 	"\x12EVENT_TYPE_CREATED\x10\x01\x12\x16\n" +
-	"\x12EVENT_TYPE_DELETED\x10\x02B3Z1github.com/therenotomorrow/gotes/pkg/api/notes/v1b\x06proto3"
+	"\x12EVENT_TYPE_DELETED\x10\x02\x12\x16\n" +
+	"\x12EVENT_TYPE_UPDATED\x10\x03\x12\x17\n" +
+	"\x13EVENT_TYPE_RESTORED\x10\x04\x12\x15\n" +
+	"\x11EVENT_TYPE_PURGED\x10\x05\x12\x15\n" +
+	"\x11EVENT_TYPE_SHARED\x10\x06\x12\x17\n" +
+	"\x13EVENT_TYPE_UNSHARED\x10\a\x12\x17\n" +
+	"\x13EVENT_TYPE_REMINDER\x10\bB3Z1github.com/therenotomorrow/gotes/pkg/api/notes/v1b\x06proto3"
 
 var (
 	file_api_notes_v1_messages_proto_rawDescOnce sync.Once
@@ -822,45 +9420,351 @@ func file_api_notes_v1_messages_proto_rawDescGZIP() []byte {
 	return file_api_notes_v1_messages_proto_rawDescData
 }
 
-var file_api_notes_v1_messages_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_api_notes_v1_messages_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
+var file_api_notes_v1_messages_proto_enumTypes = make([]protoimpl.EnumInfo, 7)
+var file_api_notes_v1_messages_proto_msgTypes = make([]protoimpl.MessageInfo, 143)
 var file_api_notes_v1_messages_proto_goTypes = []any{
-	(EventType)(0),                    // 0: api.notes.v1.EventType
-	(*Note)(nil),                      // 1: api.notes.v1.Note
-	(*ListNotesRequest)(nil),          // 2: api.notes.v1.ListNotesRequest
-	(*ListNotesResponse)(nil),         // 3: api.notes.v1.ListNotesResponse
-	(*RetrieveNoteRequest)(nil),       // 4: api.notes.v1.RetrieveNoteRequest
-	(*RetrieveNoteResponse)(nil),      // 5: api.notes.v1.RetrieveNoteResponse
-	(*CreateNoteRequest)(nil),         // 6: api.notes.v1.CreateNoteRequest
-	(*CreateNoteResponse)(nil),        // 7: api.notes.v1.CreateNoteResponse
-	(*DeleteNoteRequest)(nil),         // 8: api.notes.v1.DeleteNoteRequest
-	(*DeleteNoteResponse)(nil),        // 9: api.notes.v1.DeleteNoteResponse
-	(*Event)(nil),                     // 10: api.notes.v1.Event
-	(*Unread)(nil),                    // 11: api.notes.v1.Unread
-	(*SubscribeToEventsRequest)(nil),  // 12: api.notes.v1.SubscribeToEventsRequest
-	(*SubscribeToEventsResponse)(nil), // 13: api.notes.v1.SubscribeToEventsResponse
-	(*types.ID)(nil),                  // 14: api.types.ID
-	(*timestamppb.Timestamp)(nil),     // 15: google.protobuf.Timestamp
+	(ContentFormat)(0),                  // 0: api.notes.v1.ContentFormat
+	(DiffOperation)(0),                  // 1: api.notes.v1.DiffOperation
+	(ArchiveFormat)(0),                  // 2: api.notes.v1.ArchiveFormat
+	(ImportStatus)(0),                   // 3: api.notes.v1.ImportStatus
+	(NotebookDeleteMode)(0),             // 4: api.notes.v1.NotebookDeleteMode
+	(ShareRole)(0),                      // 5: api.notes.v1.ShareRole
+	(EventType)(0),                      // 6: api.notes.v1.EventType
+	(*Note)(nil),                        // 7: api.notes.v1.Note
+	(*ListNotesRequest)(nil),            // 8: api.notes.v1.ListNotesRequest
+	(*ListNotesResponse)(nil),           // 9: api.notes.v1.ListNotesResponse
+	(*SearchNotesRequest)(nil),          // 10: api.notes.v1.SearchNotesRequest
+	(*SearchHit)(nil),                   // 11: api.notes.v1.SearchHit
+	(*SearchNotesResponse)(nil),         // 12: api.notes.v1.SearchNotesResponse
+	(*RetrieveNoteRequest)(nil),         // 13: api.notes.v1.RetrieveNoteRequest
+	(*RetrieveNoteResponse)(nil),        // 14: api.notes.v1.RetrieveNoteResponse
+	(*CreateNoteRequest)(nil),           // 15: api.notes.v1.CreateNoteRequest
+	(*CreateNoteResponse)(nil),          // 16: api.notes.v1.CreateNoteResponse
+	(*UpdateNoteRequest)(nil),           // 17: api.notes.v1.UpdateNoteRequest
+	(*UpdateNoteResponse)(nil),          // 18: api.notes.v1.UpdateNoteResponse
+	(*EditComponent)(nil),               // 19: api.notes.v1.EditComponent
+	(*EditOperation)(nil),               // 20: api.notes.v1.EditOperation
+	(*EditCursor)(nil),                  // 21: api.notes.v1.EditCursor
+	(*Draft)(nil),                       // 22: api.notes.v1.Draft
+	(*EditAck)(nil),                     // 23: api.notes.v1.EditAck
+	(*RemoteEdit)(nil),                  // 24: api.notes.v1.RemoteEdit
+	(*RemoteCursor)(nil),                // 25: api.notes.v1.RemoteCursor
+	(*EditNoteRequest)(nil),             // 26: api.notes.v1.EditNoteRequest
+	(*EditNoteResponse)(nil),            // 27: api.notes.v1.EditNoteResponse
+	(*DeleteNoteRequest)(nil),           // 28: api.notes.v1.DeleteNoteRequest
+	(*DeleteNoteResponse)(nil),          // 29: api.notes.v1.DeleteNoteResponse
+	(*ListTrashedNotesRequest)(nil),     // 30: api.notes.v1.ListTrashedNotesRequest
+	(*ListTrashedNotesResponse)(nil),    // 31: api.notes.v1.ListTrashedNotesResponse
+	(*RestoreNoteRequest)(nil),          // 32: api.notes.v1.RestoreNoteRequest
+	(*RestoreNoteResponse)(nil),         // 33: api.notes.v1.RestoreNoteResponse
+	(*PurgeNoteRequest)(nil),            // 34: api.notes.v1.PurgeNoteRequest
+	(*PurgeNoteResponse)(nil),           // 35: api.notes.v1.PurgeNoteResponse
+	(*Tag)(nil),                         // 36: api.notes.v1.Tag
+	(*TagCount)(nil),                    // 37: api.notes.v1.TagCount
+	(*AddNoteTagsRequest)(nil),          // 38: api.notes.v1.AddNoteTagsRequest
+	(*AddNoteTagsResponse)(nil),         // 39: api.notes.v1.AddNoteTagsResponse
+	(*RemoveNoteTagsRequest)(nil),       // 40: api.notes.v1.RemoveNoteTagsRequest
+	(*RemoveNoteTagsResponse)(nil),      // 41: api.notes.v1.RemoveNoteTagsResponse
+	(*ListTagsRequest)(nil),             // 42: api.notes.v1.ListTagsRequest
+	(*ListTagsResponse)(nil),            // 43: api.notes.v1.ListTagsResponse
+	(*RenameTagRequest)(nil),            // 44: api.notes.v1.RenameTagRequest
+	(*RenameTagResponse)(nil),           // 45: api.notes.v1.RenameTagResponse
+	(*NoteRevision)(nil),                // 46: api.notes.v1.NoteRevision
+	(*ListNoteRevisionsRequest)(nil),    // 47: api.notes.v1.ListNoteRevisionsRequest
+	(*ListNoteRevisionsResponse)(nil),   // 48: api.notes.v1.ListNoteRevisionsResponse
+	(*GetNoteRevisionRequest)(nil),      // 49: api.notes.v1.GetNoteRevisionRequest
+	(*GetNoteRevisionResponse)(nil),     // 50: api.notes.v1.GetNoteRevisionResponse
+	(*DiffLine)(nil),                    // 51: api.notes.v1.DiffLine
+	(*DiffNoteRevisionsRequest)(nil),    // 52: api.notes.v1.DiffNoteRevisionsRequest
+	(*DiffNoteRevisionsResponse)(nil),   // 53: api.notes.v1.DiffNoteRevisionsResponse
+	(*RestoreNoteRevisionRequest)(nil),  // 54: api.notes.v1.RestoreNoteRevisionRequest
+	(*RestoreNoteRevisionResponse)(nil), // 55: api.notes.v1.RestoreNoteRevisionResponse
+	(*BatchNoteResult)(nil),             // 56: api.notes.v1.BatchNoteResult
+	(*BatchCreateNotesRequest)(nil),     // 57: api.notes.v1.BatchCreateNotesRequest
+	(*BatchCreateNotesResponse)(nil),    // 58: api.notes.v1.BatchCreateNotesResponse
+	(*BatchDeleteNotesRequest)(nil),     // 59: api.notes.v1.BatchDeleteNotesRequest
+	(*BatchDeleteNotesResponse)(nil),    // 60: api.notes.v1.BatchDeleteNotesResponse
+	(*BatchGetNotesRequest)(nil),        // 61: api.notes.v1.BatchGetNotesRequest
+	(*BatchGetNotesResponse)(nil),       // 62: api.notes.v1.BatchGetNotesResponse
+	(*ExportNotesRequest)(nil),          // 63: api.notes.v1.ExportNotesRequest
+	(*ExportNotesResponse)(nil),         // 64: api.notes.v1.ExportNotesResponse
+	(*ImportNotesRequest)(nil),          // 65: api.notes.v1.ImportNotesRequest
+	(*ImportNoteResult)(nil),            // 66: api.notes.v1.ImportNoteResult
+	(*ImportNotesResponse)(nil),         // 67: api.notes.v1.ImportNotesResponse
+	(*Attachment)(nil),                  // 68: api.notes.v1.Attachment
+	(*AttachmentHeader)(nil),            // 69: api.notes.v1.AttachmentHeader
+	(*UploadAttachmentRequest)(nil),     // 70: api.notes.v1.UploadAttachmentRequest
+	(*UploadAttachmentResponse)(nil),    // 71: api.notes.v1.UploadAttachmentResponse
+	(*DownloadAttachmentRequest)(nil),   // 72: api.notes.v1.DownloadAttachmentRequest
+	(*DownloadAttachmentResponse)(nil),  // 73: api.notes.v1.DownloadAttachmentResponse
+	(*ListAttachmentsRequest)(nil),      // 74: api.notes.v1.ListAttachmentsRequest
+	(*ListAttachmentsResponse)(nil),     // 75: api.notes.v1.ListAttachmentsResponse
+	(*DeleteAttachmentRequest)(nil),     // 76: api.notes.v1.DeleteAttachmentRequest
+	(*DeleteAttachmentResponse)(nil),    // 77: api.notes.v1.DeleteAttachmentResponse
+	(*MoveNoteRequest)(nil),             // 78: api.notes.v1.MoveNoteRequest
+	(*MoveNoteResponse)(nil),            // 79: api.notes.v1.MoveNoteResponse
+	(*Notebook)(nil),                    // 80: api.notes.v1.Notebook
+	(*CreateNotebookRequest)(nil),       // 81: api.notes.v1.CreateNotebookRequest
+	(*CreateNotebookResponse)(nil),      // 82: api.notes.v1.CreateNotebookResponse
+	(*GetNotebookRequest)(nil),          // 83: api.notes.v1.GetNotebookRequest
+	(*GetNotebookResponse)(nil),         // 84: api.notes.v1.GetNotebookResponse
+	(*ListNotebooksRequest)(nil),        // 85: api.notes.v1.ListNotebooksRequest
+	(*ListNotebooksResponse)(nil),       // 86: api.notes.v1.ListNotebooksResponse
+	(*UpdateNotebookRequest)(nil),       // 87: api.notes.v1.UpdateNotebookRequest
+	(*UpdateNotebookResponse)(nil),      // 88: api.notes.v1.UpdateNotebookResponse
+	(*DeleteNotebookRequest)(nil),       // 89: api.notes.v1.DeleteNotebookRequest
+	(*DeleteNotebookResponse)(nil),      // 90: api.notes.v1.DeleteNotebookResponse
+	(*Template)(nil),                    // 91: api.notes.v1.Template
+	(*CreateTemplateRequest)(nil),       // 92: api.notes.v1.CreateTemplateRequest
+	(*CreateTemplateResponse)(nil),      // 93: api.notes.v1.CreateTemplateResponse
+	(*GetTemplateRequest)(nil),          // 94: api.notes.v1.GetTemplateRequest
+	(*GetTemplateResponse)(nil),         // 95: api.notes.v1.GetTemplateResponse
+	(*ListTemplatesRequest)(nil),        // 96: api.notes.v1.ListTemplatesRequest
+	(*ListTemplatesResponse)(nil),       // 97: api.notes.v1.ListTemplatesResponse
+	(*UpdateTemplateRequest)(nil),       // 98: api.notes.v1.UpdateTemplateRequest
+	(*UpdateTemplateResponse)(nil),      // 99: api.notes.v1.UpdateTemplateResponse
+	(*DeleteTemplateRequest)(nil),       // 100: api.notes.v1.DeleteTemplateRequest
+	(*DeleteTemplateResponse)(nil),      // 101: api.notes.v1.DeleteTemplateResponse
+	(*NoteReference)(nil),               // 102: api.notes.v1.NoteReference
+	(*ListBacklinksRequest)(nil),        // 103: api.notes.v1.ListBacklinksRequest
+	(*ListBacklinksResponse)(nil),       // 104: api.notes.v1.ListBacklinksResponse
+	(*ListOutgoingLinksRequest)(nil),    // 105: api.notes.v1.ListOutgoingLinksRequest
+	(*ListOutgoingLinksResponse)(nil),   // 106: api.notes.v1.ListOutgoingLinksResponse
+	(*ListDanglingLinksRequest)(nil),    // 107: api.notes.v1.ListDanglingLinksRequest
+	(*ListDanglingLinksResponse)(nil),   // 108: api.notes.v1.ListDanglingLinksResponse
+	(*NoteShare)(nil),                   // 109: api.notes.v1.NoteShare
+	(*SharedNote)(nil),                  // 110: api.notes.v1.SharedNote
+	(*ShareNoteRequest)(nil),            // 111: api.notes.v1.ShareNoteRequest
+	(*ShareNoteResponse)(nil),           // 112: api.notes.v1.ShareNoteResponse
+	(*UnshareNoteRequest)(nil),          // 113: api.notes.v1.UnshareNoteRequest
+	(*UnshareNoteResponse)(nil),         // 114: api.notes.v1.UnshareNoteResponse
+	(*ListNoteSharesRequest)(nil),       // 115: api.notes.v1.ListNoteSharesRequest
+	(*ListNoteSharesResponse)(nil),      // 116: api.notes.v1.ListNoteSharesResponse
+	(*ListSharedNotesRequest)(nil),      // 117: api.notes.v1.ListSharedNotesRequest
+	(*ListSharedNotesResponse)(nil),     // 118: api.notes.v1.ListSharedNotesResponse
+	(*ShareLink)(nil),                   // 119: api.notes.v1.ShareLink
+	(*CreateShareLinkRequest)(nil),      // 120: api.notes.v1.CreateShareLinkRequest
+	(*CreateShareLinkResponse)(nil),     // 121: api.notes.v1.CreateShareLinkResponse
+	(*RevokeShareLinkRequest)(nil),      // 122: api.notes.v1.RevokeShareLinkRequest
+	(*RevokeShareLinkResponse)(nil),     // 123: api.notes.v1.RevokeShareLinkResponse
+	(*ListShareLinksRequest)(nil),       // 124: api.notes.v1.ListShareLinksRequest
+	(*ListShareLinksResponse)(nil),      // 125: api.notes.v1.ListShareLinksResponse
+	(*Reminder)(nil),                    // 126: api.notes.v1.Reminder
+	(*CreateReminderRequest)(nil),       // 127: api.notes.v1.CreateReminderRequest
+	(*CreateReminderResponse)(nil),      // 128: api.notes.v1.CreateReminderResponse
+	(*ListRemindersRequest)(nil),        // 129: api.notes.v1.ListRemindersRequest
+	(*ListRemindersResponse)(nil),       // 130: api.notes.v1.ListRemindersResponse
+	(*UpdateReminderRequest)(nil),       // 131: api.notes.v1.UpdateReminderRequest
+	(*UpdateReminderResponse)(nil),      // 132: api.notes.v1.UpdateReminderResponse
+	(*DeleteReminderRequest)(nil),       // 133: api.notes.v1.DeleteReminderRequest
+	(*DeleteReminderResponse)(nil),      // 134: api.notes.v1.DeleteReminderResponse
+	(*GetPublicNoteRequest)(nil),        // 135: api.notes.v1.GetPublicNoteRequest
+	(*GetPublicNoteResponse)(nil),       // 136: api.notes.v1.GetPublicNoteResponse
+	(*RenderPublicNoteRequest)(nil),     // 137: api.notes.v1.RenderPublicNoteRequest
+	(*Event)(nil),                       // 138: api.notes.v1.Event
+	(*EventFilter)(nil),                 // 139: api.notes.v1.EventFilter
+	(*Unread)(nil),                      // 140: api.notes.v1.Unread
+	(*ListEventsRequest)(nil),           // 141: api.notes.v1.ListEventsRequest
+	(*ListEventsResponse)(nil),          // 142: api.notes.v1.ListEventsResponse
+	(*MarkEventsReadRequest)(nil),       // 143: api.notes.v1.MarkEventsReadRequest
+	(*MarkEventsReadResponse)(nil),      // 144: api.notes.v1.MarkEventsReadResponse
+	(*Heartbeat)(nil),                   // 145: api.notes.v1.Heartbeat
+	(*SubscribeToEventsRequest)(nil),    // 146: api.notes.v1.SubscribeToEventsRequest
+	(*SubscribeToEventsResponse)(nil),   // 147: api.notes.v1.SubscribeToEventsResponse
+	nil,                                 // 148: api.notes.v1.CreateNoteRequest.VariablesEntry
+	nil,                                 // 149: api.notes.v1.BatchDeleteNotesRequest.VersionsEntry
+	(*types.ID)(nil),                    // 150: api.types.ID
+	(*timestamppb.Timestamp)(nil),       // 151: google.protobuf.Timestamp
+	(*fieldmaskpb.FieldMask)(nil),       // 152: google.protobuf.FieldMask
+	(*types.Error)(nil),                 // 153: api.types.Error
+	(*durationpb.Duration)(nil),         // 154: google.protobuf.Duration
 }
 var file_api_notes_v1_messages_proto_depIdxs = []int32{
-	14, // 0: api.notes.v1.Note.id:type_name -> api.types.ID
-	15, // 1: api.notes.v1.Note.created_at:type_name -> google.protobuf.Timestamp
-	15, // 2: api.notes.v1.Note.updated_at:type_name -> google.protobuf.Timestamp
-	1,  // 3: api.notes.v1.ListNotesResponse.notes:type_name -> api.notes.v1.Note
-	14, // 4: api.notes.v1.RetrieveNoteRequest.id:type_name -> api.types.ID
-	1,  // 5: api.notes.v1.RetrieveNoteResponse.note:type_name -> api.notes.v1.Note
-	1,  // 6: api.notes.v1.CreateNoteResponse.note:type_name -> api.notes.v1.Note
-	14, // 7: api.notes.v1.DeleteNoteRequest.id:type_name -> api.types.ID
-	0,  // 8: api.notes.v1.Event.type:type_name -> api.notes.v1.EventType
-	14, // 9: api.notes.v1.Event.note_id:type_name -> api.types.ID
-	15, // 10: api.notes.v1.Event.event_time:type_name -> google.protobuf.Timestamp
-	10, // 11: api.notes.v1.SubscribeToEventsResponse.event:type_name -> api.notes.v1.Event
-	11, // 12: api.notes.v1.SubscribeToEventsResponse.unread:type_name -> api.notes.v1.Unread
-	13, // [13:13] is the sub-list for method output_type
-	13, // [13:13] is the sub-list for method input_type
-	13, // [13:13] is the sub-list for extension type_name
-	13, // [13:13] is the sub-list for extension extendee
-	0,  // [0:13] is the sub-list for field type_name
+	150, // 0: api.notes.v1.Note.id:type_name -> api.types.ID
+	151, // 1: api.notes.v1.Note.created_at:type_name -> google.protobuf.Timestamp
+	151, // 2: api.notes.v1.Note.updated_at:type_name -> google.protobuf.Timestamp
+	151, // 3: api.notes.v1.Note.deleted_at:type_name -> google.protobuf.Timestamp
+	150, // 4: api.notes.v1.Note.notebook_id:type_name -> api.types.ID
+	151, // 5: api.notes.v1.ListNotesRequest.created_after:type_name -> google.protobuf.Timestamp
+	151, // 6: api.notes.v1.ListNotesRequest.created_before:type_name -> google.protobuf.Timestamp
+	151, // 7: api.notes.v1.ListNotesRequest.updated_after:type_name -> google.protobuf.Timestamp
+	151, // 8: api.notes.v1.ListNotesRequest.updated_before:type_name -> google.protobuf.Timestamp
+	150, // 9: api.notes.v1.ListNotesRequest.notebook_id:type_name -> api.types.ID
+	0,   // 10: api.notes.v1.ListNotesRequest.format:type_name -> api.notes.v1.ContentFormat
+	7,   // 11: api.notes.v1.ListNotesResponse.notes:type_name -> api.notes.v1.Note
+	7,   // 12: api.notes.v1.SearchHit.note:type_name -> api.notes.v1.Note
+	11,  // 13: api.notes.v1.SearchNotesResponse.hits:type_name -> api.notes.v1.SearchHit
+	150, // 14: api.notes.v1.RetrieveNoteRequest.id:type_name -> api.types.ID
+	0,   // 15: api.notes.v1.RetrieveNoteRequest.format:type_name -> api.notes.v1.ContentFormat
+	7,   // 16: api.notes.v1.RetrieveNoteResponse.note:type_name -> api.notes.v1.Note
+	150, // 17: api.notes.v1.CreateNoteRequest.notebook_id:type_name -> api.types.ID
+	150, // 18: api.notes.v1.CreateNoteRequest.template_id:type_name -> api.types.ID
+	148, // 19: api.notes.v1.CreateNoteRequest.variables:type_name -> api.notes.v1.CreateNoteRequest.VariablesEntry
+	7,   // 20: api.notes.v1.CreateNoteResponse.note:type_name -> api.notes.v1.Note
+	150, // 21: api.notes.v1.UpdateNoteRequest.id:type_name -> api.types.ID
+	152, // 22: api.notes.v1.UpdateNoteRequest.update_mask:type_name -> google.protobuf.FieldMask
+	7,   // 23: api.notes.v1.UpdateNoteResponse.note:type_name -> api.notes.v1.Note
+	19,  // 24: api.notes.v1.EditOperation.components:type_name -> api.notes.v1.EditComponent
+	150, // 25: api.notes.v1.RemoteEdit.user_id:type_name -> api.types.ID
+	20,  // 26: api.notes.v1.RemoteEdit.operation:type_name -> api.notes.v1.EditOperation
+	150, // 27: api.notes.v1.RemoteCursor.user_id:type_name -> api.types.ID
+	21,  // 28: api.notes.v1.RemoteCursor.cursor:type_name -> api.notes.v1.EditCursor
+	150, // 29: api.notes.v1.EditNoteRequest.note_id:type_name -> api.types.ID
+	20,  // 30: api.notes.v1.EditNoteRequest.operation:type_name -> api.notes.v1.EditOperation
+	21,  // 31: api.notes.v1.EditNoteRequest.cursor:type_name -> api.notes.v1.EditCursor
+	22,  // 32: api.notes.v1.EditNoteResponse.draft:type_name -> api.notes.v1.Draft
+	23,  // 33: api.notes.v1.EditNoteResponse.ack:type_name -> api.notes.v1.EditAck
+	24,  // 34: api.notes.v1.EditNoteResponse.edit:type_name -> api.notes.v1.RemoteEdit
+	25,  // 35: api.notes.v1.EditNoteResponse.cursor:type_name -> api.notes.v1.RemoteCursor
+	150, // 36: api.notes.v1.DeleteNoteRequest.id:type_name -> api.types.ID
+	7,   // 37: api.notes.v1.ListTrashedNotesResponse.notes:type_name -> api.notes.v1.Note
+	150, // 38: api.notes.v1.RestoreNoteRequest.id:type_name -> api.types.ID
+	7,   // 39: api.notes.v1.RestoreNoteResponse.note:type_name -> api.notes.v1.Note
+	150, // 40: api.notes.v1.PurgeNoteRequest.id:type_name -> api.types.ID
+	151, // 41: api.notes.v1.Tag.created_at:type_name -> google.protobuf.Timestamp
+	36,  // 42: api.notes.v1.TagCount.tag:type_name -> api.notes.v1.Tag
+	150, // 43: api.notes.v1.AddNoteTagsRequest.note_id:type_name -> api.types.ID
+	7,   // 44: api.notes.v1.AddNoteTagsResponse.note:type_name -> api.notes.v1.Note
+	150, // 45: api.notes.v1.RemoveNoteTagsRequest.note_id:type_name -> api.types.ID
+	7,   // 46: api.notes.v1.RemoveNoteTagsResponse.note:type_name -> api.notes.v1.Note
+	37,  // 47: api.notes.v1.ListTagsResponse.tags:type_name -> api.notes.v1.TagCount
+	36,  // 48: api.notes.v1.RenameTagResponse.tag:type_name -> api.notes.v1.Tag
+	150, // 49: api.notes.v1.NoteRevision.id:type_name -> api.types.ID
+	150, // 50: api.notes.v1.NoteRevision.note_id:type_name -> api.types.ID
+	151, // 51: api.notes.v1.NoteRevision.created_at:type_name -> google.protobuf.Timestamp
+	150, // 52: api.notes.v1.ListNoteRevisionsRequest.note_id:type_name -> api.types.ID
+	46,  // 53: api.notes.v1.ListNoteRevisionsResponse.revisions:type_name -> api.notes.v1.NoteRevision
+	150, // 54: api.notes.v1.GetNoteRevisionRequest.note_id:type_name -> api.types.ID
+	46,  // 55: api.notes.v1.GetNoteRevisionResponse.revision:type_name -> api.notes.v1.NoteRevision
+	1,   // 56: api.notes.v1.DiffLine.operation:type_name -> api.notes.v1.DiffOperation
+	150, // 57: api.notes.v1.DiffNoteRevisionsRequest.note_id:type_name -> api.types.ID
+	51,  // 58: api.notes.v1.DiffNoteRevisionsResponse.lines:type_name -> api.notes.v1.DiffLine
+	150, // 59: api.notes.v1.RestoreNoteRevisionRequest.note_id:type_name -> api.types.ID
+	7,   // 60: api.notes.v1.RestoreNoteRevisionResponse.note:type_name -> api.notes.v1.Note
+	46,  // 61: api.notes.v1.RestoreNoteRevisionResponse.revision:type_name -> api.notes.v1.NoteRevision
+	150, // 62: api.notes.v1.BatchNoteResult.id:type_name -> api.types.ID
+	7,   // 63: api.notes.v1.BatchNoteResult.note:type_name -> api.notes.v1.Note
+	153, // 64: api.notes.v1.BatchNoteResult.error:type_name -> api.types.Error
+	15,  // 65: api.notes.v1.BatchCreateNotesRequest.notes:type_name -> api.notes.v1.CreateNoteRequest
+	56,  // 66: api.notes.v1.BatchCreateNotesResponse.results:type_name -> api.notes.v1.BatchNoteResult
+	150, // 67: api.notes.v1.BatchDeleteNotesRequest.ids:type_name -> api.types.ID
+	149, // 68: api.notes.v1.BatchDeleteNotesRequest.versions:type_name -> api.notes.v1.BatchDeleteNotesRequest.VersionsEntry
+	56,  // 69: api.notes.v1.BatchDeleteNotesResponse.results:type_name -> api.notes.v1.BatchNoteResult
+	150, // 70: api.notes.v1.BatchGetNotesRequest.ids:type_name -> api.types.ID
+	56,  // 71: api.notes.v1.BatchGetNotesResponse.results:type_name -> api.notes.v1.BatchNoteResult
+	2,   // 72: api.notes.v1.ExportNotesRequest.format:type_name -> api.notes.v1.ArchiveFormat
+	2,   // 73: api.notes.v1.ImportNotesRequest.format:type_name -> api.notes.v1.ArchiveFormat
+	3,   // 74: api.notes.v1.ImportNoteResult.status:type_name -> api.notes.v1.ImportStatus
+	7,   // 75: api.notes.v1.ImportNoteResult.note:type_name -> api.notes.v1.Note
+	153, // 76: api.notes.v1.ImportNoteResult.error:type_name -> api.types.Error
+	66,  // 77: api.notes.v1.ImportNotesResponse.results:type_name -> api.notes.v1.ImportNoteResult
+	150, // 78: api.notes.v1.Attachment.id:type_name -> api.types.ID
+	150, // 79: api.notes.v1.Attachment.note_id:type_name -> api.types.ID
+	151, // 80: api.notes.v1.Attachment.created_at:type_name -> google.protobuf.Timestamp
+	150, // 81: api.notes.v1.AttachmentHeader.note_id:type_name -> api.types.ID
+	69,  // 82: api.notes.v1.UploadAttachmentRequest.header:type_name -> api.notes.v1.AttachmentHeader
+	68,  // 83: api.notes.v1.UploadAttachmentResponse.attachment:type_name -> api.notes.v1.Attachment
+	150, // 84: api.notes.v1.DownloadAttachmentRequest.id:type_name -> api.types.ID
+	68,  // 85: api.notes.v1.DownloadAttachmentResponse.attachment:type_name -> api.notes.v1.Attachment
+	150, // 86: api.notes.v1.ListAttachmentsRequest.note_id:type_name -> api.types.ID
+	68,  // 87: api.notes.v1.ListAttachmentsResponse.attachments:type_name -> api.notes.v1.Attachment
+	150, // 88: api.notes.v1.DeleteAttachmentRequest.id:type_name -> api.types.ID
+	150, // 89: api.notes.v1.MoveNoteRequest.id:type_name -> api.types.ID
+	150, // 90: api.notes.v1.MoveNoteRequest.notebook_id:type_name -> api.types.ID
+	7,   // 91: api.notes.v1.MoveNoteResponse.note:type_name -> api.notes.v1.Note
+	150, // 92: api.notes.v1.Notebook.id:type_name -> api.types.ID
+	150, // 93: api.notes.v1.Notebook.parent_id:type_name -> api.types.ID
+	151, // 94: api.notes.v1.Notebook.created_at:type_name -> google.protobuf.Timestamp
+	151, // 95: api.notes.v1.Notebook.updated_at:type_name -> google.protobuf.Timestamp
+	150, // 96: api.notes.v1.CreateNotebookRequest.parent_id:type_name -> api.types.ID
+	80,  // 97: api.notes.v1.CreateNotebookResponse.notebook:type_name -> api.notes.v1.Notebook
+	150, // 98: api.notes.v1.GetNotebookRequest.id:type_name -> api.types.ID
+	80,  // 99: api.notes.v1.GetNotebookResponse.notebook:type_name -> api.notes.v1.Notebook
+	80,  // 100: api.notes.v1.ListNotebooksResponse.notebooks:type_name -> api.notes.v1.Notebook
+	150, // 101: api.notes.v1.UpdateNotebookRequest.id:type_name -> api.types.ID
+	150, // 102: api.notes.v1.UpdateNotebookRequest.parent_id:type_name -> api.types.ID
+	152, // 103: api.notes.v1.UpdateNotebookRequest.update_mask:type_name -> google.protobuf.FieldMask
+	80,  // 104: api.notes.v1.UpdateNotebookResponse.notebook:type_name -> api.notes.v1.Notebook
+	150, // 105: api.notes.v1.DeleteNotebookRequest.id:type_name -> api.types.ID
+	4,   // 106: api.notes.v1.DeleteNotebookRequest.mode:type_name -> api.notes.v1.NotebookDeleteMode
+	150, // 107: api.notes.v1.Template.id:type_name -> api.types.ID
+	151, // 108: api.notes.v1.Template.created_at:type_name -> google.protobuf.Timestamp
+	151, // 109: api.notes.v1.Template.updated_at:type_name -> google.protobuf.Timestamp
+	91,  // 110: api.notes.v1.CreateTemplateResponse.template:type_name -> api.notes.v1.Template
+	150, // 111: api.notes.v1.GetTemplateRequest.id:type_name -> api.types.ID
+	91,  // 112: api.notes.v1.GetTemplateResponse.template:type_name -> api.notes.v1.Template
+	91,  // 113: api.notes.v1.ListTemplatesResponse.templates:type_name -> api.notes.v1.Template
+	150, // 114: api.notes.v1.UpdateTemplateRequest.id:type_name -> api.types.ID
+	152, // 115: api.notes.v1.UpdateTemplateRequest.update_mask:type_name -> google.protobuf.FieldMask
+	91,  // 116: api.notes.v1.UpdateTemplateResponse.template:type_name -> api.notes.v1.Template
+	150, // 117: api.notes.v1.DeleteTemplateRequest.id:type_name -> api.types.ID
+	150, // 118: api.notes.v1.NoteReference.source_id:type_name -> api.types.ID
+	150, // 119: api.notes.v1.NoteReference.target_id:type_name -> api.types.ID
+	150, // 120: api.notes.v1.ListBacklinksRequest.note_id:type_name -> api.types.ID
+	7,   // 121: api.notes.v1.ListBacklinksResponse.notes:type_name -> api.notes.v1.Note
+	150, // 122: api.notes.v1.ListOutgoingLinksRequest.note_id:type_name -> api.types.ID
+	102, // 123: api.notes.v1.ListOutgoingLinksResponse.links:type_name -> api.notes.v1.NoteReference
+	102, // 124: api.notes.v1.ListDanglingLinksResponse.links:type_name -> api.notes.v1.NoteReference
+	150, // 125: api.notes.v1.NoteShare.note_id:type_name -> api.types.ID
+	5,   // 126: api.notes.v1.NoteShare.role:type_name -> api.notes.v1.ShareRole
+	151, // 127: api.notes.v1.NoteShare.created_at:type_name -> google.protobuf.Timestamp
+	7,   // 128: api.notes.v1.SharedNote.note:type_name -> api.notes.v1.Note
+	5,   // 129: api.notes.v1.SharedNote.role:type_name -> api.notes.v1.ShareRole
+	151, // 130: api.notes.v1.SharedNote.shared_at:type_name -> google.protobuf.Timestamp
+	150, // 131: api.notes.v1.ShareNoteRequest.note_id:type_name -> api.types.ID
+	5,   // 132: api.notes.v1.ShareNoteRequest.role:type_name -> api.notes.v1.ShareRole
+	109, // 133: api.notes.v1.ShareNoteResponse.share:type_name -> api.notes.v1.NoteShare
+	150, // 134: api.notes.v1.UnshareNoteRequest.note_id:type_name -> api.types.ID
+	150, // 135: api.notes.v1.ListNoteSharesRequest.note_id:type_name -> api.types.ID
+	109, // 136: api.notes.v1.ListNoteSharesResponse.shares:type_name -> api.notes.v1.NoteShare
+	110, // 137: api.notes.v1.ListSharedNotesResponse.notes:type_name -> api.notes.v1.SharedNote
+	150, // 138: api.notes.v1.ShareLink.note_id:type_name -> api.types.ID
+	151, // 139: api.notes.v1.ShareLink.expires_at:type_name -> google.protobuf.Timestamp
+	151, // 140: api.notes.v1.ShareLink.created_at:type_name -> google.protobuf.Timestamp
+	150, // 141: api.notes.v1.CreateShareLinkRequest.note_id:type_name -> api.types.ID
+	154, // 142: api.notes.v1.CreateShareLinkRequest.ttl:type_name -> google.protobuf.Duration
+	119, // 143: api.notes.v1.CreateShareLinkResponse.link:type_name -> api.notes.v1.ShareLink
+	150, // 144: api.notes.v1.RevokeShareLinkRequest.note_id:type_name -> api.types.ID
+	150, // 145: api.notes.v1.ListShareLinksRequest.note_id:type_name -> api.types.ID
+	119, // 146: api.notes.v1.ListShareLinksResponse.links:type_name -> api.notes.v1.ShareLink
+	150, // 147: api.notes.v1.Reminder.id:type_name -> api.types.ID
+	150, // 148: api.notes.v1.Reminder.note_id:type_name -> api.types.ID
+	151, // 149: api.notes.v1.Reminder.fire_at:type_name -> google.protobuf.Timestamp
+	151, // 150: api.notes.v1.Reminder.fired_at:type_name -> google.protobuf.Timestamp
+	151, // 151: api.notes.v1.Reminder.created_at:type_name -> google.protobuf.Timestamp
+	151, // 152: api.notes.v1.Reminder.updated_at:type_name -> google.protobuf.Timestamp
+	150, // 153: api.notes.v1.CreateReminderRequest.note_id:type_name -> api.types.ID
+	151, // 154: api.notes.v1.CreateReminderRequest.fire_at:type_name -> google.protobuf.Timestamp
+	126, // 155: api.notes.v1.CreateReminderResponse.reminder:type_name -> api.notes.v1.Reminder
+	150, // 156: api.notes.v1.ListRemindersRequest.note_id:type_name -> api.types.ID
+	126, // 157: api.notes.v1.ListRemindersResponse.reminders:type_name -> api.notes.v1.Reminder
+	150, // 158: api.notes.v1.UpdateReminderRequest.note_id:type_name -> api.types.ID
+	150, // 159: api.notes.v1.UpdateReminderRequest.id:type_name -> api.types.ID
+	151, // 160: api.notes.v1.UpdateReminderRequest.fire_at:type_name -> google.protobuf.Timestamp
+	152, // 161: api.notes.v1.UpdateReminderRequest.update_mask:type_name -> google.protobuf.FieldMask
+	126, // 162: api.notes.v1.UpdateReminderResponse.reminder:type_name -> api.notes.v1.Reminder
+	150, // 163: api.notes.v1.DeleteReminderRequest.note_id:type_name -> api.types.ID
+	150, // 164: api.notes.v1.DeleteReminderRequest.id:type_name -> api.types.ID
+	7,   // 165: api.notes.v1.GetPublicNoteResponse.note:type_name -> api.notes.v1.Note
+	6,   // 166: api.notes.v1.Event.type:type_name -> api.notes.v1.EventType
+	150, // 167: api.notes.v1.Event.note_id:type_name -> api.types.ID
+	151, // 168: api.notes.v1.Event.event_time:type_name -> google.protobuf.Timestamp
+	151, // 169: api.notes.v1.Event.read_at:type_name -> google.protobuf.Timestamp
+	6,   // 170: api.notes.v1.EventFilter.types:type_name -> api.notes.v1.EventType
+	150, // 171: api.notes.v1.EventFilter.note_ids:type_name -> api.types.ID
+	139, // 172: api.notes.v1.ListEventsRequest.filter:type_name -> api.notes.v1.EventFilter
+	138, // 173: api.notes.v1.ListEventsResponse.events:type_name -> api.notes.v1.Event
+	151, // 174: api.notes.v1.MarkEventsReadRequest.up_to:type_name -> google.protobuf.Timestamp
+	151, // 175: api.notes.v1.Heartbeat.time:type_name -> google.protobuf.Timestamp
+	139, // 176: api.notes.v1.SubscribeToEventsRequest.filter:type_name -> api.notes.v1.EventFilter
+	138, // 177: api.notes.v1.SubscribeToEventsResponse.event:type_name -> api.notes.v1.Event
+	140, // 178: api.notes.v1.SubscribeToEventsResponse.unread:type_name -> api.notes.v1.Unread
+	145, // 179: api.notes.v1.SubscribeToEventsResponse.heartbeat:type_name -> api.notes.v1.Heartbeat
+	180, // [180:180] is the sub-list for method output_type
+	180, // [180:180] is the sub-list for method input_type
+	180, // [180:180] is the sub-list for extension type_name
+	180, // [180:180] is the sub-list for extension extendee
+	0,   // [0:180] is the sub-list for field type_name
 }
 
 func init() { file_api_notes_v1_messages_proto_init() }
@@ -868,17 +9772,43 @@ func file_api_notes_v1_messages_proto_init() {
 	if File_api_notes_v1_messages_proto != nil {
 		return
 	}
+	file_api_notes_v1_messages_proto_msgTypes[1].OneofWrappers = []any{}
 	file_api_notes_v1_messages_proto_msgTypes[12].OneofWrappers = []any{
+		(*EditComponent_Retain)(nil),
+		(*EditComponent_Insert)(nil),
+		(*EditComponent_Delete)(nil),
+	}
+	file_api_notes_v1_messages_proto_msgTypes[19].OneofWrappers = []any{
+		(*EditNoteRequest_NoteId)(nil),
+		(*EditNoteRequest_Operation)(nil),
+		(*EditNoteRequest_Cursor)(nil),
+	}
+	file_api_notes_v1_messages_proto_msgTypes[20].OneofWrappers = []any{
+		(*EditNoteResponse_Draft)(nil),
+		(*EditNoteResponse_Ack)(nil),
+		(*EditNoteResponse_Edit)(nil),
+		(*EditNoteResponse_Cursor)(nil),
+	}
+	file_api_notes_v1_messages_proto_msgTypes[63].OneofWrappers = []any{
+		(*UploadAttachmentRequest_Header)(nil),
+		(*UploadAttachmentRequest_Chunk)(nil),
+	}
+	file_api_notes_v1_messages_proto_msgTypes[66].OneofWrappers = []any{
+		(*DownloadAttachmentResponse_Attachment)(nil),
+		(*DownloadAttachmentResponse_Chunk)(nil),
+	}
+	file_api_notes_v1_messages_proto_msgTypes[140].OneofWrappers = []any{
 		(*SubscribeToEventsResponse_Event)(nil),
 		(*SubscribeToEventsResponse_Unread)(nil),
+		(*SubscribeToEventsResponse_Heartbeat)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_notes_v1_messages_proto_rawDesc), len(file_api_notes_v1_messages_proto_rawDesc)),
-			NumEnums:      1,
-			NumMessages:   13,
+			NumEnums:      7,
+			NumMessages:   143,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
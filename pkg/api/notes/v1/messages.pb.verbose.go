@@ -11,28 +11,61 @@ func (x *Note) Verbose() string {
 	if x == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("Note<Id=%v, Title=%v, Content=%v, CreatedAt=%v, UpdatedAt=%v>", x.Id, x.Title, x.Content, x.CreatedAt, x.UpdatedAt)
+	return fmt.Sprintf("Note<Id=%v, Title=%v, Content=%v, CreatedAt=%v, UpdatedAt=%v, DeletedAt=%v, Tags=%v, NotebookId=%v, Version=%v, Pinned=%v, Archived=%v, Starred=%v>", x.Id, x.Title, x.Content, x.CreatedAt, x.UpdatedAt, x.DeletedAt, x.Tags, x.NotebookId, x.Version, x.Pinned, x.Archived, x.Starred)
 }
 
 func (x *ListNotesRequest) Verbose() string {
 	if x == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ListNotesRequest<>")
+	optVal_Pinned := "<nil>"
+	if x.Pinned != nil {
+		optVal_Pinned = fmt.Sprintf("%v", *x.Pinned)
+	}
+	optVal_Archived := "<nil>"
+	if x.Archived != nil {
+		optVal_Archived = fmt.Sprintf("%v", *x.Archived)
+	}
+	optVal_Starred := "<nil>"
+	if x.Starred != nil {
+		optVal_Starred = fmt.Sprintf("%v", *x.Starred)
+	}
+	return fmt.Sprintf("ListNotesRequest<PageSize=%v, PageToken=%v, OrderBy=%v, CreatedAfter=%v, CreatedBefore=%v, UpdatedAfter=%v, UpdatedBefore=%v, TitlePrefix=%v, TagsAny=%v, TagsAll=%v, NotebookId=%v, Pinned=%s, Archived=%s, Starred=%s, Format=%v>", x.PageSize, x.PageToken, x.OrderBy, x.CreatedAfter, x.CreatedBefore, x.UpdatedAfter, x.UpdatedBefore, x.TitlePrefix, x.TagsAny, x.TagsAll, x.NotebookId, optVal_Pinned, optVal_Archived, optVal_Starred, x.Format)
 }
 
 func (x *ListNotesResponse) Verbose() string {
 	if x == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ListNotesResponse<Notes=%v>", x.Notes)
+	return fmt.Sprintf("ListNotesResponse<Notes=%v, NextPageToken=%v, TotalSize=%v>", x.Notes, x.NextPageToken, x.TotalSize)
+}
+
+func (x *SearchNotesRequest) Verbose() string {
+	if x == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SearchNotesRequest<Query=%v, PageSize=%v, PageToken=%v>", x.Query, x.PageSize, x.PageToken)
+}
+
+func (x *SearchHit) Verbose() string {
+	if x == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SearchHit<Note=%v, Rank=%v, TitleSnippet=%v, ContentSnippet=%v>", x.Note, x.Rank, x.TitleSnippet, x.ContentSnippet)
+}
+
+func (x *SearchNotesResponse) Verbose() string {
+	if x == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SearchNotesResponse<Hits=%v, NextPageToken=%v, TotalSize=%v>", x.Hits, x.NextPageToken, x.TotalSize)
 }
 
 func (x *RetrieveNoteRequest) Verbose() string {
 	if x == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("RetrieveNoteRequest<Id=%v>", x.Id)
+	return fmt.Sprintf("RetrieveNoteRequest<Id=%v, Format=%v>", x.Id, x.Format)
 }
 
 func (x *RetrieveNoteResponse) Verbose() string {
@@ -46,7 +79,7 @@ func (x *CreateNoteRequest) Verbose() string {
 	if x == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("CreateNoteRequest<Title=%v, Content=%v>", x.Title, x.Content)
+	return fmt.Sprintf("CreateNoteRequest<Title=%v, Content=%v, NotebookId=%v, TemplateId=%v, Variables=%v>", x.Title, x.Content, x.NotebookId, x.TemplateId, x.Variables)
 }
 
 func (x *CreateNoteResponse) Verbose() string {
@@ -56,11 +89,117 @@ func (x *CreateNoteResponse) Verbose() string {
 	return fmt.Sprintf("CreateNoteResponse<Note=%v>", x.Note)
 }
 
+func (x *UpdateNoteRequest) Verbose() string {
+	if x == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UpdateNoteRequest<Id=%v, Title=%v, Content=%v, UpdateMask=%v, Version=%v, Pinned=%v, Archived=%v, Starred=%v>", x.Id, x.Title, x.Content, x.UpdateMask, x.Version, x.Pinned, x.Archived, x.Starred)
+}
+
+func (x *UpdateNoteResponse) Verbose() string {
+	if x == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UpdateNoteResponse<Note=%v>", x.Note)
+}
+
+func (x *EditComponent) Verbose() string {
+	if x == nil {
+		return "<nil>"
+	}
+	oneOfVal_Kind := "<nil>"
+	switch v := x.GetKind().(type) {
+	case *EditComponent_Retain:
+		oneOfVal_Kind = fmt.Sprintf("%v", v.Retain)
+	case *EditComponent_Insert:
+		oneOfVal_Kind = fmt.Sprintf("%v", v.Insert)
+	case *EditComponent_Delete:
+		oneOfVal_Kind = fmt.Sprintf("%v", v.Delete)
+	}
+	return fmt.Sprintf("EditComponent<Kind=%s>", oneOfVal_Kind)
+}
+
+func (x *EditOperation) Verbose() string {
+	if x == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("EditOperation<Revision=%v, Components=%v>", x.Revision, x.Components)
+}
+
+func (x *EditCursor) Verbose() string {
+	if x == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("EditCursor<Revision=%v, Anchor=%v, Head=%v>", x.Revision, x.Anchor, x.Head)
+}
+
+func (x *Draft) Verbose() string {
+	if x == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("Draft<Content=%v, Revision=%v, Session=%v, Writable=%v>", x.Content, x.Revision, x.Session, x.Writable)
+}
+
+func (x *EditAck) Verbose() string {
+	if x == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("EditAck<Revision=%v>", x.Revision)
+}
+
+func (x *RemoteEdit) Verbose() string {
+	if x == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("RemoteEdit<UserId=%v, Session=%v, Operation=%v>", x.UserId, x.Session, x.Operation)
+}
+
+func (x *RemoteCursor) Verbose() string {
+	if x == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("RemoteCursor<UserId=%v, Session=%v, Cursor=%v>", x.UserId, x.Session, x.Cursor)
+}
+
+func (x *EditNoteRequest) Verbose() string {
+	if x == nil {
+		return "<nil>"
+	}
+	oneOfVal_Payload := "<nil>"
+	switch v := x.GetPayload().(type) {
+	case *EditNoteRequest_NoteId:
+		oneOfVal_Payload = fmt.Sprintf("%v", v.NoteId)
+	case *EditNoteRequest_Operation:
+		oneOfVal_Payload = fmt.Sprintf("%v", v.Operation)
+	case *EditNoteRequest_Cursor:
+		oneOfVal_Payload = fmt.Sprintf("%v", v.Cursor)
+	}
+	return fmt.Sprintf("EditNoteRequest<Payload=%s>", oneOfVal_Payload)
+}
+
+func (x *EditNoteResponse) Verbose() string {
+	if x == nil {
+		return "<nil>"
+	}
+	oneOfVal_Payload := "<nil>"
+	switch v := x.GetPayload().(type) {
+	case *EditNoteResponse_Draft:
+		oneOfVal_Payload = fmt.Sprintf("%v", v.Draft)
+	case *EditNoteResponse_Ack:
+		oneOfVal_Payload = fmt.Sprintf("%v", v.Ack)
+	case *EditNoteResponse_Edit:
+		oneOfVal_Payload = fmt.Sprintf("%v", v.Edit)
+	case *EditNoteResponse_Cursor:
+		oneOfVal_Payload = fmt.Sprintf("%v", v.Cursor)
+	}
+	return fmt.Sprintf("EditNoteResponse<Payload=%s>", oneOfVal_Payload)
+}
+
 func (x *DeleteNoteRequest) Verbose() string {
 	if x == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("DeleteNoteRequest<Id=%v>", x.Id)
+	return fmt.Sprintf("DeleteNoteRequest<Id=%v, Version=%v>", x.Id, x.Version)
 }
 
 func (x *DeleteNoteResponse) Verbose() string {
@@ -70,25 +209,837 @@ func (x *DeleteNoteResponse) Verbose() string {
 	return fmt.Sprintf("DeleteNoteResponse<>")
 }
 
-func (x *Event) Verbose() string {
+func (x *ListTrashedNotesRequest) Verbose() string {
 	if x == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("Event<Id=%v, Type=%v, NoteId=%v, EventTime=%v>", x.Id, x.Type, x.NoteId, x.EventTime)
+	return fmt.Sprintf("ListTrashedNotesRequest<PageSize=%v, PageToken=%v>", x.PageSize, x.PageToken)
 }
 
-func (x *Unread) Verbose() string {
+func (x *ListTrashedNotesResponse) Verbose() string {
 	if x == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("Unread<Events=%v>", x.Events)
+	return fmt.Sprintf("ListTrashedNotesResponse<Notes=%v, NextPageToken=%v, TotalSize=%v>", x.Notes, x.NextPageToken, x.TotalSize)
+}
+
+func (x *RestoreNoteRequest) Verbose() string {
+	if x == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("RestoreNoteRequest<Id=%v, Version=%v>", x.Id, x.Version)
+}
+
+func (x *RestoreNoteResponse) Verbose() string {
+	if x == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("RestoreNoteResponse<Note=%v>", x.Note)
+}
+
+func (x *PurgeNoteRequest) Verbose() string {
+	if x == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("PurgeNoteRequest<Id=%v, Version=%v>", x.Id, x.Version)
+}
+
+func (x *PurgeNoteResponse) Verbose() string {
+	if x == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("PurgeNoteResponse<>")
+}
+
+func (x *Tag) Verbose() string {
+	if x == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("Tag<Name=%v, CreatedAt=%v>", x.Name, x.CreatedAt)
+}
+
+func (x *TagCount) Verbose() string {
+	if x == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("TagCount<Tag=%v, Notes=%v>", x.Tag, x.Notes)
+}
+
+func (x *AddNoteTagsRequest) Verbose() string {
+	if x == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("AddNoteTagsRequest<NoteId=%v, Tags=%v, Version=%v>", x.NoteId, x.Tags, x.Version)
+}
+
+func (x *AddNoteTagsResponse) Verbose() string {
+	if x == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("AddNoteTagsResponse<Note=%v>", x.Note)
+}
+
+func (x *RemoveNoteTagsRequest) Verbose() string {
+	if x == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("RemoveNoteTagsRequest<NoteId=%v, Tags=%v, Version=%v>", x.NoteId, x.Tags, x.Version)
+}
+
+func (x *RemoveNoteTagsResponse) Verbose() string {
+	if x == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("RemoveNoteTagsResponse<Note=%v>", x.Note)
+}
+
+func (x *ListTagsRequest) Verbose() string {
+	if x == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ListTagsRequest<>")
+}
+
+func (x *ListTagsResponse) Verbose() string {
+	if x == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ListTagsResponse<Tags=%v>", x.Tags)
+}
+
+func (x *RenameTagRequest) Verbose() string {
+	if x == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("RenameTagRequest<Name=%v, NewName=%v>", x.Name, x.NewName)
+}
+
+func (x *RenameTagResponse) Verbose() string {
+	if x == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("RenameTagResponse<Tag=%v>", x.Tag)
+}
+
+func (x *NoteRevision) Verbose() string {
+	if x == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("NoteRevision<Id=%v, NoteId=%v, Revision=%v, Title=%v, Content=%v, CreatedAt=%v>", x.Id, x.NoteId, x.Revision, x.Title, x.Content, x.CreatedAt)
+}
+
+func (x *ListNoteRevisionsRequest) Verbose() string {
+	if x == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ListNoteRevisionsRequest<NoteId=%v, PageSize=%v, PageToken=%v>", x.NoteId, x.PageSize, x.PageToken)
+}
+
+func (x *ListNoteRevisionsResponse) Verbose() string {
+	if x == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ListNoteRevisionsResponse<Revisions=%v, NextPageToken=%v>", x.Revisions, x.NextPageToken)
+}
+
+func (x *GetNoteRevisionRequest) Verbose() string {
+	if x == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetNoteRevisionRequest<NoteId=%v, Revision=%v>", x.NoteId, x.Revision)
+}
+
+func (x *GetNoteRevisionResponse) Verbose() string {
+	if x == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetNoteRevisionResponse<Revision=%v>", x.Revision)
+}
+
+func (x *DiffLine) Verbose() string {
+	if x == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("DiffLine<Operation=%v, Text=%v>", x.Operation, x.Text)
+}
+
+func (x *DiffNoteRevisionsRequest) Verbose() string {
+	if x == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("DiffNoteRevisionsRequest<NoteId=%v, FromRevision=%v, ToRevision=%v>", x.NoteId, x.FromRevision, x.ToRevision)
+}
+
+func (x *DiffNoteRevisionsResponse) Verbose() string {
+	if x == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("DiffNoteRevisionsResponse<FromTitle=%v, ToTitle=%v, Lines=%v>", x.FromTitle, x.ToTitle, x.Lines)
+}
+
+func (x *RestoreNoteRevisionRequest) Verbose() string {
+	if x == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("RestoreNoteRevisionRequest<NoteId=%v, Revision=%v, Version=%v>", x.NoteId, x.Revision, x.Version)
+}
+
+func (x *RestoreNoteRevisionResponse) Verbose() string {
+	if x == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("RestoreNoteRevisionResponse<Note=%v, Revision=%v>", x.Note, x.Revision)
+}
+
+func (x *BatchNoteResult) Verbose() string {
+	if x == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("BatchNoteResult<Id=%v, Note=%v, Error=%v>", x.Id, x.Note, x.Error)
+}
+
+func (x *BatchCreateNotesRequest) Verbose() string {
+	if x == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("BatchCreateNotesRequest<Notes=%v>", x.Notes)
+}
+
+func (x *BatchCreateNotesResponse) Verbose() string {
+	if x == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("BatchCreateNotesResponse<Results=%v>", x.Results)
+}
+
+func (x *BatchDeleteNotesRequest) Verbose() string {
+	if x == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("BatchDeleteNotesRequest<Ids=%v, Versions=%v>", x.Ids, x.Versions)
+}
+
+func (x *BatchDeleteNotesResponse) Verbose() string {
+	if x == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("BatchDeleteNotesResponse<Results=%v>", x.Results)
+}
+
+func (x *BatchGetNotesRequest) Verbose() string {
+	if x == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("BatchGetNotesRequest<Ids=%v>", x.Ids)
+}
+
+func (x *BatchGetNotesResponse) Verbose() string {
+	if x == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("BatchGetNotesResponse<Results=%v>", x.Results)
+}
+
+func (x *ExportNotesRequest) Verbose() string {
+	if x == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ExportNotesRequest<Format=%v>", x.Format)
+}
+
+func (x *ExportNotesResponse) Verbose() string {
+	if x == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ExportNotesResponse<Chunk=%v>", x.Chunk)
+}
+
+func (x *ImportNotesRequest) Verbose() string {
+	if x == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ImportNotesRequest<Format=%v, Chunk=%v>", x.Format, x.Chunk)
+}
+
+func (x *ImportNoteResult) Verbose() string {
+	if x == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ImportNoteResult<Source=%v, Status=%v, Note=%v, Error=%v>", x.Source, x.Status, x.Note, x.Error)
+}
+
+func (x *ImportNotesResponse) Verbose() string {
+	if x == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ImportNotesResponse<Results=%v, Created=%v, Duplicates=%v, Failed=%v>", x.Results, x.Created, x.Duplicates, x.Failed)
+}
+
+func (x *Attachment) Verbose() string {
+	if x == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("Attachment<Id=%v, NoteId=%v, Name=%v, ContentType=%v, Size=%v, Checksum=%v, CreatedAt=%v>", x.Id, x.NoteId, x.Name, x.ContentType, x.Size, x.Checksum, x.CreatedAt)
+}
+
+func (x *AttachmentHeader) Verbose() string {
+	if x == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("AttachmentHeader<NoteId=%v, Name=%v, ContentType=%v, Checksum=%v>", x.NoteId, x.Name, x.ContentType, x.Checksum)
+}
+
+func (x *UploadAttachmentRequest) Verbose() string {
+	if x == nil {
+		return "<nil>"
+	}
+	oneOfVal_Payload := "<nil>"
+	switch v := x.GetPayload().(type) {
+	case *UploadAttachmentRequest_Header:
+		oneOfVal_Payload = fmt.Sprintf("%v", v.Header)
+	case *UploadAttachmentRequest_Chunk:
+		oneOfVal_Payload = fmt.Sprintf("%v", v.Chunk)
+	}
+	return fmt.Sprintf("UploadAttachmentRequest<Payload=%s>", oneOfVal_Payload)
+}
+
+func (x *UploadAttachmentResponse) Verbose() string {
+	if x == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UploadAttachmentResponse<Attachment=%v>", x.Attachment)
+}
+
+func (x *DownloadAttachmentRequest) Verbose() string {
+	if x == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("DownloadAttachmentRequest<Id=%v>", x.Id)
+}
+
+func (x *DownloadAttachmentResponse) Verbose() string {
+	if x == nil {
+		return "<nil>"
+	}
+	oneOfVal_Payload := "<nil>"
+	switch v := x.GetPayload().(type) {
+	case *DownloadAttachmentResponse_Attachment:
+		oneOfVal_Payload = fmt.Sprintf("%v", v.Attachment)
+	case *DownloadAttachmentResponse_Chunk:
+		oneOfVal_Payload = fmt.Sprintf("%v", v.Chunk)
+	}
+	return fmt.Sprintf("DownloadAttachmentResponse<Payload=%s>", oneOfVal_Payload)
+}
+
+func (x *ListAttachmentsRequest) Verbose() string {
+	if x == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ListAttachmentsRequest<NoteId=%v>", x.NoteId)
+}
+
+func (x *ListAttachmentsResponse) Verbose() string {
+	if x == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ListAttachmentsResponse<Attachments=%v>", x.Attachments)
+}
+
+func (x *DeleteAttachmentRequest) Verbose() string {
+	if x == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("DeleteAttachmentRequest<Id=%v>", x.Id)
+}
+
+func (x *DeleteAttachmentResponse) Verbose() string {
+	if x == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("DeleteAttachmentResponse<>")
+}
+
+func (x *MoveNoteRequest) Verbose() string {
+	if x == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("MoveNoteRequest<Id=%v, NotebookId=%v, Version=%v>", x.Id, x.NotebookId, x.Version)
+}
+
+func (x *MoveNoteResponse) Verbose() string {
+	if x == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("MoveNoteResponse<Note=%v>", x.Note)
+}
+
+func (x *Notebook) Verbose() string {
+	if x == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("Notebook<Id=%v, Name=%v, ParentId=%v, CreatedAt=%v, UpdatedAt=%v>", x.Id, x.Name, x.ParentId, x.CreatedAt, x.UpdatedAt)
+}
+
+func (x *CreateNotebookRequest) Verbose() string {
+	if x == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CreateNotebookRequest<Name=%v, ParentId=%v>", x.Name, x.ParentId)
+}
+
+func (x *CreateNotebookResponse) Verbose() string {
+	if x == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CreateNotebookResponse<Notebook=%v>", x.Notebook)
+}
+
+func (x *GetNotebookRequest) Verbose() string {
+	if x == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetNotebookRequest<Id=%v>", x.Id)
+}
+
+func (x *GetNotebookResponse) Verbose() string {
+	if x == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetNotebookResponse<Notebook=%v>", x.Notebook)
+}
+
+func (x *ListNotebooksRequest) Verbose() string {
+	if x == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ListNotebooksRequest<>")
+}
+
+func (x *ListNotebooksResponse) Verbose() string {
+	if x == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ListNotebooksResponse<Notebooks=%v>", x.Notebooks)
+}
+
+func (x *UpdateNotebookRequest) Verbose() string {
+	if x == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UpdateNotebookRequest<Id=%v, Name=%v, ParentId=%v, UpdateMask=%v>", x.Id, x.Name, x.ParentId, x.UpdateMask)
+}
+
+func (x *UpdateNotebookResponse) Verbose() string {
+	if x == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UpdateNotebookResponse<Notebook=%v>", x.Notebook)
+}
+
+func (x *DeleteNotebookRequest) Verbose() string {
+	if x == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("DeleteNotebookRequest<Id=%v, Mode=%v>", x.Id, x.Mode)
+}
+
+func (x *DeleteNotebookResponse) Verbose() string {
+	if x == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("DeleteNotebookResponse<>")
+}
+
+func (x *Template) Verbose() string {
+	if x == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("Template<Id=%v, Name=%v, Title=%v, Content=%v, Placeholders=%v, CreatedAt=%v, UpdatedAt=%v>", x.Id, x.Name, x.Title, x.Content, x.Placeholders, x.CreatedAt, x.UpdatedAt)
+}
+
+func (x *CreateTemplateRequest) Verbose() string {
+	if x == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CreateTemplateRequest<Name=%v, Title=%v, Content=%v>", x.Name, x.Title, x.Content)
+}
+
+func (x *CreateTemplateResponse) Verbose() string {
+	if x == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CreateTemplateResponse<Template=%v>", x.Template)
+}
+
+func (x *GetTemplateRequest) Verbose() string {
+	if x == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetTemplateRequest<Id=%v>", x.Id)
+}
+
+func (x *GetTemplateResponse) Verbose() string {
+	if x == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetTemplateResponse<Template=%v>", x.Template)
+}
+
+func (x *ListTemplatesRequest) Verbose() string {
+	if x == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ListTemplatesRequest<>")
+}
+
+func (x *ListTemplatesResponse) Verbose() string {
+	if x == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ListTemplatesResponse<Templates=%v>", x.Templates)
+}
+
+func (x *UpdateTemplateRequest) Verbose() string {
+	if x == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UpdateTemplateRequest<Id=%v, Name=%v, Title=%v, Content=%v, UpdateMask=%v>", x.Id, x.Name, x.Title, x.Content, x.UpdateMask)
+}
+
+func (x *UpdateTemplateResponse) Verbose() string {
+	if x == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UpdateTemplateResponse<Template=%v>", x.Template)
+}
+
+func (x *DeleteTemplateRequest) Verbose() string {
+	if x == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("DeleteTemplateRequest<Id=%v>", x.Id)
+}
+
+func (x *DeleteTemplateResponse) Verbose() string {
+	if x == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("DeleteTemplateResponse<>")
+}
+
+func (x *NoteReference) Verbose() string {
+	if x == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("NoteReference<SourceId=%v, Target=%v, TargetId=%v, TargetTitle=%v, Dangling=%v>", x.SourceId, x.Target, x.TargetId, x.TargetTitle, x.Dangling)
+}
+
+func (x *ListBacklinksRequest) Verbose() string {
+	if x == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ListBacklinksRequest<NoteId=%v>", x.NoteId)
+}
+
+func (x *ListBacklinksResponse) Verbose() string {
+	if x == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ListBacklinksResponse<Notes=%v>", x.Notes)
+}
+
+func (x *ListOutgoingLinksRequest) Verbose() string {
+	if x == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ListOutgoingLinksRequest<NoteId=%v>", x.NoteId)
+}
+
+func (x *ListOutgoingLinksResponse) Verbose() string {
+	if x == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ListOutgoingLinksResponse<Links=%v>", x.Links)
+}
+
+func (x *ListDanglingLinksRequest) Verbose() string {
+	if x == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ListDanglingLinksRequest<>")
+}
+
+func (x *ListDanglingLinksResponse) Verbose() string {
+	if x == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ListDanglingLinksResponse<Links=%v>", x.Links)
+}
+
+func (x *NoteShare) Verbose() string {
+	if x == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("NoteShare<NoteId=%v, Email=%v, Name=%v, Role=%v, CreatedAt=%v>", x.NoteId, x.Email, x.Name, x.Role, x.CreatedAt)
+}
+
+func (x *SharedNote) Verbose() string {
+	if x == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SharedNote<Note=%v, Role=%v, SharedAt=%v>", x.Note, x.Role, x.SharedAt)
+}
+
+func (x *ShareNoteRequest) Verbose() string {
+	if x == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ShareNoteRequest<NoteId=%v, Email=%v, Role=%v>", x.NoteId, x.Email, x.Role)
+}
+
+func (x *ShareNoteResponse) Verbose() string {
+	if x == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ShareNoteResponse<Share=%v>", x.Share)
+}
+
+func (x *UnshareNoteRequest) Verbose() string {
+	if x == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UnshareNoteRequest<NoteId=%v, Email=%v>", x.NoteId, x.Email)
+}
+
+func (x *UnshareNoteResponse) Verbose() string {
+	if x == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UnshareNoteResponse<>")
+}
+
+func (x *ListNoteSharesRequest) Verbose() string {
+	if x == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ListNoteSharesRequest<NoteId=%v>", x.NoteId)
+}
+
+func (x *ListNoteSharesResponse) Verbose() string {
+	if x == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ListNoteSharesResponse<Shares=%v>", x.Shares)
+}
+
+func (x *ListSharedNotesRequest) Verbose() string {
+	if x == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ListSharedNotesRequest<PageSize=%v, PageToken=%v>", x.PageSize, x.PageToken)
+}
+
+func (x *ListSharedNotesResponse) Verbose() string {
+	if x == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ListSharedNotesResponse<Notes=%v, NextPageToken=%v, TotalSize=%v>", x.Notes, x.NextPageToken, x.TotalSize)
+}
+
+func (x *ShareLink) Verbose() string {
+	if x == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ShareLink<Token=%v, NoteId=%v, Views=%v, ExpiresAt=%v, CreatedAt=%v>", x.Token, x.NoteId, x.Views, x.ExpiresAt, x.CreatedAt)
+}
+
+func (x *CreateShareLinkRequest) Verbose() string {
+	if x == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CreateShareLinkRequest<NoteId=%v, Ttl=%v>", x.NoteId, x.Ttl)
+}
+
+func (x *CreateShareLinkResponse) Verbose() string {
+	if x == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CreateShareLinkResponse<Link=%v>", x.Link)
+}
+
+func (x *RevokeShareLinkRequest) Verbose() string {
+	if x == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("RevokeShareLinkRequest<NoteId=%v, Token=%v>", x.NoteId, x.Token)
+}
+
+func (x *RevokeShareLinkResponse) Verbose() string {
+	if x == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("RevokeShareLinkResponse<>")
+}
+
+func (x *ListShareLinksRequest) Verbose() string {
+	if x == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ListShareLinksRequest<NoteId=%v>", x.NoteId)
+}
+
+func (x *ListShareLinksResponse) Verbose() string {
+	if x == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ListShareLinksResponse<Links=%v>", x.Links)
+}
+
+func (x *Reminder) Verbose() string {
+	if x == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("Reminder<Id=%v, NoteId=%v, FireAt=%v, FiredAt=%v, Recurrence=%v, TimeZone=%v, Occurrences=%v, CreatedAt=%v, UpdatedAt=%v>", x.Id, x.NoteId, x.FireAt, x.FiredAt, x.Recurrence, x.TimeZone, x.Occurrences, x.CreatedAt, x.UpdatedAt)
+}
+
+func (x *CreateReminderRequest) Verbose() string {
+	if x == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CreateReminderRequest<NoteId=%v, FireAt=%v, Recurrence=%v, TimeZone=%v>", x.NoteId, x.FireAt, x.Recurrence, x.TimeZone)
+}
+
+func (x *CreateReminderResponse) Verbose() string {
+	if x == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CreateReminderResponse<Reminder=%v>", x.Reminder)
+}
+
+func (x *ListRemindersRequest) Verbose() string {
+	if x == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ListRemindersRequest<NoteId=%v>", x.NoteId)
+}
+
+func (x *ListRemindersResponse) Verbose() string {
+	if x == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ListRemindersResponse<Reminders=%v>", x.Reminders)
+}
+
+func (x *UpdateReminderRequest) Verbose() string {
+	if x == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UpdateReminderRequest<NoteId=%v, Id=%v, FireAt=%v, Recurrence=%v, TimeZone=%v, UpdateMask=%v>", x.NoteId, x.Id, x.FireAt, x.Recurrence, x.TimeZone, x.UpdateMask)
+}
+
+func (x *UpdateReminderResponse) Verbose() string {
+	if x == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UpdateReminderResponse<Reminder=%v>", x.Reminder)
+}
+
+func (x *DeleteReminderRequest) Verbose() string {
+	if x == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("DeleteReminderRequest<NoteId=%v, Id=%v>", x.NoteId, x.Id)
+}
+
+func (x *DeleteReminderResponse) Verbose() string {
+	if x == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("DeleteReminderResponse<>")
+}
+
+func (x *GetPublicNoteRequest) Verbose() string {
+	if x == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetPublicNoteRequest<Token=%v>", x.Token)
+}
+
+func (x *GetPublicNoteResponse) Verbose() string {
+	if x == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetPublicNoteResponse<Note=%v, Views=%v>", x.Note, x.Views)
+}
+
+func (x *RenderPublicNoteRequest) Verbose() string {
+	if x == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("RenderPublicNoteRequest<Token=%v>", x.Token)
+}
+
+func (x *Event) Verbose() string {
+	if x == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("Event<Id=%v, Type=%v, NoteId=%v, EventTime=%v, Position=%v, ReadAt=%v>", x.Id, x.Type, x.NoteId, x.EventTime, x.Position, x.ReadAt)
+}
+
+func (x *EventFilter) Verbose() string {
+	if x == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("EventFilter<Types=%v, NoteIds=%v, Tags=%v>", x.Types, x.NoteIds, x.Tags)
+}
+
+func (x *Unread) Verbose() string {
+	if x == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("Unread<Events=%v>", x.Events)
+}
+
+func (x *ListEventsRequest) Verbose() string {
+	if x == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ListEventsRequest<PageSize=%v, PageToken=%v, Filter=%v, UnreadOnly=%v>", x.PageSize, x.PageToken, x.Filter, x.UnreadOnly)
+}
+
+func (x *ListEventsResponse) Verbose() string {
+	if x == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ListEventsResponse<Events=%v, NextPageToken=%v, Unread=%v>", x.Events, x.NextPageToken, x.Unread)
+}
+
+func (x *MarkEventsReadRequest) Verbose() string {
+	if x == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("MarkEventsReadRequest<Ids=%v, UpTo=%v>", x.Ids, x.UpTo)
+}
+
+func (x *MarkEventsReadResponse) Verbose() string {
+	if x == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("MarkEventsReadResponse<Marked=%v, Unread=%v>", x.Marked, x.Unread)
+}
+
+func (x *Heartbeat) Verbose() string {
+	if x == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("Heartbeat<Time=%v>", x.Time)
 }
 
 func (x *SubscribeToEventsRequest) Verbose() string {
 	if x == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("SubscribeToEventsRequest<>")
+	return fmt.Sprintf("SubscribeToEventsRequest<Device=%v, ResumeFrom=%v, Filter=%v>", x.Device, x.ResumeFrom, x.Filter)
 }
 
 func (x *SubscribeToEventsResponse) Verbose() string {
@@ -101,6 +1052,8 @@ func (x *SubscribeToEventsResponse) Verbose() string {
 		oneOfVal_Payload = fmt.Sprintf("%v", v.Event)
 	case *SubscribeToEventsResponse_Unread:
 		oneOfVal_Payload = fmt.Sprintf("%v", v.Unread)
+	case *SubscribeToEventsResponse_Heartbeat:
+		oneOfVal_Payload = fmt.Sprintf("%v", v.Heartbeat)
 	}
 	return fmt.Sprintf("SubscribeToEventsResponse<Payload=%s>", oneOfVal_Payload)
 }
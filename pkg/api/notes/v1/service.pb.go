@@ -9,6 +9,7 @@ package v1
 import (
 	_ "github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-openapiv2/options"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
+	httpbody "google.golang.org/genproto/googleapis/api/httpbody"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	reflect "reflect"
@@ -26,15 +27,69 @@ var File_api_notes_v1_service_proto protoreflect.FileDescriptor
 
 const file_api_notes_v1_service_proto_rawDesc = "" +
 	"\n" +
-	"\x1aapi/notes/v1/service.proto\x12\fapi.notes.v1\x1a\x1bapi/notes/v1/messages.proto\x1a\x1cgoogle/api/annotations.proto\x1a.protoc-gen-openapiv2/options/annotations.proto2\xd1\x04\n" +
+	"\x1aapi/notes/v1/service.proto\x12\fapi.notes.v1\x1a\x1bapi/notes/v1/messages.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x19google/api/httpbody.proto\x1a.protoc-gen-openapiv2/options/annotations.proto2\xa5;\n" +
 	"\fNotesService\x12c\n" +
 	"\tListNotes\x12\x1e.api.notes.v1.ListNotesRequest\x1a\x1f.api.notes.v1.ListNotesResponse\"\x15\x82\xd3\xe4\x93\x02\x0f\x12\r/api/v1/notes\x12w\n" +
 	"\fRetrieveNote\x12!.api.notes.v1.RetrieveNoteRequest\x1a\".api.notes.v1.RetrieveNoteResponse\" \x82\xd3\xe4\x93\x02\x1a\x12\x18/api/v1/notes/{id.value}\x12i\n" +
 	"\n" +
-	"CreateNote\x12\x1f.api.notes.v1.CreateNoteRequest\x1a .api.notes.v1.CreateNoteResponse\"\x18\x82\xd3\xe4\x93\x02\x12:\x01*\"\r/api/v1/notes\x12q\n" +
+	"CreateNote\x12\x1f.api.notes.v1.CreateNoteRequest\x1a .api.notes.v1.CreateNoteResponse\"\x18\x82\xd3\xe4\x93\x02\x12:\x01*\"\r/api/v1/notes\x12t\n" +
 	"\n" +
-	"DeleteNote\x12\x1f.api.notes.v1.DeleteNoteRequest\x1a .api.notes.v1.DeleteNoteResponse\" \x82\xd3\xe4\x93\x02\x1a*\x18/api/v1/notes/{id.value}\x12\x84\x01\n" +
-	"\x11SubscribeToEvents\x12&.api.notes.v1.SubscribeToEventsRequest\x1a'.api.notes.v1.SubscribeToEventsResponse\"\x1c\x82\xd3\xe4\x93\x02\x16\x12\x14/api/v1/notes/events0\x01B{\x92AE\x12\x14\n" +
+	"UpdateNote\x12\x1f.api.notes.v1.UpdateNoteRequest\x1a .api.notes.v1.UpdateNoteResponse\"#\x82\xd3\xe4\x93\x02\x1d:\x01*2\x18/api/v1/notes/{id.value}\x12l\n" +
+	"\bEditNote\x12\x1d.api.notes.v1.EditNoteRequest\x1a\x1e.api.notes.v1.EditNoteResponse\"\x1d\x82\xd3\xe4\x93\x02\x17:\x01*\"\x12/api/v1/notes/edit(\x010\x01\x12q\n" +
+	"\n" +
+	"DeleteNote\x12\x1f.api.notes.v1.DeleteNoteRequest\x1a .api.notes.v1.DeleteNoteResponse\" \x82\xd3\xe4\x93\x02\x1a*\x18/api/v1/notes/{id.value}\x12\x7f\n" +
+	"\vRestoreNote\x12 .api.notes.v1.RestoreNoteRequest\x1a!.api.notes.v1.RestoreNoteResponse\"+\x82\xd3\xe4\x93\x02%:\x01*\" /api/v1/notes/{id.value}/restore\x12t\n" +
+	"\tPurgeNote\x12\x1e.api.notes.v1.PurgeNoteRequest\x1a\x1f.api.notes.v1.PurgeNoteResponse\"&\x82\xd3\xe4\x93\x02 *\x1e/api/v1/notes/{id.value}/purge\x12\x88\x01\n" +
+	"\x10BatchCreateNotes\x12%.api.notes.v1.BatchCreateNotesRequest\x1a&.api.notes.v1.BatchCreateNotesResponse\"%\x82\xd3\xe4\x93\x02\x1f:\x01*\"\x1a/api/v1/notes/batch/create\x12\x88\x01\n" +
+	"\x10BatchDeleteNotes\x12%.api.notes.v1.BatchDeleteNotesRequest\x1a&.api.notes.v1.BatchDeleteNotesResponse\"%\x82\xd3\xe4\x93\x02\x1f:\x01*\"\x1a/api/v1/notes/batch/delete\x12|\n" +
+	"\rBatchGetNotes\x12\".api.notes.v1.BatchGetNotesRequest\x1a#.api.notes.v1.BatchGetNotesResponse\"\"\x82\xd3\xe4\x93\x02\x1c:\x01*\"\x17/api/v1/notes/batch/get\x12r\n" +
+	"\vExportNotes\x12 .api.notes.v1.ExportNotesRequest\x1a!.api.notes.v1.ExportNotesResponse\"\x1c\x82\xd3\xe4\x93\x02\x16\x12\x14/api/v1/notes/export0\x01\x12u\n" +
+	"\vImportNotes\x12 .api.notes.v1.ImportNotesRequest\x1a!.api.notes.v1.ImportNotesResponse\"\x1f\x82\xd3\xe4\x93\x02\x19:\x01*\"\x14/api/v1/notes/import(\x01\x12\x89\x01\n" +
+	"\x10UploadAttachment\x12%.api.notes.v1.UploadAttachmentRequest\x1a&.api.notes.v1.UploadAttachmentResponse\"$\x82\xd3\xe4\x93\x02\x1e:\x01*\"\x19/api/v1/notes/attachments(\x01\x12\x97\x01\n" +
+	"\x12DownloadAttachment\x12'.api.notes.v1.DownloadAttachmentRequest\x1a(.api.notes.v1.DownloadAttachmentResponse\",\x82\xd3\xe4\x93\x02&\x12$/api/v1/notes/attachments/{id.value}0\x01\x12\x91\x01\n" +
+	"\x0fListAttachments\x12$.api.notes.v1.ListAttachmentsRequest\x1a%.api.notes.v1.ListAttachmentsResponse\"1\x82\xd3\xe4\x93\x02+\x12)/api/v1/notes/{note_id.value}/attachments\x12\x8f\x01\n" +
+	"\x10DeleteAttachment\x12%.api.notes.v1.DeleteAttachmentRequest\x1a&.api.notes.v1.DeleteAttachmentResponse\",\x82\xd3\xe4\x93\x02&*$/api/v1/notes/attachments/{id.value}\x12s\n" +
+	"\bMoveNote\x12\x1d.api.notes.v1.MoveNoteRequest\x1a\x1e.api.notes.v1.MoveNoteResponse\"(\x82\xd3\xe4\x93\x02\":\x01*\"\x1d/api/v1/notes/{id.value}/move\x12\x81\x01\n" +
+	"\vAddNoteTags\x12 .api.notes.v1.AddNoteTagsRequest\x1a!.api.notes.v1.AddNoteTagsResponse\"-\x82\xd3\xe4\x93\x02':\x01*\"\"/api/v1/notes/{note_id.value}/tags\x12\x87\x01\n" +
+	"\x0eRemoveNoteTags\x12#.api.notes.v1.RemoveNoteTagsRequest\x1a$.api.notes.v1.RemoveNoteTagsResponse\"*\x82\xd3\xe4\x93\x02$*\"/api/v1/notes/{note_id.value}/tags\x12}\n" +
+	"\tShareNote\x12\x1e.api.notes.v1.ShareNoteRequest\x1a\x1f.api.notes.v1.ShareNoteResponse\"/\x82\xd3\xe4\x93\x02):\x01*\"$/api/v1/notes/{note_id.value}/shares\x12\x88\x01\n" +
+	"\vUnshareNote\x12 .api.notes.v1.UnshareNoteRequest\x1a!.api.notes.v1.UnshareNoteResponse\"4\x82\xd3\xe4\x93\x02.*,/api/v1/notes/{note_id.value}/shares/{email}\x12\x89\x01\n" +
+	"\x0eListNoteShares\x12#.api.notes.v1.ListNoteSharesRequest\x1a$.api.notes.v1.ListNoteSharesResponse\",\x82\xd3\xe4\x93\x02&\x12$/api/v1/notes/{note_id.value}/shares\x12\x8e\x01\n" +
+	"\x0fCreateShareLink\x12$.api.notes.v1.CreateShareLinkRequest\x1a%.api.notes.v1.CreateShareLinkResponse\".\x82\xd3\xe4\x93\x02(:\x01*\"#/api/v1/notes/{note_id.value}/links\x12\x93\x01\n" +
+	"\x0fRevokeShareLink\x12$.api.notes.v1.RevokeShareLinkRequest\x1a%.api.notes.v1.RevokeShareLinkResponse\"3\x82\xd3\xe4\x93\x02-*+/api/v1/notes/{note_id.value}/links/{token}\x12\x88\x01\n" +
+	"\x0eListShareLinks\x12#.api.notes.v1.ListShareLinksRequest\x1a$.api.notes.v1.ListShareLinksResponse\"+\x82\xd3\xe4\x93\x02%\x12#/api/v1/notes/{note_id.value}/links\x12\x8f\x01\n" +
+	"\x0eCreateReminder\x12#.api.notes.v1.CreateReminderRequest\x1a$.api.notes.v1.CreateReminderResponse\"2\x82\xd3\xe4\x93\x02,:\x01*\"'/api/v1/notes/{note_id.value}/reminders\x12\x89\x01\n" +
+	"\rListReminders\x12\".api.notes.v1.ListRemindersRequest\x1a#.api.notes.v1.ListRemindersResponse\"/\x82\xd3\xe4\x93\x02)\x12'/api/v1/notes/{note_id.value}/reminders\x12\x9a\x01\n" +
+	"\x0eUpdateReminder\x12#.api.notes.v1.UpdateReminderRequest\x1a$.api.notes.v1.UpdateReminderResponse\"=\x82\xd3\xe4\x93\x027:\x01*22/api/v1/notes/{note_id.value}/reminders/{id.value}\x12\x97\x01\n" +
+	"\x0eDeleteReminder\x12#.api.notes.v1.DeleteReminderRequest\x1a$.api.notes.v1.DeleteReminderResponse\":\x82\xd3\xe4\x93\x024*2/api/v1/notes/{note_id.value}/reminders/{id.value}\x12\x95\x01\n" +
+	"\x11ListNoteRevisions\x12&.api.notes.v1.ListNoteRevisionsRequest\x1a'.api.notes.v1.ListNoteRevisionsResponse\"/\x82\xd3\xe4\x93\x02)\x12'/api/v1/notes/{note_id.value}/revisions\x12\x9a\x01\n" +
+	"\x0fGetNoteRevision\x12$.api.notes.v1.GetNoteRevisionRequest\x1a%.api.notes.v1.GetNoteRevisionResponse\":\x82\xd3\xe4\x93\x024\x122/api/v1/notes/{note_id.value}/revisions/{revision}\x12\x90\x01\n" +
+	"\x11DiffNoteRevisions\x12&.api.notes.v1.DiffNoteRevisionsRequest\x1a'.api.notes.v1.DiffNoteRevisionsResponse\"*\x82\xd3\xe4\x93\x02$\x12\"/api/v1/notes/{note_id.value}/diff\x12\xb1\x01\n" +
+	"\x13RestoreNoteRevision\x12(.api.notes.v1.RestoreNoteRevisionRequest\x1a).api.notes.v1.RestoreNoteRevisionResponse\"E\x82\xd3\xe4\x93\x02?:\x01*\":/api/v1/notes/{note_id.value}/revisions/{revision}/restore\x12e\n" +
+	"\bListTags\x12\x1d.api.notes.v1.ListTagsRequest\x1a\x1e.api.notes.v1.ListTagsResponse\"\x1a\x82\xd3\xe4\x93\x02\x14\x12\x12/api/v1/notes/tags\x12r\n" +
+	"\tRenameTag\x12\x1e.api.notes.v1.RenameTagRequest\x1a\x1f.api.notes.v1.RenameTagResponse\"$\x82\xd3\xe4\x93\x02\x1e:\x01*2\x19/api/v1/notes/tags/{name}\x12\x7f\n" +
+	"\x0eCreateNotebook\x12#.api.notes.v1.CreateNotebookRequest\x1a$.api.notes.v1.CreateNotebookResponse\"\"\x82\xd3\xe4\x93\x02\x1c:\x01*\"\x17/api/v1/notes/notebooks\x12y\n" +
+	"\rListNotebooks\x12\".api.notes.v1.ListNotebooksRequest\x1a#.api.notes.v1.ListNotebooksResponse\"\x1f\x82\xd3\xe4\x93\x02\x19\x12\x17/api/v1/notes/notebooks\x12~\n" +
+	"\vGetNotebook\x12 .api.notes.v1.GetNotebookRequest\x1a!.api.notes.v1.GetNotebookResponse\"*\x82\xd3\xe4\x93\x02$\x12\"/api/v1/notes/notebooks/{id.value}\x12\x8a\x01\n" +
+	"\x0eUpdateNotebook\x12#.api.notes.v1.UpdateNotebookRequest\x1a$.api.notes.v1.UpdateNotebookResponse\"-\x82\xd3\xe4\x93\x02':\x01*2\"/api/v1/notes/notebooks/{id.value}\x12\x87\x01\n" +
+	"\x0eDeleteNotebook\x12#.api.notes.v1.DeleteNotebookRequest\x1a$.api.notes.v1.DeleteNotebookResponse\"*\x82\xd3\xe4\x93\x02$*\"/api/v1/notes/notebooks/{id.value}\x12\x7f\n" +
+	"\x0eCreateTemplate\x12#.api.notes.v1.CreateTemplateRequest\x1a$.api.notes.v1.CreateTemplateResponse\"\"\x82\xd3\xe4\x93\x02\x1c:\x01*\"\x17/api/v1/notes/templates\x12y\n" +
+	"\rListTemplates\x12\".api.notes.v1.ListTemplatesRequest\x1a#.api.notes.v1.ListTemplatesResponse\"\x1f\x82\xd3\xe4\x93\x02\x19\x12\x17/api/v1/notes/templates\x12~\n" +
+	"\vGetTemplate\x12 .api.notes.v1.GetTemplateRequest\x1a!.api.notes.v1.GetTemplateResponse\"*\x82\xd3\xe4\x93\x02$\x12\"/api/v1/notes/templates/{id.value}\x12\x8a\x01\n" +
+	"\x0eUpdateTemplate\x12#.api.notes.v1.UpdateTemplateRequest\x1a$.api.notes.v1.UpdateTemplateResponse\"-\x82\xd3\xe4\x93\x02':\x01*2\"/api/v1/notes/templates/{id.value}\x12\x87\x01\n" +
+	"\x0eDeleteTemplate\x12#.api.notes.v1.DeleteTemplateRequest\x1a$.api.notes.v1.DeleteTemplateResponse\"*\x82\xd3\xe4\x93\x02$*\"/api/v1/notes/templates/{id.value}\x12\x89\x01\n" +
+	"\rListBacklinks\x12\".api.notes.v1.ListBacklinksRequest\x1a#.api.notes.v1.ListBacklinksResponse\"/\x82\xd3\xe4\x93\x02)\x12'/api/v1/notes/{note_id.value}/backlinks\x12\x94\x01\n" +
+	"\x11ListOutgoingLinks\x12&.api.notes.v1.ListOutgoingLinksRequest\x1a'.api.notes.v1.ListOutgoingLinksResponse\".\x82\xd3\xe4\x93\x02(\x12&/api/v1/notes/{note_id.value}/outgoing\x12\x84\x01\n" +
+	"\x11ListDanglingLinks\x12&.api.notes.v1.ListDanglingLinksRequest\x1a'.api.notes.v1.ListDanglingLinksResponse\"\x1e\x82\xd3\xe4\x93\x02\x18\x12\x16/api/v1/notes/dangling\x12~\n" +
+	"\x10ListTrashedNotes\x12%.api.notes.v1.ListTrashedNotesRequest\x1a&.api.notes.v1.ListTrashedNotesResponse\"\x1b\x82\xd3\xe4\x93\x02\x15\x12\x13/api/v1/notes/trash\x12|\n" +
+	"\x0fListSharedNotes\x12$.api.notes.v1.ListSharedNotesRequest\x1a%.api.notes.v1.ListSharedNotesResponse\"\x1c\x82\xd3\xe4\x93\x02\x16\x12\x14/api/v1/notes/shared\x12p\n" +
+	"\vSearchNotes\x12 .api.notes.v1.SearchNotesRequest\x1a!.api.notes.v1.SearchNotesResponse\"\x1c\x82\xd3\xe4\x93\x02\x16\x12\x14/api/v1/notes/search\x12~\n" +
+	"\rGetPublicNote\x12\".api.notes.v1.GetPublicNoteRequest\x1a#.api.notes.v1.GetPublicNoteResponse\"$\x82\xd3\xe4\x93\x02\x1e\x12\x1c/api/v1/notes/public/{token}\x12z\n" +
+	"\x10RenderPublicNote\x12%.api.notes.v1.RenderPublicNoteRequest\x1a\x14.google.api.HttpBody\")\x82\xd3\xe4\x93\x02#\x12!/api/v1/notes/public/{token}/html\x12\x84\x01\n" +
+	"\x11SubscribeToEvents\x12&.api.notes.v1.SubscribeToEventsRequest\x1a'.api.notes.v1.SubscribeToEventsResponse\"\x1c\x82\xd3\xe4\x93\x02\x16\x12\x14/api/v1/notes/events0\x01\x12u\n" +
+	"\n" +
+	"ListEvents\x12\x1f.api.notes.v1.ListEventsRequest\x1a .api.notes.v1.ListEventsResponse\"$\x82\xd3\xe4\x93\x02\x1e\x12\x1c/api/v1/notes/events/history\x12\x81\x01\n" +
+	"\x0eMarkEventsRead\x12#.api.notes.v1.MarkEventsReadRequest\x1a$.api.notes.v1.MarkEventsReadResponse\"$\x82\xd3\xe4\x93\x02\x1e:\x01*\"\x19/api/v1/notes/events/readB{\x92AE\x12\x14\n" +
 	"\rNotes Service2\x031.0Z\x1f\n" +
 	"\x1d\n" +
 	"\x06Bearer\x12\x13\b\x02\x1a\rAuthorization \x02b\f\n" +
@@ -43,33 +98,241 @@ const file_api_notes_v1_service_proto_rawDesc = "" +
 	"\x06Bearer\x12\x00Z1github.com/therenotomorrow/gotes/pkg/api/notes/v1b\x06proto3"
 
 var file_api_notes_v1_service_proto_goTypes = []any{
-	(*ListNotesRequest)(nil),          // 0: api.notes.v1.ListNotesRequest
-	(*RetrieveNoteRequest)(nil),       // 1: api.notes.v1.RetrieveNoteRequest
-	(*CreateNoteRequest)(nil),         // 2: api.notes.v1.CreateNoteRequest
-	(*DeleteNoteRequest)(nil),         // 3: api.notes.v1.DeleteNoteRequest
-	(*SubscribeToEventsRequest)(nil),  // 4: api.notes.v1.SubscribeToEventsRequest
-	(*ListNotesResponse)(nil),         // 5: api.notes.v1.ListNotesResponse
-	(*RetrieveNoteResponse)(nil),      // 6: api.notes.v1.RetrieveNoteResponse
-	(*CreateNoteResponse)(nil),        // 7: api.notes.v1.CreateNoteResponse
-	(*DeleteNoteResponse)(nil),        // 8: api.notes.v1.DeleteNoteResponse
-	(*SubscribeToEventsResponse)(nil), // 9: api.notes.v1.SubscribeToEventsResponse
+	(*ListNotesRequest)(nil),            // 0: api.notes.v1.ListNotesRequest
+	(*RetrieveNoteRequest)(nil),         // 1: api.notes.v1.RetrieveNoteRequest
+	(*CreateNoteRequest)(nil),           // 2: api.notes.v1.CreateNoteRequest
+	(*UpdateNoteRequest)(nil),           // 3: api.notes.v1.UpdateNoteRequest
+	(*EditNoteRequest)(nil),             // 4: api.notes.v1.EditNoteRequest
+	(*DeleteNoteRequest)(nil),           // 5: api.notes.v1.DeleteNoteRequest
+	(*RestoreNoteRequest)(nil),          // 6: api.notes.v1.RestoreNoteRequest
+	(*PurgeNoteRequest)(nil),            // 7: api.notes.v1.PurgeNoteRequest
+	(*BatchCreateNotesRequest)(nil),     // 8: api.notes.v1.BatchCreateNotesRequest
+	(*BatchDeleteNotesRequest)(nil),     // 9: api.notes.v1.BatchDeleteNotesRequest
+	(*BatchGetNotesRequest)(nil),        // 10: api.notes.v1.BatchGetNotesRequest
+	(*ExportNotesRequest)(nil),          // 11: api.notes.v1.ExportNotesRequest
+	(*ImportNotesRequest)(nil),          // 12: api.notes.v1.ImportNotesRequest
+	(*UploadAttachmentRequest)(nil),     // 13: api.notes.v1.UploadAttachmentRequest
+	(*DownloadAttachmentRequest)(nil),   // 14: api.notes.v1.DownloadAttachmentRequest
+	(*ListAttachmentsRequest)(nil),      // 15: api.notes.v1.ListAttachmentsRequest
+	(*DeleteAttachmentRequest)(nil),     // 16: api.notes.v1.DeleteAttachmentRequest
+	(*MoveNoteRequest)(nil),             // 17: api.notes.v1.MoveNoteRequest
+	(*AddNoteTagsRequest)(nil),          // 18: api.notes.v1.AddNoteTagsRequest
+	(*RemoveNoteTagsRequest)(nil),       // 19: api.notes.v1.RemoveNoteTagsRequest
+	(*ShareNoteRequest)(nil),            // 20: api.notes.v1.ShareNoteRequest
+	(*UnshareNoteRequest)(nil),          // 21: api.notes.v1.UnshareNoteRequest
+	(*ListNoteSharesRequest)(nil),       // 22: api.notes.v1.ListNoteSharesRequest
+	(*CreateShareLinkRequest)(nil),      // 23: api.notes.v1.CreateShareLinkRequest
+	(*RevokeShareLinkRequest)(nil),      // 24: api.notes.v1.RevokeShareLinkRequest
+	(*ListShareLinksRequest)(nil),       // 25: api.notes.v1.ListShareLinksRequest
+	(*CreateReminderRequest)(nil),       // 26: api.notes.v1.CreateReminderRequest
+	(*ListRemindersRequest)(nil),        // 27: api.notes.v1.ListRemindersRequest
+	(*UpdateReminderRequest)(nil),       // 28: api.notes.v1.UpdateReminderRequest
+	(*DeleteReminderRequest)(nil),       // 29: api.notes.v1.DeleteReminderRequest
+	(*ListNoteRevisionsRequest)(nil),    // 30: api.notes.v1.ListNoteRevisionsRequest
+	(*GetNoteRevisionRequest)(nil),      // 31: api.notes.v1.GetNoteRevisionRequest
+	(*DiffNoteRevisionsRequest)(nil),    // 32: api.notes.v1.DiffNoteRevisionsRequest
+	(*RestoreNoteRevisionRequest)(nil),  // 33: api.notes.v1.RestoreNoteRevisionRequest
+	(*ListTagsRequest)(nil),             // 34: api.notes.v1.ListTagsRequest
+	(*RenameTagRequest)(nil),            // 35: api.notes.v1.RenameTagRequest
+	(*CreateNotebookRequest)(nil),       // 36: api.notes.v1.CreateNotebookRequest
+	(*ListNotebooksRequest)(nil),        // 37: api.notes.v1.ListNotebooksRequest
+	(*GetNotebookRequest)(nil),          // 38: api.notes.v1.GetNotebookRequest
+	(*UpdateNotebookRequest)(nil),       // 39: api.notes.v1.UpdateNotebookRequest
+	(*DeleteNotebookRequest)(nil),       // 40: api.notes.v1.DeleteNotebookRequest
+	(*CreateTemplateRequest)(nil),       // 41: api.notes.v1.CreateTemplateRequest
+	(*ListTemplatesRequest)(nil),        // 42: api.notes.v1.ListTemplatesRequest
+	(*GetTemplateRequest)(nil),          // 43: api.notes.v1.GetTemplateRequest
+	(*UpdateTemplateRequest)(nil),       // 44: api.notes.v1.UpdateTemplateRequest
+	(*DeleteTemplateRequest)(nil),       // 45: api.notes.v1.DeleteTemplateRequest
+	(*ListBacklinksRequest)(nil),        // 46: api.notes.v1.ListBacklinksRequest
+	(*ListOutgoingLinksRequest)(nil),    // 47: api.notes.v1.ListOutgoingLinksRequest
+	(*ListDanglingLinksRequest)(nil),    // 48: api.notes.v1.ListDanglingLinksRequest
+	(*ListTrashedNotesRequest)(nil),     // 49: api.notes.v1.ListTrashedNotesRequest
+	(*ListSharedNotesRequest)(nil),      // 50: api.notes.v1.ListSharedNotesRequest
+	(*SearchNotesRequest)(nil),          // 51: api.notes.v1.SearchNotesRequest
+	(*GetPublicNoteRequest)(nil),        // 52: api.notes.v1.GetPublicNoteRequest
+	(*RenderPublicNoteRequest)(nil),     // 53: api.notes.v1.RenderPublicNoteRequest
+	(*SubscribeToEventsRequest)(nil),    // 54: api.notes.v1.SubscribeToEventsRequest
+	(*ListEventsRequest)(nil),           // 55: api.notes.v1.ListEventsRequest
+	(*MarkEventsReadRequest)(nil),       // 56: api.notes.v1.MarkEventsReadRequest
+	(*ListNotesResponse)(nil),           // 57: api.notes.v1.ListNotesResponse
+	(*RetrieveNoteResponse)(nil),        // 58: api.notes.v1.RetrieveNoteResponse
+	(*CreateNoteResponse)(nil),          // 59: api.notes.v1.CreateNoteResponse
+	(*UpdateNoteResponse)(nil),          // 60: api.notes.v1.UpdateNoteResponse
+	(*EditNoteResponse)(nil),            // 61: api.notes.v1.EditNoteResponse
+	(*DeleteNoteResponse)(nil),          // 62: api.notes.v1.DeleteNoteResponse
+	(*RestoreNoteResponse)(nil),         // 63: api.notes.v1.RestoreNoteResponse
+	(*PurgeNoteResponse)(nil),           // 64: api.notes.v1.PurgeNoteResponse
+	(*BatchCreateNotesResponse)(nil),    // 65: api.notes.v1.BatchCreateNotesResponse
+	(*BatchDeleteNotesResponse)(nil),    // 66: api.notes.v1.BatchDeleteNotesResponse
+	(*BatchGetNotesResponse)(nil),       // 67: api.notes.v1.BatchGetNotesResponse
+	(*ExportNotesResponse)(nil),         // 68: api.notes.v1.ExportNotesResponse
+	(*ImportNotesResponse)(nil),         // 69: api.notes.v1.ImportNotesResponse
+	(*UploadAttachmentResponse)(nil),    // 70: api.notes.v1.UploadAttachmentResponse
+	(*DownloadAttachmentResponse)(nil),  // 71: api.notes.v1.DownloadAttachmentResponse
+	(*ListAttachmentsResponse)(nil),     // 72: api.notes.v1.ListAttachmentsResponse
+	(*DeleteAttachmentResponse)(nil),    // 73: api.notes.v1.DeleteAttachmentResponse
+	(*MoveNoteResponse)(nil),            // 74: api.notes.v1.MoveNoteResponse
+	(*AddNoteTagsResponse)(nil),         // 75: api.notes.v1.AddNoteTagsResponse
+	(*RemoveNoteTagsResponse)(nil),      // 76: api.notes.v1.RemoveNoteTagsResponse
+	(*ShareNoteResponse)(nil),           // 77: api.notes.v1.ShareNoteResponse
+	(*UnshareNoteResponse)(nil),         // 78: api.notes.v1.UnshareNoteResponse
+	(*ListNoteSharesResponse)(nil),      // 79: api.notes.v1.ListNoteSharesResponse
+	(*CreateShareLinkResponse)(nil),     // 80: api.notes.v1.CreateShareLinkResponse
+	(*RevokeShareLinkResponse)(nil),     // 81: api.notes.v1.RevokeShareLinkResponse
+	(*ListShareLinksResponse)(nil),      // 82: api.notes.v1.ListShareLinksResponse
+	(*CreateReminderResponse)(nil),      // 83: api.notes.v1.CreateReminderResponse
+	(*ListRemindersResponse)(nil),       // 84: api.notes.v1.ListRemindersResponse
+	(*UpdateReminderResponse)(nil),      // 85: api.notes.v1.UpdateReminderResponse
+	(*DeleteReminderResponse)(nil),      // 86: api.notes.v1.DeleteReminderResponse
+	(*ListNoteRevisionsResponse)(nil),   // 87: api.notes.v1.ListNoteRevisionsResponse
+	(*GetNoteRevisionResponse)(nil),     // 88: api.notes.v1.GetNoteRevisionResponse
+	(*DiffNoteRevisionsResponse)(nil),   // 89: api.notes.v1.DiffNoteRevisionsResponse
+	(*RestoreNoteRevisionResponse)(nil), // 90: api.notes.v1.RestoreNoteRevisionResponse
+	(*ListTagsResponse)(nil),            // 91: api.notes.v1.ListTagsResponse
+	(*RenameTagResponse)(nil),           // 92: api.notes.v1.RenameTagResponse
+	(*CreateNotebookResponse)(nil),      // 93: api.notes.v1.CreateNotebookResponse
+	(*ListNotebooksResponse)(nil),       // 94: api.notes.v1.ListNotebooksResponse
+	(*GetNotebookResponse)(nil),         // 95: api.notes.v1.GetNotebookResponse
+	(*UpdateNotebookResponse)(nil),      // 96: api.notes.v1.UpdateNotebookResponse
+	(*DeleteNotebookResponse)(nil),      // 97: api.notes.v1.DeleteNotebookResponse
+	(*CreateTemplateResponse)(nil),      // 98: api.notes.v1.CreateTemplateResponse
+	(*ListTemplatesResponse)(nil),       // 99: api.notes.v1.ListTemplatesResponse
+	(*GetTemplateResponse)(nil),         // 100: api.notes.v1.GetTemplateResponse
+	(*UpdateTemplateResponse)(nil),      // 101: api.notes.v1.UpdateTemplateResponse
+	(*DeleteTemplateResponse)(nil),      // 102: api.notes.v1.DeleteTemplateResponse
+	(*ListBacklinksResponse)(nil),       // 103: api.notes.v1.ListBacklinksResponse
+	(*ListOutgoingLinksResponse)(nil),   // 104: api.notes.v1.ListOutgoingLinksResponse
+	(*ListDanglingLinksResponse)(nil),   // 105: api.notes.v1.ListDanglingLinksResponse
+	(*ListTrashedNotesResponse)(nil),    // 106: api.notes.v1.ListTrashedNotesResponse
+	(*ListSharedNotesResponse)(nil),     // 107: api.notes.v1.ListSharedNotesResponse
+	(*SearchNotesResponse)(nil),         // 108: api.notes.v1.SearchNotesResponse
+	(*GetPublicNoteResponse)(nil),       // 109: api.notes.v1.GetPublicNoteResponse
+	(*httpbody.HttpBody)(nil),           // 110: google.api.HttpBody
+	(*SubscribeToEventsResponse)(nil),   // 111: api.notes.v1.SubscribeToEventsResponse
+	(*ListEventsResponse)(nil),          // 112: api.notes.v1.ListEventsResponse
+	(*MarkEventsReadResponse)(nil),      // 113: api.notes.v1.MarkEventsReadResponse
 }
 var file_api_notes_v1_service_proto_depIdxs = []int32{
-	0, // 0: api.notes.v1.NotesService.ListNotes:input_type -> api.notes.v1.ListNotesRequest
-	1, // 1: api.notes.v1.NotesService.RetrieveNote:input_type -> api.notes.v1.RetrieveNoteRequest
-	2, // 2: api.notes.v1.NotesService.CreateNote:input_type -> api.notes.v1.CreateNoteRequest
-	3, // 3: api.notes.v1.NotesService.DeleteNote:input_type -> api.notes.v1.DeleteNoteRequest
-	4, // 4: api.notes.v1.NotesService.SubscribeToEvents:input_type -> api.notes.v1.SubscribeToEventsRequest
-	5, // 5: api.notes.v1.NotesService.ListNotes:output_type -> api.notes.v1.ListNotesResponse
-	6, // 6: api.notes.v1.NotesService.RetrieveNote:output_type -> api.notes.v1.RetrieveNoteResponse
-	7, // 7: api.notes.v1.NotesService.CreateNote:output_type -> api.notes.v1.CreateNoteResponse
-	8, // 8: api.notes.v1.NotesService.DeleteNote:output_type -> api.notes.v1.DeleteNoteResponse
-	9, // 9: api.notes.v1.NotesService.SubscribeToEvents:output_type -> api.notes.v1.SubscribeToEventsResponse
-	5, // [5:10] is the sub-list for method output_type
-	0, // [0:5] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	0,   // 0: api.notes.v1.NotesService.ListNotes:input_type -> api.notes.v1.ListNotesRequest
+	1,   // 1: api.notes.v1.NotesService.RetrieveNote:input_type -> api.notes.v1.RetrieveNoteRequest
+	2,   // 2: api.notes.v1.NotesService.CreateNote:input_type -> api.notes.v1.CreateNoteRequest
+	3,   // 3: api.notes.v1.NotesService.UpdateNote:input_type -> api.notes.v1.UpdateNoteRequest
+	4,   // 4: api.notes.v1.NotesService.EditNote:input_type -> api.notes.v1.EditNoteRequest
+	5,   // 5: api.notes.v1.NotesService.DeleteNote:input_type -> api.notes.v1.DeleteNoteRequest
+	6,   // 6: api.notes.v1.NotesService.RestoreNote:input_type -> api.notes.v1.RestoreNoteRequest
+	7,   // 7: api.notes.v1.NotesService.PurgeNote:input_type -> api.notes.v1.PurgeNoteRequest
+	8,   // 8: api.notes.v1.NotesService.BatchCreateNotes:input_type -> api.notes.v1.BatchCreateNotesRequest
+	9,   // 9: api.notes.v1.NotesService.BatchDeleteNotes:input_type -> api.notes.v1.BatchDeleteNotesRequest
+	10,  // 10: api.notes.v1.NotesService.BatchGetNotes:input_type -> api.notes.v1.BatchGetNotesRequest
+	11,  // 11: api.notes.v1.NotesService.ExportNotes:input_type -> api.notes.v1.ExportNotesRequest
+	12,  // 12: api.notes.v1.NotesService.ImportNotes:input_type -> api.notes.v1.ImportNotesRequest
+	13,  // 13: api.notes.v1.NotesService.UploadAttachment:input_type -> api.notes.v1.UploadAttachmentRequest
+	14,  // 14: api.notes.v1.NotesService.DownloadAttachment:input_type -> api.notes.v1.DownloadAttachmentRequest
+	15,  // 15: api.notes.v1.NotesService.ListAttachments:input_type -> api.notes.v1.ListAttachmentsRequest
+	16,  // 16: api.notes.v1.NotesService.DeleteAttachment:input_type -> api.notes.v1.DeleteAttachmentRequest
+	17,  // 17: api.notes.v1.NotesService.MoveNote:input_type -> api.notes.v1.MoveNoteRequest
+	18,  // 18: api.notes.v1.NotesService.AddNoteTags:input_type -> api.notes.v1.AddNoteTagsRequest
+	19,  // 19: api.notes.v1.NotesService.RemoveNoteTags:input_type -> api.notes.v1.RemoveNoteTagsRequest
+	20,  // 20: api.notes.v1.NotesService.ShareNote:input_type -> api.notes.v1.ShareNoteRequest
+	21,  // 21: api.notes.v1.NotesService.UnshareNote:input_type -> api.notes.v1.UnshareNoteRequest
+	22,  // 22: api.notes.v1.NotesService.ListNoteShares:input_type -> api.notes.v1.ListNoteSharesRequest
+	23,  // 23: api.notes.v1.NotesService.CreateShareLink:input_type -> api.notes.v1.CreateShareLinkRequest
+	24,  // 24: api.notes.v1.NotesService.RevokeShareLink:input_type -> api.notes.v1.RevokeShareLinkRequest
+	25,  // 25: api.notes.v1.NotesService.ListShareLinks:input_type -> api.notes.v1.ListShareLinksRequest
+	26,  // 26: api.notes.v1.NotesService.CreateReminder:input_type -> api.notes.v1.CreateReminderRequest
+	27,  // 27: api.notes.v1.NotesService.ListReminders:input_type -> api.notes.v1.ListRemindersRequest
+	28,  // 28: api.notes.v1.NotesService.UpdateReminder:input_type -> api.notes.v1.UpdateReminderRequest
+	29,  // 29: api.notes.v1.NotesService.DeleteReminder:input_type -> api.notes.v1.DeleteReminderRequest
+	30,  // 30: api.notes.v1.NotesService.ListNoteRevisions:input_type -> api.notes.v1.ListNoteRevisionsRequest
+	31,  // 31: api.notes.v1.NotesService.GetNoteRevision:input_type -> api.notes.v1.GetNoteRevisionRequest
+	32,  // 32: api.notes.v1.NotesService.DiffNoteRevisions:input_type -> api.notes.v1.DiffNoteRevisionsRequest
+	33,  // 33: api.notes.v1.NotesService.RestoreNoteRevision:input_type -> api.notes.v1.RestoreNoteRevisionRequest
+	34,  // 34: api.notes.v1.NotesService.ListTags:input_type -> api.notes.v1.ListTagsRequest
+	35,  // 35: api.notes.v1.NotesService.RenameTag:input_type -> api.notes.v1.RenameTagRequest
+	36,  // 36: api.notes.v1.NotesService.CreateNotebook:input_type -> api.notes.v1.CreateNotebookRequest
+	37,  // 37: api.notes.v1.NotesService.ListNotebooks:input_type -> api.notes.v1.ListNotebooksRequest
+	38,  // 38: api.notes.v1.NotesService.GetNotebook:input_type -> api.notes.v1.GetNotebookRequest
+	39,  // 39: api.notes.v1.NotesService.UpdateNotebook:input_type -> api.notes.v1.UpdateNotebookRequest
+	40,  // 40: api.notes.v1.NotesService.DeleteNotebook:input_type -> api.notes.v1.DeleteNotebookRequest
+	41,  // 41: api.notes.v1.NotesService.CreateTemplate:input_type -> api.notes.v1.CreateTemplateRequest
+	42,  // 42: api.notes.v1.NotesService.ListTemplates:input_type -> api.notes.v1.ListTemplatesRequest
+	43,  // 43: api.notes.v1.NotesService.GetTemplate:input_type -> api.notes.v1.GetTemplateRequest
+	44,  // 44: api.notes.v1.NotesService.UpdateTemplate:input_type -> api.notes.v1.UpdateTemplateRequest
+	45,  // 45: api.notes.v1.NotesService.DeleteTemplate:input_type -> api.notes.v1.DeleteTemplateRequest
+	46,  // 46: api.notes.v1.NotesService.ListBacklinks:input_type -> api.notes.v1.ListBacklinksRequest
+	47,  // 47: api.notes.v1.NotesService.ListOutgoingLinks:input_type -> api.notes.v1.ListOutgoingLinksRequest
+	48,  // 48: api.notes.v1.NotesService.ListDanglingLinks:input_type -> api.notes.v1.ListDanglingLinksRequest
+	49,  // 49: api.notes.v1.NotesService.ListTrashedNotes:input_type -> api.notes.v1.ListTrashedNotesRequest
+	50,  // 50: api.notes.v1.NotesService.ListSharedNotes:input_type -> api.notes.v1.ListSharedNotesRequest
+	51,  // 51: api.notes.v1.NotesService.SearchNotes:input_type -> api.notes.v1.SearchNotesRequest
+	52,  // 52: api.notes.v1.NotesService.GetPublicNote:input_type -> api.notes.v1.GetPublicNoteRequest
+	53,  // 53: api.notes.v1.NotesService.RenderPublicNote:input_type -> api.notes.v1.RenderPublicNoteRequest
+	54,  // 54: api.notes.v1.NotesService.SubscribeToEvents:input_type -> api.notes.v1.SubscribeToEventsRequest
+	55,  // 55: api.notes.v1.NotesService.ListEvents:input_type -> api.notes.v1.ListEventsRequest
+	56,  // 56: api.notes.v1.NotesService.MarkEventsRead:input_type -> api.notes.v1.MarkEventsReadRequest
+	57,  // 57: api.notes.v1.NotesService.ListNotes:output_type -> api.notes.v1.ListNotesResponse
+	58,  // 58: api.notes.v1.NotesService.RetrieveNote:output_type -> api.notes.v1.RetrieveNoteResponse
+	59,  // 59: api.notes.v1.NotesService.CreateNote:output_type -> api.notes.v1.CreateNoteResponse
+	60,  // 60: api.notes.v1.NotesService.UpdateNote:output_type -> api.notes.v1.UpdateNoteResponse
+	61,  // 61: api.notes.v1.NotesService.EditNote:output_type -> api.notes.v1.EditNoteResponse
+	62,  // 62: api.notes.v1.NotesService.DeleteNote:output_type -> api.notes.v1.DeleteNoteResponse
+	63,  // 63: api.notes.v1.NotesService.RestoreNote:output_type -> api.notes.v1.RestoreNoteResponse
+	64,  // 64: api.notes.v1.NotesService.PurgeNote:output_type -> api.notes.v1.PurgeNoteResponse
+	65,  // 65: api.notes.v1.NotesService.BatchCreateNotes:output_type -> api.notes.v1.BatchCreateNotesResponse
+	66,  // 66: api.notes.v1.NotesService.BatchDeleteNotes:output_type -> api.notes.v1.BatchDeleteNotesResponse
+	67,  // 67: api.notes.v1.NotesService.BatchGetNotes:output_type -> api.notes.v1.BatchGetNotesResponse
+	68,  // 68: api.notes.v1.NotesService.ExportNotes:output_type -> api.notes.v1.ExportNotesResponse
+	69,  // 69: api.notes.v1.NotesService.ImportNotes:output_type -> api.notes.v1.ImportNotesResponse
+	70,  // 70: api.notes.v1.NotesService.UploadAttachment:output_type -> api.notes.v1.UploadAttachmentResponse
+	71,  // 71: api.notes.v1.NotesService.DownloadAttachment:output_type -> api.notes.v1.DownloadAttachmentResponse
+	72,  // 72: api.notes.v1.NotesService.ListAttachments:output_type -> api.notes.v1.ListAttachmentsResponse
+	73,  // 73: api.notes.v1.NotesService.DeleteAttachment:output_type -> api.notes.v1.DeleteAttachmentResponse
+	74,  // 74: api.notes.v1.NotesService.MoveNote:output_type -> api.notes.v1.MoveNoteResponse
+	75,  // 75: api.notes.v1.NotesService.AddNoteTags:output_type -> api.notes.v1.AddNoteTagsResponse
+	76,  // 76: api.notes.v1.NotesService.RemoveNoteTags:output_type -> api.notes.v1.RemoveNoteTagsResponse
+	77,  // 77: api.notes.v1.NotesService.ShareNote:output_type -> api.notes.v1.ShareNoteResponse
+	78,  // 78: api.notes.v1.NotesService.UnshareNote:output_type -> api.notes.v1.UnshareNoteResponse
+	79,  // 79: api.notes.v1.NotesService.ListNoteShares:output_type -> api.notes.v1.ListNoteSharesResponse
+	80,  // 80: api.notes.v1.NotesService.CreateShareLink:output_type -> api.notes.v1.CreateShareLinkResponse
+	81,  // 81: api.notes.v1.NotesService.RevokeShareLink:output_type -> api.notes.v1.RevokeShareLinkResponse
+	82,  // 82: api.notes.v1.NotesService.ListShareLinks:output_type -> api.notes.v1.ListShareLinksResponse
+	83,  // 83: api.notes.v1.NotesService.CreateReminder:output_type -> api.notes.v1.CreateReminderResponse
+	84,  // 84: api.notes.v1.NotesService.ListReminders:output_type -> api.notes.v1.ListRemindersResponse
+	85,  // 85: api.notes.v1.NotesService.UpdateReminder:output_type -> api.notes.v1.UpdateReminderResponse
+	86,  // 86: api.notes.v1.NotesService.DeleteReminder:output_type -> api.notes.v1.DeleteReminderResponse
+	87,  // 87: api.notes.v1.NotesService.ListNoteRevisions:output_type -> api.notes.v1.ListNoteRevisionsResponse
+	88,  // 88: api.notes.v1.NotesService.GetNoteRevision:output_type -> api.notes.v1.GetNoteRevisionResponse
+	89,  // 89: api.notes.v1.NotesService.DiffNoteRevisions:output_type -> api.notes.v1.DiffNoteRevisionsResponse
+	90,  // 90: api.notes.v1.NotesService.RestoreNoteRevision:output_type -> api.notes.v1.RestoreNoteRevisionResponse
+	91,  // 91: api.notes.v1.NotesService.ListTags:output_type -> api.notes.v1.ListTagsResponse
+	92,  // 92: api.notes.v1.NotesService.RenameTag:output_type -> api.notes.v1.RenameTagResponse
+	93,  // 93: api.notes.v1.NotesService.CreateNotebook:output_type -> api.notes.v1.CreateNotebookResponse
+	94,  // 94: api.notes.v1.NotesService.ListNotebooks:output_type -> api.notes.v1.ListNotebooksResponse
+	95,  // 95: api.notes.v1.NotesService.GetNotebook:output_type -> api.notes.v1.GetNotebookResponse
+	96,  // 96: api.notes.v1.NotesService.UpdateNotebook:output_type -> api.notes.v1.UpdateNotebookResponse
+	97,  // 97: api.notes.v1.NotesService.DeleteNotebook:output_type -> api.notes.v1.DeleteNotebookResponse
+	98,  // 98: api.notes.v1.NotesService.CreateTemplate:output_type -> api.notes.v1.CreateTemplateResponse
+	99,  // 99: api.notes.v1.NotesService.ListTemplates:output_type -> api.notes.v1.ListTemplatesResponse
+	100, // 100: api.notes.v1.NotesService.GetTemplate:output_type -> api.notes.v1.GetTemplateResponse
+	101, // 101: api.notes.v1.NotesService.UpdateTemplate:output_type -> api.notes.v1.UpdateTemplateResponse
+	102, // 102: api.notes.v1.NotesService.DeleteTemplate:output_type -> api.notes.v1.DeleteTemplateResponse
+	103, // 103: api.notes.v1.NotesService.ListBacklinks:output_type -> api.notes.v1.ListBacklinksResponse
+	104, // 104: api.notes.v1.NotesService.ListOutgoingLinks:output_type -> api.notes.v1.ListOutgoingLinksResponse
+	105, // 105: api.notes.v1.NotesService.ListDanglingLinks:output_type -> api.notes.v1.ListDanglingLinksResponse
+	106, // 106: api.notes.v1.NotesService.ListTrashedNotes:output_type -> api.notes.v1.ListTrashedNotesResponse
+	107, // 107: api.notes.v1.NotesService.ListSharedNotes:output_type -> api.notes.v1.ListSharedNotesResponse
+	108, // 108: api.notes.v1.NotesService.SearchNotes:output_type -> api.notes.v1.SearchNotesResponse
+	109, // 109: api.notes.v1.NotesService.GetPublicNote:output_type -> api.notes.v1.GetPublicNoteResponse
+	110, // 110: api.notes.v1.NotesService.RenderPublicNote:output_type -> google.api.HttpBody
+	111, // 111: api.notes.v1.NotesService.SubscribeToEvents:output_type -> api.notes.v1.SubscribeToEventsResponse
+	112, // 112: api.notes.v1.NotesService.ListEvents:output_type -> api.notes.v1.ListEventsResponse
+	113, // 113: api.notes.v1.NotesService.MarkEventsRead:output_type -> api.notes.v1.MarkEventsReadResponse
+	57,  // [57:114] is the sub-list for method output_type
+	0,   // [0:57] is the sub-list for method input_type
+	0,   // [0:0] is the sub-list for extension type_name
+	0,   // [0:0] is the sub-list for extension extendee
+	0,   // [0:0] is the sub-list for field type_name
 }
 
 func init() { file_api_notes_v1_service_proto_init() }
@@ -8,6 +8,7 @@ package v1
 
 import (
 	context "context"
+	httpbody "google.golang.org/genproto/googleapis/api/httpbody"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
@@ -19,11 +20,63 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	NotesService_ListNotes_FullMethodName         = "/api.notes.v1.NotesService/ListNotes"
-	NotesService_RetrieveNote_FullMethodName      = "/api.notes.v1.NotesService/RetrieveNote"
-	NotesService_CreateNote_FullMethodName        = "/api.notes.v1.NotesService/CreateNote"
-	NotesService_DeleteNote_FullMethodName        = "/api.notes.v1.NotesService/DeleteNote"
-	NotesService_SubscribeToEvents_FullMethodName = "/api.notes.v1.NotesService/SubscribeToEvents"
+	NotesService_ListNotes_FullMethodName           = "/api.notes.v1.NotesService/ListNotes"
+	NotesService_RetrieveNote_FullMethodName        = "/api.notes.v1.NotesService/RetrieveNote"
+	NotesService_CreateNote_FullMethodName          = "/api.notes.v1.NotesService/CreateNote"
+	NotesService_UpdateNote_FullMethodName          = "/api.notes.v1.NotesService/UpdateNote"
+	NotesService_EditNote_FullMethodName            = "/api.notes.v1.NotesService/EditNote"
+	NotesService_DeleteNote_FullMethodName          = "/api.notes.v1.NotesService/DeleteNote"
+	NotesService_RestoreNote_FullMethodName         = "/api.notes.v1.NotesService/RestoreNote"
+	NotesService_PurgeNote_FullMethodName           = "/api.notes.v1.NotesService/PurgeNote"
+	NotesService_BatchCreateNotes_FullMethodName    = "/api.notes.v1.NotesService/BatchCreateNotes"
+	NotesService_BatchDeleteNotes_FullMethodName    = "/api.notes.v1.NotesService/BatchDeleteNotes"
+	NotesService_BatchGetNotes_FullMethodName       = "/api.notes.v1.NotesService/BatchGetNotes"
+	NotesService_ExportNotes_FullMethodName         = "/api.notes.v1.NotesService/ExportNotes"
+	NotesService_ImportNotes_FullMethodName         = "/api.notes.v1.NotesService/ImportNotes"
+	NotesService_UploadAttachment_FullMethodName    = "/api.notes.v1.NotesService/UploadAttachment"
+	NotesService_DownloadAttachment_FullMethodName  = "/api.notes.v1.NotesService/DownloadAttachment"
+	NotesService_ListAttachments_FullMethodName     = "/api.notes.v1.NotesService/ListAttachments"
+	NotesService_DeleteAttachment_FullMethodName    = "/api.notes.v1.NotesService/DeleteAttachment"
+	NotesService_MoveNote_FullMethodName            = "/api.notes.v1.NotesService/MoveNote"
+	NotesService_AddNoteTags_FullMethodName         = "/api.notes.v1.NotesService/AddNoteTags"
+	NotesService_RemoveNoteTags_FullMethodName      = "/api.notes.v1.NotesService/RemoveNoteTags"
+	NotesService_ShareNote_FullMethodName           = "/api.notes.v1.NotesService/ShareNote"
+	NotesService_UnshareNote_FullMethodName         = "/api.notes.v1.NotesService/UnshareNote"
+	NotesService_ListNoteShares_FullMethodName      = "/api.notes.v1.NotesService/ListNoteShares"
+	NotesService_CreateShareLink_FullMethodName     = "/api.notes.v1.NotesService/CreateShareLink"
+	NotesService_RevokeShareLink_FullMethodName     = "/api.notes.v1.NotesService/RevokeShareLink"
+	NotesService_ListShareLinks_FullMethodName      = "/api.notes.v1.NotesService/ListShareLinks"
+	NotesService_CreateReminder_FullMethodName      = "/api.notes.v1.NotesService/CreateReminder"
+	NotesService_ListReminders_FullMethodName       = "/api.notes.v1.NotesService/ListReminders"
+	NotesService_UpdateReminder_FullMethodName      = "/api.notes.v1.NotesService/UpdateReminder"
+	NotesService_DeleteReminder_FullMethodName      = "/api.notes.v1.NotesService/DeleteReminder"
+	NotesService_ListNoteRevisions_FullMethodName   = "/api.notes.v1.NotesService/ListNoteRevisions"
+	NotesService_GetNoteRevision_FullMethodName     = "/api.notes.v1.NotesService/GetNoteRevision"
+	NotesService_DiffNoteRevisions_FullMethodName   = "/api.notes.v1.NotesService/DiffNoteRevisions"
+	NotesService_RestoreNoteRevision_FullMethodName = "/api.notes.v1.NotesService/RestoreNoteRevision"
+	NotesService_ListTags_FullMethodName            = "/api.notes.v1.NotesService/ListTags"
+	NotesService_RenameTag_FullMethodName           = "/api.notes.v1.NotesService/RenameTag"
+	NotesService_CreateNotebook_FullMethodName      = "/api.notes.v1.NotesService/CreateNotebook"
+	NotesService_ListNotebooks_FullMethodName       = "/api.notes.v1.NotesService/ListNotebooks"
+	NotesService_GetNotebook_FullMethodName         = "/api.notes.v1.NotesService/GetNotebook"
+	NotesService_UpdateNotebook_FullMethodName      = "/api.notes.v1.NotesService/UpdateNotebook"
+	NotesService_DeleteNotebook_FullMethodName      = "/api.notes.v1.NotesService/DeleteNotebook"
+	NotesService_CreateTemplate_FullMethodName      = "/api.notes.v1.NotesService/CreateTemplate"
+	NotesService_ListTemplates_FullMethodName       = "/api.notes.v1.NotesService/ListTemplates"
+	NotesService_GetTemplate_FullMethodName         = "/api.notes.v1.NotesService/GetTemplate"
+	NotesService_UpdateTemplate_FullMethodName      = "/api.notes.v1.NotesService/UpdateTemplate"
+	NotesService_DeleteTemplate_FullMethodName      = "/api.notes.v1.NotesService/DeleteTemplate"
+	NotesService_ListBacklinks_FullMethodName       = "/api.notes.v1.NotesService/ListBacklinks"
+	NotesService_ListOutgoingLinks_FullMethodName   = "/api.notes.v1.NotesService/ListOutgoingLinks"
+	NotesService_ListDanglingLinks_FullMethodName   = "/api.notes.v1.NotesService/ListDanglingLinks"
+	NotesService_ListTrashedNotes_FullMethodName    = "/api.notes.v1.NotesService/ListTrashedNotes"
+	NotesService_ListSharedNotes_FullMethodName     = "/api.notes.v1.NotesService/ListSharedNotes"
+	NotesService_SearchNotes_FullMethodName         = "/api.notes.v1.NotesService/SearchNotes"
+	NotesService_GetPublicNote_FullMethodName       = "/api.notes.v1.NotesService/GetPublicNote"
+	NotesService_RenderPublicNote_FullMethodName    = "/api.notes.v1.NotesService/RenderPublicNote"
+	NotesService_SubscribeToEvents_FullMethodName   = "/api.notes.v1.NotesService/SubscribeToEvents"
+	NotesService_ListEvents_FullMethodName          = "/api.notes.v1.NotesService/ListEvents"
+	NotesService_MarkEventsRead_FullMethodName      = "/api.notes.v1.NotesService/MarkEventsRead"
 )
 
 // NotesServiceClient is the client API for NotesService service.
@@ -32,16 +85,127 @@ const (
 //
 // NotesService provides CRUD operations for managing notes.
 type NotesServiceClient interface {
-	// ListNotes returns a list of notes.
+	// ListNotes returns a page of notes, sorted and filtered by the request parameters.
 	ListNotes(ctx context.Context, in *ListNotesRequest, opts ...grpc.CallOption) (*ListNotesResponse, error)
 	// RetrieveNote returns a single note by its unique identifier.
 	RetrieveNote(ctx context.Context, in *RetrieveNoteRequest, opts ...grpc.CallOption) (*RetrieveNoteResponse, error)
 	// CreateNote creates a new note and returns it with the unique identifier.
 	CreateNote(ctx context.Context, in *CreateNoteRequest, opts ...grpc.CallOption) (*CreateNoteResponse, error)
-	// DeleteNote deletes a note by its unique identifier.
+	// UpdateNote partially updates a note by its unique identifier using the update mask.
+	UpdateNote(ctx context.Context, in *UpdateNoteRequest, opts ...grpc.CallOption) (*UpdateNoteResponse, error)
+	// EditNote edits the content of a note together with its other editors, on any instance. The stream joins the note
+	// with the first message and gets the draft of the content, then it sends operations and cursors and gets those
+	// of the others. Operations are transformed against the ones applied first, so the content stays the same for
+	// everybody, and the draft is saved into the note from time to time and when the editor leaves.
+	EditNote(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[EditNoteRequest, EditNoteResponse], error)
+	// DeleteNote moves a note to the trash by its unique identifier.
 	DeleteNote(ctx context.Context, in *DeleteNoteRequest, opts ...grpc.CallOption) (*DeleteNoteResponse, error)
-	// SubscribeToEvents will notify about creation or deletion of notes.
+	// RestoreNote moves a note back from the trash by its unique identifier.
+	RestoreNote(ctx context.Context, in *RestoreNoteRequest, opts ...grpc.CallOption) (*RestoreNoteResponse, error)
+	// PurgeNote permanently deletes a trashed note by its unique identifier.
+	PurgeNote(ctx context.Context, in *PurgeNoteRequest, opts ...grpc.CallOption) (*PurgeNoteResponse, error)
+	// BatchCreateNotes creates many notes in one transaction, reporting the result of every note.
+	BatchCreateNotes(ctx context.Context, in *BatchCreateNotesRequest, opts ...grpc.CallOption) (*BatchCreateNotesResponse, error)
+	// BatchDeleteNotes moves many notes to the trash in one transaction, reporting the result of every note.
+	BatchDeleteNotes(ctx context.Context, in *BatchDeleteNotesRequest, opts ...grpc.CallOption) (*BatchDeleteNotesResponse, error)
+	// BatchGetNotes returns many notes by their unique identifiers, reporting the result of every note.
+	BatchGetNotes(ctx context.Context, in *BatchGetNotesRequest, opts ...grpc.CallOption) (*BatchGetNotesResponse, error)
+	// ExportNotes streams an archive of all notes of the user, with their tags and timestamps, in chunks.
+	ExportNotes(ctx context.Context, in *ExportNotesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportNotesResponse], error)
+	// ImportNotes creates notes from an archive streamed in chunks, skipping duplicates and reporting the result of every note.
+	ImportNotes(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[ImportNotesRequest, ImportNotesResponse], error)
+	// UploadAttachment attaches a file streamed in chunks to a note.
+	UploadAttachment(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[UploadAttachmentRequest, UploadAttachmentResponse], error)
+	// DownloadAttachment streams a file attached to a note in chunks.
+	DownloadAttachment(ctx context.Context, in *DownloadAttachmentRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[DownloadAttachmentResponse], error)
+	// ListAttachments lists files attached to a note.
+	ListAttachments(ctx context.Context, in *ListAttachmentsRequest, opts ...grpc.CallOption) (*ListAttachmentsResponse, error)
+	// DeleteAttachment removes a file from a note.
+	DeleteAttachment(ctx context.Context, in *DeleteAttachmentRequest, opts ...grpc.CallOption) (*DeleteAttachmentResponse, error)
+	// MoveNote moves a note into a notebook or to the root.
+	MoveNote(ctx context.Context, in *MoveNoteRequest, opts ...grpc.CallOption) (*MoveNoteResponse, error)
+	// AddNoteTags attaches tags to a note, creating the tags that do not exist yet.
+	AddNoteTags(ctx context.Context, in *AddNoteTagsRequest, opts ...grpc.CallOption) (*AddNoteTagsResponse, error)
+	// RemoveNoteTags detaches tags from a note.
+	RemoveNoteTags(ctx context.Context, in *RemoveNoteTagsRequest, opts ...grpc.CallOption) (*RemoveNoteTagsResponse, error)
+	// ShareNote grants another user, found by email, viewer or editor access to a note.
+	ShareNote(ctx context.Context, in *ShareNoteRequest, opts ...grpc.CallOption) (*ShareNoteResponse, error)
+	// UnshareNote revokes access to a note from another user.
+	UnshareNote(ctx context.Context, in *UnshareNoteRequest, opts ...grpc.CallOption) (*UnshareNoteResponse, error)
+	// ListNoteShares returns the collaborators of a note, only the owner can list them.
+	ListNoteShares(ctx context.Context, in *ListNoteSharesRequest, opts ...grpc.CallOption) (*ListNoteSharesResponse, error)
+	// CreateShareLink mints a public read-only link to a note, optionally expiring.
+	CreateShareLink(ctx context.Context, in *CreateShareLinkRequest, opts ...grpc.CallOption) (*CreateShareLinkResponse, error)
+	// RevokeShareLink revokes a public link to a note, the link stops working immediately.
+	RevokeShareLink(ctx context.Context, in *RevokeShareLinkRequest, opts ...grpc.CallOption) (*RevokeShareLinkResponse, error)
+	// ListShareLinks returns public links to a note with the number of views of each of them.
+	ListShareLinks(ctx context.Context, in *ListShareLinksRequest, opts ...grpc.CallOption) (*ListShareLinksResponse, error)
+	// CreateReminder reminds the user about a note at a time, once or by a recurrence rule.
+	// A fired reminder arrives as the EVENT_TYPE_REMINDER event on the event stream of the user.
+	CreateReminder(ctx context.Context, in *CreateReminderRequest, opts ...grpc.CallOption) (*CreateReminderResponse, error)
+	// ListReminders returns the reminders of the user about a note, the next to fire first.
+	ListReminders(ctx context.Context, in *ListRemindersRequest, opts ...grpc.CallOption) (*ListRemindersResponse, error)
+	// UpdateReminder reschedules a reminder, the changed reminder starts over from its time.
+	UpdateReminder(ctx context.Context, in *UpdateReminderRequest, opts ...grpc.CallOption) (*UpdateReminderResponse, error)
+	// DeleteReminder deletes a reminder, it does not fire anymore.
+	DeleteReminder(ctx context.Context, in *DeleteReminderRequest, opts ...grpc.CallOption) (*DeleteReminderResponse, error)
+	// ListNoteRevisions returns a page of revisions of a note, newest first.
+	ListNoteRevisions(ctx context.Context, in *ListNoteRevisionsRequest, opts ...grpc.CallOption) (*ListNoteRevisionsResponse, error)
+	// GetNoteRevision returns a single revision of a note by its number.
+	GetNoteRevision(ctx context.Context, in *GetNoteRevisionRequest, opts ...grpc.CallOption) (*GetNoteRevisionResponse, error)
+	// DiffNoteRevisions returns the line-level diff of the content between two revisions of a note.
+	DiffNoteRevisions(ctx context.Context, in *DiffNoteRevisionsRequest, opts ...grpc.CallOption) (*DiffNoteRevisionsResponse, error)
+	// RestoreNoteRevision brings a note back to a previous revision, recording the restore as a new revision.
+	RestoreNoteRevision(ctx context.Context, in *RestoreNoteRevisionRequest, opts ...grpc.CallOption) (*RestoreNoteRevisionResponse, error)
+	// ListTags returns all tags of the user with the number of notes using each of them.
+	ListTags(ctx context.Context, in *ListTagsRequest, opts ...grpc.CallOption) (*ListTagsResponse, error)
+	// RenameTag renames a tag, renaming to an existing tag merges both tags.
+	RenameTag(ctx context.Context, in *RenameTagRequest, opts ...grpc.CallOption) (*RenameTagResponse, error)
+	// CreateNotebook creates a new notebook, optionally nested into another one.
+	CreateNotebook(ctx context.Context, in *CreateNotebookRequest, opts ...grpc.CallOption) (*CreateNotebookResponse, error)
+	// ListNotebooks returns all notebooks of the user.
+	ListNotebooks(ctx context.Context, in *ListNotebooksRequest, opts ...grpc.CallOption) (*ListNotebooksResponse, error)
+	// GetNotebook returns a single notebook by its unique identifier.
+	GetNotebook(ctx context.Context, in *GetNotebookRequest, opts ...grpc.CallOption) (*GetNotebookResponse, error)
+	// UpdateNotebook renames a notebook or moves it under another parent using the update mask.
+	UpdateNotebook(ctx context.Context, in *UpdateNotebookRequest, opts ...grpc.CallOption) (*UpdateNotebookResponse, error)
+	// DeleteNotebook deletes a notebook, moving its content to the root or trashing it depending on the mode.
+	DeleteNotebook(ctx context.Context, in *DeleteNotebookRequest, opts ...grpc.CallOption) (*DeleteNotebookResponse, error)
+	// CreateTemplate creates a new template of notes.
+	CreateTemplate(ctx context.Context, in *CreateTemplateRequest, opts ...grpc.CallOption) (*CreateTemplateResponse, error)
+	// ListTemplates returns all templates of the user.
+	ListTemplates(ctx context.Context, in *ListTemplatesRequest, opts ...grpc.CallOption) (*ListTemplatesResponse, error)
+	// GetTemplate returns a single template by its unique identifier.
+	GetTemplate(ctx context.Context, in *GetTemplateRequest, opts ...grpc.CallOption) (*GetTemplateResponse, error)
+	// UpdateTemplate updates the name, the title or the content of a template using the update mask.
+	UpdateTemplate(ctx context.Context, in *UpdateTemplateRequest, opts ...grpc.CallOption) (*UpdateTemplateResponse, error)
+	// DeleteTemplate deletes a template, the notes created from it stay as they are.
+	DeleteTemplate(ctx context.Context, in *DeleteTemplateRequest, opts ...grpc.CallOption) (*DeleteTemplateResponse, error)
+	// ListBacklinks returns the notes referring to a note by wiki links such as `[[42]]` or `[[Title]]`.
+	ListBacklinks(ctx context.Context, in *ListBacklinksRequest, opts ...grpc.CallOption) (*ListBacklinksResponse, error)
+	// ListOutgoingLinks returns the wiki links of a note together with the notes they refer to.
+	ListOutgoingLinks(ctx context.Context, in *ListOutgoingLinksRequest, opts ...grpc.CallOption) (*ListOutgoingLinksResponse, error)
+	// ListDanglingLinks returns the wiki links of all notes of the user referring to no existing note.
+	ListDanglingLinks(ctx context.Context, in *ListDanglingLinksRequest, opts ...grpc.CallOption) (*ListDanglingLinksResponse, error)
+	// ListTrashedNotes returns a page of notes in the trash, most recently deleted first.
+	ListTrashedNotes(ctx context.Context, in *ListTrashedNotesRequest, opts ...grpc.CallOption) (*ListTrashedNotesResponse, error)
+	// ListSharedNotes returns a page of notes other users shared with the user, most recently shared first.
+	ListSharedNotes(ctx context.Context, in *ListSharedNotesRequest, opts ...grpc.CallOption) (*ListSharedNotesResponse, error)
+	// SearchNotes returns notes matching the full-text query, ordered by relevance.
+	SearchNotes(ctx context.Context, in *SearchNotesRequest, opts ...grpc.CallOption) (*SearchNotesResponse, error)
+	// GetPublicNote returns a note by a public link, it does not require authentication.
+	GetPublicNote(ctx context.Context, in *GetPublicNoteRequest, opts ...grpc.CallOption) (*GetPublicNoteResponse, error)
+	// RenderPublicNote returns a note by a public link as a HTML page, it does not require authentication.
+	RenderPublicNote(ctx context.Context, in *RenderPublicNoteRequest, opts ...grpc.CallOption) (*httpbody.HttpBody, error)
+	// SubscribeToEvents will notify about creation, update, deletion, restoration, purging or sharing of notes.
+	// The stream stays open until the client goes away, heartbeats are sent while there are no events.
+	// Browsers may read the stream as Server-Sent Events from GET /api/v1/notes/events/stream with the same query
+	// parameters, events carry their position as the id and Last-Event-ID resumes the stream after it.
 	SubscribeToEvents(ctx context.Context, in *SubscribeToEventsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[SubscribeToEventsResponse], error)
+	// ListEvents returns a page of the history of events, most recent first.
+	ListEvents(ctx context.Context, in *ListEventsRequest, opts ...grpc.CallOption) (*ListEventsResponse, error)
+	// MarkEventsRead marks events as read by their ids or up to a time, the unread counter counts only the rest.
+	MarkEventsRead(ctx context.Context, in *MarkEventsReadRequest, opts ...grpc.CallOption) (*MarkEventsReadResponse, error)
 }
 
 type notesServiceClient struct {
@@ -82,6 +246,29 @@ func (c *notesServiceClient) CreateNote(ctx context.Context, in *CreateNoteReque
 	return out, nil
 }
 
+func (c *notesServiceClient) UpdateNote(ctx context.Context, in *UpdateNoteRequest, opts ...grpc.CallOption) (*UpdateNoteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateNoteResponse)
+	err := c.cc.Invoke(ctx, NotesService_UpdateNote_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notesServiceClient) EditNote(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[EditNoteRequest, EditNoteResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &NotesService_ServiceDesc.Streams[0], NotesService_EditNote_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[EditNoteRequest, EditNoteResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type NotesService_EditNoteClient = grpc.BidiStreamingClient[EditNoteRequest, EditNoteResponse]
+
 func (c *notesServiceClient) DeleteNote(ctx context.Context, in *DeleteNoteRequest, opts ...grpc.CallOption) (*DeleteNoteResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(DeleteNoteResponse)
@@ -92,155 +279,1799 @@ func (c *notesServiceClient) DeleteNote(ctx context.Context, in *DeleteNoteReque
 	return out, nil
 }
 
-func (c *notesServiceClient) SubscribeToEvents(ctx context.Context, in *SubscribeToEventsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[SubscribeToEventsResponse], error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	stream, err := c.cc.NewStream(ctx, &NotesService_ServiceDesc.Streams[0], NotesService_SubscribeToEvents_FullMethodName, cOpts...)
-	if err != nil {
+func (c *notesServiceClient) RestoreNote(ctx context.Context, in *RestoreNoteRequest, opts ...grpc.CallOption) (*RestoreNoteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RestoreNoteResponse)
+	err := c.cc.Invoke(ctx, NotesService_RestoreNote_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notesServiceClient) PurgeNote(ctx context.Context, in *PurgeNoteRequest, opts ...grpc.CallOption) (*PurgeNoteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PurgeNoteResponse)
+	err := c.cc.Invoke(ctx, NotesService_PurgeNote_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notesServiceClient) BatchCreateNotes(ctx context.Context, in *BatchCreateNotesRequest, opts ...grpc.CallOption) (*BatchCreateNotesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchCreateNotesResponse)
+	err := c.cc.Invoke(ctx, NotesService_BatchCreateNotes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notesServiceClient) BatchDeleteNotes(ctx context.Context, in *BatchDeleteNotesRequest, opts ...grpc.CallOption) (*BatchDeleteNotesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchDeleteNotesResponse)
+	err := c.cc.Invoke(ctx, NotesService_BatchDeleteNotes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notesServiceClient) BatchGetNotes(ctx context.Context, in *BatchGetNotesRequest, opts ...grpc.CallOption) (*BatchGetNotesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchGetNotesResponse)
+	err := c.cc.Invoke(ctx, NotesService_BatchGetNotes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notesServiceClient) ExportNotes(ctx context.Context, in *ExportNotesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportNotesResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &NotesService_ServiceDesc.Streams[1], NotesService_ExportNotes_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ExportNotesRequest, ExportNotesResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type NotesService_ExportNotesClient = grpc.ServerStreamingClient[ExportNotesResponse]
+
+func (c *notesServiceClient) ImportNotes(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[ImportNotesRequest, ImportNotesResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &NotesService_ServiceDesc.Streams[2], NotesService_ImportNotes_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ImportNotesRequest, ImportNotesResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type NotesService_ImportNotesClient = grpc.ClientStreamingClient[ImportNotesRequest, ImportNotesResponse]
+
+func (c *notesServiceClient) UploadAttachment(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[UploadAttachmentRequest, UploadAttachmentResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &NotesService_ServiceDesc.Streams[3], NotesService_UploadAttachment_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[UploadAttachmentRequest, UploadAttachmentResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type NotesService_UploadAttachmentClient = grpc.ClientStreamingClient[UploadAttachmentRequest, UploadAttachmentResponse]
+
+func (c *notesServiceClient) DownloadAttachment(ctx context.Context, in *DownloadAttachmentRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[DownloadAttachmentResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &NotesService_ServiceDesc.Streams[4], NotesService_DownloadAttachment_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[DownloadAttachmentRequest, DownloadAttachmentResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type NotesService_DownloadAttachmentClient = grpc.ServerStreamingClient[DownloadAttachmentResponse]
+
+func (c *notesServiceClient) ListAttachments(ctx context.Context, in *ListAttachmentsRequest, opts ...grpc.CallOption) (*ListAttachmentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAttachmentsResponse)
+	err := c.cc.Invoke(ctx, NotesService_ListAttachments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notesServiceClient) DeleteAttachment(ctx context.Context, in *DeleteAttachmentRequest, opts ...grpc.CallOption) (*DeleteAttachmentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteAttachmentResponse)
+	err := c.cc.Invoke(ctx, NotesService_DeleteAttachment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notesServiceClient) MoveNote(ctx context.Context, in *MoveNoteRequest, opts ...grpc.CallOption) (*MoveNoteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MoveNoteResponse)
+	err := c.cc.Invoke(ctx, NotesService_MoveNote_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notesServiceClient) AddNoteTags(ctx context.Context, in *AddNoteTagsRequest, opts ...grpc.CallOption) (*AddNoteTagsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddNoteTagsResponse)
+	err := c.cc.Invoke(ctx, NotesService_AddNoteTags_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notesServiceClient) RemoveNoteTags(ctx context.Context, in *RemoveNoteTagsRequest, opts ...grpc.CallOption) (*RemoveNoteTagsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveNoteTagsResponse)
+	err := c.cc.Invoke(ctx, NotesService_RemoveNoteTags_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notesServiceClient) ShareNote(ctx context.Context, in *ShareNoteRequest, opts ...grpc.CallOption) (*ShareNoteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ShareNoteResponse)
+	err := c.cc.Invoke(ctx, NotesService_ShareNote_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notesServiceClient) UnshareNote(ctx context.Context, in *UnshareNoteRequest, opts ...grpc.CallOption) (*UnshareNoteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnshareNoteResponse)
+	err := c.cc.Invoke(ctx, NotesService_UnshareNote_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notesServiceClient) ListNoteShares(ctx context.Context, in *ListNoteSharesRequest, opts ...grpc.CallOption) (*ListNoteSharesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListNoteSharesResponse)
+	err := c.cc.Invoke(ctx, NotesService_ListNoteShares_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notesServiceClient) CreateShareLink(ctx context.Context, in *CreateShareLinkRequest, opts ...grpc.CallOption) (*CreateShareLinkResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateShareLinkResponse)
+	err := c.cc.Invoke(ctx, NotesService_CreateShareLink_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notesServiceClient) RevokeShareLink(ctx context.Context, in *RevokeShareLinkRequest, opts ...grpc.CallOption) (*RevokeShareLinkResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevokeShareLinkResponse)
+	err := c.cc.Invoke(ctx, NotesService_RevokeShareLink_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notesServiceClient) ListShareLinks(ctx context.Context, in *ListShareLinksRequest, opts ...grpc.CallOption) (*ListShareLinksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListShareLinksResponse)
+	err := c.cc.Invoke(ctx, NotesService_ListShareLinks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notesServiceClient) CreateReminder(ctx context.Context, in *CreateReminderRequest, opts ...grpc.CallOption) (*CreateReminderResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateReminderResponse)
+	err := c.cc.Invoke(ctx, NotesService_CreateReminder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notesServiceClient) ListReminders(ctx context.Context, in *ListRemindersRequest, opts ...grpc.CallOption) (*ListRemindersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListRemindersResponse)
+	err := c.cc.Invoke(ctx, NotesService_ListReminders_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notesServiceClient) UpdateReminder(ctx context.Context, in *UpdateReminderRequest, opts ...grpc.CallOption) (*UpdateReminderResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateReminderResponse)
+	err := c.cc.Invoke(ctx, NotesService_UpdateReminder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notesServiceClient) DeleteReminder(ctx context.Context, in *DeleteReminderRequest, opts ...grpc.CallOption) (*DeleteReminderResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteReminderResponse)
+	err := c.cc.Invoke(ctx, NotesService_DeleteReminder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notesServiceClient) ListNoteRevisions(ctx context.Context, in *ListNoteRevisionsRequest, opts ...grpc.CallOption) (*ListNoteRevisionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListNoteRevisionsResponse)
+	err := c.cc.Invoke(ctx, NotesService_ListNoteRevisions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notesServiceClient) GetNoteRevision(ctx context.Context, in *GetNoteRevisionRequest, opts ...grpc.CallOption) (*GetNoteRevisionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetNoteRevisionResponse)
+	err := c.cc.Invoke(ctx, NotesService_GetNoteRevision_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notesServiceClient) DiffNoteRevisions(ctx context.Context, in *DiffNoteRevisionsRequest, opts ...grpc.CallOption) (*DiffNoteRevisionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DiffNoteRevisionsResponse)
+	err := c.cc.Invoke(ctx, NotesService_DiffNoteRevisions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notesServiceClient) RestoreNoteRevision(ctx context.Context, in *RestoreNoteRevisionRequest, opts ...grpc.CallOption) (*RestoreNoteRevisionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RestoreNoteRevisionResponse)
+	err := c.cc.Invoke(ctx, NotesService_RestoreNoteRevision_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notesServiceClient) ListTags(ctx context.Context, in *ListTagsRequest, opts ...grpc.CallOption) (*ListTagsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTagsResponse)
+	err := c.cc.Invoke(ctx, NotesService_ListTags_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notesServiceClient) RenameTag(ctx context.Context, in *RenameTagRequest, opts ...grpc.CallOption) (*RenameTagResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RenameTagResponse)
+	err := c.cc.Invoke(ctx, NotesService_RenameTag_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notesServiceClient) CreateNotebook(ctx context.Context, in *CreateNotebookRequest, opts ...grpc.CallOption) (*CreateNotebookResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateNotebookResponse)
+	err := c.cc.Invoke(ctx, NotesService_CreateNotebook_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notesServiceClient) ListNotebooks(ctx context.Context, in *ListNotebooksRequest, opts ...grpc.CallOption) (*ListNotebooksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListNotebooksResponse)
+	err := c.cc.Invoke(ctx, NotesService_ListNotebooks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notesServiceClient) GetNotebook(ctx context.Context, in *GetNotebookRequest, opts ...grpc.CallOption) (*GetNotebookResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetNotebookResponse)
+	err := c.cc.Invoke(ctx, NotesService_GetNotebook_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notesServiceClient) UpdateNotebook(ctx context.Context, in *UpdateNotebookRequest, opts ...grpc.CallOption) (*UpdateNotebookResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateNotebookResponse)
+	err := c.cc.Invoke(ctx, NotesService_UpdateNotebook_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notesServiceClient) DeleteNotebook(ctx context.Context, in *DeleteNotebookRequest, opts ...grpc.CallOption) (*DeleteNotebookResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteNotebookResponse)
+	err := c.cc.Invoke(ctx, NotesService_DeleteNotebook_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notesServiceClient) CreateTemplate(ctx context.Context, in *CreateTemplateRequest, opts ...grpc.CallOption) (*CreateTemplateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateTemplateResponse)
+	err := c.cc.Invoke(ctx, NotesService_CreateTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notesServiceClient) ListTemplates(ctx context.Context, in *ListTemplatesRequest, opts ...grpc.CallOption) (*ListTemplatesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTemplatesResponse)
+	err := c.cc.Invoke(ctx, NotesService_ListTemplates_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notesServiceClient) GetTemplate(ctx context.Context, in *GetTemplateRequest, opts ...grpc.CallOption) (*GetTemplateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTemplateResponse)
+	err := c.cc.Invoke(ctx, NotesService_GetTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notesServiceClient) UpdateTemplate(ctx context.Context, in *UpdateTemplateRequest, opts ...grpc.CallOption) (*UpdateTemplateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateTemplateResponse)
+	err := c.cc.Invoke(ctx, NotesService_UpdateTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notesServiceClient) DeleteTemplate(ctx context.Context, in *DeleteTemplateRequest, opts ...grpc.CallOption) (*DeleteTemplateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteTemplateResponse)
+	err := c.cc.Invoke(ctx, NotesService_DeleteTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notesServiceClient) ListBacklinks(ctx context.Context, in *ListBacklinksRequest, opts ...grpc.CallOption) (*ListBacklinksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListBacklinksResponse)
+	err := c.cc.Invoke(ctx, NotesService_ListBacklinks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notesServiceClient) ListOutgoingLinks(ctx context.Context, in *ListOutgoingLinksRequest, opts ...grpc.CallOption) (*ListOutgoingLinksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListOutgoingLinksResponse)
+	err := c.cc.Invoke(ctx, NotesService_ListOutgoingLinks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notesServiceClient) ListDanglingLinks(ctx context.Context, in *ListDanglingLinksRequest, opts ...grpc.CallOption) (*ListDanglingLinksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListDanglingLinksResponse)
+	err := c.cc.Invoke(ctx, NotesService_ListDanglingLinks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notesServiceClient) ListTrashedNotes(ctx context.Context, in *ListTrashedNotesRequest, opts ...grpc.CallOption) (*ListTrashedNotesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTrashedNotesResponse)
+	err := c.cc.Invoke(ctx, NotesService_ListTrashedNotes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notesServiceClient) ListSharedNotes(ctx context.Context, in *ListSharedNotesRequest, opts ...grpc.CallOption) (*ListSharedNotesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSharedNotesResponse)
+	err := c.cc.Invoke(ctx, NotesService_ListSharedNotes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notesServiceClient) SearchNotes(ctx context.Context, in *SearchNotesRequest, opts ...grpc.CallOption) (*SearchNotesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchNotesResponse)
+	err := c.cc.Invoke(ctx, NotesService_SearchNotes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notesServiceClient) GetPublicNote(ctx context.Context, in *GetPublicNoteRequest, opts ...grpc.CallOption) (*GetPublicNoteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPublicNoteResponse)
+	err := c.cc.Invoke(ctx, NotesService_GetPublicNote_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notesServiceClient) RenderPublicNote(ctx context.Context, in *RenderPublicNoteRequest, opts ...grpc.CallOption) (*httpbody.HttpBody, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(httpbody.HttpBody)
+	err := c.cc.Invoke(ctx, NotesService_RenderPublicNote_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notesServiceClient) SubscribeToEvents(ctx context.Context, in *SubscribeToEventsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[SubscribeToEventsResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &NotesService_ServiceDesc.Streams[5], NotesService_SubscribeToEvents_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[SubscribeToEventsRequest, SubscribeToEventsResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type NotesService_SubscribeToEventsClient = grpc.ServerStreamingClient[SubscribeToEventsResponse]
+
+func (c *notesServiceClient) ListEvents(ctx context.Context, in *ListEventsRequest, opts ...grpc.CallOption) (*ListEventsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListEventsResponse)
+	err := c.cc.Invoke(ctx, NotesService_ListEvents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notesServiceClient) MarkEventsRead(ctx context.Context, in *MarkEventsReadRequest, opts ...grpc.CallOption) (*MarkEventsReadResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MarkEventsReadResponse)
+	err := c.cc.Invoke(ctx, NotesService_MarkEventsRead_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// NotesServiceServer is the server API for NotesService service.
+// All implementations must embed UnimplementedNotesServiceServer
+// for forward compatibility.
+//
+// NotesService provides CRUD operations for managing notes.
+type NotesServiceServer interface {
+	// ListNotes returns a page of notes, sorted and filtered by the request parameters.
+	ListNotes(context.Context, *ListNotesRequest) (*ListNotesResponse, error)
+	// RetrieveNote returns a single note by its unique identifier.
+	RetrieveNote(context.Context, *RetrieveNoteRequest) (*RetrieveNoteResponse, error)
+	// CreateNote creates a new note and returns it with the unique identifier.
+	CreateNote(context.Context, *CreateNoteRequest) (*CreateNoteResponse, error)
+	// UpdateNote partially updates a note by its unique identifier using the update mask.
+	UpdateNote(context.Context, *UpdateNoteRequest) (*UpdateNoteResponse, error)
+	// EditNote edits the content of a note together with its other editors, on any instance. The stream joins the note
+	// with the first message and gets the draft of the content, then it sends operations and cursors and gets those
+	// of the others. Operations are transformed against the ones applied first, so the content stays the same for
+	// everybody, and the draft is saved into the note from time to time and when the editor leaves.
+	EditNote(grpc.BidiStreamingServer[EditNoteRequest, EditNoteResponse]) error
+	// DeleteNote moves a note to the trash by its unique identifier.
+	DeleteNote(context.Context, *DeleteNoteRequest) (*DeleteNoteResponse, error)
+	// RestoreNote moves a note back from the trash by its unique identifier.
+	RestoreNote(context.Context, *RestoreNoteRequest) (*RestoreNoteResponse, error)
+	// PurgeNote permanently deletes a trashed note by its unique identifier.
+	PurgeNote(context.Context, *PurgeNoteRequest) (*PurgeNoteResponse, error)
+	// BatchCreateNotes creates many notes in one transaction, reporting the result of every note.
+	BatchCreateNotes(context.Context, *BatchCreateNotesRequest) (*BatchCreateNotesResponse, error)
+	// BatchDeleteNotes moves many notes to the trash in one transaction, reporting the result of every note.
+	BatchDeleteNotes(context.Context, *BatchDeleteNotesRequest) (*BatchDeleteNotesResponse, error)
+	// BatchGetNotes returns many notes by their unique identifiers, reporting the result of every note.
+	BatchGetNotes(context.Context, *BatchGetNotesRequest) (*BatchGetNotesResponse, error)
+	// ExportNotes streams an archive of all notes of the user, with their tags and timestamps, in chunks.
+	ExportNotes(*ExportNotesRequest, grpc.ServerStreamingServer[ExportNotesResponse]) error
+	// ImportNotes creates notes from an archive streamed in chunks, skipping duplicates and reporting the result of every note.
+	ImportNotes(grpc.ClientStreamingServer[ImportNotesRequest, ImportNotesResponse]) error
+	// UploadAttachment attaches a file streamed in chunks to a note.
+	UploadAttachment(grpc.ClientStreamingServer[UploadAttachmentRequest, UploadAttachmentResponse]) error
+	// DownloadAttachment streams a file attached to a note in chunks.
+	DownloadAttachment(*DownloadAttachmentRequest, grpc.ServerStreamingServer[DownloadAttachmentResponse]) error
+	// ListAttachments lists files attached to a note.
+	ListAttachments(context.Context, *ListAttachmentsRequest) (*ListAttachmentsResponse, error)
+	// DeleteAttachment removes a file from a note.
+	DeleteAttachment(context.Context, *DeleteAttachmentRequest) (*DeleteAttachmentResponse, error)
+	// MoveNote moves a note into a notebook or to the root.
+	MoveNote(context.Context, *MoveNoteRequest) (*MoveNoteResponse, error)
+	// AddNoteTags attaches tags to a note, creating the tags that do not exist yet.
+	AddNoteTags(context.Context, *AddNoteTagsRequest) (*AddNoteTagsResponse, error)
+	// RemoveNoteTags detaches tags from a note.
+	RemoveNoteTags(context.Context, *RemoveNoteTagsRequest) (*RemoveNoteTagsResponse, error)
+	// ShareNote grants another user, found by email, viewer or editor access to a note.
+	ShareNote(context.Context, *ShareNoteRequest) (*ShareNoteResponse, error)
+	// UnshareNote revokes access to a note from another user.
+	UnshareNote(context.Context, *UnshareNoteRequest) (*UnshareNoteResponse, error)
+	// ListNoteShares returns the collaborators of a note, only the owner can list them.
+	ListNoteShares(context.Context, *ListNoteSharesRequest) (*ListNoteSharesResponse, error)
+	// CreateShareLink mints a public read-only link to a note, optionally expiring.
+	CreateShareLink(context.Context, *CreateShareLinkRequest) (*CreateShareLinkResponse, error)
+	// RevokeShareLink revokes a public link to a note, the link stops working immediately.
+	RevokeShareLink(context.Context, *RevokeShareLinkRequest) (*RevokeShareLinkResponse, error)
+	// ListShareLinks returns public links to a note with the number of views of each of them.
+	ListShareLinks(context.Context, *ListShareLinksRequest) (*ListShareLinksResponse, error)
+	// CreateReminder reminds the user about a note at a time, once or by a recurrence rule.
+	// A fired reminder arrives as the EVENT_TYPE_REMINDER event on the event stream of the user.
+	CreateReminder(context.Context, *CreateReminderRequest) (*CreateReminderResponse, error)
+	// ListReminders returns the reminders of the user about a note, the next to fire first.
+	ListReminders(context.Context, *ListRemindersRequest) (*ListRemindersResponse, error)
+	// UpdateReminder reschedules a reminder, the changed reminder starts over from its time.
+	UpdateReminder(context.Context, *UpdateReminderRequest) (*UpdateReminderResponse, error)
+	// DeleteReminder deletes a reminder, it does not fire anymore.
+	DeleteReminder(context.Context, *DeleteReminderRequest) (*DeleteReminderResponse, error)
+	// ListNoteRevisions returns a page of revisions of a note, newest first.
+	ListNoteRevisions(context.Context, *ListNoteRevisionsRequest) (*ListNoteRevisionsResponse, error)
+	// GetNoteRevision returns a single revision of a note by its number.
+	GetNoteRevision(context.Context, *GetNoteRevisionRequest) (*GetNoteRevisionResponse, error)
+	// DiffNoteRevisions returns the line-level diff of the content between two revisions of a note.
+	DiffNoteRevisions(context.Context, *DiffNoteRevisionsRequest) (*DiffNoteRevisionsResponse, error)
+	// RestoreNoteRevision brings a note back to a previous revision, recording the restore as a new revision.
+	RestoreNoteRevision(context.Context, *RestoreNoteRevisionRequest) (*RestoreNoteRevisionResponse, error)
+	// ListTags returns all tags of the user with the number of notes using each of them.
+	ListTags(context.Context, *ListTagsRequest) (*ListTagsResponse, error)
+	// RenameTag renames a tag, renaming to an existing tag merges both tags.
+	RenameTag(context.Context, *RenameTagRequest) (*RenameTagResponse, error)
+	// CreateNotebook creates a new notebook, optionally nested into another one.
+	CreateNotebook(context.Context, *CreateNotebookRequest) (*CreateNotebookResponse, error)
+	// ListNotebooks returns all notebooks of the user.
+	ListNotebooks(context.Context, *ListNotebooksRequest) (*ListNotebooksResponse, error)
+	// GetNotebook returns a single notebook by its unique identifier.
+	GetNotebook(context.Context, *GetNotebookRequest) (*GetNotebookResponse, error)
+	// UpdateNotebook renames a notebook or moves it under another parent using the update mask.
+	UpdateNotebook(context.Context, *UpdateNotebookRequest) (*UpdateNotebookResponse, error)
+	// DeleteNotebook deletes a notebook, moving its content to the root or trashing it depending on the mode.
+	DeleteNotebook(context.Context, *DeleteNotebookRequest) (*DeleteNotebookResponse, error)
+	// CreateTemplate creates a new template of notes.
+	CreateTemplate(context.Context, *CreateTemplateRequest) (*CreateTemplateResponse, error)
+	// ListTemplates returns all templates of the user.
+	ListTemplates(context.Context, *ListTemplatesRequest) (*ListTemplatesResponse, error)
+	// GetTemplate returns a single template by its unique identifier.
+	GetTemplate(context.Context, *GetTemplateRequest) (*GetTemplateResponse, error)
+	// UpdateTemplate updates the name, the title or the content of a template using the update mask.
+	UpdateTemplate(context.Context, *UpdateTemplateRequest) (*UpdateTemplateResponse, error)
+	// DeleteTemplate deletes a template, the notes created from it stay as they are.
+	DeleteTemplate(context.Context, *DeleteTemplateRequest) (*DeleteTemplateResponse, error)
+	// ListBacklinks returns the notes referring to a note by wiki links such as `[[42]]` or `[[Title]]`.
+	ListBacklinks(context.Context, *ListBacklinksRequest) (*ListBacklinksResponse, error)
+	// ListOutgoingLinks returns the wiki links of a note together with the notes they refer to.
+	ListOutgoingLinks(context.Context, *ListOutgoingLinksRequest) (*ListOutgoingLinksResponse, error)
+	// ListDanglingLinks returns the wiki links of all notes of the user referring to no existing note.
+	ListDanglingLinks(context.Context, *ListDanglingLinksRequest) (*ListDanglingLinksResponse, error)
+	// ListTrashedNotes returns a page of notes in the trash, most recently deleted first.
+	ListTrashedNotes(context.Context, *ListTrashedNotesRequest) (*ListTrashedNotesResponse, error)
+	// ListSharedNotes returns a page of notes other users shared with the user, most recently shared first.
+	ListSharedNotes(context.Context, *ListSharedNotesRequest) (*ListSharedNotesResponse, error)
+	// SearchNotes returns notes matching the full-text query, ordered by relevance.
+	SearchNotes(context.Context, *SearchNotesRequest) (*SearchNotesResponse, error)
+	// GetPublicNote returns a note by a public link, it does not require authentication.
+	GetPublicNote(context.Context, *GetPublicNoteRequest) (*GetPublicNoteResponse, error)
+	// RenderPublicNote returns a note by a public link as a HTML page, it does not require authentication.
+	RenderPublicNote(context.Context, *RenderPublicNoteRequest) (*httpbody.HttpBody, error)
+	// SubscribeToEvents will notify about creation, update, deletion, restoration, purging or sharing of notes.
+	// The stream stays open until the client goes away, heartbeats are sent while there are no events.
+	// Browsers may read the stream as Server-Sent Events from GET /api/v1/notes/events/stream with the same query
+	// parameters, events carry their position as the id and Last-Event-ID resumes the stream after it.
+	SubscribeToEvents(*SubscribeToEventsRequest, grpc.ServerStreamingServer[SubscribeToEventsResponse]) error
+	// ListEvents returns a page of the history of events, most recent first.
+	ListEvents(context.Context, *ListEventsRequest) (*ListEventsResponse, error)
+	// MarkEventsRead marks events as read by their ids or up to a time, the unread counter counts only the rest.
+	MarkEventsRead(context.Context, *MarkEventsReadRequest) (*MarkEventsReadResponse, error)
+	mustEmbedUnimplementedNotesServiceServer()
+}
+
+// UnimplementedNotesServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedNotesServiceServer struct{}
+
+func (UnimplementedNotesServiceServer) ListNotes(context.Context, *ListNotesRequest) (*ListNotesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListNotes not implemented")
+}
+func (UnimplementedNotesServiceServer) RetrieveNote(context.Context, *RetrieveNoteRequest) (*RetrieveNoteResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RetrieveNote not implemented")
+}
+func (UnimplementedNotesServiceServer) CreateNote(context.Context, *CreateNoteRequest) (*CreateNoteResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateNote not implemented")
+}
+func (UnimplementedNotesServiceServer) UpdateNote(context.Context, *UpdateNoteRequest) (*UpdateNoteResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateNote not implemented")
+}
+func (UnimplementedNotesServiceServer) EditNote(grpc.BidiStreamingServer[EditNoteRequest, EditNoteResponse]) error {
+	return status.Error(codes.Unimplemented, "method EditNote not implemented")
+}
+func (UnimplementedNotesServiceServer) DeleteNote(context.Context, *DeleteNoteRequest) (*DeleteNoteResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteNote not implemented")
+}
+func (UnimplementedNotesServiceServer) RestoreNote(context.Context, *RestoreNoteRequest) (*RestoreNoteResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RestoreNote not implemented")
+}
+func (UnimplementedNotesServiceServer) PurgeNote(context.Context, *PurgeNoteRequest) (*PurgeNoteResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method PurgeNote not implemented")
+}
+func (UnimplementedNotesServiceServer) BatchCreateNotes(context.Context, *BatchCreateNotesRequest) (*BatchCreateNotesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method BatchCreateNotes not implemented")
+}
+func (UnimplementedNotesServiceServer) BatchDeleteNotes(context.Context, *BatchDeleteNotesRequest) (*BatchDeleteNotesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method BatchDeleteNotes not implemented")
+}
+func (UnimplementedNotesServiceServer) BatchGetNotes(context.Context, *BatchGetNotesRequest) (*BatchGetNotesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method BatchGetNotes not implemented")
+}
+func (UnimplementedNotesServiceServer) ExportNotes(*ExportNotesRequest, grpc.ServerStreamingServer[ExportNotesResponse]) error {
+	return status.Error(codes.Unimplemented, "method ExportNotes not implemented")
+}
+func (UnimplementedNotesServiceServer) ImportNotes(grpc.ClientStreamingServer[ImportNotesRequest, ImportNotesResponse]) error {
+	return status.Error(codes.Unimplemented, "method ImportNotes not implemented")
+}
+func (UnimplementedNotesServiceServer) UploadAttachment(grpc.ClientStreamingServer[UploadAttachmentRequest, UploadAttachmentResponse]) error {
+	return status.Error(codes.Unimplemented, "method UploadAttachment not implemented")
+}
+func (UnimplementedNotesServiceServer) DownloadAttachment(*DownloadAttachmentRequest, grpc.ServerStreamingServer[DownloadAttachmentResponse]) error {
+	return status.Error(codes.Unimplemented, "method DownloadAttachment not implemented")
+}
+func (UnimplementedNotesServiceServer) ListAttachments(context.Context, *ListAttachmentsRequest) (*ListAttachmentsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListAttachments not implemented")
+}
+func (UnimplementedNotesServiceServer) DeleteAttachment(context.Context, *DeleteAttachmentRequest) (*DeleteAttachmentResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteAttachment not implemented")
+}
+func (UnimplementedNotesServiceServer) MoveNote(context.Context, *MoveNoteRequest) (*MoveNoteResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method MoveNote not implemented")
+}
+func (UnimplementedNotesServiceServer) AddNoteTags(context.Context, *AddNoteTagsRequest) (*AddNoteTagsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method AddNoteTags not implemented")
+}
+func (UnimplementedNotesServiceServer) RemoveNoteTags(context.Context, *RemoveNoteTagsRequest) (*RemoveNoteTagsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RemoveNoteTags not implemented")
+}
+func (UnimplementedNotesServiceServer) ShareNote(context.Context, *ShareNoteRequest) (*ShareNoteResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ShareNote not implemented")
+}
+func (UnimplementedNotesServiceServer) UnshareNote(context.Context, *UnshareNoteRequest) (*UnshareNoteResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UnshareNote not implemented")
+}
+func (UnimplementedNotesServiceServer) ListNoteShares(context.Context, *ListNoteSharesRequest) (*ListNoteSharesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListNoteShares not implemented")
+}
+func (UnimplementedNotesServiceServer) CreateShareLink(context.Context, *CreateShareLinkRequest) (*CreateShareLinkResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateShareLink not implemented")
+}
+func (UnimplementedNotesServiceServer) RevokeShareLink(context.Context, *RevokeShareLinkRequest) (*RevokeShareLinkResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RevokeShareLink not implemented")
+}
+func (UnimplementedNotesServiceServer) ListShareLinks(context.Context, *ListShareLinksRequest) (*ListShareLinksResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListShareLinks not implemented")
+}
+func (UnimplementedNotesServiceServer) CreateReminder(context.Context, *CreateReminderRequest) (*CreateReminderResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateReminder not implemented")
+}
+func (UnimplementedNotesServiceServer) ListReminders(context.Context, *ListRemindersRequest) (*ListRemindersResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListReminders not implemented")
+}
+func (UnimplementedNotesServiceServer) UpdateReminder(context.Context, *UpdateReminderRequest) (*UpdateReminderResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateReminder not implemented")
+}
+func (UnimplementedNotesServiceServer) DeleteReminder(context.Context, *DeleteReminderRequest) (*DeleteReminderResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteReminder not implemented")
+}
+func (UnimplementedNotesServiceServer) ListNoteRevisions(context.Context, *ListNoteRevisionsRequest) (*ListNoteRevisionsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListNoteRevisions not implemented")
+}
+func (UnimplementedNotesServiceServer) GetNoteRevision(context.Context, *GetNoteRevisionRequest) (*GetNoteRevisionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetNoteRevision not implemented")
+}
+func (UnimplementedNotesServiceServer) DiffNoteRevisions(context.Context, *DiffNoteRevisionsRequest) (*DiffNoteRevisionsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DiffNoteRevisions not implemented")
+}
+func (UnimplementedNotesServiceServer) RestoreNoteRevision(context.Context, *RestoreNoteRevisionRequest) (*RestoreNoteRevisionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RestoreNoteRevision not implemented")
+}
+func (UnimplementedNotesServiceServer) ListTags(context.Context, *ListTagsRequest) (*ListTagsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListTags not implemented")
+}
+func (UnimplementedNotesServiceServer) RenameTag(context.Context, *RenameTagRequest) (*RenameTagResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RenameTag not implemented")
+}
+func (UnimplementedNotesServiceServer) CreateNotebook(context.Context, *CreateNotebookRequest) (*CreateNotebookResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateNotebook not implemented")
+}
+func (UnimplementedNotesServiceServer) ListNotebooks(context.Context, *ListNotebooksRequest) (*ListNotebooksResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListNotebooks not implemented")
+}
+func (UnimplementedNotesServiceServer) GetNotebook(context.Context, *GetNotebookRequest) (*GetNotebookResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetNotebook not implemented")
+}
+func (UnimplementedNotesServiceServer) UpdateNotebook(context.Context, *UpdateNotebookRequest) (*UpdateNotebookResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateNotebook not implemented")
+}
+func (UnimplementedNotesServiceServer) DeleteNotebook(context.Context, *DeleteNotebookRequest) (*DeleteNotebookResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteNotebook not implemented")
+}
+func (UnimplementedNotesServiceServer) CreateTemplate(context.Context, *CreateTemplateRequest) (*CreateTemplateResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateTemplate not implemented")
+}
+func (UnimplementedNotesServiceServer) ListTemplates(context.Context, *ListTemplatesRequest) (*ListTemplatesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListTemplates not implemented")
+}
+func (UnimplementedNotesServiceServer) GetTemplate(context.Context, *GetTemplateRequest) (*GetTemplateResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetTemplate not implemented")
+}
+func (UnimplementedNotesServiceServer) UpdateTemplate(context.Context, *UpdateTemplateRequest) (*UpdateTemplateResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateTemplate not implemented")
+}
+func (UnimplementedNotesServiceServer) DeleteTemplate(context.Context, *DeleteTemplateRequest) (*DeleteTemplateResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteTemplate not implemented")
+}
+func (UnimplementedNotesServiceServer) ListBacklinks(context.Context, *ListBacklinksRequest) (*ListBacklinksResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListBacklinks not implemented")
+}
+func (UnimplementedNotesServiceServer) ListOutgoingLinks(context.Context, *ListOutgoingLinksRequest) (*ListOutgoingLinksResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListOutgoingLinks not implemented")
+}
+func (UnimplementedNotesServiceServer) ListDanglingLinks(context.Context, *ListDanglingLinksRequest) (*ListDanglingLinksResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListDanglingLinks not implemented")
+}
+func (UnimplementedNotesServiceServer) ListTrashedNotes(context.Context, *ListTrashedNotesRequest) (*ListTrashedNotesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListTrashedNotes not implemented")
+}
+func (UnimplementedNotesServiceServer) ListSharedNotes(context.Context, *ListSharedNotesRequest) (*ListSharedNotesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListSharedNotes not implemented")
+}
+func (UnimplementedNotesServiceServer) SearchNotes(context.Context, *SearchNotesRequest) (*SearchNotesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SearchNotes not implemented")
+}
+func (UnimplementedNotesServiceServer) GetPublicNote(context.Context, *GetPublicNoteRequest) (*GetPublicNoteResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetPublicNote not implemented")
+}
+func (UnimplementedNotesServiceServer) RenderPublicNote(context.Context, *RenderPublicNoteRequest) (*httpbody.HttpBody, error) {
+	return nil, status.Error(codes.Unimplemented, "method RenderPublicNote not implemented")
+}
+func (UnimplementedNotesServiceServer) SubscribeToEvents(*SubscribeToEventsRequest, grpc.ServerStreamingServer[SubscribeToEventsResponse]) error {
+	return status.Error(codes.Unimplemented, "method SubscribeToEvents not implemented")
+}
+func (UnimplementedNotesServiceServer) ListEvents(context.Context, *ListEventsRequest) (*ListEventsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListEvents not implemented")
+}
+func (UnimplementedNotesServiceServer) MarkEventsRead(context.Context, *MarkEventsReadRequest) (*MarkEventsReadResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method MarkEventsRead not implemented")
+}
+func (UnimplementedNotesServiceServer) mustEmbedUnimplementedNotesServiceServer() {}
+func (UnimplementedNotesServiceServer) testEmbeddedByValue()                      {}
+
+// UnsafeNotesServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to NotesServiceServer will
+// result in compilation errors.
+type UnsafeNotesServiceServer interface {
+	mustEmbedUnimplementedNotesServiceServer()
+}
+
+func RegisterNotesServiceServer(s grpc.ServiceRegistrar, srv NotesServiceServer) {
+	// If the following call panics, it indicates UnimplementedNotesServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&NotesService_ServiceDesc, srv)
+}
+
+func _NotesService_ListNotes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListNotesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotesServiceServer).ListNotes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotesService_ListNotes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotesServiceServer).ListNotes(ctx, req.(*ListNotesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotesService_RetrieveNote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RetrieveNoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotesServiceServer).RetrieveNote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotesService_RetrieveNote_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotesServiceServer).RetrieveNote(ctx, req.(*RetrieveNoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotesService_CreateNote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateNoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotesServiceServer).CreateNote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotesService_CreateNote_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotesServiceServer).CreateNote(ctx, req.(*CreateNoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotesService_UpdateNote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateNoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotesServiceServer).UpdateNote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotesService_UpdateNote_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotesServiceServer).UpdateNote(ctx, req.(*UpdateNoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotesService_EditNote_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(NotesServiceServer).EditNote(&grpc.GenericServerStream[EditNoteRequest, EditNoteResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type NotesService_EditNoteServer = grpc.BidiStreamingServer[EditNoteRequest, EditNoteResponse]
+
+func _NotesService_DeleteNote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteNoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotesServiceServer).DeleteNote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotesService_DeleteNote_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotesServiceServer).DeleteNote(ctx, req.(*DeleteNoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotesService_RestoreNote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreNoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotesServiceServer).RestoreNote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotesService_RestoreNote_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotesServiceServer).RestoreNote(ctx, req.(*RestoreNoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotesService_PurgeNote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurgeNoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotesServiceServer).PurgeNote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotesService_PurgeNote_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotesServiceServer).PurgeNote(ctx, req.(*PurgeNoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotesService_BatchCreateNotes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchCreateNotesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotesServiceServer).BatchCreateNotes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotesService_BatchCreateNotes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotesServiceServer).BatchCreateNotes(ctx, req.(*BatchCreateNotesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotesService_BatchDeleteNotes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchDeleteNotesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotesServiceServer).BatchDeleteNotes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotesService_BatchDeleteNotes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotesServiceServer).BatchDeleteNotes(ctx, req.(*BatchDeleteNotesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotesService_BatchGetNotes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchGetNotesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotesServiceServer).BatchGetNotes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotesService_BatchGetNotes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotesServiceServer).BatchGetNotes(ctx, req.(*BatchGetNotesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotesService_ExportNotes_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportNotesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(NotesServiceServer).ExportNotes(m, &grpc.GenericServerStream[ExportNotesRequest, ExportNotesResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type NotesService_ExportNotesServer = grpc.ServerStreamingServer[ExportNotesResponse]
+
+func _NotesService_ImportNotes_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(NotesServiceServer).ImportNotes(&grpc.GenericServerStream[ImportNotesRequest, ImportNotesResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type NotesService_ImportNotesServer = grpc.ClientStreamingServer[ImportNotesRequest, ImportNotesResponse]
+
+func _NotesService_UploadAttachment_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(NotesServiceServer).UploadAttachment(&grpc.GenericServerStream[UploadAttachmentRequest, UploadAttachmentResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type NotesService_UploadAttachmentServer = grpc.ClientStreamingServer[UploadAttachmentRequest, UploadAttachmentResponse]
+
+func _NotesService_DownloadAttachment_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(DownloadAttachmentRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(NotesServiceServer).DownloadAttachment(m, &grpc.GenericServerStream[DownloadAttachmentRequest, DownloadAttachmentResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type NotesService_DownloadAttachmentServer = grpc.ServerStreamingServer[DownloadAttachmentResponse]
+
+func _NotesService_ListAttachments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAttachmentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotesServiceServer).ListAttachments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotesService_ListAttachments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotesServiceServer).ListAttachments(ctx, req.(*ListAttachmentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotesService_DeleteAttachment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAttachmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotesServiceServer).DeleteAttachment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotesService_DeleteAttachment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotesServiceServer).DeleteAttachment(ctx, req.(*DeleteAttachmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotesService_MoveNote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MoveNoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotesServiceServer).MoveNote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotesService_MoveNote_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotesServiceServer).MoveNote(ctx, req.(*MoveNoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotesService_AddNoteTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddNoteTagsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotesServiceServer).AddNoteTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotesService_AddNoteTags_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotesServiceServer).AddNoteTags(ctx, req.(*AddNoteTagsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotesService_RemoveNoteTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveNoteTagsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotesServiceServer).RemoveNoteTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotesService_RemoveNoteTags_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotesServiceServer).RemoveNoteTags(ctx, req.(*RemoveNoteTagsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotesService_ShareNote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ShareNoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotesServiceServer).ShareNote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotesService_ShareNote_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotesServiceServer).ShareNote(ctx, req.(*ShareNoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotesService_UnshareNote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnshareNoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotesServiceServer).UnshareNote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotesService_UnshareNote_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotesServiceServer).UnshareNote(ctx, req.(*UnshareNoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotesService_ListNoteShares_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListNoteSharesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotesServiceServer).ListNoteShares(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotesService_ListNoteShares_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotesServiceServer).ListNoteShares(ctx, req.(*ListNoteSharesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotesService_CreateShareLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateShareLinkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotesServiceServer).CreateShareLink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotesService_CreateShareLink_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotesServiceServer).CreateShareLink(ctx, req.(*CreateShareLinkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotesService_RevokeShareLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeShareLinkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotesServiceServer).RevokeShareLink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotesService_RevokeShareLink_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotesServiceServer).RevokeShareLink(ctx, req.(*RevokeShareLinkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotesService_ListShareLinks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListShareLinksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotesServiceServer).ListShareLinks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotesService_ListShareLinks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotesServiceServer).ListShareLinks(ctx, req.(*ListShareLinksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotesService_CreateReminder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateReminderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotesServiceServer).CreateReminder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotesService_CreateReminder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotesServiceServer).CreateReminder(ctx, req.(*CreateReminderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotesService_ListReminders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRemindersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotesServiceServer).ListReminders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotesService_ListReminders_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotesServiceServer).ListReminders(ctx, req.(*ListRemindersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotesService_UpdateReminder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateReminderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotesServiceServer).UpdateReminder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotesService_UpdateReminder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotesServiceServer).UpdateReminder(ctx, req.(*UpdateReminderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotesService_DeleteReminder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteReminderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotesServiceServer).DeleteReminder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotesService_DeleteReminder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotesServiceServer).DeleteReminder(ctx, req.(*DeleteReminderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotesService_ListNoteRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListNoteRevisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotesServiceServer).ListNoteRevisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotesService_ListNoteRevisions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotesServiceServer).ListNoteRevisions(ctx, req.(*ListNoteRevisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotesService_GetNoteRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNoteRevisionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotesServiceServer).GetNoteRevision(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotesService_GetNoteRevision_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotesServiceServer).GetNoteRevision(ctx, req.(*GetNoteRevisionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotesService_DiffNoteRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiffNoteRevisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotesServiceServer).DiffNoteRevisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotesService_DiffNoteRevisions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotesServiceServer).DiffNoteRevisions(ctx, req.(*DiffNoteRevisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotesService_RestoreNoteRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreNoteRevisionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotesServiceServer).RestoreNoteRevision(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotesService_RestoreNoteRevision_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotesServiceServer).RestoreNoteRevision(ctx, req.(*RestoreNoteRevisionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotesService_ListTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTagsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotesServiceServer).ListTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotesService_ListTags_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotesServiceServer).ListTags(ctx, req.(*ListTagsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotesService_RenameTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenameTagRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotesServiceServer).RenameTag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotesService_RenameTag_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotesServiceServer).RenameTag(ctx, req.(*RenameTagRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotesService_CreateNotebook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateNotebookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotesServiceServer).CreateNotebook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotesService_CreateNotebook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotesServiceServer).CreateNotebook(ctx, req.(*CreateNotebookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotesService_ListNotebooks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListNotebooksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotesServiceServer).ListNotebooks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotesService_ListNotebooks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotesServiceServer).ListNotebooks(ctx, req.(*ListNotebooksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotesService_GetNotebook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNotebookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotesServiceServer).GetNotebook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotesService_GetNotebook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotesServiceServer).GetNotebook(ctx, req.(*GetNotebookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotesService_UpdateNotebook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateNotebookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotesServiceServer).UpdateNotebook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotesService_UpdateNotebook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotesServiceServer).UpdateNotebook(ctx, req.(*UpdateNotebookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotesService_DeleteNotebook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteNotebookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotesServiceServer).DeleteNotebook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotesService_DeleteNotebook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotesServiceServer).DeleteNotebook(ctx, req.(*DeleteNotebookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotesService_CreateTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotesServiceServer).CreateTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotesService_CreateTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotesServiceServer).CreateTemplate(ctx, req.(*CreateTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotesService_ListTemplates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTemplatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotesServiceServer).ListTemplates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotesService_ListTemplates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotesServiceServer).ListTemplates(ctx, req.(*ListTemplatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotesService_GetTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotesServiceServer).GetTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotesService_GetTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotesServiceServer).GetTemplate(ctx, req.(*GetTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotesService_UpdateTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotesServiceServer).UpdateTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotesService_UpdateTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotesServiceServer).UpdateTemplate(ctx, req.(*UpdateTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotesService_DeleteTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotesServiceServer).DeleteTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotesService_DeleteTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotesServiceServer).DeleteTemplate(ctx, req.(*DeleteTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotesService_ListBacklinks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBacklinksRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	x := &grpc.GenericClientStream[SubscribeToEventsRequest, SubscribeToEventsResponse]{ClientStream: stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
+	if interceptor == nil {
+		return srv.(NotesServiceServer).ListBacklinks(ctx, in)
 	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotesService_ListBacklinks_FullMethodName,
 	}
-	return x, nil
-}
-
-// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
-type NotesService_SubscribeToEventsClient = grpc.ServerStreamingClient[SubscribeToEventsResponse]
-
-// NotesServiceServer is the server API for NotesService service.
-// All implementations must embed UnimplementedNotesServiceServer
-// for forward compatibility.
-//
-// NotesService provides CRUD operations for managing notes.
-type NotesServiceServer interface {
-	// ListNotes returns a list of notes.
-	ListNotes(context.Context, *ListNotesRequest) (*ListNotesResponse, error)
-	// RetrieveNote returns a single note by its unique identifier.
-	RetrieveNote(context.Context, *RetrieveNoteRequest) (*RetrieveNoteResponse, error)
-	// CreateNote creates a new note and returns it with the unique identifier.
-	CreateNote(context.Context, *CreateNoteRequest) (*CreateNoteResponse, error)
-	// DeleteNote deletes a note by its unique identifier.
-	DeleteNote(context.Context, *DeleteNoteRequest) (*DeleteNoteResponse, error)
-	// SubscribeToEvents will notify about creation or deletion of notes.
-	SubscribeToEvents(*SubscribeToEventsRequest, grpc.ServerStreamingServer[SubscribeToEventsResponse]) error
-	mustEmbedUnimplementedNotesServiceServer()
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotesServiceServer).ListBacklinks(ctx, req.(*ListBacklinksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-// UnimplementedNotesServiceServer must be embedded to have
-// forward compatible implementations.
-//
-// NOTE: this should be embedded by value instead of pointer to avoid a nil
-// pointer dereference when methods are called.
-type UnimplementedNotesServiceServer struct{}
-
-func (UnimplementedNotesServiceServer) ListNotes(context.Context, *ListNotesRequest) (*ListNotesResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method ListNotes not implemented")
-}
-func (UnimplementedNotesServiceServer) RetrieveNote(context.Context, *RetrieveNoteRequest) (*RetrieveNoteResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method RetrieveNote not implemented")
-}
-func (UnimplementedNotesServiceServer) CreateNote(context.Context, *CreateNoteRequest) (*CreateNoteResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method CreateNote not implemented")
-}
-func (UnimplementedNotesServiceServer) DeleteNote(context.Context, *DeleteNoteRequest) (*DeleteNoteResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method DeleteNote not implemented")
-}
-func (UnimplementedNotesServiceServer) SubscribeToEvents(*SubscribeToEventsRequest, grpc.ServerStreamingServer[SubscribeToEventsResponse]) error {
-	return status.Error(codes.Unimplemented, "method SubscribeToEvents not implemented")
+func _NotesService_ListOutgoingLinks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListOutgoingLinksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotesServiceServer).ListOutgoingLinks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotesService_ListOutgoingLinks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotesServiceServer).ListOutgoingLinks(ctx, req.(*ListOutgoingLinksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedNotesServiceServer) mustEmbedUnimplementedNotesServiceServer() {}
-func (UnimplementedNotesServiceServer) testEmbeddedByValue()                      {}
 
-// UnsafeNotesServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to NotesServiceServer will
-// result in compilation errors.
-type UnsafeNotesServiceServer interface {
-	mustEmbedUnimplementedNotesServiceServer()
+func _NotesService_ListDanglingLinks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDanglingLinksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotesServiceServer).ListDanglingLinks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotesService_ListDanglingLinks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotesServiceServer).ListDanglingLinks(ctx, req.(*ListDanglingLinksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterNotesServiceServer(s grpc.ServiceRegistrar, srv NotesServiceServer) {
-	// If the following call panics, it indicates UnimplementedNotesServiceServer was
-	// embedded by pointer and is nil.  This will cause panics if an
-	// unimplemented method is ever invoked, so we test this at initialization
-	// time to prevent it from happening at runtime later due to I/O.
-	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
-		t.testEmbeddedByValue()
+func _NotesService_ListTrashedNotes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTrashedNotesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	s.RegisterService(&NotesService_ServiceDesc, srv)
+	if interceptor == nil {
+		return srv.(NotesServiceServer).ListTrashedNotes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotesService_ListTrashedNotes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotesServiceServer).ListTrashedNotes(ctx, req.(*ListTrashedNotesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _NotesService_ListNotes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListNotesRequest)
+func _NotesService_ListSharedNotes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSharedNotesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(NotesServiceServer).ListNotes(ctx, in)
+		return srv.(NotesServiceServer).ListSharedNotes(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: NotesService_ListNotes_FullMethodName,
+		FullMethod: NotesService_ListSharedNotes_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(NotesServiceServer).ListNotes(ctx, req.(*ListNotesRequest))
+		return srv.(NotesServiceServer).ListSharedNotes(ctx, req.(*ListSharedNotesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _NotesService_RetrieveNote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RetrieveNoteRequest)
+func _NotesService_SearchNotes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchNotesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(NotesServiceServer).RetrieveNote(ctx, in)
+		return srv.(NotesServiceServer).SearchNotes(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: NotesService_RetrieveNote_FullMethodName,
+		FullMethod: NotesService_SearchNotes_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(NotesServiceServer).RetrieveNote(ctx, req.(*RetrieveNoteRequest))
+		return srv.(NotesServiceServer).SearchNotes(ctx, req.(*SearchNotesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _NotesService_CreateNote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateNoteRequest)
+func _NotesService_GetPublicNote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPublicNoteRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(NotesServiceServer).CreateNote(ctx, in)
+		return srv.(NotesServiceServer).GetPublicNote(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: NotesService_CreateNote_FullMethodName,
+		FullMethod: NotesService_GetPublicNote_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(NotesServiceServer).CreateNote(ctx, req.(*CreateNoteRequest))
+		return srv.(NotesServiceServer).GetPublicNote(ctx, req.(*GetPublicNoteRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _NotesService_DeleteNote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteNoteRequest)
+func _NotesService_RenderPublicNote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenderPublicNoteRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(NotesServiceServer).DeleteNote(ctx, in)
+		return srv.(NotesServiceServer).RenderPublicNote(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: NotesService_DeleteNote_FullMethodName,
+		FullMethod: NotesService_RenderPublicNote_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(NotesServiceServer).DeleteNote(ctx, req.(*DeleteNoteRequest))
+		return srv.(NotesServiceServer).RenderPublicNote(ctx, req.(*RenderPublicNoteRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -256,6 +2087,42 @@ func _NotesService_SubscribeToEvents_Handler(srv interface{}, stream grpc.Server
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
 type NotesService_SubscribeToEventsServer = grpc.ServerStreamingServer[SubscribeToEventsResponse]
 
+func _NotesService_ListEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotesServiceServer).ListEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotesService_ListEvents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotesServiceServer).ListEvents(ctx, req.(*ListEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotesService_MarkEventsRead_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarkEventsReadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotesServiceServer).MarkEventsRead(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotesService_MarkEventsRead_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotesServiceServer).MarkEventsRead(ctx, req.(*MarkEventsReadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // NotesService_ServiceDesc is the grpc.ServiceDesc for NotesService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -275,12 +2142,226 @@ var NotesService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CreateNote",
 			Handler:    _NotesService_CreateNote_Handler,
 		},
+		{
+			MethodName: "UpdateNote",
+			Handler:    _NotesService_UpdateNote_Handler,
+		},
 		{
 			MethodName: "DeleteNote",
 			Handler:    _NotesService_DeleteNote_Handler,
 		},
+		{
+			MethodName: "RestoreNote",
+			Handler:    _NotesService_RestoreNote_Handler,
+		},
+		{
+			MethodName: "PurgeNote",
+			Handler:    _NotesService_PurgeNote_Handler,
+		},
+		{
+			MethodName: "BatchCreateNotes",
+			Handler:    _NotesService_BatchCreateNotes_Handler,
+		},
+		{
+			MethodName: "BatchDeleteNotes",
+			Handler:    _NotesService_BatchDeleteNotes_Handler,
+		},
+		{
+			MethodName: "BatchGetNotes",
+			Handler:    _NotesService_BatchGetNotes_Handler,
+		},
+		{
+			MethodName: "ListAttachments",
+			Handler:    _NotesService_ListAttachments_Handler,
+		},
+		{
+			MethodName: "DeleteAttachment",
+			Handler:    _NotesService_DeleteAttachment_Handler,
+		},
+		{
+			MethodName: "MoveNote",
+			Handler:    _NotesService_MoveNote_Handler,
+		},
+		{
+			MethodName: "AddNoteTags",
+			Handler:    _NotesService_AddNoteTags_Handler,
+		},
+		{
+			MethodName: "RemoveNoteTags",
+			Handler:    _NotesService_RemoveNoteTags_Handler,
+		},
+		{
+			MethodName: "ShareNote",
+			Handler:    _NotesService_ShareNote_Handler,
+		},
+		{
+			MethodName: "UnshareNote",
+			Handler:    _NotesService_UnshareNote_Handler,
+		},
+		{
+			MethodName: "ListNoteShares",
+			Handler:    _NotesService_ListNoteShares_Handler,
+		},
+		{
+			MethodName: "CreateShareLink",
+			Handler:    _NotesService_CreateShareLink_Handler,
+		},
+		{
+			MethodName: "RevokeShareLink",
+			Handler:    _NotesService_RevokeShareLink_Handler,
+		},
+		{
+			MethodName: "ListShareLinks",
+			Handler:    _NotesService_ListShareLinks_Handler,
+		},
+		{
+			MethodName: "CreateReminder",
+			Handler:    _NotesService_CreateReminder_Handler,
+		},
+		{
+			MethodName: "ListReminders",
+			Handler:    _NotesService_ListReminders_Handler,
+		},
+		{
+			MethodName: "UpdateReminder",
+			Handler:    _NotesService_UpdateReminder_Handler,
+		},
+		{
+			MethodName: "DeleteReminder",
+			Handler:    _NotesService_DeleteReminder_Handler,
+		},
+		{
+			MethodName: "ListNoteRevisions",
+			Handler:    _NotesService_ListNoteRevisions_Handler,
+		},
+		{
+			MethodName: "GetNoteRevision",
+			Handler:    _NotesService_GetNoteRevision_Handler,
+		},
+		{
+			MethodName: "DiffNoteRevisions",
+			Handler:    _NotesService_DiffNoteRevisions_Handler,
+		},
+		{
+			MethodName: "RestoreNoteRevision",
+			Handler:    _NotesService_RestoreNoteRevision_Handler,
+		},
+		{
+			MethodName: "ListTags",
+			Handler:    _NotesService_ListTags_Handler,
+		},
+		{
+			MethodName: "RenameTag",
+			Handler:    _NotesService_RenameTag_Handler,
+		},
+		{
+			MethodName: "CreateNotebook",
+			Handler:    _NotesService_CreateNotebook_Handler,
+		},
+		{
+			MethodName: "ListNotebooks",
+			Handler:    _NotesService_ListNotebooks_Handler,
+		},
+		{
+			MethodName: "GetNotebook",
+			Handler:    _NotesService_GetNotebook_Handler,
+		},
+		{
+			MethodName: "UpdateNotebook",
+			Handler:    _NotesService_UpdateNotebook_Handler,
+		},
+		{
+			MethodName: "DeleteNotebook",
+			Handler:    _NotesService_DeleteNotebook_Handler,
+		},
+		{
+			MethodName: "CreateTemplate",
+			Handler:    _NotesService_CreateTemplate_Handler,
+		},
+		{
+			MethodName: "ListTemplates",
+			Handler:    _NotesService_ListTemplates_Handler,
+		},
+		{
+			MethodName: "GetTemplate",
+			Handler:    _NotesService_GetTemplate_Handler,
+		},
+		{
+			MethodName: "UpdateTemplate",
+			Handler:    _NotesService_UpdateTemplate_Handler,
+		},
+		{
+			MethodName: "DeleteTemplate",
+			Handler:    _NotesService_DeleteTemplate_Handler,
+		},
+		{
+			MethodName: "ListBacklinks",
+			Handler:    _NotesService_ListBacklinks_Handler,
+		},
+		{
+			MethodName: "ListOutgoingLinks",
+			Handler:    _NotesService_ListOutgoingLinks_Handler,
+		},
+		{
+			MethodName: "ListDanglingLinks",
+			Handler:    _NotesService_ListDanglingLinks_Handler,
+		},
+		{
+			MethodName: "ListTrashedNotes",
+			Handler:    _NotesService_ListTrashedNotes_Handler,
+		},
+		{
+			MethodName: "ListSharedNotes",
+			Handler:    _NotesService_ListSharedNotes_Handler,
+		},
+		{
+			MethodName: "SearchNotes",
+			Handler:    _NotesService_SearchNotes_Handler,
+		},
+		{
+			MethodName: "GetPublicNote",
+			Handler:    _NotesService_GetPublicNote_Handler,
+		},
+		{
+			MethodName: "RenderPublicNote",
+			Handler:    _NotesService_RenderPublicNote_Handler,
+		},
+		{
+			MethodName: "ListEvents",
+			Handler:    _NotesService_ListEvents_Handler,
+		},
+		{
+			MethodName: "MarkEventsRead",
+			Handler:    _NotesService_MarkEventsRead_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "EditNote",
+			Handler:       _NotesService_EditNote_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "ExportNotes",
+			Handler:       _NotesService_ExportNotes_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ImportNotes",
+			Handler:       _NotesService_ImportNotes_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "UploadAttachment",
+			Handler:       _NotesService_UploadAttachment_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "DownloadAttachment",
+			Handler:       _NotesService_DownloadAttachment_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "SubscribeToEvents",
 			Handler:       _NotesService_SubscribeToEvents_Handler,
@@ -45,6 +45,12 @@ const (
 	ErrorCode_ERROR_CODE_BUSINESS ErrorCode = 8
 	// The provided text is invalid.
 	ErrorCode_ERROR_CODE_INVALID_TEXT ErrorCode = 9
+	// The provided page token is invalid, expired or does not match the request.
+	ErrorCode_ERROR_CODE_INVALID_PAGE_TOKEN ErrorCode = 10
+	// The note was changed since the version the request is based on.
+	ErrorCode_ERROR_CODE_VERSION_MISMATCH ErrorCode = 11
+	// The request changes a note without telling the version it is based on.
+	ErrorCode_ERROR_CODE_VERSION_REQUIRED ErrorCode = 12
 	// Internal server error.
 	ErrorCode_ERROR_CODE_INTERNAL ErrorCode = 99
 )
@@ -62,20 +68,26 @@ var (
 		7:  "ERROR_CODE_PERMISSION_DENIED",
 		8:  "ERROR_CODE_BUSINESS",
 		9:  "ERROR_CODE_INVALID_TEXT",
+		10: "ERROR_CODE_INVALID_PAGE_TOKEN",
+		11: "ERROR_CODE_VERSION_MISMATCH",
+		12: "ERROR_CODE_VERSION_REQUIRED",
 		99: "ERROR_CODE_INTERNAL",
 	}
 	ErrorCode_value = map[string]int32{
-		"ERROR_CODE_UNKNOWN":           0,
-		"ERROR_CODE_INVALID_ID":        1,
-		"ERROR_CODE_INVALID_EMAIL":     2,
-		"ERROR_CODE_INVALID_PASSWORD":  3,
-		"ERROR_CODE_INVALID_TITLE":     4,
-		"ERROR_CODE_INVALID_CONTENT":   5,
-		"ERROR_CODE_ENTITY_NOT_FOUND":  6,
-		"ERROR_CODE_PERMISSION_DENIED": 7,
-		"ERROR_CODE_BUSINESS":          8,
-		"ERROR_CODE_INVALID_TEXT":      9,
-		"ERROR_CODE_INTERNAL":          99,
+		"ERROR_CODE_UNKNOWN":            0,
+		"ERROR_CODE_INVALID_ID":         1,
+		"ERROR_CODE_INVALID_EMAIL":      2,
+		"ERROR_CODE_INVALID_PASSWORD":   3,
+		"ERROR_CODE_INVALID_TITLE":      4,
+		"ERROR_CODE_INVALID_CONTENT":    5,
+		"ERROR_CODE_ENTITY_NOT_FOUND":   6,
+		"ERROR_CODE_PERMISSION_DENIED":  7,
+		"ERROR_CODE_BUSINESS":           8,
+		"ERROR_CODE_INVALID_TEXT":       9,
+		"ERROR_CODE_INVALID_PAGE_TOKEN": 10,
+		"ERROR_CODE_VERSION_MISMATCH":   11,
+		"ERROR_CODE_VERSION_REQUIRED":   12,
+		"ERROR_CODE_INTERNAL":           99,
 	}
 )
 
@@ -170,7 +182,7 @@ const file_api_types_error_proto_rawDesc = "" +
 	"\x15api/types/error.proto\x12\tapi.types\"I\n" +
 	"\x05Error\x12(\n" +
 	"\x04code\x18\x01 \x01(\x0e2\x14.api.types.ErrorCodeR\x04code\x12\x16\n" +
-	"\x06reason\x18\x02 \x01(\tR\x06reason*\xcd\x02\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason*\xb2\x03\n" +
 	"\tErrorCode\x12\x16\n" +
 	"\x12ERROR_CODE_UNKNOWN\x10\x00\x12\x19\n" +
 	"\x15ERROR_CODE_INVALID_ID\x10\x01\x12\x1c\n" +
@@ -181,7 +193,11 @@ const file_api_types_error_proto_rawDesc = "" +
 	"\x1bERROR_CODE_ENTITY_NOT_FOUND\x10\x06\x12 \n" +
 	"\x1cERROR_CODE_PERMISSION_DENIED\x10\a\x12\x17\n" +
 	"\x13ERROR_CODE_BUSINESS\x10\b\x12\x1b\n" +
-	"\x17ERROR_CODE_INVALID_TEXT\x10\t\x12\x17\n" +
+	"\x17ERROR_CODE_INVALID_TEXT\x10\t\x12!\n" +
+	"\x1dERROR_CODE_INVALID_PAGE_TOKEN\x10\n" +
+	"\x12\x1f\n" +
+	"\x1bERROR_CODE_VERSION_MISMATCH\x10\v\x12\x1f\n" +
+	"\x1bERROR_CODE_VERSION_REQUIRED\x10\f\x12\x17\n" +
 	"\x13ERROR_CODE_INTERNAL\x10cB0Z.github.com/therenotomorrow/gotes/pkg/api/typesb\x06proto3"
 
 var (
@@ -0,0 +1,893 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: api/webhooks/v1/messages.proto
+
+package v1
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	v1 "github.com/therenotomorrow/gotes/pkg/api/notes/v1"
+	types "github.com/therenotomorrow/gotes/pkg/api/types"
+	_ "github.com/therenotomorrow/gotes/plugin/verbose/v1"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Webhook is an endpoint that receives note events as signed HTTP POST requests.
+type Webhook struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Unique identifier of the webhook.
+	Id *types.ID `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// URL the events are posted to.
+	Url string `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	// Types of the events the webhook receives, all of them when empty.
+	EventTypes []v1.EventType `protobuf:"varint,3,rep,packed,name=event_types,json=eventTypes,proto3,enum=api.notes.v1.EventType" json:"event_types,omitempty"`
+	// Whether the webhook receives events, a webhook is disabled after too many failed deliveries in a row.
+	Enabled bool `protobuf:"varint,4,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// Number of deliveries failed in a row.
+	Failures int32 `protobuf:"varint,5,opt,name=failures,proto3" json:"failures,omitempty"`
+	// Timestamp when the webhook was created.
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// Timestamp when the webhook was last updated.
+	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// Timestamp when the webhook was disabled, unset while it is enabled.
+	DisabledAt    *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=disabled_at,json=disabledAt,proto3" json:"disabled_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Webhook) Reset() {
+	*x = Webhook{}
+	mi := &file_api_webhooks_v1_messages_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Webhook) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Webhook) ProtoMessage() {}
+
+func (x *Webhook) ProtoReflect() protoreflect.Message {
+	mi := &file_api_webhooks_v1_messages_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Webhook.ProtoReflect.Descriptor instead.
+func (*Webhook) Descriptor() ([]byte, []int) {
+	return file_api_webhooks_v1_messages_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Webhook) GetId() *types.ID {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+func (x *Webhook) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *Webhook) GetEventTypes() []v1.EventType {
+	if x != nil {
+		return x.EventTypes
+	}
+	return nil
+}
+
+func (x *Webhook) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *Webhook) GetFailures() int32 {
+	if x != nil {
+		return x.Failures
+	}
+	return 0
+}
+
+func (x *Webhook) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Webhook) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Webhook) GetDisabledAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DisabledAt
+	}
+	return nil
+}
+
+// WebhookAttempt is a single attempt to deliver an event to a webhook.
+type WebhookAttempt struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Unique identifier of the attempt.
+	Id *types.ID `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// ID of the delivered event, the same as the `X-Gotes-Delivery` header.
+	EventId string `protobuf:"bytes,2,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	// Type of the delivered event.
+	EventType v1.EventType `protobuf:"varint,3,opt,name=event_type,json=eventType,proto3,enum=api.notes.v1.EventType" json:"event_type,omitempty"`
+	// HTTP status of the response, zero when the endpoint did not respond.
+	StatusCode int32 `protobuf:"varint,4,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`
+	// Why the attempt failed, empty for delivered events.
+	Error string `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+	// Whether the endpoint accepted the event.
+	Delivered bool `protobuf:"varint,6,opt,name=delivered,proto3" json:"delivered,omitempty"`
+	// How long the endpoint took to respond.
+	Duration *durationpb.Duration `protobuf:"bytes,7,opt,name=duration,proto3" json:"duration,omitempty"`
+	// Timestamp when the attempt was made.
+	AttemptedAt   *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=attempted_at,json=attemptedAt,proto3" json:"attempted_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WebhookAttempt) Reset() {
+	*x = WebhookAttempt{}
+	mi := &file_api_webhooks_v1_messages_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WebhookAttempt) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebhookAttempt) ProtoMessage() {}
+
+func (x *WebhookAttempt) ProtoReflect() protoreflect.Message {
+	mi := &file_api_webhooks_v1_messages_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebhookAttempt.ProtoReflect.Descriptor instead.
+func (*WebhookAttempt) Descriptor() ([]byte, []int) {
+	return file_api_webhooks_v1_messages_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *WebhookAttempt) GetId() *types.ID {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+func (x *WebhookAttempt) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *WebhookAttempt) GetEventType() v1.EventType {
+	if x != nil {
+		return x.EventType
+	}
+	return v1.EventType(0)
+}
+
+func (x *WebhookAttempt) GetStatusCode() int32 {
+	if x != nil {
+		return x.StatusCode
+	}
+	return 0
+}
+
+func (x *WebhookAttempt) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *WebhookAttempt) GetDelivered() bool {
+	if x != nil {
+		return x.Delivered
+	}
+	return false
+}
+
+func (x *WebhookAttempt) GetDuration() *durationpb.Duration {
+	if x != nil {
+		return x.Duration
+	}
+	return nil
+}
+
+func (x *WebhookAttempt) GetAttemptedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AttemptedAt
+	}
+	return nil
+}
+
+// CreateWebhookRequest is the request message for registering a webhook.
+type CreateWebhookRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// URL the events are posted to, it must be an absolute http or https URL.
+	Url string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	// Types of the events the webhook receives, all of them when empty.
+	EventTypes    []v1.EventType `protobuf:"varint,2,rep,packed,name=event_types,json=eventTypes,proto3,enum=api.notes.v1.EventType" json:"event_types,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateWebhookRequest) Reset() {
+	*x = CreateWebhookRequest{}
+	mi := &file_api_webhooks_v1_messages_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateWebhookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateWebhookRequest) ProtoMessage() {}
+
+func (x *CreateWebhookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_webhooks_v1_messages_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateWebhookRequest.ProtoReflect.Descriptor instead.
+func (*CreateWebhookRequest) Descriptor() ([]byte, []int) {
+	return file_api_webhooks_v1_messages_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CreateWebhookRequest) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *CreateWebhookRequest) GetEventTypes() []v1.EventType {
+	if x != nil {
+		return x.EventTypes
+	}
+	return nil
+}
+
+// CreateWebhookResponse is the response message after registering a webhook.
+type CreateWebhookResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The registered webhook.
+	Webhook *Webhook `protobuf:"bytes,1,opt,name=webhook,proto3" json:"webhook,omitempty"`
+	// Secret the requests are signed with, it is returned only once.
+	Secret        string `protobuf:"bytes,2,opt,name=secret,proto3" json:"secret,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateWebhookResponse) Reset() {
+	*x = CreateWebhookResponse{}
+	mi := &file_api_webhooks_v1_messages_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateWebhookResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateWebhookResponse) ProtoMessage() {}
+
+func (x *CreateWebhookResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_webhooks_v1_messages_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateWebhookResponse.ProtoReflect.Descriptor instead.
+func (*CreateWebhookResponse) Descriptor() ([]byte, []int) {
+	return file_api_webhooks_v1_messages_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CreateWebhookResponse) GetWebhook() *Webhook {
+	if x != nil {
+		return x.Webhook
+	}
+	return nil
+}
+
+func (x *CreateWebhookResponse) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+// ListWebhooksRequest is the request message for listing webhooks of the user.
+type ListWebhooksRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWebhooksRequest) Reset() {
+	*x = ListWebhooksRequest{}
+	mi := &file_api_webhooks_v1_messages_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWebhooksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWebhooksRequest) ProtoMessage() {}
+
+func (x *ListWebhooksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_webhooks_v1_messages_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWebhooksRequest.ProtoReflect.Descriptor instead.
+func (*ListWebhooksRequest) Descriptor() ([]byte, []int) {
+	return file_api_webhooks_v1_messages_proto_rawDescGZIP(), []int{4}
+}
+
+// ListWebhooksResponse is the response message containing all webhooks of the user.
+type ListWebhooksResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Webhooks sorted by creation.
+	Webhooks      []*Webhook `protobuf:"bytes,1,rep,name=webhooks,proto3" json:"webhooks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWebhooksResponse) Reset() {
+	*x = ListWebhooksResponse{}
+	mi := &file_api_webhooks_v1_messages_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWebhooksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWebhooksResponse) ProtoMessage() {}
+
+func (x *ListWebhooksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_webhooks_v1_messages_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWebhooksResponse.ProtoReflect.Descriptor instead.
+func (*ListWebhooksResponse) Descriptor() ([]byte, []int) {
+	return file_api_webhooks_v1_messages_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListWebhooksResponse) GetWebhooks() []*Webhook {
+	if x != nil {
+		return x.Webhooks
+	}
+	return nil
+}
+
+// UpdateWebhookRequest is the request message for changing a webhook.
+type UpdateWebhookRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ID of the webhook to update.
+	Id *types.ID `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// New URL of the webhook, applied when `url` is present in the update mask.
+	Url string `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	// New event types of the webhook, applied when `event_types` is present in the update mask.
+	EventTypes []v1.EventType `protobuf:"varint,3,rep,packed,name=event_types,json=eventTypes,proto3,enum=api.notes.v1.EventType" json:"event_types,omitempty"`
+	// Enables or disables the webhook, applied when `enabled` is present in the update mask.
+	Enabled bool `protobuf:"varint,4,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// Fields of the webhook to update, allowed paths are `url`, `event_types` and `enabled`.
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,5,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateWebhookRequest) Reset() {
+	*x = UpdateWebhookRequest{}
+	mi := &file_api_webhooks_v1_messages_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateWebhookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateWebhookRequest) ProtoMessage() {}
+
+func (x *UpdateWebhookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_webhooks_v1_messages_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateWebhookRequest.ProtoReflect.Descriptor instead.
+func (*UpdateWebhookRequest) Descriptor() ([]byte, []int) {
+	return file_api_webhooks_v1_messages_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *UpdateWebhookRequest) GetId() *types.ID {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+func (x *UpdateWebhookRequest) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *UpdateWebhookRequest) GetEventTypes() []v1.EventType {
+	if x != nil {
+		return x.EventTypes
+	}
+	return nil
+}
+
+func (x *UpdateWebhookRequest) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *UpdateWebhookRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+// UpdateWebhookResponse is the response message after updating a webhook.
+type UpdateWebhookResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The updated webhook.
+	Webhook       *Webhook `protobuf:"bytes,1,opt,name=webhook,proto3" json:"webhook,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateWebhookResponse) Reset() {
+	*x = UpdateWebhookResponse{}
+	mi := &file_api_webhooks_v1_messages_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateWebhookResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateWebhookResponse) ProtoMessage() {}
+
+func (x *UpdateWebhookResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_webhooks_v1_messages_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateWebhookResponse.ProtoReflect.Descriptor instead.
+func (*UpdateWebhookResponse) Descriptor() ([]byte, []int) {
+	return file_api_webhooks_v1_messages_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *UpdateWebhookResponse) GetWebhook() *Webhook {
+	if x != nil {
+		return x.Webhook
+	}
+	return nil
+}
+
+// DeleteWebhookRequest is the request message for deleting a webhook.
+type DeleteWebhookRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ID of the webhook to delete.
+	Id            *types.ID `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteWebhookRequest) Reset() {
+	*x = DeleteWebhookRequest{}
+	mi := &file_api_webhooks_v1_messages_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteWebhookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteWebhookRequest) ProtoMessage() {}
+
+func (x *DeleteWebhookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_webhooks_v1_messages_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteWebhookRequest.ProtoReflect.Descriptor instead.
+func (*DeleteWebhookRequest) Descriptor() ([]byte, []int) {
+	return file_api_webhooks_v1_messages_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *DeleteWebhookRequest) GetId() *types.ID {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+// DeleteWebhookResponse is the response message after deleting a webhook.
+type DeleteWebhookResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteWebhookResponse) Reset() {
+	*x = DeleteWebhookResponse{}
+	mi := &file_api_webhooks_v1_messages_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteWebhookResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteWebhookResponse) ProtoMessage() {}
+
+func (x *DeleteWebhookResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_webhooks_v1_messages_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteWebhookResponse.ProtoReflect.Descriptor instead.
+func (*DeleteWebhookResponse) Descriptor() ([]byte, []int) {
+	return file_api_webhooks_v1_messages_proto_rawDescGZIP(), []int{9}
+}
+
+// ListWebhookAttemptsRequest is the request message for listing the recent delivery attempts of a webhook.
+type ListWebhookAttemptsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ID of the webhook.
+	Id *types.ID `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Maximum number of attempts to return, 50 by default.
+	Limit         int32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWebhookAttemptsRequest) Reset() {
+	*x = ListWebhookAttemptsRequest{}
+	mi := &file_api_webhooks_v1_messages_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWebhookAttemptsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWebhookAttemptsRequest) ProtoMessage() {}
+
+func (x *ListWebhookAttemptsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_webhooks_v1_messages_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWebhookAttemptsRequest.ProtoReflect.Descriptor instead.
+func (*ListWebhookAttemptsRequest) Descriptor() ([]byte, []int) {
+	return file_api_webhooks_v1_messages_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ListWebhookAttemptsRequest) GetId() *types.ID {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+func (x *ListWebhookAttemptsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// ListWebhookAttemptsResponse is the response message containing the recent delivery attempts.
+type ListWebhookAttemptsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Attempts, the most recent first.
+	Attempts      []*WebhookAttempt `protobuf:"bytes,1,rep,name=attempts,proto3" json:"attempts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWebhookAttemptsResponse) Reset() {
+	*x = ListWebhookAttemptsResponse{}
+	mi := &file_api_webhooks_v1_messages_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWebhookAttemptsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWebhookAttemptsResponse) ProtoMessage() {}
+
+func (x *ListWebhookAttemptsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_webhooks_v1_messages_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWebhookAttemptsResponse.ProtoReflect.Descriptor instead.
+func (*ListWebhookAttemptsResponse) Descriptor() ([]byte, []int) {
+	return file_api_webhooks_v1_messages_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ListWebhookAttemptsResponse) GetAttempts() []*WebhookAttempt {
+	if x != nil {
+		return x.Attempts
+	}
+	return nil
+}
+
+var File_api_webhooks_v1_messages_proto protoreflect.FileDescriptor
+
+const file_api_webhooks_v1_messages_proto_rawDesc = "" +
+	"\n" +
+	"\x1eapi/webhooks/v1/messages.proto\x12\x0fapi.webhooks.v1\x1a\x1bapi/notes/v1/messages.proto\x1a\x12api/types/id.proto\x1a\x1egoogle/protobuf/duration.proto\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1bbuf/validate/validate.proto\x1a\x1fplugin/verbose/v1/options.proto\"\xdd\x02\n" +
+	"\aWebhook\x12\x1d\n" +
+	"\x02id\x18\x01 \x01(\v2\r.api.types.IDR\x02id\x12\x10\n" +
+	"\x03url\x18\x02 \x01(\tR\x03url\x128\n" +
+	"\vevent_types\x18\x03 \x03(\x0e2\x17.api.notes.v1.EventTypeR\n" +
+	"eventTypes\x12\x18\n" +
+	"\aenabled\x18\x04 \x01(\bR\aenabled\x12\x1a\n" +
+	"\bfailures\x18\x05 \x01(\x05R\bfailures\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12;\n" +
+	"\vdisabled_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"disabledAt\"\xcd\x02\n" +
+	"\x0eWebhookAttempt\x12\x1d\n" +
+	"\x02id\x18\x01 \x01(\v2\r.api.types.IDR\x02id\x12\x19\n" +
+	"\bevent_id\x18\x02 \x01(\tR\aeventId\x126\n" +
+	"\n" +
+	"event_type\x18\x03 \x01(\x0e2\x17.api.notes.v1.EventTypeR\teventType\x12\x1f\n" +
+	"\vstatus_code\x18\x04 \x01(\x05R\n" +
+	"statusCode\x12\x14\n" +
+	"\x05error\x18\x05 \x01(\tR\x05error\x12\x1c\n" +
+	"\tdelivered\x18\x06 \x01(\bR\tdelivered\x125\n" +
+	"\bduration\x18\a \x01(\v2\x19.google.protobuf.DurationR\bduration\x12=\n" +
+	"\fattempted_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\vattemptedAt\"\x82\x01\n" +
+	"\x14CreateWebhookRequest\x12\x1d\n" +
+	"\x03url\x18\x01 \x01(\tB\v\xbaH\br\x06\x18\x80\x10\x88\x01\x01R\x03url\x12K\n" +
+	"\vevent_types\x18\x02 \x03(\x0e2\x17.api.notes.v1.EventTypeB\x11\xbaH\x0e\x92\x01\v\x10\x10\"\a\x82\x01\x04\x10\x01 \x00R\n" +
+	"eventTypes\"i\n" +
+	"\x15CreateWebhookResponse\x122\n" +
+	"\awebhook\x18\x01 \x01(\v2\x18.api.webhooks.v1.WebhookR\awebhook\x12\x1c\n" +
+	"\x06secret\x18\x02 \x01(\tB\x04\x80\xb5\x18\x01R\x06secret\"\x15\n" +
+	"\x13ListWebhooksRequest\"L\n" +
+	"\x14ListWebhooksResponse\x124\n" +
+	"\bwebhooks\x18\x01 \x03(\v2\x18.api.webhooks.v1.WebhookR\bwebhooks\"\xa1\x02\n" +
+	"\x14UpdateWebhookRequest\x12\x1d\n" +
+	"\x02id\x18\x01 \x01(\v2\r.api.types.IDR\x02id\x12 \n" +
+	"\x03url\x18\x02 \x01(\tB\x0e\xbaH\v\xd8\x01\x01r\x06\x18\x80\x10\x88\x01\x01R\x03url\x12K\n" +
+	"\vevent_types\x18\x03 \x03(\x0e2\x17.api.notes.v1.EventTypeB\x11\xbaH\x0e\x92\x01\v\x10\x10\"\a\x82\x01\x04\x10\x01 \x00R\n" +
+	"eventTypes\x12\x18\n" +
+	"\aenabled\x18\x04 \x01(\bR\aenabled\x12a\n" +
+	"\vupdate_mask\x18\x05 \x01(\v2\x1a.google.protobuf.FieldMaskB$\xbaH!\xc8\x01\x01\xe2\x01\x1b\x12\x03url\x12\vevent_types\x12\aenabledR\n" +
+	"updateMask\"K\n" +
+	"\x15UpdateWebhookResponse\x122\n" +
+	"\awebhook\x18\x01 \x01(\v2\x18.api.webhooks.v1.WebhookR\awebhook\"5\n" +
+	"\x14DeleteWebhookRequest\x12\x1d\n" +
+	"\x02id\x18\x01 \x01(\v2\r.api.types.IDR\x02id\"\x17\n" +
+	"\x15DeleteWebhookResponse\"\\\n" +
+	"\x1aListWebhookAttemptsRequest\x12\x1d\n" +
+	"\x02id\x18\x01 \x01(\v2\r.api.types.IDR\x02id\x12\x1f\n" +
+	"\x05limit\x18\x02 \x01(\x05B\t\xbaH\x06\x1a\x04\x18d(\x00R\x05limit\"Z\n" +
+	"\x1bListWebhookAttemptsResponse\x12;\n" +
+	"\battempts\x18\x01 \x03(\v2\x1f.api.webhooks.v1.WebhookAttemptR\battemptsB6Z4github.com/therenotomorrow/gotes/pkg/api/webhooks/v1b\x06proto3"
+
+var (
+	file_api_webhooks_v1_messages_proto_rawDescOnce sync.Once
+	file_api_webhooks_v1_messages_proto_rawDescData []byte
+)
+
+func file_api_webhooks_v1_messages_proto_rawDescGZIP() []byte {
+	file_api_webhooks_v1_messages_proto_rawDescOnce.Do(func() {
+		file_api_webhooks_v1_messages_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_api_webhooks_v1_messages_proto_rawDesc), len(file_api_webhooks_v1_messages_proto_rawDesc)))
+	})
+	return file_api_webhooks_v1_messages_proto_rawDescData
+}
+
+var file_api_webhooks_v1_messages_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
+var file_api_webhooks_v1_messages_proto_goTypes = []any{
+	(*Webhook)(nil),                     // 0: api.webhooks.v1.Webhook
+	(*WebhookAttempt)(nil),              // 1: api.webhooks.v1.WebhookAttempt
+	(*CreateWebhookRequest)(nil),        // 2: api.webhooks.v1.CreateWebhookRequest
+	(*CreateWebhookResponse)(nil),       // 3: api.webhooks.v1.CreateWebhookResponse
+	(*ListWebhooksRequest)(nil),         // 4: api.webhooks.v1.ListWebhooksRequest
+	(*ListWebhooksResponse)(nil),        // 5: api.webhooks.v1.ListWebhooksResponse
+	(*UpdateWebhookRequest)(nil),        // 6: api.webhooks.v1.UpdateWebhookRequest
+	(*UpdateWebhookResponse)(nil),       // 7: api.webhooks.v1.UpdateWebhookResponse
+	(*DeleteWebhookRequest)(nil),        // 8: api.webhooks.v1.DeleteWebhookRequest
+	(*DeleteWebhookResponse)(nil),       // 9: api.webhooks.v1.DeleteWebhookResponse
+	(*ListWebhookAttemptsRequest)(nil),  // 10: api.webhooks.v1.ListWebhookAttemptsRequest
+	(*ListWebhookAttemptsResponse)(nil), // 11: api.webhooks.v1.ListWebhookAttemptsResponse
+	(*types.ID)(nil),                    // 12: api.types.ID
+	(v1.EventType)(0),                   // 13: api.notes.v1.EventType
+	(*timestamppb.Timestamp)(nil),       // 14: google.protobuf.Timestamp
+	(*durationpb.Duration)(nil),         // 15: google.protobuf.Duration
+	(*fieldmaskpb.FieldMask)(nil),       // 16: google.protobuf.FieldMask
+}
+var file_api_webhooks_v1_messages_proto_depIdxs = []int32{
+	12, // 0: api.webhooks.v1.Webhook.id:type_name -> api.types.ID
+	13, // 1: api.webhooks.v1.Webhook.event_types:type_name -> api.notes.v1.EventType
+	14, // 2: api.webhooks.v1.Webhook.created_at:type_name -> google.protobuf.Timestamp
+	14, // 3: api.webhooks.v1.Webhook.updated_at:type_name -> google.protobuf.Timestamp
+	14, // 4: api.webhooks.v1.Webhook.disabled_at:type_name -> google.protobuf.Timestamp
+	12, // 5: api.webhooks.v1.WebhookAttempt.id:type_name -> api.types.ID
+	13, // 6: api.webhooks.v1.WebhookAttempt.event_type:type_name -> api.notes.v1.EventType
+	15, // 7: api.webhooks.v1.WebhookAttempt.duration:type_name -> google.protobuf.Duration
+	14, // 8: api.webhooks.v1.WebhookAttempt.attempted_at:type_name -> google.protobuf.Timestamp
+	13, // 9: api.webhooks.v1.CreateWebhookRequest.event_types:type_name -> api.notes.v1.EventType
+	0,  // 10: api.webhooks.v1.CreateWebhookResponse.webhook:type_name -> api.webhooks.v1.Webhook
+	0,  // 11: api.webhooks.v1.ListWebhooksResponse.webhooks:type_name -> api.webhooks.v1.Webhook
+	12, // 12: api.webhooks.v1.UpdateWebhookRequest.id:type_name -> api.types.ID
+	13, // 13: api.webhooks.v1.UpdateWebhookRequest.event_types:type_name -> api.notes.v1.EventType
+	16, // 14: api.webhooks.v1.UpdateWebhookRequest.update_mask:type_name -> google.protobuf.FieldMask
+	0,  // 15: api.webhooks.v1.UpdateWebhookResponse.webhook:type_name -> api.webhooks.v1.Webhook
+	12, // 16: api.webhooks.v1.DeleteWebhookRequest.id:type_name -> api.types.ID
+	12, // 17: api.webhooks.v1.ListWebhookAttemptsRequest.id:type_name -> api.types.ID
+	1,  // 18: api.webhooks.v1.ListWebhookAttemptsResponse.attempts:type_name -> api.webhooks.v1.WebhookAttempt
+	19, // [19:19] is the sub-list for method output_type
+	19, // [19:19] is the sub-list for method input_type
+	19, // [19:19] is the sub-list for extension type_name
+	19, // [19:19] is the sub-list for extension extendee
+	0,  // [0:19] is the sub-list for field type_name
+}
+
+func init() { file_api_webhooks_v1_messages_proto_init() }
+func file_api_webhooks_v1_messages_proto_init() {
+	if File_api_webhooks_v1_messages_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_webhooks_v1_messages_proto_rawDesc), len(file_api_webhooks_v1_messages_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   12,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_api_webhooks_v1_messages_proto_goTypes,
+		DependencyIndexes: file_api_webhooks_v1_messages_proto_depIdxs,
+		MessageInfos:      file_api_webhooks_v1_messages_proto_msgTypes,
+	}.Build()
+	File_api_webhooks_v1_messages_proto = out.File
+	file_api_webhooks_v1_messages_proto_goTypes = nil
+	file_api_webhooks_v1_messages_proto_depIdxs = nil
+}
@@ -0,0 +1,92 @@
+// Code generated by protoc-gen-verbose. DO NOT EDIT.
+// source: api/webhooks/v1/messages.proto
+
+package v1
+
+import (
+	fmt "fmt"
+)
+
+func (x *Webhook) Verbose() string {
+	if x == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("Webhook<Id=%v, Url=%v, EventTypes=%v, Enabled=%v, Failures=%v, CreatedAt=%v, UpdatedAt=%v, DisabledAt=%v>", x.Id, x.Url, x.EventTypes, x.Enabled, x.Failures, x.CreatedAt, x.UpdatedAt, x.DisabledAt)
+}
+
+func (x *WebhookAttempt) Verbose() string {
+	if x == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("WebhookAttempt<Id=%v, EventId=%v, EventType=%v, StatusCode=%v, Error=%v, Delivered=%v, Duration=%v, AttemptedAt=%v>", x.Id, x.EventId, x.EventType, x.StatusCode, x.Error, x.Delivered, x.Duration, x.AttemptedAt)
+}
+
+func (x *CreateWebhookRequest) Verbose() string {
+	if x == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CreateWebhookRequest<Url=%v, EventTypes=%v>", x.Url, x.EventTypes)
+}
+
+func (x *CreateWebhookResponse) Verbose() string {
+	if x == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CreateWebhookResponse<Webhook=%v>", x.Webhook)
+}
+
+func (x *ListWebhooksRequest) Verbose() string {
+	if x == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ListWebhooksRequest<>")
+}
+
+func (x *ListWebhooksResponse) Verbose() string {
+	if x == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ListWebhooksResponse<Webhooks=%v>", x.Webhooks)
+}
+
+func (x *UpdateWebhookRequest) Verbose() string {
+	if x == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UpdateWebhookRequest<Id=%v, Url=%v, EventTypes=%v, Enabled=%v, UpdateMask=%v>", x.Id, x.Url, x.EventTypes, x.Enabled, x.UpdateMask)
+}
+
+func (x *UpdateWebhookResponse) Verbose() string {
+	if x == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UpdateWebhookResponse<Webhook=%v>", x.Webhook)
+}
+
+func (x *DeleteWebhookRequest) Verbose() string {
+	if x == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("DeleteWebhookRequest<Id=%v>", x.Id)
+}
+
+func (x *DeleteWebhookResponse) Verbose() string {
+	if x == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("DeleteWebhookResponse<>")
+}
+
+func (x *ListWebhookAttemptsRequest) Verbose() string {
+	if x == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ListWebhookAttemptsRequest<Id=%v, Limit=%v>", x.Id, x.Limit)
+}
+
+func (x *ListWebhookAttemptsResponse) Verbose() string {
+	if x == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ListWebhookAttemptsResponse<Attempts=%v>", x.Attempts)
+}
@@ -0,0 +1,95 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: api/webhooks/v1/service.proto
+
+package v1
+
+import (
+	_ "github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-openapiv2/options"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+var File_api_webhooks_v1_service_proto protoreflect.FileDescriptor
+
+const file_api_webhooks_v1_service_proto_rawDesc = "" +
+	"\n" +
+	"\x1dapi/webhooks/v1/service.proto\x12\x0fapi.webhooks.v1\x1a\x1eapi/webhooks/v1/messages.proto\x1a\x1cgoogle/api/annotations.proto\x1a.protoc-gen-openapiv2/options/annotations.proto2\xb5\x05\n" +
+	"\x0fWebhooksService\x12{\n" +
+	"\rCreateWebhook\x12%.api.webhooks.v1.CreateWebhookRequest\x1a&.api.webhooks.v1.CreateWebhookResponse\"\x1b\x82\xd3\xe4\x93\x02\x15:\x01*\"\x10/api/v1/webhooks\x12u\n" +
+	"\fListWebhooks\x12$.api.webhooks.v1.ListWebhooksRequest\x1a%.api.webhooks.v1.ListWebhooksResponse\"\x18\x82\xd3\xe4\x93\x02\x12\x12\x10/api/v1/webhooks\x12\x86\x01\n" +
+	"\rUpdateWebhook\x12%.api.webhooks.v1.UpdateWebhookRequest\x1a&.api.webhooks.v1.UpdateWebhookResponse\"&\x82\xd3\xe4\x93\x02 :\x01*2\x1b/api/v1/webhooks/{id.value}\x12\x83\x01\n" +
+	"\rDeleteWebhook\x12%.api.webhooks.v1.DeleteWebhookRequest\x1a&.api.webhooks.v1.DeleteWebhookResponse\"#\x82\xd3\xe4\x93\x02\x1d*\x1b/api/v1/webhooks/{id.value}\x12\x9e\x01\n" +
+	"\x13ListWebhookAttempts\x12+.api.webhooks.v1.ListWebhookAttemptsRequest\x1a,.api.webhooks.v1.ListWebhookAttemptsResponse\",\x82\xd3\xe4\x93\x02&\x12$/api/v1/webhooks/{id.value}/attemptsB\x81\x01\x92AH\x12\x17\n" +
+	"\x10Webhooks Service2\x031.0Z\x1f\n" +
+	"\x1d\n" +
+	"\x06Bearer\x12\x13\b\x02\x1a\rAuthorization \x02b\f\n" +
+	"\n" +
+	"\n" +
+	"\x06Bearer\x12\x00Z4github.com/therenotomorrow/gotes/pkg/api/webhooks/v1b\x06proto3"
+
+var file_api_webhooks_v1_service_proto_goTypes = []any{
+	(*CreateWebhookRequest)(nil),        // 0: api.webhooks.v1.CreateWebhookRequest
+	(*ListWebhooksRequest)(nil),         // 1: api.webhooks.v1.ListWebhooksRequest
+	(*UpdateWebhookRequest)(nil),        // 2: api.webhooks.v1.UpdateWebhookRequest
+	(*DeleteWebhookRequest)(nil),        // 3: api.webhooks.v1.DeleteWebhookRequest
+	(*ListWebhookAttemptsRequest)(nil),  // 4: api.webhooks.v1.ListWebhookAttemptsRequest
+	(*CreateWebhookResponse)(nil),       // 5: api.webhooks.v1.CreateWebhookResponse
+	(*ListWebhooksResponse)(nil),        // 6: api.webhooks.v1.ListWebhooksResponse
+	(*UpdateWebhookResponse)(nil),       // 7: api.webhooks.v1.UpdateWebhookResponse
+	(*DeleteWebhookResponse)(nil),       // 8: api.webhooks.v1.DeleteWebhookResponse
+	(*ListWebhookAttemptsResponse)(nil), // 9: api.webhooks.v1.ListWebhookAttemptsResponse
+}
+var file_api_webhooks_v1_service_proto_depIdxs = []int32{
+	0, // 0: api.webhooks.v1.WebhooksService.CreateWebhook:input_type -> api.webhooks.v1.CreateWebhookRequest
+	1, // 1: api.webhooks.v1.WebhooksService.ListWebhooks:input_type -> api.webhooks.v1.ListWebhooksRequest
+	2, // 2: api.webhooks.v1.WebhooksService.UpdateWebhook:input_type -> api.webhooks.v1.UpdateWebhookRequest
+	3, // 3: api.webhooks.v1.WebhooksService.DeleteWebhook:input_type -> api.webhooks.v1.DeleteWebhookRequest
+	4, // 4: api.webhooks.v1.WebhooksService.ListWebhookAttempts:input_type -> api.webhooks.v1.ListWebhookAttemptsRequest
+	5, // 5: api.webhooks.v1.WebhooksService.CreateWebhook:output_type -> api.webhooks.v1.CreateWebhookResponse
+	6, // 6: api.webhooks.v1.WebhooksService.ListWebhooks:output_type -> api.webhooks.v1.ListWebhooksResponse
+	7, // 7: api.webhooks.v1.WebhooksService.UpdateWebhook:output_type -> api.webhooks.v1.UpdateWebhookResponse
+	8, // 8: api.webhooks.v1.WebhooksService.DeleteWebhook:output_type -> api.webhooks.v1.DeleteWebhookResponse
+	9, // 9: api.webhooks.v1.WebhooksService.ListWebhookAttempts:output_type -> api.webhooks.v1.ListWebhookAttemptsResponse
+	5, // [5:10] is the sub-list for method output_type
+	0, // [0:5] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_api_webhooks_v1_service_proto_init() }
+func file_api_webhooks_v1_service_proto_init() {
+	if File_api_webhooks_v1_service_proto != nil {
+		return
+	}
+	file_api_webhooks_v1_messages_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_webhooks_v1_service_proto_rawDesc), len(file_api_webhooks_v1_service_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   0,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_api_webhooks_v1_service_proto_goTypes,
+		DependencyIndexes: file_api_webhooks_v1_service_proto_depIdxs,
+	}.Build()
+	File_api_webhooks_v1_service_proto = out.File
+	file_api_webhooks_v1_service_proto_goTypes = nil
+	file_api_webhooks_v1_service_proto_depIdxs = nil
+}
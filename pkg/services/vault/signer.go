@@ -0,0 +1,62 @@
+package vault
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"strings"
+
+	"github.com/therenotomorrow/ex"
+)
+
+const (
+	tokenSeparator = "."
+
+	ErrInvalidSignature ex.Error = "invalid signature"
+)
+
+type CursorSigner struct {
+	encoder *base64.Encoding
+	secret  []byte
+}
+
+func NewCursorSigner(secret string) *CursorSigner {
+	return &CursorSigner{encoder: base64.RawURLEncoding, secret: []byte(secret)}
+}
+
+func (s *CursorSigner) Sign(payload []byte) (string, error) {
+	data := s.encoder.EncodeToString(payload)
+	mac := s.encoder.EncodeToString(s.mac(payload))
+
+	return data + tokenSeparator + mac, nil
+}
+
+func (s *CursorSigner) Verify(token string) ([]byte, error) {
+	data, mac, ok := strings.Cut(token, tokenSeparator)
+	if !ok {
+		return nil, ErrInvalidSignature
+	}
+
+	payload, err := s.encoder.DecodeString(data)
+	if err != nil {
+		return nil, ErrInvalidSignature.Because(err)
+	}
+
+	sum, err := s.encoder.DecodeString(mac)
+	if err != nil {
+		return nil, ErrInvalidSignature.Because(err)
+	}
+
+	if !hmac.Equal(sum, s.mac(payload)) {
+		return nil, ErrInvalidSignature
+	}
+
+	return payload, nil
+}
+
+func (s *CursorSigner) mac(payload []byte) []byte {
+	hash := hmac.New(sha256.New, s.secret)
+	hash.Write(payload)
+
+	return hash.Sum(nil)
+}
@@ -9,6 +9,7 @@ import (
 	pbopts "github.com/therenotomorrow/gotes/plugin/verbose/v1"
 	"google.golang.org/protobuf/compiler/protogen"
 	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/pluginpb"
 )
 
 func main() {
@@ -53,6 +54,8 @@ func (vg *Generator) Run() {
 	}
 
 	opts.Run(func(plugin *protogen.Plugin) error {
+		plugin.SupportedFeatures = uint64(pluginpb.CodeGeneratorResponse_FEATURE_PROTO3_OPTIONAL)
+
 		for _, file := range plugin.Files {
 			if !file.Generate {
 				continue
@@ -85,6 +88,10 @@ func (vg *Generator) generateFile(plugin *protogen.Plugin, file *protogen.File)
 }
 
 func (vg *Generator) generateVerbose(gen *protogen.GeneratedFile, message *protogen.Message) {
+	if message.Desc.IsMapEntry() {
+		return // map entries have no go types, maps are formatted as a whole
+	}
+
 	fmtSprintf := gen.QualifiedGoIdent(protogen.GoIdent{
 		GoName:       "Sprintf",
 		GoImportPath: "fmt",
@@ -99,7 +106,7 @@ func (vg *Generator) generateVerbose(gen *protogen.GeneratedFile, message *proto
 	args := make([]string, 0)
 
 	for _, field := range message.Fields {
-		if field.Oneof != nil {
+		if field.Oneof != nil && !field.Desc.HasOptionalKeyword() {
 			continue // process it later
 		}
 
@@ -107,11 +114,29 @@ func (vg *Generator) generateVerbose(gen *protogen.GeneratedFile, message *proto
 			continue
 		}
 
+		if field.Desc.HasOptionalKeyword() {
+			// optional scalars are pointers, print the value instead of the address
+			valVar := "optVal_" + field.GoName
+			gen.P(valVar, ` := "<nil>"`)
+			gen.P("if x.", field.GoName, " != nil {")
+			gen.P(valVar, " = ", fmtSprintf, `("%v", *x.`, field.GoName, ")")
+			gen.P("}")
+
+			fmts = append(fmts, field.GoName+"=%s")
+			args = append(args, valVar)
+
+			continue
+		}
+
 		fmts = append(fmts, field.GoName+"=%v")
 		args = append(args, "x."+field.GoName)
 	}
 
 	for _, oneOf := range message.Oneofs {
+		if oneOf.Desc.IsSynthetic() {
+			continue // optional fields are processed above
+		}
+
 		valVar := "oneOfVal_" + oneOf.GoName
 		gen.P(valVar, ` := "<nil>"`)
 
@@ -0,0 +1,4 @@
+-- name: DeleteBlobTombstones :exec
+DELETE
+FROM blob_tombstones
+WHERE blob_key = ANY (@blob_keys::VARCHAR[]);
@@ -1,4 +1,5 @@
--- name: DeleteNote :exec
+-- name: DeleteNote :execrows
 DELETE
 FROM notes
-WHERE id = @id;
+WHERE id = @id
+  AND version = @version;
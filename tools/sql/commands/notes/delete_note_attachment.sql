@@ -0,0 +1,10 @@
+-- name: DeleteNoteAttachment :execrows
+WITH deleted AS (
+    DELETE
+    FROM note_attachments
+    WHERE id = @id
+    RETURNING blob_key)
+INSERT
+INTO blob_tombstones (blob_key)
+SELECT blob_key
+FROM deleted;
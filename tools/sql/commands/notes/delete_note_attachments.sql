@@ -0,0 +1,10 @@
+-- name: DeleteNoteAttachments :exec
+WITH deleted AS (
+    DELETE
+    FROM note_attachments
+    WHERE note_id = @note_id
+    RETURNING blob_key)
+INSERT
+INTO blob_tombstones (blob_key)
+SELECT blob_key
+FROM deleted;
@@ -0,0 +1,5 @@
+-- name: DeleteNoteLink :execrows
+DELETE
+FROM note_links
+WHERE note_id = @note_id
+  AND token = @token;
@@ -0,0 +1,4 @@
+-- name: DeleteNoteReferences :exec
+DELETE
+FROM note_references
+WHERE source_id = @source_id;
@@ -0,0 +1,4 @@
+-- name: DeleteNoteReminder :execrows
+DELETE
+FROM note_reminders
+WHERE id = @id;
@@ -0,0 +1,5 @@
+-- name: DeleteNoteShare :execrows
+DELETE
+FROM note_shares
+WHERE note_id = @note_id
+  AND user_id = @user_id;
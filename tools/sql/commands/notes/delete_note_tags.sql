@@ -0,0 +1,7 @@
+-- name: DeleteNoteTags :exec
+DELETE
+FROM note_tags
+    USING tags
+WHERE note_tags.tag_id = tags.id
+  AND note_tags.note_id = @note_id
+  AND tags.name = ANY (@names::text[]);
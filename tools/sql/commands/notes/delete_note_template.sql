@@ -0,0 +1,4 @@
+-- name: DeleteNoteTemplate :exec
+DELETE
+FROM note_templates
+WHERE id = @id;
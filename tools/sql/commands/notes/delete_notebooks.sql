@@ -0,0 +1,4 @@
+-- name: DeleteNotebooks :exec
+DELETE
+FROM notebooks
+WHERE id = ANY (@ids::bigint[]);
@@ -0,0 +1,4 @@
+-- name: DeleteOutboxEvents :exec
+DELETE
+FROM event_outbox
+WHERE event_id = ANY (@event_ids::UUID[]);
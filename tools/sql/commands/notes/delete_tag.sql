@@ -0,0 +1,4 @@
+-- name: DeleteTag :exec
+DELETE
+FROM tags
+WHERE id = @id;
@@ -0,0 +1,5 @@
+-- name: DeleteTrashedNotes :many
+DELETE
+FROM notes
+WHERE deleted_at < @deleted_before
+RETURNING id, user_id;
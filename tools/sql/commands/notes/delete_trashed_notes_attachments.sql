@@ -0,0 +1,10 @@
+-- name: DeleteTrashedNotesAttachments :exec
+WITH deleted AS (
+    DELETE
+    FROM note_attachments
+    WHERE note_id IN (SELECT id FROM notes WHERE deleted_at < @deleted_before)
+    RETURNING blob_key)
+INSERT
+INTO blob_tombstones (blob_key)
+SELECT blob_key
+FROM deleted;
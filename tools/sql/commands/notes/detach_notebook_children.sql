@@ -0,0 +1,5 @@
+-- name: DetachNotebookChildren :exec
+UPDATE notebooks
+SET parent_id  = NULL,
+    updated_at = @updated_at
+WHERE parent_id = @parent_id;
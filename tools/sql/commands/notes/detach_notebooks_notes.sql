@@ -0,0 +1,5 @@
+-- name: DetachNotebooksNotes :exec
+UPDATE notes
+SET notebook_id = NULL,
+    version     = version + 1
+WHERE notebook_id = ANY (@notebook_ids::bigint[]);
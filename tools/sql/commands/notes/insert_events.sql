@@ -0,0 +1,18 @@
+-- name: InsertEvents :exec
+WITH events AS (SELECT unnest(@event_ids::UUID[])            AS event_id,
+                       unnest(@event_types::SMALLINT[])      AS event_type,
+                       unnest(@note_ids::BIGINT[])           AS note_id,
+                       unnest(@recipient_ids::BIGINT[])      AS recipient_id,
+                       unnest(@event_times::TIMESTAMPTZ[])   AS event_time),
+     history AS (INSERT INTO note_events (id, event_type, note_id, recipient_id, event_time)
+         SELECT event_id, event_type, note_id, recipient_id, event_time FROM events),
+     deliveries AS (INSERT INTO webhook_deliveries (webhook_id, event_id, event_type, note_id, recipient_id, event_time)
+         SELECT w.id, e.event_id, e.event_type, e.note_id, e.recipient_id, e.event_time
+         FROM events e
+                  JOIN webhooks w ON w.user_id = e.recipient_id
+         WHERE w.disabled_at IS NULL
+           AND (cardinality(w.event_types) = 0 OR e.event_type = ANY (w.event_types)))
+INSERT
+INTO event_outbox (event_id, event_type, note_id, recipient_id, event_time)
+SELECT event_id, event_type, note_id, recipient_id, event_time
+FROM events;
@@ -0,0 +1,4 @@
+-- name: InsertNoteAttachment :one
+INSERT INTO note_attachments (note_id, user_id, name, content_type, blob_key, size, checksum, created_at)
+VALUES (@note_id, @user_id, @name, @content_type, @blob_key, @size, @checksum, @created_at)
+RETURNING id;
@@ -0,0 +1,4 @@
+-- name: InsertNoteLink :one
+INSERT INTO note_links (note_id, token, expires_at, created_at)
+VALUES (@note_id, @token, @expires_at, @created_at)
+RETURNING id;
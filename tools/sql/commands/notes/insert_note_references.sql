@@ -0,0 +1,8 @@
+-- name: InsertNoteReferences :exec
+WITH refs AS (SELECT unnest(@positions::INTEGER[])   AS position,
+                     unnest(@target_ids::BIGINT[])   AS target_id,
+                     unnest(@target_titles::TEXT[])  AS target_title)
+INSERT
+INTO note_references (source_id, position, target_id, target_title)
+SELECT @source_id, position, NULLIF(target_id, 0), NULLIF(target_title, '')
+FROM refs;
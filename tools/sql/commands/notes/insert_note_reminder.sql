@@ -0,0 +1,4 @@
+-- name: InsertNoteReminder :one
+INSERT INTO note_reminders (note_id, user_id, fire_at, recurrence, time_zone, created_at, updated_at)
+VALUES (@note_id, @user_id, @fire_at, @recurrence, @time_zone, @created_at, @updated_at)
+RETURNING id;
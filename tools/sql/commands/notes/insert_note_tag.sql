@@ -0,0 +1,4 @@
+-- name: InsertNoteTag :exec
+INSERT INTO note_tags (note_id, tag_id)
+VALUES (@note_id, @tag_id)
+ON CONFLICT DO NOTHING;
@@ -0,0 +1,4 @@
+-- name: InsertNoteTemplate :one
+INSERT INTO note_templates (user_id, name, title, content, created_at, updated_at)
+VALUES (@user_id, @name, @title, @content, @created_at, @updated_at)
+RETURNING id;
@@ -0,0 +1,4 @@
+-- name: InsertNotebook :one
+INSERT INTO notebooks (user_id, parent_id, name, created_at, updated_at)
+VALUES (@user_id, @parent_id, @name, @created_at, @updated_at)
+RETURNING id;
@@ -0,0 +1,6 @@
+-- name: InsertRevision :one
+INSERT INTO note_revisions (note_id, revision, title, content, user_id, created_at)
+VALUES (@note_id,
+        (SELECT coalesce(max(revision), 0) + 1 FROM note_revisions WHERE note_id = @note_id),
+        @title, @content, @user_id, @created_at)
+RETURNING id, revision;
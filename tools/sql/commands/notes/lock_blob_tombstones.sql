@@ -0,0 +1,5 @@
+-- name: LockBlobTombstones :many
+SELECT blob_key
+FROM blob_tombstones
+ORDER BY buried_at, blob_key
+LIMIT @max_count FOR UPDATE SKIP LOCKED;
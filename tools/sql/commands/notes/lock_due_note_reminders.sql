@@ -0,0 +1,6 @@
+-- name: LockDueNoteReminders :many
+SELECT *
+FROM note_reminders
+WHERE fire_at <= now()
+ORDER BY fire_at, id
+LIMIT @max_count FOR UPDATE SKIP LOCKED;
@@ -0,0 +1,6 @@
+-- name: LockNote :one
+SELECT version
+FROM notes
+WHERE id = @id
+  AND version = @version
+FOR UPDATE;
@@ -0,0 +1,5 @@
+-- name: LockNotebooksByUser :exec
+SELECT id
+FROM notebooks
+WHERE user_id = @user_id
+FOR UPDATE;
@@ -0,0 +1,6 @@
+-- name: LockOutboxEvents :many
+SELECT *
+FROM event_outbox
+WHERE available_at <= now()
+ORDER BY created_at, event_id
+LIMIT @max_count FOR UPDATE SKIP LOCKED;
@@ -0,0 +1,6 @@
+-- name: MergeNoteTags :exec
+INSERT INTO note_tags (note_id, tag_id)
+SELECT source.note_id, @into_id::bigint
+FROM note_tags AS source
+WHERE source.tag_id = @from_id::bigint
+ON CONFLICT DO NOTHING;
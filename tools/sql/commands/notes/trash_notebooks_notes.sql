@@ -0,0 +1,7 @@
+-- name: TrashNotebooksNotes :many
+UPDATE notes
+SET deleted_at = @deleted_at,
+    version    = version + 1
+WHERE notebook_id = ANY (@notebook_ids::bigint[])
+  AND deleted_at IS NULL
+RETURNING id, user_id;
@@ -0,0 +1,12 @@
+-- name: UpdateNote :one
+UPDATE notes
+SET title      = @title,
+    content    = @content,
+    pinned     = @pinned,
+    archived   = @archived,
+    starred    = @starred,
+    updated_at = @updated_at,
+    version    = version + 1
+WHERE id = @id
+  AND version = @version
+RETURNING version;
@@ -0,0 +1,7 @@
+-- name: UpdateNoteDeletedAt :one
+UPDATE notes
+SET deleted_at = @deleted_at,
+    version    = version + 1
+WHERE id = @id
+  AND version = @version
+RETURNING version;
@@ -0,0 +1,6 @@
+-- name: UpdateNoteEventsReadAt :execrows
+UPDATE note_events
+SET read_at = now()
+WHERE recipient_id = @recipient_id
+  AND read_at IS NULL
+  AND (id = ANY (@ids::UUID[]) OR event_time <= sqlc.narg(up_to)::TIMESTAMPTZ);
@@ -0,0 +1,5 @@
+-- name: UpdateNoteLinkViews :one
+UPDATE note_links
+SET views = views + 1
+WHERE id = @id
+RETURNING views;
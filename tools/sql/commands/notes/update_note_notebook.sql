@@ -0,0 +1,8 @@
+-- name: UpdateNoteNotebook :one
+UPDATE notes
+SET notebook_id = @notebook_id,
+    updated_at  = @updated_at,
+    version     = version + 1
+WHERE id = @id
+  AND version = @version
+RETURNING version;
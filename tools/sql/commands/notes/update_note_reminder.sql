@@ -0,0 +1,9 @@
+-- name: UpdateNoteReminder :execrows
+UPDATE note_reminders
+SET fire_at     = @fire_at,
+    fired_at    = @fired_at,
+    recurrence  = @recurrence,
+    time_zone   = @time_zone,
+    occurrences = @occurrences,
+    updated_at  = @updated_at
+WHERE id = @id;
@@ -0,0 +1,7 @@
+-- name: UpdateNoteTemplate :exec
+UPDATE note_templates
+SET name       = @name,
+    title      = @title,
+    content    = @content,
+    updated_at = @updated_at
+WHERE id = @id;
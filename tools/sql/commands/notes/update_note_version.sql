@@ -0,0 +1,6 @@
+-- name: UpdateNoteVersion :one
+UPDATE notes
+SET version = version + 1
+WHERE id = @id
+  AND version = @version
+RETURNING version;
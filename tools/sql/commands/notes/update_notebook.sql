@@ -0,0 +1,6 @@
+-- name: UpdateNotebook :exec
+UPDATE notebooks
+SET parent_id  = @parent_id,
+    name       = @name,
+    updated_at = @updated_at
+WHERE id = @id;
@@ -0,0 +1,6 @@
+-- name: UpdateOutboxEventsAttempts :exec
+UPDATE event_outbox
+SET attempts     = attempts + 1,
+    last_error   = @last_error,
+    available_at = now() + LEAST(sqlc.arg(max_backoff)::INTERVAL, sqlc.arg(backoff)::INTERVAL * POWER(2, LEAST(attempts, 20)))
+WHERE event_id = ANY (@event_ids::UUID[]);
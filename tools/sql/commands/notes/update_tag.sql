@@ -0,0 +1,4 @@
+-- name: UpdateTag :exec
+UPDATE tags
+SET name = @name
+WHERE id = @id;
@@ -0,0 +1,5 @@
+-- name: UpsertNoteShare :one
+INSERT INTO note_shares (note_id, user_id, role, created_at)
+VALUES (@note_id, @user_id, @role, @created_at)
+ON CONFLICT (note_id, user_id) DO UPDATE SET role = excluded.role
+RETURNING created_at;
@@ -0,0 +1,5 @@
+-- name: UpsertTag :one
+INSERT INTO tags (user_id, name, created_at)
+VALUES (@user_id, @name, @created_at)
+ON CONFLICT (user_id, name) DO UPDATE SET name = excluded.name
+RETURNING id, created_at;
@@ -0,0 +1,18 @@
+-- name: ClaimWebhookDeliveries :many
+WITH due AS (SELECT d.webhook_id, d.event_id
+             FROM webhook_deliveries d
+                      JOIN webhooks w ON w.id = d.webhook_id
+             WHERE d.available_at <= now()
+               AND w.disabled_at IS NULL
+             ORDER BY d.available_at, d.created_at
+             LIMIT @max_count FOR UPDATE OF d SKIP LOCKED)
+UPDATE webhook_deliveries d
+SET attempts     = d.attempts + 1,
+    available_at = now() + sqlc.arg(lease)::INTERVAL
+FROM due,
+     webhooks w
+WHERE d.webhook_id = due.webhook_id
+  AND d.event_id = due.event_id
+  AND w.id = d.webhook_id
+RETURNING d.webhook_id, d.event_id, d.event_type, d.note_id, d.recipient_id, d.event_time, d.attempts,
+    w.user_id, w.url, w.secret, w.failures;
@@ -0,0 +1,4 @@
+-- name: DeleteWebhook :exec
+DELETE
+FROM webhooks
+WHERE id = @id;
@@ -0,0 +1,4 @@
+-- name: DeleteWebhookAttempts :execrows
+DELETE
+FROM webhook_attempts
+WHERE attempted_at < @attempted_before;
@@ -0,0 +1,4 @@
+-- name: DeleteWebhookDeliveries :exec
+DELETE
+FROM webhook_deliveries
+WHERE webhook_id = @webhook_id;
@@ -0,0 +1,5 @@
+-- name: DeleteWebhookDelivery :exec
+DELETE
+FROM webhook_deliveries
+WHERE webhook_id = @webhook_id
+  AND event_id = @event_id;
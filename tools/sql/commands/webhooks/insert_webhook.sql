@@ -0,0 +1,4 @@
+-- name: InsertWebhook :one
+INSERT INTO webhooks (user_id, url, secret, event_types, created_at, updated_at)
+VALUES (@user_id, @url, @secret, @event_types, @created_at, @updated_at)
+RETURNING id;
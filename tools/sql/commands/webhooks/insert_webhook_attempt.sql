@@ -0,0 +1,4 @@
+-- name: InsertWebhookAttempt :one
+INSERT INTO webhook_attempts (webhook_id, event_id, event_type, status_code, error, duration, attempted_at)
+VALUES (@webhook_id, @event_id, @event_type, @status_code, @error, @duration, @attempted_at)
+RETURNING id;
@@ -0,0 +1,8 @@
+-- name: UpdateWebhook :exec
+UPDATE webhooks
+SET url         = @url,
+    event_types = @event_types,
+    failures    = @failures,
+    disabled_at = @disabled_at,
+    updated_at  = @updated_at
+WHERE id = @id;
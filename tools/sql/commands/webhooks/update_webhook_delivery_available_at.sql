@@ -0,0 +1,5 @@
+-- name: UpdateWebhookDeliveryAvailableAt :exec
+UPDATE webhook_deliveries
+SET available_at = now() + LEAST(sqlc.arg(max_backoff)::INTERVAL, sqlc.arg(backoff)::INTERVAL * POWER(2, LEAST(attempts - 1, 20)))
+WHERE webhook_id = @webhook_id
+  AND event_id = @event_id;
@@ -0,0 +1,5 @@
+-- name: UpdateWebhookFailures :one
+UPDATE webhooks
+SET failures = CASE WHEN @failed::BOOLEAN THEN failures + 1 ELSE 0 END
+WHERE id = @id
+RETURNING failures;
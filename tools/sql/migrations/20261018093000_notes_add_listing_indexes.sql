@@ -0,0 +1,17 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE INDEX IF NOT EXISTS notes_user_id_created_at ON notes (user_id, created_at, id);
+
+CREATE INDEX IF NOT EXISTS notes_user_id_updated_at ON notes (user_id, updated_at, id);
+
+CREATE INDEX IF NOT EXISTS notes_user_id_title ON notes (user_id, title, id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS notes_user_id_title;
+
+DROP INDEX IF EXISTS notes_user_id_updated_at;
+
+DROP INDEX IF EXISTS notes_user_id_created_at;
+-- +goose StatementEnd
@@ -0,0 +1,17 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE notes
+    ADD COLUMN IF NOT EXISTS search TSVECTOR GENERATED ALWAYS AS (
+        setweight(to_tsvector('simple', title), 'A') || setweight(to_tsvector('simple', content), 'B')
+        ) STORED;
+
+CREATE INDEX IF NOT EXISTS notes_search ON notes USING GIN (search);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS notes_search;
+
+ALTER TABLE notes
+    DROP COLUMN IF EXISTS search;
+-- +goose StatementEnd
@@ -0,0 +1,23 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS note_revisions
+(
+    id         BIGINT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+    note_id    BIGINT       NOT NULL REFERENCES notes (id) ON DELETE CASCADE,
+    revision   INTEGER      NOT NULL,
+    title      VARCHAR(255) NOT NULL,
+    content    TEXT         NOT NULL,
+    user_id    BIGINT       NULL REFERENCES users (id) ON DELETE SET NULL,
+    created_at TIMESTAMPTZ  NOT NULL DEFAULT now(),
+    CONSTRAINT note_revisions_note_id_revision_unique UNIQUE (note_id, revision)
+);
+
+INSERT INTO note_revisions (note_id, revision, title, content, user_id, created_at)
+SELECT id, 1, title, content, user_id, updated_at
+FROM notes;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS note_revisions;
+-- +goose StatementEnd
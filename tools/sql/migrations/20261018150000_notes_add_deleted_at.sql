@@ -0,0 +1,15 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE notes
+    ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMPTZ NULL;
+
+CREATE INDEX IF NOT EXISTS notes_user_id_deleted_at ON notes (user_id, deleted_at, id) WHERE deleted_at IS NOT NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS notes_user_id_deleted_at;
+
+ALTER TABLE notes
+    DROP COLUMN IF EXISTS deleted_at;
+-- +goose StatementEnd
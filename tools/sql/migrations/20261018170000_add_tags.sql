@@ -0,0 +1,27 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS tags
+(
+    id         BIGINT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+    user_id    BIGINT      NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    name       VARCHAR(64) NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    CONSTRAINT tags_user_id_name_unique UNIQUE (user_id, name)
+);
+
+CREATE TABLE IF NOT EXISTS note_tags
+(
+    note_id BIGINT NOT NULL REFERENCES notes (id) ON DELETE CASCADE,
+    tag_id  BIGINT NOT NULL REFERENCES tags (id) ON DELETE CASCADE,
+    PRIMARY KEY (note_id, tag_id)
+);
+
+CREATE INDEX IF NOT EXISTS note_tags_tag_id ON note_tags (tag_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS note_tags;
+
+DROP TABLE IF EXISTS tags;
+-- +goose StatementEnd
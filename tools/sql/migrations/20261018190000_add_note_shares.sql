@@ -0,0 +1,18 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS note_shares
+(
+    note_id    BIGINT      NOT NULL REFERENCES notes (id) ON DELETE CASCADE,
+    user_id    BIGINT      NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    role       VARCHAR(16) NOT NULL CHECK (role IN ('viewer', 'editor')),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    PRIMARY KEY (note_id, user_id)
+);
+
+CREATE INDEX IF NOT EXISTS note_shares_user_id_created_at ON note_shares (user_id, created_at DESC, note_id DESC);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS note_shares;
+-- +goose StatementEnd
@@ -0,0 +1,20 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS note_links
+(
+    id         BIGINT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+    note_id    BIGINT      NOT NULL REFERENCES notes (id) ON DELETE CASCADE,
+    token      VARCHAR(64) NOT NULL,
+    views      BIGINT      NOT NULL DEFAULT 0,
+    expires_at TIMESTAMPTZ NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    CONSTRAINT note_links_token_unique UNIQUE (token)
+);
+
+CREATE INDEX IF NOT EXISTS note_links_note_id ON note_links (note_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS note_links;
+-- +goose StatementEnd
@@ -0,0 +1,30 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS notebooks
+(
+    id         BIGINT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+    user_id    BIGINT       NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    parent_id  BIGINT       NULL REFERENCES notebooks (id),
+    name       VARCHAR(255) NOT NULL,
+    created_at TIMESTAMPTZ  NOT NULL DEFAULT now(),
+    updated_at TIMESTAMPTZ  NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS notebooks_user_id ON notebooks (user_id);
+CREATE INDEX IF NOT EXISTS notebooks_parent_id ON notebooks (parent_id);
+
+ALTER TABLE notes
+    ADD COLUMN IF NOT EXISTS notebook_id BIGINT NULL REFERENCES notebooks (id);
+
+CREATE INDEX IF NOT EXISTS notes_notebook_id ON notes (notebook_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS notes_notebook_id;
+
+ALTER TABLE notes
+    DROP COLUMN IF EXISTS notebook_id;
+
+DROP TABLE IF EXISTS notebooks;
+-- +goose StatementEnd
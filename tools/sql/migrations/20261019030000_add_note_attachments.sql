@@ -0,0 +1,23 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS note_attachments
+(
+    id           BIGINT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+    note_id      BIGINT       NOT NULL REFERENCES notes (id) ON DELETE CASCADE,
+    user_id      BIGINT       NULL REFERENCES users (id) ON DELETE SET NULL,
+    name         VARCHAR(255) NOT NULL,
+    content_type VARCHAR(255) NOT NULL,
+    blob_key     VARCHAR(64)  NOT NULL,
+    size         BIGINT       NOT NULL,
+    checksum     VARCHAR(64)  NOT NULL,
+    created_at   TIMESTAMPTZ  NOT NULL DEFAULT now(),
+    CONSTRAINT note_attachments_blob_key_unique UNIQUE (blob_key)
+);
+
+CREATE INDEX IF NOT EXISTS note_attachments_note_id ON note_attachments (note_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS note_attachments;
+-- +goose StatementEnd
@@ -0,0 +1,22 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS event_outbox
+(
+    event_id     UUID PRIMARY KEY,
+    event_type   SMALLINT    NOT NULL,
+    note_id      BIGINT      NOT NULL,
+    recipient_id BIGINT      NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    event_time   TIMESTAMPTZ NOT NULL,
+    attempts     INTEGER     NOT NULL DEFAULT 0,
+    last_error   TEXT        NULL,
+    available_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    created_at   TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS event_outbox_available_at ON event_outbox (available_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS event_outbox;
+-- +goose StatementEnd
@@ -0,0 +1,20 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS note_events
+(
+    id           UUID PRIMARY KEY,
+    event_type   SMALLINT    NOT NULL,
+    note_id      BIGINT      NOT NULL,
+    recipient_id BIGINT      NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    event_time   TIMESTAMPTZ NOT NULL,
+    read_at      TIMESTAMPTZ NULL
+);
+
+CREATE INDEX IF NOT EXISTS note_events_recipient_id ON note_events (recipient_id, event_time DESC, id DESC);
+CREATE INDEX IF NOT EXISTS note_events_unread ON note_events (recipient_id) WHERE read_at IS NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS note_events;
+-- +goose StatementEnd
@@ -0,0 +1,54 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS webhooks
+(
+    id          BIGINT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+    user_id     BIGINT        NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    url         VARCHAR(2048) NOT NULL,
+    secret      VARCHAR(64)   NOT NULL,
+    event_types SMALLINT[]    NOT NULL DEFAULT '{}',
+    failures    INTEGER       NOT NULL DEFAULT 0,
+    disabled_at TIMESTAMPTZ   NULL,
+    created_at  TIMESTAMPTZ   NOT NULL DEFAULT now(),
+    updated_at  TIMESTAMPTZ   NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS webhooks_user_id ON webhooks (user_id);
+
+CREATE TABLE IF NOT EXISTS webhook_deliveries
+(
+    webhook_id   BIGINT      NOT NULL REFERENCES webhooks (id) ON DELETE CASCADE,
+    event_id     UUID        NOT NULL,
+    event_type   SMALLINT    NOT NULL,
+    note_id      BIGINT      NOT NULL,
+    recipient_id BIGINT      NOT NULL,
+    event_time   TIMESTAMPTZ NOT NULL,
+    attempts     INTEGER     NOT NULL DEFAULT 0,
+    available_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    created_at   TIMESTAMPTZ NOT NULL DEFAULT now(),
+    PRIMARY KEY (webhook_id, event_id)
+);
+
+CREATE INDEX IF NOT EXISTS webhook_deliveries_available_at ON webhook_deliveries (available_at);
+
+CREATE TABLE IF NOT EXISTS webhook_attempts
+(
+    id           BIGINT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+    webhook_id   BIGINT      NOT NULL REFERENCES webhooks (id) ON DELETE CASCADE,
+    event_id     UUID        NOT NULL,
+    event_type   SMALLINT    NOT NULL,
+    status_code  INTEGER     NOT NULL,
+    error        TEXT        NULL,
+    duration     INTERVAL    NOT NULL,
+    attempted_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS webhook_attempts_webhook_id ON webhook_attempts (webhook_id, attempted_at DESC, id DESC);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS webhook_attempts;
+DROP TABLE IF EXISTS webhook_deliveries;
+DROP TABLE IF EXISTS webhooks;
+-- +goose StatementEnd
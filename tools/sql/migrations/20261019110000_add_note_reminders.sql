@@ -0,0 +1,24 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS note_reminders
+(
+    id          BIGINT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+    note_id     BIGINT       NOT NULL REFERENCES notes (id) ON DELETE CASCADE,
+    user_id     BIGINT       NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    fire_at     TIMESTAMPTZ  NULL,
+    fired_at    TIMESTAMPTZ  NULL,
+    recurrence  VARCHAR(256) NOT NULL DEFAULT '',
+    time_zone   VARCHAR(64)  NOT NULL DEFAULT 'UTC',
+    occurrences INTEGER      NOT NULL DEFAULT 0,
+    created_at  TIMESTAMPTZ  NOT NULL DEFAULT now(),
+    updated_at  TIMESTAMPTZ  NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS note_reminders_note_id ON note_reminders (note_id, user_id);
+CREATE INDEX IF NOT EXISTS note_reminders_fire_at ON note_reminders (fire_at) WHERE fire_at IS NOT NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS note_reminders;
+-- +goose StatementEnd
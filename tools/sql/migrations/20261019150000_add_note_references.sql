@@ -0,0 +1,21 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS note_references
+(
+    source_id    BIGINT       NOT NULL REFERENCES notes (id) ON DELETE CASCADE,
+    position     INTEGER      NOT NULL,
+    target_id    BIGINT       NULL,
+    target_title VARCHAR(255) NULL,
+    PRIMARY KEY (source_id, position)
+);
+
+CREATE INDEX IF NOT EXISTS note_references_target_id ON note_references (target_id);
+CREATE INDEX IF NOT EXISTS note_references_target_title ON note_references (lower(target_title));
+CREATE INDEX IF NOT EXISTS notes_user_id_title ON notes (user_id, lower(title));
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS notes_user_id_title;
+DROP TABLE IF EXISTS note_references;
+-- +goose StatementEnd
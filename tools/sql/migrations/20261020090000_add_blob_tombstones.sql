@@ -0,0 +1,15 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS blob_tombstones
+(
+    blob_key  VARCHAR(64) NOT NULL PRIMARY KEY,
+    buried_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS blob_tombstones_buried_at ON blob_tombstones (buried_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS blob_tombstones;
+-- +goose StatementEnd
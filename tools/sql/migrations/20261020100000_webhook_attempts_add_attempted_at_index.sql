@@ -0,0 +1,9 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE INDEX IF NOT EXISTS webhook_attempts_attempted_at ON webhook_attempts (attempted_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS webhook_attempts_attempted_at;
+-- +goose StatementEnd
@@ -0,0 +1,38 @@
+-- +goose Up
+-- +goose StatementBegin
+WITH links AS (SELECT notes.id                                    AS source_id,
+                      link.ordinality                             AS ordinality,
+                      btrim(link.match[1], E' \t\n\r\f\v')        AS text
+               FROM notes,
+                    regexp_matches(notes.content, '\[\[([^\[\]|\n]+)(?:\|[^\[\]\n]*)?\]\]', 'g')
+                        WITH ORDINALITY AS link (match, ordinality)
+               WHERE NOT EXISTS (SELECT 1 FROM note_references WHERE note_references.source_id = notes.id)),
+     numbers AS (SELECT source_id,
+                        ordinality,
+                        text,
+                        CASE
+                            WHEN text ~ '^[+-]?[0-9]{1,19}$' AND abs(text::NUMERIC) <= 9223372036854775807
+                                THEN text::NUMERIC
+                            END AS number
+                 FROM links
+                 WHERE text <> ''
+                   AND char_length(text) <= 255),
+     targets AS (SELECT source_id,
+                        min(ordinality) AS ordinality,
+                        target_id,
+                        target_title
+                 FROM (SELECT source_id,
+                              ordinality,
+                              number::BIGINT                          AS target_id,
+                              CASE WHEN number IS NULL THEN text END AS target_title
+                       FROM numbers
+                       WHERE number IS NULL
+                          OR number > 0) AS parsed
+                 GROUP BY source_id, target_id, target_title)
+INSERT
+INTO note_references (source_id, position, target_id, target_title)
+SELECT source_id, row_number() OVER (PARTITION BY source_id ORDER BY ordinality) - 1, target_id, target_title
+FROM targets;
+-- +goose StatementEnd
+
+-- +goose Down
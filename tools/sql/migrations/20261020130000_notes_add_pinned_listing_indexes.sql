@@ -0,0 +1,41 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE INDEX IF NOT EXISTS notes_user_id_pinned_created_at_asc ON notes (user_id, pinned DESC, created_at, id);
+
+CREATE INDEX IF NOT EXISTS notes_user_id_pinned_created_at_desc ON notes (user_id, pinned DESC, created_at DESC, id DESC);
+
+CREATE INDEX IF NOT EXISTS notes_user_id_pinned_updated_at_asc ON notes (user_id, pinned DESC, updated_at, id);
+
+CREATE INDEX IF NOT EXISTS notes_user_id_pinned_updated_at_desc ON notes (user_id, pinned DESC, updated_at DESC, id DESC);
+
+CREATE INDEX IF NOT EXISTS notes_user_id_pinned_title_asc ON notes (user_id, pinned DESC, title, id);
+
+CREATE INDEX IF NOT EXISTS notes_user_id_pinned_title_desc ON notes (user_id, pinned DESC, title DESC, id DESC);
+
+DROP INDEX IF EXISTS notes_user_id_created_at;
+
+DROP INDEX IF EXISTS notes_user_id_updated_at;
+
+DROP INDEX IF EXISTS notes_user_id_title;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+CREATE INDEX IF NOT EXISTS notes_user_id_created_at ON notes (user_id, created_at, id);
+
+CREATE INDEX IF NOT EXISTS notes_user_id_updated_at ON notes (user_id, updated_at, id);
+
+CREATE INDEX IF NOT EXISTS notes_user_id_title ON notes (user_id, title, id);
+
+DROP INDEX IF EXISTS notes_user_id_pinned_title_desc;
+
+DROP INDEX IF EXISTS notes_user_id_pinned_title_asc;
+
+DROP INDEX IF EXISTS notes_user_id_pinned_updated_at_desc;
+
+DROP INDEX IF EXISTS notes_user_id_pinned_updated_at_asc;
+
+DROP INDEX IF EXISTS notes_user_id_pinned_created_at_desc;
+
+DROP INDEX IF EXISTS notes_user_id_pinned_created_at_asc;
+-- +goose StatementEnd
@@ -0,0 +1,25 @@
+-- name: CountNotesByUser :one
+SELECT count(*)
+FROM notes
+WHERE notes.user_id = @user_id
+  AND deleted_at IS NULL
+  AND (sqlc.narg(created_after)::timestamptz IS NULL OR created_at >= sqlc.narg(created_after))
+  AND (sqlc.narg(created_before)::timestamptz IS NULL OR created_at < sqlc.narg(created_before))
+  AND (sqlc.narg(updated_after)::timestamptz IS NULL OR updated_at >= sqlc.narg(updated_after))
+  AND (sqlc.narg(updated_before)::timestamptz IS NULL OR updated_at < sqlc.narg(updated_before))
+  AND (sqlc.narg(title_prefix)::text IS NULL OR starts_with(title, sqlc.narg(title_prefix)))
+  AND (sqlc.narg(notebook_id)::bigint IS NULL OR notes.notebook_id = sqlc.narg(notebook_id))
+  AND (sqlc.narg(pinned)::boolean IS NULL OR pinned = sqlc.narg(pinned))
+  AND (sqlc.narg(archived)::boolean IS NULL OR archived = sqlc.narg(archived))
+  AND (sqlc.narg(starred)::boolean IS NULL OR starred = sqlc.narg(starred))
+  AND (coalesce(cardinality(@tags_any::text[]), 0) = 0 OR EXISTS (SELECT 1
+                                                                  FROM note_tags
+                                                                           JOIN tags ON tags.id = note_tags.tag_id
+                                                                  WHERE note_tags.note_id = notes.id
+                                                                    AND tags.name = ANY (@tags_any::text[])))
+  AND (coalesce(cardinality(@tags_all::text[]), 0) = 0 OR (SELECT count(DISTINCT tags.name)
+                                                           FROM note_tags
+                                                                    JOIN tags ON tags.id = note_tags.tag_id
+                                                           WHERE note_tags.note_id = notes.id
+                                                             AND tags.name = ANY (@tags_all::text[]))
+    = cardinality(@tags_all::text[]));
@@ -0,0 +1,6 @@
+-- name: CountSearchNotesByUser :one
+SELECT count(*)
+FROM notes
+WHERE notes.user_id = @user_id
+  AND notes.deleted_at IS NULL
+  AND notes.search @@ websearch_to_tsquery('simple', @query);
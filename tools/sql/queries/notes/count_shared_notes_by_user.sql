@@ -0,0 +1,6 @@
+-- name: CountSharedNotesByUser :one
+SELECT count(*)
+FROM note_shares
+         JOIN notes ON notes.id = note_shares.note_id
+WHERE note_shares.user_id = @user_id
+  AND notes.deleted_at IS NULL;
@@ -0,0 +1,5 @@
+-- name: CountTrashedNotesByUser :one
+SELECT count(*)
+FROM notes
+WHERE user_id = @user_id
+  AND deleted_at IS NOT NULL;
@@ -0,0 +1,12 @@
+-- name: CountUnreadEventsByUser :one
+SELECT count(*)
+FROM note_events
+WHERE note_events.recipient_id = @recipient_id
+  AND read_at IS NULL
+  AND (coalesce(cardinality(@event_types::smallint[]), 0) = 0 OR event_type = ANY (@event_types::smallint[]))
+  AND (coalesce(cardinality(@note_ids::bigint[]), 0) = 0 OR note_id = ANY (@note_ids::bigint[]))
+  AND (coalesce(cardinality(@tags::text[]), 0) = 0 OR EXISTS (SELECT 1
+                                                              FROM note_tags
+                                                                       JOIN tags ON tags.id = note_tags.tag_id
+                                                              WHERE note_tags.note_id = note_events.note_id
+                                                                AND tags.name = ANY (@tags::text[])));
@@ -0,0 +1,19 @@
+-- name: SearchNotesByUser :many
+SELECT sqlc.embed(notes),
+       ts_rank(notes.search, websearch_to_tsquery('simple', @query))::real AS rank,
+       ts_headline('simple', translate(notes.title, chr(57344) || chr(57345), ''),
+                   websearch_to_tsquery('simple', @query),
+                   'StartSel=' || chr(57344) || ', StopSel=' || chr(57345) || ', HighlightAll=true')::text AS title_snippet,
+       ts_headline('simple', translate(notes.content, chr(57344) || chr(57345), ''),
+                   websearch_to_tsquery('simple', @query),
+                   'StartSel=' || chr(57344) || ', StopSel=' || chr(57345) ||
+                   ', MaxFragments=3, FragmentDelimiter=" ... "')::text AS content_snippet
+FROM notes
+WHERE notes.user_id = @user_id
+  AND notes.deleted_at IS NULL
+  AND notes.search @@ websearch_to_tsquery('simple', @query)
+  AND (sqlc.narg(after_id)::bigint IS NULL
+    OR (ts_rank(notes.search, websearch_to_tsquery('simple', @query))::real, notes.id)
+           < (sqlc.narg(after_rank)::real, sqlc.narg(after_id)))
+ORDER BY rank DESC, notes.id DESC
+LIMIT @page_limit;
@@ -0,0 +1,12 @@
+-- name: SelectBacklinks :many
+SELECT *
+FROM notes
+WHERE user_id = @user_id
+  AND deleted_at IS NULL
+  AND id <> @note_id
+  AND EXISTS (SELECT 1
+              FROM note_references
+              WHERE note_references.source_id = notes.id
+                AND (note_references.target_id = @note_id
+                  OR lower(note_references.target_title) = lower(@title::text)))
+ORDER BY updated_at DESC, id DESC;
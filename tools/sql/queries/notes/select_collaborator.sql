@@ -0,0 +1,4 @@
+-- name: SelectCollaborator :one
+SELECT id, name, email
+FROM users
+WHERE email = @email;
@@ -0,0 +1,13 @@
+-- name: SelectDanglingReferences :many
+SELECT sqlc.embed(note_references), sqlc.embed(notes)
+FROM note_references
+         JOIN notes ON notes.id = note_references.source_id
+WHERE notes.user_id = @user_id
+  AND notes.deleted_at IS NULL
+  AND NOT EXISTS (SELECT 1
+                  FROM notes targets
+                  WHERE targets.user_id = @user_id
+                    AND targets.deleted_at IS NULL
+                    AND (targets.id = note_references.target_id
+                      OR lower(targets.title) = lower(note_references.target_title)))
+ORDER BY notes.updated_at DESC, notes.id DESC, note_references.position;
@@ -0,0 +1,16 @@
+-- name: SelectEventsByUser :many
+SELECT *
+FROM note_events
+WHERE note_events.recipient_id = @recipient_id
+  AND (coalesce(cardinality(@event_types::smallint[]), 0) = 0 OR event_type = ANY (@event_types::smallint[]))
+  AND (coalesce(cardinality(@note_ids::bigint[]), 0) = 0 OR note_id = ANY (@note_ids::bigint[]))
+  AND (coalesce(cardinality(@tags::text[]), 0) = 0 OR EXISTS (SELECT 1
+                                                              FROM note_tags
+                                                                       JOIN tags ON tags.id = note_tags.tag_id
+                                                              WHERE note_tags.note_id = note_events.note_id
+                                                                AND tags.name = ANY (@tags::text[])))
+  AND (NOT @unread::boolean OR read_at IS NULL)
+  AND (sqlc.narg(after_id)::uuid IS NULL
+    OR (event_time, id) < (sqlc.narg(after_event_time)::timestamptz, sqlc.narg(after_id)))
+ORDER BY event_time DESC, id DESC
+LIMIT @page_limit;
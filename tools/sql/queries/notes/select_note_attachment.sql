@@ -0,0 +1,4 @@
+-- name: SelectNoteAttachment :one
+SELECT *
+FROM note_attachments
+WHERE id = @id;
@@ -0,0 +1,5 @@
+-- name: SelectNoteAttachments :many
+SELECT *
+FROM note_attachments
+WHERE note_id = @note_id
+ORDER BY created_at DESC, id DESC;
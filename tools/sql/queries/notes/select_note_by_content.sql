@@ -0,0 +1,10 @@
+-- name: SelectNoteByContent :one
+SELECT *
+FROM notes
+WHERE user_id = @user_id
+  AND deleted_at IS NULL
+  AND md5(content) = md5(@content::text)
+  AND title = @title
+  AND content = @content
+ORDER BY id
+LIMIT 1;
@@ -0,0 +1,4 @@
+-- name: SelectNoteLink :one
+SELECT *
+FROM note_links
+WHERE token = @token;
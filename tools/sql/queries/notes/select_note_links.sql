@@ -0,0 +1,5 @@
+-- name: SelectNoteLinks :many
+SELECT *
+FROM note_links
+WHERE note_id = @note_id
+ORDER BY created_at DESC, id DESC;
@@ -0,0 +1,4 @@
+-- name: SelectNoteReminder :one
+SELECT *
+FROM note_reminders
+WHERE id = @id;
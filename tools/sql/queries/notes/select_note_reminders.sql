@@ -0,0 +1,6 @@
+-- name: SelectNoteReminders :many
+SELECT *
+FROM note_reminders
+WHERE note_id = @note_id
+  AND user_id = @user_id
+ORDER BY fire_at NULLS LAST, id;
@@ -0,0 +1,6 @@
+-- name: SelectNoteShares :many
+SELECT sqlc.embed(note_shares), users.name, users.email
+FROM note_shares
+         JOIN users ON users.id = note_shares.user_id
+WHERE note_shares.note_id = @note_id
+ORDER BY note_shares.created_at, note_shares.user_id;
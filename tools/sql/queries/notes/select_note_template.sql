@@ -0,0 +1,4 @@
+-- name: SelectNoteTemplate :one
+SELECT *
+FROM note_templates
+WHERE id = @id;
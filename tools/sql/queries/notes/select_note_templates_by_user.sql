@@ -0,0 +1,5 @@
+-- name: SelectNoteTemplatesByUser :many
+SELECT *
+FROM note_templates
+WHERE user_id = @user_id
+ORDER BY name, id;
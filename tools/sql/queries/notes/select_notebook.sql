@@ -0,0 +1,4 @@
+-- name: SelectNotebook :one
+SELECT *
+FROM notebooks
+WHERE id = @id;
@@ -0,0 +1,11 @@
+-- name: SelectNotebookDescendants :many
+WITH RECURSIVE descendants AS (SELECT notebooks.*
+                               FROM notebooks
+                               WHERE notebooks.parent_id = @id
+                               UNION
+                               SELECT notebooks.*
+                               FROM notebooks
+                                        JOIN descendants ON notebooks.parent_id = descendants.id)
+SELECT sqlc.embed(notebooks)
+FROM notebooks
+         JOIN descendants ON descendants.id = notebooks.id;
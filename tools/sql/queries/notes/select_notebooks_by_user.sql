@@ -0,0 +1,5 @@
+-- name: SelectNotebooksByUser :many
+SELECT *
+FROM notebooks
+WHERE user_id = @user_id
+ORDER BY name, id;
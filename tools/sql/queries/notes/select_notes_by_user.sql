@@ -1,5 +0,0 @@
--- name: SelectNotesByUser :many
-SELECT *
-FROM notes
-WHERE user_id = @user_id
-ORDER BY notes.id;
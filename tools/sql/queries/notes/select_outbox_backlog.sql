@@ -0,0 +1,3 @@
+-- name: SelectOutboxBacklog :one
+SELECT COUNT(*) AS events, COALESCE(MIN(created_at), now())::TIMESTAMPTZ AS oldest
+FROM event_outbox;
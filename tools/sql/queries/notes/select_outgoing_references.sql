@@ -0,0 +1,10 @@
+-- name: SelectOutgoingReferences :many
+SELECT DISTINCT ON (note_references.position) sqlc.embed(note_references),
+                                              targets.id    AS resolved_id,
+                                              targets.title AS resolved_title
+FROM note_references
+         LEFT JOIN notes targets ON targets.user_id = @user_id
+    AND targets.deleted_at IS NULL
+    AND (targets.id = note_references.target_id OR lower(targets.title) = lower(note_references.target_title))
+WHERE note_references.source_id = @source_id
+ORDER BY note_references.position, targets.id;
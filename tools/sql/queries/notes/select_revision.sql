@@ -0,0 +1,5 @@
+-- name: SelectRevision :one
+SELECT *
+FROM note_revisions
+WHERE note_id = @note_id
+  AND revision = @revision;
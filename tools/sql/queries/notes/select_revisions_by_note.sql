@@ -0,0 +1,7 @@
+-- name: SelectRevisionsByNote :many
+SELECT *
+FROM note_revisions
+WHERE note_id = @note_id
+  AND (sqlc.narg(after_revision)::integer IS NULL OR revision < sqlc.narg(after_revision))
+ORDER BY revision DESC
+LIMIT @page_limit;
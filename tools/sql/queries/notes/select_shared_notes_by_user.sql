@@ -0,0 +1,10 @@
+-- name: SelectSharedNotesByUser :many
+SELECT sqlc.embed(notes), note_shares.role, note_shares.created_at AS shared_at
+FROM note_shares
+         JOIN notes ON notes.id = note_shares.note_id
+WHERE note_shares.user_id = @user_id
+  AND notes.deleted_at IS NULL
+  AND (sqlc.narg(after_id)::bigint IS NULL
+    OR (note_shares.created_at, notes.id) < (sqlc.narg(after_shared_at)::timestamptz, sqlc.narg(after_id)))
+ORDER BY note_shares.created_at DESC, notes.id DESC
+LIMIT @page_limit;
@@ -0,0 +1,5 @@
+-- name: SelectTag :one
+SELECT *
+FROM tags
+WHERE user_id = @user_id
+  AND name = @name;
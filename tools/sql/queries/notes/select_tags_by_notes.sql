@@ -0,0 +1,6 @@
+-- name: SelectTagsByNotes :many
+SELECT note_tags.note_id, sqlc.embed(tags)
+FROM note_tags
+         JOIN tags ON tags.id = note_tags.tag_id
+WHERE note_tags.note_id = ANY (@note_ids::bigint[])
+ORDER BY tags.name;
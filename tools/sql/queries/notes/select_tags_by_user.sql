@@ -0,0 +1,8 @@
+-- name: SelectTagsByUser :many
+SELECT sqlc.embed(tags), count(notes.id)::integer AS notes
+FROM tags
+         LEFT JOIN note_tags ON note_tags.tag_id = tags.id
+         LEFT JOIN notes ON notes.id = note_tags.note_id AND notes.deleted_at IS NULL
+WHERE tags.user_id = @user_id
+GROUP BY tags.id
+ORDER BY tags.name;
@@ -0,0 +1,9 @@
+-- name: SelectTrashedNotesByUser :many
+SELECT *
+FROM notes
+WHERE user_id = @user_id
+  AND deleted_at IS NOT NULL
+  AND (sqlc.narg(after_id)::bigint IS NULL
+    OR (deleted_at, id) < (sqlc.narg(after_deleted_at)::timestamptz, sqlc.narg(after_id)))
+ORDER BY deleted_at DESC, id DESC
+LIMIT @page_limit;
@@ -0,0 +1,4 @@
+-- name: SelectWebhook :one
+SELECT *
+FROM webhooks
+WHERE id = @id;
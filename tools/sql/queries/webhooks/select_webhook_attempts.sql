@@ -0,0 +1,6 @@
+-- name: SelectWebhookAttempts :many
+SELECT *
+FROM webhook_attempts
+WHERE webhook_id = @webhook_id
+ORDER BY attempted_at DESC, id DESC
+LIMIT @max_count;
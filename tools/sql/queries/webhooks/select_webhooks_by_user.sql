@@ -0,0 +1,5 @@
+-- name: SelectWebhooksByUser :many
+SELECT *
+FROM webhooks
+WHERE user_id = @user_id
+ORDER BY id;
@@ -1,20 +1,201 @@
 CREATE TABLE notes
+(
+    id          BIGINT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+    title       VARCHAR(255) NOT NULL,
+    content     TEXT         NOT NULL,
+    user_id     BIGINT       NULL,
+    created_at  TIMESTAMPTZ  NOT NULL DEFAULT now(),
+    updated_at  TIMESTAMPTZ  NOT NULL DEFAULT now(),
+    deleted_at  TIMESTAMPTZ  NULL,
+    notebook_id BIGINT       NULL,
+    version     BIGINT       NOT NULL DEFAULT 1,
+    pinned      BOOLEAN      NOT NULL DEFAULT FALSE,
+    archived    BOOLEAN      NOT NULL DEFAULT FALSE,
+    starred     BOOLEAN      NOT NULL DEFAULT FALSE,
+    search      TSVECTOR GENERATED ALWAYS AS (
+        setweight(to_tsvector('simple', title), 'A') || setweight(to_tsvector('simple', content), 'B')
+        ) STORED
+);
+
+CREATE TABLE IF NOT EXISTS users
 (
     id         BIGINT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+    name       VARCHAR(255) NOT NULL,
+    email      VARCHAR(255) NOT NULL,
+    password   VARCHAR(255) NOT NULL,
+    token      UUID         NOT NULL,
+    created_at TIMESTAMPTZ  NOT NULL DEFAULT now(),
+    updated_at TIMESTAMPTZ  NOT NULL DEFAULT now()
+);
+
+CREATE TABLE note_revisions
+(
+    id         BIGINT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+    note_id    BIGINT       NOT NULL REFERENCES notes (id) ON DELETE CASCADE,
+    revision   INTEGER      NOT NULL,
     title      VARCHAR(255) NOT NULL,
     content    TEXT         NOT NULL,
-    user_id    BIGINT       NULL,
+    user_id    BIGINT       NULL REFERENCES users (id) ON DELETE SET NULL,
+    created_at TIMESTAMPTZ  NOT NULL DEFAULT now(),
+    UNIQUE (note_id, revision)
+);
+
+CREATE TABLE tags
+(
+    id         BIGINT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+    user_id    BIGINT      NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    name       VARCHAR(64) NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    UNIQUE (user_id, name)
+);
+
+CREATE TABLE note_tags
+(
+    note_id BIGINT NOT NULL REFERENCES notes (id) ON DELETE CASCADE,
+    tag_id  BIGINT NOT NULL REFERENCES tags (id) ON DELETE CASCADE,
+    PRIMARY KEY (note_id, tag_id)
+);
+
+CREATE TABLE note_shares
+(
+    note_id    BIGINT      NOT NULL REFERENCES notes (id) ON DELETE CASCADE,
+    user_id    BIGINT      NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    role       VARCHAR(16) NOT NULL CHECK (role IN ('viewer', 'editor')),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    PRIMARY KEY (note_id, user_id)
+);
+
+CREATE TABLE note_links
+(
+    id         BIGINT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+    note_id    BIGINT      NOT NULL REFERENCES notes (id) ON DELETE CASCADE,
+    token      VARCHAR(64) NOT NULL UNIQUE,
+    views      BIGINT      NOT NULL DEFAULT 0,
+    expires_at TIMESTAMPTZ NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE TABLE notebooks
+(
+    id         BIGINT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+    user_id    BIGINT       NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    parent_id  BIGINT       NULL REFERENCES notebooks (id),
+    name       VARCHAR(255) NOT NULL,
     created_at TIMESTAMPTZ  NOT NULL DEFAULT now(),
     updated_at TIMESTAMPTZ  NOT NULL DEFAULT now()
 );
 
-CREATE TABLE IF NOT EXISTS users
+CREATE TABLE note_attachments
+(
+    id           BIGINT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+    note_id      BIGINT       NOT NULL REFERENCES notes (id) ON DELETE CASCADE,
+    user_id      BIGINT       NULL REFERENCES users (id) ON DELETE SET NULL,
+    name         VARCHAR(255) NOT NULL,
+    content_type VARCHAR(255) NOT NULL,
+    blob_key     VARCHAR(64)  NOT NULL UNIQUE,
+    size         BIGINT       NOT NULL,
+    checksum     VARCHAR(64)  NOT NULL,
+    created_at   TIMESTAMPTZ  NOT NULL DEFAULT now()
+);
+
+CREATE TABLE blob_tombstones
+(
+    blob_key  VARCHAR(64) NOT NULL PRIMARY KEY,
+    buried_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE TABLE event_outbox
+(
+    event_id     UUID PRIMARY KEY,
+    event_type   SMALLINT    NOT NULL,
+    note_id      BIGINT      NOT NULL,
+    recipient_id BIGINT      NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    event_time   TIMESTAMPTZ NOT NULL,
+    attempts     INTEGER     NOT NULL DEFAULT 0,
+    last_error   TEXT        NULL,
+    available_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    created_at   TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE TABLE note_events
+(
+    id           UUID PRIMARY KEY,
+    event_type   SMALLINT    NOT NULL,
+    note_id      BIGINT      NOT NULL,
+    recipient_id BIGINT      NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    event_time   TIMESTAMPTZ NOT NULL,
+    read_at      TIMESTAMPTZ NULL
+);
+
+CREATE TABLE webhooks
+(
+    id          BIGINT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+    user_id     BIGINT        NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    url         VARCHAR(2048) NOT NULL,
+    secret      VARCHAR(64)   NOT NULL,
+    event_types SMALLINT[]    NOT NULL DEFAULT '{}',
+    failures    INTEGER       NOT NULL DEFAULT 0,
+    disabled_at TIMESTAMPTZ   NULL,
+    created_at  TIMESTAMPTZ   NOT NULL DEFAULT now(),
+    updated_at  TIMESTAMPTZ   NOT NULL DEFAULT now()
+);
+
+CREATE TABLE webhook_deliveries
+(
+    webhook_id   BIGINT      NOT NULL REFERENCES webhooks (id) ON DELETE CASCADE,
+    event_id     UUID        NOT NULL,
+    event_type   SMALLINT    NOT NULL,
+    note_id      BIGINT      NOT NULL,
+    recipient_id BIGINT      NOT NULL,
+    event_time   TIMESTAMPTZ NOT NULL,
+    attempts     INTEGER     NOT NULL DEFAULT 0,
+    available_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    created_at   TIMESTAMPTZ NOT NULL DEFAULT now(),
+    PRIMARY KEY (webhook_id, event_id)
+);
+
+CREATE TABLE webhook_attempts
+(
+    id           BIGINT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+    webhook_id   BIGINT      NOT NULL REFERENCES webhooks (id) ON DELETE CASCADE,
+    event_id     UUID        NOT NULL,
+    event_type   SMALLINT    NOT NULL,
+    status_code  INTEGER     NOT NULL,
+    error        TEXT        NULL,
+    duration     INTERVAL    NOT NULL,
+    attempted_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE TABLE note_reminders
+(
+    id          BIGINT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+    note_id     BIGINT       NOT NULL REFERENCES notes (id) ON DELETE CASCADE,
+    user_id     BIGINT       NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    fire_at     TIMESTAMPTZ  NULL,
+    fired_at    TIMESTAMPTZ  NULL,
+    recurrence  VARCHAR(256) NOT NULL DEFAULT '',
+    time_zone   VARCHAR(64)  NOT NULL DEFAULT 'UTC',
+    occurrences INTEGER      NOT NULL DEFAULT 0,
+    created_at  TIMESTAMPTZ  NOT NULL DEFAULT now(),
+    updated_at  TIMESTAMPTZ  NOT NULL DEFAULT now()
+);
+
+CREATE TABLE note_templates
 (
     id         BIGINT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+    user_id    BIGINT       NOT NULL REFERENCES users (id) ON DELETE CASCADE,
     name       VARCHAR(255) NOT NULL,
-    email      VARCHAR(255) NOT NULL,
-    password   VARCHAR(255) NOT NULL,
-    token      UUID         NOT NULL,
+    title      VARCHAR(255) NOT NULL DEFAULT '',
+    content    TEXT         NOT NULL,
     created_at TIMESTAMPTZ  NOT NULL DEFAULT now(),
     updated_at TIMESTAMPTZ  NOT NULL DEFAULT now()
 );
+
+CREATE TABLE note_references
+(
+    source_id    BIGINT       NOT NULL REFERENCES notes (id) ON DELETE CASCADE,
+    position     INTEGER      NOT NULL,
+    target_id    BIGINT       NULL,
+    target_title VARCHAR(255) NULL,
+    PRIMARY KEY (source_id, position)
+);
@@ -6,6 +6,7 @@ window.onload = function () {
         urls: [
             {url: "/docs/notes/service.swagger.json", name: "NotesService"},
             {url: "/docs/users/service.swagger.json", name: "UsersService"},
+            {url: "/docs/webhooks/service.swagger.json", name: "WebhooksService"},
         ],
         dom_id: '#swagger-ui',
         deepLinking: true,